@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+	"github.com/ita-av/booking-service/pkg/client"
+)
+
+// availabilityCommand lists a barber's free slots
+func availabilityCommand() *cobra.Command {
+	var date, services, timezone string
+	var days int
+	cmd := &cobra.Command{
+		Use:   "availability BARBER_ID",
+		Short: "List a barber's free slots over one or more days",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if days < 1 || days > 31 {
+				return fmt.Errorf("--days must be between 1 and 31")
+			}
+			start := time.Now()
+			if date != "" {
+				var err error
+				if start, err = parseDay(date); err != nil {
+					return err
+				}
+			}
+			serviceTypes, err := parseServices(services)
+			if err != nil {
+				return err
+			}
+
+			c, ctx, done, err := connect(cmd)
+			if err != nil {
+				return err
+			}
+			defer done()
+
+			resp, err := c.Stub().GetAvailableTimeSlotsRange(ctx, &pb.GetAvailableTimeSlotsRangeRequest{
+				BarberId:     args[0],
+				StartDay:     calendarDate(start),
+				EndDay:       calendarDate(start.AddDate(0, 0, days-1)),
+				Timezone:     timezone,
+				ServiceTypes: serviceTypes,
+			})
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "DAY\tSTART\tEND")
+			for _, day := range resp.Days {
+				label := fmt.Sprintf("%04d-%02d-%02d", day.Day.GetYear(), day.Day.GetMonth(), day.Day.GetDay())
+				for _, slot := range client.TimeSlots(day.TimeSlots) {
+					fmt.Fprintf(w, "%s\t%s\t%s\n", label, slot.Start.Format(time.RFC3339), slot.End.Format(time.RFC3339))
+				}
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().StringVar(&date, "date", "", "the first day (YYYY-MM-DD, defaults to today)")
+	cmd.Flags().IntVar(&days, "days", 1, "how many days to list, at most 31")
+	cmd.Flags().StringVar(&services, "service", "", "only slots with room for these services, e.g. haircut,beard_trim")
+	cmd.Flags().StringVar(&timezone, "timezone", "", "IANA timezone for day boundaries (defaults to the barber's)")
+	return cmd
+}
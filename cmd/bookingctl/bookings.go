@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+	"github.com/ita-av/booking-service/pkg/client"
+)
+
+// bookingsCommand lists, confirms and cancels bookings
+func bookingsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bookings",
+		Short: "List, confirm and cancel bookings",
+	}
+	cmd.AddCommand(listBookingsCommand(), getBookingCommand(), confirmBookingCommand(), cancelBookingCommand())
+	return cmd
+}
+
+func listBookingsCommand() *cobra.Command {
+	var req pb.ListBookingsRequest
+	var statuses, services string
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List bookings, filtered by customer, barber, status, service or date",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if req.Statuses, err = parseStatuses(statuses); err != nil {
+				return err
+			}
+			if req.ServiceTypes, err = parseServices(services); err != nil {
+				return err
+			}
+
+			c, ctx, done, err := connect(cmd)
+			if err != nil {
+				return err
+			}
+			defer done()
+
+			resp, err := c.Stub().ListBookings(ctx, &req)
+			if err != nil {
+				return err
+			}
+			return printBookings(cmd.OutOrStdout(), resp.Bookings)
+		},
+	}
+	cmd.Flags().StringVar(&req.UserId, "user", "", "only the customer's bookings")
+	cmd.Flags().StringVar(&req.BarberId, "barber", "", "only the barber's bookings")
+	cmd.Flags().StringVar(&statuses, "status", "", "only bookings with these statuses, e.g. pending,confirmed")
+	cmd.Flags().StringVar(&services, "service", "", "only bookings with these services, e.g. haircut,beard_trim")
+	cmd.Flags().StringVar(&req.StartDate, "from", "", "only bookings on or after this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&req.EndDate, "to", "", "only bookings on or before this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&req.Timezone, "timezone", "", "IANA timezone for the dates (defaults to UTC)")
+	cmd.Flags().BoolVar(&req.NeedsReview, "needs-review", false, "only bookings flagged for review")
+	cmd.Flags().BoolVar(&req.Descending, "desc", false, "list the latest bookings first")
+	return cmd
+}
+
+func getBookingCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get ID",
+		Short: "Show a booking",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, done, err := connect(cmd)
+			if err != nil {
+				return err
+			}
+			defer done()
+
+			booking, err := c.GetBooking(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			return printBookings(cmd.OutOrStdout(), []*pb.Booking{booking})
+		},
+	}
+}
+
+func confirmBookingCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "confirm ID",
+		Short: "Confirm a pending booking",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, done, err := connect(cmd)
+			if err != nil {
+				return err
+			}
+			defer done()
+
+			booking, err := c.Stub().ConfirmBooking(ctx, &pb.ConfirmBookingRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+			return printBookings(cmd.OutOrStdout(), []*pb.Booking{booking})
+		},
+	}
+}
+
+func cancelBookingCommand() *cobra.Command {
+	var reason string
+	cmd := &cobra.Command{
+		Use:   "cancel ID",
+		Short: "Cancel a booking",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, done, err := connect(cmd)
+			if err != nil {
+				return err
+			}
+			defer done()
+
+			resp, err := c.CancelBooking(ctx, args[0], reason)
+			if err != nil {
+				return err
+			}
+			if resp.Fee > 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "Cancelled %s, charging a fee of %d %s\n", args[0], resp.Fee, resp.Currency)
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Cancelled %s\n", args[0])
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&reason, "reason", "", "why the booking is cancelled, shown to the customer")
+	return cmd
+}
+
+// printBookings writes bookings as a table
+func printBookings(out io.Writer, bookings []*pb.Booking) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tSTATUS\tSTART\tEND\tBARBER\tCUSTOMER\tSERVICES")
+	for _, booking := range bookings {
+		start, end, err := client.BookingTimes(booking)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			booking.Id,
+			strings.ToLower(booking.Status.String()),
+			start.Format(time.RFC3339),
+			end.Format(time.RFC3339),
+			booking.BarberId,
+			booking.UserId,
+			formatServices(booking),
+		)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// seedCatalog is the service catalog test shops get, with prices in cents
+var seedCatalog = []*pb.CatalogService{
+	{ServiceType: pb.ServiceType_HAIRCUT, Name: "Haircut", DurationMinutes: 30, Price: 2500, Active: true},
+	{ServiceType: pb.ServiceType_BEARD_TRIM, Name: "Beard trim", DurationMinutes: 15, Price: 1200, Active: true},
+	{ServiceType: pb.ServiceType_HAIR_WASH, Name: "Hair wash", DurationMinutes: 15, Price: 800, Active: true},
+	{ServiceType: pb.ServiceType_FULL_SERVICE, Name: "Full service", DurationMinutes: 60, Price: 4500, Active: true},
+}
+
+// seedBarberNames are the display names of test barbers, reused with a
+// number once they run out
+var seedBarberNames = []string{"Marco", "Giulia", "Luca", "Sofia", "Matteo", "Chiara", "Andrea", "Elena"}
+
+// seedBarber returns the nth test barber, counting from 1
+func seedBarber(n int) *pb.Barber {
+	name := seedBarberNames[(n-1)%len(seedBarberNames)]
+	if n > len(seedBarberNames) {
+		name = fmt.Sprintf("%s %d", name, (n-1)/len(seedBarberNames)+1)
+	}
+	return &pb.Barber{
+		Id:          fmt.Sprintf("seed-barber-%d", n),
+		DisplayName: name,
+		Bio:         "Test barber created by bookingctl seed",
+		Active:      true,
+	}
+}
+
+// seedSchedule returns a test barber's working hours: Tuesday to Saturday,
+// with a lunch break, and Saturdays ending early
+func seedSchedule(barberID, timezone string) *pb.SetWorkingHoursRequest {
+	req := &pb.SetWorkingHoursRequest{
+		BarberId: barberID,
+		Breaks:   []*pb.BreakPeriod{{Start: "13:00", End: "14:00"}},
+		Timezone: timezone,
+	}
+	for _, weekday := range []pb.Weekday{pb.Weekday_TUESDAY, pb.Weekday_WEDNESDAY, pb.Weekday_THURSDAY, pb.Weekday_FRIDAY} {
+		req.Hours = append(req.Hours, &pb.WorkingHours{Weekday: weekday, Start: "09:00", End: "19:00"})
+	}
+	req.Hours = append(req.Hours, &pb.WorkingHours{Weekday: pb.Weekday_SATURDAY, Start: "09:00", End: "16:00"})
+	return req
+}
+
+// serviceMix is how often each service is booked
+var serviceMix = []struct {
+	services []pb.ServiceType
+	weight   float64
+}{
+	{[]pb.ServiceType{pb.ServiceType_HAIRCUT}, 0.45},
+	{[]pb.ServiceType{pb.ServiceType_HAIRCUT, pb.ServiceType_BEARD_TRIM}, 0.2},
+	{[]pb.ServiceType{pb.ServiceType_BEARD_TRIM}, 0.15},
+	{[]pb.ServiceType{pb.ServiceType_FULL_SERVICE}, 0.12},
+	{[]pb.ServiceType{pb.ServiceType_HAIR_WASH, pb.ServiceType_HAIRCUT}, 0.08},
+}
+
+// pickServices draws the services of a booking from serviceMix
+func pickServices(rnd *rand.Rand) []pb.ServiceType {
+	draw := rnd.Float64()
+	for _, mix := range serviceMix {
+		if draw < mix.weight {
+			return mix.services
+		}
+		draw -= mix.weight
+	}
+	return serviceMix[0].services
+}
+
+// demand is how busy a slot starting at start tends to be relative to the
+// average: late afternoons, lunchtimes, Fridays and Saturdays fill up first
+func demand(start time.Time) float64 {
+	weight := 1.0
+	switch start.Weekday() {
+	case time.Saturday:
+		weight *= 1.4
+	case time.Friday:
+		weight *= 1.2
+	case time.Tuesday, time.Wednesday:
+		weight *= 0.8
+	}
+	switch hour := start.Hour(); {
+	case hour >= 17:
+		weight *= 1.4
+	case hour == 12:
+		weight *= 1.2
+	case hour < 11:
+		weight *= 0.7
+	}
+	return weight
+}
+
+// outcome is what happens to a seeded booking after it's created
+type outcome int
+
+const (
+	outcomePending outcome = iota
+	outcomeConfirmed
+	outcomeCancelled
+)
+
+// pickOutcome draws a seeded booking's outcome: most are confirmed, some
+// still wait for confirmation and a few are cancelled
+func pickOutcome(rnd *rand.Rand) outcome {
+	switch draw := rnd.Float64(); {
+	case draw < 0.7:
+		return outcomeConfirmed
+	case draw < 0.9:
+		return outcomePending
+	default:
+		return outcomeCancelled
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// hoursCommand shows and sets barbers' working hours
+func hoursCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hours",
+		Short: "Show and set barbers' working hours",
+	}
+	cmd.AddCommand(getHoursCommand(), setHoursCommand())
+	return cmd
+}
+
+func getHoursCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "get BARBER_ID",
+		Short: "Show a barber's weekly working hours",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, done, err := connect(cmd)
+			if err != nil {
+				return err
+			}
+			defer done()
+
+			schedule, err := c.Stub().GetWorkingHours(ctx, &pb.GetWorkingHoursRequest{BarberId: args[0]})
+			if err != nil {
+				return err
+			}
+			return printSchedule(cmd.OutOrStdout(), schedule)
+		},
+	}
+}
+
+func setHoursCommand() *cobra.Command {
+	var req pb.SetWorkingHoursRequest
+	var shifts, breaks []string
+	cmd := &cobra.Command{
+		Use:   "set BARBER_ID",
+		Short: "Replace a barber's weekly working hours",
+		Example: `  bookingctl hours set barber1 --shift mon=09:00-17:00 --shift tue=09:00-17:00 \
+    --break 12:00-13:00 --timezone Europe/Berlin`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req.BarberId = args[0]
+			for _, shift := range shifts {
+				hours, err := parseShift(shift)
+				if err != nil {
+					return err
+				}
+				req.Hours = append(req.Hours, hours)
+			}
+			for _, period := range breaks {
+				start, end, err := parsePeriod(period)
+				if err != nil {
+					return err
+				}
+				req.Breaks = append(req.Breaks, &pb.BreakPeriod{Start: start, End: end})
+			}
+
+			c, ctx, done, err := connect(cmd)
+			if err != nil {
+				return err
+			}
+			defer done()
+
+			schedule, err := c.Stub().SetWorkingHours(ctx, &req)
+			if err != nil {
+				return err
+			}
+			return printSchedule(cmd.OutOrStdout(), schedule)
+		},
+	}
+	cmd.Flags().StringArrayVar(&shifts, "shift", nil, "a weekday's hours, e.g. mon=09:00-17:00; repeat for each working day")
+	cmd.Flags().StringArrayVar(&breaks, "break", nil, "a daily break, e.g. 12:00-13:00; repeat for several")
+	cmd.Flags().StringVar(&req.Timezone, "timezone", "", "IANA timezone the hours are in (defaults to the shop's)")
+	cmd.Flags().Int32Var(&req.SlotMinutes, "slot-minutes", 0, "how far apart offered start times are: 15, 20, 30 or 60 (defaults to 30)")
+	cmd.Flags().Int32Var(&req.MinLeadMinutes, "min-lead-minutes", 0, "how soon a booking may start (0 uses the shop policy)")
+	cmd.Flags().Int32Var(&req.MaxAdvanceDays, "max-advance-days", 0, "how many days ahead a booking may start (0 uses the shop policy)")
+	return cmd
+}
+
+// parseShift reads a weekday's hours, e.g. "mon=09:00-17:00"
+func parseShift(value string) (*pb.WorkingHours, error) {
+	day, period, ok := strings.Cut(value, "=")
+	if !ok {
+		return nil, fmt.Errorf("invalid shift %q, expected e.g. mon=09:00-17:00", value)
+	}
+	weekday, err := parseWeekday(day)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := parsePeriod(period)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WorkingHours{Weekday: weekday, Start: start, End: end}, nil
+}
+
+// parseWeekday reads a weekday's name or its first three letters
+func parseWeekday(value string) (pb.Weekday, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) >= 3 {
+		for name, number := range pb.Weekday_value {
+			if strings.HasPrefix(name, value) {
+				return pb.Weekday(number), nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday %q", value)
+}
+
+// parsePeriod reads a period of the day, e.g. "09:00-17:00"; the service
+// checks the times themselves
+func parsePeriod(value string) (start, end string, err error) {
+	start, end, ok := strings.Cut(value, "-")
+	if !ok {
+		return "", "", fmt.Errorf("invalid period %q, expected e.g. 09:00-17:00", value)
+	}
+	return strings.TrimSpace(start), strings.TrimSpace(end), nil
+}
+
+// printSchedule writes a barber's working hours
+func printSchedule(out io.Writer, schedule *pb.BarberSchedule) error {
+	fmt.Fprintf(out, "Barber:      %s\n", schedule.BarberId)
+	fmt.Fprintf(out, "Timezone:    %s\n", schedule.Timezone)
+	fmt.Fprintf(out, "Slots every: %d minutes\n", schedule.SlotMinutes)
+	if schedule.MinLeadMinutes > 0 {
+		fmt.Fprintf(out, "Lead time:   %d minutes\n", schedule.MinLeadMinutes)
+	}
+	if schedule.MaxAdvanceDays > 0 {
+		fmt.Fprintf(out, "Book ahead:  %d days\n", schedule.MaxAdvanceDays)
+	}
+	fmt.Fprintln(out)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DAY\tSTART\tEND")
+	for _, hours := range schedule.Hours {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", strings.ToLower(hours.Weekday.String()), hours.Start, hours.End)
+	}
+	for _, period := range schedule.Breaks {
+		fmt.Fprintf(w, "break\t%s\t%s\n", period.Start, period.End)
+	}
+	return w.Flush()
+}
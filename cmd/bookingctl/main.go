@@ -0,0 +1,145 @@
+// Command bookingctl calls the booking service's gRPC API for support tasks:
+// listing, confirming and cancelling bookings, checking availability,
+// changing working hours and seeding test data.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+	"github.com/ita-av/booking-service/pkg/client"
+)
+
+// globals holds the flags every command takes
+type globals struct {
+	addr     string
+	token    string
+	tenant   string
+	insecure bool
+	timeout  time.Duration
+}
+
+var flags globals
+
+func main() {
+	root := &cobra.Command{
+		Use:           "bookingctl",
+		Short:         "Manage bookings through the booking service API",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	root.PersistentFlags().StringVar(&flags.addr, "addr", envOr("BOOKINGCTL_ADDR", "localhost:50051"), "the service address (BOOKINGCTL_ADDR)")
+	root.PersistentFlags().StringVar(&flags.token, "token", os.Getenv("BOOKINGCTL_TOKEN"), "the token to call the service with (BOOKINGCTL_TOKEN)")
+	root.PersistentFlags().StringVar(&flags.tenant, "tenant", os.Getenv("BOOKINGCTL_TENANT"), "the shop to act on, for multi-tenant deployments (BOOKINGCTL_TENANT)")
+	root.PersistentFlags().BoolVar(&flags.insecure, "insecure", false, "connect without TLS, e.g. to a local server")
+	root.PersistentFlags().DurationVar(&flags.timeout, "timeout", 30*time.Second, "how long each command may take")
+
+	root.AddCommand(bookingsCommand(), availabilityCommand(), hoursCommand(), seedCommand())
+
+	if err := root.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+// envOr returns the environment variable key, or fallback if it's unset
+func envOr(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// connect creates a client from the global flags, and a context that ends
+// after the timeout
+func connect(cmd *cobra.Command) (*client.Client, context.Context, context.CancelFunc, error) {
+	if flags.token == "" {
+		return nil, nil, nil, fmt.Errorf("a token is required, set --token or BOOKINGCTL_TOKEN")
+	}
+
+	opts := []client.Option{client.WithToken(flags.token)}
+	if flags.insecure {
+		opts = append(opts, client.WithInsecure())
+	} else {
+		opts = append(opts, client.WithTLS(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+	if flags.tenant != "" {
+		opts = append(opts, client.WithTenant(flags.tenant))
+	}
+
+	c, err := client.New(flags.addr, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(cmd.Context(), flags.timeout)
+	return c, ctx, func() {
+		cancel()
+		c.Close()
+	}, nil
+}
+
+// parseServices reads comma-separated service names, e.g. "haircut,beard_trim"
+func parseServices(value string) ([]pb.ServiceType, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var services []pb.ServiceType
+	for _, name := range strings.Split(value, ",") {
+		service, ok := pb.ServiceType_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown service %q", name)
+		}
+		services = append(services, pb.ServiceType(service))
+	}
+	return services, nil
+}
+
+// parseStatuses reads comma-separated booking statuses, e.g. "pending,confirmed"
+func parseStatuses(value string) ([]pb.BookingStatus, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var statuses []pb.BookingStatus
+	for _, name := range strings.Split(value, ",") {
+		status, ok := pb.BookingStatus_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unknown status %q", name)
+		}
+		statuses = append(statuses, pb.BookingStatus(status))
+	}
+	return statuses, nil
+}
+
+// parseDay reads an ISO date, e.g. "2025-03-14"
+func parseDay(value string) (time.Time, error) {
+	day, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", value)
+	}
+	return day, nil
+}
+
+// calendarDate converts a day to the API's date
+func calendarDate(day time.Time) *pb.CalendarDate {
+	return &pb.CalendarDate{Year: int32(day.Year()), Month: int32(day.Month()), Day: int32(day.Day())}
+}
+
+// formatServices lists a booking's services in lower case
+func formatServices(booking *pb.Booking) string {
+	services := booking.GetServiceTypes()
+	if len(services) == 0 {
+		services = []pb.ServiceType{booking.GetServiceType()}
+	}
+	names := make([]string, len(services))
+	for i, service := range services {
+		names[i] = strings.ToLower(service.String())
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+	"github.com/ita-av/booking-service/pkg/client"
+)
+
+// seedOptions holds the seed command's flags
+type seedOptions struct {
+	barbers    []string
+	newBarbers int
+	timezone   string
+	from       string
+	days       int
+	occupancy  float64
+	customers  int
+	randSeed   int64
+}
+
+// seedCommand fills a test shop with a catalog, barbers and their working
+// hours, and bookings spread like a real shop's
+func seedCommand() *cobra.Command {
+	var opts seedOptions
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Fill a test shop with barbers, working hours, a catalog and bookings",
+		Long: `Seeds a shop for local development and load tests.
+
+Adds the catalog services the shop doesn't have yet, then creates --barbers
+test barbers (seed-barber-1, seed-barber-2, ...) working Tuesday to Saturday.
+Pass --barber instead to book existing barbers and leave their profiles and
+hours alone.
+
+Then books about --occupancy of each barber's free slots over --days days
+for customers seed-customer-1 to seed-customer-N. Evenings, lunchtimes,
+Fridays and Saturdays fill up first, haircuts are the most popular service,
+and most bookings are confirmed while some stay pending and a few are
+cancelled. Bookings can only be made for free slots ahead of now, like any
+client's.
+
+The token needs the admin role to set up the catalog and barbers, and the
+barber role to book for the test customers.
+
+Only use it against test deployments: everything it creates is real.`,
+		Example: `  bookingctl seed --barbers 4 --days 14 --occupancy 0.7
+  bookingctl seed --barber barber1 --from 2025-03-10 --days 5 --rand-seed 42`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSeed(cmd, opts)
+		},
+	}
+	cmd.Flags().StringArrayVar(&opts.barbers, "barber", nil, "an existing barber to book instead of creating test barbers; repeat for several")
+	cmd.Flags().IntVar(&opts.newBarbers, "barbers", 3, "how many test barbers to create")
+	cmd.Flags().StringVar(&opts.timezone, "timezone", "", "IANA timezone of the test barbers' hours (defaults to the shop's)")
+	cmd.Flags().StringVar(&opts.from, "from", "", "the first day to book (YYYY-MM-DD, defaults to today)")
+	cmd.Flags().IntVar(&opts.days, "days", 14, "how many days to book")
+	cmd.Flags().Float64Var(&opts.occupancy, "occupancy", 0.6, "the share of free slots to book on an average day, from 0 to 1")
+	cmd.Flags().IntVar(&opts.customers, "customers", 50, "how many test customers to spread the bookings over")
+	cmd.Flags().Int64Var(&opts.randSeed, "rand-seed", 0, "seed for the random choices, to repeat a run (defaults to the time)")
+	return cmd
+}
+
+// seedTally counts the bookings seeded by outcome
+type seedTally map[outcome]int
+
+func runSeed(cmd *cobra.Command, opts seedOptions) error {
+	if len(opts.barbers) == 0 && opts.newBarbers < 1 {
+		return fmt.Errorf("--barbers must be at least 1, or pass --barber")
+	}
+	if opts.days < 1 {
+		return fmt.Errorf("--days must be at least 1")
+	}
+	if opts.occupancy < 0 || opts.occupancy > 1 {
+		return fmt.Errorf("--occupancy must be between 0 and 1")
+	}
+	if opts.customers < 1 {
+		return fmt.Errorf("--customers must be at least 1")
+	}
+	from := time.Now()
+	if opts.from != "" {
+		var err error
+		if from, err = parseDay(opts.from); err != nil {
+			return err
+		}
+	}
+	if opts.randSeed == 0 {
+		opts.randSeed = time.Now().UnixNano()
+	}
+	rnd := rand.New(rand.NewSource(opts.randSeed))
+	out := cmd.OutOrStdout()
+
+	c, ctx, done, err := connect(cmd)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	barbers := opts.barbers
+	if len(barbers) == 0 {
+		created, err := seedCatalogServices(ctx, c)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Added %d catalog services\n", created)
+
+		for n := 1; n <= opts.newBarbers; n++ {
+			barberID, err := seedTestBarber(ctx, c, n, opts.timezone)
+			if err != nil {
+				return err
+			}
+			barbers = append(barbers, barberID)
+		}
+		fmt.Fprintf(out, "Set up %d barbers\n", len(barbers))
+	}
+
+	tally := seedTally{}
+	for _, barberID := range barbers {
+		if err := seedBookings(ctx, c, rnd, barberID, from, opts, tally); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "Created %d bookings: %d confirmed, %d pending, %d cancelled (rand seed %d)\n",
+		tally[outcomeConfirmed]+tally[outcomePending]+tally[outcomeCancelled],
+		tally[outcomeConfirmed], tally[outcomePending], tally[outcomeCancelled], opts.randSeed)
+	return nil
+}
+
+// seedCatalogServices adds the catalog services the shop doesn't have yet,
+// returning how many it added
+func seedCatalogServices(ctx context.Context, c *client.Client) (int, error) {
+	created := 0
+	for _, service := range seedCatalog {
+		_, err := c.Stub().CreateCatalogService(ctx, &pb.CreateCatalogServiceRequest{Service: service})
+		if errors.Is(err, client.ErrAlreadyExists) {
+			continue
+		}
+		if err != nil {
+			return created, err
+		}
+		created++
+	}
+	return created, nil
+}
+
+// seedTestBarber creates the nth test barber unless it exists, and sets its
+// working hours
+func seedTestBarber(ctx context.Context, c *client.Client, n int, timezone string) (string, error) {
+	barber := seedBarber(n)
+	_, err := c.Stub().CreateBarber(ctx, &pb.CreateBarberRequest{Barber: barber})
+	if err != nil && !errors.Is(err, client.ErrAlreadyExists) {
+		return "", err
+	}
+	if _, err := c.Stub().SetWorkingHours(ctx, seedSchedule(barber.Id, timezone)); err != nil {
+		return "", err
+	}
+	return barber.Id, nil
+}
+
+// maxRangeDays is how many days GetAvailableTimeSlotsRange takes at once
+const maxRangeDays = 31
+
+// seedBookings books a barber's free slots over the days from from
+func seedBookings(ctx context.Context, c *client.Client, rnd *rand.Rand, barberID string, from time.Time, opts seedOptions, tally seedTally) error {
+	schedule, err := c.Stub().GetWorkingHours(ctx, &pb.GetWorkingHoursRequest{BarberId: barberID})
+	if err != nil {
+		return err
+	}
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	for offset := 0; offset < opts.days; offset += maxRangeDays {
+		days := min(maxRangeDays, opts.days-offset)
+		start := from.AddDate(0, 0, offset)
+		resp, err := c.Stub().GetAvailableTimeSlotsRange(ctx, &pb.GetAvailableTimeSlotsRangeRequest{
+			BarberId: barberID,
+			StartDay: calendarDate(start),
+			EndDay:   calendarDate(start.AddDate(0, 0, days-1)),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, day := range resp.Days {
+			for _, slot := range client.TimeSlots(day.TimeSlots) {
+				chance := math.Min(1, opts.occupancy*demand(slot.Start.In(location)))
+				if rnd.Float64() >= chance {
+					continue
+				}
+				if err := seedBooking(ctx, c, rnd, barberID, slot.Start, opts.customers, tally); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// seedBooking books a slot for a random test customer and gives the booking
+// a random outcome. Slots the booking doesn't fit, e.g. because an earlier
+// booking ran into it, are skipped.
+func seedBooking(ctx context.Context, c *client.Client, rnd *rand.Rand, barberID string, start time.Time, customers int, tally seedTally) error {
+	booking, err := c.CreateBooking(ctx, client.NewBooking{
+		UserID:   fmt.Sprintf("seed-customer-%d", rnd.Intn(customers)+1),
+		BarberID: barberID,
+		Start:    start,
+		Services: pickServices(rnd),
+		Notes:    "Seeded by bookingctl",
+	})
+	if skippableSlot(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	result := pickOutcome(rnd)
+	switch result {
+	case outcomeConfirmed:
+		_, err = c.Stub().ConfirmBooking(ctx, &pb.ConfirmBookingRequest{Id: booking.Id})
+	case outcomeCancelled:
+		_, err = c.CancelBooking(ctx, booking.Id, "Seeded cancellation")
+	}
+	if err != nil {
+		return err
+	}
+	tally[result]++
+	return nil
+}
+
+// skippableSlot reports whether a booking failed because of its time, so
+// seeding can move on to the next slot
+func skippableSlot(err error) bool {
+	for _, target := range []error{
+		client.ErrSlotUnavailable, client.ErrBarberOnBreak, client.ErrShopClosed,
+		client.ErrStartTimeInPast, client.ErrBookingTooSoon, client.ErrBookingTooFarAhead,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
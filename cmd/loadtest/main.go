@@ -0,0 +1,321 @@
+// Command loadtest drives CreateBooking and GetAvailableTimeSlots against a
+// running booking service at a set concurrency, and reports the latencies.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+	"github.com/ita-av/booking-service/pkg/client"
+)
+
+const usage = `Usage: loadtest [flags]
+
+Calls GetAvailableTimeSlots and CreateBooking from -concurrency workers for
+-duration, then prints each method's throughput and p50, p90 and p99
+latencies. Bookings are made for free slots the workers were offered, for
+customers loadtest-customer-1 to loadtest-customer-N, and cancelled at the
+end unless -keep is given. Only run it against test deployments.
+
+The token needs the barber role to book for the test customers.
+
+Flags:
+`
+
+// options holds the command line flags
+type options struct {
+	addr        string
+	token       string
+	tenant      string
+	insecure    bool
+	barbers     string
+	concurrency int
+	duration    time.Duration
+	createRatio float64
+	days        int
+	customers   int
+	keep        bool
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.addr, "addr", "localhost:50051", "the service address")
+	flag.StringVar(&opts.token, "token", os.Getenv("LOADTEST_TOKEN"), "a token with the barber role (LOADTEST_TOKEN)")
+	flag.StringVar(&opts.tenant, "tenant", "", "the shop to act on, for multi-tenant deployments")
+	flag.BoolVar(&opts.insecure, "insecure", false, "connect without TLS, e.g. to a local server")
+	flag.StringVar(&opts.barbers, "barbers", "", "comma-separated barbers to book, e.g. seed-barber-1,seed-barber-2")
+	flag.IntVar(&opts.concurrency, "concurrency", 10, "how many workers call the service at once")
+	flag.DurationVar(&opts.duration, "duration", 30*time.Second, "how long to run")
+	flag.Float64Var(&opts.createRatio, "create-ratio", 0.2, "the share of calls that book a slot, from 0 to 1")
+	flag.IntVar(&opts.days, "days", 14, "how many days ahead to look for slots")
+	flag.IntVar(&opts.customers, "customers", 100, "how many test customers to book for")
+	flag.BoolVar(&opts.keep, "keep", false, "keep the bookings made instead of cancelling them")
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(opts); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func run(opts options) error {
+	barbers := strings.Split(opts.barbers, ",")
+	switch {
+	case opts.token == "":
+		return errors.New("a token is required, set -token or LOADTEST_TOKEN")
+	case opts.barbers == "":
+		return errors.New("-barbers is required")
+	case opts.concurrency < 1:
+		return errors.New("-concurrency must be at least 1")
+	case opts.createRatio < 0 || opts.createRatio > 1:
+		return errors.New("-create-ratio must be between 0 and 1")
+	case opts.days < 1 || opts.customers < 1:
+		return errors.New("-days and -customers must be at least 1")
+	}
+
+	clientOpts := []client.Option{
+		client.WithToken(opts.token),
+		// Measure each call once; retries would hide the latency of failures
+		client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 1}),
+	}
+	if opts.insecure {
+		clientOpts = append(clientOpts, client.WithInsecure())
+	} else {
+		clientOpts = append(clientOpts, client.WithTLS(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+	if opts.tenant != "" {
+		clientOpts = append(clientOpts, client.WithTenant(opts.tenant))
+	}
+	c, err := client.New(opts.addr, clientOpts...)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	// Stop early on Ctrl-C, still reporting and cleaning up
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, opts.duration)
+	defer cancel()
+
+	fmt.Printf("Running %d workers against %s for %s\n", opts.concurrency, opts.addr, opts.duration)
+	results := newResults()
+	started := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < opts.concurrency; i++ {
+		wg.Add(1)
+		go func(seed int64) {
+			defer wg.Done()
+			w := &worker{client: c, opts: opts, barbers: barbers, rnd: rand.New(rand.NewSource(seed)), results: results}
+			w.run(ctx)
+		}(time.Now().UnixNano() + int64(i))
+	}
+	wg.Wait()
+	elapsed := time.Since(started)
+
+	results.print(elapsed)
+
+	if !opts.keep {
+		cancelled := cleanUp(c, results.booked())
+		fmt.Printf("\nCancelled %d of %d bookings made\n", cancelled, len(results.booked()))
+	}
+	return nil
+}
+
+// worker calls the service in a loop until ctx ends
+type worker struct {
+	client  *client.Client
+	opts    options
+	barbers []string
+	rnd     *rand.Rand
+	results *results
+
+	// offered are slots the worker was last shown, to book from
+	offered  []client.TimeSlot
+	barberID string
+}
+
+func (w *worker) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		if len(w.offered) > 0 && w.rnd.Float64() < w.opts.createRatio {
+			w.createBooking(ctx)
+		} else {
+			w.getSlots(ctx)
+		}
+	}
+}
+
+// getSlots lists a random barber's free slots on a random day
+func (w *worker) getSlots(ctx context.Context) {
+	barberID := w.barbers[w.rnd.Intn(len(w.barbers))]
+	day := time.Now().AddDate(0, 0, w.rnd.Intn(w.opts.days))
+
+	start := time.Now()
+	slots, err := w.client.AvailableSlots(ctx, barberID, day)
+	if ctx.Err() != nil {
+		return
+	}
+	w.results.record("GetAvailableTimeSlots", time.Since(start), err)
+	if err == nil {
+		w.offered, w.barberID = slots, barberID
+	}
+}
+
+// createBooking books one of the slots last offered; others may have taken it
+// since, which counts as a conflict rather than an error
+func (w *worker) createBooking(ctx context.Context) {
+	i := w.rnd.Intn(len(w.offered))
+	slot := w.offered[i]
+	w.offered = slices.Delete(w.offered, i, i+1)
+
+	start := time.Now()
+	booking, err := w.client.CreateBooking(ctx, client.NewBooking{
+		UserID:   fmt.Sprintf("loadtest-customer-%d", w.rnd.Intn(w.opts.customers)+1),
+		BarberID: w.barberID,
+		Start:    slot.Start,
+		Services: []pb.ServiceType{pb.ServiceType_HAIRCUT},
+		Notes:    "Booked by loadtest",
+	})
+	if ctx.Err() != nil {
+		return
+	}
+	w.results.record("CreateBooking", time.Since(start), err)
+	if err == nil {
+		w.results.addBooking(booking.Id)
+	}
+}
+
+// cleanUp cancels the bookings made, returning how many it cancelled
+func cleanUp(c *client.Client, ids []string) int {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	cancelled := 0
+	for _, id := range ids {
+		if _, err := c.CancelBooking(ctx, id, "Load test"); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to cancel %s: %v\n", id, err)
+			continue
+		}
+		cancelled++
+	}
+	return cancelled
+}
+
+// methodResults are the outcomes of one method's calls
+type methodResults struct {
+	latencies []time.Duration
+	conflicts int
+	errors    map[string]int
+}
+
+// results collects the outcomes of every worker's calls
+type results struct {
+	mu       sync.Mutex
+	methods  map[string]*methodResults
+	bookings []string
+}
+
+func newResults() *results {
+	return &results{methods: make(map[string]*methodResults)}
+}
+
+// record adds a call's latency and outcome
+func (r *results) record(method string, latency time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, ok := r.methods[method]
+	if !ok {
+		m = &methodResults{errors: make(map[string]int)}
+		r.methods[method] = m
+	}
+	m.latencies = append(m.latencies, latency)
+	switch {
+	case err == nil:
+	case errors.Is(err, client.ErrSlotUnavailable):
+		m.conflicts++
+	default:
+		m.errors[err.Error()]++
+	}
+}
+
+func (r *results) addBooking(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bookings = append(r.bookings, id)
+}
+
+func (r *results) booked() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.bookings)
+}
+
+// print writes each method's throughput and latencies, then any errors
+func (r *results) print(elapsed time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	methods := make([]string, 0, len(r.methods))
+	for method := range r.methods {
+		methods = append(methods, method)
+	}
+	slices.Sort(methods)
+
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "METHOD\tCALLS\tRPS\tCONFLICTS\tERRORS\tP50\tP90\tP99\tMAX\t")
+	for _, method := range methods {
+		m := r.methods[method]
+		slices.Sort(m.latencies)
+		errorCount := 0
+		for _, count := range m.errors {
+			errorCount += count
+		}
+		fmt.Fprintf(w, "%s\t%d\t%.1f\t%d\t%d\t%s\t%s\t%s\t%s\t\n",
+			method,
+			len(m.latencies),
+			float64(len(m.latencies))/elapsed.Seconds(),
+			m.conflicts,
+			errorCount,
+			percentile(m.latencies, 0.5),
+			percentile(m.latencies, 0.9),
+			percentile(m.latencies, 0.99),
+			percentile(m.latencies, 1),
+		)
+	}
+	w.Flush()
+
+	for _, method := range methods {
+		for message, count := range r.methods[method].errors {
+			fmt.Printf("%s failed %d times: %s\n", method, count, message)
+		}
+	}
+}
+
+// percentile returns the latency at or below which the share p of sorted
+// latencies fall, rounded to 0.1ms
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(p*float64(len(sorted))+0.5) - 1
+	i = max(0, min(i, len(sorted)-1))
+	return sorted[i].Round(100 * time.Microsecond)
+}
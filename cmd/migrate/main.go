@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/config"
+	"github.com/ita-av/booking-service/internal/migrations"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+const usage = `Usage: migrate [-to VERSION] up|down|status
+
+  up      apply pending migrations, up to VERSION if given
+  down    undo applied migrations after VERSION, or only the latest
+  status  list applied and pending migrations
+
+Every shop's database is migrated in turn, connecting with MONGO_URI and
+MONGO_DB like the server.
+`
+
+func main() {
+	to := flag.Int("to", -1, "the version to migrate up or down to")
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	command := flag.Arg(0)
+	if command != "up" && command != "down" && command != "status" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339})
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load configuration")
+	}
+
+	ctx := context.Background()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoURI))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to MongoDB")
+	}
+	defer client.Disconnect(ctx)
+
+	db := client.Database(cfg.MongoDB)
+	tenants, err := repository.NewTenantDirectory(db).ListTenants(ctx)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to list tenants")
+	}
+
+	for _, tenantID := range tenants {
+		tenantDB := client.Database(repository.TenantDatabaseName(cfg.MongoDB, tenantID))
+		if err := migrate(ctx, tenantDB, command, *to); err != nil {
+			log.Fatal().Err(err).Str("database", tenantDB.Name()).Msg("Migration failed")
+		}
+	}
+}
+
+// migrate runs the command against one database
+func migrate(ctx context.Context, db *mongo.Database, command string, to int) error {
+	migrator, err := migrations.NewMigrator(db, migrations.All)
+	if err != nil {
+		return err
+	}
+
+	logger := log.With().Str("database", db.Name()).Logger()
+
+	switch command {
+	case "status":
+		applied, pending, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, record := range applied {
+			logger.Info().Int("version", record.Version).Time("appliedAt", record.AppliedAt).Msg(record.Description)
+		}
+		for _, migration := range pending {
+			logger.Info().Int("version", migration.Version).Msg("Pending: " + migration.Description)
+		}
+
+	case "up":
+		target := max(to, 0)
+		done, err := migrator.Up(ctx, target)
+		for _, migration := range done {
+			logger.Info().Int("version", migration.Version).Msg("Applied: " + migration.Description)
+		}
+		if err != nil {
+			return err
+		}
+
+	case "down":
+		target := to
+		if target < 0 {
+			// Only undo the latest migration
+			applied, _, err := migrator.Status(ctx)
+			if err != nil {
+				return err
+			}
+			target = 0
+			if len(applied) > 1 {
+				target = applied[len(applied)-2].Version
+			}
+		}
+		done, err := migrator.Down(ctx, target)
+		for _, migration := range done {
+			logger.Info().Int("version", migration.Version).Msg("Undone: " + migration.Description)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,36 +2,82 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"net"
+	"net/http"
+	"net/mail"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata" // Embed timezone data for IANA zone lookups in minimal images
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/ita-av/booking-service/config"
+	"github.com/ita-av/booking-service/internal/audit"
 	"github.com/ita-av/booking-service/internal/auth"
-
+	"github.com/ita-av/booking-service/internal/breaker"
+	"github.com/ita-av/booking-service/internal/cache"
+	"github.com/ita-av/booking-service/internal/calendar"
+	"github.com/ita-av/booking-service/internal/certs"
+	"github.com/ita-av/booking-service/internal/changestream"
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/drain"
+	"github.com/ita-av/booking-service/internal/featureflag"
+	"github.com/ita-av/booking-service/internal/graphql"
 	grpcServer "github.com/ita-av/booking-service/internal/grpc"
+	"github.com/ita-av/booking-service/internal/healthcheck"
+	"github.com/ita-av/booking-service/internal/jobs"
+	"github.com/ita-av/booking-service/internal/logging"
+	"github.com/ita-av/booking-service/internal/messaging"
+	"github.com/ita-av/booking-service/internal/metrics"
+	"github.com/ita-av/booking-service/internal/notification"
+	"github.com/ita-av/booking-service/internal/payment"
+	"github.com/ita-av/booking-service/internal/ratelimit"
 	"github.com/ita-av/booking-service/internal/repository"
 	"github.com/ita-av/booking-service/internal/service"
+	"github.com/ita-av/booking-service/internal/storage"
+	"github.com/ita-av/booking-service/internal/validation"
+	"github.com/ita-av/booking-service/internal/webhook"
 	pb "github.com/ita-av/booking-service/pkg/api/proto"
 )
 
+// developmentJWTSecret is the user service's development key, used when
+// JWT_SECRET isn't set in development
+const developmentJWTSecret = "secret_key_123"
+
 func main() {
+	checkConfig := flag.Bool("check-config", false, "validate the configuration and exit")
+	flag.Parse()
+
 	// Load configuration
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		fmt.Printf("Failed to load configuration: %v\n", err)
 		os.Exit(1)
 	}
+	if err := cfg.Validate(); err != nil {
+		fmt.Printf("Invalid configuration:\n%v\n", err)
+		os.Exit(1)
+	}
+	if *checkConfig {
+		fmt.Println("Configuration is valid")
+		return
+	}
 
 	// Configure logging
 	switch cfg.LogLevel {
@@ -43,41 +89,443 @@ func main() {
 		zerolog.SetGlobalLevel(zerolog.WarnLevel)
 	case "error":
 		zerolog.SetGlobalLevel(zerolog.ErrorLevel)
-	default:
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
 	}
 
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339})
 
 	log.Info().
 		Str("port", cfg.ServerPort).
+		Str("storage", cfg.Storage).
 		Str("mongo_uri", cfg.MongoURI).
 		Str("mongo_db", cfg.MongoDB).
+		Str("environment", cfg.Environment).
 		Msg("Starting booking service")
 
-	// Connect to MongoDB
+	// Create the authenticator verifying tokens from the user service
+	jwtSecret := cfg.JWTSecret
+	if jwtSecret == "" && cfg.JWKSURL == "" {
+		log.Warn().Msg("JWT_SECRET is not set, using the insecure development key")
+		jwtSecret = developmentJWTSecret
+	}
+
+	authenticator, err := auth.NewAuthenticator(auth.JWTConfig{
+		Secret:              []byte(jwtSecret),
+		Algorithm:           cfg.JWTAlgorithm,
+		JWKSURL:             cfg.JWKSURL,
+		JWKSRefreshInterval: cfg.JWKSRefreshInterval,
+		Issuer:              cfg.JWTIssuer,
+		Audience:            cfg.JWTAudience,
+		Leeway:              cfg.JWTLeeway,
+		RequireExpiry:       cfg.JWTRequireExpiry,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Invalid JWT configuration")
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	mongoClient, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.MongoURI))
-	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to connect to MongoDB")
+	// Collect RPC and MongoDB metrics
+	appMetrics := metrics.New()
+
+	// Open the storage bookings are kept in
+	var (
+		bookingRepo   bookingStore
+		mongoClient   *mongo.Client
+		db            *mongo.Database
+		sqliteRepo    *repository.SQLiteBookingRepository
+		ensureIndexes []func(ctx context.Context) error
+		// Every MongoDB repository bounds, retries and fails fast its
+		// operations with these
+		mongoOpts    []repository.MongoOption
+		mongoBreaker *breaker.Breaker
+	)
+	switch cfg.Storage {
+	case "mongodb":
+		mongoClient, err = mongo.Connect(ctx, mongoClientOptions(cfg).SetMonitor(appMetrics.CommandMonitor()))
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to connect to MongoDB")
+		}
+
+		// Check the connection
+		err = mongoClient.Ping(ctx, nil)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to ping MongoDB")
+		}
+		log.Info().Msg("Connected to MongoDB")
+
+		db = mongoClient.Database(cfg.MongoDB)
+		mongoBreaker = breaker.New(breaker.Config{
+			Threshold: cfg.MongoBreakerThreshold,
+			Cooldown:  cfg.MongoBreakerCooldown,
+			OnStateChange: func(state breaker.State) {
+				appMetrics.MongoBreakerState(state.String())
+				if state == breaker.Open {
+					log.Warn().Dur("cooldown", cfg.MongoBreakerCooldown).Msg("MongoDB keeps failing, failing calls fast")
+				}
+			},
+		}, clock.System)
+		mongoOpts = append(mongoOpts,
+			repository.WithTimeouts(repository.Timeouts{
+				Read:  cfg.MongoReadTimeout,
+				Write: cfg.MongoWriteTimeout,
+			}),
+			repository.WithRetries(repository.RetryPolicy{
+				Attempts:   cfg.MongoRetryAttempts,
+				Backoff:    cfg.MongoRetryBackoff,
+				MaxBackoff: cfg.MongoRetryMaxBackoff,
+				OnRetry:    appMetrics.MongoRetried,
+			}),
+			repository.WithBreaker(mongoBreaker),
+		)
+
+		// With booking locks, the service keeps concurrent bookings apart
+		// instead of transactions
+		bookingOpts := slices.Clone(mongoOpts)
+		if cfg.BookingLock != "" {
+			bookingOpts = append(bookingOpts, repository.WithCallerLocks())
+		}
+		mongoBookingRepo := repository.NewMongoBookingRepository(db, bookingOpts...)
+		if err := mongoBookingRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+		ensureIndexes = append(ensureIndexes, mongoBookingRepo.EnsureIndexes)
+		bookingRepo = mongoBookingRepo
+	case "sqlite":
+		sqliteRepo, err = repository.NewSQLiteBookingRepository(cfg.SQLitePath)
+		if err != nil {
+			log.Fatal().Err(err).Str("path", cfg.SQLitePath).Msg("Failed to open SQLite database")
+		}
+		bookingRepo = sqliteRepo
+		log.Info().Str("path", cfg.SQLitePath).Msg("Storing bookings in SQLite")
 	}
 
-	// Check the connection
-	err = mongoClient.Ping(ctx, nil)
-	if err != nil {
-		log.Fatal().Err(err).Msg("Failed to ping MongoDB")
+	// Validate made sure the time zone exists and the flags parse
+	shopLocation, _ := time.LoadLocation(cfg.ShopTimezone)
+	featureFlags, _ := featureflag.NewStatic(cfg.FeatureFlags)
+
+	serviceOpts := []service.Option{
+		service.WithDedupeWindow(cfg.DedupeWindow),
+		service.WithShopTimezone(shopLocation),
+		service.WithSchedulingPolicy(schedulingPolicy(cfg)),
+		service.WithFeatureFlags(featureflag.New(featureFlags)),
+		service.WithCurrency(cfg.Currency),
+		service.WithCommissionRate(cfg.CommissionRate),
+		service.WithEarlyCompletion(cfg.AllowEarlyCompletion),
+		service.WithNotifier(newNotifier(cfg)),
+		service.WithCalendar(calendarOptions(cfg)),
 	}
-	log.Info().Msg("Connected to MongoDB")
 
-	db := mongoClient.Database(cfg.MongoDB)
+	// Everything besides bookings is only kept in MongoDB; without it the
+	// service runs on its defaults
+	var (
+		auditRepo   repository.AuditRepository
+		webhookRepo repository.WebhookRepository
+	)
+	if db != nil {
+		payrollRepo := repository.NewMongoPayrollRepository(db, mongoOpts...)
+
+		settingsRepo := repository.NewMongoSettingsRepository(db, mongoOpts...)
+
+		scheduleRepo := repository.NewMongoScheduleRepository(db, mongoOpts...)
+
+		holidayRepo := repository.NewMongoHolidayRepository(db, mongoOpts...)
 
-	// Create repository
-	bookingRepo := repository.NewMongoBookingRepository(db)
+		catalogRepo := repository.NewMongoCatalogRepository(db, mongoOpts...)
+
+		offerRepo := repository.NewMongoBarberServicesRepository(db, mongoOpts...)
+
+		resourceRepo := repository.NewMongoResourceRepository(db, mongoOpts...)
+
+		barberRepo := repository.NewMongoBarberRepository(db, mongoOpts...)
+		if err := barberRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+
+		historyRepo := repository.NewMongoBookingHistoryRepository(db, mongoOpts...)
+		if err := historyRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+
+		mongoAuditRepo := repository.NewMongoAuditRepository(db, mongoOpts...)
+		if err := mongoAuditRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+		auditRepo = mongoAuditRepo
+
+		surveyRepo := repository.NewMongoSurveyRepository(db, mongoOpts...)
+		if err := surveyRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+
+		reviewRepo := repository.NewMongoReviewRepository(db, mongoOpts...)
+		if err := reviewRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+
+		favoriteRepo := repository.NewMongoFavoriteRepository(db, mongoOpts...)
+		if err := favoriteRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+
+		promoRepo := repository.NewMongoPromoCodeRepository(db, mongoOpts...)
+
+		mongoWebhookRepo := repository.NewMongoWebhookRepository(db, mongoOpts...)
+		if err := mongoWebhookRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+		webhookRepo = mongoWebhookRepo
+
+		// Other tenants' databases get the same indexes when first used
+		ensureIndexes = append(ensureIndexes,
+			barberRepo.EnsureIndexes,
+			historyRepo.EnsureIndexes,
+			mongoAuditRepo.EnsureIndexes,
+			surveyRepo.EnsureIndexes,
+			reviewRepo.EnsureIndexes,
+			favoriteRepo.EnsureIndexes,
+			mongoWebhookRepo.EnsureIndexes,
+		)
+
+		serviceOpts = append(serviceOpts,
+			service.WithPayrollRepository(payrollRepo),
+			service.WithSettingsRepository(settingsRepo),
+			service.WithScheduleRepository(scheduleRepo),
+			service.WithHolidayRepository(holidayRepo),
+			service.WithCatalogRepository(catalogRepo),
+			service.WithBarberServicesRepository(offerRepo),
+			service.WithBarberRepository(barberRepo),
+			service.WithResourceRepository(resourceRepo),
+			service.WithHistoryRepository(historyRepo),
+			service.WithAuditRepository(auditRepo),
+			service.WithWebhookRepository(webhookRepo),
+			service.WithReviewRepository(reviewRepo),
+			service.WithFavoriteRepository(favoriteRepo),
+			service.WithPromoCodeRepository(promoRepo),
+		)
+
+		if cfg.SurveyBaseURL != "" {
+			serviceOpts = append(serviceOpts, service.WithSurveys(surveyRepo, cfg.SurveyBaseURL))
+		}
+	}
+
+	if cfg.LateArrivalRelease {
+		serviceOpts = append(serviceOpts, service.WithLateArrivalRelease(cfg.LateArrivalGracePeriod))
+	}
+
+	if cfg.PendingBookingTimeout > 0 {
+		serviceOpts = append(serviceOpts, service.WithPendingTimeout(cfg.PendingBookingTimeout))
+	}
+
+	serviceOpts = append(serviceOpts, service.WithDeletedRetention(cfg.DeletedRetention))
+
+	if cfg.ReminderLead > 0 {
+		serviceOpts = append(serviceOpts, service.WithReminders(cfg.ReminderLead))
+	}
+	if minute, ok, _ := cfg.DailyAgendaMinute(); ok {
+		serviceOpts = append(serviceOpts, service.WithDailyAgendas(minute))
+	}
+
+	if cfg.AutoCompleteAfter > 0 {
+		serviceOpts = append(serviceOpts, service.WithAutoCompletion(cfg.AutoCompleteAfter, cfg.AutoCompleteCheckIn))
+	}
+
+	// Create the payment provider collecting deposits
+	var paymentProvider payment.Provider
+	if cfg.StripeSecretKey != "" {
+		paymentProvider = payment.NewStripeProvider(cfg.StripeSecretKey, cfg.StripeWebhookSecret)
+		serviceOpts = append(serviceOpts, service.WithDeposits(paymentProvider, cfg.DepositRate, cfg.DepositTimeout),
+			service.WithNoShowDepositThreshold(cfg.NoShowDepositThreshold))
+	}
+
+	// Publish booking events to a message broker
+	var eventPublisher messaging.Publisher
+	switch cfg.EventPublisher {
+	case "":
+	case "kafka":
+		eventPublisher = messaging.NewKafkaPublisher(messaging.KafkaConfig{
+			Brokers: strings.Split(cfg.KafkaBrokers, ","),
+			Topic:   cfg.KafkaTopic,
+			Timeout: cfg.EventPublishTimeout,
+		})
+	case "nats":
+		eventPublisher, err = messaging.NewNATSPublisher(ctx, messaging.NATSConfig{
+			URL:           cfg.NATSURL,
+			Stream:        cfg.NATSStream,
+			SubjectPrefix: cfg.NATSSubjectPrefix,
+			Timeout:       cfg.EventPublishTimeout,
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to connect to NATS")
+		}
+	}
+	if eventPublisher != nil {
+		// Events are stored with the booking changes and relayed from there
+		outboxRepo := repository.NewMongoOutboxRepository(db, mongoOpts...)
+		if err := outboxRepo.EnsureIndexes(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to create MongoDB indexes")
+		}
+		ensureIndexes = append(ensureIndexes, outboxRepo.EnsureIndexes)
+
+		serviceOpts = append(serviceOpts, service.WithEventPublisher(eventPublisher),
+			service.WithOutbox(outboxRepo, repository.NewMongoTransactor(db, mongoOpts...)))
+		log.Info().Str("publisher", cfg.EventPublisher).Msg("Publishing booking events")
+	}
+
+	// Feed watchers every change from the change stream instead of only this
+	// instance's changes
+	if cfg.ChangeStreamEnabled {
+		serviceOpts = append(serviceOpts, service.WithExternalEvents())
+	}
+
+	// Create attachment storage
+	var attachmentStore *storage.LocalStore
+	if cfg.AttachmentDir != "" {
+		attachmentStore, err = storage.NewLocalStore(cfg.AttachmentDir, cfg.AttachmentBaseURL, cfg.AttachmentSigningKey)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to create attachment storage")
+		}
+		serviceOpts = append(serviceOpts, service.WithAttachmentStore(attachmentStore, cfg.AttachmentMaxSize))
+	}
+
+	// Cache available slots and demand heatmaps in Redis, shared by every replica
+	var slotCache *cache.RedisSlotCache
+	var heatmapCache *cache.RedisHeatmapCache
+	if cfg.RedisURL != "" {
+		slotCache, err = cache.NewRedisSlotCache(cfg.RedisURL, cfg.SlotCacheTTL)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid REDIS_URL")
+		}
+		if err := slotCache.Ping(ctx); err != nil {
+			log.Fatal().Err(err).Msg("Failed to connect to Redis")
+		}
+		serviceOpts = append(serviceOpts, service.WithSlotCache(slotCache))
+		log.Info().Dur("ttl", cfg.SlotCacheTTL).Msg("Caching available slots in Redis")
+
+		heatmapCache, err = cache.NewRedisHeatmapCache(cfg.RedisURL)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid REDIS_URL")
+		}
+		serviceOpts = append(serviceOpts, service.WithHeatmapCache(heatmapCache))
+	}
+
+	// Lock barbers' schedules while booking, shared by every replica
+	var redisLocks *repository.RedisLeaseRepository
+	if cfg.BookingLock != "" {
+		var slotLocks repository.LeaseRepository
+		switch cfg.BookingLock {
+		case "redis":
+			redisLocks, err = repository.NewRedisLeaseRepository(cfg.RedisURL)
+			if err != nil {
+				log.Fatal().Err(err).Msg("Invalid REDIS_URL")
+			}
+			if err := redisLocks.Ping(ctx); err != nil {
+				log.Fatal().Err(err).Msg("Failed to connect to Redis")
+			}
+			slotLocks = redisLocks
+		case "mongodb":
+			slotLocks = repository.NewMongoLeaseRepository(db, mongoOpts...)
+		}
+		serviceOpts = append(serviceOpts, service.WithSlotLocks(slotLocks, cfg.BookingLockTTL, cfg.BookingLockWait))
+		log.Info().Str("lock", cfg.BookingLock).Msg("Locking barbers' schedules while booking")
+	}
 
 	// Create service
-	bookingService := service.NewBookingService(bookingRepo)
+	bookingService := service.NewBookingService(bookingRepo, serviceOpts...)
+
+	// Scope every call to the caller's shop. Each shop has a database of its
+	// own, indexed when it's first used. Only tokens can bring a new shop
+	// into being; anonymous callers must name one that exists.
+	tenantDirectory := repository.NewTenantDirectory(db)
+	tenants := &auth.TenantGuard{Enabled: cfg.MultiTenant}
+	if cfg.MultiTenant {
+		tenants.Prepare = repository.NewTenantIndexes(ensureIndexes...).Prepare
+		tenants.Known = tenantDirectory.Known
+		log.Info().Msg("Multi-tenancy enabled")
+	}
+
+	// Background jobs cover every shop in turn
+	forEachTenant := func(job jobs.Job) jobs.Job {
+		if !cfg.MultiTenant {
+			return job
+		}
+		return jobs.ForEachTenant(job, tenantDirectory)
+	}
+
+	// Start background jobs
+	scheduler := jobs.NewScheduler()
+	if cfg.PayrollExportDir != "" {
+		scheduler.Every(time.Hour, forEachTenant(jobs.NewPayrollExportJob(bookingService, cfg.PayrollExportDir)))
+	}
+	if db != nil {
+		// Retention policies are stored in MongoDB
+		scheduler.Every(6*time.Hour, forEachTenant(jobs.NewRetentionJob(bookingService)))
+	}
+	if cfg.LateArrivalRelease {
+		scheduler.Every(time.Minute, forEachTenant(jobs.NewLateArrivalJob(bookingService)))
+	}
+	if paymentProvider != nil {
+		scheduler.Every(time.Minute, forEachTenant(jobs.NewDepositExpiryJob(bookingService)))
+	}
+	if cfg.PendingBookingTimeout > 0 {
+		scheduler.Every(time.Minute, forEachTenant(jobs.NewPendingExpiryJob(bookingService)))
+	}
+	scheduler.Every(time.Minute, forEachTenant(jobs.NewHoldExpiryJob(bookingService)))
+	scheduler.Every(time.Hour, forEachTenant(jobs.NewDeletedPurgeJob(bookingService)))
+	if cfg.AutoCompleteAfter > 0 {
+		scheduler.Every(10*time.Minute, forEachTenant(jobs.NewAutoCompleteJob(bookingService)))
+	}
+	if eventPublisher != nil {
+		scheduler.Every(time.Second, forEachTenant(jobs.NewOutboxRelayJob(bookingService)))
+	}
+	if cfg.ReminderLead > 0 {
+		reminderJob := forEachTenant(jobs.NewReminderJob(bookingService))
+		if db != nil {
+			// One replica at a time sends reminders; the lease outlives a few
+			// missed runs before another replica takes over
+			leaseRepo := repository.NewMongoLeaseRepository(db, mongoOpts...)
+			reminderJob = jobs.WithLease(reminderJob, leaseRepo, instanceID(), 3*cfg.ReminderInterval)
+		}
+		scheduler.Every(cfg.ReminderInterval, reminderJob)
+	}
+	if cfg.DailyAgendaTime != "" {
+		agendaJob := forEachTenant(jobs.NewDailyAgendaJob(bookingService))
+		if db != nil {
+			// One replica at a time sends agendas, like reminders
+			leaseRepo := repository.NewMongoLeaseRepository(db, mongoOpts...)
+			agendaJob = jobs.WithLease(agendaJob, leaseRepo, instanceID(), 3*service.DailyAgendaInterval)
+		}
+		scheduler.Every(service.DailyAgendaInterval, agendaJob)
+	}
+	scheduler.Start(context.Background())
+
+	// Deliver booking events to registered webhooks
+	dispatcherCtx, stopDispatcherEvents := context.WithCancel(context.Background())
+	var dispatcher *webhook.Dispatcher
+	if webhookRepo != nil {
+		dispatcher = webhook.NewDispatcher(webhookRepo, webhook.DispatcherConfig{
+			MaxAttempts: cfg.WebhookMaxAttempts,
+			Timeout:     cfg.WebhookTimeout,
+		})
+		dispatcher.Start(dispatcherCtx, bookingService.WatchAllBookings(dispatcherCtx))
+	}
+
+	// Watch the bookings collection's change stream
+	var watcher *changestream.Watcher
+	if cfg.ChangeStreamEnabled {
+		name := cfg.ChangeStreamName
+		if name == "" {
+			if name, err = os.Hostname(); err != nil {
+				log.Fatal().Err(err).Msg("CHANGE_STREAM_NAME is required when the hostname is unknown")
+			}
+		}
+		watcher = changestream.NewWatcher(db, name, bookingService)
+		if cfg.MultiTenant {
+			watcher.WatchTenants()
+		}
+		watcher.Start(context.Background())
+		log.Info().Str("name", name).Msg("Watching booking changes")
+	}
 
 	// Create gRPC server
 	bookingServer := grpcServer.NewBookingServer(bookingService)
@@ -88,11 +536,103 @@ func main() {
 		log.Fatal().Err(err).Str("port", cfg.ServerPort).Msg("Failed to listen")
 	}
 
-	s := grpc.NewServer(
-		grpc.UnaryInterceptor(auth.AuthInterceptor),
+	// Limit how often each caller may call each method
+	limiter := ratelimit.NewLimiter(rateLimits(cfg))
+
+	// Track calls in flight, and turn away new bookings once shutting down
+	drainTracker := drain.NewTracker("CreateBooking", "HoldTimeSlot", "RebookLast")
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		appMetrics.UnaryInterceptor,
+		logging.UnaryInterceptor,
+		drainTracker.Unary,
+		breaker.UnaryInterceptor,
+		authenticator.Unary,
+		tenants.Unary,
+		limiter.Unary,
+		validation.UnaryInterceptor,
+		auth.AuthorizeInterceptor,
+	}
+	if auditRepo != nil {
+		unaryInterceptors = append(unaryInterceptors, audit.NewInterceptor(auditRepo).Unary)
+	}
+
+	serverOpts := append(grpcServerOptions(cfg),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(
+			appMetrics.StreamInterceptor,
+			logging.StreamInterceptor,
+			drainTracker.Stream,
+			breaker.StreamInterceptor,
+			authenticator.Stream,
+			tenants.Stream,
+			limiter.Stream,
+			validation.StreamInterceptor,
+			auth.StreamAuthorizeInterceptor,
+		),
 	)
+
+	// Serve TLS, and require client certificates when a client CA is set
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" || cfg.TLSClientCAFile != "" {
+		certReloader, err := certs.NewReloader(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSClientCAFile)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to load TLS certificates")
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(certReloader.TLSConfig())))
+
+		// Reload certificates on SIGHUP, e.g. after a renewal
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		go func() {
+			for range hup {
+				if err := certReloader.Reload(); err != nil {
+					log.Error().Err(err).Msg("Failed to reload TLS certificates, keeping the current ones")
+					continue
+				}
+				log.Info().Msg("Reloaded TLS certificates")
+			}
+		}()
+
+		log.Info().Bool("mutual", cfg.TLSClientCAFile != "").Msg("TLS enabled")
+	} else if cfg.IsProduction() {
+		log.Warn().Msg("TLS is disabled, the gRPC server listens in plaintext")
+	}
+
+	s := grpc.NewServer(serverOpts...)
 	pb.RegisterBookingServiceServer(s, bookingServer)
 
+	// Serve gRPC health checks backed by the service's dependencies
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(s, healthServer)
+
+	healthMonitor := healthcheck.NewMonitor(healthServer, 10*time.Second, pb.BookingService_ServiceDesc.ServiceName)
+	if mongoClient != nil {
+		healthMonitor.AddCheck("mongodb", func(ctx context.Context) error {
+			return mongoClient.Ping(ctx, nil)
+		})
+		healthMonitor.AddCheck("mongodb-breaker", mongoBreaker.Check)
+	}
+	healthMonitor.AddCheck("bookings", bookingRepo.Ping)
+
+	healthCtx, stopHealthMonitor := context.WithCancel(context.Background())
+	go healthMonitor.Run(healthCtx)
+
+	// Apply changes to the scheduling policy and rate limits without a
+	// restart, on SIGHUP or when the config file changes
+	reloader := &configReloader{service: bookingService, limiter: limiter, flags: featureFlags, current: cfg}
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	go func() {
+		for range reload {
+			reloader.reload("SIGHUP")
+		}
+	}()
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		if err := config.WatchFile(context.Background(), path, func() { reloader.reload("config file") }); err != nil {
+			log.Warn().Err(err).Msg("Config file changes won't be reloaded until SIGHUP")
+		}
+	}
+
 	// Enable reflection for tools like grpcurl
 	reflection.Register(s)
 
@@ -104,20 +644,256 @@ func main() {
 		}
 	}()
 
+	// Start HTTP server for inbound webhooks, attachment uploads and GraphQL
+	mux := http.NewServeMux()
+	if cfg.POSWebhookSecret != "" {
+		mux.Handle("/webhooks/pos", webhook.NewPOSHandler(bookingService, cfg.POSWebhookSecret, tenants))
+	}
+	if paymentProvider != nil {
+		mux.Handle("/webhooks/stripe", webhook.NewPaymentHandler(bookingService, paymentProvider, tenants))
+	}
+	if attachmentStore != nil {
+		mux.Handle("/attachments/", http.StripPrefix("/attachments", attachmentStore))
+	}
+	if cfg.GraphQLEnabled {
+		schema, err := graphql.NewSchema(bookingService)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse GraphQL schema")
+		}
+		mux.Handle("/graphql", graphql.NewHandler(schema, authenticator, tenants))
+	}
+
+	var httpServer *http.Server
+	if cfg.POSWebhookSecret != "" || paymentProvider != nil || attachmentStore != nil || cfg.GraphQLEnabled {
+		httpServer = &http.Server{
+			Addr:              fmt.Sprintf(":%s", cfg.HTTPPort),
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		go func() {
+			log.Info().Str("port", cfg.HTTPPort).Msg("HTTP server listening")
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatal().Err(err).Msg("Failed to serve HTTP")
+			}
+		}()
+	}
+
+	// Start the metrics listener, kept apart from the public HTTP server
+	var metricsServer *http.Server
+	if cfg.MetricsPort != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", appMetrics.Handler())
+
+		metricsServer = &http.Server{
+			Addr:              fmt.Sprintf(":%s", cfg.MetricsPort),
+			Handler:           metricsMux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		go func() {
+			log.Info().Str("port", cfg.MetricsPort).Msg("Metrics server listening")
+			if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatal().Err(err).Msg("Failed to serve metrics")
+			}
+		}()
+	}
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 
-	log.Info().Msg("Shutting down server...")
+	log.Info().Dur("timeout", cfg.ShutdownTimeout).Msg("Shutting down server...")
+
+	// Everything below gets SHUTDOWN_TIMEOUT to finish what it's doing
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer shutdownCancel()
 
-	// Stop the gRPC server
-	s.GracefulStop()
+	// Turn away new bookings and end open streams, so clients retry on
+	// another replica, and report NOT_SERVING so load balancers stop sending
+	// traffic
+	drainTracker.Drain()
+	stopHealthMonitor()
+	healthServer.Shutdown()
+
+	// Let the calls in flight finish, then stop the HTTP and gRPC servers
+	if httpServer != nil {
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			log.Error().Err(err).Msg("Error shutting down HTTP server")
+		}
+	}
+	drainTracker.GracefulStop(shutdownCtx, s)
+
+	// Let running background jobs, such as sending reminders or relaying the
+	// outbox, finish their run
+	scheduler.Shutdown(shutdownCtx)
+
+	// Stop watching the change stream
+	if watcher != nil {
+		watcher.Stop()
+	}
 
-	// Disconnect from MongoDB
-	if err := mongoClient.Disconnect(context.Background()); err != nil {
-		log.Error().Err(err).Msg("Error disconnecting from MongoDB")
+	// Stop delivering webhooks
+	stopDispatcherEvents()
+	if dispatcher != nil {
+		dispatcher.Stop()
+	}
+
+	// Flush events still being published
+	if eventPublisher != nil {
+		if err := eventPublisher.Close(); err != nil {
+			log.Error().Err(err).Msg("Error closing event publisher")
+		}
+	}
+
+	// Stop the metrics server
+	if metricsServer != nil {
+		if err := metricsServer.Close(); err != nil {
+			log.Error().Err(err).Msg("Error shutting down metrics server")
+		}
+	}
+
+	// Close the caches and locks
+	if slotCache != nil {
+		if err := slotCache.Close(); err != nil {
+			log.Error().Err(err).Msg("Error closing Redis connection")
+		}
+	}
+	if heatmapCache != nil {
+		if err := heatmapCache.Close(); err != nil {
+			log.Error().Err(err).Msg("Error closing Redis connection")
+		}
+	}
+	if redisLocks != nil {
+		if err := redisLocks.Close(); err != nil {
+			log.Error().Err(err).Msg("Error closing Redis connection")
+		}
+	}
+
+	// Disconnect from MongoDB, or close the SQLite database
+	if mongoClient != nil {
+		if err := mongoClient.Disconnect(context.Background()); err != nil {
+			log.Error().Err(err).Msg("Error disconnecting from MongoDB")
+		}
+	}
+	if sqliteRepo != nil {
+		if err := sqliteRepo.Close(); err != nil {
+			log.Error().Err(err).Msg("Error closing SQLite database")
+		}
 	}
 
 	log.Info().Msg("Server exited properly")
 }
+
+// newNotifier creates the notifier for EMAIL_PROVIDER, or one only logging
+// notifications when it isn't set
+func newNotifier(cfg *config.Config) notification.Notifier {
+	var sender notification.EmailSender
+	var err error
+	switch cfg.EmailProvider {
+	case "":
+		return notification.NewLogNotifier()
+	case "smtp":
+		sender, err = notification.NewSMTPSender(notification.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.EmailFrom,
+			Timeout:  cfg.EmailTimeout,
+		})
+	case "sendgrid":
+		sender, err = notification.NewSendGridSender(cfg.SendGridAPIKey, cfg.EmailFrom, cfg.EmailTimeout)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create email sender")
+	}
+
+	templateFS := notification.DefaultTemplates()
+	if cfg.EmailTemplateDir != "" {
+		templateFS = os.DirFS(cfg.EmailTemplateDir)
+	}
+	templates, err := notification.ParseTemplates(templateFS)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load email templates")
+	}
+
+	var contacts notification.ContactDirectory
+	if cfg.UserServiceURL != "" {
+		contacts = notification.NewUserServiceDirectory(cfg.UserServiceURL, cfg.UserServiceToken, cfg.EmailTimeout)
+	}
+
+	log.Info().Str("provider", cfg.EmailProvider).Msg("Emailing booking notifications")
+	return notification.NewEmailNotifier(sender, templates, contacts)
+}
+
+// bookingStore is where bookings are kept, MongoDB or SQLite
+type bookingStore interface {
+	repository.BookingRepository
+	Ping(ctx context.Context) error
+}
+
+// instanceID identifies this process among the service's replicas
+func instanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
+// grpcServerOptions returns the gRPC server's connection settings from cfg,
+// which must be valid
+func grpcServerOptions(cfg *config.Config) []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(cfg.GRPCMaxRecvMsgSize),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  cfg.GRPCKeepaliveTime,
+			Timeout:               cfg.GRPCKeepaliveTimeout,
+			MaxConnectionIdle:     cfg.GRPCMaxConnectionIdle,
+			MaxConnectionAge:      cfg.GRPCMaxConnectionAge,
+			MaxConnectionAgeGrace: cfg.GRPCMaxConnectionAgeGrace,
+		}),
+		// Clients pinging more often than this are disconnected
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             cfg.GRPCKeepaliveMinTime,
+			PermitWithoutStream: cfg.GRPCKeepalivePermitIdle,
+		}),
+	}
+	if cfg.GRPCMaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(uint32(cfg.GRPCMaxConcurrentStreams)))
+	}
+	return opts
+}
+
+// mongoClientOptions returns the MongoDB client's settings from cfg, which
+// must be valid. Settings left zero keep the URI's or the driver's.
+func mongoClientOptions(cfg *config.Config) *options.ClientOptions {
+	opts := options.Client().ApplyURI(cfg.MongoURI)
+	if cfg.MongoMaxPoolSize > 0 {
+		opts.SetMaxPoolSize(uint64(cfg.MongoMaxPoolSize))
+	}
+	if cfg.MongoMinPoolSize > 0 {
+		opts.SetMinPoolSize(uint64(cfg.MongoMinPoolSize))
+	}
+	if cfg.MongoServerSelectionTimeout > 0 {
+		opts.SetServerSelectionTimeout(cfg.MongoServerSelectionTimeout)
+	}
+	if cfg.MongoReadPreference != "" {
+		mode, _ := readpref.ModeFromString(cfg.MongoReadPreference)
+		rp, _ := readpref.New(mode)
+		opts.SetReadPreference(rp)
+	}
+	return opts
+}
+
+// calendarOptions describes the shop in bookings' calendar files, with the
+// email sender as the events' organizer
+func calendarOptions(cfg *config.Config) calendar.Options {
+	opts := calendar.Options{Domain: cfg.CalendarDomain, ShopName: cfg.ShopName}
+	if from, err := mail.ParseAddress(cfg.EmailFrom); err == nil {
+		opts.Organizer = from.Address
+	}
+	return opts
+}
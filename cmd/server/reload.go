@@ -0,0 +1,79 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/config"
+	"github.com/ita-av/booking-service/internal/featureflag"
+	"github.com/ita-av/booking-service/internal/ratelimit"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// configReloader applies the settings that can change while the server runs,
+// the scheduling policy, rate limits and feature flags, when the
+// configuration is reloaded
+type configReloader struct {
+	service *service.BookingService
+	limiter *ratelimit.Limiter
+	flags   *featureflag.Static
+
+	mu      sync.Mutex
+	current *config.Config
+}
+
+// reload loads the configuration again and applies it, logging each change.
+// An invalid configuration is logged and ignored.
+func (r *configReloader) reload(trigger string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := config.LoadConfig()
+	if err == nil {
+		err = next.Validate()
+	}
+	if err != nil {
+		log.Error().Err(err).Str("trigger", trigger).Msg("Failed to reload configuration, keeping the current one")
+		return
+	}
+
+	reloaded, changes := r.current.Reload(next)
+	if err := r.service.SetSchedulingPolicy(schedulingPolicy(reloaded)); err != nil {
+		log.Error().Err(err).Str("trigger", trigger).Msg("Failed to reload configuration, keeping the current one")
+		return
+	}
+	r.limiter.SetLimits(rateLimits(reloaded))
+	// Validate made sure the flags parse
+	_ = r.flags.Update(reloaded.FeatureFlags)
+	r.current = reloaded
+
+	for _, change := range changes {
+		if change.Reloadable {
+			log.Info().Str("setting", change.Setting).Interface("from", change.Old).Interface("to", change.New).Msg("Configuration changed")
+		} else {
+			// Other settings may be secrets, so their values aren't logged
+			log.Warn().Str("setting", change.Setting).Msg("Configuration changed, restart the service to apply it")
+		}
+	}
+	log.Info().Str("trigger", trigger).Int("changes", len(changes)).Msg("Reloaded configuration")
+}
+
+// schedulingPolicy returns the shop's scheduling defaults from cfg, which
+// must be valid
+func schedulingPolicy(cfg *config.Config) service.SchedulingPolicy {
+	start, end, _ := cfg.DefaultWorkingHours()
+	return service.SchedulingPolicy{
+		MinLeadTime:            cfg.MinBookingLeadTime,
+		MaxAdvanceDays:         cfg.MaxBookingAdvanceDays,
+		DefaultSlotMinutes:     cfg.DefaultSlotMinutes,
+		DefaultWorkStartMinute: start,
+		DefaultWorkEndMinute:   end,
+	}
+}
+
+// rateLimits returns the rate limits from cfg, which must be valid
+func rateLimits(cfg *config.Config) (ratelimit.Limit, map[string]ratelimit.Limit) {
+	methods, _ := ratelimit.ParseMethodLimits(cfg.RateLimitMethods)
+	return ratelimit.Limit{Rate: cfg.RateLimit, Burst: cfg.RateLimitBurst}, methods
+}
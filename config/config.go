@@ -1,31 +1,419 @@
 package config
 
 import (
+	"os"
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 // Config holds all application settings
 type Config struct {
-	ServerPort string `mapstructure:"SERVER_PORT"`
-	MongoURI   string `mapstructure:"MONGO_URI"`
-	MongoDB    string `mapstructure:"MONGO_DB"`
-	LogLevel   string `mapstructure:"LOG_LEVEL"`
+	ServerPort   string        `mapstructure:"SERVER_PORT"`
+	MongoURI     string        `mapstructure:"MONGO_URI"`
+	MongoDB      string        `mapstructure:"MONGO_DB"`
+	Storage      string        `mapstructure:"STORAGE"`
+	SQLitePath   string        `mapstructure:"SQLITE_PATH"`
+	LogLevel     string        `mapstructure:"LOG_LEVEL"`
+	DedupeWindow time.Duration `mapstructure:"DEDUPE_WINDOW"`
+
+	// MongoDB connection pool and per-operation limits; zero pool sizes and
+	// server selection timeout leave the URI's or driver's defaults
+	MongoMaxPoolSize            int           `mapstructure:"MONGO_MAX_POOL_SIZE"`
+	MongoMinPoolSize            int           `mapstructure:"MONGO_MIN_POOL_SIZE"`
+	MongoServerSelectionTimeout time.Duration `mapstructure:"MONGO_SERVER_SELECTION_TIMEOUT"`
+	MongoReadPreference         string        `mapstructure:"MONGO_READ_PREFERENCE"`
+	MongoReadTimeout            time.Duration `mapstructure:"MONGO_READ_TIMEOUT"`
+	MongoWriteTimeout           time.Duration `mapstructure:"MONGO_WRITE_TIMEOUT"`
+
+	// Retries of MongoDB operations failing transiently, and the circuit
+	// breaker failing them fast while MongoDB is down
+	MongoRetryAttempts    int           `mapstructure:"MONGO_RETRY_ATTEMPTS"`
+	MongoRetryBackoff     time.Duration `mapstructure:"MONGO_RETRY_BACKOFF"`
+	MongoRetryMaxBackoff  time.Duration `mapstructure:"MONGO_RETRY_MAX_BACKOFF"`
+	MongoBreakerThreshold int           `mapstructure:"MONGO_BREAKER_THRESHOLD"`
+	MongoBreakerCooldown  time.Duration `mapstructure:"MONGO_BREAKER_COOLDOWN"`
+
+	HTTPPort         string `mapstructure:"HTTP_PORT"`
+	MetricsPort      string `mapstructure:"METRICS_PORT"`
+	POSWebhookSecret string `mapstructure:"POS_WEBHOOK_SECRET"`
+
+	ShutdownTimeout time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"`
+
+	GRPCMaxRecvMsgSize        int           `mapstructure:"GRPC_MAX_RECV_MSG_SIZE"`
+	GRPCMaxConcurrentStreams  int           `mapstructure:"GRPC_MAX_CONCURRENT_STREAMS"`
+	GRPCKeepaliveTime         time.Duration `mapstructure:"GRPC_KEEPALIVE_TIME"`
+	GRPCKeepaliveTimeout      time.Duration `mapstructure:"GRPC_KEEPALIVE_TIMEOUT"`
+	GRPCKeepaliveMinTime      time.Duration `mapstructure:"GRPC_KEEPALIVE_MIN_TIME"`
+	GRPCKeepalivePermitIdle   bool          `mapstructure:"GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM"`
+	GRPCMaxConnectionIdle     time.Duration `mapstructure:"GRPC_MAX_CONNECTION_IDLE"`
+	GRPCMaxConnectionAge      time.Duration `mapstructure:"GRPC_MAX_CONNECTION_AGE"`
+	GRPCMaxConnectionAgeGrace time.Duration `mapstructure:"GRPC_MAX_CONNECTION_AGE_GRACE"`
+
+	Currency         string  `mapstructure:"CURRENCY"`
+	CommissionRate   float64 `mapstructure:"COMMISSION_RATE"`
+	PayrollExportDir string  `mapstructure:"PAYROLL_EXPORT_DIR"`
+	ShopTimezone     string  `mapstructure:"SHOP_TIMEZONE"`
+	ShopName         string  `mapstructure:"SHOP_NAME"`
+	CalendarDomain   string  `mapstructure:"CALENDAR_DOMAIN"`
+
+	MinBookingLeadTime    time.Duration `mapstructure:"MIN_BOOKING_LEAD_TIME"`
+	MaxBookingAdvanceDays int           `mapstructure:"MAX_BOOKING_ADVANCE_DAYS"`
+	DefaultWorkStart      string        `mapstructure:"DEFAULT_WORK_START"`
+	DefaultWorkEnd        string        `mapstructure:"DEFAULT_WORK_END"`
+	DefaultSlotMinutes    int           `mapstructure:"DEFAULT_SLOT_MINUTES"`
+
+	AttachmentDir        string `mapstructure:"ATTACHMENT_DIR"`
+	AttachmentBaseURL    string `mapstructure:"ATTACHMENT_BASE_URL"`
+	AttachmentSigningKey string `mapstructure:"ATTACHMENT_SIGNING_KEY"`
+	AttachmentMaxSize    int64  `mapstructure:"ATTACHMENT_MAX_SIZE"`
+
+	SurveyBaseURL string `mapstructure:"SURVEY_BASE_URL"`
+
+	LateArrivalGracePeriod time.Duration `mapstructure:"LATE_ARRIVAL_GRACE_PERIOD"`
+	LateArrivalRelease     bool          `mapstructure:"LATE_ARRIVAL_RELEASE"`
+	PendingBookingTimeout  time.Duration `mapstructure:"PENDING_BOOKING_TIMEOUT"`
+	DeletedRetention       time.Duration `mapstructure:"DELETED_BOOKING_RETENTION"`
+	AutoCompleteAfter      time.Duration `mapstructure:"AUTO_COMPLETE_AFTER"`
+	AutoCompleteCheckIn    bool          `mapstructure:"AUTO_COMPLETE_REQUIRE_CHECKIN"`
+	ReminderLead           time.Duration `mapstructure:"REMINDER_LEAD"`
+	ReminderInterval       time.Duration `mapstructure:"REMINDER_INTERVAL"`
+	DailyAgendaTime        string        `mapstructure:"DAILY_AGENDA_TIME"`
+
+	AllowEarlyCompletion bool `mapstructure:"ALLOW_EARLY_COMPLETION"`
+
+	StripeSecretKey     string        `mapstructure:"STRIPE_SECRET_KEY"`
+	StripeWebhookSecret string        `mapstructure:"STRIPE_WEBHOOK_SECRET"`
+	DepositRate         float64       `mapstructure:"DEPOSIT_RATE"`
+	DepositTimeout      time.Duration `mapstructure:"DEPOSIT_TIMEOUT"`
+
+	NoShowDepositThreshold int `mapstructure:"DEPOSIT_NO_SHOW_THRESHOLD"`
+
+	Environment  string `mapstructure:"APP_ENV"`
+	JWTSecret    string `mapstructure:"JWT_SECRET"`
+	JWTAlgorithm string `mapstructure:"JWT_ALGORITHM"`
+	JWTIssuer    string `mapstructure:"JWT_ISSUER"`
+	JWTAudience  string `mapstructure:"JWT_AUDIENCE"`
+
+	JWTLeeway        time.Duration `mapstructure:"JWT_LEEWAY"`
+	JWTRequireExpiry bool          `mapstructure:"JWT_REQUIRE_EXPIRY"`
+
+	JWKSURL             string        `mapstructure:"JWKS_URL"`
+	JWKSRefreshInterval time.Duration `mapstructure:"JWKS_REFRESH_INTERVAL"`
+
+	TLSCertFile     string `mapstructure:"TLS_CERT_FILE"`
+	TLSKeyFile      string `mapstructure:"TLS_KEY_FILE"`
+	TLSClientCAFile string `mapstructure:"TLS_CLIENT_CA_FILE"`
+
+	RateLimit        float64 `mapstructure:"RATE_LIMIT"`
+	RateLimitBurst   int     `mapstructure:"RATE_LIMIT_BURST"`
+	RateLimitMethods string  `mapstructure:"RATE_LIMIT_METHODS"`
+
+	GraphQLEnabled bool `mapstructure:"GRAPHQL_ENABLED"`
+
+	WebhookMaxAttempts int           `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+	WebhookTimeout     time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+
+	EventPublisher      string        `mapstructure:"EVENT_PUBLISHER"`
+	EventPublishTimeout time.Duration `mapstructure:"EVENT_PUBLISH_TIMEOUT"`
+	KafkaBrokers        string        `mapstructure:"KAFKA_BROKERS"`
+	KafkaTopic          string        `mapstructure:"KAFKA_TOPIC"`
+	NATSURL             string        `mapstructure:"NATS_URL"`
+	NATSStream          string        `mapstructure:"NATS_STREAM"`
+	NATSSubjectPrefix   string        `mapstructure:"NATS_SUBJECT_PREFIX"`
+
+	ChangeStreamEnabled bool   `mapstructure:"CHANGE_STREAM_ENABLED"`
+	ChangeStreamName    string `mapstructure:"CHANGE_STREAM_NAME"`
+
+	MultiTenant bool `mapstructure:"MULTI_TENANT"`
+
+	FeatureFlags string `mapstructure:"FEATURE_FLAGS"`
+
+	RedisURL     string        `mapstructure:"REDIS_URL"`
+	SlotCacheTTL time.Duration `mapstructure:"SLOT_CACHE_TTL"`
+
+	BookingLock     string        `mapstructure:"BOOKING_LOCK"`
+	BookingLockTTL  time.Duration `mapstructure:"BOOKING_LOCK_TTL"`
+	BookingLockWait time.Duration `mapstructure:"BOOKING_LOCK_WAIT"`
+
+	EmailProvider    string        `mapstructure:"EMAIL_PROVIDER"`
+	EmailFrom        string        `mapstructure:"EMAIL_FROM"`
+	EmailTimeout     time.Duration `mapstructure:"EMAIL_TIMEOUT"`
+	EmailTemplateDir string        `mapstructure:"EMAIL_TEMPLATE_DIR"`
+	SMTPHost         string        `mapstructure:"SMTP_HOST"`
+	SMTPPort         int           `mapstructure:"SMTP_PORT"`
+	SMTPUsername     string        `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword     string        `mapstructure:"SMTP_PASSWORD"`
+	SendGridAPIKey   string        `mapstructure:"SENDGRID_API_KEY"`
+	UserServiceURL   string        `mapstructure:"USER_SERVICE_URL"`
+	UserServiceToken string        `mapstructure:"USER_SERVICE_TOKEN"`
 }
 
-// LoadConfig loads configuration from environment variables
+// IsProduction reports whether the service runs in production, where
+// insecure development defaults aren't allowed
+func (c *Config) IsProduction() bool {
+	return c.Environment == "production"
+}
+
+// IsDevelopment reports whether the service runs on a developer's machine,
+// where the development JWT key may be used
+func (c *Config) IsDevelopment() bool {
+	return c.Environment == "development"
+}
+
+// LoadConfig loads configuration from environment variables, which override
+// the config file at CONFIG_FILE if one is given
 func LoadConfig() (*Config, error) {
-	viper.SetDefault("SERVER_PORT", "50051")
-	viper.SetDefault("MONGO_URI", "mongodb://localhost:27017")
-	viper.SetDefault("MONGO_DB", "barbershop_bookings")
-	viper.SetDefault("LOG_LEVEL", "info")
+	// A fresh instance each time, so a reload doesn't see settings since
+	// removed from the config file
+	v := viper.New()
+	v.SetDefault("SERVER_PORT", "50051")
+	v.SetDefault("MONGO_URI", "mongodb://localhost:27017")
+	v.SetDefault("MONGO_DB", "barbershop_bookings")
+	v.SetDefault("MONGO_READ_TIMEOUT", "10s")
+	v.SetDefault("MONGO_WRITE_TIMEOUT", "10s")
+	v.SetDefault("MONGO_RETRY_ATTEMPTS", 3)
+	v.SetDefault("MONGO_RETRY_BACKOFF", "100ms")
+	v.SetDefault("MONGO_RETRY_MAX_BACKOFF", "1s")
+	v.SetDefault("MONGO_BREAKER_THRESHOLD", 5)
+	v.SetDefault("MONGO_BREAKER_COOLDOWN", "10s")
+	v.SetDefault("STORAGE", "mongodb")
+	v.SetDefault("SQLITE_PATH", "bookings.db")
+	v.SetDefault("LOG_LEVEL", "info")
+	v.SetDefault("SHUTDOWN_TIMEOUT", "25s")
+	// gRPC's own defaults; zero connection limits leave connections open
+	v.SetDefault("GRPC_MAX_RECV_MSG_SIZE", 4<<20)
+	v.SetDefault("GRPC_MAX_CONCURRENT_STREAMS", 0)
+	v.SetDefault("GRPC_KEEPALIVE_TIME", "2h")
+	v.SetDefault("GRPC_KEEPALIVE_TIMEOUT", "20s")
+	v.SetDefault("GRPC_KEEPALIVE_MIN_TIME", "5m")
+	v.SetDefault("GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM", false)
+	v.SetDefault("GRPC_MAX_CONNECTION_IDLE", "0s")
+	v.SetDefault("GRPC_MAX_CONNECTION_AGE", "0s")
+	v.SetDefault("GRPC_MAX_CONNECTION_AGE_GRACE", "0s")
+	v.SetDefault("DEDUPE_WINDOW", "10m")
+	v.SetDefault("HTTP_PORT", "8080")
+	v.SetDefault("METRICS_PORT", "9090")
+	v.SetDefault("POS_WEBHOOK_SECRET", "")
+	v.SetDefault("CURRENCY", "USD")
+	v.SetDefault("COMMISSION_RATE", 0.4)
+	v.SetDefault("PAYROLL_EXPORT_DIR", "")
+	v.SetDefault("SHOP_TIMEZONE", "UTC")
+	v.SetDefault("SHOP_NAME", "Barbershop")
+	v.SetDefault("CALENDAR_DOMAIN", "booking-service")
+	v.SetDefault("MIN_BOOKING_LEAD_TIME", "0")
+	v.SetDefault("MAX_BOOKING_ADVANCE_DAYS", 0)
+	v.SetDefault("DEFAULT_WORK_START", "09:00")
+	v.SetDefault("DEFAULT_WORK_END", "17:00")
+	v.SetDefault("DEFAULT_SLOT_MINUTES", 30)
+	v.SetDefault("ATTACHMENT_DIR", "")
+	v.SetDefault("ATTACHMENT_BASE_URL", "http://localhost:8080/attachments")
+	v.SetDefault("ATTACHMENT_SIGNING_KEY", "")
+	v.SetDefault("ATTACHMENT_MAX_SIZE", 5<<20)
+	v.SetDefault("SURVEY_BASE_URL", "")
+	v.SetDefault("LATE_ARRIVAL_GRACE_PERIOD", "15m")
+	v.SetDefault("LATE_ARRIVAL_RELEASE", false)
+	v.SetDefault("PENDING_BOOKING_TIMEOUT", "0")
+	v.SetDefault("DELETED_BOOKING_RETENTION", "720h")
+	v.SetDefault("AUTO_COMPLETE_AFTER", "0")
+	v.SetDefault("AUTO_COMPLETE_REQUIRE_CHECKIN", false)
+	v.SetDefault("REMINDER_LEAD", "0")
+	v.SetDefault("REMINDER_INTERVAL", "1m")
+	v.SetDefault("DAILY_AGENDA_TIME", "")
+	v.SetDefault("ALLOW_EARLY_COMPLETION", false)
+	v.SetDefault("STRIPE_SECRET_KEY", "")
+	v.SetDefault("STRIPE_WEBHOOK_SECRET", "")
+	v.SetDefault("DEPOSIT_RATE", 1.0)
+	v.SetDefault("DEPOSIT_TIMEOUT", "15m")
+	v.SetDefault("DEPOSIT_NO_SHOW_THRESHOLD", 0)
+	v.SetDefault("APP_ENV", "development")
+	v.SetDefault("JWT_SECRET", "")
+	v.SetDefault("JWT_ALGORITHM", "HS256")
+	v.SetDefault("JWT_ISSUER", "")
+	v.SetDefault("JWT_AUDIENCE", "")
+	v.SetDefault("JWT_LEEWAY", "30s")
+	v.SetDefault("JWT_REQUIRE_EXPIRY", true)
+	v.SetDefault("JWKS_URL", "")
+	v.SetDefault("JWKS_REFRESH_INTERVAL", "1h")
+	v.SetDefault("TLS_CERT_FILE", "")
+	v.SetDefault("TLS_KEY_FILE", "")
+	v.SetDefault("TLS_CLIENT_CA_FILE", "")
+	v.SetDefault("RATE_LIMIT", 10)
+	v.SetDefault("RATE_LIMIT_BURST", 20)
+	v.SetDefault("RATE_LIMIT_METHODS", "")
+	v.SetDefault("GRAPHQL_ENABLED", false)
+	v.SetDefault("WEBHOOK_MAX_ATTEMPTS", 5)
+	v.SetDefault("WEBHOOK_TIMEOUT", "10s")
+	v.SetDefault("EVENT_PUBLISHER", "")
+	v.SetDefault("EVENT_PUBLISH_TIMEOUT", "5s")
+	v.SetDefault("KAFKA_BROKERS", "")
+	v.SetDefault("KAFKA_TOPIC", "booking-events")
+	v.SetDefault("NATS_URL", "nats://localhost:4222")
+	v.SetDefault("NATS_STREAM", "BOOKINGS")
+	v.SetDefault("NATS_SUBJECT_PREFIX", "bookings")
+	v.SetDefault("CHANGE_STREAM_ENABLED", false)
+	v.SetDefault("CHANGE_STREAM_NAME", "")
+	v.SetDefault("FEATURE_FLAGS", "")
+	v.SetDefault("REDIS_URL", "")
+	v.SetDefault("SLOT_CACHE_TTL", "30s")
+	v.SetDefault("BOOKING_LOCK", "")
+	v.SetDefault("BOOKING_LOCK_TTL", "10s")
+	v.SetDefault("BOOKING_LOCK_WAIT", "3s")
+	v.SetDefault("EMAIL_PROVIDER", "")
+	v.SetDefault("EMAIL_FROM", "")
+	v.SetDefault("EMAIL_TIMEOUT", "10s")
+	v.SetDefault("EMAIL_TEMPLATE_DIR", "")
+	v.SetDefault("SMTP_HOST", "")
+	v.SetDefault("SMTP_PORT", 587)
+	v.SetDefault("SMTP_USERNAME", "")
+	v.SetDefault("SMTP_PASSWORD", "")
+	v.SetDefault("SENDGRID_API_KEY", "")
+	v.SetDefault("USER_SERVICE_URL", "")
+	v.SetDefault("USER_SERVICE_TOKEN", "")
+
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		if err := readFile(v, path); err != nil {
+			return nil, err
+		}
+	}
 
-	viper.AutomaticEnv()
+	v.AutomaticEnv()
 
 	config := &Config{
-		ServerPort: viper.GetString("SERVER_PORT"),
-		MongoURI:   viper.GetString("MONGO_URI"),
-		MongoDB:    viper.GetString("MONGO_DB"),
-		LogLevel:   viper.GetString("LOG_LEVEL"),
+		ServerPort:   v.GetString("SERVER_PORT"),
+		MongoURI:     v.GetString("MONGO_URI"),
+		MongoDB:      v.GetString("MONGO_DB"),
+		Storage:      v.GetString("STORAGE"),
+		SQLitePath:   v.GetString("SQLITE_PATH"),
+		LogLevel:     v.GetString("LOG_LEVEL"),
+		DedupeWindow: v.GetDuration("DEDUPE_WINDOW"),
+
+		MongoMaxPoolSize:            v.GetInt("MONGO_MAX_POOL_SIZE"),
+		MongoMinPoolSize:            v.GetInt("MONGO_MIN_POOL_SIZE"),
+		MongoServerSelectionTimeout: v.GetDuration("MONGO_SERVER_SELECTION_TIMEOUT"),
+		MongoReadPreference:         v.GetString("MONGO_READ_PREFERENCE"),
+		MongoReadTimeout:            v.GetDuration("MONGO_READ_TIMEOUT"),
+		MongoWriteTimeout:           v.GetDuration("MONGO_WRITE_TIMEOUT"),
+
+		MongoRetryAttempts:    v.GetInt("MONGO_RETRY_ATTEMPTS"),
+		MongoRetryBackoff:     v.GetDuration("MONGO_RETRY_BACKOFF"),
+		MongoRetryMaxBackoff:  v.GetDuration("MONGO_RETRY_MAX_BACKOFF"),
+		MongoBreakerThreshold: v.GetInt("MONGO_BREAKER_THRESHOLD"),
+		MongoBreakerCooldown:  v.GetDuration("MONGO_BREAKER_COOLDOWN"),
+
+		HTTPPort:         v.GetString("HTTP_PORT"),
+		MetricsPort:      v.GetString("METRICS_PORT"),
+		POSWebhookSecret: v.GetString("POS_WEBHOOK_SECRET"),
+
+		ShutdownTimeout: v.GetDuration("SHUTDOWN_TIMEOUT"),
+
+		GRPCMaxRecvMsgSize:        v.GetInt("GRPC_MAX_RECV_MSG_SIZE"),
+		GRPCMaxConcurrentStreams:  v.GetInt("GRPC_MAX_CONCURRENT_STREAMS"),
+		GRPCKeepaliveTime:         v.GetDuration("GRPC_KEEPALIVE_TIME"),
+		GRPCKeepaliveTimeout:      v.GetDuration("GRPC_KEEPALIVE_TIMEOUT"),
+		GRPCKeepaliveMinTime:      v.GetDuration("GRPC_KEEPALIVE_MIN_TIME"),
+		GRPCKeepalivePermitIdle:   v.GetBool("GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM"),
+		GRPCMaxConnectionIdle:     v.GetDuration("GRPC_MAX_CONNECTION_IDLE"),
+		GRPCMaxConnectionAge:      v.GetDuration("GRPC_MAX_CONNECTION_AGE"),
+		GRPCMaxConnectionAgeGrace: v.GetDuration("GRPC_MAX_CONNECTION_AGE_GRACE"),
+
+		Currency:         v.GetString("CURRENCY"),
+		CommissionRate:   v.GetFloat64("COMMISSION_RATE"),
+		PayrollExportDir: v.GetString("PAYROLL_EXPORT_DIR"),
+		ShopTimezone:     v.GetString("SHOP_TIMEZONE"),
+		ShopName:         v.GetString("SHOP_NAME"),
+		CalendarDomain:   v.GetString("CALENDAR_DOMAIN"),
+
+		MinBookingLeadTime:    v.GetDuration("MIN_BOOKING_LEAD_TIME"),
+		MaxBookingAdvanceDays: v.GetInt("MAX_BOOKING_ADVANCE_DAYS"),
+		DefaultWorkStart:      v.GetString("DEFAULT_WORK_START"),
+		DefaultWorkEnd:        v.GetString("DEFAULT_WORK_END"),
+		DefaultSlotMinutes:    v.GetInt("DEFAULT_SLOT_MINUTES"),
+
+		AttachmentDir:        v.GetString("ATTACHMENT_DIR"),
+		AttachmentBaseURL:    v.GetString("ATTACHMENT_BASE_URL"),
+		AttachmentSigningKey: v.GetString("ATTACHMENT_SIGNING_KEY"),
+		AttachmentMaxSize:    v.GetInt64("ATTACHMENT_MAX_SIZE"),
+
+		SurveyBaseURL: v.GetString("SURVEY_BASE_URL"),
+
+		LateArrivalGracePeriod: v.GetDuration("LATE_ARRIVAL_GRACE_PERIOD"),
+		LateArrivalRelease:     v.GetBool("LATE_ARRIVAL_RELEASE"),
+		PendingBookingTimeout:  v.GetDuration("PENDING_BOOKING_TIMEOUT"),
+		DeletedRetention:       v.GetDuration("DELETED_BOOKING_RETENTION"),
+		AutoCompleteAfter:      v.GetDuration("AUTO_COMPLETE_AFTER"),
+		AutoCompleteCheckIn:    v.GetBool("AUTO_COMPLETE_REQUIRE_CHECKIN"),
+		ReminderLead:           v.GetDuration("REMINDER_LEAD"),
+		ReminderInterval:       v.GetDuration("REMINDER_INTERVAL"),
+		DailyAgendaTime:        v.GetString("DAILY_AGENDA_TIME"),
+
+		AllowEarlyCompletion: v.GetBool("ALLOW_EARLY_COMPLETION"),
+
+		StripeSecretKey:     v.GetString("STRIPE_SECRET_KEY"),
+		StripeWebhookSecret: v.GetString("STRIPE_WEBHOOK_SECRET"),
+		DepositRate:         v.GetFloat64("DEPOSIT_RATE"),
+		DepositTimeout:      v.GetDuration("DEPOSIT_TIMEOUT"),
+
+		NoShowDepositThreshold: v.GetInt("DEPOSIT_NO_SHOW_THRESHOLD"),
+
+		Environment:  v.GetString("APP_ENV"),
+		JWTSecret:    v.GetString("JWT_SECRET"),
+		JWTAlgorithm: v.GetString("JWT_ALGORITHM"),
+		JWTIssuer:    v.GetString("JWT_ISSUER"),
+		JWTAudience:  v.GetString("JWT_AUDIENCE"),
+
+		JWTLeeway:        v.GetDuration("JWT_LEEWAY"),
+		JWTRequireExpiry: v.GetBool("JWT_REQUIRE_EXPIRY"),
+
+		JWKSURL:             v.GetString("JWKS_URL"),
+		JWKSRefreshInterval: v.GetDuration("JWKS_REFRESH_INTERVAL"),
+
+		TLSCertFile:     v.GetString("TLS_CERT_FILE"),
+		TLSKeyFile:      v.GetString("TLS_KEY_FILE"),
+		TLSClientCAFile: v.GetString("TLS_CLIENT_CA_FILE"),
+
+		RateLimit:        v.GetFloat64("RATE_LIMIT"),
+		RateLimitBurst:   v.GetInt("RATE_LIMIT_BURST"),
+		RateLimitMethods: v.GetString("RATE_LIMIT_METHODS"),
+
+		GraphQLEnabled: v.GetBool("GRAPHQL_ENABLED"),
+
+		WebhookMaxAttempts: v.GetInt("WEBHOOK_MAX_ATTEMPTS"),
+		WebhookTimeout:     v.GetDuration("WEBHOOK_TIMEOUT"),
+
+		EventPublisher:      v.GetString("EVENT_PUBLISHER"),
+		EventPublishTimeout: v.GetDuration("EVENT_PUBLISH_TIMEOUT"),
+		KafkaBrokers:        v.GetString("KAFKA_BROKERS"),
+		KafkaTopic:          v.GetString("KAFKA_TOPIC"),
+		NATSURL:             v.GetString("NATS_URL"),
+		NATSStream:          v.GetString("NATS_STREAM"),
+		NATSSubjectPrefix:   v.GetString("NATS_SUBJECT_PREFIX"),
+
+		ChangeStreamEnabled: v.GetBool("CHANGE_STREAM_ENABLED"),
+		ChangeStreamName:    v.GetString("CHANGE_STREAM_NAME"),
+
+		MultiTenant: v.GetBool("MULTI_TENANT"),
+
+		FeatureFlags: v.GetString("FEATURE_FLAGS"),
+
+		RedisURL:     v.GetString("REDIS_URL"),
+		SlotCacheTTL: v.GetDuration("SLOT_CACHE_TTL"),
+
+		BookingLock:     v.GetString("BOOKING_LOCK"),
+		BookingLockTTL:  v.GetDuration("BOOKING_LOCK_TTL"),
+		BookingLockWait: v.GetDuration("BOOKING_LOCK_WAIT"),
+
+		EmailProvider:    v.GetString("EMAIL_PROVIDER"),
+		EmailFrom:        v.GetString("EMAIL_FROM"),
+		EmailTimeout:     v.GetDuration("EMAIL_TIMEOUT"),
+		EmailTemplateDir: v.GetString("EMAIL_TEMPLATE_DIR"),
+		SMTPHost:         v.GetString("SMTP_HOST"),
+		SMTPPort:         v.GetInt("SMTP_PORT"),
+		SMTPUsername:     v.GetString("SMTP_USERNAME"),
+		SMTPPassword:     v.GetString("SMTP_PASSWORD"),
+		SendGridAPIKey:   v.GetString("SENDGRID_API_KEY"),
+		UserServiceURL:   v.GetString("USER_SERVICE_URL"),
+		UserServiceToken: v.GetString("USER_SERVICE_TOKEN"),
 	}
 
 	return config, nil
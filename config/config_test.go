@@ -0,0 +1,377 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeConfigFile writes a config file and points CONFIG_FILE at it
+func writeConfigFile(t *testing.T, name, content string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	t.Setenv("CONFIG_FILE", path)
+}
+
+func TestLoadConfig_Defaults(t *testing.T) {
+	cfg, err := LoadConfig()
+
+	require.NoError(t, err)
+	assert.Equal(t, "50051", cfg.ServerPort)
+	assert.Equal(t, "mongodb", cfg.Storage)
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestLoadConfig_File(t *testing.T) {
+	writeConfigFile(t, "config.yaml", `
+server:
+  port: "6000"
+  rate_limit:
+    rate: 2.5
+mongo:
+  uri: mongodb://db:27017
+auth:
+  jwt_leeway: 1m
+scheduling:
+  max_booking_advance_days: 30
+  booking_lock:
+    backend: mongodb
+notifications:
+  email:
+    provider: smtp
+  smtp:
+    port: 2525
+events:
+  kafka:
+    brokers: [kafka-1:9092, kafka-2:9092]
+`)
+
+	cfg, err := LoadConfig()
+
+	require.NoError(t, err)
+	assert.Equal(t, "6000", cfg.ServerPort)
+	assert.Equal(t, 2.5, cfg.RateLimit)
+	assert.Equal(t, "mongodb://db:27017", cfg.MongoURI)
+	assert.Equal(t, time.Minute, cfg.JWTLeeway)
+	assert.Equal(t, 30, cfg.MaxBookingAdvanceDays)
+	assert.Equal(t, "mongodb", cfg.BookingLock)
+	assert.Equal(t, "smtp", cfg.EmailProvider)
+	assert.Equal(t, 2525, cfg.SMTPPort)
+	assert.Equal(t, "kafka-1:9092,kafka-2:9092", cfg.KafkaBrokers)
+	// Settings the file leaves out keep their defaults
+	assert.Equal(t, "barbershop_bookings", cfg.MongoDB)
+	assert.Equal(t, 20, cfg.RateLimitBurst)
+}
+
+func TestLoadConfig_ExampleFile(t *testing.T) {
+	t.Setenv("CONFIG_FILE", "../config.example.yaml")
+	cfg, err := LoadConfig()
+
+	require.NoError(t, err)
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, "mongodb://localhost:27017", cfg.MongoURI)
+	assert.Equal(t, int64(5<<20), cfg.AttachmentMaxSize)
+}
+
+func TestLoadConfig_EnvOverridesFile(t *testing.T) {
+	writeConfigFile(t, "config.toml", `
+[server]
+port = "6000"
+log_level = "debug"
+`)
+	t.Setenv("SERVER_PORT", "7000")
+
+	cfg, err := LoadConfig()
+
+	require.NoError(t, err)
+	assert.Equal(t, "7000", cfg.ServerPort)
+	assert.Equal(t, "debug", cfg.LogLevel)
+}
+
+func TestLoadConfig_UnknownSettings(t *testing.T) {
+	writeConfigFile(t, "config.yaml", `
+server:
+  prot: "6000"
+mongo:
+  url: mongodb://db:27017
+`)
+
+	_, err := LoadConfig()
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mongo.url, server.prot")
+}
+
+func TestLoadConfig_MissingFile(t *testing.T) {
+	t.Setenv("CONFIG_FILE", filepath.Join(t.TempDir(), "missing.yaml"))
+	_, err := LoadConfig()
+
+	assert.Error(t, err)
+}
+
+func TestFileKeysAreUnique(t *testing.T) {
+	envs := make(map[string]string)
+	for key, env := range fileKeys {
+		if other, ok := envs[env]; ok {
+			t.Errorf("%s is set by both %s and %s", env, key, other)
+		}
+		envs[env] = key
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := func() *Config {
+		return &Config{
+			ServerPort:   "50051",
+			HTTPPort:     "8080",
+			Storage:      "mongodb",
+			MongoURI:     "mongodb://localhost:27017",
+			MongoDB:      "bookings",
+			LogLevel:     "info",
+			ShopTimezone: "UTC",
+			Environment:  "development",
+
+			ShutdownTimeout: 25 * time.Second,
+
+			GRPCMaxRecvMsgSize:   4 << 20,
+			GRPCKeepaliveTime:    2 * time.Hour,
+			GRPCKeepaliveTimeout: 20 * time.Second,
+			GRPCKeepaliveMinTime: 5 * time.Minute,
+
+			MongoRetryAttempts:    3,
+			MongoRetryBackoff:     100 * time.Millisecond,
+			MongoRetryMaxBackoff:  time.Second,
+			MongoBreakerThreshold: 5,
+			MongoBreakerCooldown:  10 * time.Second,
+
+			DefaultWorkStart:   "09:00",
+			DefaultWorkEnd:     "17:00",
+			DefaultSlotMinutes: 30,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		change  func(c *Config)
+		wantErr []string
+	}{
+		{
+			name:   "valid",
+			change: func(c *Config) {},
+		},
+		{
+			name:    "no time to shut down",
+			change:  func(c *Config) { c.ShutdownTimeout = 0 },
+			wantErr: []string{"SHUTDOWN_TIMEOUT must be positive"},
+		},
+		{
+			name: "gRPC connection limits",
+			change: func(c *Config) {
+				c.GRPCMaxRecvMsgSize = 0
+				c.GRPCMaxConcurrentStreams = -1
+				c.GRPCMaxConnectionAgeGrace = time.Minute
+			},
+			wantErr: []string{
+				"GRPC_MAX_RECV_MSG_SIZE must be positive",
+				"GRPC_MAX_CONCURRENT_STREAMS must be 0, for no limit, or a positive number",
+				"GRPC_MAX_CONNECTION_AGE_GRACE needs GRPC_MAX_CONNECTION_AGE",
+			},
+		},
+		{
+			name:    "unknown storage",
+			change:  func(c *Config) { c.Storage = "postgres" },
+			wantErr: []string{`STORAGE must be mongodb or sqlite, not "postgres"`},
+		},
+		{
+			name: "sqlite with MongoDB features",
+			change: func(c *Config) {
+				c.Storage = "sqlite"
+				c.MultiTenant = true
+				c.BookingLock = "mongodb"
+				c.BookingLockTTL = time.Second
+				c.BookingLockWait = time.Second
+			},
+			wantErr: []string{"MULTI_TENANT needs STORAGE=mongodb", "BOOKING_LOCK needs STORAGE=mongodb"},
+		},
+		{
+			name: "sqlite without MongoDB settings",
+			change: func(c *Config) {
+				c.Storage = "sqlite"
+				c.MongoURI = ""
+			},
+		},
+		{
+			name:    "port not a number",
+			change:  func(c *Config) { c.ServerPort = ":50051" },
+			wantErr: []string{`SERVER_PORT must be a port number, not ":50051"`},
+		},
+		{
+			name:    "port out of range",
+			change:  func(c *Config) { c.MetricsPort = "70000" },
+			wantErr: []string{`METRICS_PORT must be a port number or empty, not "70000"`},
+		},
+		{
+			name:    "unparseable Mongo URI",
+			change:  func(c *Config) { c.MongoURI = "localhost:27017" },
+			wantErr: []string{"invalid MONGO_URI"},
+		},
+		{
+			name: "Mongo pool and timeouts",
+			change: func(c *Config) {
+				c.MongoMaxPoolSize = 10
+				c.MongoMinPoolSize = 20
+				c.MongoReadTimeout = -time.Second
+				c.MongoReadPreference = "closest"
+			},
+			wantErr: []string{
+				"MONGO_MIN_POOL_SIZE must not be more than MONGO_MAX_POOL_SIZE",
+				"MONGO_READ_TIMEOUT and MONGO_WRITE_TIMEOUT must not be negative",
+				`MONGO_READ_PREFERENCE must be primary, primaryPreferred, secondary, secondaryPreferred or nearest, not "closest"`,
+			},
+		},
+		{
+			name: "Mongo retries and breaker",
+			change: func(c *Config) {
+				c.MongoRetryAttempts = 0
+				c.MongoRetryMaxBackoff = time.Millisecond
+				c.MongoBreakerCooldown = 0
+			},
+			wantErr: []string{
+				"MONGO_RETRY_ATTEMPTS must be at least 1, for no retries",
+				"MONGO_RETRY_BACKOFF must be positive and no more than MONGO_RETRY_MAX_BACKOFF",
+				"MONGO_BREAKER_COOLDOWN must be positive",
+			},
+		},
+		{
+			name: "Mongo without retries or breaker",
+			change: func(c *Config) {
+				c.MongoRetryAttempts = 1
+				c.MongoBreakerThreshold = 0
+				c.MongoBreakerCooldown = 0
+			},
+		},
+		{
+			name: "booking locks reading from secondaries",
+			change: func(c *Config) {
+				c.MongoURI = "mongodb://localhost:27017/?readPreference=secondaryPreferred"
+				c.BookingLock = "mongodb"
+				c.BookingLockTTL = time.Second
+				c.BookingLockWait = time.Second
+			},
+			wantErr: []string{"BOOKING_LOCK needs MONGO_READ_PREFERENCE=primary, not secondaryPreferred"},
+		},
+		{
+			name: "secondary reads with transactions",
+			change: func(c *Config) {
+				c.MongoReadPreference = "secondaryPreferred"
+			},
+		},
+		{
+			name:    "staging without keys",
+			change:  func(c *Config) { c.Environment = "staging" },
+			wantErr: []string{"JWT_SECRET or JWKS_URL is required outside development (APP_ENV=staging)"},
+		},
+		{
+			name: "production with JWKS",
+			change: func(c *Config) {
+				c.Environment = "production"
+				c.JWKSURL = "https://users.example.com/.well-known/jwks.json"
+			},
+		},
+		{
+			name: "default hours ending before they start",
+			change: func(c *Config) {
+				c.DefaultWorkStart = "18:00"
+				c.DefaultSlotMinutes = 45
+			},
+			wantErr: []string{"DEFAULT_WORK_START must be before DEFAULT_WORK_END", "DEFAULT_SLOT_MINUTES must be one of [15 20 30 60], not 45"},
+		},
+		{
+			name:    "default hours not a time",
+			change:  func(c *Config) { c.DefaultWorkEnd = "5pm" },
+			wantErr: []string{`invalid DEFAULT_WORK_END: "5pm" is not a time of day in HH:MM format`},
+		},
+		{
+			name:    "daily agenda at midnight's end",
+			change:  func(c *Config) { c.DailyAgendaTime = "24:00" },
+			wantErr: []string{`invalid DAILY_AGENDA_TIME: "24:00" is not a time of day before midnight`},
+		},
+		{
+			name:    "invalid method limits",
+			change:  func(c *Config) { c.RateLimitMethods = "CreateBooking" },
+			wantErr: []string{"invalid RATE_LIMIT_METHODS"},
+		},
+		{
+			name:    "invalid feature flags",
+			change:  func(c *Config) { c.FeatureFlags = "deposits=Shop A" },
+			wantErr: []string{"invalid FEATURE_FLAGS"},
+		},
+		{
+			name:    "client CA without a certificate",
+			change:  func(c *Config) { c.TLSClientCAFile = "ca.pem" },
+			wantErr: []string{"TLS_CERT_FILE and TLS_KEY_FILE are required to enable TLS"},
+		},
+		{
+			name:    "unknown time zone",
+			change:  func(c *Config) { c.ShopTimezone = "Mars/Olympus" },
+			wantErr: []string{`invalid SHOP_TIMEZONE "Mars/Olympus"`},
+		},
+		{
+			name: "deposits without a webhook secret",
+			change: func(c *Config) {
+				c.StripeSecretKey = "sk_test"
+				c.DepositRate = 1.5
+			},
+			wantErr: []string{"STRIPE_WEBHOOK_SECRET is required", "DEPOSIT_RATE must be between 0 and 1"},
+		},
+		{
+			name:    "kafka without brokers",
+			change:  func(c *Config) { c.EventPublisher = "kafka" },
+			wantErr: []string{"KAFKA_BROKERS is required when EVENT_PUBLISHER is kafka"},
+		},
+		{
+			name: "redis locks without redis",
+			change: func(c *Config) {
+				c.BookingLock = "redis"
+				c.BookingLockTTL = time.Second
+			},
+			wantErr: []string{"BOOKING_LOCK_TTL and BOOKING_LOCK_WAIT must be positive", "BOOKING_LOCK=redis needs REDIS_URL"},
+		},
+		{
+			name: "several problems",
+			change: func(c *Config) {
+				c.LogLevel = "verbose"
+				c.EmailProvider = "sendgrid"
+				c.AttachmentDir = "/attachments"
+			},
+			wantErr: []string{
+				`LOG_LEVEL must be debug, info, warn or error, not "verbose"`,
+				"SENDGRID_API_KEY is required when EMAIL_PROVIDER is sendgrid",
+				"ATTACHMENT_SIGNING_KEY is required when ATTACHMENT_DIR is set",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid()
+			tt.change(cfg)
+
+			err := cfg.Validate()
+
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// fileKeys maps the settings of a config file, by section, to the
+// environment variables overriding them
+var fileKeys = map[string]string{
+	"server.port":               "SERVER_PORT",
+	"server.http_port":          "HTTP_PORT",
+	"server.metrics_port":       "METRICS_PORT",
+	"server.environment":        "APP_ENV",
+	"server.log_level":          "LOG_LEVEL",
+	"server.graphql_enabled":    "GRAPHQL_ENABLED",
+	"server.multi_tenant":       "MULTI_TENANT",
+	"server.shutdown_timeout":   "SHUTDOWN_TIMEOUT",
+	"server.tls.cert_file":      "TLS_CERT_FILE",
+	"server.tls.key_file":       "TLS_KEY_FILE",
+	"server.tls.client_ca_file": "TLS_CLIENT_CA_FILE",
+	"server.rate_limit.rate":    "RATE_LIMIT",
+	"server.rate_limit.burst":   "RATE_LIMIT_BURST",
+	"server.rate_limit.methods": "RATE_LIMIT_METHODS",
+
+	"server.grpc.max_recv_msg_size":               "GRPC_MAX_RECV_MSG_SIZE",
+	"server.grpc.max_concurrent_streams":          "GRPC_MAX_CONCURRENT_STREAMS",
+	"server.grpc.keepalive.time":                  "GRPC_KEEPALIVE_TIME",
+	"server.grpc.keepalive.timeout":               "GRPC_KEEPALIVE_TIMEOUT",
+	"server.grpc.keepalive.min_time":              "GRPC_KEEPALIVE_MIN_TIME",
+	"server.grpc.keepalive.permit_without_stream": "GRPC_KEEPALIVE_PERMIT_WITHOUT_STREAM",
+	"server.grpc.max_connection_idle":             "GRPC_MAX_CONNECTION_IDLE",
+	"server.grpc.max_connection_age":              "GRPC_MAX_CONNECTION_AGE",
+	"server.grpc.max_connection_age_grace":        "GRPC_MAX_CONNECTION_AGE_GRACE",
+
+	"storage.backend":     "STORAGE",
+	"storage.sqlite_path": "SQLITE_PATH",
+
+	"mongo.uri":      "MONGO_URI",
+	"mongo.database": "MONGO_DB",
+
+	"mongo.max_pool_size":            "MONGO_MAX_POOL_SIZE",
+	"mongo.min_pool_size":            "MONGO_MIN_POOL_SIZE",
+	"mongo.server_selection_timeout": "MONGO_SERVER_SELECTION_TIMEOUT",
+	"mongo.read_preference":          "MONGO_READ_PREFERENCE",
+	"mongo.read_timeout":             "MONGO_READ_TIMEOUT",
+	"mongo.write_timeout":            "MONGO_WRITE_TIMEOUT",
+	"mongo.retry_attempts":           "MONGO_RETRY_ATTEMPTS",
+	"mongo.retry_backoff":            "MONGO_RETRY_BACKOFF",
+	"mongo.retry_max_backoff":        "MONGO_RETRY_MAX_BACKOFF",
+	"mongo.breaker_threshold":        "MONGO_BREAKER_THRESHOLD",
+	"mongo.breaker_cooldown":         "MONGO_BREAKER_COOLDOWN",
+
+	"redis.url": "REDIS_URL",
+
+	"auth.jwt_secret":            "JWT_SECRET",
+	"auth.jwt_algorithm":         "JWT_ALGORITHM",
+	"auth.jwt_issuer":            "JWT_ISSUER",
+	"auth.jwt_audience":          "JWT_AUDIENCE",
+	"auth.jwt_leeway":            "JWT_LEEWAY",
+	"auth.jwt_require_expiry":    "JWT_REQUIRE_EXPIRY",
+	"auth.jwks_url":              "JWKS_URL",
+	"auth.jwks_refresh_interval": "JWKS_REFRESH_INTERVAL",
+
+	"shop.name":            "SHOP_NAME",
+	"shop.timezone":        "SHOP_TIMEZONE",
+	"shop.currency":        "CURRENCY",
+	"shop.calendar_domain": "CALENDAR_DOMAIN",
+
+	"scheduling.min_booking_lead_time":         "MIN_BOOKING_LEAD_TIME",
+	"scheduling.max_booking_advance_days":      "MAX_BOOKING_ADVANCE_DAYS",
+	"scheduling.default_work_start":            "DEFAULT_WORK_START",
+	"scheduling.default_work_end":              "DEFAULT_WORK_END",
+	"scheduling.default_slot_minutes":          "DEFAULT_SLOT_MINUTES",
+	"scheduling.dedupe_window":                 "DEDUPE_WINDOW",
+	"scheduling.slot_cache_ttl":                "SLOT_CACHE_TTL",
+	"scheduling.late_arrival_grace_period":     "LATE_ARRIVAL_GRACE_PERIOD",
+	"scheduling.late_arrival_release":          "LATE_ARRIVAL_RELEASE",
+	"scheduling.pending_booking_timeout":       "PENDING_BOOKING_TIMEOUT",
+	"scheduling.deleted_booking_retention":     "DELETED_BOOKING_RETENTION",
+	"scheduling.auto_complete_after":           "AUTO_COMPLETE_AFTER",
+	"scheduling.auto_complete_require_checkin": "AUTO_COMPLETE_REQUIRE_CHECKIN",
+	"scheduling.allow_early_completion":        "ALLOW_EARLY_COMPLETION",
+	"scheduling.booking_lock.backend":          "BOOKING_LOCK",
+	"scheduling.booking_lock.ttl":              "BOOKING_LOCK_TTL",
+	"scheduling.booking_lock.wait":             "BOOKING_LOCK_WAIT",
+
+	"notifications.reminder_lead":      "REMINDER_LEAD",
+	"notifications.reminder_interval":  "REMINDER_INTERVAL",
+	"notifications.daily_agenda_time":  "DAILY_AGENDA_TIME",
+	"notifications.survey_base_url":    "SURVEY_BASE_URL",
+	"notifications.email.provider":     "EMAIL_PROVIDER",
+	"notifications.email.from":         "EMAIL_FROM",
+	"notifications.email.timeout":      "EMAIL_TIMEOUT",
+	"notifications.email.template_dir": "EMAIL_TEMPLATE_DIR",
+	"notifications.smtp.host":          "SMTP_HOST",
+	"notifications.smtp.port":          "SMTP_PORT",
+	"notifications.smtp.username":      "SMTP_USERNAME",
+	"notifications.smtp.password":      "SMTP_PASSWORD",
+	"notifications.sendgrid.api_key":   "SENDGRID_API_KEY",
+	"notifications.user_service.url":   "USER_SERVICE_URL",
+	"notifications.user_service.token": "USER_SERVICE_TOKEN",
+
+	"payments.stripe_secret_key":         "STRIPE_SECRET_KEY",
+	"payments.stripe_webhook_secret":     "STRIPE_WEBHOOK_SECRET",
+	"payments.deposit_rate":              "DEPOSIT_RATE",
+	"payments.deposit_timeout":           "DEPOSIT_TIMEOUT",
+	"payments.deposit_no_show_threshold": "DEPOSIT_NO_SHOW_THRESHOLD",
+	"payments.commission_rate":           "COMMISSION_RATE",
+	"payments.payroll_export_dir":        "PAYROLL_EXPORT_DIR",
+
+	"events.publisher":             "EVENT_PUBLISHER",
+	"events.publish_timeout":       "EVENT_PUBLISH_TIMEOUT",
+	"events.kafka.brokers":         "KAFKA_BROKERS",
+	"events.kafka.topic":           "KAFKA_TOPIC",
+	"events.nats.url":              "NATS_URL",
+	"events.nats.stream":           "NATS_STREAM",
+	"events.nats.subject_prefix":   "NATS_SUBJECT_PREFIX",
+	"events.change_stream.enabled": "CHANGE_STREAM_ENABLED",
+	"events.change_stream.name":    "CHANGE_STREAM_NAME",
+
+	"webhooks.max_attempts": "WEBHOOK_MAX_ATTEMPTS",
+	"webhooks.timeout":      "WEBHOOK_TIMEOUT",
+	"webhooks.pos_secret":   "POS_WEBHOOK_SECRET",
+
+	"attachments.dir":         "ATTACHMENT_DIR",
+	"attachments.base_url":    "ATTACHMENT_BASE_URL",
+	"attachments.signing_key": "ATTACHMENT_SIGNING_KEY",
+	"attachments.max_size":    "ATTACHMENT_MAX_SIZE",
+
+	"features.flags": "FEATURE_FLAGS",
+}
+
+// readFile merges the settings of a YAML, TOML or JSON config file into v,
+// above the defaults and below environment variables. Settings the file
+// doesn't know fail it, so typos don't go unnoticed.
+func readFile(v *viper.Viper, path string) error {
+	file := viper.New()
+	file.SetConfigFile(path)
+	if err := file.ReadInConfig(); err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	settings := make(map[string]any)
+	var unknown []string
+	for _, key := range file.AllKeys() {
+		env, ok := fileKeys[key]
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		settings[env] = fileValue(file.Get(key))
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return fmt.Errorf("unknown settings in config file %s: %s", path, strings.Join(unknown, ", "))
+	}
+
+	return v.MergeConfigMap(settings)
+}
+
+// fileValue reads lists, e.g. of Kafka brokers, like the comma-separated
+// environment variables
+func fileValue(value any) any {
+	list, ok := value.([]any)
+	if !ok {
+		return value
+	}
+	items := make([]string, len(list))
+	for i, item := range list {
+		items[i] = fmt.Sprint(item)
+	}
+	return strings.Join(items, ",")
+}
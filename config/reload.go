@@ -0,0 +1,110 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// reloadable are the settings a running service applies when its
+// configuration is reloaded; changing any other needs a restart
+var reloadable = map[string]bool{
+	"MIN_BOOKING_LEAD_TIME":    true,
+	"MAX_BOOKING_ADVANCE_DAYS": true,
+	"DEFAULT_WORK_START":       true,
+	"DEFAULT_WORK_END":         true,
+	"DEFAULT_SLOT_MINUTES":     true,
+	"RATE_LIMIT":               true,
+	"RATE_LIMIT_BURST":         true,
+	"RATE_LIMIT_METHODS":       true,
+	"FEATURE_FLAGS":            true,
+}
+
+// Change is a setting whose value differs between two configurations
+type Change struct {
+	// Setting is the setting's environment variable, e.g. RATE_LIMIT
+	Setting string
+	Old     any
+	New     any
+	// Reloadable reports whether a running service applies the change;
+	// others take effect on the next restart
+	Reloadable bool
+}
+
+// Changes lists the settings whose values differ in next, in the order the
+// Config declares them
+func (c *Config) Changes(next *Config) []Change {
+	before, after := reflect.ValueOf(c).Elem(), reflect.ValueOf(next).Elem()
+	var changes []Change
+	for i := 0; i < before.NumField(); i++ {
+		oldValue, newValue := before.Field(i).Interface(), after.Field(i).Interface()
+		if oldValue == newValue {
+			continue
+		}
+		setting := before.Type().Field(i).Tag.Get("mapstructure")
+		changes = append(changes, Change{Setting: setting, Old: oldValue, New: newValue, Reloadable: reloadable[setting]})
+	}
+	return changes
+}
+
+// Reload returns c with the reloadable settings of next applied, and the
+// changes from c to next. Changes to other settings are listed but not
+// applied, so they're reported again until the service restarts.
+func (c *Config) Reload(next *Config) (*Config, []Change) {
+	reloaded := *c
+	current, updated := reflect.ValueOf(&reloaded).Elem(), reflect.ValueOf(next).Elem()
+	for i := 0; i < current.NumField(); i++ {
+		if reloadable[current.Type().Field(i).Tag.Get("mapstructure")] {
+			current.Field(i).Set(updated.Field(i))
+		}
+	}
+	return &reloaded, c.Changes(next)
+}
+
+// watchDelay is how long WatchFile waits for a burst of writes to settle
+const watchDelay = 200 * time.Millisecond
+
+// WatchFile calls onChange after the file at path changes, until ctx ends.
+// It watches the file's directory, so it follows files that are replaced
+// rather than written to, as editors and Kubernetes config maps do.
+func WatchFile(ctx context.Context, path string, onChange func()) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch config file: %w", err)
+	}
+	path = filepath.Clean(path)
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return fmt.Errorf("failed to watch config file: %w", err)
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		// Saving a file takes several events; report them once settled
+		settle := time.NewTimer(0)
+		<-settle.C
+		for {
+			select {
+			case <-ctx.Done():
+				settle.Stop()
+				return
+			case event := <-watcher.Events:
+				// Kubernetes swaps the ..data link to update every file at once
+				name := filepath.Base(event.Name)
+				if filepath.Clean(event.Name) == path || name == "..data" {
+					settle.Reset(watchDelay)
+				}
+			case <-watcher.Errors:
+				// A dropped event is made up for by the next change or SIGHUP
+			case <-settle.C:
+				onChange()
+			}
+		}
+	}()
+	return nil
+}
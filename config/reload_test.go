@@ -0,0 +1,76 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChanges(t *testing.T) {
+	old := &Config{ServerPort: "50051", RateLimit: 10, MinBookingLeadTime: time.Hour}
+	next := *old
+	next.ServerPort = "6000"
+	next.RateLimit = 5
+
+	changes := old.Changes(&next)
+
+	assert.Equal(t, []Change{
+		{Setting: "SERVER_PORT", Old: "50051", New: "6000"},
+		{Setting: "RATE_LIMIT", Old: 10.0, New: 5.0, Reloadable: true},
+	}, changes)
+	assert.Empty(t, old.Changes(old))
+}
+
+func TestReload(t *testing.T) {
+	old := &Config{ServerPort: "50051", RateLimit: 10, DefaultSlotMinutes: 30}
+	next := &Config{ServerPort: "6000", RateLimit: 5, DefaultSlotMinutes: 15}
+
+	reloaded, changes := old.Reload(next)
+
+	assert.Equal(t, &Config{ServerPort: "50051", RateLimit: 5, DefaultSlotMinutes: 15}, reloaded)
+	assert.Len(t, changes, 3)
+	assert.Equal(t, 30, old.DefaultSlotMinutes, "the old config is left alone")
+
+	// The port still needs a restart on the next reload
+	_, changes = reloaded.Reload(next)
+	assert.Equal(t, []Change{{Setting: "SERVER_PORT", Old: "50051", New: "6000"}}, changes)
+}
+
+func TestWatchFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("server:\n  port: \"50051\"\n"), 0o600))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changed := make(chan struct{}, 10)
+	require.NoError(t, WatchFile(ctx, path, func() { changed <- struct{}{} }))
+
+	// Other files in the directory are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.yaml"), nil, 0o600))
+	select {
+	case <-changed:
+		t.Fatal("reported a change to another file")
+	case <-time.After(2 * watchDelay):
+	}
+
+	// Replacing the file, as editors do, is reported once
+	replacement := filepath.Join(dir, "config.yaml.tmp")
+	require.NoError(t, os.WriteFile(replacement, []byte("server:\n  port: \"6000\"\n"), 0o600))
+	require.NoError(t, os.Rename(replacement, path))
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change wasn't reported")
+	}
+	select {
+	case <-changed:
+		t.Fatal("the change was reported twice")
+	case <-time.After(2 * watchDelay):
+	}
+}
@@ -0,0 +1,207 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
+
+	"github.com/ita-av/booking-service/internal/featureflag"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/ratelimit"
+)
+
+// Validate checks the settings for mistakes the service can't start with,
+// returning every problem found rather than only the first
+func (c *Config) Validate() error {
+	var problems []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Errorf(format, args...))
+		}
+	}
+
+	switch c.LogLevel {
+	case "debug", "info", "warn", "error":
+	default:
+		problems = append(problems, fmt.Errorf("LOG_LEVEL must be debug, info, warn or error, not %q", c.LogLevel))
+	}
+
+	check(validPort(c.ServerPort), "SERVER_PORT must be a port number, not %q", c.ServerPort)
+	check(validPort(c.HTTPPort), "HTTP_PORT must be a port number, not %q", c.HTTPPort)
+	check(c.MetricsPort == "" || validPort(c.MetricsPort), "METRICS_PORT must be a port number or empty, not %q", c.MetricsPort)
+	check(c.ShutdownTimeout > 0, "SHUTDOWN_TIMEOUT must be positive")
+	check(c.GRPCMaxRecvMsgSize > 0, "GRPC_MAX_RECV_MSG_SIZE must be positive")
+	check(c.GRPCMaxConcurrentStreams >= 0 && int64(c.GRPCMaxConcurrentStreams) <= math.MaxUint32,
+		"GRPC_MAX_CONCURRENT_STREAMS must be 0, for no limit, or a positive number")
+	check(c.GRPCKeepaliveTime > 0 && c.GRPCKeepaliveTimeout > 0 && c.GRPCKeepaliveMinTime > 0,
+		"GRPC_KEEPALIVE_TIME, GRPC_KEEPALIVE_TIMEOUT and GRPC_KEEPALIVE_MIN_TIME must be positive")
+	check(c.GRPCMaxConnectionIdle >= 0 && c.GRPCMaxConnectionAge >= 0 && c.GRPCMaxConnectionAgeGrace >= 0,
+		"GRPC_MAX_CONNECTION_IDLE, GRPC_MAX_CONNECTION_AGE and GRPC_MAX_CONNECTION_AGE_GRACE must not be negative")
+	check(c.GRPCMaxConnectionAgeGrace == 0 || c.GRPCMaxConnectionAge > 0,
+		"GRPC_MAX_CONNECTION_AGE_GRACE needs GRPC_MAX_CONNECTION_AGE")
+
+	switch c.Storage {
+	case "mongodb":
+		readPreference := c.MongoReadPreference
+		if cs, err := connstring.ParseAndValidate(c.MongoURI); err != nil {
+			problems = append(problems, fmt.Errorf("invalid MONGO_URI: %w", err))
+		} else if readPreference == "" {
+			readPreference = cs.ReadPreference
+		}
+		check(c.MongoDB != "", "MONGO_DB is required")
+		check(c.MongoMaxPoolSize >= 0 && c.MongoMinPoolSize >= 0,
+			"MONGO_MAX_POOL_SIZE and MONGO_MIN_POOL_SIZE must not be negative")
+		check(c.MongoMaxPoolSize == 0 || c.MongoMinPoolSize <= c.MongoMaxPoolSize,
+			"MONGO_MIN_POOL_SIZE must not be more than MONGO_MAX_POOL_SIZE")
+		check(c.MongoServerSelectionTimeout >= 0, "MONGO_SERVER_SELECTION_TIMEOUT must not be negative")
+		check(c.MongoReadTimeout >= 0 && c.MongoWriteTimeout >= 0,
+			"MONGO_READ_TIMEOUT and MONGO_WRITE_TIMEOUT must not be negative")
+		check(c.MongoRetryAttempts >= 1, "MONGO_RETRY_ATTEMPTS must be at least 1, for no retries")
+		check(c.MongoRetryBackoff > 0 && c.MongoRetryMaxBackoff >= c.MongoRetryBackoff,
+			"MONGO_RETRY_BACKOFF must be positive and no more than MONGO_RETRY_MAX_BACKOFF")
+		check(c.MongoBreakerThreshold >= 0, "MONGO_BREAKER_THRESHOLD must be 0, for no breaker, or a positive number")
+		check(c.MongoBreakerThreshold == 0 || c.MongoBreakerCooldown > 0,
+			"MONGO_BREAKER_COOLDOWN must be positive")
+		if readPreference != "" {
+			if mode, err := readpref.ModeFromString(readPreference); err != nil {
+				problems = append(problems, fmt.Errorf("MONGO_READ_PREFERENCE must be primary, primaryPreferred, secondary, secondaryPreferred or nearest, not %q", readPreference))
+			} else {
+				// Booking locks check for conflicts outside transactions, so a
+				// stale secondary could let two bookings overlap
+				check(c.BookingLock == "" || mode == readpref.PrimaryMode,
+					"BOOKING_LOCK needs MONGO_READ_PREFERENCE=primary, not %s", mode)
+			}
+		}
+	case "sqlite":
+		// These features keep their data in MongoDB
+		check(!c.MultiTenant, "MULTI_TENANT needs STORAGE=mongodb")
+		check(!c.ChangeStreamEnabled, "CHANGE_STREAM_ENABLED needs STORAGE=mongodb")
+		check(c.EventPublisher == "", "EVENT_PUBLISHER needs STORAGE=mongodb")
+		check(c.SurveyBaseURL == "", "SURVEY_BASE_URL needs STORAGE=mongodb")
+		check(c.BookingLock == "", "BOOKING_LOCK needs STORAGE=mongodb")
+	default:
+		problems = append(problems, fmt.Errorf("STORAGE must be mongodb or sqlite, not %q", c.Storage))
+	}
+
+	check(c.IsDevelopment() || c.JWTSecret != "" || c.JWKSURL != "",
+		"JWT_SECRET or JWKS_URL is required outside development (APP_ENV=%s)", c.Environment)
+	check(c.TLSCertFile != "" && c.TLSKeyFile != "" || c.TLSCertFile == "" && c.TLSKeyFile == "" && c.TLSClientCAFile == "",
+		"TLS_CERT_FILE and TLS_KEY_FILE are required to enable TLS")
+	check(c.RateLimit >= 0 && c.RateLimitBurst >= 0, "RATE_LIMIT and RATE_LIMIT_BURST must not be negative")
+	if _, err := ratelimit.ParseMethodLimits(c.RateLimitMethods); err != nil {
+		problems = append(problems, fmt.Errorf("invalid RATE_LIMIT_METHODS: %w", err))
+	}
+
+	if err := featureflag.Validate(c.FeatureFlags); err != nil {
+		problems = append(problems, fmt.Errorf("invalid FEATURE_FLAGS: %w", err))
+	}
+
+	if _, err := time.LoadLocation(c.ShopTimezone); err != nil {
+		problems = append(problems, fmt.Errorf("invalid SHOP_TIMEZONE %q: %w", c.ShopTimezone, err))
+	}
+	check(c.MinBookingLeadTime >= 0, "MIN_BOOKING_LEAD_TIME must not be negative")
+	check(c.MaxBookingAdvanceDays >= 0, "MAX_BOOKING_ADVANCE_DAYS must not be negative")
+	if _, _, err := c.DefaultWorkingHours(); err != nil {
+		problems = append(problems, err)
+	}
+	if _, _, err := c.DailyAgendaMinute(); err != nil {
+		problems = append(problems, err)
+	}
+	check(slices.Contains(model.SlotMinuteOptions, c.DefaultSlotMinutes),
+		"DEFAULT_SLOT_MINUTES must be one of %v, not %d", model.SlotMinuteOptions, c.DefaultSlotMinutes)
+	check(c.CommissionRate >= 0 && c.CommissionRate <= 1, "COMMISSION_RATE must be between 0 and 1")
+
+	if c.StripeSecretKey != "" {
+		check(c.StripeWebhookSecret != "", "STRIPE_WEBHOOK_SECRET is required when STRIPE_SECRET_KEY is set")
+		check(c.DepositRate > 0 && c.DepositRate <= 1, "DEPOSIT_RATE must be between 0 and 1")
+		check(c.NoShowDepositThreshold >= 0, "DEPOSIT_NO_SHOW_THRESHOLD must not be negative")
+	}
+
+	switch c.EventPublisher {
+	case "", "nats":
+	case "kafka":
+		check(c.KafkaBrokers != "", "KAFKA_BROKERS is required when EVENT_PUBLISHER is kafka")
+	default:
+		problems = append(problems, fmt.Errorf("EVENT_PUBLISHER must be kafka, nats or empty, not %q", c.EventPublisher))
+	}
+
+	check(c.AttachmentDir == "" || c.AttachmentSigningKey != "", "ATTACHMENT_SIGNING_KEY is required when ATTACHMENT_DIR is set")
+	check(c.RedisURL == "" || c.SlotCacheTTL > 0, "SLOT_CACHE_TTL must be positive")
+
+	switch c.BookingLock {
+	case "":
+	case "redis", "mongodb":
+		check(c.BookingLockTTL > 0 && c.BookingLockWait > 0, "BOOKING_LOCK_TTL and BOOKING_LOCK_WAIT must be positive")
+		check(c.BookingLock != "redis" || c.RedisURL != "", "BOOKING_LOCK=redis needs REDIS_URL")
+	default:
+		problems = append(problems, fmt.Errorf("BOOKING_LOCK must be redis, mongodb or empty, not %q", c.BookingLock))
+	}
+
+	switch c.EmailProvider {
+	case "", "smtp":
+	case "sendgrid":
+		check(c.SendGridAPIKey != "", "SENDGRID_API_KEY is required when EMAIL_PROVIDER is sendgrid")
+	default:
+		problems = append(problems, fmt.Errorf("EMAIL_PROVIDER must be smtp, sendgrid or empty, not %q", c.EmailProvider))
+	}
+
+	return errors.Join(problems...)
+}
+
+// validPort reports whether port is a TCP port number; 0 picks a free one
+func validPort(port string) bool {
+	n, err := strconv.Atoi(port)
+	return err == nil && n >= 0 && n <= 65535
+}
+
+// DefaultWorkingHours returns DEFAULT_WORK_START and DEFAULT_WORK_END in
+// minutes after midnight
+func (c *Config) DefaultWorkingHours() (startMinute, endMinute int, err error) {
+	startMinute, err = parseTimeOfDay(c.DefaultWorkStart)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid DEFAULT_WORK_START: %w", err)
+	}
+	endMinute, err = parseTimeOfDay(c.DefaultWorkEnd)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid DEFAULT_WORK_END: %w", err)
+	}
+	if startMinute >= endMinute {
+		return 0, 0, fmt.Errorf("DEFAULT_WORK_START must be before DEFAULT_WORK_END")
+	}
+	return startMinute, endMinute, nil
+}
+
+// DailyAgendaMinute returns DAILY_AGENDA_TIME in minutes after midnight, and
+// whether daily agendas are sent at all
+func (c *Config) DailyAgendaMinute() (minute int, enabled bool, err error) {
+	if c.DailyAgendaTime == "" {
+		return 0, false, nil
+	}
+	minute, err = parseTimeOfDay(c.DailyAgendaTime)
+	if err == nil && minute >= 24*60 {
+		err = fmt.Errorf("%q is not a time of day before midnight", c.DailyAgendaTime)
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid DAILY_AGENDA_TIME: %w", err)
+	}
+	return minute, true, nil
+}
+
+// parseTimeOfDay converts "HH:MM" to minutes after midnight, allowing "24:00"
+// for the end of the day
+func parseTimeOfDay(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a time of day in HH:MM format", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,108 @@
+// Package audit records the authenticated calls that change something in
+// the audit log.
+package audit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// maxRequestSummary caps the stored size of a request, so uploads and other
+// large payloads don't bloat the log
+const maxRequestSummary = 1024
+
+// writeTimeout bounds how long recording an entry may delay the response
+const writeTimeout = 2 * time.Second
+
+// readOnlyPrefixes mark the methods that don't change anything
+var readOnlyPrefixes = []string{"Get", "List", "Watch"}
+
+// Interceptor records authenticated mutating calls. It must run after the
+// auth interceptor, which puts the caller's claims in the context.
+type Interceptor struct {
+	repo repository.AuditRepository
+	now  func() time.Time
+}
+
+// NewInterceptor creates an interceptor writing to repo
+func NewInterceptor(repo repository.AuditRepository) *Interceptor {
+	return &Interceptor{
+		repo: repo,
+		now:  time.Now,
+	}
+}
+
+// Unary is a grpc.UnaryServerInterceptor recording the call after it's handled
+func (i *Interceptor) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	actorID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil || !isMutating(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	start := i.now()
+	resp, err := handler(ctx, req)
+
+	entry := &model.AuditEntry{
+		Method:   info.FullMethod,
+		ActorID:  actorID,
+		IsBarber: auth.IsBarber(ctx),
+		IsAdmin:  auth.IsAdmin(ctx),
+		Request:  summarize(req),
+		Code:     status.Code(err).String(),
+		Duration: i.now().Sub(start),
+		At:       start,
+	}
+	if err != nil {
+		entry.Error = status.Convert(err).Message()
+	}
+
+	// The call is done either way, so a cancelled client mustn't lose the entry
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), writeTimeout)
+	defer cancel()
+	if writeErr := i.repo.AddAuditEntry(writeCtx, entry); writeErr != nil {
+		log.Error().Err(writeErr).Str("method", info.FullMethod).Str("actorID", actorID).Msg("Failed to record audit entry")
+	}
+
+	return resp, err
+}
+
+// isMutating reports whether a full gRPC method name is for a call that
+// changes something
+func isMutating(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// summarize encodes a request as JSON, truncated to maxRequestSummary bytes
+func summarize(req interface{}) string {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return ""
+	}
+	if len(data) > maxRequestSummary {
+		return string(data[:maxRequestSummary]) + "…"
+	}
+
+	return string(data)
+}
@@ -0,0 +1,112 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// fakeRepo keeps audit entries in memory
+type fakeRepo struct {
+	entries []*model.AuditEntry
+}
+
+func (r *fakeRepo) AddAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *fakeRepo) ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	return r.entries, nil
+}
+
+func (r *fakeRepo) DeleteAuditEntriesBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeRepo) AnonymizeActorEntries(ctx context.Context, actorID string) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeRepo) DeleteActorEntries(ctx context.Context, actorID string) (int64, error) {
+	return 0, nil
+}
+
+func contextWithClaims(userID string, isBarber bool) context.Context {
+	claims := &auth.Claims{IsBarber: isBarber}
+	claims.Subject = userID
+	return auth.WithClaims(context.Background(), claims)
+}
+
+func TestInterceptor_RecordsMutatingCalls(t *testing.T) {
+	repo := &fakeRepo{}
+	interceptor := NewInterceptor(repo)
+	start := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	clock := start
+	interceptor.now = func() time.Time {
+		clock = clock.Add(25 * time.Millisecond)
+		return clock
+	}
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.FailedPrecondition, "booking has already started")
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/CancelBooking"}
+
+	_, err := interceptor.Unary(contextWithClaims("barber1", true), &pb.CancelBookingRequest{Id: "b1", Reason: "sick"}, info, handler)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	if assert.Len(t, repo.entries, 1) {
+		entry := repo.entries[0]
+		assert.Equal(t, "/booking.BookingService/CancelBooking", entry.Method)
+		assert.Equal(t, "barber1", entry.ActorID)
+		assert.True(t, entry.IsBarber)
+		assert.False(t, entry.IsAdmin)
+		assert.JSONEq(t, `{"id":"b1","reason":"sick"}`, entry.Request)
+		assert.Equal(t, "FailedPrecondition", entry.Code)
+		assert.Equal(t, "booking has already started", entry.Error)
+		assert.Equal(t, 25*time.Millisecond, entry.Duration)
+		assert.Equal(t, start.Add(25*time.Millisecond), entry.At)
+	}
+}
+
+func TestInterceptor_SkipsReadsAndAnonymousCalls(t *testing.T) {
+	repo := &fakeRepo{}
+	interceptor := NewInterceptor(repo)
+
+	called := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called++
+		return nil, nil
+	}
+
+	for _, method := range []string{"GetBooking", "ListBookings", "WatchBookings"} {
+		info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/" + method}
+		_, err := interceptor.Unary(contextWithClaims("user1", false), &pb.GetBookingRequest{}, info, handler)
+		assert.NoError(t, err)
+	}
+
+	// Survey responses are public
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/SubmitSurveyResponse"}
+	_, err := interceptor.Unary(context.Background(), &pb.SubmitSurveyResponseRequest{}, info, handler)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, called)
+	assert.Empty(t, repo.entries)
+}
+
+func TestSummarize_Truncates(t *testing.T) {
+	summary := summarize(&pb.CancelBookingRequest{Reason: strings.Repeat("x", 2*maxRequestSummary)})
+	assert.Equal(t, maxRequestSummary+len("…"), len(summary))
+	assert.True(t, strings.HasSuffix(summary, "…"))
+}
@@ -0,0 +1,22 @@
+package auth
+
+import "context"
+
+// claimsKey is the context key the caller's claims are stored under. Being
+// unexported, no other package can read or overwrite them by accident.
+type claimsKey struct{}
+
+// WithClaims returns a copy of ctx carrying the caller's claims
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the caller's claims. The boolean result is false
+// when the context carries none.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	if !ok || claims == nil {
+		return nil, false
+	}
+	return claims, true
+}
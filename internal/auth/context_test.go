@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimsFromContext(t *testing.T) {
+	_, ok := ClaimsFromContext(context.Background())
+	assert.False(t, ok)
+
+	// A plain string key must not be mistaken for the claims
+	_, ok = ClaimsFromContext(context.WithValue(context.Background(), "user_claims", &Claims{}))
+	assert.False(t, ok)
+
+	claims := &Claims{IsBarber: true}
+	got, ok := ClaimsFromContext(WithClaims(context.Background(), claims))
+	assert.True(t, ok)
+	assert.Same(t, claims, got)
+}
@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// minJWKSRefreshInterval bounds how often keys are refetched, so tokens with
+// made-up key IDs or an unreachable endpoint can't stall every request
+const minJWKSRefreshInterval = 30 * time.Second
+
+// ErrUnknownKey is returned when no key in the JWKS matches a token's key ID
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// jwk is a single JSON Web Key. Only the fields of RSA and EC public keys
+// are decoded.
+type jwk struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+}
+
+// jwkSet is the document served at a JWKS endpoint
+type jwkSet struct {
+	Keys []jwk `json:"keys"`
+}
+
+// publicKey is a verification key with the algorithm it's meant for, if the
+// JWKS names one
+type publicKey struct {
+	key       interface{}
+	algorithm string
+}
+
+// JWKS fetches and caches the user service's public signing keys. Keys are
+// refetched once the cache is older than the refresh interval, or when a
+// token names a key ID the cache doesn't know, which picks up rotated keys.
+type JWKS struct {
+	url             string
+	refreshInterval time.Duration
+	client          *http.Client
+	now             func() time.Time
+
+	mu          sync.Mutex
+	keys        map[string]publicKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewJWKS creates a key set fetched from url and refreshed every refreshInterval
+func NewJWKS(url string, refreshInterval time.Duration) *JWKS {
+	return &JWKS{
+		url:             url,
+		refreshInterval: refreshInterval,
+		client:          &http.Client{Timeout: 10 * time.Second},
+		now:             time.Now,
+	}
+}
+
+// Key returns the public key with the given key ID, for the given algorithm
+func (s *JWKS) Key(ctx context.Context, keyID, algorithm string) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	key, ok := s.keys[keyID]
+	stale := now.Sub(s.fetchedAt) >= s.refreshInterval
+	if (!ok || stale) && now.Sub(s.attemptedAt) >= minJWKSRefreshInterval {
+		s.attemptedAt = now
+		if err := s.refresh(ctx); err != nil {
+			// Keep verifying with the cached keys while the endpoint is down
+			if !ok {
+				return nil, err
+			}
+			log.Warn().Err(err).Str("url", s.url).Msg("Failed to refresh JWKS, using cached keys")
+		} else {
+			key, ok = s.keys[keyID]
+		}
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, keyID)
+	}
+	if key.algorithm != "" && key.algorithm != algorithm {
+		return nil, fmt.Errorf("key %q is for %s, not %s", keyID, key.algorithm, algorithm)
+	}
+
+	return key.key, nil
+}
+
+// refresh replaces the cached keys with the ones currently served
+func (s *JWKS) refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS: status %d", resp.StatusCode)
+	}
+
+	var set jwkSet
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]publicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			log.Warn().Err(err).Str("kid", k.KeyID).Msg("Skipping unusable JWKS key")
+			continue
+		}
+		keys[k.KeyID] = publicKey{key: key, algorithm: k.Algorithm}
+	}
+
+	s.keys = keys
+	s.fetchedAt = s.now()
+
+	return nil
+}
+
+// publicKey decodes an RSA or EC public key
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+	}
+}
+
+// decodeBigInt decodes a base64url-encoded big-endian integer
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,178 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jwksServer serves a JWKS that tests can swap out to simulate key rotation
+type jwksServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	keys     []jwk
+	requests int
+}
+
+func newJWKSServer(t *testing.T, keys ...jwk) *jwksServer {
+	s := &jwksServer{keys: keys}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.requests++
+		_ = json.NewEncoder(w).Encode(jwkSet{Keys: s.keys})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) setKeys(keys ...jwk) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *jwksServer) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func encodeBigInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func rsaJWK(kid string, key *rsa.PrivateKey) jwk {
+	return jwk{
+		KeyType:   "RSA",
+		KeyID:     kid,
+		Use:       "sig",
+		Algorithm: "RS256",
+		N:         encodeBigInt(key.N),
+		E:         encodeBigInt(big.NewInt(int64(key.E))),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PrivateKey) jwk {
+	return jwk{
+		KeyType: "EC",
+		KeyID:   kid,
+		Curve:   "P-256",
+		X:       encodeBigInt(key.X),
+		Y:       encodeBigInt(key.Y),
+	}
+}
+
+func signWithKey(t *testing.T, method jwt.SigningMethod, kid string, key interface{}) string {
+	token := jwt.NewWithClaims(method, testClaims("", ""))
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestAuthenticator_VerifyJWKSTokens(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	server := newJWKSServer(t, rsaJWK("rsa-1", rsaKey), ecJWK("ec-1", ecKey))
+
+	a, err := NewAuthenticator(JWTConfig{Secret: []byte("secret"), JWKSURL: server.URL})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	claims, err := a.VerifyToken(ctx, signWithKey(t, jwt.SigningMethodRS256, "rsa-1", rsaKey))
+	require.NoError(t, err)
+	assert.Equal(t, "barber1", claims.Subject)
+
+	_, err = a.VerifyToken(ctx, signWithKey(t, jwt.SigningMethodES256, "ec-1", ecKey))
+	assert.NoError(t, err)
+
+	// HMAC tokens still work alongside the JWKS
+	_, err = a.VerifyToken(ctx, signToken(t, jwt.SigningMethodHS256, "secret", testClaims("", "")))
+	assert.NoError(t, err)
+
+	// A key can't be used with another algorithm than the one it's for
+	_, err = a.VerifyToken(ctx, signWithKey(t, jwt.SigningMethodRS256, "ec-1", rsaKey))
+	assert.Error(t, err)
+
+	// Keys are fetched once and cached
+	assert.Equal(t, 1, server.requestCount())
+}
+
+func TestJWKS_Rotation(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	server := newJWKSServer(t, rsaJWK("old", oldKey))
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	jwks := NewJWKS(server.URL, time.Hour)
+	jwks.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	key, err := jwks.Key(ctx, "old", "RS256")
+	require.NoError(t, err)
+	assert.Equal(t, &oldKey.PublicKey, key)
+
+	server.setKeys(rsaJWK("old", oldKey), rsaJWK("new", newKey))
+
+	// Unknown key IDs don't refetch more than every minJWKSRefreshInterval
+	_, err = jwks.Key(ctx, "new", "RS256")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, 1, server.requestCount())
+
+	now = now.Add(minJWKSRefreshInterval)
+	key, err = jwks.Key(ctx, "new", "RS256")
+	require.NoError(t, err)
+	assert.Equal(t, &newKey.PublicKey, key)
+	assert.Equal(t, 2, server.requestCount())
+
+	// Retired keys disappear once the cache expires
+	server.setKeys(rsaJWK("new", newKey))
+	now = now.Add(time.Hour)
+	_, err = jwks.Key(ctx, "old", "RS256")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+}
+
+func TestJWKS_KeepsCachedKeysWhenEndpointFails(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	server := newJWKSServer(t, rsaJWK("rsa-1", rsaKey))
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	jwks := NewJWKS(server.URL, time.Hour)
+	jwks.now = func() time.Time { return now }
+
+	_, err = jwks.Key(context.Background(), "rsa-1", "RS256")
+	require.NoError(t, err)
+
+	server.Close()
+	now = now.Add(2 * time.Hour)
+
+	key, err := jwks.Key(context.Background(), "rsa-1", "RS256")
+	require.NoError(t, err)
+	assert.Equal(t, &rsaKey.PublicKey, key)
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -14,19 +16,96 @@ import (
 )
 
 var (
-	// key used to verify JWTs (must match user service's key)
-	// prod. -> environment variables
-	JWTSecret = []byte("secret_key_123")
-
 	// Errors
 	ErrMissingMetadata = errors.New("missing metadata")
 	ErrMissingToken    = errors.New("missing token")
 	ErrInvalidToken    = errors.New("invalid token")
+	ErrMissingSecret   = errors.New("JWT secret or JWKS URL is required")
+	ErrTokenExpired    = errors.New("token expired")
+	ErrInvalidIssuer   = errors.New("invalid token issuer")
+	ErrInvalidAudience = errors.New("invalid token audience")
 )
 
-// Claims represents the JWT payload with is_barber field
+// jwksAlgorithms are the asymmetric signing methods accepted with a JWKS
+var jwksAlgorithms = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodES256.Alg()}
+
+// JWTConfig holds the settings used to verify tokens from the user service.
+// At least one of Secret and JWKSURL must be set.
+type JWTConfig struct {
+	// Secret is the HMAC key shared with the user service
+	Secret []byte
+	// Algorithm is the expected HMAC signing method: HS256, HS384 or HS512
+	Algorithm string
+	// JWKSURL is the user service's JWKS endpoint, used to verify RS256 and
+	// ES256 tokens
+	JWKSURL string
+	// JWKSRefreshInterval is how long fetched keys are cached
+	JWKSRefreshInterval time.Duration
+	// Issuer and Audience are checked against the iss and aud claims when set
+	Issuer   string
+	Audience string
+	// Leeway is the clock skew tolerated when checking exp, nbf and iat
+	Leeway time.Duration
+	// RequireExpiry rejects tokens without an exp claim
+	RequireExpiry bool
+}
+
+// Authenticator verifies JWTs and puts their claims in the request context
+type Authenticator struct {
+	secret        []byte
+	jwks          *JWKS
+	methods       []string
+	issuer        string
+	audience      string
+	leeway        time.Duration
+	requireExpiry bool
+}
+
+// NewAuthenticator creates an authenticator from the JWT settings
+func NewAuthenticator(cfg JWTConfig) (*Authenticator, error) {
+	if len(cfg.Secret) == 0 && cfg.JWKSURL == "" {
+		return nil, ErrMissingSecret
+	}
+
+	a := &Authenticator{
+		issuer:        cfg.Issuer,
+		audience:      cfg.Audience,
+		leeway:        cfg.Leeway,
+		requireExpiry: cfg.RequireExpiry,
+	}
+
+	if len(cfg.Secret) > 0 {
+		algorithm := cfg.Algorithm
+		if algorithm == "" {
+			algorithm = jwt.SigningMethodHS256.Alg()
+		}
+		if _, ok := jwt.GetSigningMethod(algorithm).(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unsupported JWT algorithm %q", cfg.Algorithm)
+		}
+		a.secret = cfg.Secret
+		a.methods = append(a.methods, algorithm)
+	}
+
+	if cfg.JWKSURL != "" {
+		refreshInterval := cfg.JWKSRefreshInterval
+		if refreshInterval <= 0 {
+			refreshInterval = time.Hour
+		}
+		a.jwks = NewJWKS(cfg.JWKSURL, refreshInterval)
+		a.methods = append(a.methods, jwksAlgorithms...)
+	}
+
+	return a, nil
+}
+
+// Claims represents the JWT payload. Roles supersede the is_barber and
+// is_admin flags, which older tokens carry instead. TenantID is the shop the
+// user belongs to, empty for the default shop.
 type Claims struct {
-	IsBarber bool `json:"is_barber"`
+	Roles    []Role `json:"roles,omitempty"`
+	IsBarber bool   `json:"is_barber"`
+	IsAdmin  bool   `json:"is_admin"`
+	TenantID string `json:"tenant_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -52,20 +131,47 @@ func ExtractToken(ctx context.Context) (string, error) {
 	return parts[1], nil
 }
 
-// VerifyToken validates the JWT and returns the claims
-func VerifyToken(tokenString string) (*Claims, error) {
+// VerifyToken validates the JWT and returns the claims. Expired tokens and
+// tokens for another issuer or audience fail with ErrTokenExpired,
+// ErrInvalidIssuer and ErrInvalidAudience; anything else wrong with a token
+// is an ErrInvalidToken.
+func (a *Authenticator) VerifyToken(ctx context.Context, tokenString string) (*Claims, error) {
 	claims := &Claims{}
 
+	parserOpts := []jwt.ParserOption{jwt.WithValidMethods(a.methods)}
+	if a.issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(a.issuer))
+	}
+	if a.audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(a.audience))
+	}
+	if a.leeway > 0 {
+		parserOpts = append(parserOpts, jwt.WithLeeway(a.leeway))
+	}
+	if a.requireExpiry {
+		parserOpts = append(parserOpts, jwt.WithExpirationRequired())
+	}
+
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		// Validate the signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			return a.secret, nil
 		}
-		return JWTSecret, nil
-	})
+
+		// Asymmetric tokens name the JWKS key they were signed with
+		kid, _ := token.Header["kid"].(string)
+		return a.jwks.Key(ctx, kid, token.Method.Alg())
+	}, parserOpts...)
 
 	if err != nil {
-		return nil, err
+		switch {
+		case errors.Is(err, jwt.ErrTokenExpired):
+			return nil, ErrTokenExpired
+		case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+			return nil, ErrInvalidIssuer
+		case errors.Is(err, jwt.ErrTokenInvalidAudience):
+			return nil, ErrInvalidAudience
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
 	}
 
 	if !token.Valid {
@@ -75,13 +181,38 @@ func VerifyToken(tokenString string) (*Claims, error) {
 	return claims, nil
 }
 
-// AuthInterceptor is a gRPC interceptor that checks for valid JWT tokens
-func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+// Unary is a gRPC interceptor that checks for valid JWT tokens
+func (a *Authenticator) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	// Skip auth for health check or other public methods
 	if isPublicMethod(info.FullMethod) {
-		return handler(ctx, req)
+		return handler(a.identify(ctx), req)
+	}
+
+	newCtx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Continue execution of the handler
+	return handler(newCtx, req)
+}
+
+// Stream is the streaming counterpart of Unary
+func (a *Authenticator) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isPublicMethod(info.FullMethod) {
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: a.identify(ss.Context())})
+	}
+
+	newCtx, err := a.authenticate(ss.Context())
+	if err != nil {
+		return err
 	}
 
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: newCtx})
+}
+
+// authenticate verifies the request's JWT and returns a context carrying its claims
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
 	// Extract token from context
 	token, err := ExtractToken(ctx)
 	if err != nil {
@@ -89,31 +220,60 @@ func AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServe
 	}
 
 	// Verify the token
-	claims, err := VerifyToken(token)
+	claims, err := a.VerifyToken(ctx, token)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
 	}
 
+	// Tag the request's log lines with the caller
+	zerolog.Ctx(ctx).UpdateContext(func(c zerolog.Context) zerolog.Context {
+		return c.Str("user_id", claims.Subject)
+	})
+
 	// Add claims to the context for use in handlers
-	newCtx := context.WithValue(ctx, "user_claims", claims)
+	return WithClaims(ctx, claims), nil
+}
 
-	// Continue execution of the handler
-	return handler(newCtx, req)
+// identify adds the caller's claims to the context of a public method if they
+// sent a valid token, so handlers can show signed-in users more. Calls without
+// one, or with one that doesn't verify, go through anonymously.
+func (a *Authenticator) identify(ctx context.Context) context.Context {
+	if _, err := ExtractToken(ctx); err != nil {
+		return ctx
+	}
+	newCtx, err := a.authenticate(ctx)
+	if err != nil {
+		return ctx
+	}
+	return newCtx
+}
+
+// authenticatedStream overrides the context of a server stream
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context carrying the caller's claims
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
 }
 
 // isPublicMethod determines if a method doesn't require authentication
 func isPublicMethod(method string) bool {
-	publicMethods := map[string]bool{
-		"/grpc.health.v1.Health/Check": true,
-		// Add other public methods here
+	// Health checks come from probes and load balancers without a token
+	if strings.HasPrefix(method, "/grpc.health.v1.Health/") {
+		return true
 	}
-	return publicMethods[method]
+
+	policy, ok := PolicyFor(method)
+	return ok && policy.Public
 }
 
 // GetUserIDFromContext extracts the user ID from the context
 func GetUserIDFromContext(ctx context.Context) (string, error) {
-	claims, ok := ctx.Value("user_claims").(*Claims)
-	if !ok || claims == nil {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
 		return "", errors.New("no user claims found in context")
 	}
 
@@ -126,12 +286,12 @@ func GetUserIDFromContext(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
-// IsBarber checks if the user in the context has the barber flag set to true
+// IsBarber checks if the user in the context has the barber role
 func IsBarber(ctx context.Context) bool {
-	claims, ok := ctx.Value("user_claims").(*Claims)
-	if !ok || claims == nil {
-		return false
-	}
+	return HasRole(ctx, RoleBarber)
+}
 
-	return claims.IsBarber
+// IsAdmin checks if the user in the context has the admin role
+func IsAdmin(ctx context.Context) bool {
+	return HasRole(ctx, RoleAdmin)
 }
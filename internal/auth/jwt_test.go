@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func signToken(t *testing.T, method jwt.SigningMethod, secret string, claims *Claims) string {
+	token, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return token
+}
+
+func testClaims(issuer, audience string) *Claims {
+	return testClaimsExpiring(issuer, audience, time.Now().Add(time.Hour))
+}
+
+func testClaimsExpiring(issuer, audience string, expiresAt time.Time) *Claims {
+	claims := &Claims{Roles: []Role{RoleBarber}}
+	claims.Subject = "barber1"
+	claims.Issuer = issuer
+	if audience != "" {
+		claims.Audience = jwt.ClaimStrings{audience}
+	}
+	if !expiresAt.IsZero() {
+		claims.ExpiresAt = jwt.NewNumericDate(expiresAt)
+	}
+	return claims
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	_, err := NewAuthenticator(JWTConfig{})
+	assert.ErrorIs(t, err, ErrMissingSecret)
+
+	_, err = NewAuthenticator(JWTConfig{Secret: []byte("secret"), Algorithm: "RS256"})
+	assert.Error(t, err)
+
+	a, err := NewAuthenticator(JWTConfig{Secret: []byte("secret")})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HS256"}, a.methods)
+
+	a, err = NewAuthenticator(JWTConfig{JWKSURL: "http://users/.well-known/jwks.json"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"RS256", "ES256"}, a.methods)
+}
+
+func TestAuthenticator_VerifyToken(t *testing.T) {
+	a, err := NewAuthenticator(JWTConfig{
+		Secret:    []byte("secret"),
+		Algorithm: "HS384",
+		Issuer:    "user-service",
+		Audience:  "booking-service",
+		Leeway:    time.Minute,
+
+		RequireExpiry: true,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{"valid", signToken(t, jwt.SigningMethodHS384, "secret", testClaims("user-service", "booking-service")), nil},
+		{"expired within leeway", signToken(t, jwt.SigningMethodHS384, "secret", testClaimsExpiring("user-service", "booking-service", time.Now().Add(-30*time.Second))), nil},
+		{"expired", signToken(t, jwt.SigningMethodHS384, "secret", testClaimsExpiring("user-service", "booking-service", time.Now().Add(-2*time.Minute))), ErrTokenExpired},
+		{"without expiry", signToken(t, jwt.SigningMethodHS384, "secret", testClaimsExpiring("user-service", "booking-service", time.Time{})), ErrInvalidToken},
+		{"wrong secret", signToken(t, jwt.SigningMethodHS384, "other", testClaims("user-service", "booking-service")), ErrInvalidToken},
+		{"wrong algorithm", signToken(t, jwt.SigningMethodHS256, "secret", testClaims("user-service", "booking-service")), ErrInvalidToken},
+		{"wrong issuer", signToken(t, jwt.SigningMethodHS384, "secret", testClaims("someone-else", "booking-service")), ErrInvalidIssuer},
+		{"wrong audience", signToken(t, jwt.SigningMethodHS384, "secret", testClaims("user-service", "other-service")), ErrInvalidAudience},
+		{"missing audience", signToken(t, jwt.SigningMethodHS384, "secret", testClaims("user-service", "")), ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := a.VerifyToken(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "barber1", claims.Subject)
+			assert.True(t, claims.HasRole(RoleBarber))
+		})
+	}
+}
+
+// fakeServerStream is a server stream that only carries a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func contextWithToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthenticator_Interceptors(t *testing.T) {
+	a, err := NewAuthenticator(JWTConfig{Secret: []byte("secret")})
+	require.NoError(t, err)
+
+	valid := signToken(t, jwt.SigningMethodHS256, "secret", testClaims("", ""))
+	expired := signToken(t, jwt.SigningMethodHS256, "secret", testClaimsExpiring("", "", time.Now().Add(-time.Hour)))
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		want   codes.Code
+	}{
+		{"valid token", contextWithToken(valid), "/booking.BookingService/WatchBookings", codes.OK},
+		{"missing token", context.Background(), "/booking.BookingService/WatchBookings", codes.Unauthenticated},
+		{"expired token", contextWithToken(expired), "/booking.BookingService/WatchBookings", codes.Unauthenticated},
+		{"public method", context.Background(), "/grpc.health.v1.Health/Check", codes.OK},
+		{"public method with token", contextWithToken(valid), "/booking.BookingService/ListBarbers", codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run("unary "+tt.name, func(t *testing.T) {
+			var userID string
+			_, err := a.Unary(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				userID, _ = GetUserIDFromContext(ctx)
+				return nil, nil
+			})
+			assert.Equal(t, tt.want, status.Code(err))
+			if tt.want == codes.OK && tt.ctx != context.Background() {
+				assert.Equal(t, "barber1", userID)
+			}
+		})
+
+		t.Run("stream "+tt.name, func(t *testing.T) {
+			var userID string
+			err := a.Stream(nil, &fakeServerStream{ctx: tt.ctx}, &grpc.StreamServerInfo{FullMethod: tt.method}, func(srv interface{}, ss grpc.ServerStream) error {
+				userID, _ = GetUserIDFromContext(ss.Context())
+				return nil
+			})
+			assert.Equal(t, tt.want, status.Code(err))
+			if tt.want == codes.OK && tt.ctx != context.Background() {
+				assert.Equal(t, "barber1", userID)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bookingServicePrefix starts the full name of every booking service method
+const bookingServicePrefix = "/booking.BookingService/"
+
+// MethodPolicy says who may call a method. Admins pass every role and
+// permission requirement.
+type MethodPolicy struct {
+	Public     bool       // No token needed
+	Roles      []Role     // Caller needs one of these; empty allows any signed-in user
+	Permission Permission // Caller needs this permission, if set
+	Ownership  bool       // The server's OwnershipChecker must also approve the request
+}
+
+// OwnershipChecker is implemented by servers whose methods are only open to
+// the users a resource belongs to, which only the server can look up
+type OwnershipChecker interface {
+	CheckOwnership(ctx context.Context, fullMethod string, req interface{}) error
+}
+
+var (
+	signedIn  = MethodPolicy{}
+	barbers   = MethodPolicy{Roles: []Role{RoleBarber}}
+	ownerOnly = MethodPolicy{Ownership: true}
+	ownBarber = MethodPolicy{Roles: []Role{RoleBarber}, Ownership: true}
+)
+
+// methodPolicies lists the policy of every booking service method. Methods
+// missing from it can't be called, so a new RPC must be added here. Handlers
+// still check what depends on the request, e.g. that customers only touch
+// their own bookings.
+var methodPolicies = map[string]MethodPolicy{
+	"CreateBooking":              signedIn,
+	"HoldTimeSlot":               signedIn,
+	"RebookLast":                 signedIn,
+	"GetBooking":                 ownerOnly,
+	"UpdateBooking":              signedIn,
+	"RescheduleBooking":          signedIn,
+	"ConfirmBooking":             ownerOnly,
+	"CompleteBooking":            ownerOnly,
+	"CancelBooking":              signedIn,
+	"DeleteBooking":              {Permission: PermDeleteBooking},
+	"ListBookings":               signedIn,
+	"WatchBookings":              signedIn,
+	"GetUserBookings":            signedIn,
+	"GetBarberBookings":          ownBarber,
+	"GetAvailableTimeSlots":      signedIn,
+	"GetAvailableTimeSlotsRange": signedIn,
+	"GetNextAvailableSlot":       signedIn,
+	"CheckInBooking":             ownBarber,
+	"MarkNoShow":                 ownBarber,
+	"GetUserReliability":         {Permission: PermViewCustomerStats},
+	"GetReliabilityReport":       {Permission: PermViewCustomerStats},
+	"GetCustomerSummary":         {Permission: PermViewCustomerStats},
+	"GetBookingHistory":          ownerOnly,
+	"GetBookingICS":              ownerOnly,
+	"ListAuditLog":               {Permission: PermViewAuditLog},
+	"AddBookingAttachment":       signedIn,
+	"GetBookingByExternalRef":    signedIn,
+	"RecordPOSCompletion":        barbers,
+	"SubmitSurveyResponse":       {Public: true}, // Authenticated by the token in the survey link
+	"GetBarberSurveyScores":      barbers,
+	"SubmitReview":               ownerOnly,
+	"ListBarberReviews":          signedIn,
+	"GetBarberRating":            signedIn,
+	"GetBarberStats":             barbers,
+	"GetDemandHeatmap":           barbers,
+	"GetDailyAgenda":             barbers,
+	"ExportPayroll":              {Permission: PermManagePayroll},
+	"FinalizePayrollPeriod":      {Permission: PermManagePayroll},
+	"GetRevenueReport":           {Permission: PermViewRevenue},
+	"ExportRevenueReport":        {Permission: PermViewRevenue},
+	"GetRetentionPolicy":         {Permission: PermManageSettings},
+	"UpdateRetentionPolicy":      {Permission: PermManageSettings},
+	"ExportUserData":             {Permission: PermManageUserData},
+	"EraseUserData":              {Permission: PermManageUserData},
+	"GetCancellationPolicy":      signedIn,
+	"UpdateCancellationPolicy":   {Permission: PermManageSettings},
+	"GetWorkingHours":            signedIn,
+	"SetWorkingHours":            signedIn,
+	"ListHolidays":               signedIn,
+	"AddHoliday":                 {Permission: PermManageHolidays},
+	"RemoveHoliday":              {Permission: PermManageHolidays},
+	"ListCatalogServices":        signedIn,
+	"CreateCatalogService":       {Permission: PermManageCatalog},
+	"UpdateCatalogService":       {Permission: PermManageCatalog},
+	"DeleteCatalogService":       {Permission: PermManageCatalog},
+	"ListPromoCodes":             {Permission: PermManagePromoCodes},
+	"GetPromoCode":               {Permission: PermManagePromoCodes},
+	"CreatePromoCode":            {Permission: PermManagePromoCodes},
+	"UpdatePromoCode":            {Permission: PermManagePromoCodes},
+	"DeletePromoCode":            {Permission: PermManagePromoCodes},
+	"ListResources":              signedIn,
+	"CreateResource":             {Permission: PermManageCatalog},
+	"UpdateResource":             {Permission: PermManageCatalog},
+	"DeleteResource":             {Permission: PermManageCatalog},
+	"GetBarberServices":          signedIn,
+	"SetBarberServices":          signedIn,
+	"ListBarbers":                {Public: true},
+	"GetBarber":                  signedIn,
+	"CreateBarber":               {Permission: PermManageBarbers},
+	"UpdateBarber":               signedIn,
+	"DeleteBarber":               {Permission: PermManageBarbers},
+	"AddFavoriteBarber":          signedIn,
+	"RemoveFavoriteBarber":       signedIn,
+	"ListFavorites":              signedIn,
+	"GetQuote":                   signedIn,
+	"CreateWebhook":              {Permission: PermManageWebhooks},
+	"ListWebhooks":               {Permission: PermManageWebhooks},
+	"DeleteWebhook":              {Permission: PermManageWebhooks},
+}
+
+// PolicyFor returns the policy of a booking service method, given its full
+// name. The boolean result is false for methods without a policy.
+func PolicyFor(fullMethod string) (MethodPolicy, bool) {
+	if !strings.HasPrefix(fullMethod, bookingServicePrefix) {
+		return MethodPolicy{}, false
+	}
+	policy, ok := methodPolicies[strings.TrimPrefix(fullMethod, bookingServicePrefix)]
+	return policy, ok
+}
+
+// AuthorizeCall checks a call against its method's policy, returning a gRPC
+// error if it's not allowed. Methods of other services (health checks,
+// reflection) only need the caller to be signed in, which the auth
+// interceptor already ensures.
+func AuthorizeCall(ctx context.Context, fullMethod string, server interface{}, req interface{}) error {
+	if !strings.HasPrefix(fullMethod, bookingServicePrefix) {
+		return nil
+	}
+
+	policy, ok := PolicyFor(fullMethod)
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "no authorization policy for %s", fullMethod)
+	}
+	if policy.Public {
+		return nil
+	}
+
+	if _, err := GetUserIDFromContext(ctx); err != nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if len(policy.Roles) > 0 && !IsAdmin(ctx) {
+		allowed := false
+		for _, role := range policy.Roles {
+			if HasRole(ctx, role) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return status.Errorf(codes.PermissionDenied, "role %s required", policy.Roles[0])
+		}
+	}
+
+	if policy.Permission != "" {
+		if err := Authorize(ctx, policy.Permission); err != nil {
+			return err
+		}
+	}
+
+	if policy.Ownership {
+		checker, ok := server.(OwnershipChecker)
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "no ownership check for %s", fullMethod)
+		}
+		if err := checker.CheckOwnership(ctx, fullMethod, req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AuthorizeInterceptor enforces method policies. It must run after the
+// Authenticator's interceptor, which puts the caller's claims in the context.
+func AuthorizeInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := AuthorizeCall(ctx, info.FullMethod, info.Server, req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamAuthorizeInterceptor is the streaming counterpart of AuthorizeInterceptor.
+// Ownership can't be checked before the first message, so streaming methods
+// must not require it.
+func StreamAuthorizeInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := AuthorizeCall(ss.Context(), info.FullMethod, srv, nil); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
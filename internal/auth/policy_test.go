@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeOwnershipChecker struct {
+	err error
+}
+
+func (f *fakeOwnershipChecker) CheckOwnership(ctx context.Context, fullMethod string, req interface{}) error {
+	return f.err
+}
+
+func contextWithRoles(userID string, roles ...Role) context.Context {
+	claims := &Claims{Roles: roles}
+	claims.Subject = userID
+	return WithClaims(context.Background(), claims)
+}
+
+func TestAuthorizeCall(t *testing.T) {
+	denied := status.Errorf(codes.PermissionDenied, "not yours")
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		server interface{}
+		want   codes.Code
+	}{
+		{"other services pass", context.Background(), "/grpc.health.v1.Health/Check", nil, codes.OK},
+		{"methods without a policy are denied", contextWithRoles("admin1", RoleAdmin), "/booking.BookingService/Unknown", nil, codes.PermissionDenied},
+		{"public method", context.Background(), "/booking.BookingService/SubmitSurveyResponse", nil, codes.OK},
+		{"signed in method needs claims", context.Background(), "/booking.BookingService/ListBookings", nil, codes.Unauthenticated},
+		{"signed in method", contextWithRoles("user1"), "/booking.BookingService/ListBookings", nil, codes.OK},
+		{"barber method as customer", contextWithRoles("user1"), "/booking.BookingService/GetDailyAgenda", nil, codes.PermissionDenied},
+		{"barber method as barber", contextWithRoles("barber1", RoleBarber), "/booking.BookingService/GetDailyAgenda", nil, codes.OK},
+		{"barber method as admin", contextWithRoles("admin1", RoleAdmin), "/booking.BookingService/GetDailyAgenda", nil, codes.OK},
+		{"permission missing", contextWithRoles("barber1", RoleBarber), "/booking.BookingService/DeleteBooking", nil, codes.PermissionDenied},
+		{"permission granted", contextWithRoles("admin1", RoleAdmin), "/booking.BookingService/DeleteBooking", nil, codes.OK},
+		{"ownership without a checker", contextWithRoles("barber1", RoleBarber), "/booking.BookingService/ConfirmBooking", nil, codes.PermissionDenied},
+		{"ownership refused", contextWithRoles("barber1", RoleBarber), "/booking.BookingService/ConfirmBooking", &fakeOwnershipChecker{err: denied}, codes.PermissionDenied},
+		{"ownership approved", contextWithRoles("barber1", RoleBarber), "/booking.BookingService/ConfirmBooking", &fakeOwnershipChecker{}, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AuthorizeCall(tt.ctx, tt.method, tt.server, nil)
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
+
+func TestIsPublicMethod(t *testing.T) {
+	assert.True(t, isPublicMethod("/grpc.health.v1.Health/Check"))
+	assert.True(t, isPublicMethod("/grpc.health.v1.Health/Watch"))
+	assert.True(t, isPublicMethod("/booking.BookingService/SubmitSurveyResponse"))
+	assert.True(t, isPublicMethod("/booking.BookingService/ListBarbers"))
+	assert.False(t, isPublicMethod("/booking.BookingService/GetBooking"))
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Role is what a user may act as
+type Role string
+
+// Constants for Role
+const (
+	RoleCustomer Role = "customer"
+	RoleBarber   Role = "barber"
+	RoleAdmin    Role = "admin"
+)
+
+// Permission is an action that not every user may take
+type Permission string
+
+// Constants for Permission
+const (
+	PermViewCustomerStats Permission = "customers:view_stats"
+	PermListAllBookings   Permission = "bookings:list_all"
+	PermDeleteBooking     Permission = "bookings:delete"
+	PermManageCatalog     Permission = "catalog:manage"
+	PermManageBarbers     Permission = "barbers:manage"
+	PermManageHolidays    Permission = "holidays:manage"
+	PermManageSettings    Permission = "settings:manage"
+	PermManagePayroll     Permission = "payroll:manage"
+	PermViewRevenue       Permission = "revenue:view"
+	PermViewAuditLog      Permission = "audit:view"
+	PermManageWebhooks    Permission = "webhooks:manage"
+	PermManageUserData    Permission = "users:manage_data"
+	PermManagePromoCodes  Permission = "promo_codes:manage"
+)
+
+// rolePermissions lists what each role may do beyond what every customer can.
+// Admins may do everything.
+var rolePermissions = map[Role][]Permission{
+	RoleBarber: {
+		PermViewCustomerStats,
+	},
+}
+
+// HasRole reports whether the claims grant a role. Every signed-in user is a
+// customer, and tokens without roles fall back to the is_barber and
+// is_admin flags.
+func (c *Claims) HasRole(role Role) bool {
+	if role == RoleCustomer {
+		return true
+	}
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	switch role {
+	case RoleBarber:
+		return c.IsBarber
+	case RoleAdmin:
+		return c.IsAdmin
+	default:
+		return false
+	}
+}
+
+// Can reports whether the claims grant a permission
+func (c *Claims) Can(permission Permission) bool {
+	if c.HasRole(RoleAdmin) {
+		return true
+	}
+	for role, permissions := range rolePermissions {
+		if !c.HasRole(role) {
+			continue
+		}
+		for _, p := range permissions {
+			if p == permission {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasRole checks if the user in the context has a role
+func HasRole(ctx context.Context, role Role) bool {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	return claims.HasRole(role)
+}
+
+// Can checks if the user in the context has a permission
+func Can(ctx context.Context, permission Permission) bool {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	return claims.Can(permission)
+}
+
+// Authorize returns a gRPC error unless the user in the context is signed in
+// and has a permission
+func Authorize(ctx context.Context, permission Permission) error {
+	if _, err := GetUserIDFromContext(ctx); err != nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if !Can(ctx, permission) {
+		return status.Errorf(codes.PermissionDenied, "permission %s required", permission)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClaims_HasRole(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims Claims
+		role   Role
+		want   bool
+	}{
+		{"everyone is a customer", Claims{}, RoleCustomer, true},
+		{"barber role", Claims{Roles: []Role{RoleBarber}}, RoleBarber, true},
+		{"legacy barber flag", Claims{IsBarber: true}, RoleBarber, true},
+		{"legacy admin flag", Claims{IsAdmin: true}, RoleAdmin, true},
+		{"barber isn't admin", Claims{Roles: []Role{RoleBarber}}, RoleAdmin, false},
+		{"customer isn't barber", Claims{Roles: []Role{RoleCustomer}}, RoleBarber, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.claims.HasRole(tt.role))
+		})
+	}
+}
+
+func TestClaims_Can(t *testing.T) {
+	barber := Claims{Roles: []Role{RoleBarber}}
+	assert.True(t, barber.Can(PermViewCustomerStats))
+	assert.False(t, barber.Can(PermManageCatalog))
+
+	admin := Claims{Roles: []Role{RoleAdmin}}
+	assert.True(t, admin.Can(PermManageCatalog))
+	assert.True(t, admin.Can(PermDeleteBooking))
+
+	customer := Claims{}
+	assert.False(t, customer.Can(PermViewCustomerStats))
+}
+
+func TestAuthorize(t *testing.T) {
+	err := Authorize(context.Background(), PermManageCatalog)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	claims := &Claims{Roles: []Role{RoleBarber}}
+	claims.Subject = "barber1"
+	ctx := WithClaims(context.Background(), claims)
+
+	assert.NoError(t, Authorize(ctx, PermViewCustomerStats))
+	assert.Equal(t, codes.PermissionDenied, status.Code(Authorize(ctx, PermManageCatalog)))
+}
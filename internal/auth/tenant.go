@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+var (
+	// ErrTenantMismatch is returned when a caller asks for another tenant
+	// than the one their token belongs to
+	ErrTenantMismatch = errors.New("token belongs to another tenant")
+	// ErrInvalidTenant is returned for a malformed tenant ID
+	ErrInvalidTenant = errors.New("invalid tenant ID")
+	// ErrUnknownTenant is returned when a caller without a token names a
+	// tenant that doesn't exist
+	ErrUnknownTenant = errors.New("unknown tenant")
+)
+
+// TenantGuard scopes every call to a tenant: the one in the caller's token,
+// or for anonymous calls to public methods, the one they name in the
+// x-tenant-id metadata. Callers can't reach another tenant's data, whatever
+// their role, since each tenant's storage is separate.
+type TenantGuard struct {
+	// Enabled turns multi-tenancy on. Otherwise every call is the default
+	// tenant's and tenant claims are ignored.
+	Enabled bool
+	// Prepare, if set, readies a tenant's storage before its first call,
+	// e.g. by creating its indexes
+	Prepare func(ctx context.Context) error
+	// Known, if set, reports whether a tenant exists. Callers without a
+	// token may only name tenants it knows, so they can't create new ones;
+	// without it, they only reach the default tenant.
+	Known func(ctx context.Context, tenantID string) (bool, error)
+}
+
+// Scope returns a copy of ctx scoped to the caller's tenant. requested is the
+// tenant the caller named, if any; it must agree with their token.
+func (g *TenantGuard) Scope(ctx context.Context, requested string) (context.Context, error) {
+	if g == nil || !g.Enabled {
+		return ctx, nil
+	}
+
+	tenantID := requested
+	claims, signedIn := ClaimsFromContext(ctx)
+	if signedIn {
+		if requested != "" && requested != claims.TenantID {
+			return nil, ErrTenantMismatch
+		}
+		tenantID = claims.TenantID
+	}
+	if tenantID != tenant.Default && !tenant.Valid(tenantID) {
+		return nil, ErrInvalidTenant
+	}
+
+	// Tokens are issued for real tenants, but anyone can name one
+	if !signedIn && tenantID != tenant.Default {
+		known := false
+		if g.Known != nil {
+			var err error
+			if known, err = g.Known(ctx, tenantID); err != nil {
+				return nil, err
+			}
+		}
+		if !known {
+			return nil, ErrUnknownTenant
+		}
+	}
+
+	ctx = tenant.WithID(ctx, tenantID)
+	if g.Prepare != nil {
+		if err := g.Prepare(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if tenantID != tenant.Default {
+		zerolog.Ctx(ctx).UpdateContext(func(c zerolog.Context) zerolog.Context {
+			return c.Str("tenant", tenantID)
+		})
+	}
+	return ctx, nil
+}
+
+// Unary is a gRPC interceptor scoping calls to the caller's tenant. It must
+// run after the Authenticator's interceptor, which puts the caller's claims
+// in the context.
+func (g *TenantGuard) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !strings.HasPrefix(info.FullMethod, bookingServicePrefix) {
+		return handler(ctx, req)
+	}
+
+	newCtx, err := g.scopeCall(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(newCtx, req)
+}
+
+// Stream is the streaming counterpart of Unary
+func (g *TenantGuard) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !strings.HasPrefix(info.FullMethod, bookingServicePrefix) {
+		return handler(srv, ss)
+	}
+
+	newCtx, err := g.scopeCall(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: newCtx})
+}
+
+// scopeCall scopes a gRPC call to its tenant, returning a gRPC error if it
+// can't be
+func (g *TenantGuard) scopeCall(ctx context.Context) (context.Context, error) {
+	var requested string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(tenant.MetadataKey); len(values) > 0 {
+			requested = values[0]
+		}
+	}
+
+	newCtx, err := g.Scope(ctx, requested)
+	switch {
+	case errors.Is(err, ErrTenantMismatch):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrInvalidTenant):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrUnknownTenant):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		log.Error().Err(err).Msg("Failed to prepare tenant")
+		return nil, status.Error(codes.Unavailable, "tenant is unavailable")
+	}
+	return newCtx, nil
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+func TestTenantGuard_Scope(t *testing.T) {
+	withTenant := func(tenantID string) context.Context {
+		claims := &Claims{TenantID: tenantID}
+		claims.Subject = "user1"
+		return WithClaims(context.Background(), claims)
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		requested string
+		want      string
+		wantErr   error
+	}{
+		{"token's tenant", withTenant("acme"), "", "acme", nil},
+		{"token's tenant requested", withTenant("acme"), "acme", "acme", nil},
+		{"another tenant requested", withTenant("acme"), "globex", "", ErrTenantMismatch},
+		{"default tenant's token", withTenant(""), "globex", "", ErrTenantMismatch},
+		{"anonymous", context.Background(), "globex", "globex", nil},
+		{"anonymous naming an unknown tenant", context.Background(), "initech", "", ErrUnknownTenant},
+		{"anonymous without tenant", context.Background(), "", tenant.Default, nil},
+		{"malformed tenant", context.Background(), "Globex Inc", "", ErrInvalidTenant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var prepared []string
+			guard := &TenantGuard{
+				Enabled: true,
+				Prepare: func(ctx context.Context) error {
+					prepared = append(prepared, tenant.FromContext(ctx))
+					return nil
+				},
+				// Only globex exists; acme's tokens still reach it
+				Known: func(ctx context.Context, tenantID string) (bool, error) {
+					return tenantID == "globex", nil
+				},
+			}
+
+			ctx, err := guard.Scope(tt.ctx, tt.requested)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, prepared)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tenant.FromContext(ctx))
+			assert.Equal(t, []string{tt.want}, prepared)
+		})
+	}
+}
+
+func TestTenantGuard_Disabled(t *testing.T) {
+	claims := &Claims{TenantID: "acme"}
+	ctx, err := (&TenantGuard{}).Scope(WithClaims(context.Background(), claims), "globex")
+	require.NoError(t, err)
+	assert.Equal(t, tenant.Default, tenant.FromContext(ctx))
+}
+
+func TestTenantGuard_Unary(t *testing.T) {
+	guard := &TenantGuard{Enabled: true}
+	claims := &Claims{TenantID: "acme"}
+	ctx := metadata.NewIncomingContext(WithClaims(context.Background(), claims), metadata.Pairs(tenant.MetadataKey, "globex"))
+
+	call := func(method string) (string, error) {
+		var got string
+		_, err := guard.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			got = tenant.FromContext(ctx)
+			return nil, nil
+		})
+		return got, err
+	}
+
+	_, err := call("/booking.BookingService/ListBookings")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Health checks aren't any tenant's
+	_, err = call("/grpc.health.v1.Health/Check")
+	assert.NoError(t, err)
+
+	// Callers without a token can't name tenants that don't exist
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(tenant.MetadataKey, "globex"))
+	_, err = call("/booking.BookingService/ListBarbers")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	guard.Prepare = func(ctx context.Context) error { return errors.New("no database") }
+	ctx = WithClaims(context.Background(), claims)
+	_, err = call("/booking.BookingService/ListBookings")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
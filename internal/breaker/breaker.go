@@ -0,0 +1,186 @@
+// Package breaker stops calling a dependency that keeps failing for a while,
+// failing calls fast instead of letting each one wait to time out, and lets
+// a single call through now and then to see whether it has recovered.
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ita-av/booking-service/internal/clock"
+)
+
+// ErrOpen is returned for calls refused while the breaker is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is where a breaker is in its cycle
+type State int
+
+const (
+	// Closed lets every call through
+	Closed State = iota
+	// HalfOpen lets one trial call through at a time, closing again once one
+	// succeeds
+	HalfOpen
+	// Open refuses every call until the cooldown has passed
+	Open
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case HalfOpen:
+		return "half-open"
+	case Open:
+		return "open"
+	}
+	return "unknown"
+}
+
+// Config is a breaker's settings
+type Config struct {
+	// Threshold is how many calls in a row must fail to open the breaker;
+	// zero never opens it
+	Threshold int
+	// Cooldown is how long the breaker stays open before letting a trial
+	// call through
+	Cooldown time.Duration
+	// OnStateChange, if set, is called with each new state, e.g. to export
+	// it as a metric. It's called with the breaker locked, so it mustn't
+	// call back into it.
+	OnStateChange func(State)
+}
+
+// Breaker is a circuit breaker. It's safe for concurrent use.
+type Breaker struct {
+	config Config
+	clock  clock.Clock
+
+	mu       sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// New creates a closed breaker, reading the time from clk
+func New(config Config, clk clock.Clock) *Breaker {
+	b := &Breaker{config: config, clock: clk}
+	if config.OnStateChange != nil {
+		config.OnStateChange(Closed)
+	}
+	return b
+}
+
+// Allow reports whether a call made for ctx may go ahead, returning ErrOpen
+// if not. Each allowed call must be followed by Success, Failure or Ignore.
+func (b *Breaker) Allow(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+	if err := b.allow(); err != nil {
+		if refused, ok := ctx.Value(refusedKey{}).(*atomic.Bool); ok {
+			refused.Store(true)
+		}
+		return err
+	}
+	return nil
+}
+
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		if b.clock.Now().Sub(b.openedAt) < b.config.Cooldown {
+			return ErrOpen
+		}
+		b.setState(HalfOpen)
+		b.trial = true
+	case HalfOpen:
+		if b.trial {
+			// Another call is already finding out whether it has recovered
+			return ErrOpen
+		}
+		b.trial = true
+	}
+	return nil
+}
+
+// Success records that an allowed call succeeded, closing the breaker
+func (b *Breaker) Success() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+	b.trial = false
+	if b.state != Closed {
+		b.setState(Closed)
+	}
+}
+
+// Failure records that an allowed call failed because the dependency is
+// unavailable, opening the breaker once enough have failed in a row or if
+// the call was a trial
+func (b *Breaker) Failure() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.trial = false
+	if b.state == HalfOpen || (b.config.Threshold > 0 && b.failures >= b.config.Threshold) {
+		b.openedAt = b.clock.Now()
+		if b.state != Open {
+			b.setState(Open)
+		}
+	}
+}
+
+// Ignore records that an allowed call ended without showing whether the
+// dependency works, e.g. because its caller gave up
+func (b *Breaker) Ignore() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trial = false
+}
+
+// State returns the breaker's state. An open breaker whose cooldown has
+// passed is still open until a call is allowed through.
+func (b *Breaker) State() State {
+	if b == nil {
+		return Closed
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Check is a health check failing while the breaker is open
+func (b *Breaker) Check(ctx context.Context) error {
+	if b.State() == Open {
+		return ErrOpen
+	}
+	return nil
+}
+
+// setState moves the breaker to state. It must be called with b.mu held.
+func (b *Breaker) setState(state State) {
+	b.state = state
+	if b.config.OnStateChange != nil {
+		b.config.OnStateChange(state)
+	}
+}
@@ -0,0 +1,105 @@
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/clock"
+)
+
+func TestBreaker(t *testing.T) {
+	clk := clock.NewFake(time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC))
+	var states []State
+	b := New(Config{Threshold: 3, Cooldown: 10 * time.Second, OnStateChange: func(s State) {
+		states = append(states, s)
+	}}, clk)
+	ctx := context.Background()
+
+	// Failures only open it when they come in a row
+	for _, failed := range []bool{true, true, false, true, true} {
+		require.NoError(t, b.Allow(ctx))
+		if failed {
+			b.Failure()
+		} else {
+			b.Success()
+		}
+	}
+	assert.Equal(t, Closed, b.State())
+
+	require.NoError(t, b.Allow(ctx))
+	b.Failure()
+	assert.Equal(t, Open, b.State())
+	assert.ErrorIs(t, b.Allow(ctx), ErrOpen)
+	assert.ErrorIs(t, b.Check(ctx), ErrOpen)
+
+	// After the cooldown one trial goes through at a time
+	clk.Advance(10 * time.Second)
+	require.NoError(t, b.Allow(ctx))
+	assert.Equal(t, HalfOpen, b.State())
+	assert.ErrorIs(t, b.Allow(ctx), ErrOpen)
+
+	// A failed trial opens it for another cooldown
+	b.Failure()
+	assert.Equal(t, Open, b.State())
+	clk.Advance(5 * time.Second)
+	assert.ErrorIs(t, b.Allow(ctx), ErrOpen)
+
+	// A trial that tells nothing lets the next call try
+	clk.Advance(5 * time.Second)
+	require.NoError(t, b.Allow(ctx))
+	b.Ignore()
+	require.NoError(t, b.Allow(ctx))
+	b.Success()
+	assert.Equal(t, Closed, b.State())
+	assert.NoError(t, b.Check(ctx))
+
+	assert.Equal(t, []State{Closed, Open, HalfOpen, Open, HalfOpen, Closed}, states)
+}
+
+func TestBreaker_NoThreshold(t *testing.T) {
+	b := New(Config{}, clock.System)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, b.Allow(context.Background()))
+		b.Failure()
+	}
+	assert.Equal(t, Closed, b.State())
+
+	// A nil breaker lets everything through
+	var none *Breaker
+	assert.NoError(t, none.Allow(context.Background()))
+	none.Failure()
+	assert.Equal(t, Closed, none.State())
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	clk := clock.NewFake(time.Now())
+	b := New(Config{Threshold: 1, Cooldown: time.Minute}, clk)
+	require.NoError(t, b.Allow(context.Background()))
+	b.Failure()
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/GetBooking"}
+	refusing := func(ctx context.Context, req interface{}) (interface{}, error) {
+		if err := b.Allow(ctx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to retrieve booking: %v", err)
+		}
+		return nil, nil
+	}
+	_, err := UnaryInterceptor(context.Background(), nil, info, refusing)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "circuit breaker is open")
+
+	// Other errors are left alone
+	failing := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Internal, errors.New("decode failed").Error())
+	}
+	_, err = UnaryInterceptor(context.Background(), nil, info, failing)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
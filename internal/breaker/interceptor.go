@@ -0,0 +1,52 @@
+package breaker
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// refusedKey is the context key of the flag breakers raise when they refuse
+// one of an RPC's calls
+type refusedKey struct{}
+
+// UnaryInterceptor fails RPCs with Unavailable when a breaker refused one of
+// their calls, rather than with the Internal status handlers give errors
+// they don't expect, so clients know to back off and retry
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	refused := new(atomic.Bool)
+	resp, err := handler(context.WithValue(ctx, refusedKey{}, refused), req)
+	return resp, unavailable(err, refused)
+}
+
+// StreamInterceptor is the streaming counterpart of UnaryInterceptor
+func StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	refused := new(atomic.Bool)
+	err := handler(srv, &refusedStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), refusedKey{}, refused)})
+	return unavailable(err, refused)
+}
+
+// unavailable turns err into Unavailable if the call was refused and failed
+// with an unexpected error
+func unavailable(err error, refused *atomic.Bool) error {
+	if err == nil || !refused.Load() {
+		return err
+	}
+	if st := status.Convert(err); st.Code() == codes.Internal || st.Code() == codes.Unknown {
+		return status.Error(codes.Unavailable, st.Message())
+	}
+	return err
+}
+
+// refusedStream is a server stream carrying the refused flag in its context
+type refusedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *refusedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+var _ service.HeatmapCache = (*RedisHeatmapCache)(nil)
+
+// heatmapTTL is how long heatmaps are kept. Their keys name the day they
+// cover up to, so the next day's are worked out afresh anyway.
+const heatmapTTL = 24 * time.Hour
+
+// RedisHeatmapCache implements service.HeatmapCache with Redis
+type RedisHeatmapCache struct {
+	client *redis.Client
+}
+
+// NewRedisHeatmapCache connects to the Redis server at url, e.g.
+// redis://localhost:6379/0
+func NewRedisHeatmapCache(url string) (*RedisHeatmapCache, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid Redis URL")
+	}
+
+	return &RedisHeatmapCache{client: redis.NewClient(opts)}, nil
+}
+
+// Close closes the connections to Redis
+func (c *RedisHeatmapCache) Close() error {
+	return c.client.Close()
+}
+
+// GetHeatmap returns a cached heatmap, if there is one
+func (c *RedisHeatmapCache) GetHeatmap(ctx context.Context, key string) (*model.DemandHeatmap, bool, error) {
+	value, err := c.client.Get(ctx, heatmapKey(ctx, key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, errors.Wrap(err, "failed to get cached heatmap")
+	}
+
+	var heatmap model.DemandHeatmap
+	if err := json.Unmarshal(value, &heatmap); err != nil {
+		return nil, false, errors.Wrap(err, "failed to decode cached heatmap")
+	}
+
+	return &heatmap, true, nil
+}
+
+// SetHeatmap caches a heatmap for a day
+func (c *RedisHeatmapCache) SetHeatmap(ctx context.Context, key string, heatmap *model.DemandHeatmap) error {
+	value, err := json.Marshal(heatmap)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode heatmap")
+	}
+
+	if err := c.client.Set(ctx, heatmapKey(ctx, key), value, heatmapTTL).Err(); err != nil {
+		return errors.Wrap(err, "failed to cache heatmap")
+	}
+
+	return nil
+}
+
+// heatmapKey names a heatmap in the tenant ctx is scoped to
+func heatmapKey(ctx context.Context, key string) string {
+	return "heatmap:" + tenant.FromContext(ctx) + ":" + key
+}
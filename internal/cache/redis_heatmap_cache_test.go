@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+func TestRedisHeatmapCache(t *testing.T) {
+	server := miniredis.RunT(t)
+	c, err := NewRedisHeatmapCache("redis://" + server.Addr())
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	ctx := context.Background()
+
+	_, ok, err := c.GetHeatmap(ctx, "2025-06-02:UTC:12:")
+	require.NoError(t, err)
+	assert.False(t, ok, "nothing cached yet")
+
+	heatmap := &model.DemandHeatmap{Weeks: 12, Timezone: "UTC"}
+	heatmap.Counts[time.Saturday][10] = 7
+	require.NoError(t, c.SetHeatmap(ctx, "2025-06-02:UTC:12:", heatmap))
+
+	got, ok, err := c.GetHeatmap(ctx, "2025-06-02:UTC:12:")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, 7, got.Counts[time.Saturday][10])
+
+	// Other tenants have their own
+	_, ok, err = c.GetHeatmap(tenant.WithID(ctx, "acme"), "2025-06-02:UTC:12:")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// Heatmaps are kept for a day
+	server.FastForward(24 * time.Hour)
+	_, ok, err = c.GetHeatmap(ctx, "2025-06-02:UTC:12:")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
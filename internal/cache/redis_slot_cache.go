@@ -0,0 +1,127 @@
+// Package cache keeps computed results in Redis so replicas of the service
+// share them
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+var _ service.SlotCache = (*RedisSlotCache)(nil)
+
+// cachedSlots is a cached day's slots for one variant, with when they were
+// worked out
+type cachedSlots struct {
+	CachedAt time.Time         `json:"cachedAt"`
+	Slots    []*model.TimeSlot `json:"slots"`
+}
+
+// RedisSlotCache implements service.SlotCache with Redis. Each barber's day
+// is a hash of its variants, so invalidating the day drops them all at once.
+type RedisSlotCache struct {
+	client *redis.Client
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewRedisSlotCache connects to the Redis server at url, e.g.
+// redis://localhost:6379/0, keeping slots for ttl
+func NewRedisSlotCache(url string, ttl time.Duration) (*RedisSlotCache, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid Redis URL")
+	}
+
+	return &RedisSlotCache{
+		client: redis.NewClient(opts),
+		ttl:    ttl,
+		now:    time.Now,
+	}, nil
+}
+
+// Ping checks that Redis answers
+func (c *RedisSlotCache) Ping(ctx context.Context) error {
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		return errors.Wrap(err, "failed to ping Redis")
+	}
+	return nil
+}
+
+// Close closes the connections to Redis
+func (c *RedisSlotCache) Close() error {
+	return c.client.Close()
+}
+
+// GetSlots returns a barber's cached slots for a day, if they were cached
+// less than the TTL ago
+func (c *RedisSlotCache) GetSlots(ctx context.Context, barberID, day, variant string) ([]*model.TimeSlot, bool, error) {
+	value, err := c.client.HGet(ctx, slotsKey(ctx, barberID, day), variant).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, errors.Wrap(err, "failed to get cached slots")
+	}
+
+	var cached cachedSlots
+	if err := json.Unmarshal(value, &cached); err != nil {
+		return nil, false, errors.Wrap(err, "failed to decode cached slots")
+	}
+
+	// The hash expires with its newest variant, so older ones are checked here
+	if c.now().Sub(cached.CachedAt) >= c.ttl {
+		return nil, false, nil
+	}
+
+	return cached.Slots, true, nil
+}
+
+// SetSlots caches a barber's slots for a day
+func (c *RedisSlotCache) SetSlots(ctx context.Context, barberID, day, variant string, slots []*model.TimeSlot) error {
+	value, err := json.Marshal(cachedSlots{CachedAt: c.now(), Slots: slots})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode slots")
+	}
+
+	key := slotsKey(ctx, barberID, day)
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, key, variant, value)
+	pipe.Expire(ctx, key, c.ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to cache slots")
+	}
+
+	return nil
+}
+
+// InvalidateSlots drops a barber's cached slots for the days
+func (c *RedisSlotCache) InvalidateSlots(ctx context.Context, barberID string, days []string) error {
+	if len(days) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(days))
+	for i, day := range days {
+		keys[i] = slotsKey(ctx, barberID, day)
+	}
+
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		return errors.Wrap(err, "failed to invalidate cached slots")
+	}
+
+	return nil
+}
+
+// slotsKey names the hash holding a barber's slots for a day in the tenant
+// ctx is scoped to
+func slotsKey(ctx context.Context, barberID, day string) string {
+	return "slots:" + tenant.FromContext(ctx) + ":" + barberID + ":" + day
+}
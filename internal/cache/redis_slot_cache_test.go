@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+func newTestCache(t *testing.T) (*RedisSlotCache, *time.Time) {
+	t.Helper()
+	server := miniredis.RunT(t)
+
+	c, err := NewRedisSlotCache("redis://"+server.Addr(), 30*time.Second)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+
+	now := time.Date(2025, time.March, 31, 9, 0, 0, 0, time.UTC)
+	c.now = func() time.Time { return now }
+	return c, &now
+}
+
+func TestRedisSlotCache_SetGetInvalidate(t *testing.T) {
+	c, _ := newTestCache(t)
+	ctx := context.Background()
+	start := time.Date(2025, time.March, 31, 10, 0, 0, 0, time.UTC)
+	slots := []*model.TimeSlot{{StartTime: start, EndTime: start.Add(30 * time.Minute)}}
+
+	_, ok, err := c.GetSlots(ctx, "barber1", "2025-03-31", "UTC|0")
+	require.NoError(t, err)
+	assert.False(t, ok, "nothing cached yet")
+
+	require.NoError(t, c.SetSlots(ctx, "barber1", "2025-03-31", "UTC|0", slots))
+	require.NoError(t, c.SetSlots(ctx, "barber1", "2025-03-31", "UTC|1", nil))
+
+	got, ok, err := c.GetSlots(ctx, "barber1", "2025-03-31", "UTC|0")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, got, 1)
+	assert.True(t, got[0].StartTime.Equal(start))
+
+	got, ok, err = c.GetSlots(ctx, "barber1", "2025-03-31", "UTC|1")
+	require.NoError(t, err)
+	assert.True(t, ok, "a day without slots is cached too")
+	assert.Empty(t, got)
+
+	// Other tenants don't see the entry
+	_, ok, err = c.GetSlots(tenant.WithID(ctx, "acme"), "barber1", "2025-03-31", "UTC|0")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, c.InvalidateSlots(ctx, "barber1", []string{"2025-03-30", "2025-03-31"}))
+	for _, variant := range []string{"UTC|0", "UTC|1"} {
+		_, ok, err = c.GetSlots(ctx, "barber1", "2025-03-31", variant)
+		require.NoError(t, err)
+		assert.False(t, ok, "every variant of the day is dropped")
+	}
+}
+
+func TestRedisSlotCache_Expires(t *testing.T) {
+	c, now := newTestCache(t)
+	ctx := context.Background()
+
+	require.NoError(t, c.SetSlots(ctx, "barber1", "2025-03-31", "UTC|0", nil))
+	*now = now.Add(20 * time.Second)
+	require.NoError(t, c.SetSlots(ctx, "barber1", "2025-03-31", "UTC|1", nil))
+	*now = now.Add(15 * time.Second)
+
+	_, ok, err := c.GetSlots(ctx, "barber1", "2025-03-31", "UTC|0")
+	require.NoError(t, err)
+	assert.False(t, ok, "expired although the day's hash was written since")
+
+	_, ok, err = c.GetSlots(ctx, "barber1", "2025-03-31", "UTC|1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
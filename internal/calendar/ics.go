@@ -0,0 +1,264 @@
+// Package calendar renders bookings as iCalendar (RFC 5545) files that
+// customers can add to any calendar app
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ContentType is the media type of an iCalendar file
+const ContentType = "text/calendar; charset=utf-8"
+
+// prodID identifies this service as the calendar's producer
+const prodID = "-//ita-av//booking-service//EN"
+
+// Options describe the shop bookings are at
+type Options struct {
+	// Domain makes event UIDs globally unique, e.g. "bookings.example.com"
+	Domain string
+	// ShopName titles events, e.g. "Haircut at Barbershop"
+	ShopName string
+	// Organizer is the shop's email address; events have no organizer when
+	// it's empty
+	Organizer string
+}
+
+// Filename is the name to offer a booking's calendar file under
+func Filename(booking *model.Booking) string {
+	return "booking-" + booking.ID.Hex() + ".ics"
+}
+
+// Render builds the calendar file for a booking, with times in loc. The
+// event's UID is stable and its SEQUENCE is the booking's version, so a
+// calendar app that imports the file again after a reschedule updates the
+// event it already has, and removes it once the booking is cancelled.
+func Render(booking *model.Booking, loc *time.Location, opts Options) []byte {
+	if loc == nil {
+		loc = time.UTC
+	}
+	w := &writer{}
+
+	cancelled := booking.Status == model.BookingStatusCancelled
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + prodID)
+	w.line("CALSCALE:GREGORIAN")
+	if cancelled {
+		w.line("METHOD:CANCEL")
+	} else {
+		w.line("METHOD:PUBLISH")
+	}
+
+	if loc != time.UTC {
+		writeTimezone(w, loc, booking.StartTime, booking.EndTime)
+	}
+
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + booking.ID.Hex() + "@" + opts.Domain)
+	w.line("SEQUENCE:" + strconv.FormatInt(booking.Version, 10))
+	w.line("DTSTAMP:" + utcStamp(booking.UpdatedAt))
+	if !booking.CreatedAt.IsZero() {
+		w.line("CREATED:" + utcStamp(booking.CreatedAt))
+	}
+	if !booking.UpdatedAt.IsZero() {
+		w.line("LAST-MODIFIED:" + utcStamp(booking.UpdatedAt))
+	}
+	w.line(dateTime("DTSTART", booking.StartTime, loc))
+	w.line(dateTime("DTEND", booking.EndTime, loc))
+
+	summary := capitalize(model.DescribeServices(booking.Services()))
+	if opts.ShopName != "" {
+		summary += " at " + opts.ShopName
+	}
+	w.line("SUMMARY:" + escape(summary))
+	if opts.ShopName != "" {
+		w.line("LOCATION:" + escape(opts.ShopName))
+	}
+	if booking.Notes != "" {
+		w.line("DESCRIPTION:" + escape(booking.Notes))
+	}
+	if opts.Organizer != "" {
+		organizer := "ORGANIZER"
+		if opts.ShopName != "" {
+			organizer += ";CN=" + paramValue(opts.ShopName)
+		}
+		w.line(organizer + ":mailto:" + opts.Organizer)
+	}
+	w.line("STATUS:" + eventStatus(booking.Status))
+	w.line("TRANSP:OPAQUE")
+	w.line("END:VEVENT")
+
+	w.line("END:VCALENDAR")
+	return w.bytes()
+}
+
+// eventStatus maps a booking status to a VEVENT STATUS
+func eventStatus(status model.BookingStatus) string {
+	switch status {
+	case model.BookingStatusPending:
+		return "TENTATIVE"
+	case model.BookingStatusCancelled:
+		return "CANCELLED"
+	default:
+		return "CONFIRMED"
+	}
+}
+
+// dateTime formats a DATE-TIME property, in UTC form when loc is UTC and
+// with a TZID referencing the calendar's VTIMEZONE otherwise
+func dateTime(name string, t time.Time, loc *time.Location) string {
+	if loc == time.UTC {
+		return name + ":" + utcStamp(t)
+	}
+	return name + ";TZID=" + paramValue(loc.String()) + ":" + t.In(loc).Format("20060102T150405")
+}
+
+// utcStamp formats t as a UTC DATE-TIME
+func utcStamp(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// writeTimezone writes a VTIMEZONE describing loc for the year around an
+// event, so calendar apps don't need to know the zone's rules themselves
+func writeTimezone(w *writer, loc *time.Location, start, end time.Time) {
+	from := time.Date(start.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+	until := time.Date(end.In(loc).Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+
+	// The observance in force at the start of the year, from an onset well
+	// before any event
+	name, offset := from.Zone()
+	writeObservance(w, from.IsDST(), name, offset, offset, "19700101T000000")
+
+	for _, t := range transitions(from, until) {
+		_, before := t.Add(-time.Second).Zone()
+		name, after := t.Zone()
+		// The onset is the local time just before the transition
+		onset := t.In(time.FixedZone("", before)).Format("20060102T150405")
+		writeObservance(w, t.IsDST(), name, before, after, onset)
+	}
+
+	w.line("END:VTIMEZONE")
+}
+
+// writeObservance writes a STANDARD or DAYLIGHT component of a VTIMEZONE
+func writeObservance(w *writer, dst bool, name string, from, to int, onset string) {
+	kind := "STANDARD"
+	if dst {
+		kind = "DAYLIGHT"
+	}
+	w.line("BEGIN:" + kind)
+	w.line("DTSTART:" + onset)
+	w.line("TZOFFSETFROM:" + formatOffset(from))
+	w.line("TZOFFSETTO:" + formatOffset(to))
+	if name != "" {
+		w.line("TZNAME:" + escape(name))
+	}
+	w.line("END:" + kind)
+}
+
+// transitions returns the instants in [from, until) at which the UTC offset
+// of from's location changes
+func transitions(from, until time.Time) []time.Time {
+	var found []time.Time
+	_, offset := from.Zone()
+	for day := from; day.Before(until); {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.Zone(); nextOffset != offset {
+			// Narrow the change down to the second it happens
+			lo, hi := day, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if _, midOffset := mid.Zone(); midOffset == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			found = append(found, hi.Truncate(time.Second))
+			offset = nextOffset
+		}
+		day = next
+	}
+	return found
+}
+
+// formatOffset formats a UTC offset in seconds as e.g. "+0200"
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign, seconds = '-', -seconds
+	}
+	out := fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds%3600/60)
+	if rest := seconds % 60; rest != 0 {
+		out += fmt.Sprintf("%02d", rest)
+	}
+	return out
+}
+
+// escape escapes a TEXT value
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// paramValue quotes a parameter value when it contains characters that
+// would otherwise end it
+func paramValue(s string) string {
+	s = strings.ReplaceAll(s, `"`, "'")
+	if strings.ContainsAny(s, ":;,") {
+		return `"` + s + `"`
+	}
+	return s
+}
+
+// capitalize upper-cases the first letter of s
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// writer accumulates content lines, folding them at 75 octets and ending
+// them with CRLF as RFC 5545 requires
+type writer struct {
+	b strings.Builder
+}
+
+// maxLineOctets is the longest a content line may be, excluding the CRLF
+const maxLineOctets = 75
+
+func (w *writer) line(s string) {
+	limit := maxLineOctets
+	for len(s) > limit {
+		// Don't split a multi-byte UTF-8 character
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.b.WriteString(s[:cut])
+		w.b.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space, which counts
+		limit = maxLineOctets - 1
+	}
+	w.b.WriteString(s)
+	w.b.WriteString("\r\n")
+}
+
+func (w *writer) bytes() []byte {
+	return []byte(w.b.String())
+}
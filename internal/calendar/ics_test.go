@@ -0,0 +1,97 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+func testBooking(t *testing.T) *model.Booking {
+	t.Helper()
+	id, err := primitive.ObjectIDFromHex("65a1b2c3d4e5f60718293a4b")
+	require.NoError(t, err)
+	start := time.Date(2025, time.March, 31, 9, 0, 0, 0, time.UTC)
+	return &model.Booking{
+		ID:           id,
+		UserID:       "user-1",
+		BarberID:     "barber-1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim},
+		StartTime:    start,
+		EndTime:      start.Add(45 * time.Minute),
+		Status:       model.BookingStatusConfirmed,
+		Version:      1,
+		CreatedAt:    start.Add(-48 * time.Hour),
+		UpdatedAt:    start.Add(-24 * time.Hour),
+	}
+}
+
+// unfold joins folded lines and splits the file into content lines
+func unfold(t *testing.T, ics []byte) []string {
+	t.Helper()
+	for _, line := range strings.Split(strings.TrimSuffix(string(ics), "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineOctets, "line too long: %q", line)
+	}
+	return strings.Split(strings.ReplaceAll(string(ics), "\r\n ", ""), "\r\n")
+}
+
+func TestRender_UsesBarberTimeZone(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	lines := unfold(t, Render(testBooking(t), berlin, Options{Domain: "bookings.example.com", ShopName: "Barbershop"}))
+
+	assert.Contains(t, lines, "METHOD:PUBLISH")
+	assert.Contains(t, lines, "UID:65a1b2c3d4e5f60718293a4b@bookings.example.com")
+	assert.Contains(t, lines, "SEQUENCE:1")
+	assert.Contains(t, lines, "STATUS:CONFIRMED")
+	assert.Contains(t, lines, "SUMMARY:Haircut + beard trim at Barbershop")
+	// 09:00 UTC is 11:00 in Berlin after the clocks went forward on 30 March
+	assert.Contains(t, lines, "DTSTART;TZID=Europe/Berlin:20250331T110000")
+	assert.Contains(t, lines, "DTEND;TZID=Europe/Berlin:20250331T114500")
+
+	text := strings.Join(lines, "\n")
+	assert.Contains(t, text, "BEGIN:DAYLIGHT\nDTSTART:20250330T020000\nTZOFFSETFROM:+0100\nTZOFFSETTO:+0200\nTZNAME:CEST\nEND:DAYLIGHT")
+	assert.Contains(t, text, "BEGIN:STANDARD\nDTSTART:20251026T030000\nTZOFFSETFROM:+0200\nTZOFFSETTO:+0100\nTZNAME:CET\nEND:STANDARD")
+}
+
+func TestRender_UTC(t *testing.T) {
+	lines := unfold(t, Render(testBooking(t), time.UTC, Options{Domain: "example.com"}))
+
+	assert.Contains(t, lines, "DTSTART:20250331T090000Z")
+	assert.Contains(t, lines, "SUMMARY:Haircut + beard trim")
+	assert.NotContains(t, lines, "BEGIN:VTIMEZONE")
+}
+
+func TestRender_RescheduledAndCancelled(t *testing.T) {
+	booking := testBooking(t)
+	booking.StartTime = booking.StartTime.Add(24 * time.Hour)
+	booking.EndTime = booking.EndTime.Add(24 * time.Hour)
+	booking.Version = 2
+
+	lines := unfold(t, Render(booking, time.UTC, Options{Domain: "example.com"}))
+	assert.Contains(t, lines, "SEQUENCE:2")
+	assert.Contains(t, lines, "DTSTART:20250401T090000Z")
+
+	booking.Status = model.BookingStatusCancelled
+	booking.Version = 3
+	lines = unfold(t, Render(booking, time.UTC, Options{Domain: "example.com"}))
+	assert.Contains(t, lines, "METHOD:CANCEL")
+	assert.Contains(t, lines, "SEQUENCE:3")
+	assert.Contains(t, lines, "STATUS:CANCELLED")
+}
+
+func TestRender_EscapesAndFoldsText(t *testing.T) {
+	booking := testBooking(t)
+	booking.Notes = "Short on the sides, please; keep the fringe.\nThanks — " + strings.Repeat("é", 60)
+
+	lines := unfold(t, Render(booking, time.UTC, Options{Domain: "example.com", ShopName: "Cut, Shave & Co", Organizer: "bookings@example.com"}))
+
+	assert.Contains(t, lines, `DESCRIPTION:Short on the sides\, please\; keep the fringe.\nThanks — `+strings.Repeat("é", 60))
+	assert.Contains(t, lines, `ORGANIZER;CN="Cut, Shave & Co":mailto:bookings@example.com`)
+}
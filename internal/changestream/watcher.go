@@ -0,0 +1,328 @@
+// Package changestream turns the changes MongoDB records for the bookings
+// collection into booking events, so that every replica's watchers see
+// every change, whichever replica made it.
+package changestream
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// checkpointInterval is how often the resume token is saved while changes
+// keep coming
+const checkpointInterval = 5 * time.Second
+
+// Retry delays after the change stream fails
+const (
+	minRetryDelay = time.Second
+	maxRetryDelay = 30 * time.Second
+)
+
+// changeStreamHistoryLost is the error code of a resume token too old for
+// the oplog
+const changeStreamHistoryLost = 286
+
+// Broadcaster delivers booking events to the watchers of this instance
+type Broadcaster interface {
+	Broadcast(event service.BookingEvent)
+}
+
+// change is the part of a change event the watcher reads
+type change struct {
+	ID                bson.Raw            `bson:"_id"`
+	OperationType     string              `bson:"operationType"`
+	ClusterTime       primitive.Timestamp `bson:"clusterTime"`
+	FullDocument      *model.Booking      `bson:"fullDocument"`
+	UpdateDescription struct {
+		UpdatedFields bson.Raw `bson:"updatedFields"`
+	} `bson:"updateDescription"`
+	Namespace struct {
+		DB string `bson:"db"`
+	} `bson:"ns"`
+}
+
+// checkpoint stores how far a watcher has read the change stream
+type checkpoint struct {
+	ID        string    `bson:"_id"`
+	Token     bson.Raw  `bson:"token"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+// Watcher follows the bookings collection's change stream and broadcasts a
+// booking event for every insert and update, other than bookkeeping such as
+// marking a reminder sent. It saves its resume token under its name, so
+// after a restart it carries on where it stopped.
+type Watcher struct {
+	bookings    *mongo.Collection
+	checkpoints *mongo.Collection
+	name        string
+	broadcaster Broadcaster
+	tenants     bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewWatcher creates a watcher checkpointing under name, which must be
+// unique to the instance
+func NewWatcher(db *mongo.Database, name string, broadcaster Broadcaster) *Watcher {
+	return &Watcher{
+		bookings:    db.Collection("bookings"),
+		checkpoints: db.Collection("change_stream_checkpoints"),
+		name:        name,
+		broadcaster: broadcaster,
+	}
+}
+
+// WatchTenants makes the watcher follow every tenant's bookings, not only the
+// default tenant's. Tenants have a database each, so this takes a change
+// stream over the whole deployment, which the database user must be allowed
+// to open. Call it before Start.
+func (w *Watcher) WatchTenants() {
+	w.tenants = true
+}
+
+// Start watches the change stream in the background until ctx is done or
+// Stop is called. Failures are logged and the stream is reopened.
+func (w *Watcher) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.loop(ctx)
+	}()
+}
+
+// Stop stops watching, saving the resume token
+func (w *Watcher) Stop() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+}
+
+func (w *Watcher) loop(ctx context.Context) {
+	delay := minRetryDelay
+	for {
+		started := time.Now()
+		err := w.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error().Err(err).Str("watcher", w.name).Msg("Change stream failed")
+
+		// A stream that ran for a while starts over with a short delay
+		if time.Since(started) > maxRetryDelay {
+			delay = minRetryDelay
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// watch reads the change stream from the saved resume token until it fails
+// or ctx is done
+func (w *Watcher) watch(ctx context.Context) error {
+	token, err := w.loadToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	stream, err := w.open(ctx, token)
+	var cmdErr mongo.CommandError
+	if token != nil && errors.As(err, &cmdErr) && cmdErr.Code == changeStreamHistoryLost {
+		log.Warn().Str("watcher", w.name).Msg("Change stream resume point is gone, changes since were missed")
+		stream, err = w.open(ctx, nil)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to open change stream")
+	}
+	defer stream.Close(context.Background())
+
+	lastSaved := time.Now()
+	defer func() {
+		// Save how far the stream got, even when stopping
+		if err := w.saveToken(context.Background(), stream.ResumeToken()); err != nil {
+			log.Error().Err(err).Str("watcher", w.name).Msg("Failed to save change stream checkpoint")
+		}
+	}()
+
+	for stream.Next(ctx) {
+		var c change
+		if err := stream.Decode(&c); err != nil {
+			return errors.Wrap(err, "failed to decode change")
+		}
+		if event, ok := eventFor(c); ok {
+			event.Tenant = w.tenantOf(c.Namespace.DB)
+			w.broadcaster.Broadcast(event)
+		}
+
+		if time.Since(lastSaved) >= checkpointInterval {
+			if err := w.saveToken(ctx, stream.ResumeToken()); err != nil {
+				return err
+			}
+			lastSaved = time.Now()
+		}
+	}
+
+	return stream.Err()
+}
+
+// open opens the change stream after token, or from now if it's nil
+func (w *Watcher) open(ctx context.Context, token bson.Raw) (*mongo.ChangeStream, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"operationType": bson.M{"$in": bson.A{"insert", "update", "replace"}}}}},
+	}
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if token != nil {
+		opts.SetResumeAfter(token)
+	}
+
+	if !w.tenants {
+		return w.bookings.Watch(ctx, pipeline, opts)
+	}
+
+	// Every tenant's bookings collection, and no other
+	base := regexp.QuoteMeta(w.bookings.Database().Name())
+	pipeline = append(mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"ns.db":   bson.M{"$regex": "^" + base + "(_[a-z0-9-]+)?$"},
+			"ns.coll": w.bookings.Name(),
+		}}},
+	}, pipeline...)
+	return w.bookings.Database().Client().Watch(ctx, pipeline, opts)
+}
+
+// tenantOf returns the tenant whose database is named db
+func (w *Watcher) tenantOf(db string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(db, w.bookings.Database().Name()), "_")
+}
+
+// loadToken returns the saved resume token, or nil if there's none
+func (w *Watcher) loadToken(ctx context.Context) (bson.Raw, error) {
+	var cp checkpoint
+	err := w.checkpoints.FindOne(ctx, bson.M{"_id": w.name}).Decode(&cp)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to load change stream checkpoint")
+	}
+
+	return cp.Token, nil
+}
+
+// saveToken saves the resume token
+func (w *Watcher) saveToken(ctx context.Context, token bson.Raw) error {
+	if token == nil {
+		return nil
+	}
+
+	_, err := w.checkpoints.ReplaceOne(ctx,
+		bson.M{"_id": w.name},
+		checkpoint{ID: w.name, Token: token, UpdatedAt: time.Now()},
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to save change stream checkpoint")
+	}
+
+	return nil
+}
+
+// eventFor returns the booking event of a change. Events get their ID from
+// the change, so every replica broadcasts a change under the same ID.
+func eventFor(c change) (service.BookingEvent, bool) {
+	if c.FullDocument == nil {
+		// Deleted before the update could be looked up
+		return service.BookingEvent{}, false
+	}
+	if c.FullDocument.DeletedAt != nil {
+		// Soft-deleted bookings are gone as far as watchers are concerned
+		return service.BookingEvent{}, false
+	}
+
+	event := service.BookingEvent{
+		ID:         changeID(c.ID),
+		Type:       service.BookingUpdated,
+		Booking:    c.FullDocument,
+		OccurredAt: time.Unix(int64(c.ClusterTime.T), 0).UTC(),
+	}
+
+	switch c.OperationType {
+	case "insert":
+		event.Type = service.BookingCreated
+	case "update":
+		updated := c.UpdateDescription.UpdatedFields
+		if onlyBookkeeping(updated) {
+			return service.BookingEvent{}, false
+		}
+		switch {
+		case hasField(updated, "status"):
+			switch c.FullDocument.Status {
+			case model.BookingStatusCancelled:
+				event.Type = service.BookingCancelled
+			case model.BookingStatusConfirmed:
+				event.Type = service.BookingConfirmed
+			}
+		case hasField(updated, "rescheduledAt"):
+			event.Type = service.BookingRescheduled
+		}
+	}
+
+	return event, true
+}
+
+// bookkeepingFields are written by the service's background work without
+// changing the booking, e.g. marking its reminder sent
+var bookkeepingFields = map[string]bool{
+	"reminderSentAt": true,
+}
+
+// onlyBookkeeping reports whether an update only touched bookkeeping fields
+func onlyBookkeeping(updated bson.Raw) bool {
+	elements, err := updated.Elements()
+	if err != nil || len(elements) == 0 {
+		return false
+	}
+	for _, element := range elements {
+		if !bookkeepingFields[element.Key()] {
+			return false
+		}
+	}
+	return true
+}
+
+// hasField reports whether a document has a field
+func hasField(doc bson.Raw, key string) bool {
+	_, err := doc.LookupErr(key)
+	return err == nil
+}
+
+// changeID derives an event ID from a change's resume token
+func changeID(token bson.Raw) string {
+	sum := sha256.Sum256(token)
+	return hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,91 @@
+package changestream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// updateOf returns an update change setting fields on booking
+func updateOf(t *testing.T, booking *model.Booking, fields bson.M) change {
+	t.Helper()
+	updated, err := bson.Marshal(fields)
+	require.NoError(t, err)
+
+	c := change{OperationType: "update", FullDocument: booking}
+	c.UpdateDescription.UpdatedFields = updated
+	return c
+}
+
+func TestEventFor(t *testing.T) {
+	confirmed := &model.Booking{ID: primitive.NewObjectID(), Status: model.BookingStatusConfirmed}
+	cancelled := &model.Booking{ID: primitive.NewObjectID(), Status: model.BookingStatusCancelled}
+
+	tests := []struct {
+		name   string
+		change change
+		want   service.BookingEventType
+	}{
+		{"insert", change{OperationType: "insert", FullDocument: confirmed}, service.BookingCreated},
+		{"confirmation", updateOf(t, confirmed, bson.M{"status": model.BookingStatusConfirmed, "version": 2}), service.BookingConfirmed},
+		{"cancellation", updateOf(t, cancelled, bson.M{"status": model.BookingStatusCancelled}), service.BookingCancelled},
+		{"reschedule", updateOf(t, confirmed, bson.M{"startTime": time.Now(), "rescheduledAt": time.Now()}), service.BookingRescheduled},
+		{"other update", updateOf(t, confirmed, bson.M{"notes": "fade"}), service.BookingUpdated},
+		{"replace", change{OperationType: "replace", FullDocument: confirmed}, service.BookingUpdated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, ok := eventFor(tt.change)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, event.Type)
+			assert.Equal(t, tt.change.FullDocument, event.Booking)
+		})
+	}
+
+	// Bookings deleted before the lookup have no event
+	_, ok := eventFor(change{OperationType: "update"})
+	assert.False(t, ok)
+
+	// Neither have reminders being marked sent
+	_, ok = eventFor(updateOf(t, confirmed, bson.M{"reminderSentAt": time.Now()}))
+	assert.False(t, ok)
+
+	// Or soft deletions
+	deletedAt := time.Now()
+	deleted := &model.Booking{ID: primitive.NewObjectID(), Status: model.BookingStatusConfirmed, DeletedAt: &deletedAt}
+	_, ok = eventFor(updateOf(t, deleted, bson.M{"deletedAt": deletedAt, "version": 3}))
+	assert.False(t, ok)
+}
+
+func TestChangeID(t *testing.T) {
+	token, err := bson.Marshal(bson.M{"_data": "8265A1B2C3000000012B022C0100296E5A1004"})
+	require.NoError(t, err)
+	other, err := bson.Marshal(bson.M{"_data": "8265A1B2C3000000022B022C0100296E5A1004"})
+	require.NoError(t, err)
+
+	// Every replica derives the same ID for a change
+	assert.Equal(t, changeID(token), changeID(token))
+	assert.NotEqual(t, changeID(token), changeID(other))
+	assert.Len(t, changeID(token), 32)
+}
+
+func TestWatcher_TenantOf(t *testing.T) {
+	// The client connects lazily, so none of this reaches a server
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI("mongodb://localhost:27017"))
+	require.NoError(t, err)
+	defer client.Disconnect(context.Background())
+
+	w := NewWatcher(client.Database("booking"), "test", nil)
+	assert.Equal(t, "", w.tenantOf("booking"))
+	assert.Equal(t, "acme", w.tenantOf("booking_acme"))
+}
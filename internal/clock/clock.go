@@ -0,0 +1,21 @@
+// Package clock lets code read the current time from a clock it's given, so
+// tests can control what time it is.
+package clock
+
+import "time"
+
+// Clock tells the current time
+type Clock interface {
+	Now() time.Time
+}
+
+// Func adapts a function to a Clock
+type Func func() time.Time
+
+// Now calls f
+func (f Func) Now() time.Time {
+	return f()
+}
+
+// System is the system's clock
+var System Clock = Func(time.Now)
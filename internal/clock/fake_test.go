@@ -0,0 +1,23 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2025, time.June, 2, 9, 0, 0, 0, time.UTC)
+	fake := NewFake(start)
+
+	assert.Equal(t, start, fake.Now())
+	assert.Equal(t, start, fake.Now(), "the clock only moves when told to")
+
+	fake.Advance(90 * time.Minute)
+	assert.Equal(t, start.Add(90*time.Minute), fake.Now())
+
+	later := time.Date(2025, time.June, 3, 12, 0, 0, 0, time.UTC)
+	fake.Set(later)
+	assert.Equal(t, later, fake.Now())
+}
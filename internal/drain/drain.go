@@ -0,0 +1,148 @@
+// Package drain lets the server finish the calls it's serving when it shuts
+// down, while turning away new bookings and ending open streams, so clients
+// retry on another replica instead of waiting on this one.
+package drain
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Tracker counts the calls in flight and, once draining, refuses calls to
+// the methods it was given and ends streams
+type Tracker struct {
+	refused  map[string]bool
+	inFlight atomic.Int64
+
+	drainOnce sync.Once
+	draining  chan struct{}
+}
+
+// NewTracker creates a tracker refusing the methods, by name (e.g.
+// "CreateBooking"), while draining
+func NewTracker(refused ...string) *Tracker {
+	t := &Tracker{
+		refused:  make(map[string]bool, len(refused)),
+		draining: make(chan struct{}),
+	}
+	for _, method := range refused {
+		t.refused[method] = true
+	}
+	return t
+}
+
+// Drain starts draining: new calls to the refused methods fail with
+// Unavailable, and open streams are ended with it. Calls already running
+// carry on.
+func (t *Tracker) Drain() {
+	t.drainOnce.Do(func() { close(t.draining) })
+}
+
+// Draining reports whether Drain has been called
+func (t *Tracker) Draining() bool {
+	select {
+	case <-t.draining:
+		return true
+	default:
+		return false
+	}
+}
+
+// InFlight returns how many calls are running
+func (t *Tracker) InFlight() int64 {
+	return t.inFlight.Load()
+}
+
+// Unary counts the call while it runs, refusing it if the server is draining
+// and its method is refused
+func (t *Tracker) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := t.admit(info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	t.inFlight.Add(1)
+	defer t.inFlight.Add(-1)
+	return handler(ctx, req)
+}
+
+// Stream is the streaming counterpart of Unary. Streams are ended when the
+// server starts draining, as they'd otherwise keep it from stopping.
+func (t *Tracker) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := t.admit(info.FullMethod); err != nil {
+		return err
+	}
+
+	t.inFlight.Add(1)
+	defer t.inFlight.Add(-1)
+
+	ctx, cancel := context.WithCancel(ss.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-t.draining:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err := handler(srv, &drainedStream{ServerStream: ss, ctx: ctx})
+	if t.Draining() && ss.Context().Err() == nil {
+		// The stream ended for the drain, not because the client left
+		return shuttingDown()
+	}
+	return err
+}
+
+// GracefulStop drains and stops server once its calls in flight finish, or
+// when ctx ends, cutting off the calls still running then. Unlike
+// server.GracefulStop, it can't be held up forever by a stuck call.
+func (t *Tracker) GracefulStop(ctx context.Context, server *grpc.Server) {
+	t.Drain()
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Warn().Int64("inFlight", t.InFlight()).Msg("Calls didn't finish in time, cutting them off")
+		// Stop cancels the calls' contexts and closes their connections
+		server.Stop()
+	}
+}
+
+// admit returns the status to refuse a call with, if any
+func (t *Tracker) admit(fullMethod string) error {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if t.refused[method] && t.Draining() {
+		return shuttingDown()
+	}
+	return nil
+}
+
+// shuttingDown is the status of calls turned away while draining.
+// Unavailable tells clients the call is safe to retry elsewhere.
+func shuttingDown() error {
+	return status.Error(codes.Unavailable, "server is shutting down, retry the call")
+}
+
+// drainedStream is a server stream whose context ends when the server
+// starts draining
+type drainedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *drainedStream) Context() context.Context {
+	return s.ctx
+}
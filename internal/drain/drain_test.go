@@ -0,0 +1,150 @@
+package drain
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeServerStream is a server stream that only carries a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// call makes a unary call to method through the tracker
+func call(t *Tracker, method string, handler grpc.UnaryHandler) error {
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/" + method}
+	_, err := t.Unary(context.Background(), nil, info, handler)
+	return err
+}
+
+func ok(ctx context.Context, req interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+func TestTracker_Unary(t *testing.T) {
+	tracker := NewTracker("CreateBooking")
+
+	// Calls in flight are counted
+	require.NoError(t, call(tracker, "GetBooking", func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, int64(1), tracker.InFlight())
+		return nil, nil
+	}))
+	assert.Equal(t, int64(0), tracker.InFlight())
+	require.NoError(t, call(tracker, "CreateBooking", ok))
+
+	tracker.Drain()
+	assert.True(t, tracker.Draining())
+
+	assert.Equal(t, codes.Unavailable, status.Code(call(tracker, "CreateBooking", ok)))
+	assert.NoError(t, call(tracker, "GetBooking", ok))
+	assert.Equal(t, int64(0), tracker.InFlight())
+}
+
+func TestTracker_Stream(t *testing.T) {
+	tracker := NewTracker()
+	info := &grpc.StreamServerInfo{FullMethod: "/booking.BookingService/WatchBookings"}
+	started := make(chan struct{})
+	result := make(chan error, 1)
+
+	// The stream runs until its context ends, as WatchBookings does
+	go func() {
+		result <- tracker.Stream(nil, &fakeServerStream{ctx: context.Background()}, info, func(srv interface{}, ss grpc.ServerStream) error {
+			close(started)
+			<-ss.Context().Done()
+			return nil
+		})
+	}()
+	<-started
+	assert.Equal(t, int64(1), tracker.InFlight())
+
+	tracker.Drain()
+	select {
+	case err := <-result:
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stream wasn't ended")
+	}
+	assert.Equal(t, int64(0), tracker.InFlight())
+}
+
+func TestTracker_StreamClosedByClient(t *testing.T) {
+	tracker := NewTracker()
+	info := &grpc.StreamServerInfo{FullMethod: "/booking.BookingService/WatchBookings"}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := tracker.Stream(nil, &fakeServerStream{ctx: ctx}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		<-ss.Context().Done()
+		return ss.Context().Err()
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// stuckHealthServer is a health service whose checks only finish when
+// they're cancelled
+type stuckHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	called chan struct{}
+}
+
+func (s *stuckHealthServer) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	close(s.called)
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestTracker_GracefulStop(t *testing.T) {
+	tracker := NewTracker()
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(tracker.Unary))
+	health := &stuckHealthServer{called: make(chan struct{})}
+	healthpb.RegisterHealthServer(server, health)
+	go server.Serve(lis)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet", grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		result <- err
+	}()
+	<-health.called
+
+	// The stuck call would keep GracefulStop waiting forever
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	stopped := make(chan struct{})
+	go func() {
+		tracker.GracefulStop(ctx, server)
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the server didn't stop")
+	}
+	assert.Equal(t, codes.Unavailable, status.Code(<-result))
+}
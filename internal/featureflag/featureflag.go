@@ -0,0 +1,152 @@
+// Package featureflag decides whether risky behaviors are on for a request,
+// so they can be rolled out one environment or shop at a time and turned off
+// again without a deploy.
+package featureflag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// Target is who a flag is decided for
+type Target struct {
+	TenantID string
+	UserID   string
+}
+
+// Provider decides flags. It mirrors the LaunchDarkly server SDK's
+// BoolVariation, so a client wrapped to take a Target can stand in for the
+// configured flags. Unknown flags are defaultValue.
+type Provider interface {
+	BoolVariation(key string, target Target, defaultValue bool) (bool, error)
+}
+
+// Flags decides flags for requests. A nil Flags leaves every flag at its
+// default.
+type Flags struct {
+	provider Provider
+}
+
+// New returns Flags decided by the provider
+func New(provider Provider) *Flags {
+	return &Flags{provider: provider}
+}
+
+// Enabled reports whether the flag is on for the tenant and user of ctx. A
+// failing provider is only logged, and the flag left at defaultValue.
+func (f *Flags) Enabled(ctx context.Context, key string, defaultValue bool) bool {
+	if f == nil || f.provider == nil {
+		return defaultValue
+	}
+
+	target := Target{TenantID: tenant.FromContext(ctx)}
+	target.UserID, _ = auth.GetUserIDFromContext(ctx)
+	enabled, err := f.provider.BoolVariation(key, target, defaultValue)
+	if err != nil {
+		log.Warn().Err(err).Str("flag", key).Msg("Failed to decide feature flag, using its default")
+		return defaultValue
+	}
+	return enabled
+}
+
+// rule is when a configured flag is on
+type rule struct {
+	enabled bool
+	// tenants, if set, are the only tenants the flag is on for
+	tenants map[string]bool
+}
+
+// Static is a Provider deciding flags from configuration. Its spec is a
+// comma-separated list of flags, each either a name, which is on, name=on or
+// name=off (or true and false), or name=tenant-a|tenant-b, which is on only
+// for those tenants, e.g. "slot-cache=off,deposits=shop-a|shop-b".
+type Static struct {
+	rules atomic.Pointer[map[string]rule]
+}
+
+// NewStatic returns the flags described by spec, see Static
+func NewStatic(spec string) (*Static, error) {
+	s := &Static{}
+	if err := s.Update(spec); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Update replaces the flags with those described by spec, see Static. An
+// invalid spec leaves them as they were.
+func (s *Static) Update(spec string) error {
+	rules, err := parse(spec)
+	if err != nil {
+		return err
+	}
+	s.rules.Store(&rules)
+	return nil
+}
+
+// BoolVariation implements Provider
+func (s *Static) BoolVariation(key string, target Target, defaultValue bool) (bool, error) {
+	rules := s.rules.Load()
+	if rules == nil {
+		return defaultValue, nil
+	}
+	r, ok := (*rules)[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	if r.tenants != nil {
+		return r.tenants[target.TenantID], nil
+	}
+	return r.enabled, nil
+}
+
+// Validate checks that spec describes flags, see Static
+func Validate(spec string) error {
+	_, err := parse(spec)
+	return err
+}
+
+// parse reads the flags a spec describes
+func parse(spec string) (map[string]rule, error) {
+	rules := make(map[string]rule)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(entry, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if name == "" {
+			return nil, fmt.Errorf("invalid feature flag %q: missing name", entry)
+		}
+		if _, ok := rules[name]; ok {
+			return nil, fmt.Errorf("feature flag %q is set twice", name)
+		}
+
+		switch {
+		case !hasValue || value == "on" || value == "true":
+			rules[name] = rule{enabled: true}
+		case value == "off" || value == "false":
+			rules[name] = rule{}
+		default:
+			tenants := make(map[string]bool)
+			for _, id := range strings.Split(value, "|") {
+				id = strings.TrimSpace(id)
+				if !tenant.Valid(id) {
+					return nil, fmt.Errorf("invalid feature flag %q: %q is neither on, off nor a tenant ID", entry, id)
+				}
+				tenants[id] = true
+			}
+			rules[name] = rule{tenants: tenants}
+		}
+	}
+	return rules, nil
+}
@@ -0,0 +1,98 @@
+package featureflag
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+func TestStatic(t *testing.T) {
+	flags, err := NewStatic("on-flag, off-flag=off, explicit=on, boolean=true, pilot=shop-a|shop-b")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		key          string
+		tenantID     string
+		defaultValue bool
+		want         bool
+	}{
+		{key: "on-flag", want: true},
+		{key: "explicit", want: true},
+		{key: "boolean", want: true},
+		{key: "off-flag", defaultValue: true, want: false},
+		{key: "pilot", tenantID: "shop-a", want: true},
+		{key: "pilot", tenantID: "shop-c", defaultValue: true, want: false},
+		{key: "pilot", tenantID: tenant.Default, defaultValue: true, want: false},
+		{key: "unknown", defaultValue: true, want: true},
+		{key: "unknown", want: false},
+	} {
+		got, err := flags.BoolVariation(tc.key, Target{TenantID: tc.tenantID}, tc.defaultValue)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got, "%s for tenant %q", tc.key, tc.tenantID)
+	}
+}
+
+func TestStatic_Update(t *testing.T) {
+	flags, err := NewStatic("slot-cache=off")
+	require.NoError(t, err)
+
+	require.NoError(t, flags.Update("slot-cache"))
+	enabled, _ := flags.BoolVariation("slot-cache", Target{}, false)
+	assert.True(t, enabled)
+
+	// An invalid spec keeps the flags as they were
+	assert.Error(t, flags.Update("slot-cache=Shop A"))
+	enabled, _ = flags.BoolVariation("slot-cache", Target{}, false)
+	assert.True(t, enabled)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(""))
+	assert.NoError(t, Validate("deposits=off,slot-cache"))
+
+	for _, spec := range []string{
+		"=on",
+		"deposits,deposits=off",
+		"deposits=Off",
+		"deposits=shop-a|",
+	} {
+		assert.Error(t, Validate(spec), spec)
+	}
+}
+
+// failingProvider is a Provider that can't decide any flag
+type failingProvider struct{}
+
+func (failingProvider) BoolVariation(string, Target, bool) (bool, error) {
+	return false, errors.New("provider unavailable")
+}
+
+// recordingProvider is a Provider reporting every flag on, and remembering
+// the target it was last asked about
+type recordingProvider struct {
+	target Target
+}
+
+func (p *recordingProvider) BoolVariation(_ string, target Target, _ bool) (bool, error) {
+	p.target = target
+	return true, nil
+}
+
+func TestFlags_Enabled(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), "shop-a")
+
+	provider := &recordingProvider{}
+	assert.True(t, New(provider).Enabled(ctx, "deposits", false))
+	assert.Equal(t, Target{TenantID: "shop-a"}, provider.target)
+
+	// Flags stay at their defaults without a working provider
+	var none *Flags
+	assert.True(t, none.Enabled(ctx, "deposits", true))
+	assert.True(t, New(failingProvider{}).Enabled(ctx, "deposits", true))
+	assert.False(t, New(failingProvider{}).Enabled(ctx, "deposits", false))
+}
@@ -0,0 +1,61 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// Error codes reported in the "code" extension of GraphQL errors. They
+// follow the gRPC status codes the same failures map to.
+const (
+	codeUnauthenticated    = "UNAUTHENTICATED"
+	codePermissionDenied   = "PERMISSION_DENIED"
+	codeNotFound           = "NOT_FOUND"
+	codeInvalidArgument    = "INVALID_ARGUMENT"
+	codeAlreadyExists      = "ALREADY_EXISTS"
+	codeFailedPrecondition = "FAILED_PRECONDITION"
+	codeInternal           = "INTERNAL"
+)
+
+// resolverError is a resolver failure with a code clients can branch on
+type resolverError struct {
+	code    string
+	message string
+}
+
+// newError creates a resolver error with a formatted message
+func newError(code, format string, args ...interface{}) *resolverError {
+	return &resolverError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func (e *resolverError) Error() string {
+	return e.message
+}
+
+// Extensions adds the code to the error in the response
+func (e *resolverError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// serviceError maps a booking service error to a resolver error, as the gRPC
+// handlers map it to a status; other errors say which action failed
+func serviceError(err error, action string) error {
+	switch {
+	case errors.Is(err, service.ErrBookingNotFound):
+		return newError(codeNotFound, "booking not found")
+	case errors.Is(err, service.ErrIdempotencyKeyReused), errors.Is(err, service.ErrStartTimeInPast),
+		errors.Is(err, service.ErrNoServices), errors.Is(err, service.ErrDateInPast):
+		return newError(codeInvalidArgument, "%v", err)
+	case errors.Is(err, service.ErrDuplicateBooking), errors.Is(err, service.ErrExternalRefConflict):
+		return newError(codeAlreadyExists, "%v", err)
+	case errors.Is(err, service.ErrSlotUnavailable), errors.Is(err, service.ErrDuringBreak), errors.Is(err, service.ErrShopClosed),
+		errors.Is(err, service.ErrBookingTooSoon), errors.Is(err, service.ErrBookingTooFarAhead), errors.Is(err, service.ErrServiceNotOffered),
+		errors.Is(err, service.ErrInvalidStatusTransition), errors.Is(err, service.ErrCancellationNotAllowed),
+		errors.Is(err, service.ErrBarberInactive):
+		return newError(codeFailedPrecondition, "%v", err)
+	}
+	return newError(codeInternal, "failed to %s: %v", action, err)
+}
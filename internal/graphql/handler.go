@@ -0,0 +1,98 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// maxRequestSize bounds the size of a GraphQL request body
+const maxRequestSize = 1 << 20
+
+// Handler serves GraphQL queries over HTTP. Every request needs a bearer
+// token from the user service, as gRPC calls do, and is scoped to the
+// token's tenant.
+type Handler struct {
+	schema        *gql.Schema
+	authenticator *auth.Authenticator
+	tenants       *auth.TenantGuard
+}
+
+// NewHandler creates a handler executing queries against schema
+func NewHandler(schema *gql.Schema, authenticator *auth.Authenticator, tenants *auth.TenantGuard) *Handler {
+	return &Handler{
+		schema:        schema,
+		authenticator: authenticator,
+		tenants:       tenants,
+	}
+}
+
+// request is a GraphQL request body
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// ServeHTTP authenticates the caller and executes their query. Errors in the
+// query itself are reported in the response body, with a 200 status.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "missing bearer token", http.StatusUnauthorized)
+		return
+	}
+	claims, err := h.authenticator.VerifyToken(r.Context(), token)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var req request
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestSize)).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	ctx, err := h.tenants.Scope(auth.WithClaims(r.Context(), claims), r.Header.Get(tenant.MetadataKey))
+	switch {
+	case errors.Is(err, auth.ErrTenantMismatch):
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case errors.Is(err, auth.ErrInvalidTenant):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		log.Error().Err(err).Msg("Failed to prepare tenant")
+		http.Error(w, "tenant is unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to encode GraphQL response")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
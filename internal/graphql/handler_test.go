@@ -0,0 +1,95 @@
+package graphql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/auth"
+)
+
+func TestHandler(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(auth.JWTConfig{Secret: []byte("secret")})
+	require.NoError(t, err)
+	schema, err := NewSchema(newFakeService())
+	require.NoError(t, err)
+	handler := NewHandler(schema, authenticator, &auth.TenantGuard{})
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "alice"},
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	serve := func(method, authorization, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/graphql", strings.NewReader(body))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	query := `{"query":"{ bookings { userId } }"}`
+
+	t.Run("executes the query as the caller", func(t *testing.T) {
+		rec := serve(http.MethodPost, "Bearer "+token, query)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		assert.JSONEq(t, `{"data":{"bookings":[{"userId":"alice"}]}}`, rec.Body.String())
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		rec := serve(http.MethodPost, "", query)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		rec := serve(http.MethodPost, "Bearer not-a-token", query)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		rec := serve(http.MethodPost, "Bearer "+token, "query")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("only POST is allowed", func(t *testing.T) {
+		rec := serve(http.MethodGet, "Bearer "+token, "")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestHandler_RejectsOtherTenants(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(auth.JWTConfig{Secret: []byte("secret")})
+	require.NoError(t, err)
+	schema, err := NewSchema(newFakeService())
+	require.NoError(t, err)
+	handler := NewHandler(schema, authenticator, &auth.TenantGuard{Enabled: true})
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.Claims{
+		TenantID:         "acme",
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "alice"},
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+
+	serve := func(tenantID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":"{ bookings { userId } }"}`))
+		req.Header.Set("Authorization", "Bearer "+token)
+		if tenantID != "" {
+			req.Header.Set("X-Tenant-ID", tenantID)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, serve("").Code)
+	assert.Equal(t, http.StatusOK, serve("acme").Code)
+	assert.Equal(t, http.StatusForbidden, serve("globex").Code)
+}
@@ -0,0 +1,285 @@
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"time"
+
+	gql "github.com/graph-gophers/graphql-go"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// maxCancellationReasonLength bounds the reason given for a cancellation, as
+// in the gRPC API
+const maxCancellationReasonLength = 500
+
+// maxQueryDepth bounds how deeply queries may nest, e.g. booking { barber {
+// bookings { barber ... } } }, so one request can't fan out without limit
+const maxQueryDepth = 6
+
+//go:embed schema.graphql
+var schemaSource string
+
+// Resolver resolves the root queries and mutations. The gRPC interceptors
+// don't run for GraphQL requests, so resolvers check the caller themselves,
+// with the same rules as the gRPC handlers.
+type Resolver struct {
+	service service.BookingServiceInterface
+}
+
+// NewSchema parses the schema with resolvers backed by the booking service
+func NewSchema(service service.BookingServiceInterface) (*gql.Schema, error) {
+	return gql.ParseSchema(schemaSource, &Resolver{service: service}, gql.MaxDepth(maxQueryDepth))
+}
+
+// Booking resolves a booking; customers can only read their own
+func (r *Resolver) Booking(ctx context.Context, args struct{ ID gql.ID }) (*bookingResolver, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, newError(codeUnauthenticated, "user not authenticated")
+	}
+
+	booking, err := r.service.GetBooking(ctx, string(args.ID))
+	if err != nil {
+		return nil, serviceError(err, "get booking")
+	}
+
+	if !auth.IsBarber(ctx) && !auth.IsAdmin(ctx) && booking.UserID != userID {
+		return nil, newError(codePermissionDenied, "you can only view your own bookings")
+	}
+
+	return &bookingResolver{service: r.service, booking: booking}, nil
+}
+
+// bookingsArgs filters a bookings query
+type bookingsArgs struct {
+	UserID   *string
+	BarberID *string
+	Statuses *[]string
+	From     *gql.Time
+	To       *gql.Time
+}
+
+// Bookings resolves the bookings matching the filter
+func (r *Resolver) Bookings(ctx context.Context, args bookingsArgs) ([]*bookingResolver, error) {
+	filter := model.BookingFilter{}
+	if args.UserID != nil {
+		filter.UserID = *args.UserID
+	}
+	if args.BarberID != nil {
+		filter.BarberID = *args.BarberID
+	}
+	return listBookings(ctx, r.service, filter, args.Statuses, args.From, args.To)
+}
+
+// listBookings lists the bookings matching the filter and the optional
+// statuses and start time range, with the same visibility rules as
+// ListBookings: customers see their own bookings, barbers the bookings with
+// them, and callers allowed to list all bookings see everything
+func listBookings(ctx context.Context, svc service.BookingServiceInterface, filter model.BookingFilter, statuses *[]string, from, to *gql.Time) ([]*bookingResolver, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, newError(codeUnauthenticated, "user not authenticated")
+	}
+
+	switch {
+	case auth.Can(ctx, auth.PermListAllBookings):
+	case auth.IsBarber(ctx):
+		if filter.BarberID == "" {
+			filter.BarberID = userID
+		}
+		if filter.BarberID != userID {
+			return nil, newError(codePermissionDenied, "barbers can only view their own bookings")
+		}
+	default:
+		if filter.UserID == "" {
+			filter.UserID = userID
+		}
+		if filter.UserID != userID {
+			return nil, newError(codePermissionDenied, "regular users can only view their own bookings")
+		}
+	}
+
+	if statuses != nil {
+		for _, s := range *statuses {
+			filter.Statuses = append(filter.Statuses, bookingStatuses[s])
+		}
+	}
+	if from != nil {
+		filter.StartFrom = &from.Time
+	}
+	if to != nil {
+		filter.StartBefore = &to.Time
+	}
+	if filter.StartFrom != nil && filter.StartBefore != nil && !filter.StartFrom.Before(*filter.StartBefore) {
+		return nil, newError(codeInvalidArgument, "from must be before to")
+	}
+
+	bookings, err := svc.ListBookings(ctx, filter)
+	if err != nil {
+		return nil, serviceError(err, "list bookings")
+	}
+
+	resolvers := make([]*bookingResolver, len(bookings))
+	for i, booking := range bookings {
+		resolvers[i] = &bookingResolver{service: svc, booking: booking}
+	}
+	return resolvers, nil
+}
+
+// Barber resolves a barber, whose fields are looked up as they're selected
+func (r *Resolver) Barber(ctx context.Context, args struct{ ID gql.ID }) (*barberResolver, error) {
+	if _, err := auth.GetUserIDFromContext(ctx); err != nil {
+		return nil, newError(codeUnauthenticated, "user not authenticated")
+	}
+	return &barberResolver{service: r.service, id: string(args.ID)}, nil
+}
+
+// Availability resolves a barber's free slots on a day
+func (r *Resolver) Availability(ctx context.Context, args struct {
+	BarberID     gql.ID
+	Date         string
+	ServiceTypes *[]int32
+}) ([]*timeSlotResolver, error) {
+	if _, err := auth.GetUserIDFromContext(ctx); err != nil {
+		return nil, newError(codeUnauthenticated, "user not authenticated")
+	}
+	barber := &barberResolver{service: r.service, id: string(args.BarberID)}
+	return barber.Availability(ctx, availabilityArgs{Date: args.Date, ServiceTypes: args.ServiceTypes})
+}
+
+// Services resolves the service catalog
+func (r *Resolver) Services(ctx context.Context, args struct{ IncludeInactive bool }) ([]*catalogServiceResolver, error) {
+	if _, err := auth.GetUserIDFromContext(ctx); err != nil {
+		return nil, newError(codeUnauthenticated, "user not authenticated")
+	}
+
+	services, err := r.service.ListCatalogServices(ctx, args.IncludeInactive)
+	if err != nil {
+		return nil, serviceError(err, "list services")
+	}
+
+	resolvers := make([]*catalogServiceResolver, len(services))
+	for i, s := range services {
+		resolvers[i] = &catalogServiceResolver{service: s}
+	}
+	return resolvers, nil
+}
+
+// createBookingInput is the input of the createBooking mutation
+type createBookingInput struct {
+	UserID         string
+	BarberID       string
+	StartTime      gql.Time
+	ServiceTypes   []int32
+	Notes          *string
+	ExternalRef    *string
+	IdempotencyKey *string
+}
+
+// CreateBooking creates a booking. Customers can only book for themselves;
+// barbers can book for anyone.
+func (r *Resolver) CreateBooking(ctx context.Context, args struct{ Input createBookingInput }) (*bookingResolver, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, newError(codeUnauthenticated, "user not authenticated")
+	}
+
+	input := args.Input
+	if !auth.IsBarber(ctx) && userID != input.UserID {
+		return nil, newError(codePermissionDenied, "regular users can only create bookings for themselves")
+	}
+	if input.BarberID == "" {
+		return nil, newError(codeInvalidArgument, "barber ID is required")
+	}
+
+	params := service.CreateBookingParams{
+		UserID:       input.UserID,
+		BarberID:     input.BarberID,
+		StartTime:    input.StartTime.Time,
+		ServiceTypes: serviceTypesFromInput(&input.ServiceTypes),
+	}
+	if input.Notes != nil {
+		params.Notes = *input.Notes
+	}
+	if input.ExternalRef != nil {
+		params.ExternalRef = *input.ExternalRef
+	}
+	if input.IdempotencyKey != nil {
+		params.IdempotencyKey = *input.IdempotencyKey
+	}
+
+	booking, err := r.service.CreateBooking(ctx, params)
+	if err != nil {
+		return nil, serviceError(err, "create booking")
+	}
+
+	return &bookingResolver{service: r.service, booking: booking}, nil
+}
+
+// CancelBooking cancels a booking. Customers can only cancel their own
+// bookings; the cancellation policy applies to them, not to the shop.
+func (r *Resolver) CancelBooking(ctx context.Context, args struct {
+	ID     gql.ID
+	Reason *string
+}) (*bookingResolver, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, newError(codeUnauthenticated, "user not authenticated")
+	}
+
+	booking, err := r.service.GetBooking(ctx, string(args.ID))
+	if err != nil {
+		return nil, serviceError(err, "get booking")
+	}
+
+	isBarber := auth.IsBarber(ctx)
+	if !isBarber && booking.UserID != userID {
+		return nil, newError(codePermissionDenied, "you can only cancel your own bookings")
+	}
+
+	var reason string
+	if args.Reason != nil {
+		reason = *args.Reason
+	}
+	if len(reason) > maxCancellationReasonLength {
+		return nil, newError(codeInvalidArgument, "reason must be at most %d characters", maxCancellationReasonLength)
+	}
+
+	cancelled, err := r.service.CancelBooking(ctx, booking.ID.Hex(), service.CancelBookingParams{
+		CancelledBy: userID,
+		Reason:      reason,
+		WaivePolicy: isBarber || auth.IsAdmin(ctx),
+	})
+	if err != nil {
+		return nil, serviceError(err, "cancel booking")
+	}
+	if cancelled == nil {
+		return nil, newError(codeFailedPrecondition, "booking not found or already cancelled")
+	}
+
+	return &bookingResolver{service: r.service, booking: cancelled}, nil
+}
+
+// serviceTypesFromInput converts requested service types, nil when none were given
+func serviceTypesFromInput(serviceTypes *[]int32) []model.ServiceType {
+	if serviceTypes == nil {
+		return nil
+	}
+	converted := make([]model.ServiceType, len(*serviceTypes))
+	for i, st := range *serviceTypes {
+		converted[i] = model.ServiceType(st)
+	}
+	return converted
+}
+
+// optionalTime returns nil for a nil time, so it resolves to null
+func optionalTime(t *time.Time) *gql.Time {
+	if t == nil {
+		return nil
+	}
+	return &gql.Time{Time: *t}
+}
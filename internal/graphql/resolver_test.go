@@ -0,0 +1,321 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// fakeService serves a fixed set of bookings and records the calls the
+// resolvers make
+type fakeService struct {
+	service.BookingServiceInterface
+	bookings map[string]*model.Booking
+
+	filter       *model.BookingFilter
+	createParams *service.CreateBookingParams
+	cancelParams *service.CancelBookingParams
+	slotsDate    time.Time
+}
+
+func (f *fakeService) GetBooking(ctx context.Context, id string) (*model.Booking, error) {
+	booking, ok := f.bookings[id]
+	if !ok {
+		return nil, service.ErrBookingNotFound
+	}
+	return booking, nil
+}
+
+func (f *fakeService) ListBookings(ctx context.Context, filter model.BookingFilter) ([]*model.Booking, error) {
+	f.filter = &filter
+	var bookings []*model.Booking
+	for _, booking := range f.bookings {
+		if (filter.UserID == "" || booking.UserID == filter.UserID) && (filter.BarberID == "" || booking.BarberID == filter.BarberID) {
+			bookings = append(bookings, booking)
+		}
+	}
+	return bookings, nil
+}
+
+func (f *fakeService) CreateBooking(ctx context.Context, params service.CreateBookingParams) (*model.Booking, error) {
+	f.createParams = &params
+	if params.StartTime.Hour() == 12 {
+		return nil, &service.SlotUnavailableError{}
+	}
+	return &model.Booking{
+		ID:           primitive.NewObjectID(),
+		UserID:       params.UserID,
+		BarberID:     params.BarberID,
+		StartTime:    params.StartTime,
+		EndTime:      params.StartTime.Add(30 * time.Minute),
+		ServiceTypes: params.ServiceTypes,
+	}, nil
+}
+
+func (f *fakeService) CancelBooking(ctx context.Context, id string, params service.CancelBookingParams) (*model.Booking, error) {
+	f.cancelParams = &params
+	booking := *f.bookings[id]
+	booking.Status = model.BookingStatusCancelled
+	booking.Cancellation = &model.Cancellation{CancelledBy: params.CancelledBy, Reason: params.Reason, Fee: 500, Currency: "EUR"}
+	return &booking, nil
+}
+
+func (f *fakeService) GetWorkingHours(ctx context.Context, barberID string) (*model.BarberSchedule, error) {
+	schedule := model.DefaultBarberSchedule(barberID)
+	schedule.Timezone = "Europe/Rome"
+	return schedule, nil
+}
+
+func (f *fakeService) GetAvailableTimeSlots(ctx context.Context, barberID string, date time.Time, serviceTypes []model.ServiceType) ([]*model.TimeSlot, error) {
+	f.slotsDate = date
+	return []*model.TimeSlot{{StartTime: date.Add(9 * time.Hour), EndTime: date.Add(9*time.Hour + 30*time.Minute)}}, nil
+}
+
+// testBookingID is the ID of alice's booking with barber1
+var testBookingID = primitive.NewObjectID()
+
+func newFakeService() *fakeService {
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	return &fakeService{bookings: map[string]*model.Booking{
+		testBookingID.Hex(): {
+			ID:          testBookingID,
+			UserID:      "alice",
+			BarberID:    "barber1",
+			StartTime:   start,
+			EndTime:     start.Add(30 * time.Minute),
+			ServiceType: model.ServiceTypeBeardTrim,
+			Status:      model.BookingStatusConfirmed,
+			Price:       2000,
+			Currency:    "EUR",
+		},
+	}}
+}
+
+// callerContext returns the context of a signed-in caller
+func callerContext(userID string, roles ...auth.Role) context.Context {
+	return auth.WithClaims(context.Background(), &auth.Claims{
+		Roles:            roles,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID},
+	})
+}
+
+// result is the outcome of executing a query
+type result struct {
+	data   map[string]interface{}
+	errors []map[string]interface{}
+}
+
+// execute runs a query against a schema backed by svc
+func execute(t *testing.T, ctx context.Context, svc service.BookingServiceInterface, query string, variables map[string]interface{}) result {
+	t.Helper()
+	schema, err := NewSchema(svc)
+	require.NoError(t, err)
+
+	body, err := json.Marshal(schema.Exec(ctx, query, "", variables))
+	require.NoError(t, err)
+
+	var response struct {
+		Data   map[string]interface{}   `json:"data"`
+		Errors []map[string]interface{} `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(body, &response))
+	return result{data: response.Data, errors: response.Errors}
+}
+
+// errorCode returns the code of the response's first error
+func (r result) errorCode() string {
+	if len(r.errors) == 0 {
+		return ""
+	}
+	extensions, _ := r.errors[0]["extensions"].(map[string]interface{})
+	code, _ := extensions["code"].(string)
+	return code
+}
+
+func TestBookingQuery(t *testing.T) {
+	query := `query($id: ID!) {
+		booking(id: $id) {
+			id userId status price serviceTypes startTime
+			barber { id timezone }
+		}
+	}`
+	vars := map[string]interface{}{"id": testBookingID.Hex()}
+
+	t.Run("owner reads the booking and its barber", func(t *testing.T) {
+		res := execute(t, callerContext("alice"), newFakeService(), query, vars)
+		require.Empty(t, res.errors)
+
+		booking := res.data["booking"].(map[string]interface{})
+		assert.Equal(t, testBookingID.Hex(), booking["id"])
+		assert.Equal(t, "CONFIRMED", booking["status"])
+		assert.Equal(t, float64(2000), booking["price"])
+		assert.Equal(t, []interface{}{float64(model.ServiceTypeBeardTrim)}, booking["serviceTypes"])
+		assert.Equal(t, "2024-03-01T10:00:00Z", booking["startTime"])
+		assert.Equal(t, map[string]interface{}{"id": "barber1", "timezone": "Europe/Rome"}, booking["barber"])
+	})
+
+	t.Run("other customers are denied", func(t *testing.T) {
+		res := execute(t, callerContext("bob"), newFakeService(), query, vars)
+		assert.Equal(t, codePermissionDenied, res.errorCode())
+		assert.Nil(t, res.data["booking"])
+	})
+
+	t.Run("barbers can read any booking", func(t *testing.T) {
+		res := execute(t, callerContext("barber2", auth.RoleBarber), newFakeService(), query, vars)
+		assert.Empty(t, res.errors)
+	})
+
+	t.Run("unknown booking", func(t *testing.T) {
+		res := execute(t, callerContext("alice"), newFakeService(), query, map[string]interface{}{"id": primitive.NewObjectID().Hex()})
+		assert.Equal(t, codeNotFound, res.errorCode())
+	})
+
+	t.Run("anonymous callers are rejected", func(t *testing.T) {
+		res := execute(t, context.Background(), newFakeService(), query, vars)
+		assert.Equal(t, codeUnauthenticated, res.errorCode())
+	})
+}
+
+func TestBookingsQuery(t *testing.T) {
+	t.Run("customers default to their own bookings", func(t *testing.T) {
+		svc := newFakeService()
+		res := execute(t, callerContext("alice"), svc, `{ bookings(statuses: [CONFIRMED, NO_SHOW], from: "2024-03-01T00:00:00Z") { id } }`, nil)
+		require.Empty(t, res.errors)
+		assert.Len(t, res.data["bookings"], 1)
+		assert.Equal(t, "alice", svc.filter.UserID)
+		assert.Equal(t, []model.BookingStatus{model.BookingStatusConfirmed, model.BookingStatusNoShow}, svc.filter.Statuses)
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), *svc.filter.StartFrom)
+	})
+
+	t.Run("customers can't list others' bookings", func(t *testing.T) {
+		res := execute(t, callerContext("alice"), newFakeService(), `{ bookings(userId: "bob") { id } }`, nil)
+		assert.Equal(t, codePermissionDenied, res.errorCode())
+	})
+
+	t.Run("barbers only see their own bookings", func(t *testing.T) {
+		svc := newFakeService()
+		res := execute(t, callerContext("barber1", auth.RoleBarber), svc, `{ barber(id: "barber1") { bookings { userId } } }`, nil)
+		require.Empty(t, res.errors)
+		assert.Equal(t, "barber1", svc.filter.BarberID)
+
+		res = execute(t, callerContext("barber2", auth.RoleBarber), svc, `{ barber(id: "barber1") { bookings { userId } } }`, nil)
+		assert.Equal(t, codePermissionDenied, res.errorCode())
+	})
+
+	t.Run("admins see everything", func(t *testing.T) {
+		svc := newFakeService()
+		res := execute(t, callerContext("admin1", auth.RoleAdmin), svc, `{ bookings { id } }`, nil)
+		require.Empty(t, res.errors)
+		assert.Empty(t, svc.filter.UserID)
+		assert.Empty(t, svc.filter.BarberID)
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		res := execute(t, callerContext("alice"), newFakeService(), `{ bookings(from: "2024-03-02T00:00:00Z", to: "2024-03-01T00:00:00Z") { id } }`, nil)
+		assert.Equal(t, codeInvalidArgument, res.errorCode())
+	})
+}
+
+func TestAvailabilityQuery(t *testing.T) {
+	svc := newFakeService()
+	res := execute(t, callerContext("alice"), svc, `{ availability(barberId: "barber1", date: "2024-03-01") { startTime endTime } }`, nil)
+	require.Empty(t, res.errors)
+
+	// The date is taken in the barber's time zone
+	rome, err := time.LoadLocation("Europe/Rome")
+	require.NoError(t, err)
+	assert.True(t, svc.slotsDate.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, rome)))
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"startTime": "2024-03-01T09:00:00+01:00",
+		"endTime":   "2024-03-01T09:30:00+01:00",
+	}}, res.data["availability"])
+
+	res = execute(t, callerContext("alice"), svc, `{ availability(barberId: "barber1", date: "March 1st") { startTime } }`, nil)
+	assert.Equal(t, codeInvalidArgument, res.errorCode())
+}
+
+func TestCreateBookingMutation(t *testing.T) {
+	mutation := `mutation($input: CreateBookingInput!) { createBooking(input: $input) { id userId serviceTypes } }`
+	input := func(userID, start string) map[string]interface{} {
+		return map[string]interface{}{"input": map[string]interface{}{
+			"userId":       userID,
+			"barberId":     "barber1",
+			"startTime":    start,
+			"serviceTypes": []interface{}{0, 1},
+			"notes":        "Short on the sides",
+		}}
+	}
+
+	t.Run("customers book for themselves", func(t *testing.T) {
+		svc := newFakeService()
+		res := execute(t, callerContext("alice"), svc, mutation, input("alice", "2024-03-01T10:00:00Z"))
+		require.Empty(t, res.errors)
+		assert.Equal(t, []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim}, svc.createParams.ServiceTypes)
+		assert.Equal(t, "Short on the sides", svc.createParams.Notes)
+	})
+
+	t.Run("customers can't book for others", func(t *testing.T) {
+		svc := newFakeService()
+		res := execute(t, callerContext("alice"), svc, mutation, input("bob", "2024-03-01T10:00:00Z"))
+		assert.Equal(t, codePermissionDenied, res.errorCode())
+		assert.Nil(t, svc.createParams)
+	})
+
+	t.Run("barbers book for anyone", func(t *testing.T) {
+		res := execute(t, callerContext("barber1", auth.RoleBarber), newFakeService(), mutation, input("bob", "2024-03-01T10:00:00Z"))
+		assert.Empty(t, res.errors)
+	})
+
+	t.Run("taken slot", func(t *testing.T) {
+		res := execute(t, callerContext("alice"), newFakeService(), mutation, input("alice", "2024-03-01T12:00:00Z"))
+		assert.Equal(t, codeFailedPrecondition, res.errorCode())
+		assert.Equal(t, service.ErrSlotUnavailable.Error(), res.errors[0]["message"])
+	})
+}
+
+func TestCancelBookingMutation(t *testing.T) {
+	mutation := `mutation($id: ID!) { cancelBooking(id: $id, reason: "Sick") { status cancellation { reason fee } } }`
+	vars := map[string]interface{}{"id": testBookingID.Hex()}
+
+	t.Run("customers cancel under the policy", func(t *testing.T) {
+		svc := newFakeService()
+		res := execute(t, callerContext("alice"), svc, mutation, vars)
+		require.Empty(t, res.errors)
+		assert.Equal(t, map[string]interface{}{
+			"status":       "CANCELLED",
+			"cancellation": map[string]interface{}{"reason": "Sick", "fee": float64(500)},
+		}, res.data["cancelBooking"])
+		assert.Equal(t, service.CancelBookingParams{CancelledBy: "alice", Reason: "Sick"}, *svc.cancelParams)
+	})
+
+	t.Run("the policy is waived for barbers", func(t *testing.T) {
+		svc := newFakeService()
+		res := execute(t, callerContext("barber1", auth.RoleBarber), svc, mutation, vars)
+		require.Empty(t, res.errors)
+		assert.True(t, svc.cancelParams.WaivePolicy)
+	})
+
+	t.Run("customers can't cancel others' bookings", func(t *testing.T) {
+		svc := newFakeService()
+		res := execute(t, callerContext("bob"), svc, mutation, vars)
+		assert.Equal(t, codePermissionDenied, res.errorCode())
+		assert.Nil(t, svc.cancelParams)
+	})
+}
+
+func TestQueryDepthIsLimited(t *testing.T) {
+	res := execute(t, callerContext("admin1", auth.RoleAdmin), newFakeService(),
+		`{ bookings { barber { bookings { barber { bookings { barber { id } } } } } } }`, nil)
+	require.NotEmpty(t, res.errors)
+	assert.Nil(t, res.data)
+}
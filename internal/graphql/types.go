@@ -0,0 +1,373 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	gql "github.com/graph-gophers/graphql-go"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// bookingStatuses maps the schema's BookingStatus values to model statuses
+var bookingStatuses = map[string]model.BookingStatus{
+	"PENDING":   model.BookingStatusPending,
+	"CONFIRMED": model.BookingStatusConfirmed,
+	"CANCELLED": model.BookingStatusCancelled,
+	"COMPLETED": model.BookingStatusCompleted,
+	"NO_SHOW":   model.BookingStatusNoShow,
+	"HELD":      model.BookingStatusHeld,
+}
+
+// bookingResolver resolves a booking's fields
+type bookingResolver struct {
+	service service.BookingServiceInterface
+	booking *model.Booking
+}
+
+func (r *bookingResolver) ID() gql.ID {
+	return gql.ID(r.booking.ID.Hex())
+}
+
+func (r *bookingResolver) UserID() string {
+	return r.booking.UserID
+}
+
+func (r *bookingResolver) BarberID() string {
+	return r.booking.BarberID
+}
+
+// Barber resolves the booked barber
+func (r *bookingResolver) Barber() *barberResolver {
+	return &barberResolver{service: r.service, id: r.booking.BarberID}
+}
+
+func (r *bookingResolver) StartTime() gql.Time {
+	return gql.Time{Time: r.booking.StartTime}
+}
+
+func (r *bookingResolver) EndTime() gql.Time {
+	return gql.Time{Time: r.booking.EndTime}
+}
+
+func (r *bookingResolver) ServiceTypes() []int32 {
+	services := r.booking.Services()
+	serviceTypes := make([]int32, len(services))
+	for i, st := range services {
+		serviceTypes[i] = int32(st)
+	}
+	return serviceTypes
+}
+
+func (r *bookingResolver) Status() string {
+	for name, status := range bookingStatuses {
+		if status == r.booking.Status {
+			return name
+		}
+	}
+	return "PENDING"
+}
+
+func (r *bookingResolver) Price() int32 {
+	return int32(r.booking.Price)
+}
+
+func (r *bookingResolver) Currency() string {
+	return r.booking.Currency
+}
+
+func (r *bookingResolver) Notes() string {
+	return r.booking.Notes
+}
+
+func (r *bookingResolver) ExternalRef() *string {
+	if r.booking.ExternalRef == "" {
+		return nil
+	}
+	return &r.booking.ExternalRef
+}
+
+func (r *bookingResolver) Cancellation() *cancellationResolver {
+	if r.booking.Cancellation == nil {
+		return nil
+	}
+	return &cancellationResolver{cancellation: r.booking.Cancellation}
+}
+
+func (r *bookingResolver) CheckedInAt() *gql.Time {
+	return optionalTime(r.booking.CheckedInAt)
+}
+
+func (r *bookingResolver) CreatedAt() gql.Time {
+	return gql.Time{Time: r.booking.CreatedAt}
+}
+
+func (r *bookingResolver) UpdatedAt() gql.Time {
+	return gql.Time{Time: r.booking.UpdatedAt}
+}
+
+// cancellationResolver resolves how a booking was cancelled
+type cancellationResolver struct {
+	cancellation *model.Cancellation
+}
+
+func (r *cancellationResolver) CancelledAt() gql.Time {
+	return gql.Time{Time: r.cancellation.CancelledAt}
+}
+
+func (r *cancellationResolver) CancelledBy() string {
+	return r.cancellation.CancelledBy
+}
+
+func (r *cancellationResolver) Reason() string {
+	return r.cancellation.Reason
+}
+
+func (r *cancellationResolver) Fee() int32 {
+	return int32(r.cancellation.Fee)
+}
+
+func (r *cancellationResolver) Currency() string {
+	return r.cancellation.Currency
+}
+
+// barberResolver resolves a barber's schedule, services and bookings. The
+// schedule is looked up once, however many of its fields are selected.
+type barberResolver struct {
+	service service.BookingServiceInterface
+	id      string
+
+	scheduleOnce sync.Once
+	schedule     *model.BarberSchedule
+	scheduleErr  error
+}
+
+// workingHours returns the barber's schedule, looking it up on first use
+func (r *barberResolver) workingHours(ctx context.Context) (*model.BarberSchedule, error) {
+	r.scheduleOnce.Do(func() {
+		r.schedule, r.scheduleErr = r.service.GetWorkingHours(ctx, r.id)
+		if r.scheduleErr != nil {
+			r.scheduleErr = serviceError(r.scheduleErr, "get working hours")
+		}
+	})
+	return r.schedule, r.scheduleErr
+}
+
+func (r *barberResolver) ID() gql.ID {
+	return gql.ID(r.id)
+}
+
+func (r *barberResolver) Timezone(ctx context.Context) (string, error) {
+	schedule, err := r.workingHours(ctx)
+	if err != nil {
+		return "", err
+	}
+	return schedule.Timezone, nil
+}
+
+func (r *barberResolver) WorkingHours(ctx context.Context) ([]*workingHoursResolver, error) {
+	schedule, err := r.workingHours(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hours := make([]*workingHoursResolver, len(schedule.Hours))
+	for i := range schedule.Hours {
+		hours[i] = &workingHoursResolver{hours: schedule.Hours[i]}
+	}
+	return hours, nil
+}
+
+func (r *barberResolver) Breaks(ctx context.Context) ([]*breakResolver, error) {
+	schedule, err := r.workingHours(ctx)
+	if err != nil {
+		return nil, err
+	}
+	breaks := make([]*breakResolver, len(schedule.Breaks))
+	for i := range schedule.Breaks {
+		breaks[i] = &breakResolver{period: schedule.Breaks[i]}
+	}
+	return breaks, nil
+}
+
+func (r *barberResolver) Services(ctx context.Context) ([]*offeredServiceResolver, error) {
+	services, err := r.service.GetBarberServices(ctx, r.id)
+	if err != nil {
+		return nil, serviceError(err, "get barber services")
+	}
+	resolvers := make([]*offeredServiceResolver, len(services))
+	for i, s := range services {
+		resolvers[i] = &offeredServiceResolver{service: s}
+	}
+	return resolvers, nil
+}
+
+// availabilityArgs asks for free slots on a day
+type availabilityArgs struct {
+	Date         string
+	ServiceTypes *[]int32
+}
+
+// Availability resolves the barber's free slots on a day, given in the
+// barber's time zone
+func (r *barberResolver) Availability(ctx context.Context, args availabilityArgs) ([]*timeSlotResolver, error) {
+	schedule, err := r.workingHours(ctx)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return nil, newError(codeInternal, "invalid barber time zone %q", schedule.Timezone)
+	}
+	date, err := time.ParseInLocation("2006-01-02", args.Date, loc)
+	if err != nil {
+		return nil, newError(codeInvalidArgument, "invalid date format: %q, expected YYYY-MM-DD", args.Date)
+	}
+
+	slots, err := r.service.GetAvailableTimeSlots(ctx, r.id, date, serviceTypesFromInput(args.ServiceTypes))
+	if err != nil {
+		return nil, serviceError(err, "get available time slots")
+	}
+	return timeSlots(slots), nil
+}
+
+// NextAvailable resolves the barber's earliest free slots, in the barber's
+// time zone. The service caps how many are returned.
+func (r *barberResolver) NextAvailable(ctx context.Context, args struct {
+	ServiceTypes *[]int32
+	Count        int32
+}) ([]*timeSlotResolver, error) {
+	schedule, err := r.workingHours(ctx)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return nil, newError(codeInternal, "invalid barber time zone %q", schedule.Timezone)
+	}
+
+	slots, err := r.service.GetNextAvailableSlot(ctx, r.id, serviceTypesFromInput(args.ServiceTypes), int(args.Count))
+	if err != nil {
+		return nil, serviceError(err, "get next available slot")
+	}
+	for _, slot := range slots {
+		slot.StartTime = slot.StartTime.In(loc)
+		slot.EndTime = slot.EndTime.In(loc)
+	}
+	return timeSlots(slots), nil
+}
+
+// Bookings resolves the bookings with the barber, visible to the barber
+// themselves and callers allowed to list all bookings
+func (r *barberResolver) Bookings(ctx context.Context, args struct {
+	Statuses *[]string
+	From     *gql.Time
+	To       *gql.Time
+}) ([]*bookingResolver, error) {
+	return listBookings(ctx, r.service, model.BookingFilter{BarberID: r.id}, args.Statuses, args.From, args.To)
+}
+
+// workingHoursResolver resolves a barber's hours on a weekday
+type workingHoursResolver struct {
+	hours model.WorkingHours
+}
+
+func (r *workingHoursResolver) Weekday() int32 {
+	return int32(r.hours.Weekday)
+}
+
+func (r *workingHoursResolver) Start() string {
+	return formatTimeOfDay(r.hours.StartMinute)
+}
+
+func (r *workingHoursResolver) End() string {
+	return formatTimeOfDay(r.hours.EndMinute)
+}
+
+// breakResolver resolves a barber's daily break
+type breakResolver struct {
+	period model.BreakPeriod
+}
+
+func (r *breakResolver) Start() string {
+	return formatTimeOfDay(r.period.StartMinute)
+}
+
+func (r *breakResolver) End() string {
+	return formatTimeOfDay(r.period.EndMinute)
+}
+
+// formatTimeOfDay formats minutes after midnight as HH:MM
+func formatTimeOfDay(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// offeredServiceResolver resolves a service as a barber offers it
+type offeredServiceResolver struct {
+	service *model.OfferedService
+}
+
+func (r *offeredServiceResolver) ServiceType() int32 {
+	return int32(r.service.ServiceType)
+}
+
+func (r *offeredServiceResolver) Name() string {
+	return r.service.Name
+}
+
+func (r *offeredServiceResolver) DurationMinutes() int32 {
+	return int32(r.service.DurationMinutes)
+}
+
+func (r *offeredServiceResolver) Price() int32 {
+	return int32(r.service.Price)
+}
+
+// catalogServiceResolver resolves a service in the shop's catalog
+type catalogServiceResolver struct {
+	service *model.CatalogService
+}
+
+func (r *catalogServiceResolver) ServiceType() int32 {
+	return int32(r.service.ServiceType)
+}
+
+func (r *catalogServiceResolver) Name() string {
+	return r.service.Name
+}
+
+func (r *catalogServiceResolver) DurationMinutes() int32 {
+	return int32(r.service.DurationMinutes)
+}
+
+func (r *catalogServiceResolver) Price() int32 {
+	return int32(r.service.Price)
+}
+
+func (r *catalogServiceResolver) Active() bool {
+	return r.service.Active
+}
+
+// timeSlotResolver resolves a time slot
+type timeSlotResolver struct {
+	slot *model.TimeSlot
+}
+
+// timeSlots wraps time slots in resolvers
+func timeSlots(slots []*model.TimeSlot) []*timeSlotResolver {
+	resolvers := make([]*timeSlotResolver, len(slots))
+	for i, slot := range slots {
+		resolvers[i] = &timeSlotResolver{slot: slot}
+	}
+	return resolvers
+}
+
+func (r *timeSlotResolver) StartTime() gql.Time {
+	return gql.Time{Time: r.slot.StartTime}
+}
+
+func (r *timeSlotResolver) EndTime() gql.Time {
+	return gql.Time{Time: r.slot.EndTime}
+}
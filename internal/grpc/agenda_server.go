@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// GetDailyAgenda returns a barber's bookings for a day with the free time between them
+func (s *BookingServer) GetDailyAgenda(ctx context.Context, req *pb.GetDailyAgendaRequest) (*pb.DailyAgenda, error) {
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber_id is required")
+	}
+
+	// Only the calendar day matters; the service takes it in the barber's zone
+	day, _, err := parseDateInput("", req.Day, "UTC")
+	if err != nil {
+		return nil, err
+	}
+
+	agenda, err := s.service.GetDailyAgenda(ctx, req.BarberId, day)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get daily agenda: %v", err)
+	}
+
+	bookings := make([]*pb.Booking, len(agenda.Bookings))
+	for i, booking := range agenda.Bookings {
+		bookings[i] = s.convertBookingToProto(booking)
+	}
+
+	gaps := make([]*pb.AgendaGap, len(agenda.Gaps))
+	for i, gap := range agenda.Gaps {
+		gaps[i] = &pb.AgendaGap{
+			Start: timestamppb.New(gap.Start),
+			End:   timestamppb.New(gap.End),
+		}
+	}
+
+	return &pb.DailyAgenda{
+		BarberId: agenda.BarberID,
+		Day:      convertCalendarDate(agenda.Date),
+		Timezone: agenda.Date.Location().String(),
+		Bookings: bookings,
+		Gaps:     gaps,
+	}, nil
+}
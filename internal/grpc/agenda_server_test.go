@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+func TestGetDailyAgenda(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	start := time.Date(2025, time.June, 2, 10, 0, 0, 0, loc)
+	mockService.On("GetDailyAgenda", mock.Anything, "barber1", time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC)).Return(&model.DailyAgenda{
+		BarberID: "barber1",
+		Date:     time.Date(2025, time.June, 2, 0, 0, 0, 0, loc),
+		Bookings: []*model.Booking{{
+			ID:        primitive.NewObjectID(),
+			UserID:    "user1",
+			BarberID:  "barber1",
+			StartTime: start,
+			EndTime:   start.Add(30 * time.Minute),
+			Notes:     "Short on the sides",
+		}},
+		Gaps: []*model.AgendaGap{{Start: start.Add(30 * time.Minute), End: start.Add(2 * time.Hour)}},
+	}, nil)
+
+	resp, err := withPolicy(server, "GetDailyAgenda", server.GetDailyAgenda)(mockContextWithClaims("barber1", true), &pb.GetDailyAgendaRequest{
+		BarberId: "barber1",
+		Day:      &pb.CalendarDate{Year: 2025, Month: 6, Day: 2},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", resp.Timezone)
+	assert.Equal(t, &pb.CalendarDate{Year: 2025, Month: 6, Day: 2}, resp.Day)
+	if assert.Len(t, resp.Bookings, 1) {
+		assert.Equal(t, "Short on the sides", resp.Bookings[0].Notes)
+	}
+	if assert.Len(t, resp.Gaps, 1) {
+		assert.Equal(t, start.Add(2*time.Hour).Unix(), resp.Gaps[0].End.AsTime().Unix())
+	}
+}
+
+// Test: Customer tries to get a barber's agenda (should fail)
+func TestGetDailyAgenda_Customer(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	resp, err := withPolicy(server, "GetDailyAgenda", server.GetDailyAgenda)(mockContextWithClaims("user1", false), &pb.GetDailyAgendaRequest{
+		BarberId: "barber1",
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockService.AssertNotCalled(t, "GetDailyAgenda")
+}
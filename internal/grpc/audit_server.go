@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// ListAuditLog returns the authenticated calls that changed something
+func (s *BookingServer) ListAuditLog(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.AuditLog, error) {
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	filter := model.AuditFilter{
+		ActorID: req.ActorId,
+		Method:  req.Method,
+		Limit:   int(req.Limit),
+	}
+
+	if t, ok, err := parseTimeInput(nil, req.StartTime, "start time"); err != nil {
+		return nil, err
+	} else if ok {
+		filter.From = &t
+	}
+	if t, ok, err := parseTimeInput(nil, req.EndTime, "end time"); err != nil {
+		return nil, err
+	} else if ok {
+		filter.To = &t
+	}
+
+	entries, err := s.service.ListAuditLog(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit log: %v", err)
+	}
+
+	auditLog := &pb.AuditLog{}
+	for _, entry := range entries {
+		auditLog.Entries = append(auditLog.Entries, &pb.AuditEntry{
+			Method:     entry.Method,
+			ActorId:    entry.ActorID,
+			IsBarber:   entry.IsBarber,
+			IsAdmin:    entry.IsAdmin,
+			Request:    entry.Request,
+			Code:       entry.Code,
+			Error:      entry.Error,
+			DurationMs: entry.Duration.Milliseconds(),
+			At:         entry.At.Format(time.RFC3339),
+		})
+	}
+
+	return auditLog, nil
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Admin lists a user's audit entries (should succeed)
+func TestListAuditLog_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("ListAuditLog", mock.Anything, model.AuditFilter{ActorID: "user1", From: &from, Limit: 50}).
+		Return([]*model.AuditEntry{
+			{
+				Method:   "/booking.BookingService/CancelBooking",
+				ActorID:  "user1",
+				Code:     "OK",
+				Duration: 12 * time.Millisecond,
+				At:       time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC),
+			},
+		}, nil)
+
+	// Call the method
+	resp, err := server.ListAuditLog(mockAdminContext("admin1"), &pb.ListAuditLogRequest{
+		ActorId:   "user1",
+		StartTime: "2025-06-01T00:00:00Z",
+		Limit:     50,
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Entries, 1) {
+		assert.Equal(t, "/booking.BookingService/CancelBooking", resp.Entries[0].Method)
+		assert.Equal(t, int64(12), resp.Entries[0].DurationMs)
+		assert.Equal(t, "2025-06-01T09:00:00Z", resp.Entries[0].At)
+	}
+}
+
+// Test: Barber tries to list the audit log (should fail)
+func TestListAuditLog_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "ListAuditLog", server.ListAuditLog)(mockContextWithClaims("barber1", true), &pb.ListAuditLogRequest{})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "ListAuditLog")
+}
@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+var _ auth.OwnershipChecker = (*BookingServer)(nil)
+
+// CheckOwnership approves calls to the methods whose policy requires the
+// caller to own the resource. It runs in the authorization interceptor,
+// before the handler.
+func (s *BookingServer) CheckOwnership(ctx context.Context, fullMethod string, req interface{}) error {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	switch r := req.(type) {
+	case *pb.GetBookingRequest:
+		// Customers only see their own bookings
+		booking, err := s.bookingForOwnership(ctx, r.Id)
+		if err != nil {
+			return err
+		}
+		if !auth.IsBarber(ctx) && !auth.IsAdmin(ctx) && booking.UserID != userID {
+			return status.Errorf(codes.PermissionDenied, "you can only view your own bookings")
+		}
+
+	case *pb.ConfirmBookingRequest:
+		// Only the booked barber or an admin can confirm a booking
+		booking, err := s.bookingForOwnership(ctx, r.Id)
+		if err != nil {
+			return err
+		}
+		if !isBookedBarber(ctx, booking, userID) && !auth.IsAdmin(ctx) {
+			return status.Errorf(codes.PermissionDenied, "only the booked barber can confirm this booking")
+		}
+
+	case *pb.CompleteBookingRequest:
+		// Only the booked barber or an admin can complete a booking
+		booking, err := s.bookingForOwnership(ctx, r.Id)
+		if err != nil {
+			return err
+		}
+		if !isBookedBarber(ctx, booking, userID) && !auth.IsAdmin(ctx) {
+			return status.Errorf(codes.PermissionDenied, "only the booked barber can complete this booking")
+		}
+
+	case *pb.GetBarberBookingsRequest:
+		// Barbers only see their own schedule
+		if !auth.IsAdmin(ctx) && r.BarberId != userID {
+			return status.Errorf(codes.PermissionDenied, "only the barber or an admin can view a barber's bookings")
+		}
+
+	case *pb.CheckInBookingRequest:
+		// Only the booked barber or an admin can check a customer in
+		booking, err := s.bookingForOwnership(ctx, r.Id)
+		if err != nil {
+			return err
+		}
+		if !isBookedBarber(ctx, booking, userID) && !auth.IsAdmin(ctx) {
+			return status.Errorf(codes.PermissionDenied, "only the booked barber can check in this booking")
+		}
+
+	case *pb.MarkNoShowRequest:
+		// Only the booked barber or an admin can mark a no-show
+		booking, err := s.bookingForOwnership(ctx, r.Id)
+		if err != nil {
+			return err
+		}
+		if !isBookedBarber(ctx, booking, userID) && !auth.IsAdmin(ctx) {
+			return status.Errorf(codes.PermissionDenied, "only the booked barber can mark this booking as a no-show")
+		}
+
+	case *pb.GetBookingHistoryRequest:
+		// Customers only see the history of their own bookings
+		booking, err := s.bookingForOwnership(ctx, r.BookingId)
+		if err != nil {
+			return err
+		}
+		if !auth.IsBarber(ctx) && !auth.IsAdmin(ctx) && booking.UserID != userID {
+			return status.Errorf(codes.PermissionDenied, "you can only view the history of your own bookings")
+		}
+
+	case *pb.GetBookingICSRequest:
+		booking, err := s.bookingForOwnership(ctx, r.Id)
+		if err != nil {
+			return err
+		}
+		if !isBookedBarber(ctx, booking, userID) && !auth.IsAdmin(ctx) && booking.UserID != userID {
+			return status.Errorf(codes.PermissionDenied, "you can only add your own bookings to a calendar")
+		}
+
+	case *pb.SubmitReviewRequest:
+		// Only the booking's customer can review it
+		booking, err := s.bookingForOwnership(ctx, r.BookingId)
+		if err != nil {
+			return err
+		}
+		if booking.UserID != userID {
+			return status.Errorf(codes.PermissionDenied, "you can only review your own bookings")
+		}
+
+	default:
+		return status.Errorf(codes.PermissionDenied, "no ownership check for %s", fullMethod)
+	}
+
+	return nil
+}
+
+// bookingForOwnership loads the booking an ownership check is about
+func (s *BookingServer) bookingForOwnership(ctx context.Context, id string) (*model.Booking, error) {
+	booking, err := s.service.GetBooking(ctx, id)
+	if err != nil {
+		if errors.Is(err, service.ErrBookingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve booking: %v", err)
+	}
+	if booking == nil {
+		return nil, status.Errorf(codes.NotFound, "booking not found")
+	}
+
+	return booking, nil
+}
+
+// isBookedBarber reports whether the caller is the barber a booking is with
+func isBookedBarber(ctx context.Context, booking *model.Booking, userID string) bool {
+	return auth.IsBarber(ctx) && booking.BarberID == userID
+}
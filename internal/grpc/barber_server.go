@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// ListBarbers returns the barbers' profiles. Anyone may call it, signed in or not.
+func (s *BookingServer) ListBarbers(ctx context.Context, req *pb.ListBarbersRequest) (*pb.BarberList, error) {
+	// Only staff see barbers who aren't taking bookings
+	includeInactive := req.IncludeInactive && (auth.IsBarber(ctx) || auth.IsAdmin(ctx))
+
+	barbers, err := s.service.ListBarbers(ctx, includeInactive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list barbers: %v", err)
+	}
+
+	pbBarbers := make([]*pb.Barber, len(barbers))
+	for i, barber := range barbers {
+		pbBarbers[i] = convertBarberToProto(barber)
+	}
+
+	return &pb.BarberList{Barbers: pbBarbers}, nil
+}
+
+// GetBarber returns a barber's profile
+func (s *BookingServer) GetBarber(ctx context.Context, req *pb.GetBarberRequest) (*pb.Barber, error) {
+	barber, err := s.service.GetBarber(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, service.ErrBarberNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get barber: %v", err)
+	}
+
+	return convertBarberToProto(barber), nil
+}
+
+// CreateBarber adds a barber's profile
+func (s *BookingServer) CreateBarber(ctx context.Context, req *pb.CreateBarberRequest) (*pb.Barber, error) {
+	if req.Barber == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "barber is required")
+	}
+
+	created, err := s.service.CreateBarber(ctx, convertBarberFromProto(req.Barber))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidBarber) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrBarberExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create barber: %v", err)
+	}
+
+	return convertBarberToProto(created), nil
+}
+
+// UpdateBarber changes a barber's profile. Barbers edit their own profile;
+// only admins can change whether a barber is taking bookings and how many
+// clients they take at once.
+func (s *BookingServer) UpdateBarber(ctx context.Context, req *pb.UpdateBarberRequest) (*pb.Barber, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if req.Barber == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "barber is required")
+	}
+
+	if !auth.IsAdmin(ctx) {
+		if !auth.IsBarber(ctx) || req.Barber.Id != userID {
+			return nil, status.Errorf(codes.PermissionDenied, "only the barber or an admin can update a barber's profile")
+		}
+
+		current, err := s.service.GetBarber(ctx, req.Barber.Id)
+		if err != nil {
+			if errors.Is(err, service.ErrBarberNotFound) {
+				return nil, status.Errorf(codes.NotFound, "%v", err)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get barber: %v", err)
+		}
+		if current.Active != req.Barber.Active {
+			return nil, status.Errorf(codes.PermissionDenied, "only an admin can change whether a barber is active")
+		}
+		if current.Capacity != int(req.Barber.Capacity) {
+			return nil, status.Errorf(codes.PermissionDenied, "only an admin can change a barber's capacity")
+		}
+	}
+
+	updated, err := s.service.UpdateBarber(ctx, convertBarberFromProto(req.Barber))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidBarber) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrBarberNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update barber: %v", err)
+	}
+
+	return convertBarberToProto(updated), nil
+}
+
+// DeleteBarber removes a barber's profile
+func (s *BookingServer) DeleteBarber(ctx context.Context, req *pb.DeleteBarberRequest) (*pb.DeleteBarberResponse, error) {
+	deleted, err := s.service.DeleteBarber(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete barber: %v", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "%v", service.ErrBarberNotFound)
+	}
+
+	return &pb.DeleteBarberResponse{Success: true}, nil
+}
+
+// Helper function to convert model.Barber to proto Barber
+func convertBarberToProto(barber *model.Barber) *pb.Barber {
+	result := &pb.Barber{
+		Id:          barber.ID,
+		DisplayName: barber.DisplayName,
+		Bio:         barber.Bio,
+		PhotoUrl:    barber.PhotoURL,
+		Active:      barber.Active,
+		Capacity:    int32(barber.Capacity),
+		Services:    convertOfferedServicesToProto(barber.Services),
+	}
+	if barber.Rating != nil {
+		result.Rating = convertBarberRatingToProto(barber.Rating)
+	}
+	return result
+}
+
+// Helper function to convert proto Barber to model.Barber. Services are set
+// with SetBarberServices, so they're ignored.
+func convertBarberFromProto(barber *pb.Barber) model.Barber {
+	return model.Barber{
+		ID:          barber.Id,
+		DisplayName: barber.DisplayName,
+		Bio:         barber.Bio,
+		PhotoURL:    barber.PhotoUrl,
+		Active:      barber.Active,
+		Capacity:    int(barber.Capacity),
+	}
+}
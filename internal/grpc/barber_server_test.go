@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Anonymous caller lists the barbers (should succeed, active barbers only)
+func TestListBarbers_Anonymous(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("ListBarbers", mock.Anything, false).Return([]*model.Barber{{
+		ID:          "barber1",
+		DisplayName: "Sam",
+		Active:      true,
+		Services:    []*model.OfferedService{{ServiceType: model.ServiceTypeHaircut, Name: "haircut", DurationMinutes: 30, Price: 2500}},
+	}}, nil)
+
+	// Call the method, asking for inactive barbers too
+	resp, err := withPolicy(server, "ListBarbers", server.ListBarbers)(context.Background(), &pb.ListBarbersRequest{IncludeInactive: true})
+
+	// Assertions
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Barbers, 1) {
+		assert.Equal(t, "Sam", resp.Barbers[0].DisplayName)
+		assert.Equal(t, int64(2500), resp.Barbers[0].Services[0].Price)
+	}
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber updates their own profile (should succeed)
+func TestUpdateBarber_Self(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	barber := model.Barber{ID: "barber1", DisplayName: "Sam", Bio: "Fades", Active: true}
+
+	// Set up mock expectations
+	mockService.On("GetBarber", mock.Anything, "barber1").Return(&model.Barber{ID: "barber1", DisplayName: "Sam", Active: true}, nil)
+	mockService.On("UpdateBarber", mock.Anything, barber).Return(&barber, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "UpdateBarber", server.UpdateBarber)(mockContextWithClaims("barber1", true), &pb.UpdateBarberRequest{
+		Barber: &pb.Barber{Id: "barber1", DisplayName: "Sam", Bio: "Fades", Active: true},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "Fades", resp.Bio)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber reactivates themselves or edits someone else (should fail)
+func TestUpdateBarber_NotAllowed(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetBarber", mock.Anything, "barber1").Return(&model.Barber{ID: "barber1", DisplayName: "Sam", Active: false}, nil)
+
+	update := withPolicy(server, "UpdateBarber", server.UpdateBarber)
+	_, err := update(mockContextWithClaims("barber1", true), &pb.UpdateBarberRequest{
+		Barber: &pb.Barber{Id: "barber1", DisplayName: "Sam", Active: true},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = update(mockContextWithClaims("barber1", true), &pb.UpdateBarberRequest{
+		Barber: &pb.Barber{Id: "barber1", DisplayName: "Sam", Capacity: 3},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = update(mockContextWithClaims("barber2", true), &pb.UpdateBarberRequest{
+		Barber: &pb.Barber{Id: "barber1", DisplayName: "Sam"},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mockService.AssertNotCalled(t, "UpdateBarber", mock.Anything, mock.Anything)
+}
+
+// Test: Barber creates or deletes a profile (should fail)
+func TestCreateDeleteBarber_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	_, err := withPolicy(server, "CreateBarber", server.CreateBarber)(mockContextWithClaims("barber1", true), &pb.CreateBarberRequest{
+		Barber: &pb.Barber{Id: "barber1", DisplayName: "Sam", Active: true},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = withPolicy(server, "DeleteBarber", server.DeleteBarber)(mockContextWithClaims("barber1", true), &pb.DeleteBarberRequest{Id: "barber2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mockService.AssertExpectations(t)
+}
+
+// Test: Admin deletes a barber without a profile (should fail)
+func TestDeleteBarber_NotFound(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("DeleteBarber", mock.Anything, "barber9").Return(false, nil)
+
+	// Call the method
+	_, err := server.DeleteBarber(mockAdminContext("admin1"), &pb.DeleteBarberRequest{Id: "barber9"})
+
+	// Assertions
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
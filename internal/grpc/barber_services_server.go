@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// GetBarberServices returns the services a barber offers
+func (s *BookingServer) GetBarberServices(ctx context.Context, req *pb.GetBarberServicesRequest) (*pb.BarberServiceList, error) {
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	services, err := s.service.GetBarberServices(ctx, req.BarberId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get barber services: %v", err)
+	}
+
+	return &pb.BarberServiceList{BarberId: req.BarberId, Services: convertOfferedServicesToProto(services)}, nil
+}
+
+// SetBarberServices replaces the services a barber offers. Barbers manage
+// their own services; admins can manage anyone's.
+func (s *BookingServer) SetBarberServices(ctx context.Context, req *pb.SetBarberServicesRequest) (*pb.BarberServiceList, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	barberID := req.BarberId
+	if barberID == "" && auth.IsBarber(ctx) {
+		barberID = userID
+	}
+	if barberID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	isOwnServices := auth.IsBarber(ctx) && barberID == userID
+	if !isOwnServices && !auth.IsAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the barber or an admin can set barber services")
+	}
+
+	barberServices := make([]model.BarberService, len(req.Services))
+	for i, service := range req.Services {
+		barberServices[i] = model.BarberService{
+			ServiceType:     model.ServiceType(service.ServiceType),
+			DurationMinutes: int(service.DurationMinutes),
+			Price:           service.Price,
+		}
+	}
+
+	services, err := s.service.SetBarberServices(ctx, model.BarberServices{
+		BarberID:  barberID,
+		Services:  barberServices,
+		UpdatedBy: userID,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidBarberServices) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set barber services: %v", err)
+	}
+
+	return &pb.BarberServiceList{BarberId: barberID, Services: convertOfferedServicesToProto(services)}, nil
+}
+
+// GetQuote returns the price and duration of a prospective booking
+func (s *BookingServer) GetQuote(ctx context.Context, req *pb.GetQuoteRequest) (*pb.Quote, error) {
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	quote, err := s.service.GetQuote(ctx, req.BarberId, convertServiceTypesFromProto(req.ServiceTypes))
+	if err != nil {
+		if errors.Is(err, service.ErrNoServices) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrServiceNotOffered) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get quote: %v", err)
+	}
+
+	return &pb.Quote{
+		BarberId:        quote.BarberID,
+		Services:        convertOfferedServicesToProto(quote.Services),
+		DurationMinutes: int32(quote.Duration().Minutes()),
+		Price:           quote.Price,
+		Currency:        quote.Currency,
+	}, nil
+}
+
+// Helper function to convert offered services to proto BarberServices
+func convertOfferedServicesToProto(services []*model.OfferedService) []*pb.BarberService {
+	pbServices := make([]*pb.BarberService, len(services))
+	for i, service := range services {
+		pbServices[i] = &pb.BarberService{
+			ServiceType:     pb.ServiceType(service.ServiceType),
+			Name:            service.Name,
+			DurationMinutes: int32(service.DurationMinutes),
+			Price:           service.Price,
+		}
+	}
+	return pbServices
+}
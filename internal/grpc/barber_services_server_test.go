@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Barber sets their own services (should succeed)
+func TestSetBarberServices_OwnServices(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("SetBarberServices", mock.Anything, model.BarberServices{
+		BarberID:  "barber1",
+		Services:  []model.BarberService{{ServiceType: model.ServiceTypeHaircut, DurationMinutes: 20}},
+		UpdatedBy: "barber1",
+	}).Return([]*model.OfferedService{
+		{ServiceType: model.ServiceTypeHaircut, Name: "haircut", DurationMinutes: 20},
+	}, nil)
+
+	// Call the method
+	resp, err := server.SetBarberServices(mockContextWithClaims("barber1", true), &pb.SetBarberServicesRequest{
+		Services: []*pb.BarberService{{ServiceType: pb.ServiceType_HAIRCUT, DurationMinutes: 20}},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "barber1", resp.BarberId)
+	assert.Len(t, resp.Services, 1)
+	assert.Equal(t, int32(20), resp.Services[0].DurationMinutes)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber sets another barber's services (should fail)
+func TestSetBarberServices_OtherBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := server.SetBarberServices(mockContextWithClaims("barber1", true), &pb.SetBarberServicesRequest{
+		BarberId: "barber2",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "SetBarberServices")
+}
+
+// Test: Quote for a service the barber doesn't offer (should fail)
+func TestGetQuote_ServiceNotOffered(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetQuote", mock.Anything, "barber1", []model.ServiceType{model.ServiceTypeBeardTrim}).
+		Return(nil, service.ErrServiceNotOffered)
+
+	// Call the method
+	resp, err := server.GetQuote(mockContextWithClaims("user1", false), &pb.GetQuoteRequest{
+		BarberId:     "barber1",
+		ServiceTypes: []pb.ServiceType{pb.ServiceType_BEARD_TRIM},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+// Test: Quote for two services (should sum their durations and prices)
+func TestGetQuote_SeveralServices(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetQuote", mock.Anything, "barber1", []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim}).
+		Return(&model.Quote{
+			BarberID: "barber1",
+			Services: []*model.OfferedService{
+				{ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Price: 2500},
+				{ServiceType: model.ServiceTypeBeardTrim, Name: "Beard Trim", DurationMinutes: 15, Price: 1200},
+			},
+			Price:    3700,
+			Currency: "EUR",
+		}, nil)
+
+	// Call the method
+	resp, err := server.GetQuote(mockContextWithClaims("user1", false), &pb.GetQuoteRequest{
+		BarberId:     "barber1",
+		ServiceTypes: []pb.ServiceType{pb.ServiceType_HAIRCUT, pb.ServiceType_BEARD_TRIM},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, int32(45), resp.DurationMinutes)
+	assert.Equal(t, int64(3700), resp.Price)
+	assert.Equal(t, "EUR", resp.Currency)
+	assert.Len(t, resp.Services, 2)
+}
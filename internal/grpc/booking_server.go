@@ -2,12 +2,14 @@ package grpc
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
-	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ita-av/booking-service/internal/auth"
 	"github.com/ita-av/booking-service/internal/model"
@@ -15,6 +17,12 @@ import (
 	pb "github.com/ita-av/booking-service/pkg/api/proto"
 )
 
+// maxIdempotencyKeyLength bounds client-supplied idempotency keys
+const maxIdempotencyKeyLength = 255
+
+// maxCancellationReasonLength bounds the reason given for a cancellation
+const maxCancellationReasonLength = 500
+
 // BookingServer implements the gRPC BookingService
 type BookingServer struct {
 	pb.UnimplementedBookingServiceServer
@@ -44,46 +52,158 @@ func (s *BookingServer) CreateBooking(ctx context.Context, req *pb.CreateBooking
 		return nil, status.Errorf(codes.PermissionDenied, "regular users can only create bookings for themselves")
 	}
 
-	// Continue with booking creation...
-	startTime, err := time.Parse(time.RFC3339, req.StartTime)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid start time format: %v", err)
+	// Continue with booking creation; a hold has its own time and services
+	var startTime time.Time
+	var serviceTypes []model.ServiceType
+	if req.HoldId == "" {
+		var ok bool
+		startTime, ok, err = parseTimeInput(req.StartTimeTs, req.StartTime, "start time")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "start time is required")
+		}
+
+		// Convert services, falling back to the single service type
+		serviceTypes = convertServiceTypesFromProto(req.ServiceTypes)
+		if len(serviceTypes) == 0 {
+			serviceTypes = []model.ServiceType{model.ServiceType(req.ServiceType)}
+		}
 	}
 
-	// Convert service type
-	serviceType := model.ServiceType(req.ServiceType)
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
 
 	// Create booking
-	booking, err := s.service.CreateBooking(
-		ctx,
-		req.UserId,
-		req.BarberId,
-		startTime,
-		serviceType,
-		req.Notes,
-	)
+	booking, err := s.service.CreateBooking(ctx, service.CreateBookingParams{
+		UserID:         req.UserId,
+		BarberID:       req.BarberId,
+		StartTime:      startTime,
+		ServiceTypes:   serviceTypes,
+		Notes:          req.Notes,
+		ExternalRef:    req.ExternalRef,
+		PromoCode:      req.PromoCode,
+		IdempotencyKey: req.IdempotencyKey,
+		HoldID:         req.HoldId,
+	})
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to create booking")
-		return nil, status.Errorf(codes.Internal, "failed to create booking: %v", err)
+		return nil, createBookingError(err)
 	}
 
 	// Convert to protobuf message
-	return convertBookingToProto(booking), nil
+	return s.convertBookingForCaller(ctx, booking), nil
+}
+
+// createBookingError maps the errors of creating a booking to gRPC statuses
+func createBookingError(err error) error {
+	if errors.Is(err, service.ErrIdempotencyKeyReused) || errors.Is(err, service.ErrStartTimeInPast) || errors.Is(err, service.ErrNoServices) {
+		return domainError(codes.InvalidArgument, err)
+	}
+	if errors.Is(err, service.ErrDuplicateBooking) || errors.Is(err, service.ErrExternalRefConflict) {
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	}
+	if errors.Is(err, service.ErrHoldNotFound) {
+		return status.Errorf(codes.NotFound, "hold not found")
+	}
+	if errors.Is(err, service.ErrHoldExpired) {
+		return domainError(codes.FailedPrecondition, err)
+	}
+	if errors.Is(err, service.ErrSlotBusy) {
+		return status.Errorf(codes.Unavailable, "%v", service.ErrSlotBusy)
+	}
+	if errors.Is(err, service.ErrSlotUnavailable) || errors.Is(err, service.ErrDuringBreak) || errors.Is(err, service.ErrShopClosed) ||
+		errors.Is(err, service.ErrBookingTooSoon) || errors.Is(err, service.ErrBookingTooFarAhead) || errors.Is(err, service.ErrServiceNotOffered) ||
+		errors.Is(err, service.ErrBarberInactive) || errors.Is(err, service.ErrResourceUnavailable) ||
+		errors.Is(err, service.ErrPromoCodeUnavailable) {
+		return domainError(codes.FailedPrecondition, err)
+	}
+
+	return status.Errorf(codes.Internal, "failed to create booking: %v", err)
+}
+
+// HoldTimeSlot reserves a slot for a few minutes while the customer finishes
+// booking it
+func (s *BookingServer) HoldTimeSlot(ctx context.Context, req *pb.HoldTimeSlotRequest) (*pb.Booking, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	// Like bookings, customers can only hold slots for themselves
+	if !auth.IsBarber(ctx) && userID != req.UserId {
+		return nil, status.Errorf(codes.PermissionDenied, "regular users can only hold slots for themselves")
+	}
+
+	hold, err := s.service.HoldTimeSlot(ctx, service.HoldTimeSlotParams{
+		UserID:       req.UserId,
+		BarberID:     req.BarberId,
+		StartTime:    req.StartTime.AsTime(),
+		ServiceTypes: convertServiceTypesFromProto(req.ServiceTypes),
+		Duration:     time.Duration(req.HoldMinutes) * time.Minute,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrStartTimeInPast) || errors.Is(err, service.ErrNoServices) || errors.Is(err, service.ErrInvalidHoldDuration) {
+			return nil, domainError(codes.InvalidArgument, err)
+		}
+		if errors.Is(err, service.ErrSlotBusy) {
+			return nil, status.Errorf(codes.Unavailable, "%v", service.ErrSlotBusy)
+		}
+		if errors.Is(err, service.ErrSlotUnavailable) || errors.Is(err, service.ErrDuringBreak) || errors.Is(err, service.ErrShopClosed) ||
+			errors.Is(err, service.ErrBookingTooSoon) || errors.Is(err, service.ErrBookingTooFarAhead) || errors.Is(err, service.ErrServiceNotOffered) ||
+			errors.Is(err, service.ErrBarberInactive) || errors.Is(err, service.ErrResourceUnavailable) {
+			return nil, domainError(codes.FailedPrecondition, err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to hold time slot: %v", err)
+	}
+
+	return s.convertBookingToProto(hold), nil
 }
 
 // GetBooking retrieves a booking by ID
 func (s *BookingServer) GetBooking(ctx context.Context, req *pb.GetBookingRequest) (*pb.Booking, error) {
 	booking, err := s.service.GetBooking(ctx, req.Id)
 	if err != nil {
-		if errors.Is(err, errors.New("booking not found")) {
+		if errors.Is(err, service.ErrBookingNotFound) {
 			return nil, status.Errorf(codes.NotFound, "booking not found")
 		}
 
-		log.Error().Err(err).Msg("Failed to get booking")
 		return nil, status.Errorf(codes.Internal, "failed to get booking: %v", err)
 	}
 
-	return convertBookingToProto(booking), nil
+	return s.convertBookingForCaller(ctx, booking), nil
+}
+
+// GetBookingByExternalRef retrieves a booking by its external reference
+func (s *BookingServer) GetBookingByExternalRef(ctx context.Context, req *pb.GetBookingByExternalRefRequest) (*pb.Booking, error) {
+	// Get authentication info
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if req.ExternalRef == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "external reference is required")
+	}
+
+	booking, err := s.service.GetBookingByExternalRef(ctx, req.ExternalRef)
+	if err != nil {
+		if errors.Is(err, service.ErrBookingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get booking: %v", err)
+	}
+
+	// Authorization check
+	isBarber := auth.IsBarber(ctx)
+	if !isBarber && booking.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you can only view your own bookings")
+	}
+
+	return s.convertBookingToProto(booking), nil
 }
 
 // UpdateBooking updates an existing booking
@@ -110,36 +230,183 @@ func (s *BookingServer) UpdateBooking(ctx context.Context, req *pb.UpdateBooking
 		return nil, status.Errorf(codes.PermissionDenied, "you can only update your own bookings")
 	}
 
-	var startTime *time.Time
-	var serviceType *model.ServiceType
+	params, err := updateParamsFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
 
-	// Parse start time if provided
-	if req.StartTime != "" {
-		t, err := time.Parse(time.RFC3339, req.StartTime)
+	// Update booking
+	booking, err = s.service.UpdateBooking(ctx, req.Id, params)
+	if err != nil {
+		if errors.Is(err, service.ErrBookingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		}
+		if errors.Is(err, service.ErrBookingModified) {
+			return nil, status.Errorf(codes.Aborted, "booking was modified, reload it and try again")
+		}
+		if errors.Is(err, service.ErrStartTimeInPast) || errors.Is(err, service.ErrNoServices) {
+			return nil, domainError(codes.InvalidArgument, err)
+		}
+		if errors.Is(err, service.ErrSlotUnavailable) || errors.Is(err, service.ErrDuringBreak) || errors.Is(err, service.ErrShopClosed) ||
+			errors.Is(err, service.ErrBookingTooSoon) || errors.Is(err, service.ErrBookingTooFarAhead) || errors.Is(err, service.ErrServiceNotOffered) ||
+			errors.Is(err, service.ErrBarberInactive) || errors.Is(err, service.ErrResourceUnavailable) || errors.Is(err, service.ErrBookingHeld) ||
+			errors.Is(err, service.ErrBookingCancelled) || errors.Is(err, service.ErrBookingCompleted) || errors.Is(err, service.ErrBookingNoShow) ||
+			errors.Is(err, service.ErrSlotReleased) {
+			return nil, domainError(codes.FailedPrecondition, err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update booking: %v", err)
+	}
+
+	return s.convertBookingForCaller(ctx, booking), nil
+}
+
+// updateParamsFromRequest picks the fields to change from an update request.
+// With an update mask only the listed fields are applied, so notes can be
+// cleared and the service type set back to a haircut. Without one, a start
+// time and services or a non-default service type are applied if present and
+// the notes are always replaced.
+func updateParamsFromRequest(req *pb.UpdateBookingRequest) (service.UpdateBookingParams, error) {
+	params := service.UpdateBookingParams{Version: req.Version}
+
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		t, ok, err := parseTimeInput(req.StartTimeTs, req.StartTime, "start time")
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid start time format: %v", err)
+			return params, err
+		}
+		if ok {
+			params.StartTime = &t
+		}
+
+		if len(req.ServiceTypes) > 0 {
+			params.ServiceTypes = convertServiceTypesFromProto(req.ServiceTypes)
+		} else if req.ServiceType != pb.ServiceType_HAIRCUT {
+			params.ServiceTypes = []model.ServiceType{model.ServiceType(req.ServiceType)}
+		}
+
+		notes := req.Notes
+		params.Notes = &notes
+		return params, nil
+	}
+
+	for _, path := range req.UpdateMask.Paths {
+		switch path {
+		case "start_time", "start_time_ts":
+			t, ok, err := parseTimeInput(req.StartTimeTs, req.StartTime, "start time")
+			if err != nil {
+				return params, err
+			}
+			if !ok {
+				return params, status.Errorf(codes.InvalidArgument, "start time is required when it's in the update mask")
+			}
+			params.StartTime = &t
+		case "service_type":
+			params.ServiceTypes = []model.ServiceType{model.ServiceType(req.ServiceType)}
+		case "service_types":
+			if len(req.ServiceTypes) == 0 {
+				return params, status.Errorf(codes.InvalidArgument, "service_types can't be empty when it's in the update mask")
+			}
+			params.ServiceTypes = convertServiceTypesFromProto(req.ServiceTypes)
+		case "notes":
+			notes := req.Notes
+			params.Notes = &notes
+		default:
+			return params, status.Errorf(codes.InvalidArgument, "unsupported update mask path %q", path)
 		}
-		startTime = &t
 	}
 
-	// Convert service type if provided
-	if req.ServiceType != pb.ServiceType_HAIRCUT {
-		st := model.ServiceType(req.ServiceType)
-		serviceType = &st
+	return params, nil
+}
+
+// RescheduleBooking moves a booking to a new start time
+func (s *BookingServer) RescheduleBooking(ctx context.Context, req *pb.RescheduleBookingRequest) (*pb.Booking, error) {
+	// Get authentication info
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	// Update booking
-	booking, err = s.service.UpdateBooking(ctx, req.Id, startTime, serviceType, &req.Notes)
+	startTime, ok, err := parseTimeInput(req.StartTimeTs, req.StartTime, "start time")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "start time is required")
+	}
+
+	// Get the booking to check ownership
+	booking, err := s.service.GetBooking(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve booking: %v", err)
+	}
+	if booking == nil {
+		return nil, status.Errorf(codes.NotFound, "booking not found")
+	}
+
+	// Authorization check
+	if !auth.IsBarber(ctx) && booking.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you can only reschedule your own bookings")
+	}
+
+	rescheduled, err := s.service.RescheduleBooking(ctx, req.Id, startTime)
 	if err != nil {
-		if errors.Is(err, errors.New("booking not found")) {
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound):
 			return nil, status.Errorf(codes.NotFound, "booking not found")
+		case errors.Is(err, service.ErrBookingModified):
+			return nil, status.Errorf(codes.Aborted, "%v", err)
+		case errors.Is(err, service.ErrSlotBusy):
+			return nil, status.Errorf(codes.Unavailable, "%v", service.ErrSlotBusy)
+		case errors.Is(err, service.ErrStartTimeInPast):
+			return nil, domainError(codes.InvalidArgument, err)
+		case errors.Is(err, service.ErrSlotUnavailable), errors.Is(err, service.ErrDuringBreak),
+			errors.Is(err, service.ErrShopClosed), errors.Is(err, service.ErrBookingCancelled), errors.Is(err, service.ErrBookingCompleted),
+			errors.Is(err, service.ErrBookingNoShow), errors.Is(err, service.ErrSlotReleased), errors.Is(err, service.ErrBookingTooSoon), errors.Is(err, service.ErrBookingTooFarAhead),
+			errors.Is(err, service.ErrBarberInactive), errors.Is(err, service.ErrResourceUnavailable), errors.Is(err, service.ErrBookingHeld):
+			return nil, domainError(codes.FailedPrecondition, err)
 		}
 
-		log.Error().Err(err).Msg("Failed to update booking")
-		return nil, status.Errorf(codes.Internal, "failed to update booking: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reschedule booking: %v", err)
+	}
+
+	return s.convertBookingForCaller(ctx, rescheduled), nil
+}
+
+// ConfirmBooking confirms a pending booking
+func (s *BookingServer) ConfirmBooking(ctx context.Context, req *pb.ConfirmBookingRequest) (*pb.Booking, error) {
+	// The policy only lets the booked barber or an admin through
+	confirmed, err := s.service.ConfirmBooking(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound):
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		case errors.Is(err, service.ErrBookingCancelled), errors.Is(err, service.ErrInvalidStatusTransition):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to confirm booking: %v", err)
+	}
+
+	return s.convertBookingToProto(confirmed), nil
+}
+
+// CompleteBooking marks a booking as completed
+func (s *BookingServer) CompleteBooking(ctx context.Context, req *pb.CompleteBookingRequest) (*pb.Booking, error) {
+	// The policy only lets the booked barber or an admin through
+	completed, err := s.service.CompleteBooking(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound):
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		case errors.Is(err, service.ErrBookingCancelled), errors.Is(err, service.ErrBookingNotEnded),
+			errors.Is(err, service.ErrInvalidStatusTransition):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to complete booking: %v", err)
 	}
 
-	return convertBookingToProto(booking), nil
+	return s.convertBookingToProto(completed), nil
 }
 
 // CancelBooking cancels an existing booking
@@ -165,50 +432,343 @@ func (s *BookingServer) CancelBooking(ctx context.Context, req *pb.CancelBooking
 		return nil, status.Errorf(codes.PermissionDenied, "you can only cancel your own bookings")
 	}
 
-	// Cancel booking
-	success, err := s.service.CancelBooking(ctx, req.Id)
+	if len(req.Reason) > maxCancellationReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxCancellationReasonLength)
+	}
+
+	// Cancel booking; the policy only applies to customers, not the shop
+	cancelledBooking, err := s.service.CancelBooking(ctx, req.Id, service.CancelBookingParams{
+		CancelledBy: userID,
+		Reason:      req.Reason,
+		WaivePolicy: isBarber || auth.IsAdmin(ctx),
+	})
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to cancel booking")
+		if errors.Is(err, service.ErrInvalidStatusTransition) || errors.Is(err, service.ErrCancellationNotAllowed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
 		return nil, status.Errorf(codes.Internal, "failed to cancel booking: %v", err)
 	}
 
-	var message string
-	if success {
-		message = "Booking cancelled successfully"
-	} else {
-		message = "Booking not found or already cancelled"
+	if cancelledBooking == nil {
+		return &pb.CancelBookingResponse{
+			Success: false,
+			Message: "Booking not found or already cancelled",
+		}, nil
+	}
+
+	resp := &pb.CancelBookingResponse{
+		Success: true,
+		Message: "Booking cancelled successfully",
+	}
+	if cancellation := cancelledBooking.Cancellation; cancellation != nil {
+		resp.Fee = cancellation.Fee
+		resp.Currency = cancellation.Currency
+		resp.CancelledAt = timestamppb.New(cancellation.CancelledAt)
+		if cancellation.Fee > 0 {
+			resp.Message = fmt.Sprintf("Booking cancelled with a fee of %d %s", cancellation.Fee, cancellation.Currency)
+		}
 	}
 
-	return &pb.CancelBookingResponse{
-		Success: success,
-		Message: message,
+	return resp, nil
+}
+
+// DeleteBooking soft-deletes a booking
+func (s *BookingServer) DeleteBooking(ctx context.Context, req *pb.DeleteBookingRequest) (*pb.DeleteBookingResponse, error) {
+	if req.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "booking ID is required")
+	}
+
+	deleted, err := s.service.DeleteBooking(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete booking: %v", err)
+	}
+
+	return &pb.DeleteBookingResponse{Deleted: deleted}, nil
+}
+
+// CheckInBooking records that the customer of a booking has arrived
+func (s *BookingServer) CheckInBooking(ctx context.Context, req *pb.CheckInBookingRequest) (*pb.Booking, error) {
+	// Get authentication info
+	_, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	booking, err := s.service.CheckInBooking(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound):
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		case errors.Is(err, service.ErrBookingCancelled), errors.Is(err, service.ErrBookingNoShow), errors.Is(err, service.ErrSlotReleased):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to check in booking: %v", err)
+	}
+
+	return s.convertBookingToProto(booking), nil
+}
+
+// MarkNoShow records that the customer didn't turn up for a booking
+func (s *BookingServer) MarkNoShow(ctx context.Context, req *pb.MarkNoShowRequest) (*pb.Booking, error) {
+	// Get authentication info
+	_, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	booking, err := s.service.MarkNoShow(ctx, req.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound):
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		case errors.Is(err, service.ErrBookingCancelled), errors.Is(err, service.ErrCustomerCheckedIn),
+			errors.Is(err, service.ErrInvalidStatusTransition):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to mark booking as no-show: %v", err)
+	}
+
+	return s.convertBookingToProto(booking), nil
+}
+
+// GetUserReliability returns how a customer's bookings turned out
+func (s *BookingServer) GetUserReliability(ctx context.Context, req *pb.GetUserReliabilityRequest) (*pb.UserReliability, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user ID is required")
+	}
+
+	reliability, err := s.service.GetUserReliability(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user reliability: %v", err)
+	}
+
+	return &pb.UserReliability{
+		UserId:     reliability.UserID,
+		Bookings:   int32(reliability.Bookings),
+		Completed:  int32(reliability.Completed),
+		Cancelled:  int32(reliability.Cancelled),
+		NoShows:    int32(reliability.NoShows),
+		NoShowRate: reliability.NoShowRate(),
 	}, nil
 }
 
-// GetUserBookings retrieves all bookings for a user
-func (s *BookingServer) GetUserBookings(ctx context.Context, req *pb.GetUserBookingsRequest) (*pb.BookingList, error) {
+// GetReliabilityReport returns no-show and cancellation rates per customer or
+// barber over a period
+func (s *BookingServer) GetReliabilityReport(ctx context.Context, req *pb.GetReliabilityReportRequest) (*pb.ReliabilityReport, error) {
+	// Only the calendar days matter; the service takes them in the shop's zone
+	first, ok, err := parseDateInput("", req.StartDay, "UTC")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "start day is required")
+	}
+
+	last, ok, err := parseDateInput("", req.EndDay, "UTC")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "end day is required")
+	}
+
+	rows, err := s.service.GetReliabilityReport(ctx, model.ReliabilityFilter{
+		GroupBy:     model.ReliabilityGroup(req.GroupBy),
+		BarberID:    req.BarberId,
+		Start:       first,
+		End:         last,
+		MinBookings: int(req.MinBookings),
+		SortBy:      model.ReliabilitySort(req.SortBy),
+		Limit:       int(req.Limit),
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidDateRange) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get reliability report: %v", err)
+	}
+
+	report := &pb.ReliabilityReport{
+		GroupBy:  req.GroupBy,
+		StartDay: req.StartDay,
+		EndDay:   req.EndDay,
+		Rows:     make([]*pb.ReliabilityRow, len(rows)),
+	}
+	for i, row := range rows {
+		report.Rows[i] = &pb.ReliabilityRow{
+			Id:               row.ID,
+			Bookings:         int32(row.Bookings),
+			Completed:        int32(row.Completed),
+			Cancelled:        int32(row.Cancelled),
+			NoShows:          int32(row.NoShows),
+			NoShowRate:       row.NoShowRate(),
+			CancellationRate: row.CancellationRate(),
+		}
+	}
+
+	return report, nil
+}
+
+// GetCustomerSummary returns a summary of a customer's visits
+func (s *BookingServer) GetCustomerSummary(ctx context.Context, req *pb.GetCustomerSummaryRequest) (*pb.CustomerSummary, error) {
+	if req.UserId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "user ID is required")
+	}
+
+	summary, err := s.service.GetCustomerSummary(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get customer summary: %v", err)
+	}
+
+	resp := &pb.CustomerSummary{
+		UserId:              summary.UserID,
+		TotalVisits:         int32(summary.Visits),
+		UsualService:        pb.ServiceType(summary.UsualService),
+		AverageIntervalDays: summary.AverageInterval().Hours() / 24,
+	}
+	if summary.FirstVisit != nil {
+		resp.FirstVisit = timestamppb.New(*summary.FirstVisit)
+	}
+	if summary.LastVisit != nil {
+		resp.LastVisit = timestamppb.New(*summary.LastVisit)
+	}
+	if due := summary.NextVisitDue(); due != nil {
+		resp.NextVisitDue = timestamppb.New(*due)
+	}
+
+	return resp, nil
+}
+
+// AddBookingAttachment attaches a reference image to a booking
+func (s *BookingServer) AddBookingAttachment(ctx context.Context, req *pb.AddBookingAttachmentRequest) (*pb.AddBookingAttachmentResponse, error) {
 	// Get authentication info
 	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
+	if req.Url == "" && req.ContentType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "either url or content type is required")
+	}
+
+	// Get the booking to check ownership
+	booking, err := s.service.GetBooking(ctx, req.BookingId)
+	if err != nil {
+		if errors.Is(err, service.ErrBookingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to retrieve booking: %v", err)
+	}
+
 	// Authorization check
 	isBarber := auth.IsBarber(ctx)
-	if !isBarber && userID != req.UserId {
-		return nil, status.Errorf(codes.PermissionDenied, "regular users can only view their own bookings")
+	if !isBarber && booking.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you can only add attachments to your own bookings")
 	}
 
-	bookings, err := s.service.GetUserBookings(ctx, req.UserId)
+	attachment, uploadURL, err := s.service.AddAttachment(ctx, req.BookingId, service.AttachmentParams{
+		URL:         req.Url,
+		ContentType: req.ContentType,
+		Size:        req.SizeBytes,
+	})
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get user bookings")
-		return nil, status.Errorf(codes.Internal, "failed to get user bookings: %v", err)
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound):
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		case errors.Is(err, service.ErrInvalidAttachment):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, service.ErrBookingCancelled), errors.Is(err, service.ErrTooManyAttachments):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to add attachment: %v", err)
+	}
+
+	return &pb.AddBookingAttachmentResponse{
+		Attachment: s.convertAttachmentToProto(attachment),
+		UploadUrl:  uploadURL,
+	}, nil
+}
+
+// ListBookings retrieves bookings matching the requested filters
+func (s *BookingServer) ListBookings(ctx context.Context, req *pb.ListBookingsRequest) (*pb.BookingList, error) {
+	// Get authentication info
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	filter := model.BookingFilter{
+		UserID:      req.UserId,
+		BarberID:    req.BarberId,
+		SortBy:      model.BookingSortField(req.SortBy),
+		Descending:  req.Descending,
+		NeedsReview: req.NeedsReview,
+	}
+
+	// Authorization check:
+	// Regular users can only list their own bookings and barbers the bookings
+	// with them; listing everyone's bookings is for admins
+	switch {
+	case auth.Can(ctx, auth.PermListAllBookings):
+	case auth.IsBarber(ctx):
+		if filter.BarberID == "" {
+			filter.BarberID = userID
+		}
+		if filter.BarberID != userID {
+			return nil, status.Errorf(codes.PermissionDenied, "barbers can only view their own bookings")
+		}
+	default:
+		if filter.UserID == "" {
+			filter.UserID = userID
+		}
+		if filter.UserID != userID {
+			return nil, status.Errorf(codes.PermissionDenied, "regular users can only view their own bookings")
+		}
+	}
+
+	for _, st := range req.Statuses {
+		filter.Statuses = append(filter.Statuses, model.BookingStatus(st))
+	}
+	for _, serviceType := range req.ServiceTypes {
+		filter.ServiceTypes = append(filter.ServiceTypes, model.ServiceType(serviceType))
+	}
+
+	start, ok, err := parseDateInput(req.StartDate, nil, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		filter.StartFrom = &start
+	}
+
+	end, ok, err := parseDateInput(req.EndDate, nil, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		// The end date is inclusive, so stop at the start of the next day
+		endBefore := end.AddDate(0, 0, 1)
+		filter.StartBefore = &endBefore
+	}
+
+	if filter.StartFrom != nil && filter.StartBefore != nil && !filter.StartFrom.Before(*filter.StartBefore) {
+		return nil, status.Errorf(codes.InvalidArgument, "start date must not be after end date")
+	}
+
+	bookings, err := s.service.ListBookings(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list bookings: %v", err)
 	}
 
 	// Convert to proto message
 	pbBookings := make([]*pb.Booking, len(bookings))
 	for i, booking := range bookings {
-		pbBookings[i] = convertBookingToProto(booking)
+		pbBookings[i] = s.convertBookingToProto(booking)
 	}
 
 	return &pb.BookingList{
@@ -216,42 +776,63 @@ func (s *BookingServer) GetUserBookings(ctx context.Context, req *pb.GetUserBook
 	}, nil
 }
 
-// GetBarberBookings retrieves all bookings for a barber
-func (s *BookingServer) GetBarberBookings(ctx context.Context, req *pb.GetBarberBookingsRequest) (*pb.BookingList, error) {
+// GetUserBookings retrieves all bookings for a user
+func (s *BookingServer) GetUserBookings(ctx context.Context, req *pb.GetUserBookingsRequest) (*pb.BookingList, error) {
 	// Get authentication info
-	_, err := auth.GetUserIDFromContext(ctx)
+	userID, err := auth.GetUserIDFromContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
 	}
 
-	// Authorization check:
-	// Only barbers can view barber bookings
+	// Authorization check
 	isBarber := auth.IsBarber(ctx)
-	if !isBarber {
-		return nil, status.Errorf(codes.PermissionDenied, "only barbers can view barber schedules")
+	if !isBarber && userID != req.UserId {
+		return nil, status.Errorf(codes.PermissionDenied, "regular users can only view their own bookings")
 	}
 
+	bookings, err := s.service.GetUserBookings(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user bookings: %v", err)
+	}
+
+	// Convert to proto message
+	pbBookings := make([]*pb.Booking, len(bookings))
+	for i, booking := range bookings {
+		pbBookings[i] = s.convertBookingToProto(booking)
+	}
+
+	return &pb.BookingList{
+		Bookings: pbBookings,
+	}, nil
+}
+
+// GetBarberBookings retrieves all bookings for a barber
+func (s *BookingServer) GetBarberBookings(ctx context.Context, req *pb.GetBarberBookingsRequest) (*pb.BookingList, error) {
 	var date *time.Time
 
 	// Parse date if provided
-	if req.Date != "" {
-		t, err := time.Parse("2006-01-02", req.Date)
+	if req.Date != "" || req.Day != nil {
+		timezone, err := s.barberTimezone(ctx, req.BarberId, req.Timezone)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+			return nil, err
+		}
+
+		t, _, err := parseDateInput(req.Date, req.Day, timezone)
+		if err != nil {
+			return nil, err
 		}
 		date = &t
 	}
 
 	bookings, err := s.service.GetBarberBookings(ctx, req.BarberId, date)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get barber bookings")
 		return nil, status.Errorf(codes.Internal, "failed to get barber bookings: %v", err)
 	}
 
 	// Convert to proto message
 	pbBookings := make([]*pb.Booking, len(bookings))
 	for i, booking := range bookings {
-		pbBookings[i] = convertBookingToProto(booking)
+		pbBookings[i] = s.convertBookingToProto(booking)
 	}
 
 	return &pb.BookingList{
@@ -261,44 +842,355 @@ func (s *BookingServer) GetBarberBookings(ctx context.Context, req *pb.GetBarber
 
 // GetAvailableTimeSlots retrieves available time slots for a barber on a specific date
 func (s *BookingServer) GetAvailableTimeSlots(ctx context.Context, req *pb.GetAvailableTimeSlotsRequest) (*pb.TimeSlotList, error) {
+	timezone, err := s.barberTimezone(ctx, req.BarberId, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse date
-	date, err := time.Parse("2006-01-02", req.Date)
+	date, ok, err := parseDateInput(req.Date, req.Day, timezone)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "date is required")
 	}
 
-	availableSlots, err := s.service.GetAvailableTimeSlots(ctx, req.BarberId, date)
+	serviceTypes := requestedServices(req.ServiceType, req.ServiceTypes)
+
+	availableSlots, err := s.service.GetAvailableTimeSlots(ctx, req.BarberId, date, serviceTypes)
 	if err != nil {
-		log.Error().Err(err).Msg("Failed to get available time slots")
+		if errors.Is(err, service.ErrDateInPast) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrServiceNotOffered) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
 		return nil, status.Errorf(codes.Internal, "failed to get available time slots: %v", err)
 	}
 
-	// Convert to proto message
-	pbTimeSlots := make([]*pb.TimeSlot, len(availableSlots))
-	for i, slot := range availableSlots {
+	return convertTimeSlotsToProto(availableSlots), nil
+}
+
+// GetAvailableTimeSlotsRange gets available time slots for each day of a date range
+func (s *BookingServer) GetAvailableTimeSlotsRange(ctx context.Context, req *pb.GetAvailableTimeSlotsRangeRequest) (*pb.DayTimeSlotsList, error) {
+	timezone, err := s.barberTimezone(ctx, req.BarberId, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	first, ok, err := parseDateInput(req.StartDate, req.StartDay, timezone)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "start date is required")
+	}
+
+	last, ok, err := parseDateInput(req.EndDate, req.EndDay, timezone)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "end date is required")
+	}
+
+	serviceTypes := requestedServices(req.ServiceType, req.ServiceTypes)
+
+	days, err := s.service.GetAvailableTimeSlotsRange(ctx, req.BarberId, first, last, serviceTypes)
+	if err != nil {
+		if errors.Is(err, service.ErrDateInPast) || errors.Is(err, service.ErrInvalidDateRange) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrServiceNotOffered) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get available time slots: %v", err)
+	}
+
+	pbDays := make([]*pb.DayTimeSlots, len(days))
+	for i, day := range days {
+		pbDays[i] = &pb.DayTimeSlots{
+			Day:       convertCalendarDate(day.Date),
+			TimeSlots: convertTimeSlotsToProto(day.TimeSlots).TimeSlots,
+		}
+	}
+
+	return &pb.DayTimeSlotsList{
+		Days: pbDays,
+	}, nil
+}
+
+// GetNextAvailableSlot finds a barber's earliest available slots
+func (s *BookingServer) GetNextAvailableSlot(ctx context.Context, req *pb.GetNextAvailableSlotRequest) (*pb.TimeSlotList, error) {
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber_id is required")
+	}
+
+	timezone, err := s.barberTimezone(ctx, req.BarberId, req.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := loadTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceTypes := requestedServices(req.ServiceType, req.ServiceTypes)
+
+	slots, err := s.service.GetNextAvailableSlot(ctx, req.BarberId, serviceTypes, int(req.Count))
+	if err != nil {
+		if errors.Is(err, service.ErrServiceNotOffered) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get next available slot: %v", err)
+	}
+
+	for _, slot := range slots {
+		slot.StartTime = slot.StartTime.In(loc)
+		slot.EndTime = slot.EndTime.In(loc)
+	}
+
+	return convertTimeSlotsToProto(slots), nil
+}
+
+// requestedServices returns the services availability is asked for, preferring
+// the list over the single optional service type; nil means none
+func requestedServices(serviceType *pb.ServiceType, serviceTypes []pb.ServiceType) []model.ServiceType {
+	if len(serviceTypes) > 0 {
+		return convertServiceTypesFromProto(serviceTypes)
+	}
+	if serviceType != nil {
+		return []model.ServiceType{model.ServiceType(*serviceType)}
+	}
+	return nil
+}
+
+// convertServiceTypesFromProto converts protobuf service types, nil if there
+// are none
+func convertServiceTypesFromProto(serviceTypes []pb.ServiceType) []model.ServiceType {
+	if len(serviceTypes) == 0 {
+		return nil
+	}
+	converted := make([]model.ServiceType, len(serviceTypes))
+	for i, serviceType := range serviceTypes {
+		converted[i] = model.ServiceType(serviceType)
+	}
+	return converted
+}
+
+// convertTimeSlotsToProto converts time slots to a protobuf list
+func convertTimeSlotsToProto(slots []*model.TimeSlot) *pb.TimeSlotList {
+	pbTimeSlots := make([]*pb.TimeSlot, len(slots))
+	for i, slot := range slots {
 		pbTimeSlots[i] = &pb.TimeSlot{
-			StartTime: slot.StartTime.Format(time.RFC3339),
-			EndTime:   slot.EndTime.Format(time.RFC3339),
+			StartTime:   slot.StartTime.Format(time.RFC3339),
+			EndTime:     slot.EndTime.Format(time.RFC3339),
+			StartTimeTs: timestamppb.New(slot.StartTime),
+			EndTimeTs:   timestamppb.New(slot.EndTime),
 		}
 	}
 
 	return &pb.TimeSlotList{
 		TimeSlots: pbTimeSlots,
-	}, nil
+	}
+}
+
+// RecordPOSCompletion records a point-of-sale settlement and completes the booking
+func (s *BookingServer) RecordPOSCompletion(ctx context.Context, req *pb.RecordPOSCompletionRequest) (*pb.Booking, error) {
+	// Get authentication info
+	_, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if req.BookingId == "" && req.ExternalRef == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "booking id or external reference is required")
+	}
+	if req.Amount < 0 || req.Tip < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "amount and tip must not be negative")
+	}
+	if len(req.Currency) != 3 {
+		return nil, status.Errorf(codes.InvalidArgument, "currency must be a 3-letter ISO 4217 code")
+	}
+
+	// Left zero when not given, so the service defaults it to now
+	paidAt, _, err := parseTimeInput(req.PaidAtTs, req.PaidAt, "paid at")
+	if err != nil {
+		return nil, err
+	}
+
+	renderedServices := make([]model.ServiceType, len(req.RenderedServices))
+	for i, serviceType := range req.RenderedServices {
+		renderedServices[i] = model.ServiceType(serviceType)
+	}
+
+	booking, err := s.service.RecordPOSCompletion(ctx, service.POSCompletionParams{
+		BookingID:        req.BookingId,
+		ExternalRef:      req.ExternalRef,
+		Amount:           req.Amount,
+		Tip:              req.Tip,
+		Currency:         strings.ToUpper(req.Currency),
+		RenderedServices: renderedServices,
+		PaidAt:           paidAt,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound):
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		case errors.Is(err, service.ErrBookingCancelled), errors.Is(err, service.ErrBookingAlreadyPaid),
+			errors.Is(err, service.ErrInvalidStatusTransition):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to record point-of-sale completion: %v", err)
+	}
+
+	return s.convertBookingToProto(booking), nil
 }
 
 // Helper function to convert a model.Booking to a proto Booking
-func convertBookingToProto(booking *model.Booking) *pb.Booking {
+func (s *BookingServer) convertBookingToProto(booking *model.Booking) *pb.Booking {
 	return &pb.Booking{
-		Id:          booking.ID.Hex(),
-		UserId:      booking.UserID,
-		BarberId:    booking.BarberID,
-		StartTime:   booking.StartTime.Format(time.RFC3339),
-		EndTime:     booking.EndTime.Format(time.RFC3339),
-		ServiceType: pb.ServiceType(booking.ServiceType),
-		Status:      pb.BookingStatus(booking.Status),
-		Notes:       booking.Notes,
-		CreatedAt:   booking.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:   booking.UpdatedAt.Format(time.RFC3339),
+		Id:                booking.ID.Hex(),
+		UserId:            booking.UserID,
+		BarberId:          booking.BarberID,
+		StartTime:         booking.StartTime.Format(time.RFC3339),
+		EndTime:           booking.EndTime.Format(time.RFC3339),
+		ServiceType:       pb.ServiceType(booking.ServiceType),
+		Status:            pb.BookingStatus(booking.Status),
+		Notes:             booking.Notes,
+		CreatedAt:         booking.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:         booking.UpdatedAt.Format(time.RFC3339),
+		ExternalRef:       booking.ExternalRef,
+		Payment:           convertPaymentToProto(booking.Payment),
+		Attachments:       s.convertAttachmentsToProto(booking.Attachments),
+		CheckedInAt:       formatOptionalTime(booking.CheckedInAt),
+		ReleasedAt:        formatOptionalTime(booking.ReleasedAt),
+		RescheduledFrom:   formatOptionalTime(booking.RescheduledFrom),
+		Version:           booking.Version,
+		StartTimeTs:       timestamppb.New(booking.StartTime),
+		EndTimeTs:         timestamppb.New(booking.EndTime),
+		CreatedAtTs:       timestamppb.New(booking.CreatedAt),
+		UpdatedAtTs:       timestamppb.New(booking.UpdatedAt),
+		CheckedInAtTs:     toOptionalTimestamp(booking.CheckedInAt),
+		ReleasedAtTs:      toOptionalTimestamp(booking.ReleasedAt),
+		RescheduledFromTs: toOptionalTimestamp(booking.RescheduledFrom),
+		ServiceTypes:      convertServiceTypesToProto(booking.Services()),
+		Price:             booking.Price,
+		Currency:          booking.Currency,
+		PromoCode:         booking.PromoCode,
+		Discount:          booking.Discount,
+		Deposit:           convertDepositToProto(booking.Deposit),
+		Cancellation:      convertCancellationToProto(booking.Cancellation),
+		ReviewFlaggedAtTs: toOptionalTimestamp(booking.ReviewFlaggedAt),
+		HoldExpiresAt:     toOptionalTimestamp(booking.HoldExpiresAt),
+	}
+}
+
+// Helper function to convert model.Cancellation to proto Cancellation
+func convertCancellationToProto(cancellation *model.Cancellation) *pb.Cancellation {
+	if cancellation == nil {
+		return nil
+	}
+
+	return &pb.Cancellation{
+		CancelledAt: timestamppb.New(cancellation.CancelledAt),
+		CancelledBy: cancellation.CancelledBy,
+		Reason:      cancellation.Reason,
+		Fee:         cancellation.Fee,
+		Currency:    cancellation.Currency,
+	}
+}
+
+// convertBookingForCaller converts a booking for a response to a single
+// caller. Only the booking's customer, who pays the deposit, gets the client
+// secret of a pending one.
+func (s *BookingServer) convertBookingForCaller(ctx context.Context, booking *model.Booking) *pb.Booking {
+	pbBooking := s.convertBookingToProto(booking)
+
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err == nil && userID == booking.UserID && booking.Deposit != nil && booking.Deposit.Status == model.DepositStatusPending {
+		pbBooking.Deposit.ClientSecret = booking.Deposit.ClientSecret
+	}
+	return pbBooking
+}
+
+// Helper function to convert model.Deposit to proto Deposit, without the
+// client secret
+func convertDepositToProto(deposit *model.Deposit) *pb.Deposit {
+	if deposit == nil {
+		return nil
+	}
+
+	pbDeposit := &pb.Deposit{
+		Amount:    deposit.Amount,
+		Currency:  deposit.Currency,
+		Status:    string(deposit.Status),
+		ExpiresAt: timestamppb.New(deposit.ExpiresAt),
+		PaidAt:    toOptionalTimestamp(deposit.PaidAt),
+	}
+	return pbDeposit
+}
+
+// convertServiceTypesToProto converts service types to protobuf
+func convertServiceTypesToProto(serviceTypes []model.ServiceType) []pb.ServiceType {
+	converted := make([]pb.ServiceType, len(serviceTypes))
+	for i, serviceType := range serviceTypes {
+		converted[i] = pb.ServiceType(serviceType)
+	}
+	return converted
+}
+
+// Helper function to format an optional timestamp, empty if unset
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// Helper function to convert model.Attachments to proto Attachments
+func (s *BookingServer) convertAttachmentsToProto(attachments []*model.Attachment) []*pb.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	pbAttachments := make([]*pb.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		pbAttachments[i] = s.convertAttachmentToProto(attachment)
+	}
+	return pbAttachments
+}
+
+// Helper function to convert a model.Attachment to a proto Attachment
+func (s *BookingServer) convertAttachmentToProto(attachment *model.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          attachment.ID,
+		Url:         s.service.AttachmentURL(attachment),
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.Size,
+		CreatedAt:   attachment.CreatedAt.Format(time.RFC3339),
+		CreatedAtTs: timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+// Helper function to convert a model.Payment to a proto Payment
+func convertPaymentToProto(payment *model.Payment) *pb.Payment {
+	if payment == nil {
+		return nil
+	}
+
+	return &pb.Payment{
+		Amount:           payment.Amount,
+		Tip:              payment.Tip,
+		Currency:         payment.Currency,
+		RenderedServices: convertServiceTypesToProto(payment.RenderedServices),
+		Discrepancies:    payment.Discrepancies,
+		PaidAt:           payment.PaidAt.Format(time.RFC3339),
+		PaidAtTs:         timestamppb.New(payment.PaidAt),
 	}
 }
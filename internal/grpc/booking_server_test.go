@@ -2,14 +2,20 @@ package grpc
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/ita-av/booking-service/internal/auth"
 	"github.com/ita-av/booking-service/internal/model"
@@ -26,8 +32,16 @@ var _ service.BookingServiceInterface = (*MockBookingService)(nil)
 
 // Implement all service methods...
 
-func (m *MockBookingService) CreateBooking(ctx context.Context, userID, barberID string, startTime time.Time, serviceType model.ServiceType, notes string) (*model.Booking, error) {
-	args := m.Called(ctx, userID, barberID, startTime, serviceType, notes)
+func (m *MockBookingService) CreateBooking(ctx context.Context, params service.CreateBookingParams) (*model.Booking, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) HoldTimeSlot(ctx context.Context, params service.HoldTimeSlotParams) (*model.Booking, error) {
+	args := m.Called(ctx, params)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -42,17 +56,43 @@ func (m *MockBookingService) GetBooking(ctx context.Context, id string) (*model.
 	return args.Get(0).(*model.Booking), args.Error(1)
 }
 
-func (m *MockBookingService) UpdateBooking(ctx context.Context, id string, startTime *time.Time, serviceType *model.ServiceType, notes *string) (*model.Booking, error) {
-	args := m.Called(ctx, id, startTime, serviceType, notes)
+func (m *MockBookingService) GetBookingByExternalRef(ctx context.Context, externalRef string) (*model.Booking, error) {
+	args := m.Called(ctx, externalRef)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*model.Booking), args.Error(1)
 }
 
-func (m *MockBookingService) CancelBooking(ctx context.Context, id string) (bool, error) {
-	args := m.Called(ctx, id)
-	return args.Bool(0), args.Error(1)
+func (m *MockBookingService) UpdateBooking(ctx context.Context, id string, params service.UpdateBookingParams) (*model.Booking, error) {
+	args := m.Called(ctx, id, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) CancelBooking(ctx context.Context, id string, params service.CancelBookingParams) (*model.Booking, error) {
+	args := m.Called(ctx, id, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) AddAttachment(ctx context.Context, bookingID string, params service.AttachmentParams) (*model.Attachment, string, error) {
+	args := m.Called(ctx, bookingID, params)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).(*model.Attachment), args.String(1), args.Error(2)
+}
+
+func (m *MockBookingService) AttachmentURL(attachment *model.Attachment) string {
+	if attachment.StorageKey == "" {
+		return attachment.URL
+	}
+	return m.Called(attachment).String(0)
 }
 
 func (m *MockBookingService) GetUserBookings(ctx context.Context, userID string) ([]*model.Booking, error) {
@@ -71,331 +111,2911 @@ func (m *MockBookingService) GetBarberBookings(ctx context.Context, barberID str
 	return args.Get(0).([]*model.Booking), args.Error(1)
 }
 
-func (m *MockBookingService) GetAvailableTimeSlots(ctx context.Context, barberID string, date time.Time) ([]*model.TimeSlot, error) {
-	args := m.Called(ctx, barberID, date)
+func (m *MockBookingService) GetAvailableTimeSlots(ctx context.Context, barberID string, date time.Time, serviceTypes []model.ServiceType) ([]*model.TimeSlot, error) {
+	args := m.Called(ctx, barberID, date, serviceTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.TimeSlot), args.Error(1)
+}
+
+func (m *MockBookingService) GetAvailableTimeSlotsRange(ctx context.Context, barberID string, first, last time.Time, serviceTypes []model.ServiceType) ([]*model.DaySlots, error) {
+	args := m.Called(ctx, barberID, first, last, serviceTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.DaySlots), args.Error(1)
+}
+
+func (m *MockBookingService) GetNextAvailableSlot(ctx context.Context, barberID string, serviceTypes []model.ServiceType, count int) ([]*model.TimeSlot, error) {
+	args := m.Called(ctx, barberID, serviceTypes, count)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*model.TimeSlot), args.Error(1)
 }
 
-// Mock context with user claims
-func mockContextWithClaims(userID string, isBarber bool) context.Context {
-	claims := &auth.Claims{
-		IsBarber: isBarber,
-	}
-	claims.Subject = userID
-	return context.WithValue(context.Background(), "user_claims", claims)
+func (m *MockBookingService) RecordPOSCompletion(ctx context.Context, params service.POSCompletionParams) (*model.Booking, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) SubmitSurveyResponse(ctx context.Context, bookingID, token string, score int, comment string) error {
+	args := m.Called(ctx, bookingID, token, score, comment)
+	return args.Error(0)
+}
+
+func (m *MockBookingService) GetBarberSurveyScores(ctx context.Context, barberID string, start, end *time.Time) (*model.SurveyScores, error) {
+	args := m.Called(ctx, barberID, start, end)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.SurveyScores), args.Error(1)
+}
+
+func (m *MockBookingService) SubmitReview(ctx context.Context, bookingID string, rating int, comment string) (*model.Review, error) {
+	args := m.Called(ctx, bookingID, rating, comment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Review), args.Error(1)
+}
+
+func (m *MockBookingService) ListBarberReviews(ctx context.Context, barberID string, before time.Time, limit int) ([]*model.Review, error) {
+	args := m.Called(ctx, barberID, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Review), args.Error(1)
+}
+
+func (m *MockBookingService) GetBarberRating(ctx context.Context, barberID string) (*model.BarberRating, error) {
+	args := m.Called(ctx, barberID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BarberRating), args.Error(1)
+}
+
+func (m *MockBookingService) RebookLast(ctx context.Context, userID, idempotencyKey string) (*model.Booking, error) {
+	args := m.Called(ctx, userID, idempotencyKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) AddFavoriteBarber(ctx context.Context, userID, barberID string) (*model.FavoriteBarber, error) {
+	args := m.Called(ctx, userID, barberID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.FavoriteBarber), args.Error(1)
+}
+
+func (m *MockBookingService) RemoveFavoriteBarber(ctx context.Context, userID, barberID string) (bool, error) {
+	args := m.Called(ctx, userID, barberID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingService) ListFavorites(ctx context.Context, userID string) ([]*model.FavoriteBarber, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.FavoriteBarber), args.Error(1)
+}
+
+func (m *MockBookingService) ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.PromoCode), args.Error(1)
+}
+
+func (m *MockBookingService) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PromoCode), args.Error(1)
+}
+
+func (m *MockBookingService) CreatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error) {
+	args := m.Called(ctx, promo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PromoCode), args.Error(1)
+}
+
+func (m *MockBookingService) UpdatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error) {
+	args := m.Called(ctx, promo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PromoCode), args.Error(1)
+}
+
+func (m *MockBookingService) DeletePromoCode(ctx context.Context, code string) (bool, error) {
+	args := m.Called(ctx, code)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingService) GetBarberStats(ctx context.Context, barberID string, period model.StatsPeriod, day time.Time) (*model.BarberStats, error) {
+	args := m.Called(ctx, barberID, period, day)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BarberStats), args.Error(1)
+}
+
+func (m *MockBookingService) GetRevenueReport(ctx context.Context, filter model.RevenueFilter) (*model.RevenueReport, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.RevenueReport), args.Error(1)
+}
+
+func (m *MockBookingService) GetDemandHeatmap(ctx context.Context, barberID string, weeks int) (*model.DemandHeatmap, error) {
+	args := m.Called(ctx, barberID, weeks)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.DemandHeatmap), args.Error(1)
+}
+
+func (m *MockBookingService) GetReliabilityReport(ctx context.Context, filter model.ReliabilityFilter) ([]*model.ReliabilityRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.ReliabilityRow), args.Error(1)
+}
+
+func (m *MockBookingService) SendDailyAgendas(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingService) GetDailyAgenda(ctx context.Context, barberID string, day time.Time) (*model.DailyAgenda, error) {
+	args := m.Called(ctx, barberID, day)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.DailyAgenda), args.Error(1)
+}
+
+func (m *MockBookingService) GetCustomerSummary(ctx context.Context, userID string) (*model.CustomerSummary, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CustomerSummary), args.Error(1)
+}
+
+func (m *MockBookingService) GetPayrollPeriod(ctx context.Context, year int, month time.Month) (*model.PayrollPeriod, error) {
+	args := m.Called(ctx, year, month)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PayrollPeriod), args.Error(1)
+}
+
+func (m *MockBookingService) FinalizePayrollPeriod(ctx context.Context, year int, month time.Month) (*model.PayrollPeriod, error) {
+	args := m.Called(ctx, year, month)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.PayrollPeriod), args.Error(1)
+}
+
+func (m *MockBookingService) ListBookings(ctx context.Context, filter model.BookingFilter) ([]*model.Booking, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) WatchBookings(ctx context.Context, userID, barberID string) <-chan service.BookingEvent {
+	args := m.Called(ctx, userID, barberID)
+	return args.Get(0).(chan service.BookingEvent)
+}
+
+func (m *MockBookingService) RescheduleBooking(ctx context.Context, id string, startTime time.Time) (*model.Booking, error) {
+	args := m.Called(ctx, id, startTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) ConfirmBooking(ctx context.Context, id string) (*model.Booking, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) CompleteBooking(ctx context.Context, id string) (*model.Booking, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) CheckInBooking(ctx context.Context, id string) (*model.Booking, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) MarkNoShow(ctx context.Context, id string) (*model.Booking, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) GetUserReliability(ctx context.Context, userID string) (*model.UserReliability, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.UserReliability), args.Error(1)
+}
+
+func (m *MockBookingService) GetBookingHistory(ctx context.Context, bookingID string) ([]*model.BookingHistoryEntry, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.BookingHistoryEntry), args.Error(1)
+}
+
+func (m *MockBookingService) GetBookingICS(ctx context.Context, id string) ([]byte, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockBookingService) ListAuditLog(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.AuditEntry), args.Error(1)
+}
+
+func (m *MockBookingService) DeleteBooking(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingService) ReleaseLateBookings(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingService) SendBookingReminders(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingService) GetRetentionPolicy(ctx context.Context) (*model.RetentionPolicy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.RetentionPolicy), args.Error(1)
+}
+
+func (m *MockBookingService) UpdateRetentionPolicy(ctx context.Context, policy model.RetentionPolicy, updatedBy string) (*model.RetentionPolicy, error) {
+	args := m.Called(ctx, policy, updatedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.RetentionPolicy), args.Error(1)
+}
+
+func (m *MockBookingService) ApplyRetentionPolicy(ctx context.Context) (*service.RetentionResult, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.RetentionResult), args.Error(1)
+}
+
+func (m *MockBookingService) GetWorkingHours(ctx context.Context, barberID string) (*model.BarberSchedule, error) {
+	args := m.Called(ctx, barberID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BarberSchedule), args.Error(1)
+}
+
+func (m *MockBookingService) SetWorkingHours(ctx context.Context, schedule model.BarberSchedule) (*model.BarberSchedule, error) {
+	args := m.Called(ctx, schedule)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.BarberSchedule), args.Error(1)
+}
+
+func (m *MockBookingService) AddHoliday(ctx context.Context, date time.Time, name, createdBy string) (*model.Holiday, error) {
+	args := m.Called(ctx, date, name, createdBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Holiday), args.Error(1)
+}
+
+func (m *MockBookingService) RemoveHoliday(ctx context.Context, date time.Time) (bool, error) {
+	args := m.Called(ctx, date)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingService) ListHolidays(ctx context.Context, from, to *time.Time) ([]*model.Holiday, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Holiday), args.Error(1)
+}
+
+func (m *MockBookingService) CreateWebhook(ctx context.Context, webhook model.Webhook) (*model.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Webhook), args.Error(1)
+}
+
+func (m *MockBookingService) ListWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Webhook), args.Error(1)
+}
+
+func (m *MockBookingService) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingService) ListCatalogServices(ctx context.Context, includeInactive bool) ([]*model.CatalogService, error) {
+	args := m.Called(ctx, includeInactive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.CatalogService), args.Error(1)
+}
+
+func (m *MockBookingService) CreateCatalogService(ctx context.Context, catalogService model.CatalogService) (*model.CatalogService, error) {
+	args := m.Called(ctx, catalogService)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CatalogService), args.Error(1)
+}
+
+func (m *MockBookingService) UpdateCatalogService(ctx context.Context, catalogService model.CatalogService) (*model.CatalogService, error) {
+	args := m.Called(ctx, catalogService)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CatalogService), args.Error(1)
+}
+
+func (m *MockBookingService) DeleteCatalogService(ctx context.Context, serviceType model.ServiceType) (bool, error) {
+	args := m.Called(ctx, serviceType)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingService) GetBarberServices(ctx context.Context, barberID string) ([]*model.OfferedService, error) {
+	args := m.Called(ctx, barberID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.OfferedService), args.Error(1)
+}
+
+func (m *MockBookingService) ListBarbers(ctx context.Context, includeInactive bool) ([]*model.Barber, error) {
+	args := m.Called(ctx, includeInactive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Barber), args.Error(1)
+}
+
+func (m *MockBookingService) GetBarber(ctx context.Context, id string) (*model.Barber, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Barber), args.Error(1)
+}
+
+func (m *MockBookingService) CreateBarber(ctx context.Context, barber model.Barber) (*model.Barber, error) {
+	args := m.Called(ctx, barber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Barber), args.Error(1)
+}
+
+func (m *MockBookingService) UpdateBarber(ctx context.Context, barber model.Barber) (*model.Barber, error) {
+	args := m.Called(ctx, barber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Barber), args.Error(1)
+}
+
+func (m *MockBookingService) DeleteBarber(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingService) ListResources(ctx context.Context) ([]*model.Resource, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.Resource), args.Error(1)
+}
+
+func (m *MockBookingService) CreateResource(ctx context.Context, resource model.Resource) (*model.Resource, error) {
+	args := m.Called(ctx, resource)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Resource), args.Error(1)
+}
+
+func (m *MockBookingService) UpdateResource(ctx context.Context, resource model.Resource) (*model.Resource, error) {
+	args := m.Called(ctx, resource)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Resource), args.Error(1)
+}
+
+func (m *MockBookingService) DeleteResource(ctx context.Context, id string) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingService) SetBarberServices(ctx context.Context, services model.BarberServices) ([]*model.OfferedService, error) {
+	args := m.Called(ctx, services)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*model.OfferedService), args.Error(1)
+}
+
+func (m *MockBookingService) GetQuote(ctx context.Context, barberID string, serviceTypes []model.ServiceType) (*model.Quote, error) {
+	args := m.Called(ctx, barberID, serviceTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Quote), args.Error(1)
+}
+
+func (m *MockBookingService) RecordDepositPayment(ctx context.Context, bookingID, intentID string, amount int64, currency string) (*model.Booking, error) {
+	args := m.Called(ctx, bookingID, intentID, amount, currency)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) ExpireUnpaidBookings(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingService) ExpireStalePendingBookings(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingService) ExpireHolds(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingService) PurgeDeletedBookings(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingService) ExportUserData(ctx context.Context, userID string) (*model.UserDataExport, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.UserDataExport), args.Error(1)
+}
+
+func (m *MockBookingService) EraseUserData(ctx context.Context, userID string, mode model.RetentionMode) (*service.ErasureResult, error) {
+	args := m.Called(ctx, userID, mode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.ErasureResult), args.Error(1)
+}
+
+func (m *MockBookingService) AutoCompleteBookings(ctx context.Context) (int, int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+func (m *MockBookingService) RelayOutbox(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingService) GetCancellationPolicy(ctx context.Context) (*model.CancellationPolicy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CancellationPolicy), args.Error(1)
+}
+
+func (m *MockBookingService) UpdateCancellationPolicy(ctx context.Context, policy model.CancellationPolicy, updatedBy string) (*model.CancellationPolicy, error) {
+	args := m.Called(ctx, policy, updatedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*model.CancellationPolicy), args.Error(1)
+}
+
+// createParams matches CreateBookingParams on the fields clients control
+func createParams(userID, barberID string, serviceType model.ServiceType, notes string) interface{} {
+	return mock.MatchedBy(func(p service.CreateBookingParams) bool {
+		return p.UserID == userID &&
+			p.BarberID == barberID &&
+			slices.Equal(p.ServiceTypes, []model.ServiceType{serviceType}) &&
+			p.Notes == notes
+	})
+}
+
+// Mock context with user claims
+func mockContextWithClaims(userID string, isBarber bool) context.Context {
+	claims := &auth.Claims{
+		IsBarber: isBarber,
+	}
+	claims.Subject = userID
+	return auth.WithClaims(context.Background(), claims)
+}
+
+// Mock context with admin claims
+func mockAdminContext(userID string) context.Context {
+	claims := &auth.Claims{
+		IsAdmin: true,
+	}
+	claims.Subject = userID
+	return auth.WithClaims(context.Background(), claims)
+}
+
+// Test: Regular user creates booking for themselves (should succeed)
+func TestCreateBooking_RegularUserForSelf(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create test data
+	objectID := primitive.NewObjectID()
+	startTime := time.Now().Round(time.Second)
+	endTime := startTime.Add(30 * time.Minute)
+
+	booking := &model.Booking{
+		ID:          objectID,
+		UserID:      "user1",
+		BarberID:    "barber1",
+		StartTime:   startTime,
+		EndTime:     endTime,
+		ServiceType: model.ServiceTypeHaircut,
+		Status:      model.BookingStatusPending,
+		Notes:       "Test booking",
+		CreatedAt:   startTime,
+		UpdatedAt:   startTime,
+	}
+
+	// Set up mock expectations
+	mockService.On("CreateBooking",
+		mock.Anything,
+		createParams("user1", "barber1", model.ServiceTypeHaircut, "Test booking")).Return(booking, nil)
+
+	// Create the request
+	req := &pb.CreateBookingRequest{
+		UserId:      "user1",
+		BarberId:    "barber1",
+		StartTime:   startTime.Format(time.RFC3339),
+		ServiceType: pb.ServiceType_HAIRCUT,
+		Notes:       "Test booking",
+	}
+
+	// Create context with claims (regular user)
+	ctx := mockContextWithClaims("user1", false)
+
+	// Call the method
+	resp, err := server.CreateBooking(ctx, req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, objectID.Hex(), resp.Id)
+}
+
+// Test: Regular user tries to create booking for another user (should fail)
+func TestCreateBooking_RegularUserForOther(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create the request
+	req := &pb.CreateBookingRequest{
+		UserId:      "user2", // Different from authenticated user
+		BarberId:    "barber1",
+		StartTime:   time.Now().Format(time.RFC3339),
+		ServiceType: pb.ServiceType_HAIRCUT,
+	}
+
+	// Create context with claims (regular user)
+	ctx := mockContextWithClaims("user1", false)
+
+	// Call the method
+	resp, err := server.CreateBooking(ctx, req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	// Verify that the error is permission denied
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	// Verify that the service was never called
+	mockService.AssertNotCalled(t, "CreateBooking")
+}
+
+// Test: Barber creates booking for another user (should succeed)
+func TestCreateBooking_BarberForOther(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create test data
+	objectID := primitive.NewObjectID()
+	startTime := time.Now().Round(time.Second)
+	endTime := startTime.Add(30 * time.Minute)
+
+	booking := &model.Booking{
+		ID:          objectID,
+		UserID:      "user1",
+		BarberID:    "barber1",
+		StartTime:   startTime,
+		EndTime:     endTime,
+		ServiceType: model.ServiceTypeHaircut,
+		Status:      model.BookingStatusPending,
+		CreatedAt:   startTime,
+		UpdatedAt:   startTime,
+	}
+
+	// Set up mock expectations
+	mockService.On("CreateBooking",
+		mock.Anything,
+		createParams("user1", "barber1", model.ServiceTypeHaircut, "")).Return(booking, nil)
+
+	// Create the request
+	req := &pb.CreateBookingRequest{
+		UserId:      "user1", // Different from authenticated user (barber2)
+		BarberId:    "barber1",
+		StartTime:   startTime.Format(time.RFC3339),
+		ServiceType: pb.ServiceType_HAIRCUT,
+	}
+
+	// Create context with claims (barber)
+	ctx := mockContextWithClaims("barber2", true)
+
+	// Call the method
+	resp, err := server.CreateBooking(ctx, req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, objectID.Hex(), resp.Id)
+}
+
+// Test: Regular user tries to get barber bookings (should fail)
+func TestGetBarberBookings_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create the request
+	req := &pb.GetBarberBookingsRequest{
+		BarberId: "barber1",
+	}
+
+	// Create context with claims (regular user)
+	ctx := mockContextWithClaims("user1", false)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBarberBookings", server.GetBarberBookings)(ctx, req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	// Verify that the error is permission denied
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	// Verify that the service was never called
+	mockService.AssertNotCalled(t, "GetBarberBookings")
+}
+
+// Test: Barber gets barber bookings (should succeed)
+func TestGetBarberBookings_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create test data
+	startTime := time.Now().Round(time.Second)
+	bookings := []*model.Booking{
+		{
+			ID:        primitive.NewObjectID(),
+			UserID:    "user1",
+			BarberID:  "barber1",
+			StartTime: startTime,
+			EndTime:   startTime.Add(30 * time.Minute),
+		},
+	}
+
+	// Set up mock expectations
+	mockService.On("GetBarberBookings",
+		mock.Anything,
+		"barber1",
+		mock.Anything).Return(bookings, nil)
+
+	// Create the request
+	req := &pb.GetBarberBookingsRequest{
+		BarberId: "barber1",
+	}
+
+	// Create context with claims (barber)
+	ctx := mockContextWithClaims("barber1", true)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBarberBookings", server.GetBarberBookings)(ctx, req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Len(t, resp.Bookings, 1)
+}
+
+// Test: Barber gets another barber's bookings (should fail)
+func TestGetBarberBookings_OtherBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBarberBookings", server.GetBarberBookings)(mockContextWithClaims("barber2", true), &pb.GetBarberBookingsRequest{
+		BarberId: "barber1",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "GetBarberBookings")
+}
+
+// Test: Admin who isn't a barber gets barber bookings (should succeed)
+func TestGetBarberBookings_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetBarberBookings", mock.Anything, "barber1", mock.Anything).Return([]*model.Booking{}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBarberBookings", server.GetBarberBookings)(mockAdminContext("admin1"), &pb.GetBarberBookingsRequest{
+		BarberId: "barber1",
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Regular user tries to view another user's bookings (should fail)
+func TestGetUserBookings_RegularUserForOther(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create the request
+	req := &pb.GetUserBookingsRequest{
+		UserId: "user2", // Different from authenticated user
+	}
+
+	// Create context with claims (regular user)
+	ctx := mockContextWithClaims("user1", false)
+
+	// Call the method
+	resp, err := server.GetUserBookings(ctx, req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	// Verify that the error is permission denied
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	// Verify that the service was never called
+	mockService.AssertNotCalled(t, "GetUserBookings")
+}
+
+// Test: Regular user views their own bookings (should succeed)
+func TestGetUserBookings_RegularUserForSelf(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create test data
+	startTime := time.Now().Round(time.Second)
+	bookings := []*model.Booking{
+		{
+			ID:        primitive.NewObjectID(),
+			UserID:    "user1",
+			BarberID:  "barber1",
+			StartTime: startTime,
+			EndTime:   startTime.Add(30 * time.Minute),
+		},
+	}
+
+	// Set up mock expectations
+	mockService.On("GetUserBookings",
+		mock.Anything,
+		"user1").Return(bookings, nil)
+
+	// Create the request
+	req := &pb.GetUserBookingsRequest{
+		UserId: "user1",
+	}
+
+	// Create context with claims (regular user)
+	ctx := mockContextWithClaims("user1", false)
+
+	// Call the method
+	resp, err := server.GetUserBookings(ctx, req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Len(t, resp.Bookings, 1)
+}
+
+// Test: Barber views another user's bookings (should succeed)
+func TestGetUserBookings_BarberForOther(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create test data
+	startTime := time.Now().Round(time.Second)
+	bookings := []*model.Booking{
+		{
+			ID:        primitive.NewObjectID(),
+			UserID:    "user1",
+			BarberID:  "barber1",
+			StartTime: startTime,
+			EndTime:   startTime.Add(30 * time.Minute),
+		},
+	}
+
+	// Set up mock expectations
+	mockService.On("GetUserBookings",
+		mock.Anything,
+		"user1").Return(bookings, nil)
+
+	// Create the request
+	req := &pb.GetUserBookingsRequest{
+		UserId: "user1",
+	}
+
+	// Create context with claims (barber)
+	ctx := mockContextWithClaims("barber2", true)
+
+	// Call the method
+	resp, err := server.GetUserBookings(ctx, req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Len(t, resp.Bookings, 1)
+}
+
+// Test: Duplicate booking request is rejected with AlreadyExists
+func TestCreateBooking_Duplicate(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	startTime := time.Now().Round(time.Second)
+
+	// Set up mock expectations
+	mockService.On("CreateBooking",
+		mock.Anything,
+		createParams("user1", "barber1", model.ServiceTypeHaircut, "")).Return(nil, service.ErrDuplicateBooking)
+
+	// Create the request
+	req := &pb.CreateBookingRequest{
+		UserId:      "user1",
+		BarberId:    "barber1",
+		StartTime:   startTime.Format(time.RFC3339),
+		ServiceType: pb.ServiceType_HAIRCUT,
+	}
+
+	// Create context with claims (regular user)
+	ctx := mockContextWithClaims("user1", false)
+
+	// Call the method
+	resp, err := server.CreateBooking(ctx, req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+}
+
+// Test: Slot taken by an overlapping booking is rejected with FailedPrecondition
+func TestCreateBooking_SlotUnavailable(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	startTime := time.Now().Round(time.Second)
+
+	conflict := &model.TimeSlot{StartTime: startTime.Add(-15 * time.Minute), EndTime: startTime.Add(15 * time.Minute)}
+	alternative := &model.TimeSlot{StartTime: startTime.Add(30 * time.Minute), EndTime: startTime.Add(60 * time.Minute)}
+
+	// Set up mock expectations
+	mockService.On("CreateBooking",
+		mock.Anything,
+		createParams("user1", "barber1", model.ServiceTypeHaircut, "")).Return(nil, &service.SlotUnavailableError{
+		Conflicts:    []*model.TimeSlot{conflict},
+		Alternatives: []*model.TimeSlot{alternative},
+	})
+
+	// Create the request
+	req := &pb.CreateBookingRequest{
+		UserId:      "user1",
+		BarberId:    "barber1",
+		StartTime:   startTime.Format(time.RFC3339),
+		ServiceType: pb.ServiceType_HAIRCUT,
+	}
+
+	// Call the method
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, service.ErrSlotUnavailable.Error(), st.Message())
+
+	// Clients get the reason, the conflicting time and free slots to offer instead
+	details := st.Details()
+	require.Len(t, details, 2)
+	info, ok := details[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, "SLOT_UNAVAILABLE", info.Reason)
+	assert.Equal(t, conflict.StartTime.Format(time.RFC3339), info.Metadata["conflictStart"])
+	assert.Equal(t, conflict.EndTime.Format(time.RFC3339), info.Metadata["conflictEnd"])
+
+	slotDetail, ok := details[1].(*pb.SlotUnavailableDetail)
+	require.True(t, ok)
+	require.Len(t, slotDetail.Conflicts, 1)
+	assert.True(t, conflict.StartTime.Equal(slotDetail.Conflicts[0].StartTimeTs.AsTime()))
+	require.Len(t, slotDetail.Alternatives, 1)
+	assert.True(t, alternative.StartTime.Equal(slotDetail.Alternatives[0].StartTimeTs.AsTime()))
+}
+
+// Test: Times the barber can't be booked carry an ErrorInfo reason
+func TestCreateBooking_ErrorReasons(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{service.ErrDuringBreak, codes.FailedPrecondition, "BARBER_ON_BREAK"},
+		{service.ErrShopClosed, codes.FailedPrecondition, "SHOP_CLOSED"},
+		{errors.Wrap(service.ErrBookingTooSoon, "bookings must start at least 60 minutes from now"), codes.FailedPrecondition, "BOOKING_TOO_SOON"},
+		{service.ErrStartTimeInPast, codes.InvalidArgument, "START_TIME_IN_PAST"},
+		{service.ErrPromoCodeUnavailable, codes.FailedPrecondition, "PROMO_CODE_UNAVAILABLE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			mockService := new(MockBookingService)
+			server := &BookingServer{service: mockService}
+			mockService.On("CreateBooking", mock.Anything, mock.Anything).Return(nil, tt.err)
+
+			_, err := server.CreateBooking(mockContextWithClaims("user1", false), &pb.CreateBookingRequest{
+				UserId:      "user1",
+				BarberId:    "barber1",
+				StartTimeTs: timestamppb.Now(),
+			})
+
+			st := status.Convert(err)
+			assert.Equal(t, tt.code, st.Code())
+			assert.Equal(t, tt.err.Error(), st.Message())
+			require.Len(t, st.Details(), 1)
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			require.True(t, ok)
+			assert.Equal(t, tt.reason, info.Reason)
+			assert.Equal(t, "booking.ita-av", info.Domain)
+		})
+	}
+}
+
+// Test: Booking several services at once (should succeed)
+func TestCreateBooking_MultipleServices(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	startTime := time.Now().Add(24 * time.Hour).Round(time.Second)
+	services := []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim}
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("CreateBooking", mock.Anything, mock.MatchedBy(func(p service.CreateBookingParams) bool {
+		return slices.Equal(p.ServiceTypes, services)
+	})).Return(&model.Booking{
+		ID:           objectID,
+		UserID:       "user1",
+		BarberID:     "barber1",
+		StartTime:    startTime,
+		EndTime:      model.CalculateEndTime(startTime, services...),
+		ServiceType:  services[0],
+		ServiceTypes: services,
+	}, nil)
+
+	// Create the request
+	req := &pb.CreateBookingRequest{
+		UserId:       "user1",
+		BarberId:     "barber1",
+		StartTimeTs:  timestamppb.New(startTime),
+		ServiceType:  pb.ServiceType_FULL_SERVICE, // Ignored in favour of the list
+		ServiceTypes: []pb.ServiceType{pb.ServiceType_HAIRCUT, pb.ServiceType_BEARD_TRIM},
+	}
+
+	// Call the method
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ServiceType_HAIRCUT, resp.ServiceType)
+	assert.Equal(t, []pb.ServiceType{pb.ServiceType_HAIRCUT, pb.ServiceType_BEARD_TRIM}, resp.ServiceTypes)
+	assert.True(t, resp.EndTimeTs.AsTime().Equal(startTime.Add(45*time.Minute)))
+	mockService.AssertExpectations(t)
+}
+
+// Test: Booking in the past (should fail)
+func TestCreateBooking_StartTimeInPast(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	startTime := time.Now().Add(-time.Hour).Round(time.Second)
+
+	// Set up mock expectations
+	mockService.On("CreateBooking",
+		mock.Anything,
+		createParams("user1", "barber1", model.ServiceTypeHaircut, "")).Return(nil, service.ErrStartTimeInPast)
+
+	// Create the request
+	req := &pb.CreateBookingRequest{
+		UserId:      "user1",
+		BarberId:    "barber1",
+		StartTime:   startTime.Format(time.RFC3339),
+		ServiceType: pb.ServiceType_HAIRCUT,
+	}
+
+	// Call the method
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// Test: Booking outside the lead time policy is rejected with the policy message
+func TestCreateBooking_TooSoon(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	startTime := time.Now().Round(time.Second)
+	policyErr := errors.Wrap(service.ErrBookingTooSoon, "bookings must start at least 120 minutes from now")
+
+	// Set up mock expectations
+	mockService.On("CreateBooking",
+		mock.Anything,
+		createParams("user1", "barber1", model.ServiceTypeHaircut, "")).Return(nil, policyErr)
+
+	// Create the request
+	req := &pb.CreateBookingRequest{
+		UserId:      "user1",
+		BarberId:    "barber1",
+		StartTime:   startTime.Format(time.RFC3339),
+		ServiceType: pb.ServiceType_HAIRCUT,
+	}
+
+	// Call the method
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), "at least 120 minutes")
+}
+
+// Test: Regular user looks up another user's booking by external reference (should fail)
+func TestGetBookingByExternalRef_RegularUserForOther(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	booking := &model.Booking{
+		ID:          primitive.NewObjectID(),
+		UserID:      "user2",
+		BarberID:    "barber1",
+		ExternalRef: "pos-1001",
+	}
+
+	// Set up mock expectations
+	mockService.On("GetBookingByExternalRef", mock.Anything, "pos-1001").Return(booking, nil)
+
+	// Create context with claims (regular user)
+	ctx := mockContextWithClaims("user1", false)
+
+	// Call the method
+	resp, err := server.GetBookingByExternalRef(ctx, &pb.GetBookingByExternalRefRequest{ExternalRef: "pos-1001"})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
+// Test: Regular user tries to get another user's booking (should fail)
+func TestGetBooking_RegularUserForOther(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user2", BarberID: "barber1"}, nil)
+
+	// Call the method
+	get := withPolicy(server, "GetBooking", server.GetBooking)
+	resp, err := get(mockContextWithClaims("user1", false), &pb.GetBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	// The booking's customer and barbers can still get it
+	_, err = get(mockContextWithClaims("user2", false), &pb.GetBookingRequest{Id: objectID.Hex()})
+	assert.NoError(t, err)
+	_, err = get(mockContextWithClaims("barber1", true), &pb.GetBookingRequest{Id: objectID.Hex()})
+	assert.NoError(t, err)
+}
+
+// Test: Get a booking with a pending deposit (only its customer gets the client secret)
+func TestGetBooking_DepositClientSecret(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{
+		ID:       objectID,
+		UserID:   "user1",
+		BarberID: "barber1",
+		Deposit:  &model.Deposit{Amount: 500, Currency: "EUR", Status: model.DepositStatusPending, ClientSecret: "pi_1_secret"},
+	}, nil)
+
+	// Call the method
+	resp, err := server.GetBooking(mockContextWithClaims("user1", false), &pb.GetBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "pi_1_secret", resp.Deposit.ClientSecret)
+
+	resp, err = server.GetBooking(mockContextWithClaims("barber1", true), &pb.GetBookingRequest{Id: objectID.Hex()})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500), resp.Deposit.Amount)
+	assert.Empty(t, resp.Deposit.ClientSecret)
+}
+
+// Test: Get a cancelled booking (should say who cancelled it, when and why)
+func TestGetBooking_Cancelled(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	cancelledAt := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{
+		ID:           objectID,
+		UserID:       "user1",
+		Status:       model.BookingStatusCancelled,
+		Cancellation: &model.Cancellation{CancelledAt: cancelledAt, CancelledBy: "user1", Reason: "sick"},
+	}, nil)
+
+	// Call the method
+	resp, err := server.GetBooking(mockContextWithClaims("user1", false), &pb.GetBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "user1", resp.Cancellation.CancelledBy)
+	assert.Equal(t, "sick", resp.Cancellation.Reason)
+	assert.Equal(t, cancelledAt, resp.Cancellation.CancelledAt.AsTime())
+}
+
+// Test: Barber looks up a booking by external reference (should succeed)
+func TestGetBookingByExternalRef_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	booking := &model.Booking{
+		ID:          objectID,
+		UserID:      "user1",
+		BarberID:    "barber1",
+		ExternalRef: "pos-1001",
+	}
+
+	// Set up mock expectations
+	mockService.On("GetBookingByExternalRef", mock.Anything, "pos-1001").Return(booking, nil)
+
+	// Create context with claims (barber)
+	ctx := mockContextWithClaims("barber1", true)
+
+	// Call the method
+	resp, err := server.GetBookingByExternalRef(ctx, &pb.GetBookingByExternalRefRequest{ExternalRef: "pos-1001"})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, objectID.Hex(), resp.Id)
+	assert.Equal(t, "pos-1001", resp.ExternalRef)
+}
+
+// Test: Regular user tries to record a POS completion (should fail)
+func TestRecordPOSCompletion_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	req := &pb.RecordPOSCompletionRequest{
+		ExternalRef: "pos-1001",
+		Amount:      3500,
+		Currency:    "EUR",
+	}
+
+	// Create context with claims (regular user)
+	ctx := mockContextWithClaims("user1", false)
+
+	// Call the method
+	resp, err := withPolicy(server, "RecordPOSCompletion", server.RecordPOSCompletion)(ctx, req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "RecordPOSCompletion")
+}
+
+// Test: Barber records a POS completion for a cancelled booking (should fail)
+func TestRecordPOSCompletion_CancelledBooking(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("RecordPOSCompletion", mock.Anything, mock.Anything).Return(nil, service.ErrBookingCancelled)
+
+	req := &pb.RecordPOSCompletionRequest{
+		ExternalRef: "pos-1001",
+		Amount:      3500,
+		Currency:    "EUR",
+	}
+
+	// Create context with claims (barber)
+	ctx := mockContextWithClaims("barber1", true)
+
+	// Call the method
+	resp, err := server.RecordPOSCompletion(ctx, req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+// Test: Barber records a POS completion (should succeed)
+func TestRecordPOSCompletion_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	paidAt := time.Now().Round(time.Second)
+	booking := &model.Booking{
+		ID:          objectID,
+		UserID:      "user1",
+		BarberID:    "barber1",
+		ServiceType: model.ServiceTypeHaircut,
+		Status:      model.BookingStatusCompleted,
+		ExternalRef: "pos-1001",
+		Payment: &model.Payment{
+			Amount:           3500,
+			Tip:              500,
+			Currency:         "EUR",
+			RenderedServices: []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim},
+			Discrepancies:    []string{"beard trim rendered but not booked"},
+			PaidAt:           paidAt,
+		},
+	}
+
+	// Set up mock expectations
+	mockService.On("RecordPOSCompletion", mock.Anything, mock.MatchedBy(func(p service.POSCompletionParams) bool {
+		return p.ExternalRef == "pos-1001" && p.Amount == 3500 && p.Currency == "EUR" && len(p.RenderedServices) == 2
+	})).Return(booking, nil)
+
+	req := &pb.RecordPOSCompletionRequest{
+		ExternalRef:      "pos-1001",
+		Amount:           3500,
+		Tip:              500,
+		Currency:         "eur",
+		RenderedServices: []pb.ServiceType{pb.ServiceType_HAIRCUT, pb.ServiceType_BEARD_TRIM},
+	}
+
+	// Create context with claims (barber)
+	ctx := mockContextWithClaims("barber1", true)
+
+	// Call the method
+	resp, err := server.RecordPOSCompletion(ctx, req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, pb.BookingStatus_COMPLETED, resp.Status)
+	assert.Equal(t, int64(3500), resp.Payment.Amount)
+	assert.Len(t, resp.Payment.Discrepancies, 1)
+}
+
+// Test: Available time slots with an unknown timezone (should fail naming the zone)
+func TestGetAvailableTimeSlots_InvalidTimezone(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	req := &pb.GetAvailableTimeSlotsRequest{
+		BarberId: "barber1",
+		Date:     "2025-04-01",
+		Timezone: "Mars/Olympus_Mons",
+	}
+
+	// Call the method
+	resp, err := server.GetAvailableTimeSlots(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Contains(t, st.Message(), "Mars/Olympus_Mons")
+
+	mockService.AssertNotCalled(t, "GetAvailableTimeSlots")
+}
+
+// Test: Structured date with timezone is resolved to midnight in that zone
+func TestGetAvailableTimeSlots_StructuredDateWithTimezone(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	expected := time.Date(2025, time.April, 1, 0, 0, 0, 0, loc)
+
+	// Set up mock expectations
+	mockService.On("GetAvailableTimeSlots", mock.Anything, "barber1", mock.MatchedBy(func(d time.Time) bool {
+		return d.Equal(expected) && d.Location().String() == "America/New_York"
+	}), ([]model.ServiceType)(nil)).Return([]*model.TimeSlot{}, nil)
+
+	req := &pb.GetAvailableTimeSlotsRequest{
+		BarberId: "barber1",
+		Day:      &pb.CalendarDate{Year: 2025, Month: 4, Day: 1},
+		Timezone: "America/New_York",
+	}
+
+	// Call the method
+	resp, err := server.GetAvailableTimeSlots(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Available slots without a timezone use the barber's (should succeed)
+func TestGetAvailableTimeSlots_BarberTimezone(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	expected := time.Date(2025, time.April, 1, 0, 0, 0, 0, loc)
+
+	// Set up mock expectations
+	mockService.On("GetWorkingHours", mock.Anything, "barber1").Return(&model.BarberSchedule{BarberID: "barber1", Timezone: "Europe/Berlin"}, nil)
+	mockService.On("GetAvailableTimeSlots", mock.Anything, "barber1", mock.MatchedBy(func(d time.Time) bool {
+		return d.Equal(expected) && d.Location().String() == "Europe/Berlin"
+	}), ([]model.ServiceType)(nil)).Return([]*model.TimeSlot{}, nil)
+
+	req := &pb.GetAvailableTimeSlotsRequest{
+		BarberId: "barber1",
+		Date:     "2025-04-01",
+	}
+
+	// Call the method
+	resp, err := server.GetAvailableTimeSlots(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Available slots for a specific service (should succeed)
+func TestGetAvailableTimeSlots_ServiceType(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	haircut := pb.ServiceType_HAIRCUT
+	start := time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("GetAvailableTimeSlots", mock.Anything, "barber1", mock.Anything, []model.ServiceType{model.ServiceTypeHaircut}).Return([]*model.TimeSlot{{StartTime: start, EndTime: start.Add(30 * time.Minute)}}, nil)
+
+	req := &pb.GetAvailableTimeSlotsRequest{
+		BarberId:    "barber1",
+		Date:        "2025-04-01",
+		Timezone:    "UTC",
+		ServiceType: &haircut,
+	}
+
+	// Call the method
+	resp, err := server.GetAvailableTimeSlots(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Len(t, resp.TimeSlots, 1)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Available slots grouped by day (should succeed)
+func TestGetAvailableTimeSlotsRange(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	first := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2025, time.April, 2, 0, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("GetAvailableTimeSlotsRange", mock.Anything, "barber1", first, last, ([]model.ServiceType)(nil)).Return([]*model.DaySlots{
+		{Date: first, TimeSlots: []*model.TimeSlot{{StartTime: first.Add(9 * time.Hour), EndTime: first.Add(9*time.Hour + 30*time.Minute)}}},
+		{Date: last, TimeSlots: []*model.TimeSlot{}},
+	}, nil)
+
+	req := &pb.GetAvailableTimeSlotsRangeRequest{
+		BarberId:  "barber1",
+		StartDate: "2025-04-01",
+		EndDay:    &pb.CalendarDate{Year: 2025, Month: 4, Day: 2},
+		Timezone:  "UTC",
+	}
+
+	// Call the method
+	resp, err := server.GetAvailableTimeSlotsRange(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Days, 2) {
+		assert.Equal(t, int32(1), resp.Days[0].Day.Day)
+		assert.Len(t, resp.Days[0].TimeSlots, 1)
+		assert.Equal(t, int32(2), resp.Days[1].Day.Day)
+		assert.Empty(t, resp.Days[1].TimeSlots)
+	}
+	mockService.AssertExpectations(t)
+}
+
+// Test: Date range that is too long (should fail)
+func TestGetAvailableTimeSlotsRange_InvalidRange(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetAvailableTimeSlotsRange", mock.Anything, "barber1", mock.Anything, mock.Anything, ([]model.ServiceType)(nil)).
+		Return(nil, errors.Wrap(service.ErrInvalidDateRange, "range can cover at most 31 days"))
+
+	req := &pb.GetAvailableTimeSlotsRangeRequest{
+		BarberId:  "barber1",
+		StartDate: "2025-04-01",
+		EndDate:   "2025-06-01",
+		Timezone:  "UTC",
+	}
+
+	// Call the method
+	resp, err := server.GetAvailableTimeSlotsRange(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// Test: Next available slots in the barber's time zone (should succeed)
+func TestGetNextAvailableSlot(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	start := time.Date(2025, time.April, 1, 7, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("GetWorkingHours", mock.Anything, "barber1").Return(&model.BarberSchedule{BarberID: "barber1", Timezone: "Europe/Berlin"}, nil)
+	mockService.On("GetNextAvailableSlot", mock.Anything, "barber1", ([]model.ServiceType)(nil), 2).Return([]*model.TimeSlot{
+		{StartTime: start, EndTime: start.Add(30 * time.Minute)},
+		{StartTime: start.Add(time.Hour), EndTime: start.Add(90 * time.Minute)},
+	}, nil)
+
+	req := &pb.GetNextAvailableSlotRequest{
+		BarberId: "barber1",
+		Count:    2,
+	}
+
+	// Call the method
+	resp, err := server.GetNextAvailableSlot(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	if assert.Len(t, resp.TimeSlots, 2) {
+		assert.Equal(t, start.In(berlin).Format(time.RFC3339), resp.TimeSlots[0].StartTime)
+		assert.True(t, resp.TimeSlots[0].StartTimeTs.AsTime().Equal(start))
+	}
+	mockService.AssertExpectations(t)
+}
+
+// Test: Next available slot without a barber (should fail)
+func TestGetNextAvailableSlot_MissingBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := server.GetNextAvailableSlot(mockContextWithClaims("user1", false), &pb.GetNextAvailableSlotRequest{})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// Test: Structured date that doesn't exist (should fail)
+func TestGetBarberBookings_InvalidStructuredDate(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetWorkingHours", mock.Anything, "barber1").Return(&model.BarberSchedule{BarberID: "barber1", Timezone: "UTC"}, nil)
+
+	req := &pb.GetBarberBookingsRequest{
+		BarberId: "barber1",
+		Day:      &pb.CalendarDate{Year: 2025, Month: 2, Day: 30},
+	}
+
+	// Call the method
+	resp, err := server.GetBarberBookings(mockContextWithClaims("barber1", true), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// Test: Regular user attaches an image to another user's booking (should fail)
+func TestAddBookingAttachment_RegularUserForOther(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user2"}, nil)
+
+	req := &pb.AddBookingAttachmentRequest{
+		BookingId: objectID.Hex(),
+		Url:       "https://example.com/style.jpg",
+	}
+
+	// Call the method
+	resp, err := server.AddBookingAttachment(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "AddAttachment")
+}
+
+// Test: Regular user requests an upload URL for their own booking (should succeed)
+func TestAddBookingAttachment_Upload(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	attachment := &model.Attachment{
+		ID:          "att1",
+		URL:         "http://localhost:8080/attachments/bookings/" + objectID.Hex() + "/att1.jpg",
+		ContentType: "image/jpeg",
+		Size:        1024,
+		CreatedAt:   time.Now(),
+	}
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+	mockService.On("AddAttachment", mock.Anything, objectID.Hex(), service.AttachmentParams{
+		ContentType: "image/jpeg",
+		Size:        1024,
+	}).Return(attachment, "http://upload.example/signed", nil)
+
+	req := &pb.AddBookingAttachmentRequest{
+		BookingId:   objectID.Hex(),
+		ContentType: "image/jpeg",
+		SizeBytes:   1024,
+	}
+
+	// Call the method
+	resp, err := server.AddBookingAttachment(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "att1", resp.Attachment.Id)
+	assert.Equal(t, "http://upload.example/signed", resp.UploadUrl)
+}
+
+// Test: Uploaded attachments come with a signed download URL
+func TestGetBooking_SignedAttachmentURL(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	uploaded := &model.Attachment{ID: "att1", StorageKey: "bookings/" + objectID.Hex() + "/att1.jpg", ContentType: "image/jpeg"}
+	external := &model.Attachment{ID: "att2", URL: "https://example.com/style.jpg"}
+	booking := &model.Booking{ID: objectID, UserID: "user1", Attachments: []*model.Attachment{uploaded, external}}
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(booking, nil)
+	mockService.On("AttachmentURL", uploaded).Return("http://localhost:8080/attachments/bookings/att1.jpg?sig=abc")
+
+	// Call the method
+	resp, err := server.GetBooking(mockContextWithClaims("user1", false), &pb.GetBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	require.Len(t, resp.Attachments, 2)
+	assert.Equal(t, "http://localhost:8080/attachments/bookings/att1.jpg?sig=abc", resp.Attachments[0].Url)
+	assert.Equal(t, "https://example.com/style.jpg", resp.Attachments[1].Url)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Invalid attachment is reported as InvalidArgument
+func TestAddBookingAttachment_Invalid(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+	mockService.On("AddAttachment", mock.Anything, objectID.Hex(), mock.Anything).Return(nil, "", service.ErrInvalidAttachment)
+
+	req := &pb.AddBookingAttachmentRequest{
+		BookingId:   objectID.Hex(),
+		ContentType: "application/pdf",
+		SizeBytes:   1024,
+	}
+
+	// Call the method
+	resp, err := server.AddBookingAttachment(mockContextWithClaims("user1", false), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// Test: Regular user tries to check in a booking (should fail)
+func TestCheckInBooking_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "CheckInBooking", server.CheckInBooking)(mockContextWithClaims("user1", false), &pb.CheckInBookingRequest{Id: primitive.NewObjectID().Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "CheckInBooking")
+}
+
+// Test: Barber checks in another barber's booking (should fail)
+func TestCheckInBooking_OtherBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, BarberID: "barber1"}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "CheckInBooking", server.CheckInBooking)(mockContextWithClaims("barber2", true), &pb.CheckInBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "CheckInBooking")
+}
+
+// Test: Barber checks in a booking (should succeed)
+func TestCheckInBooking_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	checkedInAt := time.Date(2025, 3, 14, 10, 5, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("CheckInBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{
+		ID:          objectID,
+		BarberID:    "barber1",
+		CheckedInAt: &checkedInAt,
+	}, nil)
+
+	// Call the method
+	resp, err := server.CheckInBooking(mockContextWithClaims("barber1", true), &pb.CheckInBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "2025-03-14T10:05:00Z", resp.CheckedInAt)
+	assert.Empty(t, resp.ReleasedAt)
+}
+
+// Test: Barber checks in a booking whose slot was already released (should fail)
+func TestCheckInBooking_Released(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("CheckInBooking", mock.Anything, objectID.Hex()).Return(nil, service.ErrSlotReleased)
+
+	// Call the method
+	resp, err := server.CheckInBooking(mockContextWithClaims("barber1", true), &pb.CheckInBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+// Test: Regular user tries to mark a no-show (should fail)
+func TestMarkNoShow_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "MarkNoShow", server.MarkNoShow)(mockContextWithClaims("user1", false), &pb.MarkNoShowRequest{Id: primitive.NewObjectID().Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "MarkNoShow")
+}
+
+// Test: Barber marks another barber's booking as a no-show (should fail)
+func TestMarkNoShow_OtherBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, BarberID: "barber1"}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "MarkNoShow", server.MarkNoShow)(mockContextWithClaims("barber2", true), &pb.MarkNoShowRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "MarkNoShow")
+}
+
+// Test: Barber marks a booking as a no-show (should succeed)
+func TestMarkNoShow_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("MarkNoShow", mock.Anything, objectID.Hex()).Return(&model.Booking{
+		ID:       objectID,
+		BarberID: "barber1",
+		Status:   model.BookingStatusNoShow,
+	}, nil)
+
+	// Call the method
+	resp, err := server.MarkNoShow(mockContextWithClaims("barber1", true), &pb.MarkNoShowRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, pb.BookingStatus_NO_SHOW, resp.Status)
+}
+
+// Test: Barber marks a checked-in booking as a no-show (should fail)
+func TestMarkNoShow_CheckedIn(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("MarkNoShow", mock.Anything, objectID.Hex()).Return(nil, service.ErrCustomerCheckedIn)
+
+	// Call the method
+	resp, err := server.MarkNoShow(mockContextWithClaims("barber1", true), &pb.MarkNoShowRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+// Test: Regular user asks for a customer's reliability (should fail)
+func TestGetUserReliability_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "GetUserReliability", server.GetUserReliability)(mockContextWithClaims("user1", false), &pb.GetUserReliabilityRequest{UserId: "user1"})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "GetUserReliability")
+}
+
+// Test: Barber gets a customer's reliability (should succeed)
+func TestGetUserReliability_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetUserReliability", mock.Anything, "user1").Return(&model.UserReliability{
+		UserID:    "user1",
+		Bookings:  6,
+		Completed: 3,
+		Cancelled: 2,
+		NoShows:   1,
+	}, nil)
+
+	// Call the method
+	resp, err := server.GetUserReliability(mockContextWithClaims("barber1", true), &pb.GetUserReliabilityRequest{UserId: "user1"})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, int32(6), resp.Bookings)
+	assert.Equal(t, int32(1), resp.NoShows)
+	assert.InDelta(t, 0.25, resp.NoShowRate, 1e-9)
+}
+
+// Test: Regular user tries to get the reliability report (should fail)
+func TestGetReliabilityReport_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	req := &pb.GetReliabilityReportRequest{
+		StartDay: &pb.CalendarDate{Year: 2025, Month: 6, Day: 1},
+		EndDay:   &pb.CalendarDate{Year: 2025, Month: 6, Day: 30},
+	}
+	resp, err := withPolicy(server, "GetReliabilityReport", server.GetReliabilityReport)(mockContextWithClaims("user1", false), req)
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockService.AssertNotCalled(t, "GetReliabilityReport")
+}
+
+func TestGetReliabilityReport_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetReliabilityReport", mock.Anything, model.ReliabilityFilter{
+		GroupBy:     model.ReliabilityByUser,
+		BarberID:    "barber1",
+		Start:       time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+		End:         time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+		MinBookings: 3,
+		SortBy:      model.SortByCancellationRate,
+		Limit:       10,
+	}).Return([]*model.ReliabilityRow{
+		{ID: "user2", Bookings: 4, Completed: 1, Cancelled: 2, NoShows: 1},
+	}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetReliabilityReport", server.GetReliabilityReport)(mockContextWithClaims("barber1", true), &pb.GetReliabilityReportRequest{
+		GroupBy:     pb.ReliabilityGroup_BY_USER,
+		StartDay:    &pb.CalendarDate{Year: 2025, Month: 6, Day: 1},
+		EndDay:      &pb.CalendarDate{Year: 2025, Month: 6, Day: 30},
+		BarberId:    "barber1",
+		MinBookings: 3,
+		SortBy:      pb.ReliabilitySort_CANCELLATION_RATE,
+		Limit:       10,
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Rows, 1) {
+		assert.Equal(t, "user2", resp.Rows[0].Id)
+		assert.InDelta(t, 0.5, resp.Rows[0].NoShowRate, 1e-9)
+		assert.InDelta(t, 0.5, resp.Rows[0].CancellationRate, 1e-9)
+	}
+	assert.Equal(t, &pb.CalendarDate{Year: 2025, Month: 6, Day: 30}, resp.EndDay)
+}
+
+// Test: Barber asks for a reliability report over too long a range (should fail)
+func TestGetReliabilityReport_InvalidRange(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	mockService.On("GetReliabilityReport", mock.Anything, mock.Anything).
+		Return(nil, errors.Wrap(service.ErrInvalidDateRange, "range can cover at most 366 days"))
+
+	resp, err := server.GetReliabilityReport(mockContextWithClaims("barber1", true), &pb.GetReliabilityReportRequest{
+		StartDay: &pb.CalendarDate{Year: 2024, Month: 1, Day: 1},
+		EndDay:   &pb.CalendarDate{Year: 2025, Month: 6, Day: 30},
+	})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// Test: Regular user tries to get another customer's summary (should fail)
+func TestGetCustomerSummary_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	resp, err := withPolicy(server, "GetCustomerSummary", server.GetCustomerSummary)(mockContextWithClaims("user1", false), &pb.GetCustomerSummaryRequest{UserId: "user2"})
+
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockService.AssertNotCalled(t, "GetCustomerSummary")
+}
+
+func TestGetCustomerSummary_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	first := time.Date(2025, time.January, 1, 10, 0, 0, 0, time.UTC)
+	last := time.Date(2025, time.March, 2, 10, 0, 0, 0, time.UTC)
+	mockService.On("GetCustomerSummary", mock.Anything, "user1").Return(&model.CustomerSummary{
+		UserID:       "user1",
+		Visits:       3,
+		FirstVisit:   &first,
+		LastVisit:    &last,
+		UsualService: model.ServiceTypeBeardTrim,
+	}, nil)
+
+	resp, err := withPolicy(server, "GetCustomerSummary", server.GetCustomerSummary)(mockContextWithClaims("barber1", true), &pb.GetCustomerSummaryRequest{UserId: "user1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.TotalVisits)
+	assert.Equal(t, pb.ServiceType_BEARD_TRIM, resp.UsualService)
+	assert.Equal(t, last, resp.LastVisit.AsTime())
+	assert.InDelta(t, 30, resp.AverageIntervalDays, 1e-9)
+	assert.Equal(t, last.AddDate(0, 0, 30), resp.NextVisitDue.AsTime())
+}
+
+// Test: Barber gets the summary of a customer who never visited
+func TestGetCustomerSummary_NoVisits(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	mockService.On("GetCustomerSummary", mock.Anything, "user1").Return(&model.CustomerSummary{UserID: "user1"}, nil)
+
+	resp, err := server.GetCustomerSummary(mockContextWithClaims("barber1", true), &pb.GetCustomerSummaryRequest{UserId: "user1"})
+
+	assert.NoError(t, err)
+	assert.Zero(t, resp.TotalVisits)
+	assert.Nil(t, resp.LastVisit)
+	assert.Nil(t, resp.NextVisitDue)
+}
+
+// Test: Barber tries to confirm another barber's booking (should fail)
+func TestConfirmBooking_OtherBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, BarberID: "barber1"}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "ConfirmBooking", server.ConfirmBooking)(mockContextWithClaims("barber2", true), &pb.ConfirmBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "ConfirmBooking")
+}
+
+// Test: Booked barber confirms a pending booking (should succeed)
+func TestConfirmBooking_BookedBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	booking := &model.Booking{ID: objectID, BarberID: "barber1", Status: model.BookingStatusPending}
+	confirmed := &model.Booking{ID: objectID, BarberID: "barber1", Status: model.BookingStatusConfirmed}
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(booking, nil)
+	mockService.On("ConfirmBooking", mock.Anything, objectID.Hex()).Return(confirmed, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "ConfirmBooking", server.ConfirmBooking)(mockContextWithClaims("barber1", true), &pb.ConfirmBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, pb.BookingStatus_CONFIRMED, resp.Status)
+}
+
+// Test: Admin confirms a cancelled booking (should fail)
+func TestConfirmBooking_Cancelled(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, BarberID: "barber1", Status: model.BookingStatusCancelled}, nil)
+	mockService.On("ConfirmBooking", mock.Anything, objectID.Hex()).Return(nil, service.ErrBookingCancelled)
+
+	// Call the method
+	resp, err := withPolicy(server, "ConfirmBooking", server.ConfirmBooking)(mockAdminContext("admin1"), &pb.ConfirmBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+// Test: Regular user tries to complete their own booking (should fail)
+func TestCompleteBooking_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1", BarberID: "barber1"}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "CompleteBooking", server.CompleteBooking)(mockContextWithClaims("user1", false), &pb.CompleteBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "CompleteBooking")
+}
+
+// Test: Booked barber completes a booking that hasn't ended (should fail)
+func TestCompleteBooking_NotEnded(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, BarberID: "barber1"}, nil)
+	mockService.On("CompleteBooking", mock.Anything, objectID.Hex()).Return(nil, service.ErrBookingNotEnded)
+
+	// Call the method
+	resp, err := withPolicy(server, "CompleteBooking", server.CompleteBooking)(mockContextWithClaims("barber1", true), &pb.CompleteBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+// Test: Booked barber completes a booking (should succeed)
+func TestCompleteBooking_BookedBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	completed := &model.Booking{ID: objectID, BarberID: "barber1", Status: model.BookingStatusCompleted}
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, BarberID: "barber1"}, nil)
+	mockService.On("CompleteBooking", mock.Anything, objectID.Hex()).Return(completed, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "CompleteBooking", server.CompleteBooking)(mockContextWithClaims("barber1", true), &pb.CompleteBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, pb.BookingStatus_COMPLETED, resp.Status)
+}
+
+// Test: User cancels a booking that has already started (should fail)
+func TestCancelBooking_AlreadyStarted(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+	mockService.On("CancelBooking", mock.Anything, objectID.Hex(), service.CancelBookingParams{CancelledBy: "user1"}).Return(nil, &service.TransitionError{
+		From:   model.BookingStatusConfirmed,
+		To:     model.BookingStatusCancelled,
+		Reason: "booking has already started",
+	})
+
+	// Call the method
+	resp, err := server.CancelBooking(mockContextWithClaims("user1", false), &pb.CancelBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), "already started")
+}
+
+// Test: Regular user cancels within the fee window (should report the fee)
+func TestCancelBooking_WithFee(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	cancelledAt := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+	mockService.On("CancelBooking", mock.Anything, objectID.Hex(), service.CancelBookingParams{CancelledBy: "user1", Reason: "sick"}).
+		Return(&model.Booking{
+			ID:           objectID,
+			Status:       model.BookingStatusCancelled,
+			Cancellation: &model.Cancellation{CancelledAt: cancelledAt, CancelledBy: "user1", Reason: "sick", Fee: 1500, Currency: "EUR"},
+		}, nil)
+
+	// Call the method
+	resp, err := server.CancelBooking(mockContextWithClaims("user1", false), &pb.CancelBookingRequest{Id: objectID.Hex(), Reason: "sick"})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, int64(1500), resp.Fee)
+	assert.Equal(t, "EUR", resp.Currency)
+	assert.Equal(t, cancelledAt, resp.CancelledAt.AsTime())
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber cancels a booking (should waive the cancellation policy)
+func TestCancelBooking_BarberWaivesPolicy(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+	mockService.On("CancelBooking", mock.Anything, objectID.Hex(), service.CancelBookingParams{CancelledBy: "barber1", WaivePolicy: true}).
+		Return(&model.Booking{ID: objectID, Status: model.BookingStatusCancelled}, nil)
+
+	// Call the method
+	resp, err := server.CancelBooking(mockContextWithClaims("barber1", true), &pb.CancelBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Regular user cancels too close to the start (should fail)
+func TestCancelBooking_NotAllowed(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+	mockService.On("CancelBooking", mock.Anything, objectID.Hex(), mock.Anything).Return(nil, service.ErrCancellationNotAllowed)
+
+	// Call the method
+	resp, err := server.CancelBooking(mockContextWithClaims("user1", false), &pb.CancelBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+// Test: Regular user lists bookings without a user filter (should default to themselves)
+func TestListBookings_RegularUserDefaultsToSelf(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("ListBookings", mock.Anything, mock.MatchedBy(func(filter model.BookingFilter) bool {
+		return filter.UserID == "user1" && len(filter.Statuses) == 1 && filter.Statuses[0] == model.BookingStatusConfirmed
+	})).Return([]*model.Booking{{ID: primitive.NewObjectID(), UserID: "user1"}}, nil)
+
+	// Call the method
+	resp, err := server.ListBookings(mockContextWithClaims("user1", false), &pb.ListBookingsRequest{
+		Statuses: []pb.BookingStatus{pb.BookingStatus_CONFIRMED},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Len(t, resp.Bookings, 1)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Regular user lists another user's bookings (should fail)
+func TestListBookings_RegularUserForOther(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := server.ListBookings(mockContextWithClaims("user1", false), &pb.ListBookingsRequest{UserId: "user2"})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "ListBookings")
+}
+
+// Test: Barber lists a date range in a timezone (end date should be inclusive)
+func TestListBookings_DateRange(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	loc, _ := time.LoadLocation("Europe/Rome")
+	wantFrom := time.Date(2025, 3, 1, 0, 0, 0, 0, loc)
+	wantBefore := time.Date(2025, 4, 1, 0, 0, 0, 0, loc)
+
+	// Set up mock expectations
+	mockService.On("ListBookings", mock.Anything, mock.MatchedBy(func(filter model.BookingFilter) bool {
+		return filter.BarberID == "barber1" &&
+			filter.StartFrom.Equal(wantFrom) &&
+			filter.StartBefore.Equal(wantBefore) &&
+			filter.SortBy == model.SortByCreatedAt &&
+			filter.Descending
+	})).Return([]*model.Booking{}, nil)
+
+	// Call the method
+	resp, err := server.ListBookings(mockContextWithClaims("barber1", true), &pb.ListBookingsRequest{
+		BarberId:   "barber1",
+		StartDate:  "2025-03-01",
+		EndDate:    "2025-03-31",
+		Timezone:   "Europe/Rome",
+		SortBy:     pb.BookingSortField_CREATED_AT,
+		Descending: true,
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber lists bookings without filters (should only see their own)
+func TestListBookings_BarberDefaultsToSelf(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("ListBookings", mock.Anything, mock.MatchedBy(func(filter model.BookingFilter) bool {
+		return filter.BarberID == "barber1" && filter.UserID == ""
+	})).Return([]*model.Booking{}, nil)
+
+	// Call the method
+	resp, err := server.ListBookings(mockContextWithClaims("barber1", true), &pb.ListBookingsRequest{})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber lists another barber's bookings (should fail)
+func TestListBookings_BarberForOtherBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := server.ListBookings(mockContextWithClaims("barber1", true), &pb.ListBookingsRequest{BarberId: "barber2"})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "ListBookings")
+}
+
+// Test: Admin lists all bookings (should succeed)
+func TestListBookings_AdminListsAll(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("ListBookings", mock.Anything, mock.MatchedBy(func(filter model.BookingFilter) bool {
+		return filter.BarberID == "" && filter.UserID == ""
+	})).Return([]*model.Booking{}, nil)
+
+	// Call the method
+	resp, err := server.ListBookings(mockAdminContext("admin1"), &pb.ListBookingsRequest{})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Admin deletes a booking (should succeed)
+func TestDeleteBooking_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("DeleteBooking", mock.Anything, objectID.Hex()).Return(true, nil)
+
+	// Call the method
+	resp, err := server.DeleteBooking(mockAdminContext("admin1"), &pb.DeleteBookingRequest{Id: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.True(t, resp.Deleted)
+}
+
+// Test: Barber deletes a booking (should fail)
+func TestDeleteBooking_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "DeleteBooking", server.DeleteBooking)(mockContextWithClaims("barber1", true), &pb.DeleteBookingRequest{Id: primitive.NewObjectID().Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "DeleteBooking")
+}
+
+// Test: Start date after end date (should fail)
+func TestListBookings_InvalidRange(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := server.ListBookings(mockContextWithClaims("barber1", true), &pb.ListBookingsRequest{
+		StartDate: "2025-03-31",
+		EndDate:   "2025-03-01",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// Test: User reschedules into a taken slot (should fail)
+func TestRescheduleBooking_SlotUnavailable(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	newStart := time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+	mockService.On("RescheduleBooking", mock.Anything, objectID.Hex(), newStart).Return(nil, service.ErrSlotUnavailable)
+
+	// Call the method
+	resp, err := server.RescheduleBooking(mockContextWithClaims("user1", false), &pb.RescheduleBookingRequest{
+		Id:        objectID.Hex(),
+		StartTime: "2025-03-14T15:00:00Z",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
 }
 
-// Test: Regular user creates booking for themselves (should succeed)
-func TestCreateBooking_RegularUserForSelf(t *testing.T) {
+// Test: User reschedules their own booking (should succeed)
+func TestRescheduleBooking_Owner(t *testing.T) {
 	mockService := new(MockBookingService)
 	server := &BookingServer{service: mockService}
 
-	// Create test data
 	objectID := primitive.NewObjectID()
-	startTime := time.Now().Round(time.Second)
-	endTime := startTime.Add(30 * time.Minute)
-
-	booking := &model.Booking{
-		ID:          objectID,
-		UserID:      "user1",
-		BarberID:    "barber1",
-		StartTime:   startTime,
-		EndTime:     endTime,
-		ServiceType: model.ServiceTypeHaircut,
-		Status:      model.BookingStatusPending,
-		Notes:       "Test booking",
-		CreatedAt:   startTime,
-		UpdatedAt:   startTime,
+	oldStart := time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC)
+	newStart := time.Date(2025, 3, 14, 15, 0, 0, 0, time.UTC)
+
+	rescheduled := &model.Booking{
+		ID:              objectID,
+		UserID:          "user1",
+		StartTime:       newStart,
+		EndTime:         newStart.Add(30 * time.Minute),
+		RescheduledFrom: &oldStart,
 	}
 
 	// Set up mock expectations
-	mockService.On("CreateBooking",
-		mock.Anything,
-		"user1",
-		"barber1",
-		mock.AnythingOfType("time.Time"),
-		model.ServiceTypeHaircut,
-		"Test booking").Return(booking, nil)
-
-	// Create the request
-	req := &pb.CreateBookingRequest{
-		UserId:      "user1",
-		BarberId:    "barber1",
-		StartTime:   startTime.Format(time.RFC3339),
-		ServiceType: pb.ServiceType_HAIRCUT,
-		Notes:       "Test booking",
-	}
-
-	// Create context with claims (regular user)
-	ctx := mockContextWithClaims("user1", false)
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1", StartTime: oldStart}, nil)
+	mockService.On("RescheduleBooking", mock.Anything, objectID.Hex(), newStart).Return(rescheduled, nil)
 
 	// Call the method
-	resp, err := server.CreateBooking(ctx, req)
+	resp, err := server.RescheduleBooking(mockContextWithClaims("user1", false), &pb.RescheduleBookingRequest{
+		Id:        objectID.Hex(),
+		StartTime: "2025-03-14T15:00:00Z",
+	})
 
 	// Assertions
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, objectID.Hex(), resp.Id)
+	assert.Equal(t, "2025-03-14T15:00:00Z", resp.StartTime)
+	assert.Equal(t, "2025-03-14T10:00:00Z", resp.RescheduledFrom)
 }
 
-// Test: Regular user tries to create booking for another user (should fail)
-func TestCreateBooking_RegularUserForOther(t *testing.T) {
+// Test: User reschedules someone else's booking (should fail)
+func TestRescheduleBooking_OtherUser(t *testing.T) {
 	mockService := new(MockBookingService)
 	server := &BookingServer{service: mockService}
 
-	// Create the request
-	req := &pb.CreateBookingRequest{
-		UserId:      "user2", // Different from authenticated user
-		BarberId:    "barber1",
-		StartTime:   time.Now().Format(time.RFC3339),
-		ServiceType: pb.ServiceType_HAIRCUT,
-	}
+	objectID := primitive.NewObjectID()
 
-	// Create context with claims (regular user)
-	ctx := mockContextWithClaims("user1", false)
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user2"}, nil)
 
 	// Call the method
-	resp, err := server.CreateBooking(ctx, req)
+	resp, err := server.RescheduleBooking(mockContextWithClaims("user1", false), &pb.RescheduleBookingRequest{
+		Id:        objectID.Hex(),
+		StartTime: "2025-03-14T15:00:00Z",
+	})
 
 	// Assertions
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	// Verify that the error is permission denied
 	st, ok := status.FromError(err)
 	assert.True(t, ok)
 	assert.Equal(t, codes.PermissionDenied, st.Code())
 
-	// Verify that the service was never called
-	mockService.AssertNotCalled(t, "CreateBooking")
+	mockService.AssertNotCalled(t, "RescheduleBooking")
 }
 
-// Test: Barber creates booking for another user (should succeed)
-func TestCreateBooking_BarberForOther(t *testing.T) {
+// Test: Update with a stale version (should fail)
+func TestUpdateBooking_VersionMismatch(t *testing.T) {
 	mockService := new(MockBookingService)
 	server := &BookingServer{service: mockService}
 
-	// Create test data
 	objectID := primitive.NewObjectID()
-	startTime := time.Now().Round(time.Second)
-	endTime := startTime.Add(30 * time.Minute)
-
-	booking := &model.Booking{
-		ID:          objectID,
-		UserID:      "user1",
-		BarberID:    "barber1",
-		StartTime:   startTime,
-		EndTime:     endTime,
-		ServiceType: model.ServiceTypeHaircut,
-		Status:      model.BookingStatusPending,
-		CreatedAt:   startTime,
-		UpdatedAt:   startTime,
-	}
+	version := int64(2)
 
 	// Set up mock expectations
-	mockService.On("CreateBooking",
-		mock.Anything,
-		"user1",
-		"barber1",
-		mock.AnythingOfType("time.Time"),
-		model.ServiceTypeHaircut,
-		"").Return(booking, nil)
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1", Version: 3}, nil)
+	mockService.On("UpdateBooking", mock.Anything, objectID.Hex(), mock.MatchedBy(func(p service.UpdateBookingParams) bool {
+		return p.Version != nil && *p.Version == version
+	})).Return(nil, service.ErrBookingModified)
 
-	// Create the request
-	req := &pb.CreateBookingRequest{
-		UserId:      "user1", // Different from authenticated user (barber2)
-		BarberId:    "barber1",
-		StartTime:   startTime.Format(time.RFC3339),
-		ServiceType: pb.ServiceType_HAIRCUT,
-	}
+	// Call the method
+	resp, err := server.UpdateBooking(mockContextWithClaims("user1", false), &pb.UpdateBookingRequest{
+		Id:      objectID.Hex(),
+		Notes:   "Beard trim too",
+		Version: &version,
+	})
 
-	// Create context with claims (barber)
-	ctx := mockContextWithClaims("barber2", true)
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Aborted, st.Code())
+}
+
+// Test: Update returns the new version (should succeed)
+func TestUpdateBooking_ReturnsVersion(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	version := int64(3)
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1", Version: 3}, nil)
+	mockService.On("UpdateBooking", mock.Anything, objectID.Hex(), mock.MatchedBy(func(p service.UpdateBookingParams) bool {
+		return p.Version != nil && *p.Version == version
+	})).Return(&model.Booking{ID: objectID, UserID: "user1", Version: 4}, nil)
 
 	// Call the method
-	resp, err := server.CreateBooking(ctx, req)
+	resp, err := server.UpdateBooking(mockContextWithClaims("user1", false), &pb.UpdateBookingRequest{
+		Id:      objectID.Hex(),
+		Notes:   "Beard trim too",
+		Version: &version,
+	})
 
 	// Assertions
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, objectID.Hex(), resp.Id)
+	assert.Equal(t, int64(4), resp.Version)
 }
 
-// Test: Regular user tries to get barber bookings (should fail)
-func TestGetBarberBookings_RegularUser(t *testing.T) {
+// Test: Idempotency key reused for a different booking (should fail)
+func TestCreateBooking_IdempotencyKeyReused(t *testing.T) {
 	mockService := new(MockBookingService)
 	server := &BookingServer{service: mockService}
 
+	// Set up mock expectations
+	mockService.On("CreateBooking", mock.Anything, mock.MatchedBy(func(p service.CreateBookingParams) bool {
+		return p.IdempotencyKey == "retry-1"
+	})).Return(nil, service.ErrIdempotencyKeyReused)
+
 	// Create the request
-	req := &pb.GetBarberBookingsRequest{
-		BarberId: "barber1",
+	req := &pb.CreateBookingRequest{
+		UserId:         "user1",
+		BarberId:       "barber1",
+		StartTime:      time.Now().Format(time.RFC3339),
+		ServiceType:    pb.ServiceType_HAIRCUT,
+		IdempotencyKey: "retry-1",
 	}
 
-	// Create context with claims (regular user)
-	ctx := mockContextWithClaims("user1", false)
-
 	// Call the method
-	resp, err := server.GetBarberBookings(ctx, req)
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), req)
 
 	// Assertions
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	// Verify that the error is permission denied
 	st, ok := status.FromError(err)
 	assert.True(t, ok)
-	assert.Equal(t, codes.PermissionDenied, st.Code())
-
-	// Verify that the service was never called
-	mockService.AssertNotCalled(t, "GetBarberBookings")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
 }
 
-// Test: Barber gets barber bookings (should succeed)
-func TestGetBarberBookings_Barber(t *testing.T) {
+// Test: Create a booking with a Timestamp start time (should succeed)
+func TestCreateBooking_TimestampStartTime(t *testing.T) {
 	mockService := new(MockBookingService)
 	server := &BookingServer{service: mockService}
 
-	// Create test data
-	startTime := time.Now().Round(time.Second)
-	bookings := []*model.Booking{
-		{
-			ID:        primitive.NewObjectID(),
-			UserID:    "user1",
-			BarberID:  "barber1",
-			StartTime: startTime,
-			EndTime:   startTime.Add(30 * time.Minute),
-		},
-	}
-
-	// Set up mock expectations
-	mockService.On("GetBarberBookings",
-		mock.Anything,
-		"barber1",
-		mock.Anything).Return(bookings, nil)
+	objectID := primitive.NewObjectID()
+	startTime := time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC)
 
-	// Create the request
-	req := &pb.GetBarberBookingsRequest{
-		BarberId: "barber1",
+	booking := &model.Booking{
+		ID:        objectID,
+		UserID:    "user1",
+		BarberID:  "barber1",
+		StartTime: startTime,
+		EndTime:   startTime.Add(30 * time.Minute),
 	}
 
-	// Create context with claims (barber)
-	ctx := mockContextWithClaims("barber2", true)
+	// Set up mock expectations
+	mockService.On("CreateBooking", mock.Anything, mock.MatchedBy(func(p service.CreateBookingParams) bool {
+		return p.StartTime.Equal(startTime)
+	})).Return(booking, nil)
 
-	// Call the method
-	resp, err := server.GetBarberBookings(ctx, req)
+	// Call the method; the Timestamp wins over the legacy string
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), &pb.CreateBookingRequest{
+		UserId:      "user1",
+		BarberId:    "barber1",
+		StartTime:   "2025-03-14T12:00:00+02:00",
+		StartTimeTs: timestamppb.New(startTime),
+	})
 
 	// Assertions
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Len(t, resp.Bookings, 1)
+	assert.True(t, resp.StartTimeTs.AsTime().Equal(startTime))
+	assert.True(t, resp.EndTimeTs.AsTime().Equal(startTime.Add(30*time.Minute)))
+	assert.Nil(t, resp.CheckedInAtTs)
 }
 
-// Test: Regular user tries to view another user's bookings (should fail)
-func TestGetUserBookings_RegularUserForOther(t *testing.T) {
+// Test: Create a booking with an out-of-range Timestamp (should fail)
+func TestCreateBooking_InvalidTimestamp(t *testing.T) {
 	mockService := new(MockBookingService)
 	server := &BookingServer{service: mockService}
 
-	// Create the request
-	req := &pb.GetUserBookingsRequest{
-		UserId: "user2", // Different from authenticated user
-	}
-
-	// Create context with claims (regular user)
-	ctx := mockContextWithClaims("user1", false)
-
 	// Call the method
-	resp, err := server.GetUserBookings(ctx, req)
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), &pb.CreateBookingRequest{
+		UserId:      "user1",
+		BarberId:    "barber1",
+		StartTimeTs: &timestamppb.Timestamp{Seconds: 1741946400, Nanos: -1},
+	})
 
 	// Assertions
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 
-	// Verify that the error is permission denied
 	st, ok := status.FromError(err)
 	assert.True(t, ok)
-	assert.Equal(t, codes.PermissionDenied, st.Code())
+	assert.Equal(t, codes.InvalidArgument, st.Code())
 
-	// Verify that the service was never called
-	mockService.AssertNotCalled(t, "GetUserBookings")
+	mockService.AssertNotCalled(t, "CreateBooking")
 }
 
-// Test: Regular user views their own bookings (should succeed)
-func TestGetUserBookings_RegularUserForSelf(t *testing.T) {
+// Test: Create a booking without any start time (should fail)
+func TestCreateBooking_MissingStartTime(t *testing.T) {
 	mockService := new(MockBookingService)
 	server := &BookingServer{service: mockService}
 
-	// Create test data
-	startTime := time.Now().Round(time.Second)
-	bookings := []*model.Booking{
-		{
-			ID:        primitive.NewObjectID(),
-			UserID:    "user1",
-			BarberID:  "barber1",
-			StartTime: startTime,
-			EndTime:   startTime.Add(30 * time.Minute),
-		},
-	}
+	// Call the method
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), &pb.CreateBookingRequest{
+		UserId:   "user1",
+		BarberId: "barber1",
+	})
 
-	// Set up mock expectations
-	mockService.On("GetUserBookings",
-		mock.Anything,
-		"user1").Return(bookings, nil)
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
 
-	// Create the request
-	req := &pb.GetUserBookingsRequest{
-		UserId: "user1",
-	}
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
 
-	// Create context with claims (regular user)
-	ctx := mockContextWithClaims("user1", false)
+// Test: Update mask clears the notes and leaves everything else alone (should succeed)
+func TestUpdateBooking_MaskClearsNotes(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	existing := &model.Booking{ID: objectID, UserID: "user1", ServiceType: model.ServiceTypeBeardTrim, Notes: "Short on the sides"}
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(existing, nil)
+	mockService.On("UpdateBooking", mock.Anything, objectID.Hex(), mock.MatchedBy(func(p service.UpdateBookingParams) bool {
+		return p.Notes != nil && *p.Notes == "" && p.StartTime == nil && p.ServiceTypes == nil
+	})).Return(&model.Booking{ID: objectID, UserID: "user1", ServiceType: model.ServiceTypeBeardTrim}, nil)
 
 	// Call the method
-	resp, err := server.GetUserBookings(ctx, req)
+	resp, err := server.UpdateBooking(mockContextWithClaims("user1", false), &pb.UpdateBookingRequest{
+		Id:         objectID.Hex(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"notes"}},
+	})
 
 	// Assertions
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Len(t, resp.Bookings, 1)
+	assert.Empty(t, resp.Notes)
 }
 
-// Test: Barber views another user's bookings (should succeed)
-func TestGetUserBookings_BarberForOther(t *testing.T) {
+// Test: Update mask sets the service type back to a haircut (should succeed)
+func TestUpdateBooking_MaskSetsHaircut(t *testing.T) {
 	mockService := new(MockBookingService)
 	server := &BookingServer{service: mockService}
 
-	// Create test data
-	startTime := time.Now().Round(time.Second)
-	bookings := []*model.Booking{
-		{
-			ID:        primitive.NewObjectID(),
-			UserID:    "user1",
-			BarberID:  "barber1",
-			StartTime: startTime,
-			EndTime:   startTime.Add(30 * time.Minute),
-		},
-	}
+	objectID := primitive.NewObjectID()
 
 	// Set up mock expectations
-	mockService.On("GetUserBookings",
-		mock.Anything,
-		"user1").Return(bookings, nil)
-
-	// Create the request
-	req := &pb.GetUserBookingsRequest{
-		UserId: "user1",
-	}
-
-	// Create context with claims (barber)
-	ctx := mockContextWithClaims("barber2", true)
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1", ServiceType: model.ServiceTypeBeardTrim}, nil)
+	mockService.On("UpdateBooking", mock.Anything, objectID.Hex(), mock.MatchedBy(func(p service.UpdateBookingParams) bool {
+		return slices.Equal(p.ServiceTypes, []model.ServiceType{model.ServiceTypeHaircut}) && p.Notes == nil
+	})).Return(&model.Booking{ID: objectID, UserID: "user1", ServiceType: model.ServiceTypeHaircut}, nil)
 
 	// Call the method
-	resp, err := server.GetUserBookings(ctx, req)
+	resp, err := server.UpdateBooking(mockContextWithClaims("user1", false), &pb.UpdateBookingRequest{
+		Id:          objectID.Hex(),
+		ServiceType: pb.ServiceType_HAIRCUT,
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"service_type"}},
+	})
 
 	// Assertions
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Len(t, resp.Bookings, 1)
+	assert.Equal(t, pb.ServiceType_HAIRCUT, resp.ServiceType)
+}
+
+// Test: Update mask with an unknown path (should fail)
+func TestUpdateBooking_MaskUnknownPath(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+
+	// Call the method
+	resp, err := server.UpdateBooking(mockContextWithClaims("user1", false), &pb.UpdateBookingRequest{
+		Id:         objectID.Hex(),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user_id"}},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	mockService.AssertNotCalled(t, "UpdateBooking")
 }
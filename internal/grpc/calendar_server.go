@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/calendar"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// GetBookingICS returns a booking as an iCalendar file
+func (s *BookingServer) GetBookingICS(ctx context.Context, req *pb.GetBookingICSRequest) (*pb.BookingICS, error) {
+	// The policy only lets the booking's customer, its barber and admins through
+	data, err := s.service.GetBookingICS(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, service.ErrBookingNotFound) {
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to render calendar file: %v", err)
+	}
+
+	return &pb.BookingICS{
+		ContentType: calendar.ContentType,
+		Filename:    "booking-" + req.Id + ".ics",
+		Data:        data,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Customer downloads their own booking's calendar file (should succeed)
+func TestGetBookingICS_Owner(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+	ics := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
+
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1", BarberID: "barber1"}, nil)
+	mockService.On("GetBookingICS", mock.Anything, objectID.Hex()).Return(ics, nil)
+
+	resp, err := withPolicy(server, "GetBookingICS", server.GetBookingICS)(mockContextWithClaims("user1", false), &pb.GetBookingICSRequest{Id: objectID.Hex()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "text/calendar; charset=utf-8", resp.ContentType)
+	assert.Equal(t, "booking-"+objectID.Hex()+".ics", resp.Filename)
+	assert.Equal(t, ics, resp.Data)
+}
+
+// Test: The booked barber downloads the calendar file (should succeed)
+func TestGetBookingICS_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1", BarberID: "barber1"}, nil)
+	mockService.On("GetBookingICS", mock.Anything, objectID.Hex()).Return([]byte("BEGIN:VCALENDAR\r\n"), nil)
+
+	_, err := withPolicy(server, "GetBookingICS", server.GetBookingICS)(mockContextWithClaims("barber1", true), &pb.GetBookingICSRequest{Id: objectID.Hex()})
+
+	assert.NoError(t, err)
+}
+
+// Test: Another customer or barber asks for the calendar file (should fail)
+func TestGetBookingICS_NotInvolved(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1", BarberID: "barber1"}, nil)
+
+	for _, ctx := range []struct {
+		userID   string
+		isBarber bool
+	}{{"user2", false}, {"barber2", true}} {
+		_, err := withPolicy(server, "GetBookingICS", server.GetBookingICS)(mockContextWithClaims(ctx.userID, ctx.isBarber), &pb.GetBookingICSRequest{Id: objectID.Hex()})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), ctx.userID)
+	}
+	mockService.AssertNotCalled(t, "GetBookingICS", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// ListCatalogServices returns the services the shop offers
+func (s *BookingServer) ListCatalogServices(ctx context.Context, req *pb.ListCatalogServicesRequest) (*pb.CatalogServiceList, error) {
+	// Only staff see services that are no longer offered
+	includeInactive := req.IncludeInactive && (auth.IsBarber(ctx) || auth.IsAdmin(ctx))
+
+	services, err := s.service.ListCatalogServices(ctx, includeInactive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list catalog services: %v", err)
+	}
+
+	pbServices := make([]*pb.CatalogService, len(services))
+	for i, catalogService := range services {
+		pbServices[i] = convertCatalogServiceToProto(catalogService)
+	}
+
+	return &pb.CatalogServiceList{Services: pbServices}, nil
+}
+
+// CreateCatalogService adds a service to the catalog
+func (s *BookingServer) CreateCatalogService(ctx context.Context, req *pb.CreateCatalogServiceRequest) (*pb.CatalogService, error) {
+	if req.Service == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "service is required")
+	}
+
+	created, err := s.service.CreateCatalogService(ctx, convertCatalogServiceFromProto(req.Service))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCatalogService) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrCatalogServiceExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create catalog service: %v", err)
+	}
+
+	return convertCatalogServiceToProto(created), nil
+}
+
+// UpdateCatalogService changes a catalog service
+func (s *BookingServer) UpdateCatalogService(ctx context.Context, req *pb.UpdateCatalogServiceRequest) (*pb.CatalogService, error) {
+	if req.Service == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "service is required")
+	}
+
+	updated, err := s.service.UpdateCatalogService(ctx, convertCatalogServiceFromProto(req.Service))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCatalogService) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrCatalogServiceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update catalog service: %v", err)
+	}
+
+	return convertCatalogServiceToProto(updated), nil
+}
+
+// DeleteCatalogService removes a service from the catalog
+func (s *BookingServer) DeleteCatalogService(ctx context.Context, req *pb.DeleteCatalogServiceRequest) (*pb.DeleteCatalogServiceResponse, error) {
+	deleted, err := s.service.DeleteCatalogService(ctx, model.ServiceType(req.ServiceType))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete catalog service: %v", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "%v", service.ErrCatalogServiceNotFound)
+	}
+
+	return &pb.DeleteCatalogServiceResponse{Success: true}, nil
+}
+
+// Helper function to convert model.CatalogService to proto CatalogService
+func convertCatalogServiceToProto(catalogService *model.CatalogService) *pb.CatalogService {
+	result := &pb.CatalogService{
+		ServiceType:     pb.ServiceType(catalogService.ServiceType),
+		Name:            catalogService.Name,
+		DurationMinutes: int32(catalogService.DurationMinutes),
+		Price:           catalogService.Price,
+		Active:          catalogService.Active,
+	}
+	if catalogService.CleanupMinutes != nil {
+		cleanup := int32(*catalogService.CleanupMinutes)
+		result.CleanupMinutes = &cleanup
+	}
+	return result
+}
+
+// Helper function to convert proto CatalogService to model.CatalogService
+func convertCatalogServiceFromProto(catalogService *pb.CatalogService) model.CatalogService {
+	result := model.CatalogService{
+		ServiceType:     model.ServiceType(catalogService.ServiceType),
+		Name:            catalogService.Name,
+		DurationMinutes: int(catalogService.DurationMinutes),
+		Price:           catalogService.Price,
+		Active:          catalogService.Active,
+	}
+	if catalogService.CleanupMinutes != nil {
+		cleanup := int(*catalogService.CleanupMinutes)
+		result.CleanupMinutes = &cleanup
+	}
+	return result
+}
@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Admin adds a service to the catalog (should succeed)
+func TestCreateCatalogService_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	catalogService := model.CatalogService{
+		ServiceType:     model.ServiceTypeBeardTrim,
+		Name:            "Beard Trim",
+		DurationMinutes: 20,
+		Price:           1500,
+		Active:          true,
+	}
+
+	// Set up mock expectations
+	mockService.On("CreateCatalogService", mock.Anything, catalogService).Return(&catalogService, nil)
+
+	// Call the method
+	resp, err := server.CreateCatalogService(mockAdminContext("admin1"), &pb.CreateCatalogServiceRequest{
+		Service: &pb.CatalogService{
+			ServiceType:     pb.ServiceType_BEARD_TRIM,
+			Name:            "Beard Trim",
+			DurationMinutes: 20,
+			Price:           1500,
+			Active:          true,
+		},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, int32(20), resp.DurationMinutes)
+	assert.Equal(t, int64(1500), resp.Price)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber adds a service to the catalog (should fail)
+func TestCreateCatalogService_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "CreateCatalogService", server.CreateCatalogService)(mockContextWithClaims("barber1", true), &pb.CreateCatalogServiceRequest{
+		Service: &pb.CatalogService{Name: "Beard Trim", DurationMinutes: 20},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "CreateCatalogService")
+}
+
+// Test: Regular user adds a service to the catalog (should fail)
+func TestCreateCatalogService_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "CreateCatalogService", server.CreateCatalogService)(mockContextWithClaims("user1", false), &pb.CreateCatalogServiceRequest{
+		Service: &pb.CatalogService{Name: "Beard Trim", DurationMinutes: 20},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "CreateCatalogService")
+}
+
+// Test: Admin updates a service that isn't in the catalog (should fail)
+func TestUpdateCatalogService_NotFound(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("UpdateCatalogService", mock.Anything, mock.Anything).Return(nil, service.ErrCatalogServiceNotFound)
+
+	// Call the method
+	resp, err := server.UpdateCatalogService(mockAdminContext("admin1"), &pb.UpdateCatalogServiceRequest{
+		Service: &pb.CatalogService{ServiceType: pb.ServiceType_FULL_SERVICE, Name: "Full Service", DurationMinutes: 60},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+// Test: Regular user lists the catalog with inactive services (should only see active ones)
+func TestListCatalogServices_RegularUserIgnoresInactive(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("ListCatalogServices", mock.Anything, false).Return([]*model.CatalogService{
+		{ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Active: true},
+	}, nil)
+
+	// Call the method
+	resp, err := server.ListCatalogServices(mockContextWithClaims("user1", false), &pb.ListCatalogServicesRequest{
+		IncludeInactive: true,
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Len(t, resp.Services, 1)
+	mockService.AssertExpectations(t)
+}
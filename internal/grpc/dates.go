@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// loadTimezone loads an IANA timezone, UTC when empty
+func loadTimezone(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q: unknown IANA zone", timezone)
+	}
+	return loc, nil
+}
+
+// parseDateInput resolves a day from either a date string or a structured
+// CalendarDate, returning midnight of that day in the requested IANA timezone
+// (UTC when empty). The boolean result is false when no date was given.
+func parseDateInput(date string, day *pb.CalendarDate, timezone string) (time.Time, bool, error) {
+	loc, err := loadTimezone(timezone)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	switch {
+	case day != nil:
+		t := time.Date(int(day.Year), time.Month(day.Month), int(day.Day), 0, 0, 0, 0, loc)
+		// time.Date normalizes out-of-range values (e.g. Feb 30), so reject those
+		if t.Year() != int(day.Year) || int32(t.Month()) != day.Month || int32(t.Day()) != day.Day {
+			return time.Time{}, false, status.Errorf(codes.InvalidArgument, "invalid date: %04d-%02d-%02d", day.Year, day.Month, day.Day)
+		}
+		return t, true, nil
+
+	case date != "":
+		// Accept a bare date, or a full timestamp whose day is taken in the requested zone
+		if t, err := time.ParseInLocation("2006-01-02", date, loc); err == nil {
+			return t, true, nil
+		}
+		t, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return time.Time{}, false, status.Errorf(codes.InvalidArgument, "invalid date format: %q, expected YYYY-MM-DD", date)
+		}
+		t = t.In(loc)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc), true, nil
+
+	default:
+		return time.Time{}, false, nil
+	}
+}
+
+// parseTimeInput resolves an instant from either a protobuf Timestamp or a
+// legacy RFC3339 string, preferring the Timestamp when both are set. The
+// boolean result is false when neither was given.
+func parseTimeInput(ts *timestamppb.Timestamp, value string, field string) (time.Time, bool, error) {
+	switch {
+	case ts != nil:
+		if err := ts.CheckValid(); err != nil {
+			return time.Time{}, false, status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+		}
+		return ts.AsTime(), true, nil
+
+	case value != "":
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, false, status.Errorf(codes.InvalidArgument, "invalid %s format: %v", field, err)
+		}
+		return t, true, nil
+
+	default:
+		return time.Time{}, false, nil
+	}
+}
+
+// toOptionalTimestamp converts an optional time, nil if unset
+func toOptionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
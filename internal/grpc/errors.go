@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// errorDomain is the ErrorInfo domain of the errors this service raises
+const errorDomain = "booking.ita-av"
+
+// errorReasons are the ErrorInfo reasons of the domain errors clients are
+// expected to handle, e.g. by offering another time
+var errorReasons = []struct {
+	err    error
+	reason string
+}{
+	{service.ErrSlotUnavailable, "SLOT_UNAVAILABLE"},
+	{service.ErrDuringBreak, "BARBER_ON_BREAK"},
+	{service.ErrShopClosed, "SHOP_CLOSED"},
+	{service.ErrStartTimeInPast, "START_TIME_IN_PAST"},
+	{service.ErrBookingTooSoon, "BOOKING_TOO_SOON"},
+	{service.ErrBookingTooFarAhead, "BOOKING_TOO_FAR_AHEAD"},
+	{service.ErrServiceNotOffered, "SERVICE_NOT_OFFERED"},
+	{service.ErrBarberInactive, "BARBER_INACTIVE"},
+	{service.ErrResourceUnavailable, "RESOURCE_UNAVAILABLE"},
+	{service.ErrHoldExpired, "HOLD_EXPIRED"},
+	{service.ErrNothingToRebook, "NOTHING_TO_REBOOK"},
+	{service.ErrNoSlotToRebook, "NO_SLOT_TO_REBOOK"},
+	{service.ErrPromoCodeUnavailable, "PROMO_CODE_UNAVAILABLE"},
+}
+
+// domainError builds the status for a service error. Errors with a known
+// reason carry an ErrorInfo detail, and taken slots a SlotUnavailableDetail
+// with the conflicting bookings' times and free slots to offer instead.
+func domainError(code codes.Code, err error) error {
+	st := status.New(code, err.Error())
+
+	var details []protoadapt.MessageV1
+	for _, r := range errorReasons {
+		if errors.Is(err, r.err) {
+			details = append(details, &errdetails.ErrorInfo{Reason: r.reason, Domain: errorDomain})
+			break
+		}
+	}
+
+	var slotErr *service.SlotUnavailableError
+	if errors.As(err, &slotErr) {
+		if len(slotErr.Conflicts) > 0 {
+			// The first conflict is enough for clients that only read ErrorInfo
+			info := details[0].(*errdetails.ErrorInfo)
+			info.Metadata = map[string]string{
+				"conflictStart": slotErr.Conflicts[0].StartTime.Format(time.RFC3339),
+				"conflictEnd":   slotErr.Conflicts[0].EndTime.Format(time.RFC3339),
+			}
+		}
+		details = append(details, &pb.SlotUnavailableDetail{
+			Conflicts:    convertTimeSlotsToProto(slotErr.Conflicts).TimeSlots,
+			Alternatives: convertTimeSlotsToProto(slotErr.Alternatives).TimeSlots,
+		})
+	}
+
+	if len(details) == 0 {
+		return st.Err()
+	}
+	detailed, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
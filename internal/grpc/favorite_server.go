@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// AddFavoriteBarber marks a barber as one of the caller's favorites
+func (s *BookingServer) AddFavoriteBarber(ctx context.Context, req *pb.AddFavoriteBarberRequest) (*pb.FavoriteBarber, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	favorite, err := s.service.AddFavoriteBarber(ctx, userID, req.BarberId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add favorite: %v", err)
+	}
+
+	return convertFavoriteBarberToProto(favorite), nil
+}
+
+// RemoveFavoriteBarber removes a barber from the caller's favorites
+func (s *BookingServer) RemoveFavoriteBarber(ctx context.Context, req *pb.RemoveFavoriteBarberRequest) (*pb.RemoveFavoriteBarberResponse, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	removed, err := s.service.RemoveFavoriteBarber(ctx, userID, req.BarberId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove favorite: %v", err)
+	}
+
+	return &pb.RemoveFavoriteBarberResponse{Removed: removed}, nil
+}
+
+// ListFavorites returns the caller's favorite barbers
+func (s *BookingServer) ListFavorites(ctx context.Context, req *pb.ListFavoritesRequest) (*pb.FavoriteBarberList, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	favorites, err := s.service.ListFavorites(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list favorites: %v", err)
+	}
+
+	result := &pb.FavoriteBarberList{
+		Favorites: make([]*pb.FavoriteBarber, len(favorites)),
+	}
+	for i, favorite := range favorites {
+		result.Favorites[i] = convertFavoriteBarberToProto(favorite)
+	}
+
+	return result, nil
+}
+
+// RebookLast books a customer's most recent completed booking again at the
+// barber's next free slot
+func (s *BookingServer) RebookLast(ctx context.Context, req *pb.RebookLastRequest) (*pb.Booking, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated: %v", err)
+	}
+
+	// Regular users can only rebook for themselves, barbers for anyone
+	if !auth.IsBarber(ctx) && userID != req.UserId {
+		return nil, status.Errorf(codes.PermissionDenied, "regular users can only rebook for themselves")
+	}
+
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+
+	booking, err := s.service.RebookLast(ctx, req.UserId, req.IdempotencyKey)
+	if err != nil {
+		if errors.Is(err, service.ErrNothingToRebook) || errors.Is(err, service.ErrNoSlotToRebook) {
+			return nil, domainError(codes.FailedPrecondition, err)
+		}
+		if errors.Is(err, service.ErrUserIDRequired) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		return nil, createBookingError(err)
+	}
+
+	return s.convertBookingForCaller(ctx, booking), nil
+}
+
+// Helper function to convert model.FavoriteBarber to proto FavoriteBarber
+func convertFavoriteBarberToProto(favorite *model.FavoriteBarber) *pb.FavoriteBarber {
+	result := &pb.FavoriteBarber{
+		BarberId: favorite.BarberID,
+		AddedAt:  timestamppb.New(favorite.AddedAt),
+	}
+	if favorite.Barber != nil {
+		result.Barber = convertBarberToProto(favorite.Barber)
+	}
+	return result
+}
@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Customer adds a favorite barber for themselves (should succeed)
+func TestAddFavoriteBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	addedAt := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("AddFavoriteBarber", mock.Anything, "user1", "barber1").Return(&model.FavoriteBarber{
+		UserID:   "user1",
+		BarberID: "barber1",
+		AddedAt:  addedAt,
+	}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "AddFavoriteBarber", server.AddFavoriteBarber)(mockContextWithClaims("user1", false), &pb.AddFavoriteBarberRequest{
+		BarberId: "barber1",
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "barber1", resp.BarberId)
+	assert.Equal(t, addedAt, resp.AddedAt.AsTime())
+	assert.Nil(t, resp.Barber)
+}
+
+// Test: Customer lists their favorites with the barbers' profiles
+func TestListFavorites(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("ListFavorites", mock.Anything, "user1").Return([]*model.FavoriteBarber{
+		{UserID: "user1", BarberID: "barber2"},
+		{UserID: "user1", BarberID: "barber1", Barber: &model.Barber{ID: "barber1", DisplayName: "Sam", Active: true}},
+	}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "ListFavorites", server.ListFavorites)(mockContextWithClaims("user1", false), &pb.ListFavoritesRequest{})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Len(t, resp.Favorites, 2)
+	assert.Nil(t, resp.Favorites[0].Barber)
+	assert.Equal(t, "Sam", resp.Favorites[1].Barber.DisplayName)
+}
+
+// Test: Customer rebooks their last booking (should succeed)
+func TestRebookLast(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	start := time.Date(2025, 6, 3, 10, 0, 0, 0, time.UTC)
+	booking := &model.Booking{
+		ID:          primitive.NewObjectID(),
+		UserID:      "user1",
+		BarberID:    "barber1",
+		StartTime:   start,
+		EndTime:     start.Add(30 * time.Minute),
+		ServiceType: model.ServiceTypeHaircut,
+		Status:      model.BookingStatusPending,
+	}
+
+	// Set up mock expectations
+	mockService.On("RebookLast", mock.Anything, "user1", "key1").Return(booking, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "RebookLast", server.RebookLast)(mockContextWithClaims("user1", false), &pb.RebookLastRequest{
+		UserId:         "user1",
+		IdempotencyKey: "key1",
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, booking.ID.Hex(), resp.Id)
+	assert.Equal(t, "barber1", resp.BarberId)
+}
+
+// Test: Customer rebooks for someone else (should fail)
+func TestRebookLast_OtherUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := server.RebookLast(mockContextWithClaims("user1", false), &pb.RebookLastRequest{
+		UserId: "user2",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	mockService.AssertNotCalled(t, "RebookLast", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test: Rebooking without a completed booking or a free slot (should fail)
+func TestRebookLast_ErrorReasons(t *testing.T) {
+	tests := []struct {
+		err    error
+		reason string
+	}{
+		{service.ErrNothingToRebook, "NOTHING_TO_REBOOK"},
+		{service.ErrNoSlotToRebook, "NO_SLOT_TO_REBOOK"},
+		{service.ErrBarberInactive, "BARBER_INACTIVE"},
+	}
+
+	for _, tt := range tests {
+		mockService := new(MockBookingService)
+		server := &BookingServer{service: mockService}
+
+		// Set up mock expectations
+		mockService.On("RebookLast", mock.Anything, "user1", "").Return(nil, tt.err)
+
+		// Call the method
+		resp, err := server.RebookLast(mockContextWithClaims("user1", false), &pb.RebookLastRequest{
+			UserId: "user1",
+		})
+
+		// Assertions
+		assert.Nil(t, resp)
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, st.Code(), tt.reason)
+
+		if assert.Len(t, st.Details(), 1, tt.reason) {
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			assert.True(t, ok)
+			assert.Equal(t, tt.reason, info.Reason)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// GetBookingHistory returns every change made to a booking
+func (s *BookingServer) GetBookingHistory(ctx context.Context, req *pb.GetBookingHistoryRequest) (*pb.BookingHistory, error) {
+	// The policy only lets the booking's customer and staff through
+	entries, err := s.service.GetBookingHistory(ctx, req.BookingId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get booking history: %v", err)
+	}
+
+	history := &pb.BookingHistory{BookingId: req.BookingId}
+	for _, entry := range entries {
+		history.Entries = append(history.Entries, convertHistoryEntryToProto(entry))
+	}
+
+	return history, nil
+}
+
+// Helper function to convert model.BookingHistoryEntry to proto BookingHistoryEntry
+func convertHistoryEntryToProto(entry *model.BookingHistoryEntry) *pb.BookingHistoryEntry {
+	protoEntry := &pb.BookingHistoryEntry{
+		Action:  string(entry.Action),
+		ActorId: entry.ActorID,
+		At:      entry.At.Format(time.RFC3339),
+	}
+
+	for _, change := range entry.Changes {
+		protoEntry.Changes = append(protoEntry.Changes, &pb.FieldChange{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+
+	return protoEntry
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: User gets the history of their own booking (should succeed)
+func TestGetBookingHistory_Owner(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user1"}, nil)
+	mockService.On("GetBookingHistory", mock.Anything, objectID.Hex()).Return([]*model.BookingHistoryEntry{
+		{
+			BookingID: objectID.Hex(),
+			Action:    model.BookingActionRescheduled,
+			ActorID:   "user1",
+			At:        time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC),
+			Changes: []model.FieldChange{
+				{Field: "startTime", Before: `"2025-06-02T10:00:00Z"`, After: `"2025-06-02T11:00:00Z"`},
+			},
+		},
+	}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBookingHistory", server.GetBookingHistory)(mockContextWithClaims("user1", false), &pb.GetBookingHistoryRequest{BookingId: objectID.Hex()})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, objectID.Hex(), resp.BookingId)
+	if assert.Len(t, resp.Entries, 1) {
+		assert.Equal(t, "rescheduled", resp.Entries[0].Action)
+		assert.Equal(t, "user1", resp.Entries[0].ActorId)
+		assert.Equal(t, "2025-06-01T09:00:00Z", resp.Entries[0].At)
+		assert.Equal(t, "startTime", resp.Entries[0].Changes[0].Field)
+	}
+}
+
+// Test: User tries to get the history of someone else's booking (should fail)
+func TestGetBookingHistory_OtherUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	objectID := primitive.NewObjectID()
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, objectID.Hex()).Return(&model.Booking{ID: objectID, UserID: "user2"}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBookingHistory", server.GetBookingHistory)(mockContextWithClaims("user1", false), &pb.GetBookingHistoryRequest{BookingId: objectID.Hex()})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "GetBookingHistory")
+}
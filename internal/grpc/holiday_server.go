@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// ListHolidays returns the days the shop is closed
+func (s *BookingServer) ListHolidays(ctx context.Context, req *pb.ListHolidaysRequest) (*pb.HolidayList, error) {
+	var from, to *time.Time
+
+	start, ok, err := parseDateInput(req.StartDate, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		from = &start
+	}
+
+	end, ok, err := parseDateInput(req.EndDate, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		to = &end
+	}
+
+	holidays, err := s.service.ListHolidays(ctx, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list holidays: %v", err)
+	}
+
+	pbHolidays := make([]*pb.Holiday, len(holidays))
+	for i, holiday := range holidays {
+		pbHolidays[i] = convertHolidayToProto(holiday)
+	}
+
+	return &pb.HolidayList{Holidays: pbHolidays}, nil
+}
+
+// AddHoliday closes the shop on a day
+func (s *BookingServer) AddHoliday(ctx context.Context, req *pb.AddHolidayRequest) (*pb.Holiday, error) {
+	date, ok, err := parseDateInput(req.Date, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "date is required")
+	}
+
+	userID, _ := auth.GetUserIDFromContext(ctx)
+
+	holiday, err := s.service.AddHoliday(ctx, date, req.Name, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add holiday: %v", err)
+	}
+
+	return convertHolidayToProto(holiday), nil
+}
+
+// RemoveHoliday reopens the shop on a day
+func (s *BookingServer) RemoveHoliday(ctx context.Context, req *pb.RemoveHolidayRequest) (*pb.RemoveHolidayResponse, error) {
+	date, ok, err := parseDateInput(req.Date, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "date is required")
+	}
+
+	removed, err := s.service.RemoveHoliday(ctx, date)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove holiday: %v", err)
+	}
+	if !removed {
+		return nil, status.Errorf(codes.NotFound, "no holiday on %s", req.Date)
+	}
+
+	return &pb.RemoveHolidayResponse{Success: true}, nil
+}
+
+// Helper function to convert model.Holiday to proto Holiday
+func convertHolidayToProto(holiday *model.Holiday) *pb.Holiday {
+	return &pb.Holiday{
+		Date: holiday.Date,
+		Name: holiday.Name,
+	}
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Admin adds a holiday (should succeed)
+func TestAddHoliday_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	date := time.Date(2025, time.December, 25, 0, 0, 0, 0, time.UTC)
+
+	// Set up mock expectations
+	mockService.On("AddHoliday", mock.Anything, date, "Christmas Day", "admin1").
+		Return(&model.Holiday{Date: "2025-12-25", Name: "Christmas Day"}, nil)
+
+	// Call the method
+	resp, err := server.AddHoliday(mockAdminContext("admin1"), &pb.AddHolidayRequest{
+		Date: "2025-12-25",
+		Name: "Christmas Day",
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "2025-12-25", resp.Date)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber adds a holiday (should fail)
+func TestAddHoliday_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "AddHoliday", server.AddHoliday)(mockContextWithClaims("barber1", true), &pb.AddHolidayRequest{
+		Date: "2025-12-25",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "AddHoliday")
+}
+
+// Test: Admin removes a date that isn't a holiday (should fail)
+func TestRemoveHoliday_NotFound(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("RemoveHoliday", mock.Anything, time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC)).Return(false, nil)
+
+	// Call the method
+	resp, err := server.RemoveHoliday(mockAdminContext("admin1"), &pb.RemoveHolidayRequest{
+		Date: "2025-12-24",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+// Test: User books on a holiday (should fail)
+func TestCreateBooking_Holiday(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("CreateBooking", mock.Anything, mock.Anything).Return(nil, service.ErrShopClosed)
+
+	// Call the method
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), &pb.CreateBookingRequest{
+		UserId:    "user1",
+		BarberId:  "barber1",
+		StartTime: "2025-12-25T10:00:00Z",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Contains(t, st.Message(), "holiday")
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// ExportPayroll exports a month's per-barber payroll
+func (s *BookingServer) ExportPayroll(ctx context.Context, req *pb.ExportPayrollRequest) (*pb.PayrollExport, error) {
+	month, err := parsePayrollMonth(req.Year, req.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := s.service.GetPayrollPeriod(ctx, int(req.Year), month)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get payroll period: %v", err)
+	}
+
+	return convertPayrollToProto(period, req.Format)
+}
+
+// FinalizePayrollPeriod locks a past month's payroll and returns its export
+func (s *BookingServer) FinalizePayrollPeriod(ctx context.Context, req *pb.FinalizePayrollPeriodRequest) (*pb.PayrollExport, error) {
+	month, err := parsePayrollMonth(req.Year, req.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := s.service.FinalizePayrollPeriod(ctx, int(req.Year), month)
+	if err != nil {
+		if errors.Is(err, service.ErrPayrollPeriodOpen) || errors.Is(err, service.ErrPayrollPeriodFinalized) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to finalize payroll period: %v", err)
+	}
+
+	return convertPayrollToProto(period, req.Format)
+}
+
+// parsePayrollMonth validates the year and month of a payroll request
+func parsePayrollMonth(year, month int32) (time.Month, error) {
+	if year < 2000 || year > 9999 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid year: %d", year)
+	}
+	if month < 1 || month > 12 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid month: %d", month)
+	}
+
+	return time.Month(month), nil
+}
+
+// Helper function to encode a model.PayrollPeriod as a proto PayrollExport
+func convertPayrollToProto(period *model.PayrollPeriod, format pb.ExportFormat) (*pb.PayrollExport, error) {
+	payrollFormat := service.PayrollFormatCSV
+	contentType := "text/csv"
+	extension := "csv"
+	if format == pb.ExportFormat_JSON {
+		payrollFormat = service.PayrollFormatJSON
+		contentType = "application/json"
+		extension = "json"
+	}
+
+	content, err := service.EncodePayroll(period, payrollFormat)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode payroll: %v", err)
+	}
+
+	return &pb.PayrollExport{
+		Period:      period.ID,
+		Finalized:   period.Finalized,
+		Filename:    fmt.Sprintf("payroll-%s.%s", period.ID, extension),
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Barber tries to export payroll (should fail)
+func TestExportPayroll_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create context with claims (barber)
+	ctx := mockContextWithClaims("barber1", true)
+
+	// Call the method
+	resp, err := withPolicy(server, "ExportPayroll", server.ExportPayroll)(ctx, &pb.ExportPayrollRequest{Year: 2025, Month: 3})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "GetPayrollPeriod")
+}
+
+// Test: Admin exports payroll as CSV (should succeed)
+func TestExportPayroll_AdminCSV(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	period := &model.PayrollPeriod{
+		ID:       "2025-03",
+		Year:     2025,
+		Month:    time.March,
+		Currency: "USD",
+		Entries: []*model.PayrollEntry{
+			{BarberID: "barber1", CompletedBookings: 2, HoursWorked: 1.5, Revenue: 7000, Commission: 2800, Tips: 1000},
+		},
+		Finalized: true,
+	}
+
+	// Set up mock expectations
+	mockService.On("GetPayrollPeriod", mock.Anything, 2025, time.March).Return(period, nil)
+
+	// Create context with claims (admin)
+	ctx := mockAdminContext("admin1")
+
+	// Call the method
+	resp, err := server.ExportPayroll(ctx, &pb.ExportPayrollRequest{Year: 2025, Month: 3, Format: pb.ExportFormat_CSV})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.True(t, resp.Finalized)
+	assert.Equal(t, "payroll-2025-03.csv", resp.Filename)
+	assert.Equal(t, "period,barber_id,completed_bookings,hours_worked,revenue,commission,tips,currency\n"+
+		"2025-03,barber1,2,1.50,7000,2800,1000,USD\n", string(resp.Content))
+}
+
+// Test: Admin finalizes an already finalized period (should fail)
+func TestFinalizePayrollPeriod_AlreadyFinalized(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("FinalizePayrollPeriod", mock.Anything, 2025, time.March).Return(nil, service.ErrPayrollPeriodFinalized)
+
+	// Create context with claims (admin)
+	ctx := mockAdminContext("admin1")
+
+	// Call the method
+	resp, err := server.FinalizePayrollPeriod(ctx, &pb.FinalizePayrollPeriodRequest{Year: 2025, Month: 3})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
@@ -0,0 +1,138 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// ListPromoCodes returns every promo code with how often it was used
+func (s *BookingServer) ListPromoCodes(ctx context.Context, req *pb.ListPromoCodesRequest) (*pb.PromoCodeList, error) {
+	promos, err := s.service.ListPromoCodes(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list promo codes: %v", err)
+	}
+
+	pbPromos := make([]*pb.PromoCode, len(promos))
+	for i, promo := range promos {
+		pbPromos[i] = convertPromoCodeToProto(promo)
+	}
+
+	return &pb.PromoCodeList{PromoCodes: pbPromos}, nil
+}
+
+// GetPromoCode returns a promo code with how often it was used
+func (s *BookingServer) GetPromoCode(ctx context.Context, req *pb.GetPromoCodeRequest) (*pb.PromoCode, error) {
+	promo, err := s.service.GetPromoCode(ctx, req.Code)
+	if err != nil {
+		if errors.Is(err, service.ErrPromoCodeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get promo code: %v", err)
+	}
+
+	return convertPromoCodeToProto(promo), nil
+}
+
+// CreatePromoCode adds a promo code
+func (s *BookingServer) CreatePromoCode(ctx context.Context, req *pb.CreatePromoCodeRequest) (*pb.PromoCode, error) {
+	if req.PromoCode == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "promo code is required")
+	}
+
+	created, err := s.service.CreatePromoCode(ctx, convertPromoCodeFromProto(req.PromoCode))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidPromoCode) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrPromoCodeExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create promo code: %v", err)
+	}
+
+	return convertPromoCodeToProto(created), nil
+}
+
+// UpdatePromoCode changes a promo code
+func (s *BookingServer) UpdatePromoCode(ctx context.Context, req *pb.UpdatePromoCodeRequest) (*pb.PromoCode, error) {
+	if req.PromoCode == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "promo code is required")
+	}
+
+	updated, err := s.service.UpdatePromoCode(ctx, convertPromoCodeFromProto(req.PromoCode))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidPromoCode) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrPromoCodeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update promo code: %v", err)
+	}
+
+	return convertPromoCodeToProto(updated), nil
+}
+
+// DeletePromoCode removes a promo code
+func (s *BookingServer) DeletePromoCode(ctx context.Context, req *pb.DeletePromoCodeRequest) (*pb.DeletePromoCodeResponse, error) {
+	deleted, err := s.service.DeletePromoCode(ctx, req.Code)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete promo code: %v", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "%v", service.ErrPromoCodeNotFound)
+	}
+
+	return &pb.DeletePromoCodeResponse{Success: true}, nil
+}
+
+// Helper function to convert model.PromoCode to proto PromoCode
+func convertPromoCodeToProto(promo *model.PromoCode) *pb.PromoCode {
+	result := &pb.PromoCode{
+		Code:       promo.Code,
+		PercentOff: int32(promo.PercentOff),
+		AmountOff:  promo.AmountOff,
+		MaxUses:    int32(promo.MaxUses),
+		Uses:       int32(promo.Uses),
+		Active:     promo.Active,
+	}
+	if promo.ValidFrom != nil {
+		result.ValidFrom = timestamppb.New(*promo.ValidFrom)
+	}
+	if promo.ValidUntil != nil {
+		result.ValidUntil = timestamppb.New(*promo.ValidUntil)
+	}
+	return result
+}
+
+// Helper function to convert proto PromoCode to model.PromoCode. Uses are
+// counted by the service, so they're ignored.
+func convertPromoCodeFromProto(promo *pb.PromoCode) model.PromoCode {
+	result := model.PromoCode{
+		Code:       promo.Code,
+		PercentOff: int(promo.PercentOff),
+		AmountOff:  promo.AmountOff,
+		MaxUses:    int(promo.MaxUses),
+		Active:     promo.Active,
+	}
+	if promo.ValidFrom != nil {
+		validFrom := promo.ValidFrom.AsTime()
+		result.ValidFrom = &validFrom
+	}
+	if promo.ValidUntil != nil {
+		validUntil := promo.ValidUntil.AsTime()
+		result.ValidUntil = &validUntil
+	}
+	return result
+}
@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Admin adds a promo code (should succeed)
+func TestCreatePromoCode_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	validUntil := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	promo := model.PromoCode{
+		Code:       "SPRING15",
+		PercentOff: 15,
+		ValidUntil: &validUntil,
+		MaxUses:    100,
+		Active:     true,
+	}
+
+	// Set up mock expectations
+	mockService.On("CreatePromoCode", mock.Anything, promo).Return(&promo, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "CreatePromoCode", server.CreatePromoCode)(mockAdminContext("admin1"), &pb.CreatePromoCodeRequest{
+		PromoCode: &pb.PromoCode{
+			Code:       "SPRING15",
+			PercentOff: 15,
+			ValidUntil: timestamppb.New(validUntil),
+			MaxUses:    100,
+			Active:     true,
+		},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "SPRING15", resp.Code)
+	assert.Equal(t, int32(15), resp.PercentOff)
+	assert.Nil(t, resp.ValidFrom)
+	assert.Equal(t, validUntil, resp.ValidUntil.AsTime())
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber or customer manages promo codes (should fail)
+func TestPromoCodes_NotAdmin(t *testing.T) {
+	for _, isBarber := range []bool{true, false} {
+		mockService := new(MockBookingService)
+		server := &BookingServer{service: mockService}
+
+		// Call the methods
+		_, err := withPolicy(server, "CreatePromoCode", server.CreatePromoCode)(mockContextWithClaims("user1", isBarber), &pb.CreatePromoCodeRequest{
+			PromoCode: &pb.PromoCode{Code: "SPRING15", PercentOff: 15},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = withPolicy(server, "ListPromoCodes", server.ListPromoCodes)(mockContextWithClaims("user1", isBarber), &pb.ListPromoCodesRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		mockService.AssertNotCalled(t, "CreatePromoCode", mock.Anything, mock.Anything)
+		mockService.AssertNotCalled(t, "ListPromoCodes", mock.Anything)
+	}
+}
+
+// Test: Invalid or duplicate promo codes are refused (should fail)
+func TestCreatePromoCode_Rejected(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{service.ErrInvalidPromoCode, codes.InvalidArgument},
+		{service.ErrPromoCodeExists, codes.AlreadyExists},
+	}
+
+	for _, tt := range tests {
+		mockService := new(MockBookingService)
+		server := &BookingServer{service: mockService}
+
+		// Set up mock expectations
+		mockService.On("CreatePromoCode", mock.Anything, mock.Anything).Return(nil, tt.err)
+
+		// Call the method
+		resp, err := server.CreatePromoCode(mockAdminContext("admin1"), &pb.CreatePromoCodeRequest{
+			PromoCode: &pb.PromoCode{Code: "SPRING15", PercentOff: 15},
+		})
+
+		// Assertions
+		assert.Nil(t, resp)
+		assert.Equal(t, tt.code, status.Code(err), tt.err.Error())
+	}
+}
+
+// Test: Getting or deleting an unknown promo code (should fail)
+func TestPromoCode_NotFound(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetPromoCode", mock.Anything, "SUMMER").Return(nil, service.ErrPromoCodeNotFound)
+	mockService.On("DeletePromoCode", mock.Anything, "SUMMER").Return(false, nil)
+
+	// Call the methods
+	_, err := server.GetPromoCode(mockAdminContext("admin1"), &pb.GetPromoCodeRequest{Code: "SUMMER"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = server.DeletePromoCode(mockAdminContext("admin1"), &pb.DeletePromoCodeRequest{Code: "SUMMER"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+// Test: Booking with a promo code shows the discount (should succeed)
+func TestCreateBooking_PromoCode(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	start := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+	booking := &model.Booking{
+		ID:           primitive.NewObjectID(),
+		UserID:       "user1",
+		BarberID:     "barber1",
+		StartTime:    start,
+		EndTime:      start.Add(30 * time.Minute),
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		Status:       model.BookingStatusConfirmed,
+		Price:        2125,
+		PromoCode:    "SPRING15",
+		Discount:     375,
+	}
+
+	// Set up mock expectations
+	mockService.On("CreateBooking", mock.Anything, mock.MatchedBy(func(params service.CreateBookingParams) bool {
+		return params.PromoCode == "spring15"
+	})).Return(booking, nil)
+
+	// Call the method
+	resp, err := server.CreateBooking(mockContextWithClaims("user1", false), &pb.CreateBookingRequest{
+		UserId:      "user1",
+		BarberId:    "barber1",
+		StartTimeTs: timestamppb.New(start),
+		PromoCode:   "spring15",
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "SPRING15", resp.PromoCode)
+	assert.Equal(t, int64(375), resp.Discount)
+	assert.Equal(t, int64(2125), resp.Price)
+	mockService.AssertExpectations(t)
+}
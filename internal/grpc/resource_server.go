@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// ListResources returns the shop's shared equipment
+func (s *BookingServer) ListResources(ctx context.Context, req *pb.ListResourcesRequest) (*pb.ResourceList, error) {
+	resources, err := s.service.ListResources(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list resources: %v", err)
+	}
+
+	pbResources := make([]*pb.Resource, len(resources))
+	for i, resource := range resources {
+		pbResources[i] = convertResourceToProto(resource)
+	}
+
+	return &pb.ResourceList{Resources: pbResources}, nil
+}
+
+// CreateResource adds a piece of shared equipment
+func (s *BookingServer) CreateResource(ctx context.Context, req *pb.CreateResourceRequest) (*pb.Resource, error) {
+	if req.Resource == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resource is required")
+	}
+
+	created, err := s.service.CreateResource(ctx, convertResourceFromProto(req.Resource))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidResource) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrResourceExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create resource: %v", err)
+	}
+
+	return convertResourceToProto(created), nil
+}
+
+// UpdateResource changes a piece of shared equipment
+func (s *BookingServer) UpdateResource(ctx context.Context, req *pb.UpdateResourceRequest) (*pb.Resource, error) {
+	if req.Resource == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "resource is required")
+	}
+
+	updated, err := s.service.UpdateResource(ctx, convertResourceFromProto(req.Resource))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidResource) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if errors.Is(err, service.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update resource: %v", err)
+	}
+
+	return convertResourceToProto(updated), nil
+}
+
+// DeleteResource removes a piece of shared equipment
+func (s *BookingServer) DeleteResource(ctx context.Context, req *pb.DeleteResourceRequest) (*pb.DeleteResourceResponse, error) {
+	deleted, err := s.service.DeleteResource(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete resource: %v", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "%v", service.ErrResourceNotFound)
+	}
+
+	return &pb.DeleteResourceResponse{Success: true}, nil
+}
+
+// Helper function to convert model.Resource to proto Resource
+func convertResourceToProto(resource *model.Resource) *pb.Resource {
+	serviceTypes := make([]pb.ServiceType, len(resource.ServiceTypes))
+	for i, serviceType := range resource.ServiceTypes {
+		serviceTypes[i] = pb.ServiceType(serviceType)
+	}
+
+	return &pb.Resource{
+		Id:           resource.ID,
+		Name:         resource.Name,
+		Capacity:     int32(resource.Capacity),
+		ServiceTypes: serviceTypes,
+	}
+}
+
+// Helper function to convert proto Resource to model.Resource
+func convertResourceFromProto(resource *pb.Resource) model.Resource {
+	serviceTypes := make([]model.ServiceType, len(resource.ServiceTypes))
+	for i, serviceType := range resource.ServiceTypes {
+		serviceTypes[i] = model.ServiceType(serviceType)
+	}
+
+	return model.Resource{
+		ID:           resource.Id,
+		Name:         resource.Name,
+		Capacity:     int(resource.Capacity),
+		ServiceTypes: serviceTypes,
+	}
+}
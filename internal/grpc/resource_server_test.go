@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Admin adds a wash station (should succeed)
+func TestCreateResource_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	resource := model.Resource{
+		ID:           "wash-station",
+		Name:         "Wash station",
+		Capacity:     2,
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHairWash},
+	}
+
+	// Set up mock expectations
+	mockService.On("CreateResource", mock.Anything, resource).Return(&resource, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "CreateResource", server.CreateResource)(mockAdminContext("admin1"), &pb.CreateResourceRequest{
+		Resource: &pb.Resource{
+			Id:           "wash-station",
+			Name:         "Wash station",
+			Capacity:     2,
+			ServiceTypes: []pb.ServiceType{pb.ServiceType_HAIR_WASH},
+		},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), resp.Capacity)
+	assert.Equal(t, []pb.ServiceType{pb.ServiceType_HAIR_WASH}, resp.ServiceTypes)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber changes a resource (should fail)
+func TestUpdateResource_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	_, err := withPolicy(server, "UpdateResource", server.UpdateResource)(mockContextWithClaims("barber1", true), &pb.UpdateResourceRequest{
+		Resource: &pb.Resource{Id: "wash-station", Name: "Wash station", Capacity: 3},
+	})
+
+	// Assertions
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockService.AssertNotCalled(t, "UpdateResource", mock.Anything, mock.Anything)
+}
+
+// Test: Admin removes a resource that doesn't exist (should fail)
+func TestDeleteResource_NotFound(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("DeleteResource", mock.Anything, "wash-station").Return(false, nil)
+
+	// Call the method
+	_, err := server.DeleteResource(mockAdminContext("admin1"), &pb.DeleteResourceRequest{Id: "wash-station"})
+
+	// Assertions
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Contains(t, err.Error(), service.ErrResourceNotFound.Error())
+}
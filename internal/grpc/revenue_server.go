@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// GetRevenueReport returns completed-booking revenue by period, barber and service
+func (s *BookingServer) GetRevenueReport(ctx context.Context, req *pb.GetRevenueReportRequest) (*pb.RevenueReport, error) {
+	report, err := s.revenueReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]*pb.RevenueLine, len(report.Lines))
+	for i, line := range report.Lines {
+		lines[i] = &pb.RevenueLine{
+			PeriodStart: convertCalendarDate(line.PeriodStart),
+			BarberId:    line.BarberID,
+			ServiceType: pb.ServiceType(line.ServiceType),
+			Bookings:    int32(line.Bookings),
+			Revenue:     line.Revenue,
+			Tips:        line.Tips,
+		}
+	}
+
+	return &pb.RevenueReport{
+		StartDay:    convertCalendarDate(report.Start),
+		EndDay:      convertCalendarDate(report.End.AddDate(0, 0, -1)),
+		Granularity: pb.ReportGranularity(report.Granularity),
+		Currency:    report.Currency,
+		Lines:       lines,
+		Bookings:    int32(report.Bookings),
+		Revenue:     report.Revenue,
+		Tips:        report.Tips,
+	}, nil
+}
+
+// ExportRevenueReport exports a revenue report as CSV
+func (s *BookingServer) ExportRevenueReport(ctx context.Context, req *pb.GetRevenueReportRequest) (*pb.RevenueExport, error) {
+	report, err := s.revenueReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := service.EncodeRevenueCSV(report)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode revenue report: %v", err)
+	}
+
+	return &pb.RevenueExport{
+		Filename: fmt.Sprintf("revenue-%s-%s.csv",
+			report.Start.Format("2006-01-02"), report.End.AddDate(0, 0, -1).Format("2006-01-02")),
+		ContentType: "text/csv",
+		Content:     content,
+	}, nil
+}
+
+// revenueReport gets the revenue report a request asks for
+func (s *BookingServer) revenueReport(ctx context.Context, req *pb.GetRevenueReportRequest) (*model.RevenueReport, error) {
+	// Only the calendar days matter; the service takes them in the shop's zone
+	first, ok, err := parseDateInput("", req.StartDay, "UTC")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "start day is required")
+	}
+
+	last, ok, err := parseDateInput("", req.EndDay, "UTC")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "end day is required")
+	}
+
+	report, err := s.service.GetRevenueReport(ctx, model.RevenueFilter{
+		BarberID:    req.BarberId,
+		First:       first,
+		Last:        last,
+		Granularity: model.RevenueGranularity(req.Granularity),
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidDateRange) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get revenue report: %v", err)
+	}
+
+	return report, nil
+}
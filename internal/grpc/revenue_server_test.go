@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// revenueRequest asks for June 2025 by week
+var revenueRequest = &pb.GetRevenueReportRequest{
+	StartDay:    &pb.CalendarDate{Year: 2025, Month: 6, Day: 1},
+	EndDay:      &pb.CalendarDate{Year: 2025, Month: 6, Day: 30},
+	Granularity: pb.ReportGranularity_WEEKLY,
+}
+
+// revenueFilter is what revenueRequest asks the service for
+var revenueFilter = model.RevenueFilter{
+	First:       time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+	Last:        time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+	Granularity: model.RevenueByWeek,
+}
+
+// Test: Barber asks for the revenue report (should fail)
+func TestGetRevenueReport_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "GetRevenueReport", server.GetRevenueReport)(mockContextWithClaims("barber1", true), revenueRequest)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mockService.AssertNotCalled(t, "GetRevenueReport")
+}
+
+// Test: Admin gets the revenue report (should succeed)
+func TestGetRevenueReport_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetRevenueReport", mock.Anything, revenueFilter).Return(&model.RevenueReport{
+		Start:       time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+		End:         time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+		Granularity: model.RevenueByWeek,
+		Currency:    "USD",
+		Lines: []*model.RevenueLine{
+			{PeriodStart: time.Date(2025, time.May, 26, 0, 0, 0, 0, time.UTC), BarberID: "barber1", ServiceType: model.ServiceTypeBeardTrim, Bookings: 1, Revenue: 1500},
+		},
+		Bookings: 1,
+		Revenue:  1500,
+	}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetRevenueReport", server.GetRevenueReport)(mockAdminContext("admin1"), revenueRequest)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.CalendarDate{Year: 2025, Month: 6, Day: 30}, resp.EndDay)
+	assert.Equal(t, int64(1500), resp.Revenue)
+	assert.Len(t, resp.Lines, 1)
+	assert.Equal(t, &pb.CalendarDate{Year: 2025, Month: 5, Day: 26}, resp.Lines[0].PeriodStart)
+	assert.Equal(t, pb.ServiceType_BEARD_TRIM, resp.Lines[0].ServiceType)
+}
+
+// Test: Admin exports the revenue report as CSV (should succeed)
+func TestExportRevenueReport_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetRevenueReport", mock.Anything, revenueFilter).Return(&model.RevenueReport{
+		Start:    time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+		Currency: "USD",
+		Lines: []*model.RevenueLine{
+			{PeriodStart: time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC), BarberID: "barber1", ServiceType: model.ServiceTypeHaircut, Bookings: 2, Revenue: 6000, Tips: 500},
+		},
+	}, nil)
+
+	// Call the method
+	resp, err := server.ExportRevenueReport(mockAdminContext("admin1"), revenueRequest)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "revenue-2025-06-01-2025-06-30.csv", resp.Filename)
+	assert.Equal(t, "text/csv", resp.ContentType)
+	assert.Equal(t, "period_start,barber_id,service_type,bookings,revenue,tips,currency\n"+
+		"2025-06-02,barber1,haircut,2,6000,500,USD\n", string(resp.Content))
+}
+
+// Test: Admin asks for a range ending before it starts (should fail)
+func TestGetRevenueReport_InvalidRange(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetRevenueReport", mock.Anything, mock.Anything).Return(nil, service.ErrInvalidDateRange)
+
+	// Call the method
+	resp, err := server.GetRevenueReport(mockAdminContext("admin1"), revenueRequest)
+
+	// Assertions
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,121 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// maxReviewCommentLength limits the size of free-text review comments
+const maxReviewCommentLength = 2000
+
+// SubmitReview records the customer's review of one of their completed
+// bookings
+func (s *BookingServer) SubmitReview(ctx context.Context, req *pb.SubmitReviewRequest) (*pb.Review, error) {
+	if req.BookingId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "booking id is required")
+	}
+
+	if len(req.Comment) > maxReviewCommentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "comment must be at most %d characters", maxReviewCommentLength)
+	}
+
+	review, err := s.service.SubmitReview(ctx, req.BookingId, int(req.Rating), req.Comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidReviewRating):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, service.ErrBookingNotFound):
+			return nil, status.Errorf(codes.NotFound, "booking not found")
+		case errors.Is(err, service.ErrBookingNotCompleted):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, service.ErrReviewExists):
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to submit review: %v", err)
+	}
+
+	return convertReviewToProto(review, true), nil
+}
+
+// ListBarberReviews returns a page of a barber's reviews, newest first. Only
+// barbers and admins see who wrote them.
+func (s *BookingServer) ListBarberReviews(ctx context.Context, req *pb.ListBarberReviewsRequest) (*pb.ReviewList, error) {
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	var before time.Time
+	if req.Before != nil {
+		before = req.Before.AsTime()
+	}
+
+	reviews, err := s.service.ListBarberReviews(ctx, req.BarberId, before, int(req.Limit))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reviews: %v", err)
+	}
+
+	showAuthors := auth.IsBarber(ctx) || auth.IsAdmin(ctx)
+	result := &pb.ReviewList{
+		Reviews: make([]*pb.Review, len(reviews)),
+	}
+	for i, review := range reviews {
+		result.Reviews[i] = convertReviewToProto(review, showAuthors)
+	}
+	if len(reviews) > 0 {
+		result.NextBefore = timestamppb.New(reviews[len(reviews)-1].CreatedAt)
+	}
+
+	return result, nil
+}
+
+// GetBarberRating returns the number of a barber's reviews and their average
+// rating
+func (s *BookingServer) GetBarberRating(ctx context.Context, req *pb.GetBarberRatingRequest) (*pb.BarberRating, error) {
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	rating, err := s.service.GetBarberRating(ctx, req.BarberId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get barber rating: %v", err)
+	}
+
+	return convertBarberRatingToProto(rating), nil
+}
+
+// Helper function to convert model.Review to proto Review, leaving out the
+// author unless showAuthor is set
+func convertReviewToProto(review *model.Review, showAuthor bool) *pb.Review {
+	result := &pb.Review{
+		Id:        review.ID.Hex(),
+		BookingId: review.BookingID,
+		BarberId:  review.BarberID,
+		Rating:    int32(review.Rating),
+		Comment:   review.Comment,
+		CreatedAt: timestamppb.New(review.CreatedAt),
+	}
+	if showAuthor {
+		result.UserId = review.UserID
+	}
+	return result
+}
+
+// Helper function to convert model.BarberRating to proto BarberRating
+func convertBarberRatingToProto(rating *model.BarberRating) *pb.BarberRating {
+	return &pb.BarberRating{
+		BarberId:      rating.BarberID,
+		Reviews:       int32(rating.Reviews),
+		AverageRating: rating.AverageRating,
+	}
+}
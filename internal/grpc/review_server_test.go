@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Customer reviews their own completed booking (should succeed)
+func TestSubmitReview_Owner(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	booking := &model.Booking{UserID: "user1", BarberID: "barber1", Status: model.BookingStatusCompleted}
+	review := &model.Review{
+		ID:        primitive.NewObjectID(),
+		BookingID: "booking1",
+		UserID:    "user1",
+		BarberID:  "barber1",
+		Rating:    5,
+		Comment:   "Great fade",
+		CreatedAt: time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+	}
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, "booking1").Return(booking, nil)
+	mockService.On("SubmitReview", mock.Anything, "booking1", 5, "Great fade").Return(review, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "SubmitReview", server.SubmitReview)(mockContextWithClaims("user1", false), &pb.SubmitReviewRequest{
+		BookingId: "booking1",
+		Rating:    5,
+		Comment:   "Great fade",
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, review.ID.Hex(), resp.Id)
+	assert.Equal(t, "barber1", resp.BarberId)
+	assert.Equal(t, int32(5), resp.Rating)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Customer reviews someone else's booking (should fail)
+func TestSubmitReview_NotOwner(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	booking := &model.Booking{UserID: "user2", BarberID: "barber1", Status: model.BookingStatusCompleted}
+
+	// Set up mock expectations
+	mockService.On("GetBooking", mock.Anything, "booking1").Return(booking, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "SubmitReview", server.SubmitReview)(mockContextWithClaims("user1", false), &pb.SubmitReviewRequest{
+		BookingId: "booking1",
+		Rating:    1,
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	mockService.AssertNotCalled(t, "SubmitReview", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test: Reviewing a booking twice or before it's completed (should fail)
+func TestSubmitReview_Rejected(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{service.ErrReviewExists, codes.AlreadyExists},
+		{service.ErrBookingNotCompleted, codes.FailedPrecondition},
+		{service.ErrInvalidReviewRating, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		mockService := new(MockBookingService)
+		server := &BookingServer{service: mockService}
+
+		// Set up mock expectations
+		mockService.On("SubmitReview", mock.Anything, "booking1", 4, "").Return(nil, tt.err)
+
+		// Call the method
+		resp, err := server.SubmitReview(mockContextWithClaims("user1", false), &pb.SubmitReviewRequest{
+			BookingId: "booking1",
+			Rating:    4,
+		})
+
+		// Assertions
+		assert.Nil(t, resp)
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, tt.code, st.Code(), tt.err.Error())
+	}
+}
+
+// Test: Customers see reviews without their authors, barbers see them all
+func TestListBarberReviews_HidesAuthors(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	oldest := time.Date(2025, 3, 9, 12, 0, 0, 0, time.UTC)
+	reviews := []*model.Review{
+		{ID: primitive.NewObjectID(), BookingID: "booking2", UserID: "user2", BarberID: "barber1", Rating: 4, CreatedAt: oldest.Add(24 * time.Hour)},
+		{ID: primitive.NewObjectID(), BookingID: "booking1", UserID: "user1", BarberID: "barber1", Rating: 5, CreatedAt: oldest},
+	}
+
+	// Set up mock expectations
+	mockService.On("ListBarberReviews", mock.Anything, "barber1", time.Time{}, 2).Return(reviews, nil)
+
+	req := &pb.ListBarberReviewsRequest{BarberId: "barber1", Limit: 2}
+
+	// Call the method as a customer
+	resp, err := withPolicy(server, "ListBarberReviews", server.ListBarberReviews)(mockContextWithClaims("user3", false), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Len(t, resp.Reviews, 2)
+	assert.Empty(t, resp.Reviews[0].UserId)
+	assert.Equal(t, oldest, resp.NextBefore.AsTime())
+
+	// Call the method as a barber
+	resp, err = withPolicy(server, "ListBarberReviews", server.ListBarberReviews)(mockContextWithClaims("barber1", true), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "user2", resp.Reviews[0].UserId)
+}
+
+// Test: Signed-in user gets a barber's rating (should succeed)
+func TestGetBarberRating(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("GetBarberRating", mock.Anything, "barber1").Return(&model.BarberRating{
+		BarberID:      "barber1",
+		Reviews:       3,
+		AverageRating: 4.5,
+	}, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBarberRating", server.GetBarberRating)(mockContextWithClaims("user1", false), &pb.GetBarberRatingRequest{
+		BarberId: "barber1",
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Reviews)
+	assert.Equal(t, 4.5, resp.AverageRating)
+}
@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// GetWorkingHours returns a barber's weekly working hours
+func (s *BookingServer) GetWorkingHours(ctx context.Context, req *pb.GetWorkingHoursRequest) (*pb.BarberSchedule, error) {
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	schedule, err := s.service.GetWorkingHours(ctx, req.BarberId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get working hours: %v", err)
+	}
+
+	return convertScheduleToProto(schedule), nil
+}
+
+// SetWorkingHours replaces a barber's weekly working hours. Barbers manage
+// their own hours; admins can manage anyone's.
+func (s *BookingServer) SetWorkingHours(ctx context.Context, req *pb.SetWorkingHoursRequest) (*pb.BarberSchedule, error) {
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	barberID := req.BarberId
+	if barberID == "" && auth.IsBarber(ctx) {
+		barberID = userID
+	}
+	if barberID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber id is required")
+	}
+
+	isOwnSchedule := auth.IsBarber(ctx) && barberID == userID
+	if !isOwnSchedule && !auth.IsAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the barber or an admin can set working hours")
+	}
+
+	hours := make([]model.WorkingHours, len(req.Hours))
+	for i, h := range req.Hours {
+		start, err := parseTimeOfDay(h.Start)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start for %s: %v", h.Weekday, err)
+		}
+		end, err := parseTimeOfDay(h.End)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end for %s: %v", h.Weekday, err)
+		}
+
+		hours[i] = model.WorkingHours{
+			Weekday:     time.Weekday(h.Weekday),
+			StartMinute: start,
+			EndMinute:   end,
+		}
+	}
+
+	breaks := make([]model.BreakPeriod, len(req.Breaks))
+	for i, b := range req.Breaks {
+		start, err := parseTimeOfDay(b.Start)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid break start: %v", err)
+		}
+		end, err := parseTimeOfDay(b.End)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid break end: %v", err)
+		}
+
+		breaks[i] = model.BreakPeriod{StartMinute: start, EndMinute: end}
+	}
+
+	schedule, err := s.service.SetWorkingHours(ctx, model.BarberSchedule{
+		BarberID:       barberID,
+		Hours:          hours,
+		Breaks:         breaks,
+		Timezone:       req.Timezone,
+		SlotMinutes:    int(req.SlotMinutes),
+		MinLeadMinutes: int(req.MinLeadMinutes),
+		MaxAdvanceDays: int(req.MaxAdvanceDays),
+		UpdatedBy:      userID,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidWorkingHours) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to set working hours: %v", err)
+	}
+
+	return convertScheduleToProto(schedule), nil
+}
+
+// parseTimeOfDay converts "HH:MM" to minutes after midnight, allowing "24:00"
+// for the end of the day
+func parseTimeOfDay(value string) (int, error) {
+	if value == "24:00" {
+		return 24 * 60, nil
+	}
+
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a time of day in HH:MM format", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// formatTimeOfDay converts minutes after midnight to "HH:MM"
+func formatTimeOfDay(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// Helper function to convert model.BarberSchedule to proto BarberSchedule
+func convertScheduleToProto(schedule *model.BarberSchedule) *pb.BarberSchedule {
+	hours := make([]*pb.WorkingHours, len(schedule.Hours))
+	for i, h := range schedule.Hours {
+		hours[i] = &pb.WorkingHours{
+			Weekday: pb.Weekday(h.Weekday),
+			Start:   formatTimeOfDay(h.StartMinute),
+			End:     formatTimeOfDay(h.EndMinute),
+		}
+	}
+
+	breaks := make([]*pb.BreakPeriod, len(schedule.Breaks))
+	for i, b := range schedule.Breaks {
+		breaks[i] = &pb.BreakPeriod{
+			Start: formatTimeOfDay(b.StartMinute),
+			End:   formatTimeOfDay(b.EndMinute),
+		}
+	}
+
+	return &pb.BarberSchedule{
+		BarberId:       schedule.BarberID,
+		Hours:          hours,
+		Breaks:         breaks,
+		Timezone:       schedule.Timezone,
+		SlotMinutes:    int32(schedule.SlotDuration() / time.Minute),
+		MinLeadMinutes: int32(schedule.MinLeadMinutes),
+		MaxAdvanceDays: int32(schedule.MaxAdvanceDays),
+	}
+}
+
+// barberTimezone returns the time zone to read a barber's dates in: the one
+// requested, or else the barber's own
+func (s *BookingServer) barberTimezone(ctx context.Context, barberID, requested string) (string, error) {
+	if requested != "" {
+		return requested, nil
+	}
+
+	schedule, err := s.service.GetWorkingHours(ctx, barberID)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get barber time zone: %v", err)
+	}
+
+	return schedule.Timezone, nil
+}
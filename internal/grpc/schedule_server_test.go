@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Barber sets their own working hours (should succeed)
+func TestSetWorkingHours_OwnSchedule(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	hours := []model.WorkingHours{
+		{Weekday: time.Monday, StartMinute: 9 * 60, EndMinute: 12 * 60},
+		{Weekday: time.Monday, StartMinute: 13 * 60, EndMinute: 24 * 60},
+	}
+
+	// Set up mock expectations
+	mockService.On("SetWorkingHours", mock.Anything, model.BarberSchedule{BarberID: "barber1", Hours: hours, Breaks: []model.BreakPeriod{}, UpdatedBy: "barber1"}).
+		Return(&model.BarberSchedule{BarberID: "barber1", Hours: hours}, nil)
+
+	// Call the method
+	resp, err := server.SetWorkingHours(mockContextWithClaims("barber1", true), &pb.SetWorkingHoursRequest{
+		Hours: []*pb.WorkingHours{
+			{Weekday: pb.Weekday_MONDAY, Start: "09:00", End: "12:00"},
+			{Weekday: pb.Weekday_MONDAY, Start: "13:00", End: "24:00"},
+		},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, "barber1", resp.BarberId)
+	assert.Len(t, resp.Hours, 2)
+	assert.Equal(t, "13:00", resp.Hours[1].Start)
+	assert.Equal(t, "24:00", resp.Hours[1].End)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Barber sets another barber's working hours (should fail)
+func TestSetWorkingHours_OtherBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := server.SetWorkingHours(mockContextWithClaims("barber1", true), &pb.SetWorkingHoursRequest{
+		BarberId: "barber2",
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "SetWorkingHours")
+}
+
+// Test: Admin sets hours with a malformed time (should fail)
+func TestSetWorkingHours_InvalidTime(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := server.SetWorkingHours(mockAdminContext("admin1"), &pb.SetWorkingHoursRequest{
+		BarberId: "barber1",
+		Hours:    []*pb.WorkingHours{{Weekday: pb.Weekday_TUESDAY, Start: "9am", End: "17:00"}},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	mockService.AssertNotCalled(t, "SetWorkingHours")
+}
+
+// Test: Admin sets overlapping shifts (should fail)
+func TestSetWorkingHours_Overlapping(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("SetWorkingHours", mock.Anything, mock.Anything).
+		Return(nil, service.ErrInvalidWorkingHours)
+
+	// Call the method
+	resp, err := server.SetWorkingHours(mockAdminContext("admin1"), &pb.SetWorkingHoursRequest{
+		BarberId: "barber1",
+		Hours: []*pb.WorkingHours{
+			{Weekday: pb.Weekday_FRIDAY, Start: "09:00", End: "13:00"},
+			{Weekday: pb.Weekday_FRIDAY, Start: "12:00", End: "17:00"},
+		},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// Test: Barber sets a lunch break in their time zone (should succeed)
+func TestSetWorkingHours_WithBreak(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	hours := []model.WorkingHours{{Weekday: time.Monday, StartMinute: 9 * 60, EndMinute: 17 * 60}}
+	breaks := []model.BreakPeriod{{StartMinute: 12 * 60, EndMinute: 13 * 60}}
+
+	// Set up mock expectations
+	mockService.On("SetWorkingHours", mock.Anything, model.BarberSchedule{BarberID: "barber1", Hours: hours, Breaks: breaks, Timezone: "Europe/Rome", UpdatedBy: "barber1"}).
+		Return(&model.BarberSchedule{BarberID: "barber1", Hours: hours, Breaks: breaks, Timezone: "Europe/Rome"}, nil)
+
+	// Call the method
+	resp, err := server.SetWorkingHours(mockContextWithClaims("barber1", true), &pb.SetWorkingHoursRequest{
+		Hours:    []*pb.WorkingHours{{Weekday: pb.Weekday_MONDAY, Start: "09:00", End: "17:00"}},
+		Breaks:   []*pb.BreakPeriod{{Start: "12:00", End: "13:00"}},
+		Timezone: "Europe/Rome",
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Len(t, resp.Breaks, 1)
+	assert.Equal(t, "12:00", resp.Breaks[0].Start)
+	assert.Equal(t, "13:00", resp.Breaks[0].End)
+	assert.Equal(t, "Europe/Rome", resp.Timezone)
+	mockService.AssertExpectations(t)
+}
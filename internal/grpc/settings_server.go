@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// GetRetentionPolicy returns the shop's data retention policy
+func (s *BookingServer) GetRetentionPolicy(ctx context.Context, req *pb.GetRetentionPolicyRequest) (*pb.RetentionPolicy, error) {
+	policy, err := s.service.GetRetentionPolicy(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get retention policy: %v", err)
+	}
+
+	return convertRetentionPolicyToProto(policy), nil
+}
+
+// UpdateRetentionPolicy replaces the shop's data retention policy
+func (s *BookingServer) UpdateRetentionPolicy(ctx context.Context, req *pb.UpdateRetentionPolicyRequest) (*pb.RetentionPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "policy is required")
+	}
+
+	userID, _ := auth.GetUserIDFromContext(ctx)
+
+	policy := model.RetentionPolicy{
+		CompletedDays: int(req.Policy.CompletedDays),
+		CancelledDays: int(req.Policy.CancelledDays),
+		NoShowDays:    int(req.Policy.NoShowDays),
+		AuditDays:     int(req.Policy.AuditDays),
+		Mode:          model.RetentionMode(req.Policy.Mode),
+	}
+
+	updated, err := s.service.UpdateRetentionPolicy(ctx, policy, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRetentionPolicy) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update retention policy: %v", err)
+	}
+
+	return convertRetentionPolicyToProto(updated), nil
+}
+
+// GetCancellationPolicy returns the shop's cancellation policy, so customers
+// can see what cancelling will cost
+func (s *BookingServer) GetCancellationPolicy(ctx context.Context, req *pb.GetCancellationPolicyRequest) (*pb.CancellationPolicy, error) {
+	policy, err := s.service.GetCancellationPolicy(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get cancellation policy: %v", err)
+	}
+
+	return convertCancellationPolicyToProto(policy), nil
+}
+
+// UpdateCancellationPolicy replaces the shop's cancellation policy
+func (s *BookingServer) UpdateCancellationPolicy(ctx context.Context, req *pb.UpdateCancellationPolicyRequest) (*pb.CancellationPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "policy is required")
+	}
+
+	userID, _ := auth.GetUserIDFromContext(ctx)
+
+	rules := make([]model.CancellationRule, len(req.Policy.Rules))
+	for i, rule := range req.Policy.Rules {
+		rules[i] = model.CancellationRule{
+			WithinMinutes: int(rule.WithinMinutes),
+			FeePercent:    int(rule.FeePercent),
+			Forbidden:     rule.Forbidden,
+		}
+	}
+
+	updated, err := s.service.UpdateCancellationPolicy(ctx, model.CancellationPolicy{Rules: rules}, userID)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCancellationPolicy) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to update cancellation policy: %v", err)
+	}
+
+	return convertCancellationPolicyToProto(updated), nil
+}
+
+// Helper function to convert model.RetentionPolicy to proto RetentionPolicy
+func convertRetentionPolicyToProto(policy *model.RetentionPolicy) *pb.RetentionPolicy {
+	return &pb.RetentionPolicy{
+		CompletedDays: int32(policy.CompletedDays),
+		CancelledDays: int32(policy.CancelledDays),
+		NoShowDays:    int32(policy.NoShowDays),
+		AuditDays:     int32(policy.AuditDays),
+		Mode:          pb.RetentionMode(policy.Mode),
+	}
+}
+
+// Helper function to convert model.CancellationPolicy to proto CancellationPolicy
+func convertCancellationPolicyToProto(policy *model.CancellationPolicy) *pb.CancellationPolicy {
+	rules := make([]*pb.CancellationRule, len(policy.Rules))
+	for i, rule := range policy.Rules {
+		rules[i] = &pb.CancellationRule{
+			WithinMinutes: int32(rule.WithinMinutes),
+			FeePercent:    int32(rule.FeePercent),
+			Forbidden:     rule.Forbidden,
+		}
+	}
+
+	return &pb.CancellationPolicy{Rules: rules}
+}
@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Barber tries to update the retention policy (should fail)
+func TestUpdateRetentionPolicy_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Create context with claims (barber)
+	ctx := mockContextWithClaims("barber1", true)
+
+	// Call the method
+	resp, err := withPolicy(server, "UpdateRetentionPolicy", server.UpdateRetentionPolicy)(ctx, &pb.UpdateRetentionPolicyRequest{
+		Policy: &pb.RetentionPolicy{CompletedDays: 30},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "UpdateRetentionPolicy")
+}
+
+// Test: Admin updates the retention policy (should succeed)
+func TestUpdateRetentionPolicy_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	policy := model.RetentionPolicy{
+		CompletedDays: 365,
+		CancelledDays: 30,
+		NoShowDays:    90,
+		Mode:          model.RetentionModeDelete,
+	}
+
+	// Set up mock expectations
+	mockService.On("UpdateRetentionPolicy", mock.Anything, policy, "admin1").Return(&policy, nil)
+
+	// Create context with claims (admin)
+	ctx := mockAdminContext("admin1")
+
+	// Call the method
+	resp, err := server.UpdateRetentionPolicy(ctx, &pb.UpdateRetentionPolicyRequest{
+		Policy: &pb.RetentionPolicy{
+			CompletedDays: 365,
+			CancelledDays: 30,
+			NoShowDays:    90,
+			Mode:          pb.RetentionMode_DELETE,
+		},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, int32(365), resp.CompletedDays)
+	assert.Equal(t, pb.RetentionMode_DELETE, resp.Mode)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Admin sets a negative retention period (should fail)
+func TestUpdateRetentionPolicy_Invalid(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("UpdateRetentionPolicy", mock.Anything, mock.Anything, "admin1").Return(nil, service.ErrInvalidRetentionPolicy)
+
+	// Create context with claims (admin)
+	ctx := mockAdminContext("admin1")
+
+	// Call the method
+	resp, err := server.UpdateRetentionPolicy(ctx, &pb.UpdateRetentionPolicyRequest{
+		Policy: &pb.RetentionPolicy{CompletedDays: -1},
+	})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
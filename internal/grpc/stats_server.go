@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// GetBarberStats returns a barber's booking statistics for a week or month
+func (s *BookingServer) GetBarberStats(ctx context.Context, req *pb.GetBarberStatsRequest) (*pb.BarberStats, error) {
+	if req.BarberId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "barber_id is required")
+	}
+
+	// Only the calendar day matters; the service takes it in the barber's zone
+	day, _, err := parseDateInput("", req.Day, "UTC")
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.service.GetBarberStats(ctx, req.BarberId, model.StatsPeriod(req.Period), day)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get barber stats: %v", err)
+	}
+
+	var statusCounts []*pb.StatusCount
+	for st := model.BookingStatusPending; st <= model.BookingStatusHeld; st++ {
+		if count := stats.StatusCounts[st]; count > 0 {
+			statusCounts = append(statusCounts, &pb.StatusCount{Status: pb.BookingStatus(st), Count: int32(count)})
+		}
+	}
+
+	busiest := stats.BusiestDays(model.BusiestDayCount)
+	busiestDays := make([]*pb.DayStats, len(busiest))
+	for i, d := range busiest {
+		busiestDays[i] = &pb.DayStats{
+			Day:         convertCalendarDate(d.Date),
+			Bookings:    int32(d.Bookings),
+			BookedHours: d.BookedTime.Hours(),
+		}
+	}
+
+	return &pb.BarberStats{
+		BarberId:     stats.BarberID,
+		StartDay:     convertCalendarDate(stats.Start),
+		EndDay:       convertCalendarDate(stats.End.AddDate(0, 0, -1)),
+		StatusCounts: statusCounts,
+		Bookings:     int32(stats.Bookings),
+		BookedHours:  stats.BookedTime.Hours(),
+		WorkingHours: stats.WorkingTime.Hours(),
+		Utilization:  stats.Utilization(),
+		BusiestDays:  busiestDays,
+	}, nil
+}
+
+// GetDemandHeatmap returns past bookings by the weekday and hour they started at
+func (s *BookingServer) GetDemandHeatmap(ctx context.Context, req *pb.GetDemandHeatmapRequest) (*pb.DemandHeatmap, error) {
+	heatmap, err := s.service.GetDemandHeatmap(ctx, req.BarberId, int(req.Weeks))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidHeatmapWeeks) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to get demand heatmap: %v", err)
+	}
+
+	cells := make([]*pb.HeatmapCell, 0, 7*24)
+	for weekday, hours := range heatmap.Counts {
+		for hour, count := range hours {
+			cells = append(cells, &pb.HeatmapCell{
+				Weekday:       pb.Weekday(weekday),
+				Hour:          int32(hour),
+				Bookings:      int32(count),
+				WeeklyAverage: float64(count) / float64(heatmap.Weeks),
+			})
+		}
+	}
+
+	return &pb.DemandHeatmap{
+		BarberId:    heatmap.BarberID,
+		StartDay:    convertCalendarDate(heatmap.Start),
+		EndDay:      convertCalendarDate(heatmap.End.AddDate(0, 0, -1)),
+		Weeks:       int32(heatmap.Weeks),
+		Timezone:    heatmap.Timezone,
+		Cells:       cells,
+		GeneratedAt: timestamppb.New(heatmap.GeneratedAt),
+	}, nil
+}
+
+// convertCalendarDate converts the calendar day of t, in its own location
+func convertCalendarDate(t time.Time) *pb.CalendarDate {
+	return &pb.CalendarDate{
+		Year:  int32(t.Year()),
+		Month: int32(t.Month()),
+		Day:   int32(t.Day()),
+	}
+}
@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Regular user asks for a barber's stats (should fail)
+func TestGetBarberStats_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBarberStats", server.GetBarberStats)(mockContextWithClaims("user1", false), &pb.GetBarberStatsRequest{BarberId: "barber1"})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mockService.AssertNotCalled(t, "GetBarberStats")
+}
+
+// Test: Barber gets their monthly stats (should succeed)
+func TestGetBarberStats_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	day := func(d int) time.Time { return time.Date(2025, time.June, d, 0, 0, 0, 0, time.UTC) }
+
+	// Set up mock expectations
+	mockService.On("GetBarberStats", mock.Anything, "barber1", model.StatsPeriodMonth, day(14)).Return(&model.BarberStats{
+		BarberID: "barber1",
+		Start:    day(1),
+		End:      time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+		StatusCounts: map[model.BookingStatus]int{
+			model.BookingStatusCompleted: 5,
+			model.BookingStatusCancelled: 1,
+		},
+		Bookings:    6,
+		BookedTime:  5 * time.Hour,
+		WorkingTime: 20 * time.Hour,
+		Days: []*model.DayStats{
+			{Date: day(3), Bookings: 2, BookedTime: time.Hour},
+			{Date: day(10), Bookings: 3, BookedTime: 4 * time.Hour},
+		},
+	}, nil)
+
+	// Call the method
+	resp, err := server.GetBarberStats(mockContextWithClaims("barber1", true), &pb.GetBarberStatsRequest{
+		BarberId: "barber1",
+		Period:   pb.StatsPeriod_MONTH,
+		Day:      &pb.CalendarDate{Year: 2025, Month: 6, Day: 14},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.CalendarDate{Year: 2025, Month: 6, Day: 30}, resp.EndDay)
+	assert.Equal(t, []*pb.StatusCount{
+		{Status: pb.BookingStatus_CANCELLED, Count: 1},
+		{Status: pb.BookingStatus_COMPLETED, Count: 5},
+	}, resp.StatusCounts)
+	assert.InDelta(t, 25, resp.Utilization, 1e-9)
+	assert.Len(t, resp.BusiestDays, 2)
+	assert.Equal(t, int32(10), resp.BusiestDays[0].Day.Day)
+	assert.InDelta(t, 4, resp.BusiestDays[0].BookedHours, 1e-9)
+}
+
+// Test: Barber gets the shop's demand heatmap (should succeed)
+func TestGetDemandHeatmap_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	heatmap := &model.DemandHeatmap{
+		Start:    time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC),
+		Weeks:    12,
+		Timezone: "UTC",
+	}
+	heatmap.Counts[time.Saturday][10] = 18
+
+	// Set up mock expectations
+	mockService.On("GetDemandHeatmap", mock.Anything, "", 0).Return(heatmap, nil)
+
+	// Call the method
+	resp, err := withPolicy(server, "GetDemandHeatmap", server.GetDemandHeatmap)(mockContextWithClaims("barber1", true), &pb.GetDemandHeatmapRequest{})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.CalendarDate{Year: 2025, Month: 6, Day: 1}, resp.EndDay)
+	assert.Len(t, resp.Cells, 7*24)
+	cell := resp.Cells[int(time.Saturday)*24+10]
+	assert.Equal(t, pb.Weekday_SATURDAY, cell.Weekday)
+	assert.Equal(t, int32(10), cell.Hour)
+	assert.Equal(t, int32(18), cell.Bookings)
+	assert.InDelta(t, 1.5, cell.WeeklyAverage, 1e-9)
+}
+
+// Test: Customer asks for the demand heatmap (should fail)
+func TestGetDemandHeatmap_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "GetDemandHeatmap", server.GetDemandHeatmap)(mockContextWithClaims("user1", false), &pb.GetDemandHeatmapRequest{})
+
+	// Assertions
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	mockService.AssertNotCalled(t, "GetDemandHeatmap")
+}
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// maxSurveyCommentLength limits the size of free-text survey comments
+const maxSurveyCommentLength = 2000
+
+// SubmitSurveyResponse records a survey response. The method is public; the
+// token from the survey link authenticates the caller.
+func (s *BookingServer) SubmitSurveyResponse(ctx context.Context, req *pb.SubmitSurveyResponseRequest) (*pb.SubmitSurveyResponseResponse, error) {
+	if req.BookingId == "" || req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "booking id and token are required")
+	}
+
+	if len(req.Comment) > maxSurveyCommentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "comment must be at most %d characters", maxSurveyCommentLength)
+	}
+
+	err := s.service.SubmitSurveyResponse(ctx, req.BookingId, req.Token, int(req.Score), req.Comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidSurveyScore):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, service.ErrSurveyNotFound):
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to submit survey response: %v", err)
+	}
+
+	return &pb.SubmitSurveyResponseResponse{
+		Success: true,
+		Message: "Thank you for your feedback",
+	}, nil
+}
+
+// GetBarberSurveyScores returns aggregate survey scores for a barber
+func (s *BookingServer) GetBarberSurveyScores(ctx context.Context, req *pb.GetBarberSurveyScoresRequest) (*pb.SurveyScores, error) {
+	// Get authentication info
+	_, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var start, end *time.Time
+	if req.StartDate != "" {
+		t, err := time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid start date format: %v", err)
+		}
+		start = &t
+	}
+	if req.EndDate != "" {
+		t, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end date format: %v", err)
+		}
+		end = &t
+	}
+
+	scores, err := s.service.GetBarberSurveyScores(ctx, req.BarberId, start, end)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get survey scores: %v", err)
+	}
+
+	scoreCounts := make([]int32, len(scores.ScoreCounts))
+	for i, count := range scores.ScoreCounts {
+		scoreCounts[i] = int32(count)
+	}
+
+	return &pb.SurveyScores{
+		BarberId:     scores.BarberID,
+		Responses:    int32(scores.Responses),
+		AverageScore: scores.AverageScore,
+		ScoreCounts:  scoreCounts,
+	}, nil
+}
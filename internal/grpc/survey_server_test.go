@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Survey response without a JWT is accepted with a valid token
+func TestSubmitSurveyResponse_Unauthenticated(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("SubmitSurveyResponse", mock.Anything, "booking1", "token1", 5, "Great fade").Return(nil)
+
+	req := &pb.SubmitSurveyResponseRequest{
+		BookingId: "booking1",
+		Token:     "token1",
+		Score:     5,
+		Comment:   "Great fade",
+	}
+
+	// Call the method without claims
+	resp, err := server.SubmitSurveyResponse(context.Background(), req)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+// Test: Survey response with an unknown token (should fail)
+func TestSubmitSurveyResponse_UnknownToken(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("SubmitSurveyResponse", mock.Anything, "booking1", "wrong", 4, "").Return(service.ErrSurveyNotFound)
+
+	req := &pb.SubmitSurveyResponseRequest{
+		BookingId: "booking1",
+		Token:     "wrong",
+		Score:     4,
+	}
+
+	// Call the method
+	resp, err := server.SubmitSurveyResponse(context.Background(), req)
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+// Test: Barber views survey scores (should succeed)
+func TestGetBarberSurveyScores_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	scores := &model.SurveyScores{
+		BarberID:     "barber1",
+		Responses:    3,
+		AverageScore: 4.33,
+		ScoreCounts:  [5]int{0, 0, 0, 2, 1},
+	}
+
+	// Set up mock expectations
+	mockService.On("GetBarberSurveyScores", mock.Anything, "barber1", mock.Anything, mock.Anything).Return(scores, nil)
+
+	// Call the method
+	resp, err := server.GetBarberSurveyScores(mockContextWithClaims("barber1", true), &pb.GetBarberSurveyScoresRequest{BarberId: "barber1"})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Responses)
+	assert.Equal(t, []int32{0, 0, 0, 2, 1}, resp.ScoreCounts)
+}
+
+// Test: Regular user tries to view survey scores (should fail)
+func TestGetBarberSurveyScores_RegularUser(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "GetBarberSurveyScores", server.GetBarberSurveyScores)(mockContextWithClaims("user1", false), &pb.GetBarberSurveyScoresRequest{BarberId: "barber1"})
+
+	// Assertions
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
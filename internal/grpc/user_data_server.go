@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// ExportUserData returns everything stored about a customer as a JSON file
+func (s *BookingServer) ExportUserData(ctx context.Context, req *pb.ExportUserDataRequest) (*pb.UserDataExport, error) {
+	export, err := s.service.ExportUserData(ctx, req.UserId)
+	if err != nil {
+		if errors.Is(err, service.ErrUserIDRequired) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to export user data: %v", err)
+	}
+
+	content, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode user data: %v", err)
+	}
+
+	return &pb.UserDataExport{
+		Filename:    fmt.Sprintf("user-data-%s.json", export.ExportedAt.UTC().Format("20060102T150405Z")),
+		ContentType: "application/json",
+		Content:     content,
+	}, nil
+}
+
+// EraseUserData anonymizes or deletes a customer's personal data
+func (s *BookingServer) EraseUserData(ctx context.Context, req *pb.EraseUserDataRequest) (*pb.EraseUserDataResponse, error) {
+	result, err := s.service.EraseUserData(ctx, req.UserId, model.RetentionMode(req.Mode))
+	if err != nil {
+		if errors.Is(err, service.ErrUserIDRequired) || errors.Is(err, service.ErrInvalidErasureMode) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to erase user data: %v", err)
+	}
+
+	return &pb.EraseUserDataResponse{
+		BookingsAnonymized: result.Anonymized,
+		BookingsDeleted:    result.Deleted,
+		SurveysErased:      result.Surveys,
+		AuditEntriesErased: result.AuditEntries,
+		ReviewsErased:      result.Reviews,
+		FavoritesErased:    result.Favorites,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Barber tries to export a customer's data (should fail)
+func TestExportUserData_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	resp, err := withPolicy(server, "ExportUserData", server.ExportUserData)(mockContextWithClaims("barber1", true), &pb.ExportUserDataRequest{UserId: "user1"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockService.AssertNotCalled(t, "ExportUserData")
+}
+
+// Test: Admin exports a customer's data as JSON (should succeed)
+func TestExportUserData_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	export := &model.UserDataExport{
+		UserID:     "user1",
+		ExportedAt: time.Date(2025, time.June, 1, 9, 30, 0, 0, time.UTC),
+		Bookings:   []*model.Booking{{ID: primitive.NewObjectID(), UserID: "user1", Notes: "skin fade"}},
+	}
+	mockService.On("ExportUserData", mock.Anything, "user1").Return(export, nil)
+
+	resp, err := withPolicy(server, "ExportUserData", server.ExportUserData)(mockAdminContext("admin1"), &pb.ExportUserDataRequest{UserId: "user1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "user-data-20250601T093000Z.json", resp.Filename)
+	assert.Equal(t, "application/json", resp.ContentType)
+
+	var decoded model.UserDataExport
+	require.NoError(t, json.Unmarshal(resp.Content, &decoded))
+	assert.Equal(t, "user1", decoded.UserID)
+	require.Len(t, decoded.Bookings, 1)
+	assert.Equal(t, "skin fade", decoded.Bookings[0].Notes)
+}
+
+// Test: Admin erases a customer's data (should succeed)
+func TestEraseUserData_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	result := &service.ErasureResult{Anonymized: 3, Deleted: 1, Surveys: 2, AuditEntries: 5}
+	mockService.On("EraseUserData", mock.Anything, "user1", model.RetentionModeAnonymize).Return(result, nil)
+
+	resp, err := withPolicy(server, "EraseUserData", server.EraseUserData)(mockAdminContext("admin1"), &pb.EraseUserDataRequest{UserId: "user1", Mode: pb.RetentionMode_ANONYMIZE})
+	require.NoError(t, err)
+
+	assert.Equal(t, &pb.EraseUserDataResponse{BookingsAnonymized: 3, BookingsDeleted: 1, SurveysErased: 2, AuditEntriesErased: 5}, resp)
+}
+
+// Test: Customer tries to erase their own data (should fail)
+func TestEraseUserData_Customer(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	resp, err := withPolicy(server, "EraseUserData", server.EraseUserData)(mockContextWithClaims("user1", false), &pb.EraseUserDataRequest{UserId: "user1"})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockService.AssertNotCalled(t, "EraseUserData")
+}
@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// WatchBookings streams changes to a user's or a barber's bookings until the
+// client disconnects
+func (s *BookingServer) WatchBookings(req *pb.WatchBookingsRequest, stream grpc.ServerStreamingServer[pb.BookingEvent]) error {
+	ctx := stream.Context()
+
+	// Get authentication info
+	userID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if (req.UserId == "") == (req.BarberId == "") {
+		return status.Errorf(codes.InvalidArgument, "exactly one of user id or barber id is required")
+	}
+
+	// Authorization check:
+	// Regular users can only watch their own bookings, barber schedules are staff only
+	isStaff := auth.IsBarber(ctx) || auth.IsAdmin(ctx)
+	if req.BarberId != "" && !isStaff {
+		return status.Errorf(codes.PermissionDenied, "only barbers can watch barber schedules")
+	}
+	if req.UserId != "" && !isStaff && req.UserId != userID {
+		return status.Errorf(codes.PermissionDenied, "regular users can only watch their own bookings")
+	}
+
+	events := s.service.WatchBookings(ctx, req.UserId, req.BarberId)
+	for event := range events {
+		err := stream.Send(&pb.BookingEvent{
+			Type:    pb.BookingEventType(event.Type),
+			Booking: s.convertBookingToProto(event.Booking),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// mockEventStream collects the events sent on a WatchBookings stream
+type mockEventStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pb.BookingEvent
+}
+
+func (s *mockEventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockEventStream) Send(event *pb.BookingEvent) error {
+	s.sent = append(s.sent, event)
+	return nil
+}
+
+// Test: Regular user watches a barber's schedule (should fail)
+func TestWatchBookings_RegularUserForBarber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	stream := &mockEventStream{ctx: mockContextWithClaims("user1", false)}
+
+	// Call the method
+	err := server.WatchBookings(&pb.WatchBookingsRequest{BarberId: "barber1"}, stream)
+
+	// Assertions
+	assert.Error(t, err)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+
+	mockService.AssertNotCalled(t, "WatchBookings")
+}
+
+// Test: Request without a user or barber (should fail)
+func TestWatchBookings_MissingTarget(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	stream := &mockEventStream{ctx: mockContextWithClaims("barber1", true)}
+
+	// Call the method
+	err := server.WatchBookings(&pb.WatchBookingsRequest{}, stream)
+
+	// Assertions
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+// Test: User watches their own bookings (should stream events until closed)
+func TestWatchBookings_StreamsEvents(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	events := make(chan service.BookingEvent, 2)
+	events <- service.BookingEvent{Type: service.BookingCreated, Booking: &model.Booking{ID: primitive.NewObjectID(), UserID: "user1"}}
+	events <- service.BookingEvent{Type: service.BookingCancelled, Booking: &model.Booking{ID: primitive.NewObjectID(), UserID: "user1"}}
+	close(events)
+
+	// Set up mock expectations
+	mockService.On("WatchBookings", mock.Anything, "user1", "").Return(events)
+
+	stream := &mockEventStream{ctx: mockContextWithClaims("user1", false)}
+
+	// Call the method
+	err := server.WatchBookings(&pb.WatchBookingsRequest{UserId: "user1"}, stream)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Len(t, stream.sent, 2)
+	assert.Equal(t, pb.BookingEventType_BOOKING_CREATED, stream.sent[0].Type)
+	assert.Equal(t, pb.BookingEventType_BOOKING_CANCELLED, stream.sent[1].Type)
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// CreateWebhook registers a URL to be sent booking events. The response is
+// the only time the webhook's signing secret is returned.
+func (s *BookingServer) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	userID, _ := auth.GetUserIDFromContext(ctx)
+
+	webhook, err := s.service.CreateWebhook(ctx, model.Webhook{
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		CreatedBy:  userID,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidWebhook) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
+	}
+
+	pbWebhook := convertWebhookToProto(webhook)
+	pbWebhook.Secret = webhook.Secret
+	return pbWebhook, nil
+}
+
+// ListWebhooks returns the registered webhooks, without their secrets
+func (s *BookingServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.WebhookList, error) {
+	webhooks, err := s.service.ListWebhooks(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhooks: %v", err)
+	}
+
+	pbWebhooks := make([]*pb.Webhook, len(webhooks))
+	for i, webhook := range webhooks {
+		pbWebhooks[i] = convertWebhookToProto(webhook)
+	}
+
+	return &pb.WebhookList{Webhooks: pbWebhooks}, nil
+}
+
+// DeleteWebhook stops sending events to a webhook
+func (s *BookingServer) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	deleted, err := s.service.DeleteWebhook(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+
+	return &pb.DeleteWebhookResponse{Deleted: true}, nil
+}
+
+// Helper function to convert model.Webhook to proto Webhook, leaving out the secret
+func convertWebhookToProto(webhook *model.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:         webhook.ID.Hex(),
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		CreatedBy:  webhook.CreatedBy,
+		CreatedAt:  timestamppb.New(webhook.CreatedAt),
+	}
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// Test: Admin creates a webhook and gets its secret once (should succeed)
+func TestCreateWebhook_Admin(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	webhook := &model.Webhook{
+		ID:         primitive.NewObjectID(),
+		URL:        "https://example.com/hooks",
+		Secret:     "s3cret",
+		EventTypes: []string{model.WebhookEventBookingCreated},
+		CreatedBy:  "admin1",
+		CreatedAt:  time.Now(),
+	}
+
+	// Set up mock expectations
+	mockService.On("CreateWebhook", mock.Anything, model.Webhook{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{model.WebhookEventBookingCreated},
+		CreatedBy:  "admin1",
+	}).Return(webhook, nil)
+	mockService.On("ListWebhooks", mock.Anything).Return([]*model.Webhook{webhook}, nil)
+
+	// Call the method
+	resp, err := server.CreateWebhook(mockAdminContext("admin1"), &pb.CreateWebhookRequest{
+		Url:        "https://example.com/hooks",
+		EventTypes: []string{model.WebhookEventBookingCreated},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, webhook.ID.Hex(), resp.Id)
+	assert.Equal(t, "s3cret", resp.Secret)
+
+	// Listing leaves the secret out
+	list, err := server.ListWebhooks(mockAdminContext("admin1"), &pb.ListWebhooksRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Webhooks, 1)
+	assert.Empty(t, list.Webhooks[0].Secret)
+	mockService.AssertExpectations(t)
+}
+
+// Test: Admin creates a webhook the service rejects (should fail)
+func TestCreateWebhook_Invalid(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("CreateWebhook", mock.Anything, mock.Anything).
+		Return(nil, errors.Wrap(service.ErrInvalidWebhook, "url must be an absolute http(s) URL"))
+
+	// Call the method
+	resp, err := server.CreateWebhook(mockAdminContext("admin1"), &pb.CreateWebhookRequest{
+		Url:        "https://",
+		EventTypes: []string{model.WebhookEventBookingCreated},
+	})
+
+	// Assertions
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// Test: Barber lists webhooks (should fail)
+func TestListWebhooks_Barber(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Call the method
+	resp, err := withPolicy(server, "ListWebhooks", server.ListWebhooks)(mockContextWithClaims("barber1", true), &pb.ListWebhooksRequest{})
+
+	// Assertions
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	mockService.AssertNotCalled(t, "ListWebhooks")
+}
+
+// Test: Admin deletes a webhook that doesn't exist (should fail)
+func TestDeleteWebhook_NotFound(t *testing.T) {
+	mockService := new(MockBookingService)
+	server := &BookingServer{service: mockService}
+
+	// Set up mock expectations
+	mockService.On("DeleteWebhook", mock.Anything, "missing").Return(false, nil)
+
+	// Call the method
+	resp, err := server.DeleteWebhook(mockAdminContext("admin1"), &pb.DeleteWebhookRequest{Id: "missing"})
+
+	// Assertions
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockService.AssertExpectations(t)
+}
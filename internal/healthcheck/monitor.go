@@ -0,0 +1,116 @@
+package healthcheck
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// LivenessService is the health service name that reports whether the
+// process is up, regardless of its dependencies. Liveness probes should use
+// it, so an unreachable database doesn't get the pod restarted.
+const LivenessService = "liveness"
+
+// checkTimeout bounds a single round of checks
+const checkTimeout = 5 * time.Second
+
+// Check reports whether a dependency is usable
+type Check func(ctx context.Context) error
+
+// Monitor runs dependency checks periodically and publishes the result on a
+// gRPC health server. The overall status ("") and the monitored services are
+// SERVING while every check passes, and NOT_SERVING otherwise.
+type Monitor struct {
+	server   *health.Server
+	services []string
+	interval time.Duration
+
+	mu      sync.Mutex
+	checks  map[string]Check
+	failing map[string]bool
+}
+
+// NewMonitor creates a monitor publishing to server every interval, for the
+// overall status and the given services
+func NewMonitor(server *health.Server, interval time.Duration, services ...string) *Monitor {
+	server.SetServingStatus(LivenessService, healthpb.HealthCheckResponse_SERVING)
+
+	return &Monitor{
+		server:   server,
+		services: append([]string{""}, services...),
+		interval: interval,
+		checks:   make(map[string]Check),
+		failing:  make(map[string]bool),
+	}
+}
+
+// AddCheck adds a named dependency check
+func (m *Monitor) AddCheck(name string, check Check) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checks[name] = check
+}
+
+// Run checks the dependencies until ctx is cancelled
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.CheckNow(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckNow runs every check once and publishes the result. It reports
+// whether all of them passed.
+func (m *Monitor) CheckNow(ctx context.Context) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	names := make([]string, 0, len(m.checks))
+	for name := range m.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	healthy := true
+	for _, name := range names {
+		err := m.checks[name](ctx)
+		if err != nil {
+			healthy = false
+		}
+
+		// Only log changes, not every failing round
+		switch {
+		case err != nil && !m.failing[name]:
+			log.Error().Err(err).Str("check", name).Msg("Health check failed")
+		case err == nil && m.failing[name]:
+			log.Info().Str("check", name).Msg("Health check recovered")
+		}
+		m.failing[name] = err != nil
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if !healthy {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	for _, service := range m.services {
+		m.server.SetServingStatus(service, status)
+	}
+
+	return healthy
+}
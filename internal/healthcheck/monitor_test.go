@@ -0,0 +1,74 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatus(t *testing.T, server *health.Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func TestMonitor_CheckNow(t *testing.T) {
+	server := health.NewServer()
+	monitor := NewMonitor(server, time.Minute, "booking.BookingService")
+
+	var dbErr error
+	monitor.AddCheck("mongodb", func(ctx context.Context) error { return dbErr })
+	monitor.AddCheck("bookings", func(ctx context.Context) error { return nil })
+
+	assert.True(t, monitor.CheckNow(context.Background()))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, "booking.BookingService"))
+
+	dbErr = errors.New("connection refused")
+	assert.False(t, monitor.CheckNow(context.Background()))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, server, "booking.BookingService"))
+
+	// The process itself is still alive
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, LivenessService))
+
+	dbErr = nil
+	assert.True(t, monitor.CheckNow(context.Background()))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, server, ""))
+}
+
+func TestMonitor_RunStopsWithContext(t *testing.T) {
+	server := health.NewServer()
+	monitor := NewMonitor(server, time.Millisecond)
+
+	checked := make(chan struct{}, 1)
+	monitor.AddCheck("ping", func(ctx context.Context) error {
+		select {
+		case checked <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		monitor.Run(ctx)
+		close(done)
+	}()
+
+	<-checked
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("monitor didn't stop")
+	}
+}
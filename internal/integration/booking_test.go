@@ -0,0 +1,233 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+	"github.com/ita-av/booking-service/pkg/client"
+)
+
+func TestBookingLifecycle(t *testing.T) {
+	s := newStack(t, stackConfig{})
+	s.openBarber(t, "barber1")
+	ctx := context.Background()
+	customer := s.as(t, "customer1")
+	barber := s.as(t, "barber1", auth.RoleBarber)
+	start := slotAt(10)
+
+	booking, err := customer.CreateBooking(ctx, client.NewBooking{
+		BarberID: "barber1",
+		Start:    start,
+		Services: []pb.ServiceType{pb.ServiceType_HAIRCUT},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "customer1", booking.UserId)
+	assert.Equal(t, pb.BookingStatus_PENDING, booking.Status)
+
+	// The slot is no longer offered
+	slots, err := customer.AvailableSlots(ctx, "barber1", start)
+	require.NoError(t, err)
+	assert.NotContains(t, slotStarts(slots), start.Unix())
+
+	// Only the booked barber confirms it
+	_, err = customer.Stub().ConfirmBooking(ctx, &pb.ConfirmBookingRequest{Id: booking.Id})
+	assert.ErrorIs(t, err, client.ErrPermissionDenied)
+	confirmed, err := barber.Stub().ConfirmBooking(ctx, &pb.ConfirmBookingRequest{Id: booking.Id})
+	require.NoError(t, err)
+	assert.Equal(t, pb.BookingStatus_CONFIRMED, confirmed.Status)
+
+	// Other customers can't cancel it
+	_, err = s.as(t, "customer2").CancelBooking(ctx, booking.Id, "")
+	assert.ErrorIs(t, err, client.ErrPermissionDenied)
+
+	_, err = customer.CancelBooking(ctx, booking.Id, "Can't make it")
+	require.NoError(t, err)
+	cancelled, err := customer.GetBooking(ctx, booking.Id)
+	require.NoError(t, err)
+	assert.Equal(t, pb.BookingStatus_CANCELLED, cancelled.Status)
+	assert.Equal(t, "Can't make it", cancelled.Cancellation.GetReason())
+
+	// Cancelling freed the slot
+	slots, err = customer.AvailableSlots(ctx, "barber1", start)
+	require.NoError(t, err)
+	assert.Contains(t, slotStarts(slots), start.Unix())
+
+	// Every change was recorded
+	history, err := customer.Stub().GetBookingHistory(ctx, &pb.GetBookingHistoryRequest{BookingId: booking.Id})
+	require.NoError(t, err)
+	assert.Len(t, history.Entries, 3)
+}
+
+func TestUnauthenticatedCallsAreRejected(t *testing.T) {
+	s := newStack(t, stackConfig{})
+
+	_, err := s.anonymous(t).CreateBooking(context.Background(), client.NewBooking{
+		BarberID: "barber1",
+		Start:    slotAt(10),
+		Services: []pb.ServiceType{pb.ServiceType_HAIRCUT},
+	})
+
+	assert.ErrorIs(t, err, client.ErrUnauthenticated)
+}
+
+func TestAuditLogRecordsChanges(t *testing.T) {
+	s := newStack(t, stackConfig{})
+	s.openBarber(t, "barber1")
+	ctx := context.Background()
+
+	_, err := s.as(t, "customer1").CreateBooking(ctx, client.NewBooking{
+		BarberID: "barber1",
+		Start:    slotAt(11),
+		Services: []pb.ServiceType{pb.ServiceType_HAIRCUT},
+	})
+	require.NoError(t, err)
+
+	// Customers can't read the audit log
+	_, err = s.as(t, "customer1").Stub().ListAuditLog(ctx, &pb.ListAuditLogRequest{})
+	assert.ErrorIs(t, err, client.ErrPermissionDenied)
+
+	log, err := s.as(t, "admin", auth.RoleAdmin).Stub().ListAuditLog(ctx, &pb.ListAuditLogRequest{ActorId: "customer1"})
+	require.NoError(t, err)
+	require.Len(t, log.Entries, 1)
+	assert.Equal(t, "/booking.BookingService/CreateBooking", log.Entries[0].Method)
+	assert.Equal(t, "OK", log.Entries[0].Code)
+}
+
+func TestDeletedBookingsAreHidden(t *testing.T) {
+	s := newStack(t, stackConfig{})
+	s.openBarber(t, "barber1")
+	ctx := context.Background()
+	admin := s.as(t, "admin", auth.RoleAdmin)
+	start := slotAt(12)
+
+	booking, err := s.as(t, "customer1").CreateBooking(ctx, client.NewBooking{
+		BarberID: "barber1",
+		Start:    start,
+		Services: []pb.ServiceType{pb.ServiceType_HAIRCUT},
+	})
+	require.NoError(t, err)
+
+	_, err = admin.Stub().DeleteBooking(ctx, &pb.DeleteBookingRequest{Id: booking.Id})
+	require.NoError(t, err)
+
+	_, err = admin.GetBooking(ctx, booking.Id)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	list, err := admin.Stub().ListBookings(ctx, &pb.ListBookingsRequest{BarberId: "barber1"})
+	require.NoError(t, err)
+	assert.Empty(t, list.Bookings)
+
+	// The deleted booking no longer takes the slot
+	slots, err := admin.AvailableSlots(ctx, "barber1", start)
+	require.NoError(t, err)
+	assert.Contains(t, slotStarts(slots), start.Unix())
+}
+
+func TestConcurrentBookingsOfOneSlot(t *testing.T) {
+	for name, cfg := range map[string]stackConfig{
+		"transactions": {},
+		"slot locks":   {slotLocks: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := newStack(t, cfg)
+			s.openBarber(t, "barber1")
+			ctx := context.Background()
+			start := slotAt(14)
+
+			const customers = 10
+			clients := make([]*client.Client, customers)
+			for i := range clients {
+				clients[i] = s.as(t, fmt.Sprintf("customer%d", i))
+			}
+
+			var (
+				wg    sync.WaitGroup
+				ready = make(chan struct{})
+				errs  = make([]error, customers)
+			)
+			for i, c := range clients {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					<-ready
+					_, errs[i] = c.CreateBooking(ctx, client.NewBooking{
+						BarberID: "barber1",
+						Start:    start,
+						Services: []pb.ServiceType{pb.ServiceType_HAIRCUT},
+					})
+				}()
+			}
+			close(ready)
+			wg.Wait()
+
+			booked := 0
+			for _, err := range errs {
+				if err == nil {
+					booked++
+					continue
+				}
+				assert.ErrorIs(t, err, client.ErrSlotUnavailable)
+			}
+			assert.Equal(t, 1, booked, "exactly one customer gets the slot")
+
+			list, err := s.as(t, "admin", auth.RoleAdmin).Stub().ListBookings(ctx, &pb.ListBookingsRequest{BarberId: "barber1"})
+			require.NoError(t, err)
+			assert.Len(t, list.Bookings, 1)
+		})
+	}
+}
+
+func TestConcurrentOverlappingBookings(t *testing.T) {
+	s := newStack(t, stackConfig{})
+	s.openBarber(t, "barber1")
+	ctx := context.Background()
+	start := slotAt(15)
+
+	// A full service at 15:00 and a haircut at 15:30 overlap, so only one
+	// of them is booked
+	var (
+		wg    sync.WaitGroup
+		ready = make(chan struct{})
+		errs  = make([]error, 2)
+	)
+	requests := []client.NewBooking{
+		{BarberID: "barber1", Start: start, Services: []pb.ServiceType{pb.ServiceType_FULL_SERVICE}},
+		{BarberID: "barber1", Start: start.Add(30 * time.Minute), Services: []pb.ServiceType{pb.ServiceType_HAIRCUT}},
+	}
+	for i, req := range requests {
+		c := s.as(t, fmt.Sprintf("customer%d", i))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ready
+			_, errs[i] = c.CreateBooking(ctx, req)
+		}()
+	}
+	close(ready)
+	wg.Wait()
+
+	if errs[0] == nil {
+		assert.ErrorIs(t, errs[1], client.ErrSlotUnavailable)
+	} else {
+		assert.ErrorIs(t, errs[0], client.ErrSlotUnavailable)
+		assert.NoError(t, errs[1])
+	}
+}
+
+// slotStarts returns when slots start, in Unix seconds
+func slotStarts(slots []client.TimeSlot) []int64 {
+	starts := make([]int64, len(slots))
+	for i, slot := range slots {
+		starts[i] = slot.Start.Unix()
+	}
+	return starts
+}
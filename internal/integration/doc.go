@@ -0,0 +1,6 @@
+// Package integration tests the whole gRPC server, from authentication
+// through the service to a real MongoDB started with testcontainers. Its
+// tests only build with the integration tag and need Docker:
+//
+//	go test -tags integration ./internal/integration/...
+package integration
@@ -0,0 +1,210 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+
+	"github.com/ita-av/booking-service/internal/audit"
+	"github.com/ita-av/booking-service/internal/auth"
+	grpcServer "github.com/ita-av/booking-service/internal/grpc"
+	"github.com/ita-av/booking-service/internal/repository"
+	"github.com/ita-av/booking-service/internal/service"
+	"github.com/ita-av/booking-service/internal/validation"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+	"github.com/ita-av/booking-service/pkg/client"
+)
+
+// jwtSecret signs the test tokens
+const jwtSecret = "integration-secret"
+
+var (
+	// mongoClient is connected to the MongoDB container shared by every test
+	mongoClient *mongo.Client
+	// mongoErr is why MongoDB couldn't be started, e.g. Docker isn't running
+	mongoErr error
+	// databases numbers the test databases
+	databases atomic.Int64
+)
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+
+	container, err := startMongo(ctx)
+	if err == nil {
+		var uri string
+		if uri, err = container.ConnectionString(ctx); err == nil {
+			mongoClient, err = mongo.Connect(ctx, options.Client().ApplyURI(uri).SetDirect(true))
+		}
+	}
+	mongoErr = err
+
+	code := m.Run()
+
+	if mongoClient != nil {
+		mongoClient.Disconnect(ctx)
+	}
+	testcontainers.TerminateContainer(container)
+	os.Exit(code)
+}
+
+// startMongo starts a MongoDB container. testcontainers panics when it
+// can't find Docker, which fails startMongo like any other error.
+func startMongo(ctx context.Context) (container *mongodb.MongoDBContainer, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	// Bookings are made in transactions, which need a replica set
+	return mongodb.Run(ctx, "mongo:7", mongodb.WithReplicaSet("rs0"))
+}
+
+// stackConfig tweaks how a test server is put together
+type stackConfig struct {
+	// slotLocks keeps concurrent bookings apart with leases in MongoDB
+	// instead of transactions, like BOOKING_LOCK=mongodb
+	slotLocks bool
+}
+
+// stack is a booking server on a fresh database, listening on a local port
+type stack struct {
+	addr string
+	db   *mongo.Database
+}
+
+// newStack starts a server wired like cmd/server on a database of its own,
+// skipping the test if MongoDB couldn't be started
+func newStack(t *testing.T, cfg stackConfig) *stack {
+	t.Helper()
+	if mongoErr != nil {
+		t.Skipf("MongoDB container unavailable: %v", mongoErr)
+	}
+
+	ctx := context.Background()
+	db := mongoClient.Database(fmt.Sprintf("booking_it_%d_%d", os.Getpid(), databases.Add(1)))
+	t.Cleanup(func() { db.Drop(context.Background()) })
+
+	var bookingOpts []repository.MongoOption
+	if cfg.slotLocks {
+		bookingOpts = append(bookingOpts, repository.WithCallerLocks())
+	}
+	bookingRepo := repository.NewMongoBookingRepository(db, bookingOpts...)
+	require.NoError(t, bookingRepo.EnsureIndexes(ctx))
+	barberRepo := repository.NewMongoBarberRepository(db)
+	require.NoError(t, barberRepo.EnsureIndexes(ctx))
+	historyRepo := repository.NewMongoBookingHistoryRepository(db)
+	require.NoError(t, historyRepo.EnsureIndexes(ctx))
+	auditRepo := repository.NewMongoAuditRepository(db)
+	require.NoError(t, auditRepo.EnsureIndexes(ctx))
+
+	serviceOpts := []service.Option{
+		service.WithShopTimezone(time.UTC),
+		service.WithSettingsRepository(repository.NewMongoSettingsRepository(db)),
+		service.WithScheduleRepository(repository.NewMongoScheduleRepository(db)),
+		service.WithHolidayRepository(repository.NewMongoHolidayRepository(db)),
+		service.WithCatalogRepository(repository.NewMongoCatalogRepository(db)),
+		service.WithBarberServicesRepository(repository.NewMongoBarberServicesRepository(db)),
+		service.WithBarberRepository(barberRepo),
+		service.WithResourceRepository(repository.NewMongoResourceRepository(db)),
+		service.WithHistoryRepository(historyRepo),
+		service.WithAuditRepository(auditRepo),
+	}
+	if cfg.slotLocks {
+		serviceOpts = append(serviceOpts, service.WithSlotLocks(repository.NewMongoLeaseRepository(db), 10*time.Second, 5*time.Second))
+	}
+	bookingService := service.NewBookingService(bookingRepo, serviceOpts...)
+
+	authenticator, err := auth.NewAuthenticator(auth.JWTConfig{Secret: []byte(jwtSecret)})
+	require.NoError(t, err)
+	tenants := &auth.TenantGuard{}
+
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			authenticator.Unary,
+			tenants.Unary,
+			validation.UnaryInterceptor,
+			auth.AuthorizeInterceptor,
+			audit.NewInterceptor(auditRepo).Unary,
+		),
+		grpc.ChainStreamInterceptor(
+			authenticator.Stream,
+			tenants.Stream,
+			validation.StreamInterceptor,
+			auth.StreamAuthorizeInterceptor,
+		),
+	)
+	pb.RegisterBookingServiceServer(s, grpcServer.NewBookingServer(bookingService))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	return &stack{addr: lis.Addr().String(), db: db}
+}
+
+// as returns a client calling the server as userID with the roles
+func (s *stack) as(t *testing.T, userID string, roles ...auth.Role) *client.Client {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.Claims{
+		Roles: roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}).SignedString([]byte(jwtSecret))
+	require.NoError(t, err)
+
+	return s.dial(t, client.WithToken(token))
+}
+
+// anonymous returns a client calling the server without a token
+func (s *stack) anonymous(t *testing.T) *client.Client {
+	t.Helper()
+	return s.dial(t)
+}
+
+func (s *stack) dial(t *testing.T, opts ...client.Option) *client.Client {
+	t.Helper()
+
+	c, err := client.New(s.addr, append(opts, client.WithInsecure())...)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// openBarber sets up a barber working every day from 09:00 to 18:00 UTC in
+// 30 minute slots
+func (s *stack) openBarber(t *testing.T, barberID string) {
+	t.Helper()
+
+	req := &pb.SetWorkingHoursRequest{BarberId: barberID, Timezone: "UTC", SlotMinutes: 30}
+	for weekday := range pb.Weekday_name {
+		req.Hours = append(req.Hours, &pb.WorkingHours{Weekday: pb.Weekday(weekday), Start: "09:00", End: "18:00"})
+	}
+	_, err := s.as(t, "admin", auth.RoleAdmin).Stub().SetWorkingHours(context.Background(), req)
+	require.NoError(t, err)
+}
+
+// slotAt returns a slot start a few days ahead, within working hours
+func slotAt(hour int) time.Time {
+	day := time.Now().UTC().AddDate(0, 0, 3)
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,153 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+	"github.com/ita-av/booking-service/pkg/client"
+)
+
+func TestBarberStats(t *testing.T) {
+	s := newStack(t, stackConfig{})
+	s.openBarber(t, "barber1")
+	ctx := context.Background()
+	customer := s.as(t, "customer1")
+
+	var bookings []*pb.Booking
+	for _, hour := range []int{10, 12, 14} {
+		booking, err := customer.CreateBooking(ctx, client.NewBooking{
+			BarberID: "barber1",
+			Start:    slotAt(hour),
+			Services: []pb.ServiceType{pb.ServiceType_HAIRCUT},
+		})
+		require.NoError(t, err)
+		bookings = append(bookings, booking)
+	}
+	_, err := customer.CancelBooking(ctx, bookings[2].Id, "")
+	require.NoError(t, err)
+
+	day := slotAt(0)
+	stats, err := s.as(t, "barber1", auth.RoleBarber).Stub().GetBarberStats(ctx, &pb.GetBarberStatsRequest{
+		BarberId: "barber1",
+		Period:   pb.StatsPeriod_WEEK,
+		Day:      &pb.CalendarDate{Year: int32(day.Year()), Month: int32(day.Month()), Day: int32(day.Day())},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), stats.Bookings)
+	assert.Equal(t, []*pb.StatusCount{
+		{Status: pb.BookingStatus_PENDING, Count: 2},
+		{Status: pb.BookingStatus_CANCELLED, Count: 1},
+	}, stats.StatusCounts)
+
+	// The cancelled booking doesn't take up any time
+	booked := 0.0
+	for _, booking := range bookings[:2] {
+		booked += booking.EndTimeTs.AsTime().Sub(booking.StartTimeTs.AsTime()).Hours()
+	}
+	assert.InDelta(t, booked, stats.BookedHours, 1e-9)
+	// Nine hours a day, every day
+	assert.InDelta(t, 63, stats.WorkingHours, 1e-9)
+	require.Len(t, stats.BusiestDays, 1)
+	assert.Equal(t, int32(day.Day()), stats.BusiestDays[0].Day.Day)
+	assert.Equal(t, int32(2), stats.BusiestDays[0].Bookings)
+}
+
+func TestReliabilityReport(t *testing.T) {
+	s := newStack(t, stackConfig{})
+	s.openBarber(t, "barber1")
+	ctx := context.Background()
+
+	for i, userID := range []string{"customer1", "customer1", "customer2"} {
+		customer := s.as(t, userID)
+		booking, err := customer.CreateBooking(ctx, client.NewBooking{
+			BarberID: "barber1",
+			Start:    slotAt(10 + 2*i),
+			Services: []pb.ServiceType{pb.ServiceType_HAIRCUT},
+		})
+		require.NoError(t, err)
+		if i == 0 {
+			_, err = customer.CancelBooking(ctx, booking.Id, "")
+			require.NoError(t, err)
+		}
+	}
+
+	day := slotAt(0)
+	date := &pb.CalendarDate{Year: int32(day.Year()), Month: int32(day.Month()), Day: int32(day.Day())}
+	report, err := s.as(t, "barber1", auth.RoleBarber).Stub().GetReliabilityReport(ctx, &pb.GetReliabilityReportRequest{
+		GroupBy:  pb.ReliabilityGroup_BY_USER,
+		StartDay: date,
+		EndDay:   date,
+		SortBy:   pb.ReliabilitySort_CANCELLATION_RATE,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, report.Rows, 2)
+	assert.Equal(t, "customer1", report.Rows[0].Id)
+	assert.Equal(t, int32(2), report.Rows[0].Bookings)
+	assert.Equal(t, int32(1), report.Rows[0].Cancelled)
+	assert.InDelta(t, 0.5, report.Rows[0].CancellationRate, 1e-9)
+	assert.Equal(t, "customer2", report.Rows[1].Id)
+	assert.Zero(t, report.Rows[1].CancellationRate)
+}
+
+func TestRevenueReport(t *testing.T) {
+	s := newStack(t, stackConfig{})
+	s.openBarber(t, "barber1")
+	ctx := context.Background()
+	customer := s.as(t, "customer1")
+	barber := s.as(t, "barber1", auth.RoleBarber).Stub()
+
+	pay := func(hour int, services []pb.ServiceType, amount, tip int64, rendered ...pb.ServiceType) {
+		t.Helper()
+		booking, err := customer.CreateBooking(ctx, client.NewBooking{BarberID: "barber1", Start: slotAt(hour), Services: services})
+		require.NoError(t, err)
+		_, err = barber.RecordPOSCompletion(ctx, &pb.RecordPOSCompletionRequest{
+			BookingId: booking.Id, Amount: amount, Tip: tip, Currency: "USD", RenderedServices: rendered,
+		})
+		require.NoError(t, err)
+	}
+	pay(10, []pb.ServiceType{pb.ServiceType_HAIRCUT}, 3000, 500)
+	// Split between both services, the haircut taking the odd cent
+	pay(12, []pb.ServiceType{pb.ServiceType_HAIRCUT, pb.ServiceType_BEARD_TRIM}, 4001, 0)
+	// Split by what was rendered rather than booked
+	pay(14, []pb.ServiceType{pb.ServiceType_HAIRCUT}, 5000, 1000, pb.ServiceType_HAIRCUT, pb.ServiceType_HAIR_WASH)
+
+	day := slotAt(0)
+	date := &pb.CalendarDate{Year: int32(day.Year()), Month: int32(day.Month()), Day: int32(day.Day())}
+	report, err := s.as(t, "admin", auth.RoleAdmin).Stub().GetRevenueReport(ctx, &pb.GetRevenueReportRequest{
+		StartDay:    date,
+		EndDay:      date,
+		Granularity: pb.ReportGranularity_DAILY,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(3), report.Bookings)
+	assert.Equal(t, int64(12001), report.Revenue)
+	assert.Equal(t, int64(1500), report.Tips)
+	require.Len(t, report.Lines, 3)
+	for i, want := range []struct {
+		service  pb.ServiceType
+		bookings int32
+		revenue  int64
+		tips     int64
+	}{
+		{pb.ServiceType_HAIRCUT, 3, 3000 + 2001 + 2500, 500 + 500},
+		{pb.ServiceType_BEARD_TRIM, 1, 2000, 0},
+		{pb.ServiceType_HAIR_WASH, 1, 2500, 500},
+	} {
+		line := report.Lines[i]
+		assert.Equal(t, date.Day, line.PeriodStart.Day)
+		assert.Equal(t, want.service, line.ServiceType)
+		assert.Equal(t, want.bookings, line.Bookings)
+		assert.Equal(t, want.revenue, line.Revenue)
+		assert.Equal(t, want.tips, line.Tips)
+	}
+}
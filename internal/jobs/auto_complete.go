@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// AutoCompleteJob completes bookings that are long over
+type AutoCompleteJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewAutoCompleteJob creates a new auto-complete job
+func NewAutoCompleteJob(service service.BookingServiceInterface) *AutoCompleteJob {
+	return &AutoCompleteJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *AutoCompleteJob) Name() string {
+	return "auto-complete"
+}
+
+// Run completes or flags finished bookings
+func (j *AutoCompleteJob) Run(ctx context.Context) error {
+	if _, _, err := j.service.AutoCompleteBookings(ctx); err != nil {
+		return errors.Wrap(err, "failed to auto-complete bookings")
+	}
+
+	return nil
+}
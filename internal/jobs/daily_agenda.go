@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// DailyAgendaJob sends barbers their agenda for the next day
+type DailyAgendaJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewDailyAgendaJob creates a new daily agenda job. It must run every
+// service.DailyAgendaInterval for each barber to get one agenda a day.
+func NewDailyAgendaJob(service service.BookingServiceInterface) *DailyAgendaJob {
+	return &DailyAgendaJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *DailyAgendaJob) Name() string {
+	return "daily-agendas"
+}
+
+// Run sends the agendas that are due
+func (j *DailyAgendaJob) Run(ctx context.Context) error {
+	if _, err := j.service.SendDailyAgendas(ctx); err != nil {
+		return errors.Wrap(err, "failed to send daily agendas")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// DeletedPurgeJob permanently removes soft-deleted bookings once their
+// retention period has passed
+type DeletedPurgeJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewDeletedPurgeJob creates a new deleted booking purge job
+func NewDeletedPurgeJob(service service.BookingServiceInterface) *DeletedPurgeJob {
+	return &DeletedPurgeJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *DeletedPurgeJob) Name() string {
+	return "deleted-purge"
+}
+
+// Run purges soft-deleted bookings past their retention period
+func (j *DeletedPurgeJob) Run(ctx context.Context) error {
+	if _, err := j.service.PurgeDeletedBookings(ctx); err != nil {
+		return errors.Wrap(err, "failed to purge deleted bookings")
+	}
+
+	return nil
+}
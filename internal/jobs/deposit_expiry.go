@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// DepositExpiryJob cancels bookings whose deposit wasn't paid in time
+type DepositExpiryJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewDepositExpiryJob creates a new deposit expiry job
+func NewDepositExpiryJob(service service.BookingServiceInterface) *DepositExpiryJob {
+	return &DepositExpiryJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *DepositExpiryJob) Name() string {
+	return "deposit-expiry"
+}
+
+// Run cancels unpaid bookings past their deposit deadline
+func (j *DepositExpiryJob) Run(ctx context.Context) error {
+	if _, err := j.service.ExpireUnpaidBookings(ctx); err != nil {
+		return errors.Wrap(err, "failed to expire unpaid bookings")
+	}
+
+	return nil
+}
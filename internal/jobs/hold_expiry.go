@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// HoldExpiryJob deletes slot holds that lapsed without being booked
+type HoldExpiryJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewHoldExpiryJob creates a new hold expiry job
+func NewHoldExpiryJob(service service.BookingServiceInterface) *HoldExpiryJob {
+	return &HoldExpiryJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *HoldExpiryJob) Name() string {
+	return "hold-expiry"
+}
+
+// Run deletes lapsed holds
+func (j *HoldExpiryJob) Run(ctx context.Context) error {
+	if _, err := j.service.ExpireHolds(ctx); err != nil {
+		return errors.Wrap(err, "failed to expire holds")
+	}
+
+	return nil
+}
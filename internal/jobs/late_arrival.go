@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// LateArrivalJob releases the remaining time of bookings whose customer
+// hasn't checked in within the grace period after the start time
+type LateArrivalJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewLateArrivalJob creates a new late-arrival job
+func NewLateArrivalJob(service service.BookingServiceInterface) *LateArrivalJob {
+	return &LateArrivalJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *LateArrivalJob) Name() string {
+	return "late-arrival"
+}
+
+// Run releases bookings past their grace period
+func (j *LateArrivalJob) Run(ctx context.Context) error {
+	if _, err := j.service.ReleaseLateBookings(ctx); err != nil {
+		return errors.Wrap(err, "failed to release late bookings")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// LeasedJob runs a job only while holding its lease, so with several
+// replicas scheduling it, one at a time runs it. The holder renews the lease
+// on every run and keeps it; another replica takes over once it has lapsed,
+// e.g. because the holder stopped.
+type LeasedJob struct {
+	job    Job
+	leases repository.LeaseRepository
+	holder string
+	ttl    time.Duration
+}
+
+// WithLease wraps job so it only runs on the replica holding its lease. The
+// ttl must be longer than the interval the job runs at, or the lease lapses
+// between runs.
+func WithLease(job Job, leases repository.LeaseRepository, holder string, ttl time.Duration) *LeasedJob {
+	return &LeasedJob{
+		job:    job,
+		leases: leases,
+		holder: holder,
+		ttl:    ttl,
+	}
+}
+
+// Name returns the wrapped job's name, which is also the lease's
+func (j *LeasedJob) Name() string {
+	return j.job.Name()
+}
+
+// Run runs the job if the lease is this holder's
+func (j *LeasedJob) Run(ctx context.Context) error {
+	acquired, err := j.leases.AcquireLease(ctx, j.job.Name(), j.holder, j.ttl)
+	if err != nil {
+		return errors.Wrap(err, "failed to acquire job lease")
+	}
+	if !acquired {
+		log.Debug().Str("job", j.job.Name()).Msg("Job lease held by another replica")
+		return nil
+	}
+
+	return j.job.Run(ctx)
+}
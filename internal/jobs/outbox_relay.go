@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// OutboxRelayJob publishes the booking events waiting in the outbox
+type OutboxRelayJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewOutboxRelayJob creates a new outbox relay job
+func NewOutboxRelayJob(service service.BookingServiceInterface) *OutboxRelayJob {
+	return &OutboxRelayJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *OutboxRelayJob) Name() string {
+	return "outbox-relay"
+}
+
+// Run publishes pending outbox events
+func (j *OutboxRelayJob) Run(ctx context.Context) error {
+	if _, err := j.service.RelayOutbox(ctx); err != nil {
+		return errors.Wrap(err, "failed to relay outbox events")
+	}
+
+	return nil
+}
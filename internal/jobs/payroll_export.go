@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/service"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// PayrollExportJob finalizes the previous month's payroll once it has ended
+// and writes CSV and JSON exports to a directory for payroll software to pick
+// up. Tenants other than the default one get a subdirectory each.
+type PayrollExportJob struct {
+	service service.BookingServiceInterface
+	dir     string
+}
+
+// NewPayrollExportJob creates a new payroll export job
+func NewPayrollExportJob(service service.BookingServiceInterface, dir string) *PayrollExportJob {
+	return &PayrollExportJob{
+		service: service,
+		dir:     dir,
+	}
+}
+
+// Name returns the job name
+func (j *PayrollExportJob) Name() string {
+	return "payroll-export"
+}
+
+// Run finalizes and exports the previous month if it hasn't been exported yet
+func (j *PayrollExportJob) Run(ctx context.Context) error {
+	previous := time.Now().UTC().AddDate(0, -1, 0)
+	year, month := previous.Year(), previous.Month()
+
+	dir := j.dir
+	if tenantID := tenant.FromContext(ctx); tenantID != tenant.Default {
+		dir = filepath.Join(dir, tenantID)
+	}
+
+	csvPath := filepath.Join(dir, fmt.Sprintf("payroll-%04d-%02d.csv", year, int(month)))
+	jsonPath := filepath.Join(dir, fmt.Sprintf("payroll-%04d-%02d.json", year, int(month)))
+	if fileExists(csvPath) && fileExists(jsonPath) {
+		return nil
+	}
+
+	period, err := j.service.FinalizePayrollPeriod(ctx, year, month)
+	if errors.Is(err, service.ErrPayrollPeriodFinalized) {
+		period, err = j.service.GetPayrollPeriod(ctx, year, month)
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to finalize payroll period")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create payroll export directory")
+	}
+
+	for path, format := range map[string]service.PayrollFormat{
+		csvPath:  service.PayrollFormatCSV,
+		jsonPath: service.PayrollFormatJSON,
+	} {
+		content, err := service.EncodePayroll(period, format)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, 0o640); err != nil {
+			return errors.Wrap(err, "failed to write payroll export")
+		}
+	}
+
+	log.Info().
+		Str("period", period.ID).
+		Str("dir", dir).
+		Msg("Payroll exported")
+
+	return nil
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
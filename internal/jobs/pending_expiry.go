@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// PendingExpiryJob cancels bookings that were never confirmed
+type PendingExpiryJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewPendingExpiryJob creates a new pending booking expiry job
+func NewPendingExpiryJob(service service.BookingServiceInterface) *PendingExpiryJob {
+	return &PendingExpiryJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *PendingExpiryJob) Name() string {
+	return "pending-expiry"
+}
+
+// Run cancels bookings left pending past the timeout
+func (j *PendingExpiryJob) Run(ctx context.Context) error {
+	if _, err := j.service.ExpireStalePendingBookings(ctx); err != nil {
+		return errors.Wrap(err, "failed to expire stale pending bookings")
+	}
+
+	return nil
+}
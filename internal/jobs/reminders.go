@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// ReminderJob reminds customers of their upcoming bookings
+type ReminderJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewReminderJob creates a new booking reminder job
+func NewReminderJob(service service.BookingServiceInterface) *ReminderJob {
+	return &ReminderJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *ReminderJob) Name() string {
+	return "booking-reminders"
+}
+
+// Run sends the reminders that are due
+func (j *ReminderJob) Run(ctx context.Context) error {
+	if _, err := j.service.SendBookingReminders(ctx); err != nil {
+		return errors.Wrap(err, "failed to send booking reminders")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// RetentionJob anonymizes or deletes finished bookings according to the
+// retention policy admins configured in the shop settings
+type RetentionJob struct {
+	service service.BookingServiceInterface
+}
+
+// NewRetentionJob creates a new retention job
+func NewRetentionJob(service service.BookingServiceInterface) *RetentionJob {
+	return &RetentionJob{
+		service: service,
+	}
+}
+
+// Name returns the job name
+func (j *RetentionJob) Name() string {
+	return "retention"
+}
+
+// Run applies the current retention policy
+func (j *RetentionJob) Run(ctx context.Context) error {
+	if _, err := j.service.ApplyRetentionPolicy(ctx); err != nil {
+		return errors.Wrap(err, "failed to apply retention policy")
+	}
+
+	return nil
+}
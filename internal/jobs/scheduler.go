@@ -0,0 +1,122 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Job is a unit of background work run periodically by the Scheduler
+type Job interface {
+	Name() string
+	Run(ctx context.Context) error
+}
+
+type scheduledJob struct {
+	job      Job
+	interval time.Duration
+}
+
+// Scheduler runs registered jobs at fixed intervals until stopped
+type Scheduler struct {
+	jobs   []scheduledJob
+	cancel context.CancelFunc
+	// stop is closed to stop starting new runs, letting running ones finish
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewScheduler creates a new job scheduler
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Every registers a job to run once at start and then every interval
+func (s *Scheduler) Every(interval time.Duration, job Job) {
+	s.jobs = append(s.jobs, scheduledJob{job: job, interval: interval})
+}
+
+// Start launches all registered jobs in the background
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.stop = make(chan struct{})
+
+	for _, sj := range s.jobs {
+		s.wg.Add(1)
+		go func(sj scheduledJob) {
+			defer s.wg.Done()
+			s.loop(ctx, sj)
+		}(sj)
+	}
+}
+
+// Stop cancels running jobs and waits for them to return
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+// Shutdown stops starting jobs and waits for running ones to finish, so a
+// reminder or outbox run isn't cut off halfway. Jobs still running when ctx
+// ends are cancelled, as by Stop.
+func (s *Scheduler) Shutdown(ctx context.Context) {
+	if s.stop != nil {
+		s.stopOnce.Do(func() { close(s.stop) })
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-ctx.Done():
+		log.Warn().Msg("Background jobs didn't finish in time, cancelling them")
+		s.Stop()
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, sj scheduledJob) {
+	ticker := time.NewTicker(sj.interval)
+	defer ticker.Stop()
+
+	log.Info().
+		Str("job", sj.job.Name()).
+		Dur("interval", sj.interval).
+		Msg("Background job scheduled")
+
+	for {
+		s.run(ctx, sj.job)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	start := time.Now()
+	if err := job.Run(ctx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		log.Error().Err(err).Str("job", job.Name()).Msg("Background job failed")
+		return
+	}
+
+	log.Debug().
+		Str("job", job.Name()).
+		Dur("duration", time.Since(start)).
+		Msg("Background job finished")
+}
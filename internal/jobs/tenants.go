@@ -0,0 +1,65 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// TenantLister lists the tenants with data
+type TenantLister interface {
+	ListTenants(ctx context.Context) ([]string, error)
+}
+
+// TenantJob runs a job once per tenant, each run scoped to its tenant
+type TenantJob struct {
+	job     Job
+	tenants TenantLister
+}
+
+// ForEachTenant wraps job so every run covers every tenant in turn. To
+// combine it with a lease, wrap the TenantJob in the lease, so one replica
+// covers every tenant.
+func ForEachTenant(job Job, tenants TenantLister) *TenantJob {
+	return &TenantJob{
+		job:     job,
+		tenants: tenants,
+	}
+}
+
+// Name returns the wrapped job's name
+func (j *TenantJob) Name() string {
+	return j.job.Name()
+}
+
+// Run runs the job for each tenant. A tenant's failure is logged and doesn't
+// keep the job from the tenants after it.
+func (j *TenantJob) Run(ctx context.Context) error {
+	tenants, err := j.tenants.ListTenants(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list tenants")
+	}
+
+	failed := 0
+	for _, tenantID := range tenants {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := j.job.Run(tenant.WithID(ctx, tenantID)); err != nil {
+			log.Error().Err(err).
+				Str("job", j.job.Name()).
+				Str("tenant", tenantID).
+				Msg("Background job failed for tenant")
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed for %d of %d tenants", failed, len(tenants))
+	}
+	return nil
+}
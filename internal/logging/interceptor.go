@@ -0,0 +1,131 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the metadata key carrying the request ID. Clients may
+// send their own to correlate logs across services; it's echoed back in the
+// response headers either way.
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLength bounds client-supplied request IDs
+const maxRequestIDLength = 128
+
+// UnaryInterceptor assigns each call a request ID, puts a logger tagged with
+// it in the context for handlers to use with log.Ctx, and logs the call's
+// outcome once it's handled. It should run before authentication, so
+// refused calls are logged too.
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx = startCall(ctx, info.FullMethod)
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(zerolog.Ctx(ctx), err, time.Since(start))
+
+	return resp, err
+}
+
+// StreamInterceptor is the streaming counterpart of UnaryInterceptor
+func StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := startCall(ss.Context(), info.FullMethod)
+
+	start := time.Now()
+	err := handler(srv, &loggedStream{ServerStream: ss, ctx: ctx})
+	logCall(zerolog.Ctx(ctx), err, time.Since(start))
+
+	return err
+}
+
+// RequestID returns the request ID of the call the context belongs to
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// requestIDKey is the context key the request ID is stored under
+type requestIDKey struct{}
+
+// startCall sets up the request ID and the request-scoped logger. Later
+// interceptors can add fields to the logger with UpdateContext, e.g. the
+// caller once they're authenticated.
+func startCall(ctx context.Context, fullMethod string) context.Context {
+	id := incomingRequestID(ctx)
+	if id == "" {
+		id = newRequestID()
+	}
+
+	// Headers can only fail to send if the call is already gone
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+
+	logger := log.With().Str("request_id", id).Str("method", fullMethod).Logger()
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+
+	return logger.WithContext(ctx)
+}
+
+// logCall logs a handled call. Failures the server is responsible for are
+// errors; the caller's mistakes are only worth a warning.
+func logCall(logger *zerolog.Logger, err error, duration time.Duration) {
+	code := status.Code(err)
+
+	var event *zerolog.Event
+	switch code {
+	case codes.OK:
+		event = logger.Info()
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unavailable, codes.DeadlineExceeded, codes.Unimplemented:
+		event = logger.Error().Err(err)
+	default:
+		event = logger.Warn().Err(err)
+	}
+
+	event.Str("code", code.String()).Dur("latency", duration).Msg("Handled RPC")
+}
+
+// incomingRequestID returns the request ID the client sent, if it's usable
+func incomingRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(RequestIDHeader)
+	if len(values) == 0 || len(values[0]) > maxRequestIDLength {
+		return ""
+	}
+	for _, r := range values[0] {
+		if r < 0x21 || r > 0x7e {
+			return ""
+		}
+	}
+
+	return values[0]
+}
+
+// newRequestID returns a random 128-bit request ID
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// loggedStream overrides the context of a server stream
+type loggedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context carrying the request ID and logger
+func (s *loggedStream) Context() context.Context {
+	return s.ctx
+}
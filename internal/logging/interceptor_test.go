@@ -0,0 +1,146 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// captureLogs sends the global logger's output to a buffer for the test
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	previous := log.Logger
+	log.Logger = zerolog.New(&buf)
+	t.Cleanup(func() { log.Logger = previous })
+	return &buf
+}
+
+// logLines decodes the JSON log lines written to buf
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var lines []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	buf := captureLogs(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/GetBooking"}
+
+	var handlerRequestID string
+	_, err := UnaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		handlerRequestID = RequestID(ctx)
+
+		// Later interceptors tag the request's logger, and handlers log through it
+		zerolog.Ctx(ctx).UpdateContext(func(c zerolog.Context) zerolog.Context {
+			return c.Str("user_id", "user1")
+		})
+		log.Ctx(ctx).Info().Msg("Looking up booking")
+
+		return nil, status.Error(codes.NotFound, "booking not found")
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	lines := logLines(t, buf)
+	require.Len(t, lines, 2)
+	assert.Len(t, handlerRequestID, 32)
+	for _, line := range lines {
+		assert.Equal(t, handlerRequestID, line["request_id"])
+		assert.Equal(t, "/booking.BookingService/GetBooking", line["method"])
+		assert.Equal(t, "user1", line["user_id"])
+	}
+
+	assert.Equal(t, "warn", lines[1]["level"])
+	assert.Equal(t, "NotFound", lines[1]["code"])
+	assert.Contains(t, lines[1], "latency")
+}
+
+func TestUnaryInterceptor_ServerErrors(t *testing.T) {
+	buf := captureLogs(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/CreateBooking"}
+
+	_, _ = UnaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.Internal, "database is down")
+	})
+
+	lines := logLines(t, buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "error", lines[0]["level"])
+	assert.Equal(t, "rpc error: code = Internal desc = database is down", lines[0]["error"])
+}
+
+func TestUnaryInterceptor_ClientRequestID(t *testing.T) {
+	captureLogs(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/GetBooking"}
+
+	tests := []struct {
+		name     string
+		incoming string
+		want     string
+	}{
+		{"client ID is kept", "checkout-42", "checkout-42"},
+		{"unprintable ID is replaced", "bad id\n", ""},
+		{"overlong ID is replaced", strings.Repeat("a", maxRequestIDLength+1), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, tt.incoming))
+
+			var got string
+			_, _ = UnaryInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = RequestID(ctx)
+				return nil, nil
+			})
+
+			if tt.want != "" {
+				assert.Equal(t, tt.want, got)
+			} else {
+				assert.NotEqual(t, tt.incoming, got)
+				assert.Len(t, got, 32)
+			}
+		})
+	}
+}
+
+// fakeServerStream is a server stream that only carries a context
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	buf := captureLogs(t)
+	info := &grpc.StreamServerInfo{FullMethod: "/booking.BookingService/WatchBookings"}
+
+	var handlerRequestID string
+	err := StreamInterceptor(nil, &fakeServerStream{ctx: context.Background()}, info, func(srv interface{}, ss grpc.ServerStream) error {
+		handlerRequestID = RequestID(ss.Context())
+		return nil
+	})
+	assert.NoError(t, err)
+
+	lines := logLines(t, buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "info", lines[0]["level"])
+	assert.Equal(t, handlerRequestID, lines[0]["request_id"])
+	assert.Equal(t, "OK", lines[0]["code"])
+}
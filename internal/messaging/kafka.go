@@ -0,0 +1,86 @@
+package messaging
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// KafkaConfig says where booking events are published
+type KafkaConfig struct {
+	Brokers []string
+	Topic   string
+	// Timeout limits how long publishing an event may take
+	Timeout time.Duration
+}
+
+// messageWriter is the part of kafka.Writer the publisher uses
+type messageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+// KafkaPublisher publishes booking events to a Kafka topic, keyed by booking
+// ID so that each booking's events stay in order on one partition
+type KafkaPublisher struct {
+	writer  messageWriter
+	timeout time.Duration
+}
+
+var _ service.EventPublisher = (*KafkaPublisher)(nil)
+
+// NewKafkaPublisher creates a publisher writing to the topic. Connections
+// are made on the first publish.
+func NewKafkaPublisher(config KafkaConfig) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(config.Brokers...),
+			Topic:        config.Topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			// Events are published one at a time while a request waits
+			BatchTimeout: 10 * time.Millisecond,
+		},
+		timeout: config.Timeout,
+	}
+}
+
+// Publish writes an event to the topic, waiting for all in-sync replicas to
+// store it
+func (p *KafkaPublisher) Publish(ctx context.Context, event service.BookingEvent) error {
+	body, err := encode(event)
+	if err != nil {
+		return err
+	}
+
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	err = p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.Booking.ID.Hex()),
+		Value: body,
+		Time:  event.OccurredAt,
+		Headers: []kafka.Header{
+			{Key: "id", Value: []byte(event.ID)},
+			{Key: "type", Value: []byte(event.Type.String())},
+			{Key: "content-type", Value: []byte(ContentType)},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to write booking event to Kafka")
+	}
+
+	return nil
+}
+
+// Close flushes pending writes and closes the connections to the brokers
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
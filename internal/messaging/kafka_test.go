@@ -0,0 +1,68 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// fakeWriter records the messages it's given
+type fakeWriter struct {
+	messages []kafka.Message
+	err      error
+}
+
+func (w *fakeWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.messages = append(w.messages, msgs...)
+	return nil
+}
+
+func (w *fakeWriter) Close() error {
+	return nil
+}
+
+func TestKafkaPublisher_Publish(t *testing.T) {
+	writer := &fakeWriter{}
+	p := &KafkaPublisher{writer: writer, timeout: time.Second}
+
+	booking := &model.Booking{ID: primitive.NewObjectID(), UserID: "alice", BarberID: "barber1"}
+	occurredAt := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	err := p.Publish(context.Background(), service.BookingEvent{
+		ID:         "event1",
+		Type:       service.BookingCancelled,
+		Booking:    booking,
+		OccurredAt: occurredAt,
+		Tenant:     "acme",
+	})
+	require.NoError(t, err)
+	require.Len(t, writer.messages, 1)
+
+	msg := writer.messages[0]
+	assert.Equal(t, booking.ID.Hex(), string(msg.Key))
+	assert.Equal(t, occurredAt, msg.Time)
+	assert.Contains(t, msg.Headers, kafka.Header{Key: "type", Value: []byte("booking.cancelled")})
+
+	var body Message
+	require.NoError(t, json.Unmarshal(msg.Value, &body))
+	assert.Equal(t, "event1", body.ID)
+	assert.Equal(t, "booking.cancelled", body.Type)
+	assert.Equal(t, "acme", body.TenantID)
+	assert.Equal(t, occurredAt, body.OccurredAt)
+	assert.Equal(t, "alice", body.Booking.UserID)
+
+	writer.err = errors.New("leader not available")
+	assert.Error(t, p.Publish(context.Background(), service.BookingEvent{Type: service.BookingCreated, Booking: booking}))
+}
@@ -0,0 +1,49 @@
+// Package messaging publishes booking events to message brokers, so that
+// other services (notifications, analytics) can follow booking lifecycles
+// without calling the booking service.
+package messaging
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// Publisher is an event publisher holding connections to a broker
+type Publisher interface {
+	service.EventPublisher
+	Close() error
+}
+
+// ContentType is the encoding of published messages
+const ContentType = "application/json"
+
+// Message is the JSON body of a published booking event. Its ID is unique
+// per event, so consumers can drop messages delivered twice. TenantID is the
+// shop the booking belongs to, omitted for the default shop.
+type Message struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	TenantID   string         `json:"tenantId,omitempty"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Booking    *model.Booking `json:"booking"`
+}
+
+// encode returns the JSON body of an event
+func encode(event service.BookingEvent) ([]byte, error) {
+	body, err := json.Marshal(Message{
+		ID:         event.ID,
+		Type:       event.Type.String(),
+		TenantID:   event.Tenant,
+		OccurredAt: event.OccurredAt,
+		Booking:    event.Booking,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode booking event")
+	}
+	return body, nil
+}
@@ -0,0 +1,116 @@
+package messaging
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// NATSConfig says where booking events are published
+type NATSConfig struct {
+	URL string
+	// Stream is the JetStream stream capturing the events. It's created for
+	// the subjects under SubjectPrefix if it doesn't exist.
+	Stream string
+	// SubjectPrefix namespaces the subjects, e.g. "bookings" publishes
+	// booking.created events to bookings.created
+	SubjectPrefix string
+	// Timeout limits how long publishing an event may take
+	Timeout time.Duration
+}
+
+// msgPublisher is the part of jetstream.JetStream the publisher uses
+type msgPublisher interface {
+	PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error)
+}
+
+// NATSPublisher publishes booking events to NATS JetStream, one subject per
+// event type
+type NATSPublisher struct {
+	conn    *nats.Conn
+	js      msgPublisher
+	prefix  string
+	timeout time.Duration
+}
+
+var _ service.EventPublisher = (*NATSPublisher)(nil)
+
+// NewNATSPublisher connects to NATS and makes sure the stream exists
+func NewNATSPublisher(ctx context.Context, config NATSConfig) (*NATSPublisher, error) {
+	conn, err := nats.Connect(config.URL, nats.Name("booking-service"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to NATS")
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to open JetStream")
+	}
+
+	if config.Stream != "" {
+		_, err := js.Stream(ctx, config.Stream)
+		if errors.Is(err, jetstream.ErrStreamNotFound) {
+			_, err = js.CreateStream(ctx, jetstream.StreamConfig{
+				Name:     config.Stream,
+				Subjects: []string{config.SubjectPrefix + ".>"},
+			})
+		}
+		if err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "failed to set up stream %s", config.Stream)
+		}
+	}
+
+	return &NATSPublisher{
+		conn:    conn,
+		js:      js,
+		prefix:  config.SubjectPrefix,
+		timeout: config.Timeout,
+	}, nil
+}
+
+// Publish sends an event to its subject and waits for JetStream to store it.
+// The event ID is the message ID, so JetStream drops republished duplicates.
+func (p *NATSPublisher) Publish(ctx context.Context, event service.BookingEvent) error {
+	body, err := encode(event)
+	if err != nil {
+		return err
+	}
+
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	msg := nats.NewMsg(p.subject(event.Type))
+	msg.Data = body
+	msg.Header.Set("Content-Type", ContentType)
+	msg.Header.Set("Booking-Id", event.Booking.ID.Hex())
+
+	if _, err := p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(event.ID)); err != nil {
+		return errors.Wrap(err, "failed to publish booking event to NATS")
+	}
+
+	return nil
+}
+
+// subject returns the subject of an event type, e.g. bookings.created
+func (p *NATSPublisher) subject(eventType service.BookingEventType) string {
+	return p.prefix + "." + strings.TrimPrefix(eventType.String(), "booking.")
+}
+
+// Close closes the connection to NATS
+func (p *NATSPublisher) Close() error {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// fakeJetStream records the messages it's given
+type fakeJetStream struct {
+	messages []*nats.Msg
+}
+
+func (js *fakeJetStream) PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	js.messages = append(js.messages, msg)
+	return &jetstream.PubAck{Stream: "BOOKINGS"}, nil
+}
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	js := &fakeJetStream{}
+	p := &NATSPublisher{js: js, prefix: "bookings"}
+
+	booking := &model.Booking{ID: primitive.NewObjectID(), UserID: "alice"}
+	for _, eventType := range []service.BookingEventType{service.BookingCreated, service.BookingRescheduled} {
+		err := p.Publish(context.Background(), service.BookingEvent{ID: "event1", Type: eventType, Booking: booking})
+		require.NoError(t, err)
+	}
+
+	require.Len(t, js.messages, 2)
+	assert.Equal(t, "bookings.created", js.messages[0].Subject)
+	assert.Equal(t, "bookings.rescheduled", js.messages[1].Subject)
+	assert.Equal(t, booking.ID.Hex(), js.messages[0].Header.Get("Booking-Id"))
+
+	var body Message
+	require.NoError(t, json.Unmarshal(js.messages[0].Data, &body))
+	assert.Equal(t, "booking.created", body.Type)
+	assert.Equal(t, "alice", body.Booking.UserID)
+}
@@ -0,0 +1,152 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.mongodb.org/mongo-driver/event"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics collects RPC and MongoDB metrics in its own Prometheus registry
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcHandled  *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+
+	mongoDuration *prometheus.HistogramVec
+	mongoInFlight prometheus.Gauge
+	mongoRetries  prometheus.Counter
+	mongoBreaker  *prometheus.GaugeVec
+}
+
+// breakerStates are the states MongoBreakerState reports
+var breakerStates = []string{"closed", "half-open", "open"}
+
+// New creates the metrics, including the Go runtime and process collectors
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "RPCs completed on the server, by method and status code.",
+		}, []string{"service", "method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Time taken to handle RPCs, by method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"service", "method"}),
+		mongoDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "mongodb_command_duration_seconds",
+			Help:    "Time taken by MongoDB commands, by command and outcome.",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"command", "outcome"}),
+		mongoInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "mongodb_commands_in_flight",
+			Help: "MongoDB commands currently running.",
+		}),
+		mongoRetries: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "mongodb_operation_retries_total",
+			Help: "MongoDB operations retried after a transient error.",
+		}),
+		mongoBreaker: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "mongodb_circuit_breaker_state",
+			Help: "1 for the state the MongoDB circuit breaker is in, and 0 for the others.",
+		}, []string{"state"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcHandled,
+		m.rpcDuration,
+		m.mongoDuration,
+		m.mongoInFlight,
+		m.mongoRetries,
+		m.mongoBreaker,
+	)
+
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// UnaryInterceptor records the outcome and duration of unary RPCs. It should
+// run first, so calls refused by later interceptors are counted too.
+func (m *Metrics) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.observeRPC(info.FullMethod, err, time.Since(start))
+
+	return resp, err
+}
+
+// StreamInterceptor is the streaming counterpart of UnaryInterceptor
+func (m *Metrics) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observeRPC(info.FullMethod, err, time.Since(start))
+
+	return err
+}
+
+// observeRPC records a completed RPC
+func (m *Metrics) observeRPC(fullMethod string, err error, duration time.Duration) {
+	service, method := splitMethod(fullMethod)
+	m.rpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	m.rpcDuration.WithLabelValues(service, method).Observe(duration.Seconds())
+}
+
+// CommandMonitor returns a MongoDB command monitor recording the duration
+// of every command the client runs, and how many are running
+func (m *Metrics) CommandMonitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, evt *event.CommandStartedEvent) {
+			m.mongoInFlight.Inc()
+		},
+		Succeeded: func(ctx context.Context, evt *event.CommandSucceededEvent) {
+			m.mongoInFlight.Dec()
+			m.mongoDuration.WithLabelValues(evt.CommandName, "success").Observe(evt.Duration.Seconds())
+		},
+		Failed: func(ctx context.Context, evt *event.CommandFailedEvent) {
+			m.mongoInFlight.Dec()
+			m.mongoDuration.WithLabelValues(evt.CommandName, "failure").Observe(evt.Duration.Seconds())
+		},
+	}
+}
+
+// MongoRetried counts a MongoDB operation retried after err
+func (m *Metrics) MongoRetried(err error) {
+	m.mongoRetries.Inc()
+}
+
+// MongoBreakerState records the state the MongoDB circuit breaker is in:
+// closed, half-open or open
+func (m *Metrics) MongoBreakerState(state string) {
+	for _, s := range breakerStates {
+		value := 0.0
+		if s == state {
+			value = 1
+		}
+		m.mongoBreaker.WithLabelValues(s).Set(value)
+	}
+}
+
+// splitMethod splits "/package.Service/Method" into its service and method
+func splitMethod(fullMethod string) (string, string) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "unknown", name
+}
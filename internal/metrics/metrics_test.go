@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/event"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	m := New()
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/GetBooking"}
+
+	_, _ = m.UnaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	_, _ = m.UnaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "booking not found")
+	})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.rpcHandled.WithLabelValues("booking.BookingService", "GetBooking", "OK")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.rpcHandled.WithLabelValues("booking.BookingService", "GetBooking", "NotFound")))
+	assert.Equal(t, 1, testutil.CollectAndCount(m.rpcDuration))
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	m := New()
+	info := &grpc.StreamServerInfo{FullMethod: "/booking.BookingService/WatchBookings"}
+
+	_ = m.StreamInterceptor(nil, nil, info, func(srv interface{}, ss grpc.ServerStream) error {
+		return status.Error(codes.Canceled, "client went away")
+	})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.rpcHandled.WithLabelValues("booking.BookingService", "WatchBookings", "Canceled")))
+}
+
+func TestCommandMonitor(t *testing.T) {
+	m := New()
+	monitor := m.CommandMonitor()
+
+	monitor.Started(context.Background(), &event.CommandStartedEvent{CommandName: "find"})
+	monitor.Started(context.Background(), &event.CommandStartedEvent{CommandName: "insert"})
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.mongoInFlight))
+
+	monitor.Succeeded(context.Background(), &event.CommandSucceededEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "find", Duration: 3 * time.Millisecond},
+	})
+	monitor.Failed(context.Background(), &event.CommandFailedEvent{
+		CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "insert", Duration: time.Millisecond},
+	})
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.mongoInFlight))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.mongoDuration))
+}
+
+func TestMongoBreakerState(t *testing.T) {
+	m := New()
+	m.MongoBreakerState("open")
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.mongoBreaker.WithLabelValues("open")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.mongoBreaker.WithLabelValues("closed")))
+
+	m.MongoBreakerState("closed")
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.mongoBreaker.WithLabelValues("open")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.mongoBreaker.WithLabelValues("closed")))
+}
+
+func TestHandler(t *testing.T) {
+	m := New()
+	m.observeRPC("/booking.BookingService/CreateBooking", nil, time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body := rec.Body.String()
+	assert.True(t, strings.Contains(body, `grpc_server_handled_total{code="OK",method="CreateBooking",service="booking.BookingService"} 1`))
+	assert.True(t, strings.Contains(body, "go_goroutines"))
+}
+
+func TestSplitMethod(t *testing.T) {
+	service, method := splitMethod("/grpc.health.v1.Health/Check")
+	assert.Equal(t, "grpc.health.v1.Health", service)
+	assert.Equal(t, "Check", method)
+
+	service, method = splitMethod("Check")
+	assert.Equal(t, "unknown", service)
+	assert.Equal(t, "Check", method)
+}
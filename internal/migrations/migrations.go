@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// All is every migration, in order. Append new ones with the next version;
+// never change or remove one that has shipped.
+var All = []Migration{
+	{
+		Version:     1,
+		Description: "Give bookings stored with a single service type the list of service types",
+		Up:          backfillServiceTypes,
+	},
+}
+
+// backfillServiceTypes sets the service types of bookings stored before they
+// could have several to their one service type. It can't be undone, as the
+// backfilled bookings can't be told apart afterwards.
+func backfillServiceTypes(ctx context.Context, db *mongo.Database) error {
+	filter := bson.M{"serviceTypes": bson.M{"$exists": false}}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"serviceTypes": bson.A{"$serviceType"}}}},
+	}
+
+	if _, err := db.Collection("bookings").UpdateMany(ctx, filter, update); err != nil {
+		return errors.Wrap(err, "failed to backfill service types")
+	}
+
+	return nil
+}
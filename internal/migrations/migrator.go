@@ -0,0 +1,223 @@
+// Package migrations changes stored data in ordered, versioned steps as the
+// model evolves, recording the steps applied to each database in its
+// schema_migrations collection
+package migrations
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ErrIrreversible is returned when migrating down past a migration that
+// can't be undone
+var ErrIrreversible = errors.New("migration can't be undone")
+
+// Migration is one versioned change to the stored data
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database) error
+	Down        func(ctx context.Context, db *mongo.Database) error // Nil if it can't be undone
+}
+
+// Record is a migration applied to a database
+type Record struct {
+	Version     int       `bson:"_id"`
+	Description string    `bson:"description"`
+	AppliedAt   time.Time `bson:"appliedAt"`
+}
+
+// Migrator applies migrations to a database. Only one should run against a
+// database at a time.
+type Migrator struct {
+	db         *mongo.Database
+	records    *mongo.Collection
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator for db, checking that the migrations are
+// in order of increasing positive versions
+func NewMigrator(db *mongo.Database, migrations []Migration) (*Migrator, error) {
+	if err := validate(migrations); err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		records:    db.Collection("schema_migrations"),
+		migrations: migrations,
+	}, nil
+}
+
+// Status returns the migrations applied to the database, oldest first, and
+// those still pending
+func (m *Migrator) Status(ctx context.Context) ([]Record, []Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending, err := planUp(m.migrations, applied, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return applied, pending, nil
+}
+
+// Up applies, oldest first, the pending migrations up to and including the
+// target version, or all of them if target is 0. It returns the migrations
+// applied, stopping at the first that fails.
+func (m *Migrator) Up(ctx context.Context, target int) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planUp(m.migrations, applied, target)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range plan {
+		if err := migration.Up(ctx, m.db); err != nil {
+			return done, errors.Wrapf(err, "migration %d failed", migration.Version)
+		}
+
+		record := Record{Version: migration.Version, Description: migration.Description, AppliedAt: time.Now()}
+		if _, err := m.records.InsertOne(ctx, record); err != nil {
+			return done, errors.Wrapf(err, "failed to record migration %d", migration.Version)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Down undoes, newest first, the applied migrations after the target
+// version. It returns the migrations undone, stopping at the first that
+// fails. Nothing is undone if one of them is irreversible.
+func (m *Migrator) Down(ctx context.Context, target int) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planDown(m.migrations, applied, target)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range plan {
+		if err := migration.Down(ctx, m.db); err != nil {
+			return done, errors.Wrapf(err, "undoing migration %d failed", migration.Version)
+		}
+
+		if _, err := m.records.DeleteOne(ctx, bson.M{"_id": migration.Version}); err != nil {
+			return done, errors.Wrapf(err, "failed to unrecord migration %d", migration.Version)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// applied returns the migrations recorded in the database, oldest first
+func (m *Migrator) applied(ctx context.Context) ([]Record, error) {
+	cursor, err := m.records.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list applied migrations")
+	}
+	defer cursor.Close(ctx)
+
+	var records []Record
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, errors.Wrap(err, "failed to decode applied migrations")
+	}
+
+	return records, nil
+}
+
+// validate checks that migrations are in order of increasing positive
+// versions and can be applied
+func validate(migrations []Migration) error {
+	previous := 0
+	for _, migration := range migrations {
+		if migration.Version <= previous {
+			return errors.Errorf("migration %d is out of order", migration.Version)
+		}
+		if migration.Up == nil {
+			return errors.Errorf("migration %d has no up step", migration.Version)
+		}
+		previous = migration.Version
+	}
+	return nil
+}
+
+// planUp returns the migrations not yet applied up to the target version, or
+// all of them if target is 0, oldest first. A database with migrations this
+// build doesn't know was migrated by a newer one, and isn't touched.
+func planUp(migrations []Migration, applied []Record, target int) ([]Migration, error) {
+	done, err := appliedVersions(migrations, applied)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan []Migration
+	for _, migration := range migrations {
+		if target > 0 && migration.Version > target {
+			break
+		}
+		if !done[migration.Version] {
+			plan = append(plan, migration)
+		}
+	}
+	return plan, nil
+}
+
+// planDown returns the applied migrations after the target version, newest
+// first, or ErrIrreversible if one of them can't be undone
+func planDown(migrations []Migration, applied []Record, target int) ([]Migration, error) {
+	done, err := appliedVersions(migrations, applied)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan []Migration
+	for _, migration := range migrations {
+		if migration.Version > target && done[migration.Version] {
+			if migration.Down == nil {
+				return nil, errors.Wrapf(ErrIrreversible, "migration %d", migration.Version)
+			}
+			plan = append(plan, migration)
+		}
+	}
+	sort.Slice(plan, func(i, j int) bool { return plan[i].Version > plan[j].Version })
+	return plan, nil
+}
+
+// appliedVersions returns the set of applied versions, failing if one isn't
+// among the migrations
+func appliedVersions(migrations []Migration, applied []Record) (map[int]bool, error) {
+	known := make(map[int]bool, len(migrations))
+	for _, migration := range migrations {
+		known[migration.Version] = true
+	}
+
+	done := make(map[int]bool, len(applied))
+	for _, record := range applied {
+		if !known[record.Version] {
+			return nil, errors.Errorf("database has migration %d, which this build doesn't know", record.Version)
+		}
+		done[record.Version] = true
+	}
+	return done, nil
+}
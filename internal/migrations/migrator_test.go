@@ -0,0 +1,71 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func noop(context.Context, *mongo.Database) error { return nil }
+
+func testMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Description: "first", Up: noop, Down: noop},
+		{Version: 2, Description: "second", Up: noop},
+		{Version: 3, Description: "third", Up: noop, Down: noop},
+	}
+}
+
+func versions(migrations []Migration) []int {
+	result := make([]int, len(migrations))
+	for i, migration := range migrations {
+		result[i] = migration.Version
+	}
+	return result
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, validate(All))
+	assert.NoError(t, validate(testMigrations()))
+
+	assert.Error(t, validate([]Migration{{Version: 2, Up: noop}, {Version: 1, Up: noop}}), "out of order")
+	assert.Error(t, validate([]Migration{{Version: 1, Up: noop}, {Version: 1, Up: noop}}), "duplicate version")
+	assert.Error(t, validate([]Migration{{Version: 0, Up: noop}}), "zero version")
+	assert.Error(t, validate([]Migration{{Version: 1}}), "no up step")
+}
+
+func TestPlanUp(t *testing.T) {
+	plan, err := planUp(testMigrations(), nil, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, versions(plan))
+
+	plan, err = planUp(testMigrations(), []Record{{Version: 1}}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int{2}, versions(plan), "stops at the target")
+
+	plan, err = planUp(testMigrations(), []Record{{Version: 1}, {Version: 2}, {Version: 3}}, 0)
+	require.NoError(t, err)
+	assert.Empty(t, plan)
+
+	_, err = planUp(testMigrations(), []Record{{Version: 4}}, 0)
+	assert.Error(t, err, "a newer build's migration")
+}
+
+func TestPlanDown(t *testing.T) {
+	all := []Record{{Version: 1}, {Version: 2}, {Version: 3}}
+
+	plan, err := planDown(testMigrations(), all, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int{3}, versions(plan))
+
+	_, err = planDown(testMigrations(), all, 1)
+	assert.True(t, errors.Is(err, ErrIrreversible), "migration 2 can't be undone")
+
+	plan, err = planDown(testMigrations(), []Record{{Version: 1}}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, versions(plan))
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// AgendaGap is free time in a barber's working hours between bookings
+type AgendaGap struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Duration returns how long the gap lasts
+func (g *AgendaGap) Duration() time.Duration {
+	return g.End.Sub(g.Start)
+}
+
+// DailyAgenda is a barber's bookings on one of their days in the order they
+// start, with the free time left in their working hours
+type DailyAgenda struct {
+	BarberID string       `json:"barberId"`
+	Date     time.Time    `json:"date"` // Midnight in the barber's time zone
+	Bookings []*Booking   `json:"bookings"`
+	Gaps     []*AgendaGap `json:"gaps"`
+}
+
+// FreeTimeOn returns the parts of the barber's shifts on the day starting at
+// midnight that aren't taken by breaks or the busy bookings, including their
+// cleanup time, in order
+func (s *BarberSchedule) FreeTimeOn(midnight time.Time, busy []*Booking) []*AgendaGap {
+	at := func(minute int) time.Time {
+		return time.Date(midnight.Year(), midnight.Month(), midnight.Day(), 0, minute, 0, 0, midnight.Location())
+	}
+
+	var taken []AgendaGap
+	for _, b := range s.Breaks {
+		taken = append(taken, AgendaGap{Start: at(b.StartMinute), End: at(b.EndMinute)})
+	}
+	for _, booking := range busy {
+		taken = append(taken, AgendaGap{Start: booking.StartTime, End: booking.OccupiedUntil()})
+	}
+	sort.Slice(taken, func(i, j int) bool {
+		return taken[i].Start.Before(taken[j].Start)
+	})
+
+	shifts := s.HoursOn(midnight.Weekday())
+	sort.Slice(shifts, func(i, j int) bool {
+		return shifts[i].StartMinute < shifts[j].StartMinute
+	})
+
+	var gaps []*AgendaGap
+	for _, h := range shifts {
+		free, end := at(h.StartMinute), at(h.EndMinute)
+		for _, t := range taken {
+			if !t.End.After(free) || !t.Start.Before(end) {
+				continue
+			}
+			if t.Start.After(free) {
+				gaps = append(gaps, &AgendaGap{Start: free, End: t.Start})
+			}
+			if t.End.After(free) {
+				free = t.End
+			}
+		}
+		if end.After(free) {
+			gaps = append(gaps, &AgendaGap{Start: free, End: end})
+		}
+	}
+	return gaps
+}
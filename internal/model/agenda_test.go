@@ -0,0 +1,43 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeTimeOn(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	monday := time.Date(2025, time.June, 2, 0, 0, 0, 0, loc)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, time.June, 2, hour, minute, 0, 0, loc)
+	}
+
+	schedule := &BarberSchedule{
+		BarberID: "barber1",
+		Hours: []WorkingHours{
+			{Weekday: time.Monday, StartMinute: 14 * 60, EndMinute: 18 * 60},
+			{Weekday: time.Monday, StartMinute: 9 * 60, EndMinute: 12 * 60},
+		},
+		Breaks:   []BreakPeriod{{StartMinute: 16 * 60, EndMinute: 16*60 + 30}},
+		Timezone: "Europe/Berlin",
+	}
+	busy := []*Booking{
+		{StartTime: at(9, 0), EndTime: at(9, 30), ServiceType: ServiceTypeHaircut},
+		// Full services are followed by ten minutes of cleanup
+		{StartTime: at(10, 0), EndTime: at(11, 0), ServiceType: ServiceTypeFullService},
+		{StartTime: at(15, 30), EndTime: at(16, 30), ServiceType: ServiceTypeHaircut},
+	}
+
+	assert.Equal(t, []*AgendaGap{
+		{Start: at(9, 30), End: at(10, 0)},
+		{Start: at(11, 10), End: at(12, 0)},
+		{Start: at(14, 0), End: at(15, 30)},
+		{Start: at(16, 30), End: at(18, 0)},
+	}, schedule.FreeTimeOn(monday, busy))
+
+	// Days off have no free time
+	assert.Empty(t, schedule.FreeTimeOn(monday.AddDate(0, 0, 1), nil))
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// AuditEntry records one authenticated call that changed something
+type AuditEntry struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Method   string             `bson:"method" json:"method"` // Full gRPC method name
+	ActorID  string             `bson:"actorId" json:"actorId"`
+	IsBarber bool               `bson:"isBarber,omitempty" json:"isBarber,omitempty"`
+	IsAdmin  bool               `bson:"isAdmin,omitempty" json:"isAdmin,omitempty"`
+	Request  string             `bson:"request,omitempty" json:"request,omitempty"` // JSON summary, possibly truncated
+	Code     string             `bson:"code" json:"code"`                           // gRPC status code, e.g. "OK"
+	Error    string             `bson:"error,omitempty" json:"error,omitempty"`
+	Duration time.Duration      `bson:"duration" json:"duration"`
+	At       time.Time          `bson:"at" json:"at"`
+}
+
+// AuditFilter selects audit entries. Empty fields match everything; the
+// time range is [From, To).
+type AuditFilter struct {
+	ActorID string
+	Method  string
+	From    *time.Time
+	To      *time.Time
+	Limit   int
+}
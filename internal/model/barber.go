@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// Barber is a barber's public profile, keyed by the user ID bookings refer to
+// them by. Inactive barbers can't be booked and have no free slots. The
+// services they offer are set separately, as their BarberServices.
+//
+// Capacity is how many clients the barber takes at once, for "barbers" that
+// are really a shop resource such as a pair of chairs with an assistant.
+// Zero means one, like barbers without a profile.
+type Barber struct {
+	ID          string    `bson:"_id" json:"id"`
+	DisplayName string    `bson:"displayName" json:"displayName"`
+	Bio         string    `bson:"bio,omitempty" json:"bio,omitempty"`
+	PhotoURL    string    `bson:"photoUrl,omitempty" json:"photoUrl,omitempty"`
+	Active      bool      `bson:"active" json:"active"`
+	Capacity    int       `bson:"capacity,omitempty" json:"capacity,omitempty"`
+	CreatedAt   time.Time `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time `bson:"updatedAt" json:"updatedAt"`
+
+	// Services are the services the barber offers, filled in when the
+	// profile is read
+	Services []*OfferedService `bson:"-" json:"services,omitempty"`
+	// Rating is the average of the barber's reviews, filled in when the
+	// profile is read if reviews are stored
+	Rating *BarberRating `bson:"-" json:"rating,omitempty"`
+}
+
+// ClientCapacity returns how many overlapping bookings the barber can take
+func (b *Barber) ClientCapacity() int {
+	if b.Capacity < 1 {
+		return 1
+	}
+	return b.Capacity
+}
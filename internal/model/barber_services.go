@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+// BarberServices is the set of services a barber offers, with optional
+// per-barber durations and prices. Barbers without any offer every service in
+// the catalog.
+type BarberServices struct {
+	BarberID  string          `bson:"_id" json:"barberId"`
+	Services  []BarberService `bson:"services" json:"services"`
+	UpdatedAt time.Time       `bson:"updatedAt" json:"updatedAt"`
+	UpdatedBy string          `bson:"updatedBy" json:"updatedBy"`
+}
+
+// BarberService is one service a barber offers. Zero duration or price means
+// the catalog's.
+type BarberService struct {
+	ServiceType     ServiceType `bson:"serviceType" json:"serviceType"`
+	DurationMinutes int         `bson:"durationMinutes,omitempty" json:"durationMinutes,omitempty"`
+	Price           int64       `bson:"price,omitempty" json:"price,omitempty"` // In minor currency units (e.g. cents)
+}
+
+// OfferedService is a service as a particular barber offers it, with their
+// overrides applied to the catalog's name, duration and price
+type OfferedService struct {
+	ServiceType     ServiceType `json:"serviceType"`
+	Name            string      `json:"name"`
+	DurationMinutes int         `json:"durationMinutes"`
+	Price           int64       `json:"price"`
+	CleanupMinutes  int         `json:"cleanupMinutes"` // From the catalog; barbers can't change it
+}
+
+// Duration returns how long the service takes with this barber
+func (o *OfferedService) Duration() time.Duration {
+	return time.Duration(o.DurationMinutes) * time.Minute
+}
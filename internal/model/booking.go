@@ -1,6 +1,7 @@
 package model
 
 import (
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -20,26 +21,111 @@ const (
 	ServiceTypeFullService
 )
 
+// BuiltInServiceTypes are the services offered when the catalog doesn't say otherwise
+var BuiltInServiceTypes = []ServiceType{ServiceTypeHaircut, ServiceTypeBeardTrim, ServiceTypeHairWash, ServiceTypeFullService}
+
 // Constants for BookingStatus
 const (
 	BookingStatusPending BookingStatus = iota
 	BookingStatusConfirmed
 	BookingStatusCancelled
 	BookingStatusCompleted
+	BookingStatusNoShow
+	// BookingStatusHeld is a slot reserved while the customer finishes
+	// booking it, until HoldExpiresAt
+	BookingStatusHeld
 )
 
 // Booking represents a barbershop appointment
 type Booking struct {
-	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	UserID      string             `bson:"userId" json:"userId"`
-	BarberID    string             `bson:"barberId" json:"barberId"`
-	StartTime   time.Time          `bson:"startTime" json:"startTime"`
-	EndTime     time.Time          `bson:"endTime" json:"endTime"`
-	ServiceType ServiceType        `bson:"serviceType" json:"serviceType"`
-	Status      BookingStatus      `bson:"status" json:"status"`
-	Notes       string             `bson:"notes,omitempty" json:"notes,omitempty"`
-	CreatedAt   time.Time          `bson:"createdAt" json:"createdAt"`
-	UpdatedAt   time.Time          `bson:"updatedAt" json:"updatedAt"`
+	ID              primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID          string             `bson:"userId" json:"userId"`
+	BarberID        string             `bson:"barberId" json:"barberId"`
+	StartTime       time.Time          `bson:"startTime" json:"startTime"`
+	EndTime         time.Time          `bson:"endTime" json:"endTime"`
+	ServiceType     ServiceType        `bson:"serviceType" json:"serviceType"`                           // First of ServiceTypes
+	ServiceTypes    []ServiceType      `bson:"serviceTypes,omitempty" json:"serviceTypes,omitempty"`     // All services, in the order they're done
+	CleanupMinutes  *int               `bson:"cleanupMinutes,omitempty" json:"cleanupMinutes,omitempty"` // Needed after the services, as the catalog set it when booked
+	Status          BookingStatus      `bson:"status" json:"status"`
+	Price           int64              `bson:"price,omitempty" json:"price,omitempty"` // Quoted when booked less any discount, in minor currency units
+	Currency        string             `bson:"currency,omitempty" json:"currency,omitempty"`
+	PromoCode       string             `bson:"promoCode,omitempty" json:"promoCode,omitempty"`
+	Discount        int64              `bson:"discount,omitempty" json:"discount,omitempty"` // Taken off Price by the promo code
+	Notes           string             `bson:"notes,omitempty" json:"notes,omitempty"`
+	ExternalRef     string             `bson:"externalRef,omitempty" json:"externalRef,omitempty"`
+	IdempotencyKey  string             `bson:"idempotencyKey,omitempty" json:"-"`
+	Payment         *Payment           `bson:"payment,omitempty" json:"payment,omitempty"`
+	Deposit         *Deposit           `bson:"deposit,omitempty" json:"deposit,omitempty"`
+	Cancellation    *Cancellation      `bson:"cancellation,omitempty" json:"cancellation,omitempty"`
+	Attachments     []*Attachment      `bson:"attachments,omitempty" json:"attachments,omitempty"`
+	Anonymized      bool               `bson:"anonymized,omitempty" json:"anonymized,omitempty"`
+	CheckedInAt     *time.Time         `bson:"checkedInAt,omitempty" json:"checkedInAt,omitempty"`
+	ReleasedAt      *time.Time         `bson:"releasedAt,omitempty" json:"releasedAt,omitempty"` // Rest of the slot freed for a late customer
+	ReminderSentAt  *time.Time         `bson:"reminderSentAt,omitempty" json:"reminderSentAt,omitempty"`
+	ReviewFlaggedAt *time.Time         `bson:"reviewFlaggedAt,omitempty" json:"reviewFlaggedAt,omitempty"`
+	RescheduledFrom *time.Time         `bson:"rescheduledFrom,omitempty" json:"rescheduledFrom,omitempty"`
+	RescheduledAt   *time.Time         `bson:"rescheduledAt,omitempty" json:"rescheduledAt,omitempty"`
+	HoldExpiresAt   *time.Time         `bson:"holdExpiresAt,omitempty" json:"holdExpiresAt,omitempty"`
+	DeletedAt       *time.Time         `bson:"deletedAt,omitempty" json:"deletedAt,omitempty"` // Soft-deleted, until purged
+	Version         int64              `bson:"version" json:"version"`
+	CreatedAt       time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt       time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// Payment records how a booking was settled at the point of sale.
+// Amounts are in minor currency units (e.g. cents).
+type Payment struct {
+	Amount           int64         `bson:"amount" json:"amount"`
+	Tip              int64         `bson:"tip" json:"tip"`
+	Currency         string        `bson:"currency" json:"currency"`
+	RenderedServices []ServiceType `bson:"renderedServices" json:"renderedServices"`
+	Discrepancies    []string      `bson:"discrepancies,omitempty" json:"discrepancies,omitempty"`
+	PaidAt           time.Time     `bson:"paidAt" json:"paidAt"`
+}
+
+// Cancellation records who cancelled a booking, when, why, and the fee the
+// cancellation policy charged. Fees are in minor currency units (e.g. cents).
+type Cancellation struct {
+	CancelledAt time.Time `bson:"cancelledAt" json:"cancelledAt"`
+	CancelledBy string    `bson:"cancelledBy,omitempty" json:"cancelledBy,omitempty"`
+	Reason      string    `bson:"reason,omitempty" json:"reason,omitempty"`
+	Fee         int64     `bson:"fee" json:"fee"`
+	Currency    string    `bson:"currency,omitempty" json:"currency,omitempty"`
+}
+
+// DepositStatus is the state of a booking's online deposit
+type DepositStatus string
+
+// Deposit statuses
+const (
+	DepositStatusPending DepositStatus = "pending"
+	DepositStatusPaid    DepositStatus = "paid"
+	DepositStatusExpired DepositStatus = "expired"
+)
+
+// Deposit is a prepayment collected online when a booking is made. Bookings
+// stay pending until it's paid and are cancelled if it isn't paid in time.
+// Amounts are in minor currency units (e.g. cents).
+type Deposit struct {
+	PaymentIntentID string        `bson:"paymentIntentId" json:"paymentIntentId"`
+	ClientSecret    string        `bson:"clientSecret" json:"-"`
+	Amount          int64         `bson:"amount" json:"amount"`
+	Currency        string        `bson:"currency" json:"currency"`
+	Status          DepositStatus `bson:"status" json:"status"`
+	ExpiresAt       time.Time     `bson:"expiresAt" json:"expiresAt"`
+	PaidAt          *time.Time    `bson:"paidAt,omitempty" json:"paidAt,omitempty"`
+}
+
+// Attachment is a reference image attached to a booking, e.g. the desired style.
+// StorageKey is set for files uploaded to the service's own storage and is
+// empty for external URLs.
+type Attachment struct {
+	ID          string    `bson:"id" json:"id"`
+	URL         string    `bson:"url" json:"url"`
+	StorageKey  string    `bson:"storageKey,omitempty" json:"-"`
+	ContentType string    `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	Size        int64     `bson:"size,omitempty" json:"size,omitempty"`
+	CreatedAt   time.Time `bson:"createdAt" json:"createdAt"`
 }
 
 // TimeSlot represents an available time slot for booking
@@ -48,6 +134,48 @@ type TimeSlot struct {
 	EndTime   time.Time `json:"endTime"`
 }
 
+// DaySlots holds the available time slots on one day
+type DaySlots struct {
+	Date      time.Time   `json:"date"` // Midnight at the start of the day
+	TimeSlots []*TimeSlot `json:"timeSlots"`
+}
+
+// String returns a human readable name for the service type
+func (s ServiceType) String() string {
+	switch s {
+	case ServiceTypeHaircut:
+		return "haircut"
+	case ServiceTypeBeardTrim:
+		return "beard trim"
+	case ServiceTypeHairWash:
+		return "hair wash"
+	case ServiceTypeFullService:
+		return "full service"
+	default:
+		return "unknown"
+	}
+}
+
+// String returns a human-readable name for the booking status
+func (s BookingStatus) String() string {
+	switch s {
+	case BookingStatusPending:
+		return "pending"
+	case BookingStatusConfirmed:
+		return "confirmed"
+	case BookingStatusCancelled:
+		return "cancelled"
+	case BookingStatusCompleted:
+		return "completed"
+	case BookingStatusNoShow:
+		return "no-show"
+	case BookingStatusHeld:
+		return "held"
+	default:
+		return "unknown"
+	}
+}
+
 // GetDuration returns the duration for a service type in minutes
 func (s ServiceType) GetDuration() int {
 	switch s {
@@ -64,8 +192,127 @@ func (s ServiceType) GetDuration() int {
 	}
 }
 
-// CalculateEndTime calculates the end time based on the start time and service type
-func CalculateEndTime(startTime time.Time, serviceType ServiceType) time.Time {
-	duration := serviceType.GetDuration()
+// GetCleanupBuffer returns the built-in cleanup time needed after a service
+// type in minutes, used unless the catalog sets one
+func (s ServiceType) GetCleanupBuffer() int {
+	switch s {
+	case ServiceTypeFullService:
+		return 10
+	default:
+		return 0
+	}
+}
+
+// MaxCleanupMinutes is the longest cleanup buffer a service can have
+const MaxCleanupMinutes = 120
+
+// MaxCleanupBuffer bounds how far any booking's cleanup buffer runs past its
+// end, for widening searches so earlier bookings whose buffer runs into a
+// window are found
+const MaxCleanupBuffer = MaxCleanupMinutes * time.Minute
+
+// CalculateOccupiedUntil calculates when the barber is free again after
+// services ending at endTime that need cleanupMinutes of cleanup
+func CalculateOccupiedUntil(endTime time.Time, cleanupMinutes int) time.Time {
+	return endTime.Add(time.Minute * time.Duration(cleanupMinutes))
+}
+
+// Services returns all of the booking's services, including for bookings
+// stored before they could have more than one
+func (b *Booking) Services() []ServiceType {
+	if len(b.ServiceTypes) > 0 {
+		return b.ServiceTypes
+	}
+	return []ServiceType{b.ServiceType}
+}
+
+// DescribeServices returns a human readable list of services, e.g.
+// "haircut + beard trim"
+func DescribeServices(serviceTypes []ServiceType) string {
+	names := make([]string, len(serviceTypes))
+	for i, serviceType := range serviceTypes {
+		names[i] = serviceType.String()
+	}
+	return strings.Join(names, " + ")
+}
+
+// Cleanup returns the minutes of cleanup the barber needs after the booking:
+// the longest of its services' buffers when it was booked, or their built-in
+// ones for bookings stored before they recorded it
+func (b *Booking) Cleanup() int {
+	if b.CleanupMinutes != nil {
+		return *b.CleanupMinutes
+	}
+
+	longest := 0
+	for _, serviceType := range b.Services() {
+		longest = max(longest, serviceType.GetCleanupBuffer())
+	}
+	return longest
+}
+
+// OccupiedUntil returns when the barber is free again after this booking.
+// Released bookings stop occupying the barber when they were released.
+func (b *Booking) OccupiedUntil() time.Time {
+	if b.ReleasedAt != nil {
+		return CalculateOccupiedUntil(*b.ReleasedAt, b.Cleanup())
+	}
+	return CalculateOccupiedUntil(b.EndTime, b.Cleanup())
+}
+
+// Overlaps reports whether the booking, including its cleanup buffer,
+// overlaps the half-open interval [start, end)
+func (b *Booking) Overlaps(start, end time.Time) bool {
+	return start.Before(b.OccupiedUntil()) && end.After(b.StartTime)
+}
+
+// HoldExpired reports whether the booking is a hold that lapsed by now
+func (b *Booking) HoldExpired(now time.Time) bool {
+	return b.Status == BookingStatusHeld && b.HoldExpiresAt != nil && !now.Before(*b.HoldExpiresAt)
+}
+
+// FitsCapacity reports whether a booking occupying [start, occupiedUntil) can
+// be added to bookings without more than capacity of them, cleanup buffers
+// included, overlapping at any moment
+func FitsCapacity(bookings []*Booking, start, occupiedUntil time.Time, capacity int) bool {
+	var overlapping []*Booking
+	for _, booking := range bookings {
+		if booking.Overlaps(start, occupiedUntil) {
+			overlapping = append(overlapping, booking)
+		}
+	}
+	if len(overlapping) < capacity {
+		return true
+	}
+
+	// The most bookings overlap at the window's start or where one of them
+	// starts within it
+	instants := []time.Time{start}
+	for _, booking := range overlapping {
+		if booking.StartTime.After(start) {
+			instants = append(instants, booking.StartTime)
+		}
+	}
+	for _, instant := range instants {
+		concurrent := 0
+		for _, booking := range overlapping {
+			if !instant.Before(booking.StartTime) && instant.Before(booking.OccupiedUntil()) {
+				concurrent++
+			}
+		}
+		if concurrent >= capacity {
+			return false
+		}
+	}
+	return true
+}
+
+// CalculateEndTime calculates the end time based on the start time and the
+// services done back to back
+func CalculateEndTime(startTime time.Time, serviceTypes ...ServiceType) time.Time {
+	duration := 0
+	for _, serviceType := range serviceTypes {
+		duration += serviceType.GetDuration()
+	}
 	return startTime.Add(time.Minute * time.Duration(duration))
 }
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// BookingAction is what was done to a booking
+type BookingAction string
+
+// Constants for BookingAction
+const (
+	BookingActionCreated         BookingAction = "created"
+	BookingActionUpdated         BookingAction = "updated"
+	BookingActionRescheduled     BookingAction = "rescheduled"
+	BookingActionConfirmed       BookingAction = "confirmed"
+	BookingActionCompleted       BookingAction = "completed"
+	BookingActionCancelled       BookingAction = "cancelled"
+	BookingActionNoShow          BookingAction = "no_show"
+	BookingActionCheckedIn       BookingAction = "checked_in"
+	BookingActionReleased        BookingAction = "released"
+	BookingActionAttachmentAdded BookingAction = "attachment_added"
+	BookingActionDepositPaid     BookingAction = "deposit_paid"
+	BookingActionPaid            BookingAction = "paid"
+	BookingActionDeleted         BookingAction = "deleted"
+)
+
+// SystemActor is recorded as the actor of changes made by background jobs
+// and webhooks rather than by a signed-in user
+const SystemActor = "system"
+
+// BookingHistoryEntry records one change to a booking
+type BookingHistoryEntry struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	BookingID string             `bson:"bookingId" json:"bookingId"`
+	Action    BookingAction      `bson:"action" json:"action"`
+	ActorID   string             `bson:"actorId" json:"actorId"`
+	Changes   []FieldChange      `bson:"changes,omitempty" json:"changes,omitempty"`
+	At        time.Time          `bson:"at" json:"at"`
+}
+
+// FieldChange is a booking field's value before and after a change, JSON
+// encoded. An empty value means the field wasn't set.
+type FieldChange struct {
+	Field  string `bson:"field" json:"field"`
+	Before string `bson:"before,omitempty" json:"before,omitempty"`
+	After  string `bson:"after,omitempty" json:"after,omitempty"`
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBookingOverlaps_CleanupBuffer(t *testing.T) {
+	start := time.Date(2025, time.April, 1, 10, 0, 0, 0, time.UTC)
+
+	fullService := &Booking{
+		StartTime:   start,
+		EndTime:     CalculateEndTime(start, ServiceTypeFullService),
+		ServiceType: ServiceTypeFullService,
+	}
+	haircut := &Booking{
+		StartTime:   start,
+		EndTime:     CalculateEndTime(start, ServiceTypeHaircut),
+		ServiceType: ServiceTypeHaircut,
+	}
+	cleanup := 15
+	recorded := &Booking{
+		StartTime:      start,
+		EndTime:        CalculateEndTime(start, ServiceTypeHaircut),
+		ServiceType:    ServiceTypeHaircut,
+		CleanupMinutes: &cleanup,
+	}
+
+	tests := []struct {
+		name    string
+		booking *Booking
+		start   time.Time
+		end     time.Time
+		want    bool
+	}{
+		{"during service", fullService, start.Add(30 * time.Minute), start.Add(45 * time.Minute), true},
+		{"during cleanup buffer", fullService, start.Add(65 * time.Minute), start.Add(95 * time.Minute), true},
+		{"after cleanup buffer", fullService, start.Add(70 * time.Minute), start.Add(100 * time.Minute), false},
+		{"right after service without buffer", haircut, start.Add(30 * time.Minute), start.Add(60 * time.Minute), false},
+		{"during recorded buffer", recorded, start.Add(40 * time.Minute), start.Add(70 * time.Minute), true},
+		{"after recorded buffer", recorded, start.Add(45 * time.Minute), start.Add(75 * time.Minute), false},
+		{"ending at start", haircut, start.Add(-30 * time.Minute), start, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.booking.Overlaps(tt.start, tt.end))
+		})
+	}
+}
+
+func TestBookingServices_Combined(t *testing.T) {
+	start := time.Date(2025, time.April, 1, 10, 0, 0, 0, time.UTC)
+
+	services := []ServiceType{ServiceTypeHaircut, ServiceTypeBeardTrim, ServiceTypeFullService}
+	booking := &Booking{
+		StartTime:    start,
+		EndTime:      CalculateEndTime(start, services...),
+		ServiceType:  services[0],
+		ServiceTypes: services,
+	}
+
+	// 30 + 15 + 60 minutes back to back, then the full service's cleanup buffer
+	assert.Equal(t, start.Add(105*time.Minute), booking.EndTime)
+	assert.Equal(t, start.Add(115*time.Minute), booking.OccupiedUntil())
+	assert.Equal(t, "haircut + beard trim + full service", DescribeServices(booking.Services()))
+
+	// Bookings stored with a single service type
+	legacy := &Booking{ServiceType: ServiceTypeHairWash}
+	assert.Equal(t, []ServiceType{ServiceTypeHairWash}, legacy.Services())
+}
+
+func TestFitsCapacity(t *testing.T) {
+	start := time.Date(2025, time.April, 1, 10, 0, 0, 0, time.UTC)
+	haircut := func(offset time.Duration) *Booking {
+		return &Booking{
+			StartTime:   start.Add(offset),
+			EndTime:     CalculateEndTime(start.Add(offset), ServiceTypeHaircut),
+			ServiceType: ServiceTypeHaircut,
+		}
+	}
+	// A 10:00 to 11:00 window
+	end := start.Add(time.Hour)
+
+	// A 9:45 haircut whose customer didn't turn up, released at 9:50
+	released := haircut(-15 * time.Minute)
+	releasedAt := start.Add(-10 * time.Minute)
+	released.ReleasedAt = &releasedAt
+
+	tests := []struct {
+		name     string
+		bookings []*Booking
+		capacity int
+		want     bool
+	}{
+		{"free", nil, 1, true},
+		{"one booking, one seat", []*Booking{haircut(0)}, 1, false},
+		{"one booking, two seats", []*Booking{haircut(0)}, 2, true},
+		{"back to back bookings never overlap each other", []*Booking{haircut(0), haircut(30 * time.Minute)}, 2, true},
+		{"two at once", []*Booking{haircut(0), haircut(15 * time.Minute)}, 2, false},
+		{"outside the window", []*Booking{haircut(-time.Hour), haircut(time.Hour)}, 1, true},
+		{"released before the window", []*Booking{released}, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FitsCapacity(tt.bookings, start, end, tt.capacity))
+		})
+	}
+}
+
+func BenchmarkFitsCapacity(b *testing.B) {
+	// A day of half-hour haircuts with two chairs, mostly full
+	day := time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC)
+	var bookings []*Booking
+	for i := 0; i < 36; i++ {
+		start := day.Add(time.Duration(i/2) * 30 * time.Minute)
+		bookings = append(bookings, &Booking{
+			StartTime:   start,
+			EndTime:     CalculateEndTime(start, ServiceTypeHaircut),
+			ServiceType: ServiceTypeHaircut,
+		})
+	}
+	start := day.Add(4 * time.Hour)
+	occupiedUntil := CalculateOccupiedUntil(start.Add(time.Hour), ServiceTypeFullService.GetCleanupBuffer())
+
+	b.ReportAllocs()
+	for b.Loop() {
+		FitsCapacity(bookings, start, occupiedUntil, 2)
+	}
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// CatalogService is a service the shop offers, keyed by its service type. Its
+// duration and cleanup buffer take precedence over the built-in ones when
+// booking, and inactive services can't be booked.
+type CatalogService struct {
+	ServiceType     ServiceType `bson:"_id" json:"serviceType"`
+	Name            string      `bson:"name" json:"name"`
+	DurationMinutes int         `bson:"durationMinutes" json:"durationMinutes"`
+	Price           int64       `bson:"price" json:"price"`                                       // In minor currency units (e.g. cents)
+	CleanupMinutes  *int        `bson:"cleanupMinutes,omitempty" json:"cleanupMinutes,omitempty"` // Unset uses the built-in buffer
+	Active          bool        `bson:"active" json:"active"`
+	CreatedAt       time.Time   `bson:"createdAt" json:"createdAt"`
+	UpdatedAt       time.Time   `bson:"updatedAt" json:"updatedAt"`
+}
+
+// Duration returns how long the service takes
+func (c *CatalogService) Duration() time.Duration {
+	return time.Duration(c.DurationMinutes) * time.Minute
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// CustomerSummary sums up a customer's visits, i.e. their completed bookings
+type CustomerSummary struct {
+	UserID       string      `json:"userId"`
+	Visits       int         `json:"visits"`
+	FirstVisit   *time.Time  `json:"firstVisit,omitempty"`
+	LastVisit    *time.Time  `json:"lastVisit,omitempty"`
+	UsualService ServiceType `json:"usualService"` // The first service they book most often
+}
+
+// AverageInterval returns the average time between the customer's visits,
+// or zero before their second visit
+func (s *CustomerSummary) AverageInterval() time.Duration {
+	if s.Visits < 2 {
+		return 0
+	}
+	return s.LastVisit.Sub(*s.FirstVisit) / time.Duration(s.Visits-1)
+}
+
+// NextVisitDue returns when the customer would be back if they kept to their
+// average interval, or nil before their second visit
+func (s *CustomerSummary) NextVisitDue() *time.Time {
+	interval := s.AverageInterval()
+	if interval <= 0 {
+		return nil
+	}
+	due := s.LastVisit.Add(interval)
+	return &due
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomerSummaryIntervals(t *testing.T) {
+	first := time.Date(2025, time.January, 1, 10, 0, 0, 0, time.UTC)
+	last := time.Date(2025, time.March, 2, 10, 0, 0, 0, time.UTC)
+
+	summary := &CustomerSummary{UserID: "user1", Visits: 3, FirstVisit: &first, LastVisit: &last}
+	assert.Equal(t, 30*24*time.Hour, summary.AverageInterval())
+	assert.Equal(t, last.AddDate(0, 0, 30), *summary.NextVisitDue())
+
+	// One visit doesn't make an interval
+	once := &CustomerSummary{UserID: "user2", Visits: 1, FirstVisit: &first, LastVisit: &first}
+	assert.Zero(t, once.AverageInterval())
+	assert.Nil(t, once.NextVisitDue())
+
+	assert.Nil(t, (&CustomerSummary{UserID: "user3"}).NextVisitDue())
+}
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// FavoriteBarber is a barber a customer marked as a favorite
+type FavoriteBarber struct {
+	UserID   string    `bson:"userId" json:"userId"`
+	BarberID string    `bson:"barberId" json:"barberId"`
+	AddedAt  time.Time `bson:"addedAt" json:"addedAt"`
+
+	// Barber is the barber's profile, if they have one. It's looked up
+	// rather than stored.
+	Barber *Barber `bson:"-" json:"barber,omitempty"`
+}
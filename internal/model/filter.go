@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// BookingSortField selects the field bookings are sorted by
+type BookingSortField int
+
+// Constants for BookingSortField
+const (
+	SortByStartTime BookingSortField = iota
+	SortByCreatedAt
+	SortByUpdatedAt
+)
+
+// BookingFilter selects bookings for listing. Empty fields don't filter.
+type BookingFilter struct {
+	UserID       string
+	BarberID     string
+	Statuses     []BookingStatus
+	ServiceTypes []ServiceType
+	StartFrom    *time.Time // Inclusive
+	StartBefore  *time.Time // Exclusive
+	SortBy       BookingSortField
+	Descending   bool
+	// NeedsReview only selects confirmed bookings flagged for review after
+	// ending without a check-in, overriding Statuses
+	NeedsReview bool
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Lookback bounds of a demand heatmap, in weeks
+const (
+	DefaultHeatmapWeeks = 12
+	MaxHeatmapWeeks     = 52
+)
+
+// DemandHeatmap counts past bookings by the weekday and hour they started
+// at, so a shop can staff its busy hours. Only bookings that took up a
+// barber's time are counted.
+type DemandHeatmap struct {
+	BarberID    string     `json:"barberId,omitempty"` // The whole shop if empty
+	Start       time.Time  `json:"start"`              // First day covered
+	End         time.Time  `json:"end"`                // Day after the last
+	Weeks       int        `json:"weeks"`              // How many weeks Start to End is
+	Timezone    string     `json:"timezone"`           // Weekdays and hours are taken in it
+	Counts      [7][24]int `json:"counts"`             // Indexed by time.Weekday, then hour
+	GeneratedAt time.Time  `json:"generatedAt"`
+}
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// HolidayDateFormat is the layout of holiday dates
+const HolidayDateFormat = "2006-01-02"
+
+// Holiday is a date the whole shop is closed. The date is a calendar day,
+// independent of time zone.
+type Holiday struct {
+	Date      string    `bson:"_id" json:"date"`
+	Name      string    `bson:"name,omitempty" json:"name,omitempty"`
+	CreatedAt time.Time `bson:"createdAt" json:"createdAt"`
+	CreatedBy string    `bson:"createdBy,omitempty" json:"createdBy,omitempty"`
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OutboxEvent is a booking event stored in the same transaction as the
+// change it describes, until it has been published to the message broker
+type OutboxEvent struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	EventID     string             `bson:"eventId"`
+	Type        string             `bson:"type"` // E.g. "booking.created"
+	Booking     *Booking           `bson:"booking"`
+	OccurredAt  time.Time          `bson:"occurredAt"`
+	PublishedAt *time.Time         `bson:"publishedAt,omitempty"`
+	Attempts    int                `bson:"attempts"`
+	LastError   string             `bson:"lastError,omitempty"`
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// PayrollEntry summarises one barber's earnings for a payroll period.
+// Amounts are in minor currency units (e.g. cents).
+type PayrollEntry struct {
+	BarberID          string  `bson:"barberId" json:"barberId"`
+	CompletedBookings int     `bson:"completedBookings" json:"completedBookings"`
+	HoursWorked       float64 `bson:"hoursWorked" json:"hoursWorked"`
+	Revenue           int64   `bson:"revenue" json:"revenue"`
+	Commission        int64   `bson:"commission" json:"commission"`
+	Tips              int64   `bson:"tips" json:"tips"`
+}
+
+// PayrollPeriod holds the payroll entries for a calendar month. Once
+// finalized, the entries are a locked snapshot and are no longer recomputed.
+type PayrollPeriod struct {
+	ID             string          `bson:"_id" json:"id"` // "YYYY-MM"
+	Year           int             `bson:"year" json:"year"`
+	Month          time.Month      `bson:"month" json:"month"`
+	Currency       string          `bson:"currency" json:"currency"`
+	CommissionRate float64         `bson:"commissionRate" json:"commissionRate"`
+	Entries        []*PayrollEntry `bson:"entries" json:"entries"`
+	Finalized      bool            `bson:"finalized" json:"finalized"`
+	FinalizedAt    *time.Time      `bson:"finalizedAt,omitempty" json:"finalizedAt,omitempty"`
+	GeneratedAt    time.Time       `bson:"generatedAt" json:"generatedAt"`
+}
+
+// PayrollPeriodID returns the identifier of the payroll period for a month
+func PayrollPeriodID(year int, month time.Month) string {
+	return fmt.Sprintf("%04d-%02d", year, int(month))
+}
+
+// PayrollPeriodRange returns the UTC start (inclusive) and end (exclusive) of a payroll month
+func PayrollPeriodRange(year int, month time.Month) (time.Time, time.Time) {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// PromoCode is a code customers enter when booking to get a discount. It
+// takes either a percentage or a fixed amount off the booking's price.
+type PromoCode struct {
+	Code       string     `bson:"_id" json:"code"`                                  // Upper case
+	PercentOff int        `bson:"percentOff,omitempty" json:"percentOff,omitempty"` // 1-100
+	AmountOff  int64      `bson:"amountOff,omitempty" json:"amountOff,omitempty"`   // In minor currency units (e.g. cents)
+	ValidFrom  *time.Time `bson:"validFrom,omitempty" json:"validFrom,omitempty"`
+	ValidUntil *time.Time `bson:"validUntil,omitempty" json:"validUntil,omitempty"` // Exclusive
+	MaxUses    int        `bson:"maxUses,omitempty" json:"maxUses,omitempty"`       // Unlimited if zero
+	Uses       int        `bson:"uses" json:"uses"`                                 // Bookings made with the code
+	Active     bool       `bson:"active" json:"active"`
+	CreatedAt  time.Time  `bson:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time  `bson:"updatedAt" json:"updatedAt"`
+}
+
+// NormalizePromoCode returns code the way it's stored, so customers needn't
+// match its case
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ValidAt reports whether the code is active at t. It doesn't check whether
+// it's used up.
+func (p *PromoCode) ValidAt(t time.Time) bool {
+	if !p.Active {
+		return false
+	}
+	if p.ValidFrom != nil && t.Before(*p.ValidFrom) {
+		return false
+	}
+	return p.ValidUntil == nil || t.Before(*p.ValidUntil)
+}
+
+// Discount returns how much the code takes off price, never more than the
+// price itself. Percentages are rounded down to the minor unit.
+func (p *PromoCode) Discount(price int64) int64 {
+	discount := p.AmountOff
+	if p.PercentOff > 0 {
+		discount = price * int64(p.PercentOff) / 100
+	}
+	return min(discount, price)
+}
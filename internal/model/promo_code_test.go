@@ -0,0 +1,42 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoCodeDiscount(t *testing.T) {
+	percent := &PromoCode{Code: "SPRING15", PercentOff: 15}
+	assert.Equal(t, int64(375), percent.Discount(2500))
+	// Rounded down to the cent
+	assert.Equal(t, int64(149), percent.Discount(999))
+
+	amount := &PromoCode{Code: "FIVEOFF", AmountOff: 500}
+	assert.Equal(t, int64(500), amount.Discount(2500))
+	// Never more than the price
+	assert.Equal(t, int64(300), amount.Discount(300))
+	assert.Zero(t, amount.Discount(0))
+}
+
+func TestPromoCodeValidAt(t *testing.T) {
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	promo := &PromoCode{Code: "SPRING15", PercentOff: 15, ValidFrom: &from, ValidUntil: &until, Active: true}
+
+	assert.False(t, promo.ValidAt(from.Add(-time.Second)))
+	assert.True(t, promo.ValidAt(from))
+	assert.True(t, promo.ValidAt(until.Add(-time.Second)))
+	assert.False(t, promo.ValidAt(until))
+
+	promo.Active = false
+	assert.False(t, promo.ValidAt(from))
+
+	// Open-ended codes
+	assert.True(t, (&PromoCode{Code: "ALWAYS", AmountOff: 100, Active: true}).ValidAt(until))
+}
+
+func TestNormalizePromoCode(t *testing.T) {
+	assert.Equal(t, "SPRING15", NormalizePromoCode(" spring15 "))
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// Quote is what a prospective booking would cost and how long it would take
+type Quote struct {
+	BarberID string            `json:"barberId"`
+	Services []*OfferedService `json:"services"` // In the order they'd be done
+	Price    int64             `json:"price"`    // In minor currency units (e.g. cents)
+	Currency string            `json:"currency"`
+}
+
+// Duration returns how long the services take back to back
+func (q *Quote) Duration() time.Duration {
+	var duration time.Duration
+	for _, service := range q.Services {
+		duration += service.Duration()
+	}
+	return duration
+}
+
+// Cleanup returns the minutes of cleanup needed after the services, the
+// longest of their buffers
+func (q *Quote) Cleanup() int {
+	longest := 0
+	for _, service := range q.Services {
+		longest = max(longest, service.CleanupMinutes)
+	}
+	return longest
+}
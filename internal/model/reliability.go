@@ -0,0 +1,101 @@
+package model
+
+import "time"
+
+// UserReliability summarizes how a customer's bookings turned out
+type UserReliability struct {
+	UserID    string `json:"userId"`
+	Bookings  int    `json:"bookings"`
+	Completed int    `json:"completed"`
+	Cancelled int    `json:"cancelled"`
+	NoShows   int    `json:"noShows"`
+}
+
+// NoShowRate returns the share of the customer's attended or missed bookings
+// they didn't turn up for, between 0 and 1
+func (r *UserReliability) NoShowRate() float64 {
+	return noShowRate(r.Completed, r.NoShows)
+}
+
+// ReliabilityGroup is who a reliability report's rows are about
+type ReliabilityGroup int
+
+const (
+	// ReliabilityByUser has a row per customer
+	ReliabilityByUser ReliabilityGroup = iota
+	// ReliabilityByBarber has a row per barber
+	ReliabilityByBarber
+)
+
+// ReliabilitySort is the rate a reliability report's rows are ordered by,
+// highest first
+type ReliabilitySort int
+
+const (
+	// SortByNoShowRate puts the most missed bookings first
+	SortByNoShowRate ReliabilitySort = iota
+	// SortByCancellationRate puts the most cancelled bookings first
+	SortByCancellationRate
+)
+
+// ReliabilityFilter selects the bookings a reliability report covers and
+// which of its rows are returned
+type ReliabilityFilter struct {
+	GroupBy     ReliabilityGroup
+	BarberID    string    // Only this barber's bookings, if set
+	Start       time.Time // Bookings starting in [Start, End). The service
+	End         time.Time // takes the first and last calendar days instead.
+	MinBookings int       // Rows with fewer bookings are left out
+	SortBy      ReliabilitySort
+	Limit       int
+}
+
+// ReliabilityRow summarizes how the bookings of a customer or barber turned
+// out in a period. Holds aren't counted.
+type ReliabilityRow struct {
+	ID        string `bson:"_id" json:"id"` // The user or barber ID
+	Bookings  int    `bson:"bookings" json:"bookings"`
+	Completed int    `bson:"completed" json:"completed"`
+	Cancelled int    `bson:"cancelled" json:"cancelled"`
+	NoShows   int    `bson:"noShows" json:"noShows"`
+}
+
+// NoShowRate returns the share of attended or missed bookings that were
+// missed, between 0 and 1
+func (r *ReliabilityRow) NoShowRate() float64 {
+	return noShowRate(r.Completed, r.NoShows)
+}
+
+// CancellationRate returns the share of bookings that were cancelled,
+// between 0 and 1
+func (r *ReliabilityRow) CancellationRate() float64 {
+	if r.Bookings == 0 {
+		return 0
+	}
+	return float64(r.Cancelled) / float64(r.Bookings)
+}
+
+// noShowRate returns the share of finished bookings that were missed
+func noShowRate(completed, noShows int) float64 {
+	finished := completed + noShows
+	if finished == 0 {
+		return 0
+	}
+	return float64(noShows) / float64(finished)
+}
+
+// Before reports whether row a comes before row b: the higher rate first,
+// then the more bookings it counts, then by ID
+func (s ReliabilitySort) Before(a, b *ReliabilityRow) bool {
+	rateA, rateB, countA, countB := a.NoShowRate(), b.NoShowRate(), a.NoShows, b.NoShows
+	if s == SortByCancellationRate {
+		rateA, rateB, countA, countB = a.CancellationRate(), b.CancellationRate(), a.Cancelled, b.Cancelled
+	}
+	if rateA != rateB {
+		return rateA > rateB
+	}
+	if countA != countB {
+		return countA > countB
+	}
+	return a.ID < b.ID
+}
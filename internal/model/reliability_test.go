@@ -0,0 +1,31 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReliabilityRowRates(t *testing.T) {
+	row := &ReliabilityRow{Bookings: 10, Completed: 6, Cancelled: 2, NoShows: 2}
+	assert.InDelta(t, 0.25, row.NoShowRate(), 1e-9)
+	assert.InDelta(t, 0.2, row.CancellationRate(), 1e-9)
+
+	empty := &ReliabilityRow{}
+	assert.Zero(t, empty.NoShowRate())
+	assert.Zero(t, empty.CancellationRate())
+}
+
+func TestReliabilitySortBefore(t *testing.T) {
+	flaky := &ReliabilityRow{ID: "a", Bookings: 4, Completed: 2, NoShows: 2}
+	canceller := &ReliabilityRow{ID: "b", Bookings: 4, Completed: 1, Cancelled: 3}
+	frequentFlaky := &ReliabilityRow{ID: "c", Bookings: 8, Completed: 4, NoShows: 4}
+
+	assert.True(t, SortByNoShowRate.Before(flaky, canceller))
+	assert.False(t, SortByNoShowRate.Before(canceller, flaky))
+	assert.True(t, SortByCancellationRate.Before(canceller, flaky))
+
+	// Equal rates put the row counting more no-shows first, then go by ID
+	assert.True(t, SortByNoShowRate.Before(frequentFlaky, flaky))
+	assert.False(t, SortByNoShowRate.Before(flaky, flaky))
+}
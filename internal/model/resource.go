@@ -0,0 +1,54 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// Resource is shared equipment some services can't be done without, e.g. a
+// wash station. A booking for any of its service types, with any barber,
+// takes one of its units for as long as the booking occupies the barber, so
+// no more than Capacity such bookings can overlap.
+type Resource struct {
+	ID           string        `bson:"_id" json:"id"` // e.g. "wash-station"
+	Name         string        `bson:"name" json:"name"`
+	Capacity     int           `bson:"capacity" json:"capacity"`         // How many units the shop has
+	ServiceTypes []ServiceType `bson:"serviceTypes" json:"serviceTypes"` // The services that need a unit
+	CreatedAt    time.Time     `bson:"createdAt" json:"createdAt"`
+	UpdatedAt    time.Time     `bson:"updatedAt" json:"updatedAt"`
+}
+
+// NeededFor reports whether any of the services need the resource
+func (r *Resource) NeededFor(serviceTypes ...ServiceType) bool {
+	for _, serviceType := range serviceTypes {
+		if slices.Contains(r.ServiceTypes, serviceType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Users returns the bookings that take a unit of the resource
+func (r *Resource) Users(bookings []*Booking) []*Booking {
+	var users []*Booking
+	for _, booking := range bookings {
+		if r.NeededFor(booking.Services()...) {
+			users = append(users, booking)
+		}
+	}
+	return users
+}
+
+// ResourceServiceTypes returns every service type that needs one of the
+// resources
+func ResourceServiceTypes(resources []*Resource) []ServiceType {
+	var serviceTypes []ServiceType
+	for _, resource := range resources {
+		for _, serviceType := range resource.ServiceTypes {
+			if !slices.Contains(serviceTypes, serviceType) {
+				serviceTypes = append(serviceTypes, serviceType)
+			}
+		}
+	}
+	return serviceTypes
+}
@@ -0,0 +1,98 @@
+package model
+
+import "time"
+
+// RevenueGranularity is the length of the periods a revenue report is
+// broken down into
+type RevenueGranularity int
+
+const (
+	// RevenueByDay reports each day
+	RevenueByDay RevenueGranularity = iota
+	// RevenueByWeek reports each week from Monday to Sunday
+	RevenueByWeek
+	// RevenueByMonth reports each calendar month
+	RevenueByMonth
+)
+
+// PeriodStart returns the midnight starting the period that contains t, in
+// t's location
+func (g RevenueGranularity) PeriodStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch g {
+	case RevenueByWeek:
+		return startOfWeek(day)
+	case RevenueByMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+// RevenueFilter selects the completed bookings a revenue report covers
+type RevenueFilter struct {
+	BarberID    string    // All barbers if empty
+	First       time.Time // Calendar days, both included, in the shop's time zone
+	Last        time.Time
+	Granularity RevenueGranularity
+}
+
+// RevenueLine is the revenue of one barber's bookings for one service in a
+// period. Bookings with several services count under each of them, their
+// amounts split evenly between them. Amounts are in minor currency units
+// (e.g. cents).
+type RevenueLine struct {
+	PeriodStart time.Time   `json:"periodStart"`
+	BarberID    string      `json:"barberId"`
+	ServiceType ServiceType `json:"serviceType"`
+	Bookings    int         `json:"bookings"`
+	Revenue     int64       `json:"revenue"`
+	Tips        int64       `json:"tips"`
+}
+
+// RevenueReport breaks down the revenue of completed bookings by period,
+// barber and service. Amounts are in minor currency units (e.g. cents).
+type RevenueReport struct {
+	Start       time.Time          `json:"start"`
+	End         time.Time          `json:"end"` // Exclusive
+	Granularity RevenueGranularity `json:"granularity"`
+	Currency    string             `json:"currency"`
+	Lines       []*RevenueLine     `json:"lines"` // By period, then barber, then service
+	Bookings    int                `json:"bookings"`
+	Revenue     int64              `json:"revenue"`
+	Tips        int64              `json:"tips"`
+}
+
+// RevenueTotal is a revenue line's share in one currency
+type RevenueTotal struct {
+	RevenueLine
+	Currency string
+}
+
+// RevenueTotals is what the completed bookings starting in a time range
+// earned, summed up for a revenue report
+type RevenueTotals struct {
+	Bookings int // Each booking once, however many services it had
+	Lines    []*RevenueTotal
+}
+
+// RevenueServices returns the services a completed booking's revenue is
+// split between: those rendered at the point of sale if it recorded them,
+// else the ones booked
+func (b *Booking) RevenueServices() []ServiceType {
+	if b.Payment != nil && len(b.Payment.RenderedServices) > 0 {
+		return b.Payment.RenderedServices
+	}
+	return b.Services()
+}
+
+// SplitAmount splits an amount evenly into n shares, the first taking what
+// doesn't divide evenly
+func SplitAmount(amount int64, n int) []int64 {
+	shares := make([]int64, n)
+	for i := range shares {
+		shares[i] = amount / int64(n)
+	}
+	shares[0] += amount % int64(n)
+	return shares
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitAmount(t *testing.T) {
+	assert.Equal(t, []int64{3000}, SplitAmount(3000, 1))
+	assert.Equal(t, []int64{1667, 1666, 1666}, SplitAmount(4999, 3))
+	assert.Equal(t, []int64{0, 0}, SplitAmount(0, 2))
+}
+
+func TestBookingRevenueServices(t *testing.T) {
+	booking := &Booking{ServiceType: ServiceTypeHaircut}
+	assert.Equal(t, []ServiceType{ServiceTypeHaircut}, booking.RevenueServices())
+
+	booking.ServiceTypes = []ServiceType{ServiceTypeHaircut, ServiceTypeBeardTrim}
+	assert.Equal(t, []ServiceType{ServiceTypeHaircut, ServiceTypeBeardTrim}, booking.RevenueServices())
+
+	// A payment without rendered services keeps the booked ones
+	booking.Payment = &Payment{Amount: 4000}
+	assert.Equal(t, []ServiceType{ServiceTypeHaircut, ServiceTypeBeardTrim}, booking.RevenueServices())
+
+	booking.Payment.RenderedServices = []ServiceType{ServiceTypeHaircut, ServiceTypeHairWash}
+	assert.Equal(t, []ServiceType{ServiceTypeHaircut, ServiceTypeHairWash}, booking.RevenueServices())
+}
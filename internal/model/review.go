@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Limits on reviews
+const (
+	MinReviewRating = 1
+	MaxReviewRating = 5
+)
+
+// Review is a customer's rating of a completed booking, at most one per
+// booking
+type Review struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	BookingID string             `bson:"bookingId" json:"bookingId"`
+	UserID    string             `bson:"userId" json:"userId"` // Empty once anonymized
+	BarberID  string             `bson:"barberId" json:"barberId"`
+	Rating    int                `bson:"rating" json:"rating"` // 1-5
+	Comment   string             `bson:"comment,omitempty" json:"comment,omitempty"`
+	CreatedAt time.Time          `bson:"createdAt" json:"createdAt"`
+}
+
+// BarberRating is the average of a barber's review ratings
+type BarberRating struct {
+	BarberID      string  `bson:"_id" json:"barberId"`
+	Reviews       int     `bson:"reviews" json:"reviews"`
+	AverageRating float64 `bson:"averageRating" json:"averageRating"` // Zero without reviews
+}
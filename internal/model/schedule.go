@@ -0,0 +1,126 @@
+package model
+
+import "time"
+
+// Default working hours for barbers without a schedule, as minutes after midnight
+const (
+	DefaultWorkStartMinute = 9 * 60
+	DefaultWorkEndMinute   = 17 * 60
+)
+
+// DefaultSlotMinutes is the slot granularity for barbers who haven't chosen one
+const DefaultSlotMinutes = 30
+
+// SlotMinuteOptions are the slot granularities a barber can choose from
+var SlotMinuteOptions = []int{15, 20, 30, 60}
+
+// WorkingHours is a shift on one weekday, in minutes after midnight. A barber
+// can have several shifts on the same day, e.g. around a lunch break.
+type WorkingHours struct {
+	Weekday     time.Weekday `bson:"weekday" json:"weekday"`
+	StartMinute int          `bson:"startMinute" json:"startMinute"`
+	EndMinute   int          `bson:"endMinute" json:"endMinute"`
+}
+
+// BreakPeriod is a recurring daily break, in minutes after midnight
+type BreakPeriod struct {
+	StartMinute int `bson:"startMinute" json:"startMinute"`
+	EndMinute   int `bson:"endMinute" json:"endMinute"`
+}
+
+// BarberSchedule holds a barber's weekly working hours and daily breaks, as
+// wall-clock times in the barber's IANA time zone. Weekdays without any hours
+// are days off.
+type BarberSchedule struct {
+	BarberID       string         `bson:"_id" json:"barberId"`
+	Hours          []WorkingHours `bson:"hours" json:"hours"`
+	Breaks         []BreakPeriod  `bson:"breaks,omitempty" json:"breaks,omitempty"`
+	Timezone       string         `bson:"timezone,omitempty" json:"timezone,omitempty"`
+	SlotMinutes    int            `bson:"slotMinutes,omitempty" json:"slotMinutes,omitempty"`       // How far apart offered start times are; zero means the default
+	MinLeadMinutes int            `bson:"minLeadMinutes,omitempty" json:"minLeadMinutes,omitempty"` // How soon a booking may start; zero means the shop policy
+	MaxAdvanceDays int            `bson:"maxAdvanceDays,omitempty" json:"maxAdvanceDays,omitempty"` // How far ahead a booking may start; zero means the shop policy
+	UpdatedAt      time.Time      `bson:"updatedAt" json:"updatedAt"`
+	UpdatedBy      string         `bson:"updatedBy,omitempty" json:"updatedBy,omitempty"`
+}
+
+// DefaultBarberSchedule returns the schedule used for barbers who haven't
+// configured one: 9 AM to 5 PM every day
+func DefaultBarberSchedule(barberID string) *BarberSchedule {
+	return DailySchedule(barberID, DefaultWorkStartMinute, DefaultWorkEndMinute)
+}
+
+// DailySchedule returns a schedule with the same shift every day, in minutes
+// after midnight
+func DailySchedule(barberID string, startMinute, endMinute int) *BarberSchedule {
+	hours := make([]WorkingHours, 0, 7)
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		hours = append(hours, WorkingHours{Weekday: day, StartMinute: startMinute, EndMinute: endMinute})
+	}
+	return &BarberSchedule{BarberID: barberID, Hours: hours}
+}
+
+// Location returns the schedule's time zone, UTC if unset or unknown
+func (s *BarberSchedule) Location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// SlotDuration returns how far apart offered start times are
+func (s *BarberSchedule) SlotDuration() time.Duration {
+	if s.SlotMinutes <= 0 {
+		return DefaultSlotMinutes * time.Minute
+	}
+	return time.Duration(s.SlotMinutes) * time.Minute
+}
+
+// HoursOn returns the shifts on a weekday
+func (s *BarberSchedule) HoursOn(day time.Weekday) []WorkingHours {
+	var hours []WorkingHours
+	for _, h := range s.Hours {
+		if h.Weekday == day {
+			hours = append(hours, h)
+		}
+	}
+	return hours
+}
+
+// Overlaps reports whether two shifts on the same weekday overlap
+func (h WorkingHours) Overlaps(other WorkingHours) bool {
+	return h.Weekday == other.Weekday && h.StartMinute < other.EndMinute && other.StartMinute < h.EndMinute
+}
+
+// OverlapsBreak reports whether [start, end) overlaps a daily break
+func (s *BarberSchedule) OverlapsBreak(start, end time.Time) bool {
+	loc := s.Location()
+	start = start.In(loc)
+	end = end.In(loc)
+
+	for day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc); day.Before(end); day = day.AddDate(0, 0, 1) {
+		for _, b := range s.Breaks {
+			breakStart := time.Date(day.Year(), day.Month(), day.Day(), 0, b.StartMinute, 0, 0, loc)
+			breakEnd := time.Date(day.Year(), day.Month(), day.Day(), 0, b.EndMinute, 0, 0, loc)
+			if start.Before(breakEnd) && breakStart.Before(end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WorkingTimeOn returns how long the barber works on a weekday, leaving out
+// the parts of their shifts taken by breaks
+func (s *BarberSchedule) WorkingTimeOn(day time.Weekday) time.Duration {
+	minutes := 0
+	for _, h := range s.HoursOn(day) {
+		minutes += h.EndMinute - h.StartMinute
+		for _, b := range s.Breaks {
+			if overlap := min(h.EndMinute, b.EndMinute) - max(h.StartMinute, b.StartMinute); overlap > 0 {
+				minutes -= overlap
+			}
+		}
+	}
+	return time.Duration(minutes) * time.Minute
+}
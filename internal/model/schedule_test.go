@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBarberScheduleOverlapsBreak(t *testing.T) {
+	schedule := &BarberSchedule{Breaks: []BreakPeriod{{StartMinute: 12 * 60, EndMinute: 13 * 60}}, Timezone: "Europe/Berlin"}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	day := time.Date(2025, time.April, 1, 0, 0, 0, 0, berlin)
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  bool
+	}{
+		{"before break", day.Add(11 * time.Hour), day.Add(11*time.Hour + 30*time.Minute), false},
+		{"ending at break", day.Add(11*time.Hour + 30*time.Minute), day.Add(12 * time.Hour), false},
+		{"running into break", day.Add(11*time.Hour + 45*time.Minute), day.Add(12*time.Hour + 15*time.Minute), true},
+		{"during break", day.Add(12*time.Hour + 15*time.Minute), day.Add(12*time.Hour + 45*time.Minute), true},
+		{"starting at break end", day.Add(13 * time.Hour), day.Add(13*time.Hour + 30*time.Minute), false},
+		{"break in the barber's zone", time.Date(2025, time.April, 1, 10, 0, 0, 0, time.UTC), time.Date(2025, time.April, 1, 10, 30, 0, 0, time.UTC), true},
+		{"noon in another zone", time.Date(2025, time.April, 1, 12, 0, 0, 0, time.UTC), time.Date(2025, time.April, 1, 12, 30, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, schedule.OverlapsBreak(tt.start, tt.end))
+		})
+	}
+}
+
+func TestBarberScheduleWorkingTimeOn(t *testing.T) {
+	schedule := &BarberSchedule{
+		Hours: []WorkingHours{
+			{Weekday: time.Monday, StartMinute: 9 * 60, EndMinute: 12*60 + 30},
+			{Weekday: time.Monday, StartMinute: 14 * 60, EndMinute: 18 * 60},
+			{Weekday: time.Tuesday, StartMinute: 13 * 60, EndMinute: 17 * 60},
+		},
+		Breaks: []BreakPeriod{{StartMinute: 12 * 60, EndMinute: 13 * 60}, {StartMinute: 16 * 60, EndMinute: 16*60 + 15}},
+	}
+
+	assert.Equal(t, 6*time.Hour+45*time.Minute, schedule.WorkingTimeOn(time.Monday))
+	assert.Equal(t, 3*time.Hour+45*time.Minute, schedule.WorkingTimeOn(time.Tuesday))
+	assert.Zero(t, schedule.WorkingTimeOn(time.Sunday))
+}
@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// RetentionMode determines what happens to bookings past their retention period
+type RetentionMode int
+
+// Constants for RetentionMode
+const (
+	RetentionModeAnonymize RetentionMode = iota
+	RetentionModeDelete
+)
+
+// RetentionPolicy defines how long finished bookings are kept, per status.
+// A period of zero days keeps bookings forever.
+type RetentionPolicy struct {
+	CompletedDays int           `bson:"completedDays" json:"completedDays"`
+	CancelledDays int           `bson:"cancelledDays" json:"cancelledDays"`
+	NoShowDays    int           `bson:"noShowDays" json:"noShowDays"`
+	AuditDays     int           `bson:"auditDays" json:"auditDays"` // Audit log entries, by when they were recorded
+	Mode          RetentionMode `bson:"mode" json:"mode"`
+}
+
+// CancellationRule applies to customer cancellations made less than
+// WithinMinutes before the start time
+type CancellationRule struct {
+	WithinMinutes int  `bson:"withinMinutes" json:"withinMinutes"`
+	FeePercent    int  `bson:"feePercent" json:"feePercent"` // Share of the booking's price charged
+	Forbidden     bool `bson:"forbidden" json:"forbidden"`   // Cancellations in the window are refused
+}
+
+// CancellationPolicy decides what cancelling costs depending on how close to
+// the start time it happens. Cancellations outside every rule's window are
+// free.
+type CancellationPolicy struct {
+	Rules []CancellationRule `bson:"rules" json:"rules"`
+}
+
+// RuleFor returns the rule with the narrowest window that a cancellation made
+// untilStart before the start time falls into, or nil if it's free
+func (p CancellationPolicy) RuleFor(untilStart time.Duration) *CancellationRule {
+	var applicable *CancellationRule
+	for i, rule := range p.Rules {
+		if untilStart >= time.Duration(rule.WithinMinutes)*time.Minute {
+			continue
+		}
+		if applicable == nil || rule.WithinMinutes < applicable.WithinMinutes {
+			applicable = &p.Rules[i]
+		}
+	}
+	return applicable
+}
+
+// ShopSettings holds shop-wide settings managed by admins at runtime
+type ShopSettings struct {
+	ID           string             `bson:"_id" json:"id"`
+	Retention    RetentionPolicy    `bson:"retention" json:"retention"`
+	Cancellation CancellationPolicy `bson:"cancellation" json:"cancellation"`
+	UpdatedAt    time.Time          `bson:"updatedAt" json:"updatedAt"`
+	UpdatedBy    string             `bson:"updatedBy,omitempty" json:"updatedBy,omitempty"`
+}
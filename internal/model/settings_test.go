@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancellationPolicyRuleFor(t *testing.T) {
+	policy := CancellationPolicy{Rules: []CancellationRule{
+		{WithinMinutes: 60, Forbidden: true},
+		{WithinMinutes: 24 * 60, FeePercent: 50},
+	}}
+
+	tests := []struct {
+		name       string
+		untilStart time.Duration
+		want       *CancellationRule
+	}{
+		{"more than a day ahead", 25 * time.Hour, nil},
+		{"exactly a day ahead", 24 * time.Hour, nil},
+		{"within a day", 23 * time.Hour, &policy.Rules[1]},
+		{"within an hour", 30 * time.Minute, &policy.Rules[0]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, policy.RuleFor(tt.untilStart))
+		})
+	}
+}
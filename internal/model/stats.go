@@ -0,0 +1,95 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// StatsPeriod is the span of time a barber's statistics cover
+type StatsPeriod int
+
+const (
+	// StatsPeriodWeek is a week from Monday to Sunday
+	StatsPeriodWeek StatsPeriod = iota
+	// StatsPeriodMonth is a calendar month
+	StatsPeriodMonth
+)
+
+// StatsDayFormat is the layout of the day keys bookings are grouped by
+const StatsDayFormat = "2006-01-02"
+
+// BusiestDayCount is how many of a period's busiest days are reported
+const BusiestDayCount = 3
+
+// PeriodRange returns the first day and the day after the last of the period
+// containing day, as midnights in day's location
+func (p StatsPeriod) PeriodRange(day time.Time) (time.Time, time.Time) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	if p == StatsPeriodMonth {
+		start = start.AddDate(0, 0, 1-start.Day())
+		return start, start.AddDate(0, 1, 0)
+	}
+	start = startOfWeek(start)
+	return start, start.AddDate(0, 0, 7)
+}
+
+// startOfWeek returns the Monday on or before a midnight
+func startOfWeek(day time.Time) time.Time {
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+// BookedStatuses are the statuses of bookings that take up a barber's time
+var BookedStatuses = []BookingStatus{
+	BookingStatusPending,
+	BookingStatusConfirmed,
+	BookingStatusCompleted,
+	BookingStatusNoShow,
+}
+
+// DayStats is the time booked with a barber on one of their days
+type DayStats struct {
+	Date       time.Time     `json:"date"` // Midnight in the barber's time zone
+	Bookings   int           `json:"bookings"`
+	BookedTime time.Duration `json:"bookedTime"`
+}
+
+// BarberStats summarizes a barber's bookings over a period, for their
+// dashboard. Bookings are counted on the day they start.
+type BarberStats struct {
+	BarberID     string                `json:"barberId"`
+	Start        time.Time             `json:"start"` // First day of the period
+	End          time.Time             `json:"end"`   // Day after the last
+	StatusCounts map[BookingStatus]int `json:"statusCounts"`
+	Bookings     int                   `json:"bookings"`
+	BookedTime   time.Duration         `json:"bookedTime"`  // Length of the bookings taking up the barber's time
+	WorkingTime  time.Duration         `json:"workingTime"` // Scheduled hours, less breaks and holidays
+	Days         []*DayStats           `json:"days"`        // Days with booked time, in no particular order
+}
+
+// Utilization returns the booked time as a percentage of the working time.
+// Bookings outside working hours can take it over 100.
+func (s *BarberStats) Utilization() float64 {
+	if s.WorkingTime <= 0 {
+		return 0
+	}
+	return 100 * float64(s.BookedTime) / float64(s.WorkingTime)
+}
+
+// BusiestDays returns up to n days with the most booked time, busiest first
+func (s *BarberStats) BusiestDays(n int) []*DayStats {
+	days := make([]*DayStats, len(s.Days))
+	copy(days, s.Days)
+	sort.Slice(days, func(i, j int) bool {
+		if days[i].BookedTime != days[j].BookedTime {
+			return days[i].BookedTime > days[j].BookedTime
+		}
+		if days[i].Bookings != days[j].Bookings {
+			return days[i].Bookings > days[j].Bookings
+		}
+		return days[i].Date.Before(days[j].Date)
+	})
+	if len(days) > n {
+		days = days[:n]
+	}
+	return days
+}
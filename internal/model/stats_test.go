@@ -0,0 +1,39 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsPeriodRange(t *testing.T) {
+	sunday := time.Date(2025, time.June, 8, 15, 0, 0, 0, time.UTC)
+
+	start, end := StatsPeriodWeek.PeriodRange(sunday)
+	assert.Equal(t, time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2025, time.June, 9, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = StatsPeriodMonth.PeriodRange(sunday)
+	assert.Equal(t, time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestBarberStatsBusiestDays(t *testing.T) {
+	day := func(d, bookings int, booked time.Duration) *DayStats {
+		return &DayStats{Date: time.Date(2025, time.June, d, 0, 0, 0, 0, time.UTC), Bookings: bookings, BookedTime: booked}
+	}
+	stats := &BarberStats{Days: []*DayStats{
+		day(2, 2, time.Hour),
+		day(3, 4, 3*time.Hour),
+		day(4, 3, time.Hour),
+		day(5, 1, 30*time.Minute),
+	}}
+
+	busiest := stats.BusiestDays(3)
+
+	// Ties on booked time go to the day with more bookings
+	assert.Equal(t, []*DayStats{stats.Days[1], stats.Days[2], stats.Days[0]}, busiest)
+	assert.Len(t, stats.BusiestDays(10), 4)
+	assert.Zero(t, stats.Utilization(), "no working time")
+}
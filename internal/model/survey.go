@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Survey is a satisfaction survey sent after a completed booking
+type Survey struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	BookingID   string             `bson:"bookingId" json:"bookingId"`
+	UserID      string             `bson:"userId" json:"userId"`
+	BarberID    string             `bson:"barberId" json:"barberId"`
+	Token       string             `bson:"token" json:"-"`
+	SentAt      time.Time          `bson:"sentAt" json:"sentAt"`
+	Score       int                `bson:"score,omitempty" json:"score,omitempty"` // 1-5
+	Comment     string             `bson:"comment,omitempty" json:"comment,omitempty"`
+	RespondedAt *time.Time         `bson:"respondedAt,omitempty" json:"respondedAt,omitempty"`
+}
+
+// SurveyScores aggregates survey responses for a barber
+type SurveyScores struct {
+	BarberID     string  `json:"barberId"`
+	Responses    int     `json:"responses"`
+	AverageScore float64 `json:"averageScore"`
+	ScoreCounts  [5]int  `json:"scoreCounts"` // Index 0 holds the number of 1-star responses
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// UserDataExport is everything stored about a customer, handed over when
+// they ask for their data
+type UserDataExport struct {
+	UserID       string                 `json:"userId"`
+	ExportedAt   time.Time              `json:"exportedAt"`
+	Bookings     []*Booking             `json:"bookings"`
+	History      []*BookingHistoryEntry `json:"history"`
+	Surveys      []*Survey              `json:"surveys"`
+	Reviews      []*Review              `json:"reviews"`
+	Favorites    []*FavoriteBarber      `json:"favorites"`
+	AuditEntries []*AuditEntry          `json:"auditEntries"`
+}
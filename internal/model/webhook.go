@@ -0,0 +1,41 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Booking events webhooks can subscribe to
+const (
+	WebhookEventBookingCreated     = "booking.created"
+	WebhookEventBookingUpdated     = "booking.updated"
+	WebhookEventBookingRescheduled = "booking.rescheduled"
+	WebhookEventBookingConfirmed   = "booking.confirmed"
+	WebhookEventBookingCancelled   = "booking.cancelled"
+)
+
+// WebhookEvents lists every event webhooks can subscribe to
+var WebhookEvents = []string{
+	WebhookEventBookingCreated,
+	WebhookEventBookingUpdated,
+	WebhookEventBookingRescheduled,
+	WebhookEventBookingConfirmed,
+	WebhookEventBookingCancelled,
+}
+
+// Webhook is a URL booking events are POSTed to, signed with its secret
+type Webhook struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	URL        string             `bson:"url" json:"url"`
+	Secret     string             `bson:"secret" json:"-"`
+	EventTypes []string           `bson:"eventTypes" json:"eventTypes"`
+	CreatedBy  string             `bson:"createdBy,omitempty" json:"createdBy,omitempty"`
+	CreatedAt  time.Time          `bson:"createdAt" json:"createdAt"`
+}
+
+// Subscribes reports whether the webhook is sent an event
+func (w *Webhook) Subscribes(eventType string) bool {
+	return slices.Contains(w.EventTypes, eventType)
+}
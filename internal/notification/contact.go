@@ -0,0 +1,111 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ita-av/booking-service/internal/auth"
+)
+
+// Metadata keys a caller can pass their own contact details in, e.g. set by
+// an API gateway that already knows them
+const (
+	EmailMetadataKey = "x-user-email"
+	NameMetadataKey  = "x-user-name"
+)
+
+// Contact is where a user's notifications are sent
+type Contact struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// ContactDirectory looks up users' contact details
+type ContactDirectory interface {
+	// LookupContact returns nil if the user isn't known
+	LookupContact(ctx context.Context, userID string) (*Contact, error)
+}
+
+// contactFromMetadata returns the contact details the caller passed with the
+// request, provided the caller is the user being notified. Details passed
+// by anyone else, such as an admin cancelling a customer's booking, are
+// theirs and not the recipient's.
+func contactFromMetadata(ctx context.Context, userID string) (*Contact, bool) {
+	claims, ok := auth.ClaimsFromContext(ctx)
+	if !ok || claims.Subject != userID {
+		return nil, false
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	emails := md.Get(EmailMetadataKey)
+	if len(emails) == 0 || emails[0] == "" {
+		return nil, false
+	}
+
+	contact := &Contact{Email: emails[0]}
+	if names := md.Get(NameMetadataKey); len(names) > 0 {
+		contact.Name = names[0]
+	}
+	return contact, true
+}
+
+// UserServiceDirectory looks contacts up in the user service's REST API, at
+// GET {baseURL}/users/{id}
+type UserServiceDirectory struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// NewUserServiceDirectory creates a directory for the user service at
+// baseURL, authenticating with token if it's set
+func NewUserServiceDirectory(baseURL, token string, timeout time.Duration) *UserServiceDirectory {
+	return &UserServiceDirectory{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// LookupContact fetches a user's name and email address
+func (d *UserServiceDirectory) LookupContact(ctx context.Context, userID string) (*Contact, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.baseURL+"/users/"+url.PathEscape(userID), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build user request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if d.token != "" {
+		req.Header.Set("Authorization", "Bearer "+d.token)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+		return nil, fmt.Errorf("user service responded %s", resp.Status)
+	}
+
+	var contact Contact
+	if err := json.NewDecoder(resp.Body).Decode(&contact); err != nil {
+		return nil, errors.Wrap(err, "invalid user response")
+	}
+	return &contact, nil
+}
@@ -0,0 +1,214 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// Email is a message to send to one recipient
+type Email struct {
+	To     string
+	ToName string
+	// Subject and Text are required; HTML is an optional alternative body
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// EmailSender sends emails through a provider
+type EmailSender interface {
+	Send(ctx context.Context, email Email) error
+}
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// DefaultTemplates returns the built-in email templates
+func DefaultTemplates() fs.FS {
+	templates, _ := fs.Sub(defaultTemplates, "templates")
+	return templates
+}
+
+// Templates renders the emails of notification kinds. Each kind has a
+// "<kind>.txt" template defining "subject" and "body", and optionally a
+// "<kind>.html" template rendering an HTML body.
+type Templates struct {
+	text map[Kind]*template.Template
+	html map[Kind]*htmltemplate.Template
+}
+
+// templateFuncs are available to every template
+var templateFuncs = map[string]any{
+	"services": model.DescribeServices,
+	"amount":   formatAmount,
+}
+
+// ParseTemplates parses the templates in fsys. Kinds without a template are
+// sent with the subject and body of the notification itself.
+func ParseTemplates(fsys fs.FS) (*Templates, error) {
+	t := &Templates{
+		text: make(map[Kind]*template.Template),
+		html: make(map[Kind]*htmltemplate.Template),
+	}
+
+	names, err := fs.Glob(fsys, "*.txt")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list email templates")
+	}
+	for _, name := range names {
+		kind := Kind(strings.TrimSuffix(name, ".txt"))
+		text, err := template.New(name).Funcs(templateFuncs).ParseFS(fsys, name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse email template %s", name)
+		}
+		if text.Lookup("subject") == nil || text.Lookup("body") == nil {
+			return nil, errors.Errorf("email template %s must define subject and body", name)
+		}
+		t.text[kind] = text
+
+		htmlName := string(kind) + ".html"
+		if _, err := fs.Stat(fsys, htmlName); err != nil {
+			continue
+		}
+		html, err := htmltemplate.New(htmlName).Funcs(templateFuncs).ParseFS(fsys, htmlName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse email template %s", htmlName)
+		}
+		t.html[kind] = html
+	}
+
+	return t, nil
+}
+
+// templateData is what the templates render
+type templateData struct {
+	Name    string
+	Message Message
+	Booking *model.Booking
+	// Start, End and RescheduledFrom are in the booking's time zone
+	Start           time.Time
+	End             time.Time
+	RescheduledFrom *time.Time
+}
+
+// render builds the email for a notification to contact
+func (t *Templates) render(msg Message, contact *Contact) (Email, error) {
+	email := Email{To: contact.Email, ToName: contact.Name, Subject: msg.Subject, Text: msg.Body}
+
+	text, ok := t.text[msg.Kind]
+	if !ok || msg.Booking == nil {
+		return email, nil
+	}
+
+	loc := msg.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	data := templateData{
+		Name:    contact.Name,
+		Message: msg,
+		Booking: msg.Booking,
+		Start:   msg.Booking.StartTime.In(loc),
+		End:     msg.Booking.EndTime.In(loc),
+	}
+	if from := msg.Booking.RescheduledFrom; from != nil {
+		local := from.In(loc)
+		data.RescheduledFrom = &local
+	}
+
+	var subject, body bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Email{}, errors.Wrapf(err, "failed to render %s subject", msg.Kind)
+	}
+	if err := text.ExecuteTemplate(&body, "body", data); err != nil {
+		return Email{}, errors.Wrapf(err, "failed to render %s body", msg.Kind)
+	}
+	email.Subject = strings.TrimSpace(subject.String())
+	email.Text = strings.TrimSpace(body.String()) + "\n"
+
+	if html, ok := t.html[msg.Kind]; ok {
+		var buf bytes.Buffer
+		if err := html.Execute(&buf, data); err != nil {
+			return Email{}, errors.Wrapf(err, "failed to render %s HTML body", msg.Kind)
+		}
+		email.HTML = buf.String()
+	}
+
+	return email, nil
+}
+
+// formatAmount formats an amount in minor currency units, e.g. "12.50 USD"
+func formatAmount(amount int64, currency string) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/100, amount%100, currency)
+}
+
+// EmailNotifier emails notifications to users, looking their address up in
+// the request or a contact directory
+type EmailNotifier struct {
+	sender    EmailSender
+	templates *Templates
+	contacts  ContactDirectory
+}
+
+// NewEmailNotifier creates a notifier sending through sender. contacts may
+// be nil, in which case only users who pass their address with the request
+// are emailed.
+func NewEmailNotifier(sender EmailSender, templates *Templates, contacts ContactDirectory) *EmailNotifier {
+	return &EmailNotifier{sender: sender, templates: templates, contacts: contacts}
+}
+
+// Notify emails the notification. Users without a known email address are
+// skipped.
+func (n *EmailNotifier) Notify(ctx context.Context, msg Message) error {
+	contact, err := n.contact(ctx, msg.UserID)
+	if err != nil {
+		return err
+	}
+	if contact == nil || contact.Email == "" {
+		log.Debug().
+			Str("kind", string(msg.Kind)).
+			Str("userID", msg.UserID).
+			Msg("No email address for notification")
+		return nil
+	}
+
+	email, err := n.templates.render(msg, contact)
+	if err != nil {
+		return err
+	}
+	if err := n.sender.Send(ctx, email); err != nil {
+		return errors.Wrap(err, "failed to send email")
+	}
+	return nil
+}
+
+// contact finds where to email a user
+func (n *EmailNotifier) contact(ctx context.Context, userID string) (*Contact, error) {
+	if contact, ok := contactFromMetadata(ctx, userID); ok {
+		return contact, nil
+	}
+	if n.contacts == nil {
+		return nil, nil
+	}
+	contact, err := n.contacts.LookupContact(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to look up contact")
+	}
+	return contact, nil
+}
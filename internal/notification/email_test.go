@@ -0,0 +1,188 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// fakeSender records the emails it's given
+type fakeSender struct {
+	emails []Email
+}
+
+func (s *fakeSender) Send(ctx context.Context, email Email) error {
+	s.emails = append(s.emails, email)
+	return nil
+}
+
+// fakeDirectory knows a fixed set of contacts
+type fakeDirectory map[string]*Contact
+
+func (d fakeDirectory) LookupContact(ctx context.Context, userID string) (*Contact, error) {
+	return d[userID], nil
+}
+
+func testBookingMessage(kind Kind) Message {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	start := time.Date(2025, time.June, 3, 9, 0, 0, 0, time.UTC)
+	from := start.Add(-24 * time.Hour)
+	return Message{
+		Kind:    kind,
+		UserID:  "user1",
+		Subject: "Booking confirmed",
+		Body:    "Your haircut is booked.",
+		Booking: &model.Booking{
+			ID:              primitive.NewObjectID(),
+			UserID:          "user1",
+			StartTime:       start,
+			EndTime:         start.Add(45 * time.Minute),
+			ServiceTypes:    []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim},
+			Price:           3550,
+			Currency:        "EUR",
+			RescheduledFrom: &from,
+			Cancellation:    &model.Cancellation{Reason: "sick", Fee: 1200, Currency: "EUR"},
+		},
+		Location: berlin,
+	}
+}
+
+func TestTemplates_Render(t *testing.T) {
+	templates, err := ParseTemplates(DefaultTemplates())
+	require.NoError(t, err)
+	contact := &Contact{Name: "Alice", Email: "alice@example.com"}
+
+	email, err := templates.render(testBookingMessage(KindBookingConfirmation), contact)
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", email.To)
+	assert.Equal(t, "Your booking on Tue 3 Jun at 11:00", email.Subject)
+	assert.True(t, strings.HasPrefix(email.Text, "Hi Alice,\n\n"))
+	assert.Contains(t, email.Text, "Tuesday 3 June 2025, 11:00 to 11:45 (CEST)")
+	assert.Contains(t, email.Text, "Price: 35.50 EUR")
+
+	email, err = templates.render(testBookingMessage(KindBookingRescheduled), &Contact{Email: "alice@example.com"})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(email.Text, "Hi,\n\n"))
+	assert.Contains(t, email.Text, "on Monday 2 June 2025 at 11:00 has been rescheduled to Tuesday 3 June 2025, 11:00")
+
+	email, err = templates.render(testBookingMessage(KindBookingCancelled), contact)
+	require.NoError(t, err)
+	assert.Equal(t, "Your booking on Tue 3 Jun at 11:00 is cancelled", email.Subject)
+	assert.Contains(t, email.Text, "Reason: sick")
+	assert.Contains(t, email.Text, "A cancellation fee of 12.00 EUR applies")
+
+	// Kinds without a template are sent as they are
+	email, err = templates.render(Message{Kind: KindSurvey, Subject: "How was it?", Body: "Tell us."}, contact)
+	require.NoError(t, err)
+	assert.Equal(t, Email{To: "alice@example.com", ToName: "Alice", Subject: "How was it?", Text: "Tell us."}, email)
+}
+
+func TestEmailNotifier_Contacts(t *testing.T) {
+	templates, err := ParseTemplates(DefaultTemplates())
+	require.NoError(t, err)
+	sender := &fakeSender{}
+	directory := fakeDirectory{"user1": {Name: "Alice", Email: "alice@directory.example"}}
+	notifier := NewEmailNotifier(sender, templates, directory)
+	msg := testBookingMessage(KindBookingConfirmation)
+
+	withCaller := func(userID string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(EmailMetadataKey, "alice@request.example"))
+		return auth.WithClaims(ctx, &auth.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: userID}})
+	}
+
+	// The recipient's own request carries their address
+	require.NoError(t, notifier.Notify(withCaller("user1"), msg))
+	// Someone else's request doesn't
+	require.NoError(t, notifier.Notify(withCaller("admin1"), msg))
+	// Users the directory doesn't know aren't emailed
+	msg.UserID = "user2"
+	require.NoError(t, notifier.Notify(context.Background(), msg))
+
+	require.Len(t, sender.emails, 2)
+	assert.Equal(t, "alice@request.example", sender.emails[0].To)
+	assert.Equal(t, "alice@directory.example", sender.emails[1].To)
+	assert.Equal(t, "Alice", sender.emails[1].ToName)
+}
+
+func TestUserServiceDirectory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		if r.URL.Path != "/users/user1" {
+			http.NotFound(w, r)
+			return
+		}
+		io.WriteString(w, `{"id": "user1", "name": "Alice", "email": "alice@example.com"}`)
+	}))
+	defer server.Close()
+	directory := NewUserServiceDirectory(server.URL+"/", "secret", time.Second)
+
+	contact, err := directory.LookupContact(context.Background(), "user1")
+	require.NoError(t, err)
+	assert.Equal(t, &Contact{Name: "Alice", Email: "alice@example.com"}, contact)
+
+	contact, err = directory.LookupContact(context.Background(), "user2")
+	require.NoError(t, err)
+	assert.Nil(t, contact)
+}
+
+func TestSendGridSender(t *testing.T) {
+	var received sendGridRequest
+	status := http.StatusAccepted
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/send", r.URL.Path)
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(status)
+		io.WriteString(w, `{"errors": [{"message": "bad"}]}`)
+	}))
+	defer server.Close()
+
+	sender, err := NewSendGridSender("key", "Barbershop <bookings@example.com>", time.Second)
+	require.NoError(t, err)
+	sender.baseURL = server.URL
+
+	email := Email{To: "alice@example.com", ToName: "Alice", Subject: "Hi", Text: "text", HTML: "<p>html</p>"}
+	require.NoError(t, sender.Send(context.Background(), email))
+	assert.Equal(t, sendGridRequest{
+		Personalizations: []sendGridPersonalization{{To: []sendGridAddress{{Email: "alice@example.com", Name: "Alice"}}}},
+		From:             sendGridAddress{Email: "bookings@example.com", Name: "Barbershop"},
+		Subject:          "Hi",
+		Content:          []sendGridContent{{Type: "text/plain", Value: "text"}, {Type: "text/html", Value: "<p>html</p>"}},
+	}, received)
+
+	status = http.StatusBadRequest
+	err = sender.Send(context.Background(), email)
+	assert.ErrorContains(t, err, "400 Bad Request")
+}
+
+func TestSMTPSender_Message(t *testing.T) {
+	sender, err := NewSMTPSender(SMTPConfig{Host: "smtp.example.com", Port: 587, From: "Barbershop <bookings@example.com>"})
+	require.NoError(t, err)
+	sender.now = func() time.Time { return time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC) }
+
+	message := string(sender.message(Email{To: "alice@example.com", ToName: "Alice", Subject: "Your booking – Tuesday", Text: "See you soon"}))
+	assert.Contains(t, message, "From: \"Barbershop\" <bookings@example.com>\r\n")
+	assert.Contains(t, message, "To: \"Alice\" <alice@example.com>\r\n")
+	assert.Contains(t, message, "Subject: =?utf-8?q?Your_booking_=E2=80=93_Tuesday?=\r\n")
+	assert.Contains(t, message, "Date: Sun, 01 Jun 2025 09:00:00 +0000\r\n")
+	assert.Contains(t, message, "Content-Type: text/plain; charset=utf-8\r\n")
+	assert.True(t, strings.HasSuffix(message, "\r\n\r\nSee you soon"))
+
+	message = string(sender.message(Email{To: "alice@example.com", Subject: "Hi", Text: "text", HTML: "<p>html</p>"}))
+	assert.Contains(t, message, "Content-Type: multipart/alternative; boundary=")
+	assert.Contains(t, message, "Content-Type: text/html; charset=utf-8\r\n")
+}
@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// Kind identifies the type of a notification
+type Kind string
+
+// Notification kinds
+const (
+	KindSurvey        Kind = "survey"
+	KindSlotReleased  Kind = "slot_released"
+	KindDepositUnpaid Kind = "deposit_unpaid"
+	KindDailyAgenda   Kind = "daily_agenda"
+
+	KindBookingConfirmation Kind = "booking_confirmation"
+	KindBookingRescheduled  Kind = "booking_rescheduled"
+	KindBookingCancelled    Kind = "booking_cancelled"
+	KindBookingReminder     Kind = "booking_reminder"
+)
+
+// Message is a notification addressed to a user of the booking system
+type Message struct {
+	Kind      Kind
+	UserID    string
+	BookingID string
+	Subject   string
+	Body      string
+
+	// Booking is the booking the notification is about, if any, for channels
+	// rendering their own message from it
+	Booking *model.Booking
+	// Location is the time zone the booking's times are shown in
+	Location *time.Location
+}
+
+// Notifier delivers notifications to users
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// LogNotifier writes notifications to the log instead of delivering them.
+// It is used when no delivery channel is configured.
+type LogNotifier struct{}
+
+// NewLogNotifier creates a new log-only notifier
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+// Notify logs the notification
+func (n *LogNotifier) Notify(ctx context.Context, msg Message) error {
+	log.Info().
+		Str("kind", string(msg.Kind)).
+		Str("userID", msg.UserID).
+		Str("bookingID", msg.BookingID).
+		Str("subject", msg.Subject).
+		Msg("Notification")
+	return nil
+}
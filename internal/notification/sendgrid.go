@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// sendGridAPIURL is SendGrid's v3 API
+const sendGridAPIURL = "https://api.sendgrid.com/v3"
+
+// SendGridSender sends emails with SendGrid's mail send API
+type SendGridSender struct {
+	apiKey  string
+	from    *mail.Address
+	baseURL string
+	client  *http.Client
+}
+
+// NewSendGridSender creates a SendGrid sender from an API key and the sender
+// address, e.g. "Barbershop <bookings@example.com>"
+func NewSendGridSender(apiKey, from string, timeout time.Duration) (*SendGridSender, error) {
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+	return &SendGridSender{
+		apiKey:  apiKey,
+		from:    address,
+		baseURL: sendGridAPIURL,
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// sendGridAddress is an email address in a SendGrid request
+type sendGridAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// sendGridContent is one body of a SendGrid request
+type sendGridContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// sendGridPersonalization addresses a SendGrid request
+type sendGridPersonalization struct {
+	To []sendGridAddress `json:"to"`
+}
+
+// sendGridRequest is the body of a mail send request
+type sendGridRequest struct {
+	Personalizations []sendGridPersonalization `json:"personalizations"`
+	From             sendGridAddress           `json:"from"`
+	Subject          string                    `json:"subject"`
+	Content          []sendGridContent         `json:"content"`
+}
+
+// Send sends an email. SendGrid accepts it for delivery with 202 Accepted.
+func (s *SendGridSender) Send(ctx context.Context, email Email) error {
+	body := sendGridRequest{
+		Personalizations: []sendGridPersonalization{{To: []sendGridAddress{{Email: email.To, Name: email.ToName}}}},
+		From:             sendGridAddress{Email: s.from.Address, Name: s.from.Name},
+		Subject:          email.Subject,
+		// SendGrid wants the plain text body first
+		Content: []sendGridContent{{Type: "text/plain", Value: email.Text}},
+	}
+	if email.HTML != "" {
+		body.Content = append(body.Content, sendGridContent{Type: "text/html", Value: email.HTML})
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode email")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+"/mail/send", bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "failed to build SendGrid request")
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "SendGrid request failed")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		return fmt.Errorf("SendGrid responded %s: %s", resp.Status, bytes.TrimSpace(detail))
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SMTPConfig configures an SMTP sender
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // Authenticates with PLAIN when set
+	Password string
+	From     string // e.g. "Barbershop <bookings@example.com>"
+	Timeout  time.Duration
+}
+
+// SMTPSender sends emails through an SMTP server, upgrading the connection
+// with STARTTLS when the server offers it
+type SMTPSender struct {
+	config SMTPConfig
+	from   *mail.Address
+	now    func() time.Time
+}
+
+// NewSMTPSender creates an SMTP sender
+func NewSMTPSender(config SMTPConfig) (*SMTPSender, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid sender address")
+	}
+	return &SMTPSender{config: config, from: from, now: time.Now}, nil
+}
+
+// Send delivers an email, giving up after the configured timeout
+func (s *SMTPSender) Send(ctx context.Context, email Email) error {
+	if s.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.Timeout)
+		defer cancel()
+	}
+
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to SMTP server")
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		return errors.Wrap(err, "failed to start SMTP session")
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+			return errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	if s.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return errors.Wrap(err, "sender refused")
+	}
+	if err := client.Rcpt(email.To); err != nil {
+		return errors.Wrap(err, "recipient refused")
+	}
+	w, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start message")
+	}
+	if _, err := w.Write(s.message(email)); err != nil {
+		return errors.Wrap(err, "failed to write message")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "message refused")
+	}
+	return client.Quit()
+}
+
+// message builds the MIME message for an email, with the HTML body as a
+// multipart/alternative when there is one
+func (s *SMTPSender) message(email Email) []byte {
+	var buf bytes.Buffer
+	to := mail.Address{Name: email.ToName, Address: email.To}
+	fmt.Fprintf(&buf, "From: %s\r\n", s.from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", s.now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: <%s@%s>\r\n", randomToken(), s.config.Host)
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if email.HTML == "" {
+		writePart(&buf, "text/plain", email.Text)
+		return buf.Bytes()
+	}
+
+	boundary := randomToken()
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	writePart(&buf, "text/plain", email.Text)
+	fmt.Fprintf(&buf, "\r\n--%s\r\n", boundary)
+	writePart(&buf, "text/html", email.HTML)
+	fmt.Fprintf(&buf, "\r\n--%s--\r\n", boundary)
+	return buf.Bytes()
+}
+
+// writePart writes the headers and quoted-printable body of a message part
+func writePart(buf *bytes.Buffer, contentType, body string) {
+	fmt.Fprintf(buf, "Content-Type: %s; charset=utf-8\r\n", contentType)
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	qp := quotedprintable.NewWriter(buf)
+	qp.Write([]byte(body))
+	qp.Close()
+}
+
+// randomToken returns a random hex string for message IDs and boundaries
+func randomToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
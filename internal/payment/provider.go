@@ -0,0 +1,59 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidSignature is returned for webhooks that weren't signed by the provider
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// EventType identifies what a payment webhook reports
+type EventType string
+
+// Payment event types
+const (
+	EventPaymentSucceeded EventType = "payment_succeeded"
+	EventPaymentFailed    EventType = "payment_failed"
+	EventOther            EventType = "other"
+)
+
+// IntentParams describes a payment to collect for a booking. Amounts are in
+// minor currency units (e.g. cents). TenantID is the shop the booking
+// belongs to, so the payment's webhook reaches it.
+type IntentParams struct {
+	BookingID string
+	TenantID  string
+	Amount    int64
+	Currency  string
+}
+
+// Intent is a payment the customer completes on the client with ClientSecret
+type Intent struct {
+	ID           string
+	ClientSecret string
+}
+
+// Event is a payment webhook from the provider
+type Event struct {
+	Type      EventType
+	IntentID  string
+	BookingID string
+	TenantID  string
+	Amount    int64
+	Currency  string
+}
+
+// Provider abstracts the payment provider collecting booking deposits
+type Provider interface {
+	// CreateIntent starts collecting a payment. Creating an intent twice for
+	// the same booking returns the same intent.
+	CreateIntent(ctx context.Context, params IntentParams) (*Intent, error)
+	// CancelIntent stops collecting a payment that hasn't been made
+	CancelIntent(ctx context.Context, intentID string) error
+	// ParseEvent verifies and decodes a webhook, returning
+	// ErrInvalidSignature if the provider didn't send it
+	ParseEvent(payload []byte, header http.Header) (*Event, error)
+}
@@ -0,0 +1,226 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// StripeSignatureHeader carries Stripe's webhook signature
+const StripeSignatureHeader = "Stripe-Signature"
+
+// stripeAPIURL is Stripe's REST API
+const stripeAPIURL = "https://api.stripe.com/v1"
+
+// signatureTolerance bounds how old a signed webhook may be, to limit replays
+const signatureTolerance = 5 * time.Minute
+
+// PaymentIntent metadata keys holding the booking ID and its tenant
+const (
+	bookingIDKey = "booking_id"
+	tenantIDKey  = "tenant_id"
+)
+
+// StripeProvider collects payments with Stripe PaymentIntents
+type StripeProvider struct {
+	secretKey     string
+	webhookSecret []byte
+	baseURL       string
+	client        *http.Client
+	now           func() time.Time
+}
+
+// NewStripeProvider creates a Stripe payment provider from an API secret key
+// and the signing secret of the webhook endpoint
+func NewStripeProvider(secretKey, webhookSecret string) *StripeProvider {
+	return &StripeProvider{
+		secretKey:     secretKey,
+		webhookSecret: []byte(webhookSecret),
+		baseURL:       stripeAPIURL,
+		client:        &http.Client{Timeout: 10 * time.Second},
+		now:           time.Now,
+	}
+}
+
+// stripeIntent is the part of a Stripe PaymentIntent the service uses
+type stripeIntent struct {
+	ID             string            `json:"id"`
+	ClientSecret   string            `json:"client_secret"`
+	Amount         int64             `json:"amount"`
+	AmountReceived int64             `json:"amount_received"`
+	Currency       string            `json:"currency"`
+	Metadata       map[string]string `json:"metadata"`
+}
+
+// stripeEvent is a Stripe webhook event
+type stripeEvent struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Data struct {
+		Object json.RawMessage `json:"object"`
+	} `json:"data"`
+}
+
+// stripeError is the error body Stripe returns for failed requests
+type stripeError struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// CreateIntent creates a PaymentIntent tagged with the booking ID. The
+// booking ID doubles as the idempotency key, so retries return the same intent.
+func (p *StripeProvider) CreateIntent(ctx context.Context, params IntentParams) (*Intent, error) {
+	form := url.Values{}
+	form.Set("amount", strconv.FormatInt(params.Amount, 10))
+	form.Set("currency", strings.ToLower(params.Currency))
+	form.Set("automatic_payment_methods[enabled]", "true")
+	form.Set("metadata["+bookingIDKey+"]", params.BookingID)
+	if params.TenantID != "" {
+		form.Set("metadata["+tenantIDKey+"]", params.TenantID)
+	}
+
+	var intent stripeIntent
+	if err := p.post(ctx, "/payment_intents", form, "booking-deposit-"+params.BookingID, &intent); err != nil {
+		return nil, errors.Wrap(err, "failed to create payment intent")
+	}
+
+	return &Intent{ID: intent.ID, ClientSecret: intent.ClientSecret}, nil
+}
+
+// CancelIntent cancels a PaymentIntent
+func (p *StripeProvider) CancelIntent(ctx context.Context, intentID string) error {
+	if err := p.post(ctx, "/payment_intents/"+url.PathEscape(intentID)+"/cancel", url.Values{}, "", nil); err != nil {
+		return errors.Wrap(err, "failed to cancel payment intent")
+	}
+	return nil
+}
+
+// ParseEvent verifies the Stripe-Signature header and decodes PaymentIntent
+// events. Other events are returned as EventOther.
+func (p *StripeProvider) ParseEvent(payload []byte, header http.Header) (*Event, error) {
+	if err := p.verifySignature(payload, header.Get(StripeSignatureHeader)); err != nil {
+		return nil, err
+	}
+
+	var event stripeEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, errors.Wrap(err, "invalid event payload")
+	}
+
+	var eventType EventType
+	switch event.Type {
+	case "payment_intent.succeeded":
+		eventType = EventPaymentSucceeded
+	case "payment_intent.payment_failed":
+		eventType = EventPaymentFailed
+	default:
+		return &Event{Type: EventOther}, nil
+	}
+
+	var intent stripeIntent
+	if err := json.Unmarshal(event.Data.Object, &intent); err != nil {
+		return nil, errors.Wrap(err, "invalid payment intent")
+	}
+
+	return &Event{
+		Type:      eventType,
+		IntentID:  intent.ID,
+		BookingID: intent.Metadata[bookingIDKey],
+		TenantID:  intent.Metadata[tenantIDKey],
+		Amount:    intent.AmountReceived,
+		Currency:  strings.ToUpper(intent.Currency),
+	}, nil
+}
+
+// verifySignature checks a "t=<timestamp>,v1=<signature>" header against an
+// HMAC-SHA256 of "<timestamp>.<payload>"
+func (p *StripeProvider) verifySignature(payload []byte, header string) error {
+	if len(p.webhookSecret) == 0 {
+		return ErrInvalidSignature
+	}
+
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if age := p.now().Sub(time.Unix(seconds, 0)); age > signatureTolerance || age < -signatureTolerance {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, p.webhookSecret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	for _, signature := range signatures {
+		decoded, err := hex.DecodeString(signature)
+		if err == nil && hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// post sends a form-encoded request to the Stripe API and decodes the response into out
+func (p *StripeProvider) post(ctx context.Context, path string, form url.Values, idempotencyKey string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(p.secretKey, "")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 300 {
+		var apiErr stripeError
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Error.Message != "" {
+			return fmt.Errorf("stripe: %s", apiErr.Error.Message)
+		}
+		return fmt.Errorf("stripe: unexpected status %d", resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(body, out)
+}
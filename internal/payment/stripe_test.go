@@ -0,0 +1,89 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func stripeSignature(secret string, timestamp time.Time, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.%s", timestamp.Unix(), payload)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp.Unix(), hex.EncodeToString(mac.Sum(nil)))
+}
+
+func TestStripeProvider_ParseEvent(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	provider := NewStripeProvider("sk_test", "whsec_test")
+	provider.now = func() time.Time { return now }
+
+	payload := `{"id":"evt_1","type":"payment_intent.succeeded","data":{"object":{"id":"pi_1","amount_received":2500,"currency":"eur","metadata":{"booking_id":"b1","tenant_id":"acme"}}}}`
+
+	tests := []struct {
+		name      string
+		signature string
+		wantErr   bool
+	}{
+		{name: "valid", signature: stripeSignature("whsec_test", now, payload)},
+		{name: "wrong secret", signature: stripeSignature("whsec_other", now, payload), wantErr: true},
+		{name: "stale", signature: stripeSignature("whsec_test", now.Add(-time.Hour), payload), wantErr: true},
+		{name: "missing", signature: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(StripeSignatureHeader, tt.signature)
+
+			event, err := provider.ParseEvent([]byte(payload), header)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidSignature)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &Event{
+				Type:      EventPaymentSucceeded,
+				IntentID:  "pi_1",
+				BookingID: "b1",
+				TenantID:  "acme",
+				Amount:    2500,
+				Currency:  "EUR",
+			}, event)
+		})
+	}
+}
+
+func TestStripeProvider_CreateIntent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/payment_intents", r.URL.Path)
+		assert.Equal(t, "booking-deposit-b1", r.Header.Get("Idempotency-Key"))
+
+		user, _, _ := r.BasicAuth()
+		assert.Equal(t, "sk_test", user)
+
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "2500", r.PostForm.Get("amount"))
+		assert.Equal(t, "eur", r.PostForm.Get("currency"))
+		assert.Equal(t, "b1", r.PostForm.Get("metadata[booking_id]"))
+		assert.Equal(t, "acme", r.PostForm.Get("metadata[tenant_id]"))
+
+		w.Write([]byte(`{"id":"pi_1","client_secret":"pi_1_secret"}`))
+	}))
+	defer server.Close()
+
+	provider := NewStripeProvider("sk_test", "whsec_test")
+	provider.baseURL = server.URL
+
+	intent, err := provider.CreateIntent(context.Background(), IntentParams{BookingID: "b1", TenantID: "acme", Amount: 2500, Currency: "EUR"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Intent{ID: "pi_1", ClientSecret: "pi_1_secret"}, intent)
+}
@@ -0,0 +1,238 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ita-av/booking-service/internal/auth"
+)
+
+// RetryAfterHeader is the response header carrying how many seconds to wait
+// before retrying a rate-limited call
+const RetryAfterHeader = "retry-after"
+
+// idleTimeout is how long a caller's buckets are kept after their last call.
+// A bucket idle this long has refilled anyway, so dropping it changes nothing.
+const idleTimeout = 10 * time.Minute
+
+// Limit is a token bucket: calls are allowed at Rate per second on average,
+// with bursts of up to Burst calls. A zero Rate doesn't limit calls.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// bucketKey identifies a caller's bucket for one method
+type bucketKey struct {
+	caller string
+	method string
+}
+
+// bucket is a caller's token bucket for one method
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// Limiter rate-limits calls per caller and method. Authenticated callers are
+// identified by their user ID, and anonymous callers of public methods by
+// their IP address.
+type Limiter struct {
+	now func() time.Time
+
+	mu           sync.Mutex
+	defaultLimit Limit
+	methods      map[string]Limit
+	buckets      map[bucketKey]*bucket
+	lastSweep    time.Time
+}
+
+// NewLimiter creates a limiter applying defaultLimit to every method, except
+// those with their own limit in methods, keyed by method name (e.g.
+// "CreateBooking")
+func NewLimiter(defaultLimit Limit, methods map[string]Limit) *Limiter {
+	return &Limiter{
+		defaultLimit: defaultLimit,
+		methods:      methods,
+		now:          time.Now,
+		buckets:      make(map[bucketKey]*bucket),
+	}
+}
+
+// Unary is a gRPC interceptor that rejects calls over the caller's limit with
+// ResourceExhausted. It must run after authentication, so callers are
+// identified by their user ID.
+func (l *Limiter) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := l.allow(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream is the streaming counterpart of Unary; it limits opening streams
+func (l *Limiter) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.allow(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// allow takes a token from the caller's bucket for the method, returning the
+// ResourceExhausted status to fail the call with if the bucket is empty
+func (l *Limiter) allow(ctx context.Context, fullMethod string) error {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	caller := callerKey(ctx)
+	now := l.now()
+
+	l.mu.Lock()
+	limit := l.limitFor(method)
+	if limit.Rate <= 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	l.sweep(now)
+	key := bucketKey{caller: caller, method: method}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), max(limit.Burst, 1))}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	reservation := b.limiter.ReserveN(now, 1)
+	l.mu.Unlock()
+
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return nil
+	}
+	// The call is refused, so give the token back
+	reservation.CancelAt(now)
+
+	return exhausted(ctx, method, delay)
+}
+
+// SetLimits replaces the limits while the limiter is in use. Callers' buckets
+// are kept, with the new rate and burst, so changing limits doesn't hand
+// everyone a fresh burst.
+func (l *Limiter) SetLimits(defaultLimit Limit, methods map[string]Limit) {
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.defaultLimit = defaultLimit
+	l.methods = methods
+	for key, b := range l.buckets {
+		limit := l.limitFor(key.method)
+		if limit.Rate <= 0 {
+			delete(l.buckets, key)
+			continue
+		}
+		b.limiter.SetLimitAt(now, rate.Limit(limit.Rate))
+		b.limiter.SetBurstAt(now, max(limit.Burst, 1))
+	}
+}
+
+// limitFor returns a method's limit. The caller must hold l.mu.
+func (l *Limiter) limitFor(method string) Limit {
+	if limit, ok := l.methods[method]; ok {
+		return limit
+	}
+	return l.defaultLimit
+}
+
+// sweep drops the buckets of callers that have gone quiet, at most once per
+// idle timeout. The caller must hold l.mu.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < idleTimeout {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastUsed) >= idleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// callerKey identifies the caller: by user ID once authenticated, otherwise
+// by IP address
+func callerKey(ctx context.Context) string {
+	if userID, err := auth.GetUserIDFromContext(ctx); err == nil {
+		return "user:" + userID
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return "ip:" + host
+}
+
+// exhausted builds the ResourceExhausted status for a refused call, with a
+// RetryInfo detail and a retry-after header saying when to try again
+func exhausted(ctx context.Context, method string, delay time.Duration) error {
+	seconds := int(math.Ceil(delay.Seconds()))
+	// Not every caller has a transport to send headers on, e.g. in tests
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.Itoa(seconds)))
+
+	st := status.Newf(codes.ResourceExhausted, "rate limit exceeded for %s, retry in %ds", method, seconds)
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// ParseMethodLimits parses per-method limits written as comma-separated
+// method=rate:burst pairs, e.g. "CreateBooking=1:5,SubmitSurveyResponse=0.2:3".
+// The burst may be left out, and defaults to the rate rounded up.
+func ParseMethodLimits(s string) (map[string]Limit, error) {
+	limits := make(map[string]Limit)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		method, value, ok := strings.Cut(entry, "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid rate limit %q, expected method=rate:burst", entry)
+		}
+		rateValue, burstValue, hasBurst := strings.Cut(value, ":")
+
+		r, err := strconv.ParseFloat(rateValue, 64)
+		if err != nil || r < 0 {
+			return nil, fmt.Errorf("invalid rate in rate limit %q", entry)
+		}
+		burst := int(math.Ceil(r))
+		if hasBurst {
+			burst, err = strconv.Atoi(burstValue)
+			if err != nil || burst < 1 {
+				return nil, fmt.Errorf("invalid burst in rate limit %q", entry)
+			}
+		}
+
+		limits[strings.TrimSpace(method)] = Limit{Rate: r, Burst: burst}
+	}
+	return limits, nil
+}
@@ -0,0 +1,164 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/ita-av/booking-service/internal/auth"
+)
+
+// userContext returns a context for a signed-in user
+func userContext(userID string) context.Context {
+	return auth.WithClaims(context.Background(), &auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: userID},
+	})
+}
+
+// peerContext returns a context for an anonymous caller at ip
+func peerContext(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 40000},
+	})
+}
+
+// call makes a unary call to method through the limiter
+func call(l *Limiter, ctx context.Context, method string) error {
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/" + method}
+	_, err := l.Unary(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestLimiter(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	l := NewLimiter(Limit{Rate: 1, Burst: 2}, map[string]Limit{
+		"CreateBooking":       {Rate: 0.5, Burst: 1},
+		"ListCatalogServices": {Rate: 0},
+	})
+	l.now = func() time.Time { return now }
+
+	alice := userContext("alice")
+
+	t.Run("allows bursts up to the limit", func(t *testing.T) {
+		require.NoError(t, call(l, alice, "GetBooking"))
+		require.NoError(t, call(l, alice, "GetBooking"))
+
+		err := call(l, alice, "GetBooking")
+		st := status.Convert(err)
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Equal(t, "rate limit exceeded for GetBooking, retry in 1s", st.Message())
+		require.Len(t, st.Details(), 1)
+		retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+		require.True(t, ok)
+		assert.Equal(t, time.Second, retryInfo.RetryDelay.AsDuration())
+	})
+
+	t.Run("buckets are per caller and method", func(t *testing.T) {
+		assert.NoError(t, call(l, userContext("bob"), "GetBooking"))
+		assert.NoError(t, call(l, alice, "GetUserBookings"))
+	})
+
+	t.Run("method limits override the default", func(t *testing.T) {
+		require.NoError(t, call(l, alice, "CreateBooking"))
+		err := call(l, alice, "CreateBooking")
+		assert.Equal(t, "rate limit exceeded for CreateBooking, retry in 2s", status.Convert(err).Message())
+
+		for i := 0; i < 5; i++ {
+			assert.NoError(t, call(l, alice, "ListCatalogServices"))
+		}
+	})
+
+	t.Run("refused calls don't use tokens", func(t *testing.T) {
+		now = now.Add(time.Second)
+		assert.NoError(t, call(l, alice, "GetBooking"))
+		assert.Error(t, call(l, alice, "GetBooking"))
+	})
+
+	t.Run("anonymous callers are limited by IP", func(t *testing.T) {
+		require.NoError(t, call(l, peerContext("203.0.113.7"), "SubmitSurveyResponse"))
+		require.NoError(t, call(l, peerContext("203.0.113.7"), "SubmitSurveyResponse"))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(call(l, peerContext("203.0.113.7"), "SubmitSurveyResponse")))
+		assert.NoError(t, call(l, peerContext("203.0.113.8"), "SubmitSurveyResponse"))
+	})
+
+	t.Run("idle buckets are dropped", func(t *testing.T) {
+		now = now.Add(idleTimeout)
+		require.NoError(t, call(l, userContext("carol"), "GetBooking"))
+		assert.Len(t, l.buckets, 1)
+	})
+}
+
+func TestLimiterStream(t *testing.T) {
+	l := NewLimiter(Limit{Rate: 1, Burst: 1}, nil)
+	info := &grpc.StreamServerInfo{FullMethod: "/booking.BookingService/WatchBookings", IsServerStream: true}
+	ss := &fakeStream{ctx: userContext("alice")}
+	handler := func(srv interface{}, ss grpc.ServerStream) error { return nil }
+
+	assert.NoError(t, l.Stream(nil, ss, info, handler))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(l.Stream(nil, ss, info, handler)))
+}
+
+func TestLimiterSetLimits(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	l := NewLimiter(Limit{Rate: 1, Burst: 1}, nil)
+	l.now = func() time.Time { return now }
+	alice := userContext("alice")
+
+	require.NoError(t, call(l, alice, "GetBooking"))
+	require.Error(t, call(l, alice, "GetBooking"))
+
+	// A larger burst lets the caller's bucket fill further, but doesn't refill it
+	l.SetLimits(Limit{Rate: 1, Burst: 3}, nil)
+	assert.Error(t, call(l, alice, "GetBooking"))
+	now = now.Add(3 * time.Second)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, call(l, alice, "GetBooking"))
+	}
+	assert.Error(t, call(l, alice, "GetBooking"))
+
+	// New method limits apply to existing buckets
+	l.SetLimits(Limit{Rate: 1, Burst: 3}, map[string]Limit{"GetBooking": {Rate: 0}})
+	assert.NoError(t, call(l, alice, "GetBooking"))
+	assert.Empty(t, l.buckets)
+}
+
+// fakeStream is a server stream with a fixed context
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestParseMethodLimits(t *testing.T) {
+	limits, err := ParseMethodLimits(" CreateBooking=1:5, SubmitSurveyResponse=0.2:3,GetQuote=2.5,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Limit{
+		"CreateBooking":        {Rate: 1, Burst: 5},
+		"SubmitSurveyResponse": {Rate: 0.2, Burst: 3},
+		"GetQuote":             {Rate: 2.5, Burst: 3},
+	}, limits)
+
+	limits, err = ParseMethodLimits("")
+	require.NoError(t, err)
+	assert.Empty(t, limits)
+
+	for _, invalid := range []string{"CreateBooking", "=1:5", "CreateBooking=fast", "CreateBooking=-1", "CreateBooking=1:0", "CreateBooking=1:x"} {
+		_, err := ParseMethodLimits(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
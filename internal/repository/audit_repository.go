@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// AuditRepository defines the interface for audit log storage
+type AuditRepository interface {
+	AddAuditEntry(ctx context.Context, entry *model.AuditEntry) error
+	ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error)
+	DeleteAuditEntriesBefore(ctx context.Context, cutoff time.Time) (int64, error)
+	AnonymizeActorEntries(ctx context.Context, actorID string) (int64, error)
+	DeleteActorEntries(ctx context.Context, actorID string) (int64, error)
+}
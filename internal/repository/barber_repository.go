@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ErrBarberExists is returned when creating a profile for a barber who already has one
+var ErrBarberExists = errors.New("barber already exists")
+
+// BarberRepository defines the interface for barber profile storage
+type BarberRepository interface {
+	ListBarbers(ctx context.Context, activeOnly bool) ([]*model.Barber, error)
+	GetBarber(ctx context.Context, id string) (*model.Barber, error)
+	CreateBarber(ctx context.Context, barber *model.Barber) (*model.Barber, error)
+	UpdateBarber(ctx context.Context, barber *model.Barber) (*model.Barber, error)
+	DeleteBarber(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// BarberServicesRepository defines the interface for storing which services
+// each barber offers
+type BarberServicesRepository interface {
+	GetBarberServices(ctx context.Context, barberID string) (*model.BarberServices, error)
+	SaveBarberServices(ctx context.Context, services *model.BarberServices) (*model.BarberServices, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// BookingHistoryRepository defines the interface for booking history storage
+type BookingHistoryRepository interface {
+	AddHistoryEntry(ctx context.Context, entry *model.BookingHistoryEntry) error
+	GetBookingHistory(ctx context.Context, bookingID string) ([]*model.BookingHistoryEntry, error)
+	DeleteBookingHistory(ctx context.Context, bookingIDs []string) (int64, error)
+}
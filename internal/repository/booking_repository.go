@@ -4,16 +4,66 @@ import (
 	"context"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/ita-av/booking-service/internal/model"
 )
 
+// Repository errors
+var (
+	ErrExternalRefExists   = errors.New("external reference already in use")
+	ErrSlotUnavailable     = errors.New("time slot overlaps another booking")
+	ErrResourceUnavailable = errors.New("time slot needs a resource that is fully booked")
+	ErrIdempotencyKeyUsed  = errors.New("idempotency key already used")
+)
+
+// BookingMove is a booking's new time, or new length, with the other fields
+// that change along with it
+type BookingMove struct {
+	StartTime      time.Time
+	EndTime        time.Time
+	CleanupMinutes int                    // Buffer the booking needs after EndTime
+	Updates        map[string]interface{} // Other fields to set, e.g. new services and their price
+	Version        *int64                 // If set, the booking must still be at it
+}
+
 // BookingRepository defines the interface for booking data operations
 type BookingRepository interface {
-	CreateBooking(ctx context.Context, booking *model.Booking) (*model.Booking, error)
+	CreateBooking(ctx context.Context, booking *model.Booking, capacity int, resources []*model.Resource) (*model.Booking, error)
 	GetBookingByID(ctx context.Context, id string) (*model.Booking, error)
+	GetBookingByExternalRef(ctx context.Context, externalRef string) (*model.Booking, error)
+	GetBookingByIdempotencyKey(ctx context.Context, userID, key string) (*model.Booking, error)
 	UpdateBooking(ctx context.Context, id string, updates map[string]interface{}) (*model.Booking, error)
-	CancelBooking(ctx context.Context, id string) (bool, error)
+	UpdateBookingAtVersion(ctx context.Context, id string, version int64, updates map[string]interface{}) (*model.Booking, error)
+	UpdateBookingIfUnset(ctx context.Context, id string, fields []string, updates map[string]interface{}) (*model.Booking, error)
+	RescheduleBooking(ctx context.Context, booking *model.Booking, move BookingMove, capacity int, resources []*model.Resource) (*model.Booking, error)
+	TransitionBookingStatus(ctx context.Context, id string, from, to model.BookingStatus, updates map[string]interface{}) (*model.Booking, error)
+	AddAttachment(ctx context.Context, id string, attachment *model.Attachment) (*model.Booking, error)
+	ListBookings(ctx context.Context, filter model.BookingFilter) ([]*model.Booking, error)
 	GetUserBookings(ctx context.Context, userID string) ([]*model.Booking, error)
+	FindUserBookings(ctx context.Context, userID string) ([]*model.Booking, error)
 	GetBarberBookings(ctx context.Context, barberID string, date *time.Time) ([]*model.Booking, error)
 	GetBookingsInTimeRange(ctx context.Context, barberID string, start, end time.Time) ([]*model.Booking, error)
+	GetBookingsForServices(ctx context.Context, serviceTypes []model.ServiceType, start, end time.Time) ([]*model.Booking, error)
+	GetCompletedBookings(ctx context.Context, start, end time.Time) ([]*model.Booking, error)
+	GetRevenue(ctx context.Context, barberID string, start, end time.Time, granularity model.RevenueGranularity) (*model.RevenueTotals, error)
+	GetUserReliability(ctx context.Context, userID string) (*model.UserReliability, error)
+	GetReliabilityReport(ctx context.Context, filter model.ReliabilityFilter) ([]*model.ReliabilityRow, error)
+	GetCustomerSummary(ctx context.Context, userID string) (*model.CustomerSummary, error)
+	GetBarberStats(ctx context.Context, barberID string, start, end time.Time) (*model.BarberStats, error)
+	GetDemandHeatmap(ctx context.Context, barberID string, start, end time.Time) (*model.DemandHeatmap, error)
+	FindLateBookings(ctx context.Context, startedBefore, now time.Time) ([]*model.Booking, error)
+	FindUnpaidBookings(ctx context.Context, expiredBefore time.Time) ([]*model.Booking, error)
+	FindBookingsToAutoComplete(ctx context.Context, endedBefore time.Time, limit int64) ([]*model.Booking, error)
+	FindStalePendingBookings(ctx context.Context, createdBefore time.Time, limit int64) ([]*model.Booking, error)
+	FindBookingsDueReminder(ctx context.Context, now, startsBefore time.Time, limit int64) ([]*model.Booking, error)
+	MarkReminderSent(ctx context.Context, id string, startTime, sentAt time.Time) (bool, error)
+	FindExpiredBookings(ctx context.Context, status model.BookingStatus, endedBefore time.Time, includeAnonymized bool, limit int64) ([]*model.Booking, error)
+	SoftDeleteBooking(ctx context.Context, id string, deletedAt time.Time) (*model.Booking, error)
+	FindDeletedBookings(ctx context.Context, deletedBefore time.Time, limit int64) ([]*model.Booking, error)
+	DeleteBookings(ctx context.Context, ids []string) (int64, error)
+	AnonymizeBookings(ctx context.Context, ids []string) (int64, error)
+	FindDuplicateBooking(ctx context.Context, userID, barberID string, startTime time.Time, serviceTypes []model.ServiceType, createdAfter time.Time) (*model.Booking, error)
+	ConvertHold(ctx context.Context, booking *model.Booking, now time.Time) (*model.Booking, error)
+	DeleteExpiredHolds(ctx context.Context, expiredBefore time.Time, limit int64) ([]*model.Booking, error)
 }
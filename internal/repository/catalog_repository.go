@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ErrCatalogServiceExists is returned when creating a service whose type is already in the catalog
+var ErrCatalogServiceExists = errors.New("service already in the catalog")
+
+// CatalogRepository defines the interface for service catalog storage
+type CatalogRepository interface {
+	ListServices(ctx context.Context, activeOnly bool) ([]*model.CatalogService, error)
+	CreateService(ctx context.Context, service *model.CatalogService) (*model.CatalogService, error)
+	UpdateService(ctx context.Context, service *model.CatalogService) (*model.CatalogService, error)
+	DeleteService(ctx context.Context, serviceType model.ServiceType) (bool, error)
+}
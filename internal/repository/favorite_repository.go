@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// FavoriteRepository defines the interface for customers' favorite barbers
+type FavoriteRepository interface {
+	// AddFavorite stores a favorite, keeping the original if the customer
+	// already marked the barber
+	AddFavorite(ctx context.Context, favorite *model.FavoriteBarber) (*model.FavoriteBarber, error)
+	RemoveFavorite(ctx context.Context, userID, barberID string) (bool, error)
+	// ListFavorites returns a customer's favorites, most recently added first
+	ListFavorites(ctx context.Context, userID string) ([]*model.FavoriteBarber, error)
+	DeleteUserFavorites(ctx context.Context, userID string) (int64, error)
+}
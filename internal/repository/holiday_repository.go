@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// HolidayRepository defines the interface for shop holiday storage. Dates
+// are in model.HolidayDateFormat.
+type HolidayRepository interface {
+	GetHoliday(ctx context.Context, date string) (*model.Holiday, error)
+	ListHolidays(ctx context.Context, from, to string) ([]*model.Holiday, error)
+	AddHoliday(ctx context.Context, holiday *model.Holiday) (*model.Holiday, error)
+	DeleteHoliday(ctx context.Context, date string) (bool, error)
+}
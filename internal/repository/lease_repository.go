@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// LeaseRepository hands out named, expiring leases, so work that must not
+// run concurrently runs on one replica at a time
+type LeaseRepository interface {
+	// AcquireLease takes or renews the lease for holder until ttl from now.
+	// It returns false if another holder's lease hasn't expired yet.
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	// ReleaseLease gives up holder's lease, if it still has it
+	ReleaseLease(ctx context.Context, name, holder string) error
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoAuditRepository implements repository.AuditRepository with MongoDB
+type MongoAuditRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoAuditRepository creates a new MongoDB-backed audit log repository
+func NewMongoAuditRepository(db *mongo.Database, opts ...MongoOption) *MongoAuditRepository {
+	return &MongoAuditRepository{
+		collection: db.Collection("audit_log"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoAuditRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "at", Value: -1}},
+			Options: options.Index().SetName("at"),
+		},
+		{
+			Keys:    bson.D{{Key: "actorId", Value: 1}, {Key: "at", Value: -1}},
+			Options: options.Index().SetName("actorId_at"),
+		},
+	}
+
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return errors.Wrap(err, "failed to create audit log indexes")
+	}
+
+	return nil
+}
+
+// AddAuditEntry stores an audit entry
+func (r *MongoAuditRepository) AddAuditEntry(ctx context.Context, entry *model.AuditEntry) error {
+	return r.guard.write(ctx, func(ctx context.Context) error {
+		if entry.ID.IsZero() {
+			entry.ID = primitive.NewObjectID()
+		}
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, entry); err != nil {
+			return errors.Wrap(err, "failed to insert audit entry")
+		}
+
+		return nil
+	})
+}
+
+// ListAuditEntries retrieves the audit entries matching a filter, newest first
+func (r *MongoAuditRepository) ListAuditEntries(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.AuditEntry, error) {
+		query := bson.M{}
+		if filter.ActorID != "" {
+			query["actorId"] = filter.ActorID
+		}
+		if filter.Method != "" {
+			query["method"] = filter.Method
+		}
+
+		at := bson.M{}
+		if filter.From != nil {
+			at["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			at["$lt"] = *filter.To
+		}
+		if len(at) > 0 {
+			query["at"] = at
+		}
+
+		opts := options.Find().SetSort(bson.D{{Key: "at", Value: -1}, {Key: "_id", Value: -1}})
+		if filter.Limit > 0 {
+			opts.SetLimit(int64(filter.Limit))
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, query, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list audit entries")
+		}
+		defer cursor.Close(ctx)
+
+		var entries []*model.AuditEntry
+		if err := cursor.All(ctx, &entries); err != nil {
+			return nil, errors.Wrap(err, "failed to decode audit entries")
+		}
+
+		return entries, nil
+	})
+}
+
+// DeleteAuditEntriesBefore removes audit entries recorded before a cutoff
+func (r *MongoAuditRepository) DeleteAuditEntriesBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteMany(ctx, bson.M{"at": bson.M{"$lt": cutoff}})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to delete audit entries")
+		}
+
+		return result.DeletedCount, nil
+	})
+}
+
+// AnonymizeActorEntries strips an actor's ID and request summaries from
+// their audit entries, keeping which methods were called when
+func (r *MongoAuditRepository) AnonymizeActorEntries(ctx context.Context, actorID string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		update := bson.M{
+			"$set":   bson.M{"actorId": ""},
+			"$unset": bson.M{"request": ""},
+		}
+
+		result, err := tenantCollection(ctx, r.collection).UpdateMany(ctx, bson.M{"actorId": actorID}, update)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to anonymize audit entries")
+		}
+
+		return result.ModifiedCount, nil
+	})
+}
+
+// DeleteActorEntries removes an actor's audit entries
+func (r *MongoAuditRepository) DeleteActorEntries(ctx context.Context, actorID string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteMany(ctx, bson.M{"actorId": actorID})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to delete audit entries")
+		}
+
+		return result.DeletedCount, nil
+	})
+}
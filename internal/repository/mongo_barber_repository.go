@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoBarberRepository implements repository.BarberRepository with MongoDB
+type MongoBarberRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoBarberRepository creates a new MongoDB-backed barber profile repository
+func NewMongoBarberRepository(db *mongo.Database, opts ...MongoOption) *MongoBarberRepository {
+	return &MongoBarberRepository{
+		collection: db.Collection("barbers"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoBarberRepository) EnsureIndexes(ctx context.Context) error {
+	index := mongo.IndexModel{
+		Keys:    bson.D{{Key: "active", Value: 1}, {Key: "displayName", Value: 1}},
+		Options: options.Index().SetName("active_displayName"),
+	}
+
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateOne(ctx, index); err != nil {
+		return errors.Wrap(err, "failed to create barber indexes")
+	}
+
+	return nil
+}
+
+// ListBarbers retrieves barber profiles ordered by display name, optionally
+// only the active ones
+func (r *MongoBarberRepository) ListBarbers(ctx context.Context, activeOnly bool) ([]*model.Barber, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Barber, error) {
+		filter := bson.M{}
+		if activeOnly {
+			filter["active"] = true
+		}
+
+		opts := options.Find().SetSort(bson.D{{Key: "displayName", Value: 1}, {Key: "_id", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list barbers")
+		}
+		defer cursor.Close(ctx)
+
+		var barbers []*model.Barber
+		if err := cursor.All(ctx, &barbers); err != nil {
+			return nil, errors.Wrap(err, "failed to decode barbers")
+		}
+
+		return barbers, nil
+	})
+}
+
+// GetBarber retrieves a barber's profile, returning nil if they have none
+func (r *MongoBarberRepository) GetBarber(ctx context.Context, id string) (*model.Barber, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.Barber, error) {
+		var barber model.Barber
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"_id": id}).Decode(&barber)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to get barber")
+		}
+
+		return &barber, nil
+	})
+}
+
+// CreateBarber stores a barber's profile, returning ErrBarberExists if they
+// already have one
+func (r *MongoBarberRepository) CreateBarber(ctx context.Context, barber *model.Barber) (*model.Barber, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Barber, error) {
+		now := time.Now()
+		barber.CreatedAt = now
+		barber.UpdatedAt = now
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, barber); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, ErrBarberExists
+			}
+			return nil, errors.Wrap(err, "failed to create barber")
+		}
+
+		return barber, nil
+	})
+}
+
+// UpdateBarber replaces a barber's display name, bio, photo and active flag.
+// It returns nil if the barber has no profile.
+func (r *MongoBarberRepository) UpdateBarber(ctx context.Context, barber *model.Barber) (*model.Barber, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Barber, error) {
+		update := bson.M{
+			"$set": bson.M{
+				"displayName": barber.DisplayName,
+				"bio":         barber.Bio,
+				"photoUrl":    barber.PhotoURL,
+				"active":      barber.Active,
+				"capacity":    barber.Capacity,
+				"updatedAt":   time.Now(),
+			},
+		}
+
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var updated model.Barber
+		err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": barber.ID}, update, opts).Decode(&updated)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to update barber")
+		}
+
+		return &updated, nil
+	})
+}
+
+// DeleteBarber removes a barber's profile, reporting whether there was one
+func (r *MongoBarberRepository) DeleteBarber(ctx context.Context, id string) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteOne(ctx, bson.M{"_id": id})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to delete barber")
+		}
+
+		return result.DeletedCount > 0, nil
+	})
+}
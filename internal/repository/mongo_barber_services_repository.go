@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoBarberServicesRepository implements repository.BarberServicesRepository with MongoDB
+type MongoBarberServicesRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoBarberServicesRepository creates a new MongoDB-backed barber services repository
+func NewMongoBarberServicesRepository(db *mongo.Database, opts ...MongoOption) *MongoBarberServicesRepository {
+	return &MongoBarberServicesRepository{
+		collection: db.Collection("barber_services"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// GetBarberServices retrieves the services a barber offers, returning nil if
+// none are stored
+func (r *MongoBarberServicesRepository) GetBarberServices(ctx context.Context, barberID string) (*model.BarberServices, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.BarberServices, error) {
+		var services model.BarberServices
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"_id": barberID}).Decode(&services)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to get barber services")
+		}
+
+		return &services, nil
+	})
+}
+
+// SaveBarberServices replaces the services a barber offers
+func (r *MongoBarberServicesRepository) SaveBarberServices(ctx context.Context, services *model.BarberServices) (*model.BarberServices, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.BarberServices, error) {
+		update := bson.M{
+			"$set": bson.M{
+				"services":  services.Services,
+				"updatedAt": time.Now(),
+				"updatedBy": services.UpdatedBy,
+			},
+		}
+
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+		var saved model.BarberServices
+		if err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": services.BarberID}, update, opts).Decode(&saved); err != nil {
+			return nil, errors.Wrap(err, "failed to save barber services")
+		}
+
+		return &saved, nil
+	})
+}
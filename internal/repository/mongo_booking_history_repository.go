@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoBookingHistoryRepository implements repository.BookingHistoryRepository with MongoDB
+type MongoBookingHistoryRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoBookingHistoryRepository creates a new MongoDB-backed booking history repository
+func NewMongoBookingHistoryRepository(db *mongo.Database, opts ...MongoOption) *MongoBookingHistoryRepository {
+	return &MongoBookingHistoryRepository{
+		collection: db.Collection("booking_events"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoBookingHistoryRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "bookingId", Value: 1}, {Key: "at", Value: 1}},
+			Options: options.Index().SetName("bookingId_at"),
+		},
+	}
+
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return errors.Wrap(err, "failed to create booking history indexes")
+	}
+
+	return nil
+}
+
+// AddHistoryEntry stores a change to a booking
+func (r *MongoBookingHistoryRepository) AddHistoryEntry(ctx context.Context, entry *model.BookingHistoryEntry) error {
+	return r.guard.write(ctx, func(ctx context.Context) error {
+		if entry.ID.IsZero() {
+			entry.ID = primitive.NewObjectID()
+		}
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, entry); err != nil {
+			return errors.Wrap(err, "failed to insert booking history entry")
+		}
+
+		return nil
+	})
+}
+
+// GetBookingHistory retrieves the changes to a booking, oldest first
+func (r *MongoBookingHistoryRepository) GetBookingHistory(ctx context.Context, bookingID string) ([]*model.BookingHistoryEntry, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.BookingHistoryEntry, error) {
+		// Entries recorded in the same instant keep their insertion order
+		opts := options.Find().SetSort(bson.D{{Key: "at", Value: 1}, {Key: "_id", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{"bookingId": bookingID}, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get booking history")
+		}
+		defer cursor.Close(ctx)
+
+		var entries []*model.BookingHistoryEntry
+		if err := cursor.All(ctx, &entries); err != nil {
+			return nil, errors.Wrap(err, "failed to decode booking history")
+		}
+
+		return entries, nil
+	})
+}
+
+// DeleteBookingHistory removes the history of bookings by ID
+func (r *MongoBookingHistoryRepository) DeleteBookingHistory(ctx context.Context, bookingIDs []string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteMany(ctx, bson.M{"bookingId": bson.M{"$in": bookingIDs}})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to delete booking history")
+		}
+
+		return result.DeletedCount, nil
+	})
+}
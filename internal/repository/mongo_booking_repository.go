@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -10,79 +11,312 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/ita-av/booking-service/internal/clock"
 	"github.com/ita-av/booking-service/internal/model"
 )
 
-// BookingRepository implements repository.BookingRepository with MongoDB	
+// BookingRepository implements repository.BookingRepository with MongoDB
 type MongoBookingRepository struct {
-	collection *mongo.Collection
+	client        *mongo.Client
+	collection    *mongo.Collection
+	locks         *mongo.Collection
+	resourceLocks *mongo.Collection
+	// lockedByCaller skips the transactions guarding availability checks
+	lockedByCaller bool
+	// clock timestamps changes and decides which holds have lapsed
+	clock clock.Clock
+	guard guard
+}
+
+// WithCallerLocks leaves keeping concurrent bookings for a barber or resource
+// apart to the caller, e.g. a service holding slot locks, instead of running
+// availability checks in transactions. MongoDB then needn't be a replica set.
+func WithCallerLocks() MongoOption {
+	return func(o *mongoOptions) {
+		o.callerLocks = true
+	}
+}
+
+// WithClock replaces the clock the booking repository reads the current
+// time from
+func WithClock(c clock.Clock) MongoOption {
+	return func(o *mongoOptions) {
+		o.clock = c
+	}
 }
 
 // NewBookingRepository creates a new MongoDB-backed booking repository
-func NewMongoBookingRepository(db *mongo.Database) *MongoBookingRepository {
+func NewMongoBookingRepository(db *mongo.Database, opts ...MongoOption) *MongoBookingRepository {
+	o := newMongoOptions(opts)
 	return &MongoBookingRepository{
-		collection: db.Collection("bookings"),
+		client:         db.Client(),
+		collection:     db.Collection("bookings"),
+		locks:          db.Collection("barber_locks"),
+		resourceLocks:  db.Collection("resource_locks"),
+		lockedByCaller: o.callerLocks,
+		clock:          o.clock,
+		guard:          o.guard(),
 	}
 }
 
-// CreateBooking adds a new booking to the database
-func (r *MongoBookingRepository) CreateBooking(ctx context.Context, booking *model.Booking) (*model.Booking, error) {
-	// Set timestamps
-	now := time.Now()
-	booking.CreatedAt = now
-	booking.UpdatedAt = now
-
-	// Generate new ID if not set
-	if booking.ID.IsZero() {
-		booking.ID = primitive.NewObjectID()
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoBookingRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// External references must be unique, but most bookings don't have one
+			Keys: bson.D{{Key: "externalRef", Value: 1}},
+			Options: options.Index().
+				SetName("externalRef_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"externalRef": bson.M{"$type": "string"}}),
+		},
+		{
+			// Replayed create requests are detected by the client's idempotency key
+			Keys: bson.D{{Key: "userId", Value: 1}, {Key: "idempotencyKey", Value: 1}},
+			Options: options.Index().
+				SetName("idempotencyKey_unique").
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"idempotencyKey": bson.M{"$type": "string"}}),
+		},
+		{
+			// Unpaid deposits are swept by expiry; only bookings with a deposit are indexed
+			Keys: bson.D{{Key: "deposit.status", Value: 1}, {Key: "deposit.expiresAt", Value: 1}},
+			Options: options.Index().
+				SetName("deposit_expiry").
+				SetPartialFilterExpression(bson.M{"deposit": bson.M{"$exists": true}}),
+		},
+		{
+			// A barber's schedule and availability are read by start time
+			Keys:    bson.D{{Key: "barberId", Value: 1}, {Key: "startTime", Value: 1}},
+			Options: options.Index().SetName("barberId_startTime"),
+		},
+		{
+			// So are a customer's bookings
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "startTime", Value: 1}},
+			Options: options.Index().SetName("userId_startTime"),
+		},
+		{
+			// Bookings left pending are swept by their creation time
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "createdAt", Value: 1}},
+			Options: options.Index().SetName("status_createdAt"),
+		},
+		{
+			// Lapsed holds are swept by expiry; only holds are indexed
+			Keys: bson.D{{Key: "holdExpiresAt", Value: 1}},
+			Options: options.Index().
+				SetName("hold_expiry").
+				SetPartialFilterExpression(bson.M{"holdExpiresAt": bson.M{"$exists": true}}),
+		},
+		{
+			// Reminders are due for bookings starting soon that haven't had one
+			Keys:    bson.D{{Key: "reminderSentAt", Value: 1}, {Key: "startTime", Value: 1}},
+			Options: options.Index().SetName("reminder_due"),
+		},
+		{
+			// Soft-deleted bookings are purged by deletion time; only they are indexed
+			Keys: bson.D{{Key: "deletedAt", Value: 1}},
+			Options: options.Index().
+				SetName("deleted_purge").
+				SetPartialFilterExpression(bson.M{"deletedAt": bson.M{"$exists": true}}),
+		},
 	}
 
-	// Insert into MongoDB
-	_, err := r.collection.InsertOne(ctx, booking)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to insert booking")
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return errors.Wrap(err, "failed to create booking indexes")
 	}
 
-	return booking, nil
+	return nil
+}
+
+// Ping checks that the bookings collection can be read
+func (r *MongoBookingRepository) Ping(ctx context.Context) error {
+	return r.guard.read(ctx, func(ctx context.Context) error {
+		opts := options.FindOne().SetProjection(bson.M{"_id": 1})
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{}, opts).Err()
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return errors.Wrap(err, "failed to read bookings")
+		}
+
+		return nil
+	})
+}
+
+// CreateBooking adds a new booking to the database, provided the barber has
+// fewer than capacity bookings overlapping it and each of the resources its
+// services need has a unit free. The availability checks and the insert run
+// in one transaction, so bookings created concurrently past the barber's
+// capacity are rejected with ErrSlotUnavailable, and past a resource's with
+// ErrResourceUnavailable. With WithCallerLocks the caller must hold the
+// barber's and resources' locks instead.
+func (r *MongoBookingRepository) CreateBooking(ctx context.Context, booking *model.Booking, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		// Set timestamps
+		now := r.clock.Now()
+		booking.CreatedAt = now
+		booking.UpdatedAt = now
+		booking.Version = 1
+
+		// Generate new ID if not set
+		if booking.ID.IsZero() {
+			booking.ID = primitive.NewObjectID()
+		}
+
+		_, err := r.inBarberTransaction(ctx, booking.BarberID, resources, func(sessCtx context.Context) (interface{}, error) {
+			conflict, err := r.hasConflict(sessCtx, booking.BarberID, booking.StartTime, booking.OccupiedUntil(), booking.ID, capacity)
+			if err != nil {
+				return nil, err
+			}
+			if conflict {
+				return nil, ErrSlotUnavailable
+			}
+			full, err := r.resourcesFull(sessCtx, resources, booking.StartTime, booking.OccupiedUntil(), booking.ID)
+			if err != nil {
+				return nil, err
+			}
+			if full {
+				return nil, ErrResourceUnavailable
+			}
+
+			// Insert into MongoDB
+			_, err = tenantCollection(sessCtx, r.collection).InsertOne(sessCtx, booking)
+			if err != nil {
+				switch {
+				case isDuplicateKeyOn(err, "idempotencyKey_unique"):
+					return nil, ErrIdempotencyKeyUsed
+				case isDuplicateKeyOn(err, "externalRef_unique"):
+					return nil, ErrExternalRefExists
+				}
+				return nil, errors.Wrap(err, "failed to insert booking")
+			}
+
+			return nil, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return booking, nil
+	})
 }
 
 // GetBookingByID retrieves a booking by its ID
 func (r *MongoBookingRepository) GetBookingByID(ctx context.Context, id string) (*model.Booking, error) {
-	objectID, err := primitive.ObjectIDFromHex(id)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid booking ID format")
-	}
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid booking ID format")
+		}
 
-	var booking model.Booking
-	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&booking)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, nil // No booking found
+		var booking model.Booking
+		err = tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"_id": objectID, "deletedAt": nil}).Decode(&booking)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil // No booking found
+			}
+			return nil, errors.Wrap(err, "failed to get booking")
 		}
-		return nil, errors.Wrap(err, "failed to get booking")
-	}
 
-	return &booking, nil
+		return &booking, nil
+	})
+}
+
+// GetBookingByExternalRef retrieves a booking by its external reference
+func (r *MongoBookingRepository) GetBookingByExternalRef(ctx context.Context, externalRef string) (*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		var booking model.Booking
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"externalRef": externalRef, "deletedAt": nil}).Decode(&booking)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil // No booking found
+			}
+			return nil, errors.Wrap(err, "failed to get booking by external reference")
+		}
+
+		return &booking, nil
+	})
+}
+
+// GetBookingByIdempotencyKey retrieves the booking a user created with an idempotency key
+func (r *MongoBookingRepository) GetBookingByIdempotencyKey(ctx context.Context, userID, key string) (*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		var booking model.Booking
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"userId": userID, "idempotencyKey": key, "deletedAt": nil}).Decode(&booking)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil // No booking found
+			}
+			return nil, errors.Wrap(err, "failed to get booking by idempotency key")
+		}
+
+		return &booking, nil
+	})
 }
 
 // UpdateBooking updates an existing booking
 func (r *MongoBookingRepository) UpdateBooking(ctx context.Context, id string, updates map[string]interface{}) (*model.Booking, error) {
-	objectID, err := primitive.ObjectIDFromHex(id)
-	if err != nil {
-		return nil, errors.Wrap(err, "invalid booking ID format")
-	}
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid booking ID format")
+		}
 
+		return r.updateBooking(ctx, bson.M{"_id": objectID, "deletedAt": nil}, updates)
+	})
+}
+
+// UpdateBookingAtVersion updates a booking only if it's still at the expected
+// version. It returns nil if the booking doesn't exist or has changed since.
+func (r *MongoBookingRepository) UpdateBookingAtVersion(ctx context.Context, id string, version int64, updates map[string]interface{}) (*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid booking ID format")
+		}
+
+		filter := bson.M{"_id": objectID, "version": version, "deletedAt": nil}
+		if version == 0 {
+			// Bookings created before versioning have no version field
+			filter["version"] = bson.M{"$in": bson.A{int64(0), nil}}
+		}
+
+		return r.updateBooking(ctx, filter, updates)
+	})
+}
+
+// UpdateBookingIfUnset updates a booking only if none of fields is set on it.
+// It returns nil if the booking doesn't exist or one of them is set.
+func (r *MongoBookingRepository) UpdateBookingIfUnset(ctx context.Context, id string, fields []string, updates map[string]interface{}) (*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid booking ID format")
+		}
+
+		filter := bson.M{"_id": objectID, "deletedAt": nil}
+		for _, field := range fields {
+			filter[field] = nil
+		}
+
+		return r.updateBooking(ctx, filter, updates)
+	})
+}
+
+// updateBooking applies updates to the booking matching filter and bumps its version
+func (r *MongoBookingRepository) updateBooking(ctx context.Context, filter bson.M, updates map[string]interface{}) (*model.Booking, error) {
 	// Add updated timestamp
-	updates["updatedAt"] = time.Now()
+	updates["updatedAt"] = r.clock.Now()
 
-	update := bson.M{"$set": updates}
+	update := bson.M{
+		"$set": updates,
+		"$inc": bson.M{"version": 1},
+	}
 
 	// Create the options to return the updated document
 	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
 
-	result := r.collection.FindOneAndUpdate(
+	result := tenantCollection(ctx, r.collection).FindOneAndUpdate(
 		ctx,
-		bson.M{"_id": objectID},
+		filter,
 		update,
 		opts,
 	)
@@ -98,108 +332,1325 @@ func (r *MongoBookingRepository) UpdateBooking(ctx context.Context, id string, u
 	return &booking, nil
 }
 
-// CancelBooking sets a booking's status to cancelled
-func (r *MongoBookingRepository) CancelBooking(ctx context.Context, id string) (bool, error) {
-	objectID, err := primitive.ObjectIDFromHex(id)
+// RescheduleBooking moves a booking to a new time, or changes its length,
+// along with the move's other updates. The availability check and the move
+// run in one transaction, so a concurrent booking can't take the slot in
+// between; ErrSlotUnavailable is returned if the new time overlaps capacity
+// other bookings, and ErrResourceUnavailable if one of the resources has no
+// unit free then. It returns nil if the booking was moved, cancelled or, with
+// a version, changed concurrently. With WithCallerLocks the caller must hold
+// the barber's and resources' locks instead.
+func (r *MongoBookingRepository) RescheduleBooking(ctx context.Context, booking *model.Booking, move BookingMove, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		occupiedUntil := model.CalculateOccupiedUntil(move.EndTime, move.CleanupMinutes)
+
+		result, err := r.inBarberTransaction(ctx, booking.BarberID, resources, func(sessCtx context.Context) (interface{}, error) {
+			conflict, err := r.hasConflict(sessCtx, booking.BarberID, move.StartTime, occupiedUntil, booking.ID, capacity)
+			if err != nil {
+				return nil, err
+			}
+			if conflict {
+				return nil, ErrSlotUnavailable
+			}
+			full, err := r.resourcesFull(sessCtx, resources, move.StartTime, occupiedUntil, booking.ID)
+			if err != nil {
+				return nil, err
+			}
+			if full {
+				return nil, ErrResourceUnavailable
+			}
+
+			now := r.clock.Now()
+			filter := bson.M{
+				"_id":       booking.ID,
+				"startTime": booking.StartTime,
+				"status":    bson.M{"$in": []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}},
+				"deletedAt": nil,
+			}
+			if move.Version != nil {
+				filter["version"] = *move.Version
+				if *move.Version == 0 {
+					// Bookings created before versioning have no version field
+					filter["version"] = bson.M{"$in": bson.A{int64(0), nil}}
+				}
+			}
+
+			set := bson.M{
+				"startTime": move.StartTime,
+				"endTime":   move.EndTime,
+				"updatedAt": now,
+			}
+			for field, value := range move.Updates {
+				set[field] = value
+			}
+			update := bson.M{
+				"$set": set,
+				"$inc": bson.M{"version": 1},
+			}
+			if !move.StartTime.Equal(booking.StartTime) {
+				set["rescheduledFrom"] = booking.StartTime
+				set["rescheduledAt"] = now
+				// A check-in and a reminder belong to the original appointment
+				update["$unset"] = bson.M{"checkedInAt": "", "reminderSentAt": ""}
+			}
+
+			opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+			var updated model.Booking
+			if err := tenantCollection(sessCtx, r.collection).FindOneAndUpdate(sessCtx, filter, update, opts).Decode(&updated); err != nil {
+				if err == mongo.ErrNoDocuments {
+					return (*model.Booking)(nil), nil
+				}
+				return nil, errors.Wrap(err, "failed to reschedule booking")
+			}
+
+			return &updated, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return result.(*model.Booking), nil
+	})
+}
+
+// inBarberTransaction runs fn in a transaction that first writes the lock
+// documents of the barber and of the resources. Concurrent transactions for
+// the same barber or resource then conflict and are retried, instead of both
+// passing an availability check. Called within a transaction, fn joins it.
+// With WithCallerLocks fn runs as is, in ctx's transaction if it has one.
+func (r *MongoBookingRepository) inBarberTransaction(ctx context.Context, barberID string, resources []*model.Resource, fn func(sessCtx context.Context) (interface{}, error)) (interface{}, error) {
+	if r.lockedByCaller {
+		return fn(ctx)
+	}
+
+	return inTransaction(ctx, r.client, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		_, err := tenantCollection(sessCtx, r.locks).UpdateOne(sessCtx,
+			bson.M{"_id": barberID},
+			bson.M{"$inc": bson.M{"version": 1}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to lock barber schedule")
+		}
+
+		for _, resource := range resources {
+			_, err := tenantCollection(sessCtx, r.resourceLocks).UpdateOne(sessCtx,
+				bson.M{"_id": resource.ID},
+				bson.M{"$inc": bson.M{"version": 1}},
+				options.Update().SetUpsert(true),
+			)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to lock resource")
+			}
+		}
+
+		return fn(sessCtx)
+	})
+}
+
+// hasConflict reports whether the barber's bookings other than excludeID
+// already fill their capacity somewhere in the window, including cleanup
+// buffers
+func (r *MongoBookingRepository) hasConflict(ctx context.Context, barberID string, start, occupiedUntil time.Time, excludeID primitive.ObjectID, capacity int) (bool, error) {
+	// Widen the search so earlier bookings whose buffer runs into the window are found
+	bookings, err := r.GetBookingsInTimeRange(ctx, barberID, start.Add(-model.MaxCleanupBuffer), occupiedUntil)
 	if err != nil {
-		return false, errors.Wrap(err, "invalid booking ID format")
+		return false, err
 	}
 
-	update := bson.M{
-		"$set": bson.M{
-			"status":    model.BookingStatusCancelled,
-			"updatedAt": time.Now(),
-		},
+	others := bookings[:0]
+	for _, booking := range bookings {
+		if booking.ID != excludeID {
+			others = append(others, booking)
+		}
 	}
 
-	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	return !model.FitsCapacity(others, start, occupiedUntil, capacity), nil
+}
+
+// resourcesFull reports whether the bookings other than excludeID, with any
+// barber, already take every unit of one of the resources somewhere in the
+// window
+func (r *MongoBookingRepository) resourcesFull(ctx context.Context, resources []*model.Resource, start, occupiedUntil time.Time, excludeID primitive.ObjectID) (bool, error) {
+	if len(resources) == 0 {
+		return false, nil
+	}
+
+	bookings, err := r.GetBookingsForServices(ctx, model.ResourceServiceTypes(resources), start.Add(-model.MaxCleanupBuffer), occupiedUntil)
 	if err != nil {
-		return false, errors.Wrap(err, "failed to cancel booking")
+		return false, err
+	}
+
+	others := bookings[:0]
+	for _, booking := range bookings {
+		if booking.ID != excludeID {
+			others = append(others, booking)
+		}
 	}
 
-	return result.ModifiedCount > 0, nil
+	for _, resource := range resources {
+		if !model.FitsCapacity(resource.Users(others), start, occupiedUntil, resource.Capacity) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// TransitionBookingStatus changes a booking's status, along with any other
+// updates, only if the booking is still in the expected status. It returns
+// nil if no booking with the ID is in that status.
+func (r *MongoBookingRepository) TransitionBookingStatus(ctx context.Context, id string, from, to model.BookingStatus, updates map[string]interface{}) (*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid booking ID format")
+		}
+
+		set := bson.M{}
+		for field, value := range updates {
+			set[field] = value
+		}
+		set["status"] = to
+		set["updatedAt"] = r.clock.Now()
+
+		filter := bson.M{
+			"_id":       objectID,
+			"status":    from,
+			"deletedAt": nil,
+		}
+
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var booking model.Booking
+		update := bson.M{
+			"$set": set,
+			"$inc": bson.M{"version": 1},
+		}
+
+		if err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, filter, update, opts).Decode(&booking); err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to update booking status")
+		}
+
+		return &booking, nil
+	})
+}
+
+// AddAttachment appends an attachment to a booking
+func (r *MongoBookingRepository) AddAttachment(ctx context.Context, id string, attachment *model.Attachment) (*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid booking ID format")
+		}
+
+		update := bson.M{
+			"$push": bson.M{"attachments": attachment},
+			"$set":  bson.M{"updatedAt": r.clock.Now()},
+			"$inc":  bson.M{"version": 1},
+		}
+
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var booking model.Booking
+		err = tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": objectID, "deletedAt": nil}, update, opts).Decode(&booking)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil // No booking found
+			}
+			return nil, errors.Wrap(err, "failed to add attachment")
+		}
+
+		return &booking, nil
+	})
+}
+
+// ListBookings retrieves the bookings matching a filter, sorted as requested
+func (r *MongoBookingRepository) ListBookings(ctx context.Context, filter model.BookingFilter) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		query := bson.M{"deletedAt": nil}
+		if filter.UserID != "" {
+			query["userId"] = filter.UserID
+		}
+		if filter.BarberID != "" {
+			query["barberId"] = filter.BarberID
+		}
+		if len(filter.Statuses) > 0 {
+			query["status"] = bson.M{"$in": filter.Statuses}
+		}
+		if filter.NeedsReview {
+			query["status"] = model.BookingStatusConfirmed
+			query["reviewFlaggedAt"] = bson.M{"$exists": true}
+		}
+		if len(filter.ServiceTypes) > 0 {
+			query["$or"] = []bson.M{
+				{"serviceType": bson.M{"$in": filter.ServiceTypes}},
+				{"serviceTypes": bson.M{"$in": filter.ServiceTypes}},
+			}
+		}
+
+		startTime := bson.M{}
+		if filter.StartFrom != nil {
+			startTime["$gte"] = *filter.StartFrom
+		}
+		if filter.StartBefore != nil {
+			startTime["$lt"] = *filter.StartBefore
+		}
+		if len(startTime) > 0 {
+			query["startTime"] = startTime
+		}
+
+		sortField := "startTime"
+		switch filter.SortBy {
+		case model.SortByCreatedAt:
+			sortField = "createdAt"
+		case model.SortByUpdatedAt:
+			sortField = "updatedAt"
+		}
+		order := 1
+		if filter.Descending {
+			order = -1
+		}
+
+		opts := options.Find().SetSort(bson.D{{Key: sortField, Value: order}, {Key: "_id", Value: order}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, query, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
 }
 
 // GetUserBookings retrieves all bookings for a specific user
 func (r *MongoBookingRepository) GetUserBookings(ctx context.Context, userID string) ([]*model.Booking, error) {
-	cursor, err := r.collection.Find(ctx, bson.M{"userId": userID})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get user bookings")
-	}
-	defer cursor.Close(ctx)
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{"userId": userID, "deletedAt": nil})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user bookings")
+		}
+		defer cursor.Close(ctx)
 
-	var bookings []*model.Booking
-	if err := cursor.All(ctx, &bookings); err != nil {
-		return nil, errors.Wrap(err, "failed to decode bookings")
-	}
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// FindUserBookings retrieves all bookings of a user, soft-deleted ones
+// included, for exporting or erasing the user's data
+func (r *MongoBookingRepository) FindUserBookings(ctx context.Context, userID string) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		opts := options.Find().SetSort(bson.D{{Key: "startTime", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{"userId": userID}, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find user bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
 
-	return bookings, nil
+		return bookings, nil
+	})
 }
 
 // GetBarberBookings retrieves all bookings for a specific barber
 func (r *MongoBookingRepository) GetBarberBookings(ctx context.Context, barberID string, date *time.Time) ([]*model.Booking, error) {
-	filter := bson.M{"barberId": barberID}
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{"barberId": barberID, "deletedAt": nil}
 
-	// Add date filter if specified
-	if date != nil {
-		startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-		endOfDay := startOfDay.Add(24 * time.Hour)
+		// Add date filter if specified
+		if date != nil {
+			startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+			endOfDay := startOfDay.AddDate(0, 0, 1) // Not 24h on DST transitions
 
-		filter["startTime"] = bson.M{
-			"$gte": startOfDay,
-			"$lt":  endOfDay,
+			filter["startTime"] = bson.M{
+				"$gte": startOfDay,
+				"$lt":  endOfDay,
+			}
 		}
-	}
 
-	cursor, err := r.collection.Find(ctx, filter)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get barber bookings")
-	}
-	defer cursor.Close(ctx)
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get barber bookings")
+		}
+		defer cursor.Close(ctx)
 
-	var bookings []*model.Booking
-	if err := cursor.All(ctx, &bookings); err != nil {
-		return nil, errors.Wrap(err, "failed to decode bookings")
-	}
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
 
-	return bookings, nil
+		return bookings, nil
+	})
 }
 
 // GetBookingsInTimeRange retrieves all bookings for a barber in a time range
 func (r *MongoBookingRepository) GetBookingsInTimeRange(ctx context.Context, barberID string, start, end time.Time) ([]*model.Booking, error) {
-	filter := bson.M{
-		"barberId": barberID,
-		"status":   bson.M{"$ne": model.BookingStatusCancelled},
-		"$or": []bson.M{
-			{
-				"startTime": bson.M{
-					"$gte": start,
-					"$lt":  end,
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"barberId":  barberID,
+			"status":    bson.M{"$ne": model.BookingStatusCancelled},
+			"deletedAt": nil,
+			"$nor":      []bson.M{lapsedHolds(r.clock.Now())},
+			"$or": []bson.M{
+				{
+					"startTime": bson.M{
+						"$gte": start,
+						"$lt":  end,
+					},
+				},
+				{
+					"endTime": bson.M{
+						"$gt":  start,
+						"$lte": end,
+					},
+				},
+				{
+					"startTime": bson.M{"$lte": start},
+					"endTime":   bson.M{"$gte": end},
 				},
 			},
-			{
-				"endTime": bson.M{
-					"$gt":  start,
-					"$lte": end,
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get bookings in time range")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// GetBookingsForServices retrieves the active bookings with any barber that
+// include one of the services and overlap a time range
+func (r *MongoBookingRepository) GetBookingsForServices(ctx context.Context, serviceTypes []model.ServiceType, start, end time.Time) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"status":    bson.M{"$ne": model.BookingStatusCancelled},
+			"deletedAt": nil,
+			"$nor":      []bson.M{lapsedHolds(r.clock.Now())},
+			"startTime": bson.M{"$lt": end},
+			"endTime":   bson.M{"$gt": start},
+			// Bookings stored before they could have several services only have the one
+			"$or": []bson.M{
+				{"serviceTypes": bson.M{"$in": serviceTypes}},
+				{"serviceType": bson.M{"$in": serviceTypes}},
+			},
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get bookings for services")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// GetCompletedBookings retrieves all completed bookings starting in a time range
+func (r *MongoBookingRepository) GetCompletedBookings(ctx context.Context, start, end time.Time) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"status": model.BookingStatusCompleted,
+			"startTime": bson.M{
+				"$gte": start,
+				"$lt":  end,
+			},
+			"deletedAt": nil,
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get completed bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// revenueUnits are the $dateTrunc units of the revenue granularities
+var revenueUnits = map[model.RevenueGranularity]string{
+	model.RevenueByDay:   "day",
+	model.RevenueByWeek:  "week",
+	model.RevenueByMonth: "month",
+}
+
+// GetRevenue sums what the completed bookings starting in a time range
+// earned by period, barber, service and currency, with periods taken in
+// start's location. A booking earns what was paid at the point of sale, or
+// its quoted price if no payment was recorded, split evenly between its
+// services. An empty barber ID sums every barber's.
+func (r *MongoBookingRepository) GetRevenue(ctx context.Context, barberID string, start, end time.Time, granularity model.RevenueGranularity) (*model.RevenueTotals, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.RevenueTotals, error) {
+		loc := start.Location()
+		match := bson.M{
+			"status":    model.BookingStatusCompleted,
+			"startTime": bson.M{"$gte": start, "$lt": end},
+			"deletedAt": nil,
+		}
+		if barberID != "" {
+			match["barberId"] = barberID
+		}
+
+		// Mirrors model.Booking.RevenueServices
+		nonEmpty := func(field string) bson.M {
+			return bson.M{"$gt": bson.A{bson.M{"$size": bson.M{"$ifNull": bson.A{field, bson.A{}}}}, 0}}
+		}
+		services := bson.M{"$switch": bson.M{
+			"branches": bson.A{
+				bson.M{"case": nonEmpty("$payment.renderedServices"), "then": "$payment.renderedServices"},
+				bson.M{"case": nonEmpty("$serviceTypes"), "then": "$serviceTypes"},
+			},
+			"default": bson.A{"$serviceType"},
+		}}
+		// Mirrors model.SplitAmount
+		share := func(field string) bson.M {
+			return bson.M{"$add": bson.A{
+				bson.M{"$toLong": bson.M{"$floor": bson.M{"$divide": bson.A{field, "$shares"}}}},
+				bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$share", 0}}, bson.M{"$mod": bson.A{field, "$shares"}}, 0}},
+			}}
+		}
+
+		period := bson.M{"date": "$startTime", "unit": revenueUnits[granularity], "timezone": loc.String()}
+		if granularity == model.RevenueByWeek {
+			period["startOfWeek"] = "monday"
+		}
+
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: match}},
+			{{Key: "$project", Value: bson.M{
+				"barberId": 1,
+				"services": services,
+				"amount":   bson.M{"$ifNull": bson.A{"$payment.amount", "$price"}},
+				"tip":      bson.M{"$ifNull": bson.A{"$payment.tip", 0}},
+				"currency": bson.M{"$ifNull": bson.A{"$payment.currency", "$currency"}},
+				"period":   bson.M{"$dateTrunc": period},
+			}}},
+			{{Key: "$facet", Value: bson.M{
+				"bookings": bson.A{bson.M{"$count": "count"}},
+				"lines": bson.A{
+					bson.M{"$set": bson.M{"shares": bson.M{"$size": "$services"}}},
+					bson.M{"$unwind": bson.M{"path": "$services", "includeArrayIndex": "share"}},
+					bson.M{"$group": bson.M{
+						"_id": bson.M{
+							"period":      "$period",
+							"barberId":    "$barberId",
+							"serviceType": "$services",
+							"currency":    "$currency",
+						},
+						"bookings": bson.M{"$sum": 1},
+						"revenue":  bson.M{"$sum": share("$amount")},
+						"tips":     bson.M{"$sum": share("$tip")},
+					}},
 				},
+			}}},
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to aggregate revenue")
+		}
+		defer cursor.Close(ctx)
+
+		var facets []struct {
+			Bookings []struct {
+				Count int `bson:"count"`
+			} `bson:"bookings"`
+			Lines []struct {
+				ID struct {
+					Period      time.Time         `bson:"period"`
+					BarberID    string            `bson:"barberId"`
+					ServiceType model.ServiceType `bson:"serviceType"`
+					Currency    string            `bson:"currency"`
+				} `bson:"_id"`
+				Bookings int   `bson:"bookings"`
+				Revenue  int64 `bson:"revenue"`
+				Tips     int64 `bson:"tips"`
+			} `bson:"lines"`
+		}
+		if err := cursor.All(ctx, &facets); err != nil {
+			return nil, errors.Wrap(err, "failed to decode revenue")
+		}
+
+		totals := &model.RevenueTotals{}
+		if len(facets) == 0 {
+			return totals, nil
+		}
+		if len(facets[0].Bookings) > 0 {
+			totals.Bookings = facets[0].Bookings[0].Count
+		}
+		for _, line := range facets[0].Lines {
+			totals.Lines = append(totals.Lines, &model.RevenueTotal{
+				RevenueLine: model.RevenueLine{
+					PeriodStart: line.ID.Period.In(loc),
+					BarberID:    line.ID.BarberID,
+					ServiceType: line.ID.ServiceType,
+					Bookings:    line.Bookings,
+					Revenue:     line.Revenue,
+					Tips:        line.Tips,
+				},
+				Currency: line.ID.Currency,
+			})
+		}
+
+		return totals, nil
+	})
+}
+
+// GetUserReliability counts a customer's bookings by status
+func (r *MongoBookingRepository) GetUserReliability(ctx context.Context, userID string) (*model.UserReliability, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.UserReliability, error) {
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{"userId": userID, "deletedAt": nil}}},
+			{{Key: "$group", Value: bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}}},
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to aggregate user bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var groups []struct {
+			Status model.BookingStatus `bson:"_id"`
+			Count  int                 `bson:"count"`
+		}
+		if err := cursor.All(ctx, &groups); err != nil {
+			return nil, errors.Wrap(err, "failed to decode user booking counts")
+		}
+
+		reliability := &model.UserReliability{UserID: userID}
+		for _, group := range groups {
+			reliability.Bookings += group.Count
+			switch group.Status {
+			case model.BookingStatusCompleted:
+				reliability.Completed = group.Count
+			case model.BookingStatusCancelled:
+				reliability.Cancelled = group.Count
+			case model.BookingStatusNoShow:
+				reliability.NoShows = group.Count
+			}
+		}
+
+		return reliability, nil
+	})
+}
+
+// GetBarberStats counts a barber's bookings starting in a time range by
+// status, and sums the time booked on each day, taken in start's location
+func (r *MongoBookingRepository) GetBarberStats(ctx context.Context, barberID string, start, end time.Time) (*model.BarberStats, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.BarberStats, error) {
+		loc := start.Location()
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{
+				"barberId":  barberID,
+				"startTime": bson.M{"$gte": start, "$lt": end},
+				"deletedAt": nil,
+			}}},
+			{{Key: "$facet", Value: bson.M{
+				"statuses": bson.A{
+					bson.M{"$group": bson.M{"_id": "$status", "count": bson.M{"$sum": 1}}},
+				},
+				"days": bson.A{
+					bson.M{"$match": bson.M{"status": bson.M{"$in": model.BookedStatuses}}},
+					bson.M{"$group": bson.M{
+						"_id": bson.M{"$dateToString": bson.M{
+							"format":   "%Y-%m-%d",
+							"date":     "$startTime",
+							"timezone": loc.String(),
+						}},
+						"bookings": bson.M{"$sum": 1},
+						"bookedMs": bson.M{"$sum": bson.M{"$subtract": bson.A{"$endTime", "$startTime"}}},
+					}},
+				},
+			}}},
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to aggregate barber bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var facets []struct {
+			Statuses []struct {
+				Status model.BookingStatus `bson:"_id"`
+				Count  int                 `bson:"count"`
+			} `bson:"statuses"`
+			Days []struct {
+				Day      string `bson:"_id"`
+				Bookings int    `bson:"bookings"`
+				BookedMs int64  `bson:"bookedMs"`
+			} `bson:"days"`
+		}
+		if err := cursor.All(ctx, &facets); err != nil {
+			return nil, errors.Wrap(err, "failed to decode barber booking stats")
+		}
+
+		stats := &model.BarberStats{
+			BarberID:     barberID,
+			Start:        start,
+			End:          end,
+			StatusCounts: make(map[model.BookingStatus]int),
+		}
+		if len(facets) == 0 {
+			return stats, nil
+		}
+		for _, group := range facets[0].Statuses {
+			stats.StatusCounts[group.Status] = group.Count
+			stats.Bookings += group.Count
+		}
+		for _, group := range facets[0].Days {
+			date, err := time.ParseInLocation(model.StatsDayFormat, group.Day, loc)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid booking day %q", group.Day)
+			}
+			booked := time.Duration(group.BookedMs) * time.Millisecond
+			stats.Days = append(stats.Days, &model.DayStats{Date: date, Bookings: group.Bookings, BookedTime: booked})
+			stats.BookedTime += booked
+		}
+
+		return stats, nil
+	})
+}
+
+// GetDemandHeatmap counts the bookings taking up barbers' time that started
+// in a time range by weekday and hour, taken in start's location. An empty
+// barber ID counts every barber's.
+func (r *MongoBookingRepository) GetDemandHeatmap(ctx context.Context, barberID string, start, end time.Time) (*model.DemandHeatmap, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.DemandHeatmap, error) {
+		loc := start.Location()
+		match := bson.M{
+			"startTime": bson.M{"$gte": start, "$lt": end},
+			"status":    bson.M{"$in": model.BookedStatuses},
+			"deletedAt": nil,
+		}
+		if barberID != "" {
+			match["barberId"] = barberID
+		}
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: match}},
+			{{Key: "$group", Value: bson.M{
+				"_id": bson.M{
+					"weekday": bson.M{"$dayOfWeek": bson.M{"date": "$startTime", "timezone": loc.String()}},
+					"hour":    bson.M{"$hour": bson.M{"date": "$startTime", "timezone": loc.String()}},
+				},
+				"count": bson.M{"$sum": 1},
+			}}},
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to aggregate booking demand")
+		}
+		defer cursor.Close(ctx)
+
+		var groups []struct {
+			ID struct {
+				Weekday int `bson:"weekday"` // 1 is Sunday
+				Hour    int `bson:"hour"`
+			} `bson:"_id"`
+			Count int `bson:"count"`
+		}
+		if err := cursor.All(ctx, &groups); err != nil {
+			return nil, errors.Wrap(err, "failed to decode booking demand")
+		}
+
+		heatmap := &model.DemandHeatmap{BarberID: barberID, Start: start, End: end, Timezone: loc.String()}
+		for _, group := range groups {
+			heatmap.Counts[group.ID.Weekday-1][group.ID.Hour] = group.Count
+		}
+
+		return heatmap, nil
+	})
+}
+
+// GetReliabilityReport counts how the bookings starting in a period turned
+// out per customer or barber, returning the rows with the highest rate first
+func (r *MongoBookingRepository) GetReliabilityReport(ctx context.Context, filter model.ReliabilityFilter) ([]*model.ReliabilityRow, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.ReliabilityRow, error) {
+		match := bson.M{
+			"startTime": bson.M{"$gte": filter.Start, "$lt": filter.End},
+			"status":    bson.M{"$ne": model.BookingStatusHeld},
+			"deletedAt": nil,
+		}
+		if filter.BarberID != "" {
+			match["barberId"] = filter.BarberID
+		}
+		groupBy := "$barberId"
+		if filter.GroupBy == model.ReliabilityByUser {
+			// Anonymized bookings no longer say whose they were
+			groupBy = "$userId"
+			match["userId"] = bson.M{"$ne": ""}
+		}
+
+		countOf := func(status model.BookingStatus) bson.M {
+			return bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$status", status}}, 1, 0}}}
+		}
+		rate := bson.M{"$cond": bson.A{
+			bson.M{"$gt": bson.A{bson.M{"$add": bson.A{"$completed", "$noShows"}}, 0}},
+			bson.M{"$divide": bson.A{"$noShows", bson.M{"$add": bson.A{"$completed", "$noShows"}}}},
+			0,
+		}}
+		count := "$noShows"
+		if filter.SortBy == model.SortByCancellationRate {
+			rate = bson.M{"$divide": bson.A{"$cancelled", "$bookings"}}
+			count = "$cancelled"
+		}
+
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: match}},
+			{{Key: "$group", Value: bson.M{
+				"_id":       groupBy,
+				"bookings":  bson.M{"$sum": 1},
+				"completed": countOf(model.BookingStatusCompleted),
+				"cancelled": countOf(model.BookingStatusCancelled),
+				"noShows":   countOf(model.BookingStatusNoShow),
+			}}},
+			{{Key: "$match", Value: bson.M{"bookings": bson.M{"$gte": max(filter.MinBookings, 1)}}}},
+			{{Key: "$addFields", Value: bson.M{"rate": rate, "count": count}}},
+			{{Key: "$sort", Value: bson.D{{Key: "rate", Value: -1}, {Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+		}
+		if filter.Limit > 0 {
+			pipeline = append(pipeline, bson.D{{Key: "$limit", Value: filter.Limit}})
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to aggregate booking outcomes")
+		}
+		defer cursor.Close(ctx)
+
+		var rows []*model.ReliabilityRow
+		if err := cursor.All(ctx, &rows); err != nil {
+			return nil, errors.Wrap(err, "failed to decode booking outcomes")
+		}
+
+		return rows, nil
+	})
+}
+
+// GetCustomerSummary counts a customer's completed bookings, finds their first
+// and last and the service they start with most often, ties going to the one
+// booked last
+func (r *MongoBookingRepository) GetCustomerSummary(ctx context.Context, userID string) (*model.CustomerSummary, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.CustomerSummary, error) {
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{
+				"userId":    userID,
+				"status":    model.BookingStatusCompleted,
+				"deletedAt": nil,
+			}}},
+			{{Key: "$facet", Value: bson.M{
+				"visits": bson.A{
+					bson.M{"$group": bson.M{
+						"_id":   nil,
+						"count": bson.M{"$sum": 1},
+						"first": bson.M{"$min": "$startTime"},
+						"last":  bson.M{"$max": "$startTime"},
+					}},
+				},
+				"services": bson.A{
+					bson.M{"$group": bson.M{
+						"_id":   "$serviceType",
+						"count": bson.M{"$sum": 1},
+						"last":  bson.M{"$max": "$startTime"},
+					}},
+					bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "last", Value: -1}, {Key: "_id", Value: 1}}},
+					bson.M{"$limit": 1},
+				},
+			}}},
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to aggregate customer bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var facets []struct {
+			Visits []struct {
+				Count int       `bson:"count"`
+				First time.Time `bson:"first"`
+				Last  time.Time `bson:"last"`
+			} `bson:"visits"`
+			Services []struct {
+				ServiceType model.ServiceType `bson:"_id"`
+			} `bson:"services"`
+		}
+		if err := cursor.All(ctx, &facets); err != nil {
+			return nil, errors.Wrap(err, "failed to decode customer summary")
+		}
+
+		summary := &model.CustomerSummary{UserID: userID}
+		if len(facets) == 0 || len(facets[0].Visits) == 0 {
+			return summary, nil
+		}
+		visits := facets[0].Visits[0]
+		summary.Visits = visits.Count
+		summary.FirstVisit = &visits.First
+		summary.LastVisit = &visits.Last
+		if len(facets[0].Services) > 0 {
+			summary.UsualService = facets[0].Services[0].ServiceType
+		}
+
+		return summary, nil
+	})
+}
+
+// FindLateBookings retrieves active bookings that started before a cutoff,
+// are still running and whose customer hasn't checked in
+func (r *MongoBookingRepository) FindLateBookings(ctx context.Context, startedBefore, now time.Time) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"status":      bson.M{"$in": []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}},
+			"startTime":   bson.M{"$lt": startedBefore},
+			"endTime":     bson.M{"$gt": now},
+			"checkedInAt": bson.M{"$exists": false},
+			"releasedAt":  bson.M{"$exists": false},
+			"deletedAt":   nil,
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find late bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// FindUnpaidBookings retrieves pending bookings whose deposit wasn't paid
+// before it expired
+func (r *MongoBookingRepository) FindUnpaidBookings(ctx context.Context, expiredBefore time.Time) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"status":            model.BookingStatusPending,
+			"deposit.status":    model.DepositStatusPending,
+			"deposit.expiresAt": bson.M{"$lt": expiredBefore},
+			"deletedAt":         nil,
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find unpaid bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// FindBookingsToAutoComplete retrieves up to limit confirmed bookings that
+// ended before a cutoff and haven't been flagged for review, oldest first
+func (r *MongoBookingRepository) FindBookingsToAutoComplete(ctx context.Context, endedBefore time.Time, limit int64) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"status":          model.BookingStatusConfirmed,
+			"endTime":         bson.M{"$lt": endedBefore},
+			"reviewFlaggedAt": bson.M{"$exists": false},
+			"deletedAt":       nil,
+		}
+		opts := options.Find().SetSort(bson.D{{Key: "endTime", Value: 1}}).SetLimit(limit)
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find bookings to complete")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// FindStalePendingBookings retrieves up to limit bookings created before a
+// cutoff that are still pending, oldest first. Bookings awaiting a deposit
+// are left out, as the deposit's own deadline applies to them.
+func (r *MongoBookingRepository) FindStalePendingBookings(ctx context.Context, createdBefore time.Time, limit int64) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"status":    model.BookingStatusPending,
+			"createdAt": bson.M{"$lt": createdBefore},
+			"deposit":   bson.M{"$exists": false},
+			"deletedAt": nil,
+		}
+		opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}}).SetLimit(limit)
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find stale pending bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// FindBookingsDueReminder retrieves up to limit active bookings starting
+// between now and a cutoff that haven't been sent a reminder, soonest first
+func (r *MongoBookingRepository) FindBookingsDueReminder(ctx context.Context, now, startsBefore time.Time, limit int64) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"reminderSentAt": nil,
+			"startTime":      bson.M{"$gt": now, "$lte": startsBefore},
+			"status":         bson.M{"$in": []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed}},
+			"deletedAt":      nil,
+		}
+		opts := options.Find().SetSort(bson.D{{Key: "startTime", Value: 1}}).SetLimit(limit)
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find bookings due a reminder")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// MarkReminderSent records that a booking's customer was reminded of it. It
+// returns false if the booking was rescheduled or already marked meanwhile.
+// The booking's version isn't bumped, as this isn't a change to the booking.
+func (r *MongoBookingRepository) MarkReminderSent(ctx context.Context, id string, startTime, sentAt time.Time) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return false, nil
+		}
+
+		filter := bson.M{"_id": objectID, "startTime": startTime, "reminderSentAt": nil, "deletedAt": nil}
+		result, err := tenantCollection(ctx, r.collection).UpdateOne(ctx, filter, bson.M{"$set": bson.M{"reminderSentAt": sentAt}})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to mark reminder sent")
+		}
+
+		return result.ModifiedCount > 0, nil
+	})
+}
+
+// FindExpiredBookings retrieves up to limit bookings with a status that ended
+// before a cutoff, for applying retention policies
+func (r *MongoBookingRepository) FindExpiredBookings(ctx context.Context, status model.BookingStatus, endedBefore time.Time, includeAnonymized bool, limit int64) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{
+			"status":    status,
+			"endTime":   bson.M{"$lt": endedBefore},
+			"deletedAt": nil,
+		}
+		if !includeAnonymized {
+			filter["anonymized"] = bson.M{"$ne": true}
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, options.Find().SetLimit(limit))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find expired bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		return bookings, nil
+	})
+}
+
+// DeleteBookings permanently removes bookings by ID
+func (r *MongoBookingRepository) DeleteBookings(ctx context.Context, ids []string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		objectIDs, err := toObjectIDs(ids)
+		if err != nil {
+			return 0, err
+		}
+
+		result, err := tenantCollection(ctx, r.collection).DeleteMany(ctx, bson.M{"_id": bson.M{"$in": objectIDs}})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to delete bookings")
+		}
+
+		return result.DeletedCount, nil
+	})
+}
+
+// AnonymizeBookings strips personal data from bookings while keeping the
+// figures needed for reporting
+func (r *MongoBookingRepository) AnonymizeBookings(ctx context.Context, ids []string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		objectIDs, err := toObjectIDs(ids)
+		if err != nil {
+			return 0, err
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"userId":     "",
+				"anonymized": true,
+				"updatedAt":  r.clock.Now(),
 			},
-			{
-				"startTime": bson.M{"$lte": start},
-				"endTime":   bson.M{"$gte": end},
+			"$unset": bson.M{
+				"notes":                    "",
+				"externalRef":              "",
+				"attachments":              "",
+				"cancellation.cancelledBy": "",
+				"cancellation.reason":      "",
 			},
-		},
-	}
+			"$inc": bson.M{"version": 1},
+		}
 
-	cursor, err := r.collection.Find(ctx, filter)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get bookings in time range")
-	}
-	defer cursor.Close(ctx)
+		result, err := tenantCollection(ctx, r.collection).UpdateMany(ctx, bson.M{"_id": bson.M{"$in": objectIDs}}, update)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to anonymize bookings")
+		}
+
+		return result.ModifiedCount, nil
+	})
+}
+
+// FindDuplicateBooking finds an active booking created after createdAfter that
+// matches the same user, barber, start time and services
+func (r *MongoBookingRepository) FindDuplicateBooking(ctx context.Context, userID, barberID string, startTime time.Time, serviceTypes []model.ServiceType, createdAfter time.Time) (*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		// Bookings stored before they could have several services only have the one
+		sameServices := bson.A{serviceTypes}
+		if len(serviceTypes) == 1 {
+			sameServices = append(sameServices, nil)
+		}
+
+		filter := bson.M{
+			"userId":       userID,
+			"barberId":     barberID,
+			"startTime":    startTime,
+			"serviceType":  serviceTypes[0],
+			"serviceTypes": bson.M{"$in": sameServices},
+			"status": bson.M{"$in": []model.BookingStatus{
+				model.BookingStatusPending,
+				model.BookingStatusConfirmed,
+			}},
+			"createdAt": bson.M{"$gte": createdAfter},
+			"deletedAt": nil,
+		}
+
+		var booking model.Booking
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, filter).Decode(&booking)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil // No duplicate found
+			}
+			return nil, errors.Wrap(err, "failed to find duplicate booking")
+		}
+
+		return &booking, nil
+	})
+}
+
+// SoftDeleteBooking marks a booking deleted at a time, after which no other
+// method finds it. It returns the deleted booking, or nil if there's no such
+// booking or it was deleted already.
+func (r *MongoBookingRepository) SoftDeleteBooking(ctx context.Context, id string, deletedAt time.Time) (*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid booking ID format")
+		}
+
+		update := bson.M{
+			"$set": bson.M{"deletedAt": deletedAt, "updatedAt": deletedAt},
+			"$inc": bson.M{"version": 1},
+		}
+
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var booking model.Booking
+		err = tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": objectID, "deletedAt": nil}, update, opts).Decode(&booking)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil // No booking found
+			}
+			return nil, errors.Wrap(err, "failed to soft-delete booking")
+		}
+
+		return &booking, nil
+	})
+}
+
+// FindDeletedBookings retrieves up to limit bookings soft-deleted before a
+// cutoff, oldest first, for purging them
+func (r *MongoBookingRepository) FindDeletedBookings(ctx context.Context, deletedBefore time.Time, limit int64) ([]*model.Booking, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		filter := bson.M{"deletedAt": bson.M{"$lt": deletedBefore}}
+		opts := options.Find().SetSort(bson.D{{Key: "deletedAt", Value: 1}}).SetLimit(limit)
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find deleted bookings")
+		}
+		defer cursor.Close(ctx)
+
+		var bookings []*model.Booking
+		if err := cursor.All(ctx, &bookings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
 
-	var bookings []*model.Booking
-	if err := cursor.All(ctx, &bookings); err != nil {
-		return nil, errors.Wrap(err, "failed to decode bookings")
+		return bookings, nil
+	})
+}
+
+// toObjectIDs converts hex booking IDs to MongoDB object IDs
+func toObjectIDs(ids []string) ([]primitive.ObjectID, error) {
+	objectIDs := make([]primitive.ObjectID, len(ids))
+	for i, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid booking ID format")
+		}
+		objectIDs[i] = objectID
 	}
+	return objectIDs, nil
+}
+
+// isDuplicateKeyOn reports whether err is a duplicate key error on the named index
+func isDuplicateKeyOn(err error, index string) bool {
+	return mongo.IsDuplicateKeyError(err) && strings.Contains(err.Error(), index)
+}
+
+// ConvertHold replaces a hold that hasn't lapsed by now with the booking
+// made from it, which has the hold's ID. It returns nil if the hold lapsed,
+// was converted already or is gone.
+func (r *MongoBookingRepository) ConvertHold(ctx context.Context, booking *model.Booking, now time.Time) (*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Booking, error) {
+		filter := bson.M{
+			"_id":           booking.ID,
+			"status":        model.BookingStatusHeld,
+			"holdExpiresAt": bson.M{"$gt": now},
+			"deletedAt":     nil,
+		}
+		booking.CreatedAt = now
+		booking.UpdatedAt = now
+		booking.Version++
+
+		opts := options.FindOneAndReplace().SetReturnDocument(options.After)
+
+		var converted model.Booking
+		if err := tenantCollection(ctx, r.collection).FindOneAndReplace(ctx, filter, booking, opts).Decode(&converted); err != nil {
+			switch {
+			case errors.Is(err, mongo.ErrNoDocuments):
+				return nil, nil
+			case isDuplicateKeyOn(err, "idempotencyKey_unique"):
+				return nil, ErrIdempotencyKeyUsed
+			case isDuplicateKeyOn(err, "externalRef_unique"):
+				return nil, ErrExternalRefExists
+			}
+			return nil, errors.Wrap(err, "failed to convert hold")
+		}
+
+		return &converted, nil
+	})
+}
+
+// DeleteExpiredHolds removes up to limit holds that lapsed before a cutoff,
+// returning the deleted ones. A hold converted in the meantime is kept.
+func (r *MongoBookingRepository) DeleteExpiredHolds(ctx context.Context, expiredBefore time.Time, limit int64) ([]*model.Booking, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) ([]*model.Booking, error) {
+		opts := options.Find().SetSort(bson.D{{Key: "holdExpiresAt", Value: 1}}).SetLimit(limit)
+
+		filter := lapsedHolds(expiredBefore)
+		filter["deletedAt"] = nil
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to find expired holds")
+		}
+		defer cursor.Close(ctx)
+
+		var holds []*model.Booking
+		if err := cursor.All(ctx, &holds); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bookings")
+		}
+
+		var deleted []*model.Booking
+		for _, hold := range holds {
+			// Still lapsed, so it wasn't converted since
+			filter["_id"] = hold.ID
+			result, err := tenantCollection(ctx, r.collection).DeleteOne(ctx, filter)
+			if err != nil {
+				return deleted, errors.Wrap(err, "failed to delete expired hold")
+			}
+			if result.DeletedCount == 1 {
+				deleted = append(deleted, hold)
+			}
+		}
 
-	return bookings, nil
+		return deleted, nil
+	})
+}
+
+// lapsedHolds matches holds that expired by a time
+func lapsedHolds(by time.Time) bson.M {
+	return bson.M{
+		"status":        model.BookingStatusHeld,
+		"holdExpiresAt": bson.M{"$lte": by},
+	}
 }
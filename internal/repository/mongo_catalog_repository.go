@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoCatalogRepository implements repository.CatalogRepository with MongoDB
+type MongoCatalogRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoCatalogRepository creates a new MongoDB-backed service catalog repository
+func NewMongoCatalogRepository(db *mongo.Database, opts ...MongoOption) *MongoCatalogRepository {
+	return &MongoCatalogRepository{
+		collection: db.Collection("services"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// ListServices retrieves the catalog ordered by service type, optionally only
+// the active services
+func (r *MongoCatalogRepository) ListServices(ctx context.Context, activeOnly bool) ([]*model.CatalogService, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.CatalogService, error) {
+		filter := bson.M{}
+		if activeOnly {
+			filter["active"] = true
+		}
+
+		opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list services")
+		}
+		defer cursor.Close(ctx)
+
+		var services []*model.CatalogService
+		if err := cursor.All(ctx, &services); err != nil {
+			return nil, errors.Wrap(err, "failed to decode services")
+		}
+
+		return services, nil
+	})
+}
+
+// CreateService adds a service to the catalog, returning
+// ErrCatalogServiceExists if its type is already there
+func (r *MongoCatalogRepository) CreateService(ctx context.Context, service *model.CatalogService) (*model.CatalogService, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.CatalogService, error) {
+		now := time.Now()
+		service.CreatedAt = now
+		service.UpdatedAt = now
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, service); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, ErrCatalogServiceExists
+			}
+			return nil, errors.Wrap(err, "failed to create service")
+		}
+
+		return service, nil
+	})
+}
+
+// UpdateService replaces a service's name, duration, price and active flag.
+// It returns nil if the service isn't in the catalog.
+func (r *MongoCatalogRepository) UpdateService(ctx context.Context, service *model.CatalogService) (*model.CatalogService, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.CatalogService, error) {
+		set := bson.M{
+			"name":            service.Name,
+			"durationMinutes": service.DurationMinutes,
+			"price":           service.Price,
+			"active":          service.Active,
+			"updatedAt":       time.Now(),
+		}
+		update := bson.M{"$set": set}
+		if service.CleanupMinutes != nil {
+			set["cleanupMinutes"] = *service.CleanupMinutes
+		} else {
+			update["$unset"] = bson.M{"cleanupMinutes": ""}
+		}
+
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var updated model.CatalogService
+		err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": service.ServiceType}, update, opts).Decode(&updated)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to update service")
+		}
+
+		return &updated, nil
+	})
+}
+
+// DeleteService removes a service from the catalog, reporting whether it was there
+func (r *MongoCatalogRepository) DeleteService(ctx context.Context, serviceType model.ServiceType) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteOne(ctx, bson.M{"_id": serviceType})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to delete service")
+		}
+
+		return result.DeletedCount > 0, nil
+	})
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoFavoriteRepository implements repository.FavoriteRepository with MongoDB
+type MongoFavoriteRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoFavoriteRepository creates a new MongoDB-backed favorite repository
+func NewMongoFavoriteRepository(db *mongo.Database, opts ...MongoOption) *MongoFavoriteRepository {
+	return &MongoFavoriteRepository{
+		collection: db.Collection("favorites"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoFavoriteRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// Each barber is a customer's favorite once
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "barberId", Value: 1}},
+			Options: options.Index().SetName("userId_barberId_unique").SetUnique(true),
+		},
+	}
+
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return errors.Wrap(err, "failed to create favorite indexes")
+	}
+
+	return nil
+}
+
+// AddFavorite stores a favorite, keeping the original if the customer already
+// marked the barber
+func (r *MongoFavoriteRepository) AddFavorite(ctx context.Context, favorite *model.FavoriteBarber) (*model.FavoriteBarber, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.FavoriteBarber, error) {
+		filter := bson.M{"userId": favorite.UserID, "barberId": favorite.BarberID}
+		update := bson.M{"$setOnInsert": favorite}
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+		var stored model.FavoriteBarber
+		err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, filter, update, opts).Decode(&stored)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to add favorite")
+		}
+
+		return &stored, nil
+	})
+}
+
+// RemoveFavorite removes a barber from a customer's favorites, reporting
+// whether they were one
+func (r *MongoFavoriteRepository) RemoveFavorite(ctx context.Context, userID, barberID string) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteOne(ctx, bson.M{"userId": userID, "barberId": barberID})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to remove favorite")
+		}
+
+		return result.DeletedCount > 0, nil
+	})
+}
+
+// ListFavorites retrieves a customer's favorites, most recently added first
+func (r *MongoFavoriteRepository) ListFavorites(ctx context.Context, userID string) ([]*model.FavoriteBarber, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.FavoriteBarber, error) {
+		opts := options.Find().SetSort(bson.D{{Key: "addedAt", Value: -1}, {Key: "barberId", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{"userId": userID}, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list favorites")
+		}
+		defer cursor.Close(ctx)
+
+		favorites := []*model.FavoriteBarber{}
+		if err := cursor.All(ctx, &favorites); err != nil {
+			return nil, errors.Wrap(err, "failed to decode favorites")
+		}
+
+		return favorites, nil
+	})
+}
+
+// DeleteUserFavorites removes all of a customer's favorites
+func (r *MongoFavoriteRepository) DeleteUserFavorites(ctx context.Context, userID string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteMany(ctx, bson.M{"userId": userID})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to delete user favorites")
+		}
+
+		return result.DeletedCount, nil
+	})
+}
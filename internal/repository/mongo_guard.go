@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+
+	"github.com/ita-av/booking-service/internal/breaker"
+)
+
+// RetryPolicy retries MongoDB operations failing with transient errors, such
+// as a network blip or the primary stepping down. Reads are retried after
+// any of them, and writes only when the server can't have applied them.
+// Operations that time out aren't retried.
+type RetryPolicy struct {
+	// Attempts is how many times an operation is tried in all; one or fewer
+	// doesn't retry
+	Attempts int
+	// Backoff is the wait before the first retry, doubled after each one up
+	// to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// OnRetry, if set, is called with the error before each retry, e.g. to
+	// count them
+	OnRetry func(err error)
+}
+
+// Error codes of servers that aren't, or stopped being, able to serve an
+// operation, e.g. during an election
+var (
+	// notPrimaryCodes refuse operations before running them:
+	// NotWritablePrimary, NotPrimaryNoSecondaryOk and NotPrimaryOrSecondary
+	notPrimaryCodes = []int{10107, 13435, 13436}
+	// transientCodes may also interrupt operations already running
+	transientCodes = append([]int{6, 7, 89, 91, 189, 262, 9001, 11600, 11602}, notPrimaryCodes...)
+)
+
+// guardedKey marks the context of an operation the guard is running
+type guardedKey struct{}
+
+// guard runs a repository's operations within its timeouts, retrying those
+// failing transiently and failing them fast while the breaker is open
+type guard struct {
+	timeouts Timeouts
+	retry    RetryPolicy
+	breaker  *breaker.Breaker
+}
+
+// read runs a query
+func (g guard) read(ctx context.Context, fn func(ctx context.Context) error) error {
+	return g.run(ctx, g.timeouts.Read, true, fn)
+}
+
+// write runs a change, or a transaction
+func (g guard) write(ctx context.Context, fn func(ctx context.Context) error) error {
+	return g.run(ctx, g.timeouts.Write, false, fn)
+}
+
+// readValue runs a query returning a value
+func readValue[T any](ctx context.Context, g guard, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := g.read(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
+
+// writeValue runs a change returning a value
+func writeValue[T any](ctx context.Context, g guard, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := g.write(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
+
+func (g guard) run(ctx context.Context, timeout time.Duration, idempotent bool, fn func(ctx context.Context) error) error {
+	if ctx.Value(guardedKey{}) != nil {
+		// Part of another operation, such as a transaction, which is retried
+		// and counted by the breaker as a whole
+		ctx, cancel := withTimeout(ctx, timeout)
+		defer cancel()
+		return fn(ctx)
+	}
+
+	if err := g.breaker.Allow(ctx); err != nil {
+		return errors.Wrap(err, "mongodb is unavailable")
+	}
+
+	backoff := g.retry.Backoff
+	for attempt := 1; ; attempt++ {
+		err := g.attempt(ctx, timeout, fn)
+		switch {
+		case err == nil:
+			g.breaker.Success()
+			return nil
+		case ctx.Err() != nil:
+			// The caller gave up, which says nothing about MongoDB
+			g.breaker.Ignore()
+			return err
+		case !unavailable(err):
+			// MongoDB answered, if not as the operation hoped
+			g.breaker.Success()
+			return err
+		case attempt >= g.retry.Attempts || !retryable(err, idempotent):
+			g.breaker.Failure()
+			return err
+		}
+
+		if g.retry.OnRetry != nil {
+			g.retry.OnRetry(err)
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			g.breaker.Ignore()
+			return err
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, g.retry.MaxBackoff)
+	}
+}
+
+// attempt runs fn once, within the timeout
+func (g guard) attempt(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	ctx, cancel := withTimeout(context.WithValue(ctx, guardedKey{}, true), timeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// retryable reports whether an operation failing with err may be tried
+// again: a read after any transient error, and a write only when it was
+// refused before being run
+func retryable(err error, idempotent bool) bool {
+	if errors.As(err, &topology.ServerSelectionError{}) {
+		// No server was found to send the operation to, but running out of
+		// time looking for one is a timeout
+		return !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	codes := notPrimaryCodes
+	if idempotent {
+		if mongo.IsNetworkError(err) && !mongo.IsTimeout(err) {
+			return true
+		}
+		codes = transientCodes
+	}
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) {
+		for _, code := range codes {
+			if serverErr.HasErrorCode(code) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unavailable reports whether err shows MongoDB couldn't be reached or
+// didn't answer in time, which is what opens the breaker
+func unavailable(err error) bool {
+	return retryable(err, true) || mongo.IsTimeout(err)
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/topology"
+
+	"github.com/ita-av/booking-service/internal/breaker"
+	"github.com/ita-av/booking-service/internal/clock"
+)
+
+var (
+	errNoPrimary  = topology.ServerSelectionError{Wrapped: topology.ErrServerSelectionTimeout}
+	errStepDown   = mongo.CommandError{Code: 11602, Name: "InterruptedDueToReplStateChange"}
+	errNotPrimary = mongo.CommandError{Code: 10107, Name: "NotWritablePrimary"}
+	errDuplicate  = mongo.WriteException{WriteErrors: []mongo.WriteError{{Code: 11000}}}
+)
+
+// failing returns an operation failing with errs in turn, then succeeding,
+// and counts its calls
+func failing(calls *int, errs ...error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		*calls++
+		if *calls <= len(errs) {
+			return errors.Wrap(errs[*calls-1], "failed")
+		}
+		return nil
+	}
+}
+
+func TestGuard_Retries(t *testing.T) {
+	g := guard{retry: RetryPolicy{Attempts: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}}
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		write     bool
+		errs      []error
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "read after stepdown", errs: []error{errStepDown, errNoPrimary}, wantCalls: 3},
+		{name: "read out of attempts", errs: []error{errStepDown, errStepDown, errStepDown}, wantCalls: 3, wantErr: true},
+		{name: "write refused by a secondary", write: true, errs: []error{errNotPrimary}, wantCalls: 2},
+		{name: "write interrupted", write: true, errs: []error{errStepDown}, wantCalls: 1, wantErr: true},
+		{name: "duplicate key", write: true, errs: []error{errDuplicate}, wantCalls: 1, wantErr: true},
+		{name: "timeout", errs: []error{context.DeadlineExceeded}, wantCalls: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			run := g.read
+			if tt.write {
+				run = g.write
+			}
+			err := run(ctx, failing(&calls, tt.errs...))
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestGuard_Breaker(t *testing.T) {
+	b := breaker.New(breaker.Config{Threshold: 2, Cooldown: time.Minute}, clock.NewFake(time.Now()))
+	g := guard{breaker: b}
+	ctx := context.Background()
+
+	// Errors MongoDB answered with don't count
+	calls := 0
+	for i := 0; i < 3; i++ {
+		assert.Error(t, g.write(ctx, failing(&calls, errDuplicate)))
+		calls = 0
+	}
+	assert.Equal(t, breaker.Closed, b.State())
+
+	for i := 0; i < 2; i++ {
+		assert.Error(t, g.read(ctx, failing(&calls, errNoPrimary)))
+		calls = 0
+	}
+	assert.Equal(t, breaker.Open, b.State())
+
+	err := g.read(ctx, failing(&calls))
+	assert.ErrorIs(t, err, breaker.ErrOpen)
+	assert.Zero(t, calls, "calls fail fast while it's open")
+}
+
+func TestGuard_Nested(t *testing.T) {
+	g := guard{retry: RetryPolicy{Attempts: 3}, timeouts: Timeouts{Read: time.Minute, Write: time.Minute}}
+	outer, inner := 0, 0
+
+	err := g.write(context.Background(), func(ctx context.Context) error {
+		outer++
+		_, hasDeadline := ctx.Deadline()
+		require.True(t, hasDeadline)
+		return g.read(ctx, failing(&inner, errStepDown))
+	})
+
+	// The inner read isn't retried on its own; the outer write can't be
+	assert.Error(t, err)
+	assert.Equal(t, 1, outer)
+	assert.Equal(t, 1, inner)
+}
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoHolidayRepository implements repository.HolidayRepository with MongoDB
+type MongoHolidayRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoHolidayRepository creates a new MongoDB-backed holiday repository
+func NewMongoHolidayRepository(db *mongo.Database, opts ...MongoOption) *MongoHolidayRepository {
+	return &MongoHolidayRepository{
+		collection: db.Collection("holidays"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// GetHoliday retrieves the holiday on a date, returning nil if the shop is open
+func (r *MongoHolidayRepository) GetHoliday(ctx context.Context, date string) (*model.Holiday, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.Holiday, error) {
+		var holiday model.Holiday
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"_id": date}).Decode(&holiday)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to get holiday")
+		}
+
+		return &holiday, nil
+	})
+}
+
+// ListHolidays retrieves holidays between two dates, both inclusive. Empty
+// bounds are open.
+func (r *MongoHolidayRepository) ListHolidays(ctx context.Context, from, to string) ([]*model.Holiday, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Holiday, error) {
+		dateFilter := bson.M{}
+		if from != "" {
+			dateFilter["$gte"] = from
+		}
+		if to != "" {
+			dateFilter["$lte"] = to
+		}
+
+		filter := bson.M{}
+		if len(dateFilter) > 0 {
+			// Dates are ISO formatted, so string order is date order
+			filter["_id"] = dateFilter
+		}
+
+		opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list holidays")
+		}
+		defer cursor.Close(ctx)
+
+		var holidays []*model.Holiday
+		if err := cursor.All(ctx, &holidays); err != nil {
+			return nil, errors.Wrap(err, "failed to decode holidays")
+		}
+
+		return holidays, nil
+	})
+}
+
+// AddHoliday stores a holiday, replacing the name of an existing one on the same date
+func (r *MongoHolidayRepository) AddHoliday(ctx context.Context, holiday *model.Holiday) (*model.Holiday, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Holiday, error) {
+		update := bson.M{
+			"$set": bson.M{
+				"name": holiday.Name,
+			},
+			"$setOnInsert": bson.M{
+				"createdAt": time.Now(),
+				"createdBy": holiday.CreatedBy,
+			},
+		}
+
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+		var stored model.Holiday
+		if err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": holiday.Date}, update, opts).Decode(&stored); err != nil {
+			return nil, errors.Wrap(err, "failed to add holiday")
+		}
+
+		return &stored, nil
+	})
+}
+
+// DeleteHoliday removes a holiday, reporting whether one existed
+func (r *MongoHolidayRepository) DeleteHoliday(ctx context.Context, date string) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteOne(ctx, bson.M{"_id": date})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to delete holiday")
+		}
+
+		return result.DeletedCount > 0, nil
+	})
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoLeaseRepository implements repository.LeaseRepository with MongoDB,
+// one document per lease
+type MongoLeaseRepository struct {
+	collection *mongo.Collection
+	now        func() time.Time
+	guard      guard
+}
+
+// NewMongoLeaseRepository creates a new MongoDB-backed lease repository
+func NewMongoLeaseRepository(db *mongo.Database, opts ...MongoOption) *MongoLeaseRepository {
+	return &MongoLeaseRepository{
+		collection: db.Collection("leases"),
+		now:        time.Now,
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// AcquireLease takes the lease if holder already has it or it has expired.
+// Otherwise the upsert collides with the other holder's document on _id,
+// which is how a taken lease is told apart.
+func (r *MongoLeaseRepository) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		now := r.now()
+		filter := bson.M{
+			"_id": name,
+			"$or": bson.A{
+				bson.M{"holder": holder},
+				bson.M{"expiresAt": bson.M{"$lte": now}},
+			},
+		}
+		update := bson.M{
+			"$set": bson.M{"holder": holder, "expiresAt": now.Add(ttl), "renewedAt": now},
+		}
+
+		_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, "failed to acquire lease")
+		}
+
+		return true, nil
+	})
+}
+
+// ReleaseLease deletes holder's lease, letting another holder take it
+// without waiting for it to expire
+func (r *MongoLeaseRepository) ReleaseLease(ctx context.Context, name, holder string) error {
+	return r.guard.write(ctx, func(ctx context.Context) error {
+		if _, err := r.collection.DeleteOne(ctx, bson.M{"_id": name, "holder": holder}); err != nil {
+			return errors.Wrap(err, "failed to release lease")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ita-av/booking-service/internal/breaker"
+	"github.com/ita-av/booking-service/internal/clock"
+)
+
+// Timeouts bound how long a single MongoDB operation may take, so a slow or
+// unreachable database fails calls instead of hanging them. An earlier
+// deadline on the caller's context still applies; zero leaves operations
+// bounded only by it.
+type Timeouts struct {
+	// Read bounds queries, including reading all their results
+	Read time.Duration
+	// Write bounds changes, including the transactions guarding them
+	Write time.Duration
+}
+
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// mongoOptions are the settings MongoOptions configure
+type mongoOptions struct {
+	timeouts    Timeouts
+	retry       RetryPolicy
+	breaker     *breaker.Breaker
+	callerLocks bool
+	clock       clock.Clock
+}
+
+// MongoOption configures a MongoDB repository. Options only some
+// repositories use, such as WithCallerLocks, are ignored by the others.
+type MongoOption func(*mongoOptions)
+
+// WithTimeouts bounds each of the repository's operations
+func WithTimeouts(timeouts Timeouts) MongoOption {
+	return func(o *mongoOptions) {
+		o.timeouts = timeouts
+	}
+}
+
+// WithRetries retries the repository's operations that fail transiently
+func WithRetries(policy RetryPolicy) MongoOption {
+	return func(o *mongoOptions) {
+		o.retry = policy
+	}
+}
+
+// WithBreaker fails the repository's operations fast while b is open. The
+// repositories of a client should share one breaker, as they fail together.
+func WithBreaker(b *breaker.Breaker) MongoOption {
+	return func(o *mongoOptions) {
+		o.breaker = b
+	}
+}
+
+// newMongoOptions applies opts to the defaults
+func newMongoOptions(opts []MongoOption) mongoOptions {
+	o := mongoOptions{clock: clock.System}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// guard returns the guard running operations as the options say
+func (o mongoOptions) guard() guard {
+	return guard{timeouts: o.timeouts, retry: o.retry, breaker: o.breaker}
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// publishedEventRetention is how long published events are kept, to help
+// trace what consumers were sent
+const publishedEventRetention = 7 * 24 * time.Hour
+
+// MongoOutboxRepository implements repository.OutboxRepository with MongoDB
+type MongoOutboxRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoOutboxRepository creates a new MongoDB-backed event outbox
+func NewMongoOutboxRepository(db *mongo.Database, opts ...MongoOption) *MongoOutboxRepository {
+	return &MongoOutboxRepository{
+		collection: db.Collection("outbox"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoOutboxRepository) EnsureIndexes(ctx context.Context) error {
+	// Published events expire; pending ones have no publishedAt and are
+	// kept, and found through the same index
+	index := mongo.IndexModel{
+		Keys: bson.D{{Key: "publishedAt", Value: 1}},
+		Options: options.Index().
+			SetName("publishedAt_ttl").
+			SetExpireAfterSeconds(int32(publishedEventRetention.Seconds())),
+	}
+
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateOne(ctx, index); err != nil {
+		return errors.Wrap(err, "failed to create outbox indexes")
+	}
+
+	return nil
+}
+
+// AddEvent stores an event to be published. Called with a transaction's
+// context, the event is only stored if the transaction commits.
+func (r *MongoOutboxRepository) AddEvent(ctx context.Context, event *model.OutboxEvent) error {
+	return r.guard.write(ctx, func(ctx context.Context) error {
+		if event.ID.IsZero() {
+			event.ID = primitive.NewObjectID()
+		}
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, event); err != nil {
+			return errors.Wrap(err, "failed to insert outbox event")
+		}
+
+		return nil
+	})
+}
+
+// ListPendingEvents retrieves the oldest events not yet published
+func (r *MongoOutboxRepository) ListPendingEvents(ctx context.Context, limit int64) ([]*model.OutboxEvent, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.OutboxEvent, error) {
+		opts := options.Find().
+			SetSort(bson.D{{Key: "_id", Value: 1}}).
+			SetLimit(limit)
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{"publishedAt": bson.M{"$exists": false}}, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list outbox events")
+		}
+		defer cursor.Close(ctx)
+
+		var events []*model.OutboxEvent
+		if err := cursor.All(ctx, &events); err != nil {
+			return nil, errors.Wrap(err, "failed to decode outbox events")
+		}
+
+		return events, nil
+	})
+}
+
+// MarkEventPublished records that an event was published
+func (r *MongoOutboxRepository) MarkEventPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	return r.guard.write(ctx, func(ctx context.Context) error {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return errors.Wrap(err, "invalid outbox event ID format")
+		}
+
+		update := bson.M{
+			"$set": bson.M{"publishedAt": publishedAt},
+			"$inc": bson.M{"attempts": 1},
+		}
+		if _, err := tenantCollection(ctx, r.collection).UpdateByID(ctx, objectID, update); err != nil {
+			return errors.Wrap(err, "failed to mark outbox event published")
+		}
+
+		return nil
+	})
+}
+
+// RecordEventFailure records a failed attempt to publish an event
+func (r *MongoOutboxRepository) RecordEventFailure(ctx context.Context, id string, reason string) error {
+	return r.guard.write(ctx, func(ctx context.Context) error {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return errors.Wrap(err, "invalid outbox event ID format")
+		}
+
+		update := bson.M{
+			"$set": bson.M{"lastError": reason},
+			"$inc": bson.M{"attempts": 1},
+		}
+		if _, err := tenantCollection(ctx, r.collection).UpdateByID(ctx, objectID, update); err != nil {
+			return errors.Wrap(err, "failed to record outbox event failure")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoPayrollRepository implements repository.PayrollRepository with MongoDB
+type MongoPayrollRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoPayrollRepository creates a new MongoDB-backed payroll repository
+func NewMongoPayrollRepository(db *mongo.Database, opts ...MongoOption) *MongoPayrollRepository {
+	return &MongoPayrollRepository{
+		collection: db.Collection("payroll_periods"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// GetPayrollPeriod retrieves a payroll period by its "YYYY-MM" identifier
+func (r *MongoPayrollRepository) GetPayrollPeriod(ctx context.Context, id string) (*model.PayrollPeriod, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.PayrollPeriod, error) {
+		var period model.PayrollPeriod
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"_id": id}).Decode(&period)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil // No period stored
+			}
+			return nil, errors.Wrap(err, "failed to get payroll period")
+		}
+
+		return &period, nil
+	})
+}
+
+// SavePayrollPeriod stores a payroll period unless it has already been finalized
+func (r *MongoPayrollRepository) SavePayrollPeriod(ctx context.Context, period *model.PayrollPeriod) error {
+	return r.guard.write(ctx, func(ctx context.Context) error {
+		// Only replace periods that are not yet finalized
+		filter := bson.M{
+			"_id":       period.ID,
+			"finalized": bson.M{"$ne": true},
+		}
+
+		_, err := tenantCollection(ctx, r.collection).ReplaceOne(ctx, filter, period, options.Replace().SetUpsert(true))
+		if err != nil {
+			// The upsert collides with the finalized document's _id
+			if mongo.IsDuplicateKeyError(err) {
+				return ErrPayrollPeriodFinalized
+			}
+			return errors.Wrap(err, "failed to save payroll period")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoPromoCodeRepository implements repository.PromoCodeRepository with MongoDB
+type MongoPromoCodeRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoPromoCodeRepository creates a new MongoDB-backed promo code repository
+func NewMongoPromoCodeRepository(db *mongo.Database, opts ...MongoOption) *MongoPromoCodeRepository {
+	return &MongoPromoCodeRepository{
+		collection: db.Collection("promo_codes"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// ListPromoCodes retrieves every promo code ordered by code
+func (r *MongoPromoCodeRepository) ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.PromoCode, error) {
+		opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{}, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list promo codes")
+		}
+		defer cursor.Close(ctx)
+
+		promos := []*model.PromoCode{}
+		if err := cursor.All(ctx, &promos); err != nil {
+			return nil, errors.Wrap(err, "failed to decode promo codes")
+		}
+
+		return promos, nil
+	})
+}
+
+// GetPromoCode retrieves a promo code, or nil if it doesn't exist
+func (r *MongoPromoCodeRepository) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.PromoCode, error) {
+		var promo model.PromoCode
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"_id": code}).Decode(&promo)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to get promo code")
+		}
+
+		return &promo, nil
+	})
+}
+
+// CreatePromoCode adds a promo code, returning ErrPromoCodeExists if the code
+// is taken
+func (r *MongoPromoCodeRepository) CreatePromoCode(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.PromoCode, error) {
+		now := time.Now()
+		promo.Uses = 0
+		promo.CreatedAt = now
+		promo.UpdatedAt = now
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, promo); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, ErrPromoCodeExists
+			}
+			return nil, errors.Wrap(err, "failed to create promo code")
+		}
+
+		return promo, nil
+	})
+}
+
+// UpdatePromoCode replaces a code's discount, validity window, limit and
+// active flag, keeping its uses. It returns nil if the code doesn't exist.
+func (r *MongoPromoCodeRepository) UpdatePromoCode(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.PromoCode, error) {
+		set := bson.M{
+			"active":    promo.Active,
+			"updatedAt": time.Now(),
+		}
+		unset := bson.M{}
+
+		// Unset terms are removed, like omitempty leaves them out on insert
+		terms := []struct {
+			key   string
+			value interface{}
+			isSet bool
+		}{
+			{"percentOff", promo.PercentOff, promo.PercentOff != 0},
+			{"amountOff", promo.AmountOff, promo.AmountOff != 0},
+			{"validFrom", promo.ValidFrom, promo.ValidFrom != nil},
+			{"validUntil", promo.ValidUntil, promo.ValidUntil != nil},
+			{"maxUses", promo.MaxUses, promo.MaxUses != 0},
+		}
+		for _, term := range terms {
+			if term.isSet {
+				set[term.key] = term.value
+			} else {
+				unset[term.key] = ""
+			}
+		}
+
+		update := bson.M{"$set": set}
+		if len(unset) > 0 {
+			update["$unset"] = unset
+		}
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var updated model.PromoCode
+		err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": promo.Code}, update, opts).Decode(&updated)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to update promo code")
+		}
+
+		return &updated, nil
+	})
+}
+
+// DeletePromoCode removes a promo code, reporting whether it existed.
+// Bookings made with it keep their discount.
+func (r *MongoPromoCodeRepository) DeletePromoCode(ctx context.Context, code string) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteOne(ctx, bson.M{"_id": code})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to delete promo code")
+		}
+
+		return result.DeletedCount > 0, nil
+	})
+}
+
+// RedeemPromoCode counts a use of a code that's active, valid at now and
+// under its limit. It returns nil if the code can't be used.
+func (r *MongoPromoCodeRepository) RedeemPromoCode(ctx context.Context, code string, now time.Time) (*model.PromoCode, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.PromoCode, error) {
+		filter := bson.M{
+			"_id":    code,
+			"active": true,
+			"$and": bson.A{
+				bson.M{"$or": bson.A{
+					bson.M{"validFrom": bson.M{"$exists": false}},
+					bson.M{"validFrom": bson.M{"$lte": now}},
+				}},
+				bson.M{"$or": bson.A{
+					bson.M{"validUntil": bson.M{"$exists": false}},
+					bson.M{"validUntil": bson.M{"$gt": now}},
+				}},
+				bson.M{"$or": bson.A{
+					bson.M{"maxUses": bson.M{"$exists": false}},
+					bson.M{"$expr": bson.M{"$lt": bson.A{"$uses", "$maxUses"}}},
+				}},
+			},
+		}
+		update := bson.M{"$inc": bson.M{"uses": 1}}
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var promo model.PromoCode
+		err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, filter, update, opts).Decode(&promo)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to redeem promo code")
+		}
+
+		return &promo, nil
+	})
+}
+
+// ReleasePromoCode gives back a use counted for a booking that wasn't made
+func (r *MongoPromoCodeRepository) ReleasePromoCode(ctx context.Context, code string) error {
+	return r.guard.write(ctx, func(ctx context.Context) error {
+		filter := bson.M{"_id": code, "uses": bson.M{"$gt": 0}}
+		if _, err := tenantCollection(ctx, r.collection).UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"uses": -1}}); err != nil {
+			return errors.Wrap(err, "failed to release promo code")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoResourceRepository implements repository.ResourceRepository with MongoDB
+type MongoResourceRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoResourceRepository creates a new MongoDB-backed resource repository
+func NewMongoResourceRepository(db *mongo.Database, opts ...MongoOption) *MongoResourceRepository {
+	return &MongoResourceRepository{
+		collection: db.Collection("resources"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// ListResources retrieves every resource ordered by ID
+func (r *MongoResourceRepository) ListResources(ctx context.Context) ([]*model.Resource, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Resource, error) {
+		opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{}, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list resources")
+		}
+		defer cursor.Close(ctx)
+
+		var resources []*model.Resource
+		if err := cursor.All(ctx, &resources); err != nil {
+			return nil, errors.Wrap(err, "failed to decode resources")
+		}
+
+		return resources, nil
+	})
+}
+
+// CreateResource adds a resource, returning ErrResourceExists if its ID is
+// already taken
+func (r *MongoResourceRepository) CreateResource(ctx context.Context, resource *model.Resource) (*model.Resource, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Resource, error) {
+		now := time.Now()
+		resource.CreatedAt = now
+		resource.UpdatedAt = now
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, resource); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, ErrResourceExists
+			}
+			return nil, errors.Wrap(err, "failed to create resource")
+		}
+
+		return resource, nil
+	})
+}
+
+// UpdateResource replaces a resource's name, capacity and service types. It
+// returns nil if there's no resource with the ID.
+func (r *MongoResourceRepository) UpdateResource(ctx context.Context, resource *model.Resource) (*model.Resource, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Resource, error) {
+		update := bson.M{
+			"$set": bson.M{
+				"name":         resource.Name,
+				"capacity":     resource.Capacity,
+				"serviceTypes": resource.ServiceTypes,
+				"updatedAt":    time.Now(),
+			},
+		}
+
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		var updated model.Resource
+		err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": resource.ID}, update, opts).Decode(&updated)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to update resource")
+		}
+
+		return &updated, nil
+	})
+}
+
+// DeleteResource removes a resource, reporting whether it was there
+func (r *MongoResourceRepository) DeleteResource(ctx context.Context, id string) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteOne(ctx, bson.M{"_id": id})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to delete resource")
+		}
+
+		return result.DeletedCount > 0, nil
+	})
+}
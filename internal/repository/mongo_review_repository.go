@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoReviewRepository implements repository.ReviewRepository with MongoDB
+type MongoReviewRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoReviewRepository creates a new MongoDB-backed review repository
+func NewMongoReviewRepository(db *mongo.Database, opts ...MongoOption) *MongoReviewRepository {
+	return &MongoReviewRepository{
+		collection: db.Collection("reviews"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoReviewRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// One review per booking
+			Keys:    bson.D{{Key: "bookingId", Value: 1}},
+			Options: options.Index().SetName("bookingId_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "barberId", Value: 1}, {Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("barberId_createdAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}},
+			Options: options.Index().SetName("userId"),
+		},
+	}
+
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return errors.Wrap(err, "failed to create review indexes")
+	}
+
+	return nil
+}
+
+// CreateReview stores a review, returning ErrReviewExists if its booking has
+// already been reviewed
+func (r *MongoReviewRepository) CreateReview(ctx context.Context, review *model.Review) (*model.Review, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Review, error) {
+		if review.ID.IsZero() {
+			review.ID = primitive.NewObjectID()
+		}
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, review); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil, ErrReviewExists
+			}
+			return nil, errors.Wrap(err, "failed to insert review")
+		}
+
+		return review, nil
+	})
+}
+
+// ListBarberReviews retrieves up to limit of a barber's reviews created
+// before a time, newest first
+func (r *MongoReviewRepository) ListBarberReviews(ctx context.Context, barberID string, before time.Time, limit int64) ([]*model.Review, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Review, error) {
+		filter := bson.M{
+			"barberId":  barberID,
+			"createdAt": bson.M{"$lt": before},
+		}
+		opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}}).SetLimit(limit)
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list barber reviews")
+		}
+		defer cursor.Close(ctx)
+
+		reviews := []*model.Review{}
+		if err := cursor.All(ctx, &reviews); err != nil {
+			return nil, errors.Wrap(err, "failed to decode reviews")
+		}
+
+		return reviews, nil
+	})
+}
+
+// GetBarberRating averages the ratings of a barber's reviews
+func (r *MongoReviewRepository) GetBarberRating(ctx context.Context, barberID string) (*model.BarberRating, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.BarberRating, error) {
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{"barberId": barberID}}},
+			{{Key: "$group", Value: bson.M{
+				"_id":           "$barberId",
+				"reviews":       bson.M{"$sum": 1},
+				"averageRating": bson.M{"$avg": "$rating"},
+			}}},
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to aggregate barber rating")
+		}
+		defer cursor.Close(ctx)
+
+		var ratings []*model.BarberRating
+		if err := cursor.All(ctx, &ratings); err != nil {
+			return nil, errors.Wrap(err, "failed to decode barber rating")
+		}
+
+		if len(ratings) == 0 {
+			return &model.BarberRating{BarberID: barberID}, nil
+		}
+		return ratings[0], nil
+	})
+}
+
+// GetUserReviews retrieves the reviews a user wrote, oldest first
+func (r *MongoReviewRepository) GetUserReviews(ctx context.Context, userID string) ([]*model.Review, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Review, error) {
+		opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{"userId": userID}, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user reviews")
+		}
+		defer cursor.Close(ctx)
+
+		var reviews []*model.Review
+		if err := cursor.All(ctx, &reviews); err != nil {
+			return nil, errors.Wrap(err, "failed to decode reviews")
+		}
+
+		return reviews, nil
+	})
+}
+
+// AnonymizeUserReviews strips a user's ID and comments from their reviews,
+// keeping the ratings for the barbers' averages
+func (r *MongoReviewRepository) AnonymizeUserReviews(ctx context.Context, userID string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		update := bson.M{
+			"$set":   bson.M{"userId": ""},
+			"$unset": bson.M{"comment": ""},
+		}
+
+		result, err := tenantCollection(ctx, r.collection).UpdateMany(ctx, bson.M{"userId": userID}, update)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to anonymize user reviews")
+		}
+
+		return result.ModifiedCount, nil
+	})
+}
+
+// DeleteUserReviews removes the reviews a user wrote
+func (r *MongoReviewRepository) DeleteUserReviews(ctx context.Context, userID string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteMany(ctx, bson.M{"userId": userID})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to delete user reviews")
+		}
+
+		return result.DeletedCount, nil
+	})
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoScheduleRepository implements repository.ScheduleRepository with MongoDB
+type MongoScheduleRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoScheduleRepository creates a new MongoDB-backed schedule repository
+func NewMongoScheduleRepository(db *mongo.Database, opts ...MongoOption) *MongoScheduleRepository {
+	return &MongoScheduleRepository{
+		collection: db.Collection("barber_schedules"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// GetSchedule retrieves a barber's schedule, returning nil if none is stored
+func (r *MongoScheduleRepository) GetSchedule(ctx context.Context, barberID string) (*model.BarberSchedule, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.BarberSchedule, error) {
+		var schedule model.BarberSchedule
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"_id": barberID}).Decode(&schedule)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil
+			}
+			return nil, errors.Wrap(err, "failed to get schedule")
+		}
+
+		return &schedule, nil
+	})
+}
+
+// SaveSchedule replaces a barber's working hours, breaks, time zone and
+// booking policies
+func (r *MongoScheduleRepository) SaveSchedule(ctx context.Context, schedule *model.BarberSchedule) (*model.BarberSchedule, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.BarberSchedule, error) {
+		update := bson.M{
+			"$set": bson.M{
+				"hours":          schedule.Hours,
+				"breaks":         schedule.Breaks,
+				"timezone":       schedule.Timezone,
+				"slotMinutes":    schedule.SlotMinutes,
+				"minLeadMinutes": schedule.MinLeadMinutes,
+				"maxAdvanceDays": schedule.MaxAdvanceDays,
+				"updatedAt":      time.Now(),
+				"updatedBy":      schedule.UpdatedBy,
+			},
+		}
+
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+		var saved model.BarberSchedule
+		if err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": schedule.BarberID}, update, opts).Decode(&saved); err != nil {
+			return nil, errors.Wrap(err, "failed to save schedule")
+		}
+
+		return &saved, nil
+	})
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// shopSettingsID is the identifier of the single shop settings document
+const shopSettingsID = "shop"
+
+// MongoSettingsRepository implements repository.SettingsRepository with MongoDB
+type MongoSettingsRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoSettingsRepository creates a new MongoDB-backed settings repository
+func NewMongoSettingsRepository(db *mongo.Database, opts ...MongoOption) *MongoSettingsRepository {
+	return &MongoSettingsRepository{
+		collection: db.Collection("settings"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// GetSettings retrieves the shop settings, returning defaults if none are stored
+func (r *MongoSettingsRepository) GetSettings(ctx context.Context) (*model.ShopSettings, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.ShopSettings, error) {
+		var settings model.ShopSettings
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"_id": shopSettingsID}).Decode(&settings)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return &model.ShopSettings{ID: shopSettingsID}, nil
+			}
+			return nil, errors.Wrap(err, "failed to get settings")
+		}
+
+		return &settings, nil
+	})
+}
+
+// UpdateRetentionPolicy replaces the retention policy in the shop settings
+func (r *MongoSettingsRepository) UpdateRetentionPolicy(ctx context.Context, policy model.RetentionPolicy, updatedBy string) (*model.ShopSettings, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.ShopSettings, error) {
+		update := bson.M{
+			"$set": bson.M{
+				"retention": policy,
+				"updatedAt": time.Now(),
+				"updatedBy": updatedBy,
+			},
+		}
+
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+		var settings model.ShopSettings
+		if err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": shopSettingsID}, update, opts).Decode(&settings); err != nil {
+			return nil, errors.Wrap(err, "failed to update retention policy")
+		}
+
+		return &settings, nil
+	})
+}
+
+// UpdateCancellationPolicy replaces the cancellation policy in the shop settings
+func (r *MongoSettingsRepository) UpdateCancellationPolicy(ctx context.Context, policy model.CancellationPolicy, updatedBy string) (*model.ShopSettings, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.ShopSettings, error) {
+		update := bson.M{
+			"$set": bson.M{
+				"cancellation": policy,
+				"updatedAt":    time.Now(),
+				"updatedBy":    updatedBy,
+			},
+		}
+
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+		var settings model.ShopSettings
+		if err := tenantCollection(ctx, r.collection).FindOneAndUpdate(ctx, bson.M{"_id": shopSettingsID}, update, opts).Decode(&settings); err != nil {
+			return nil, errors.Wrap(err, "failed to update cancellation policy")
+		}
+
+		return &settings, nil
+	})
+}
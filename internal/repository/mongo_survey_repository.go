@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoSurveyRepository implements repository.SurveyRepository with MongoDB
+type MongoSurveyRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoSurveyRepository creates a new MongoDB-backed survey repository
+func NewMongoSurveyRepository(db *mongo.Database, opts ...MongoOption) *MongoSurveyRepository {
+	return &MongoSurveyRepository{
+		collection: db.Collection("surveys"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoSurveyRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// One survey per booking
+			Keys:    bson.D{{Key: "bookingId", Value: 1}},
+			Options: options.Index().SetName("bookingId_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "barberId", Value: 1}, {Key: "respondedAt", Value: 1}},
+			Options: options.Index().SetName("barberId_respondedAt"),
+		},
+	}
+
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateMany(ctx, indexes); err != nil {
+		return errors.Wrap(err, "failed to create survey indexes")
+	}
+
+	return nil
+}
+
+// CreateSurvey stores a newly sent survey
+func (r *MongoSurveyRepository) CreateSurvey(ctx context.Context, survey *model.Survey) (*model.Survey, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Survey, error) {
+		if survey.ID.IsZero() {
+			survey.ID = primitive.NewObjectID()
+		}
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, survey); err != nil {
+			return nil, errors.Wrap(err, "failed to insert survey")
+		}
+
+		return survey, nil
+	})
+}
+
+// GetSurveyByBookingID retrieves the survey sent for a booking
+func (r *MongoSurveyRepository) GetSurveyByBookingID(ctx context.Context, bookingID string) (*model.Survey, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.Survey, error) {
+		var survey model.Survey
+		err := tenantCollection(ctx, r.collection).FindOne(ctx, bson.M{"bookingId": bookingID}).Decode(&survey)
+		if err != nil {
+			if err == mongo.ErrNoDocuments {
+				return nil, nil // No survey found
+			}
+			return nil, errors.Wrap(err, "failed to get survey")
+		}
+
+		return &survey, nil
+	})
+}
+
+// RecordSurveyResponse stores the response to an unanswered survey matching
+// the booking and token. It returns false when no such survey exists.
+func (r *MongoSurveyRepository) RecordSurveyResponse(ctx context.Context, bookingID, token string, score int, comment string) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		filter := bson.M{
+			"bookingId":   bookingID,
+			"token":       token,
+			"respondedAt": bson.M{"$exists": false},
+		}
+
+		update := bson.M{
+			"$set": bson.M{
+				"score":       score,
+				"comment":     comment,
+				"respondedAt": time.Now(),
+			},
+		}
+
+		result, err := tenantCollection(ctx, r.collection).UpdateOne(ctx, filter, update)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to record survey response")
+		}
+
+		return result.ModifiedCount > 0, nil
+	})
+}
+
+// GetBarberSurveyScores aggregates a barber's survey responses, optionally
+// limited to responses in [start, end)
+func (r *MongoSurveyRepository) GetBarberSurveyScores(ctx context.Context, barberID string, start, end *time.Time) (*model.SurveyScores, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) (*model.SurveyScores, error) {
+		respondedAt := bson.M{"$exists": true}
+		if start != nil {
+			respondedAt["$gte"] = *start
+		}
+		if end != nil {
+			respondedAt["$lt"] = *end
+		}
+
+		pipeline := mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{"barberId": barberID, "respondedAt": respondedAt}}},
+			{{Key: "$group", Value: bson.M{"_id": "$score", "count": bson.M{"$sum": 1}}}},
+		}
+
+		cursor, err := tenantCollection(ctx, r.collection).Aggregate(ctx, pipeline)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to aggregate survey scores")
+		}
+		defer cursor.Close(ctx)
+
+		var groups []struct {
+			Score int `bson:"_id"`
+			Count int `bson:"count"`
+		}
+		if err := cursor.All(ctx, &groups); err != nil {
+			return nil, errors.Wrap(err, "failed to decode survey scores")
+		}
+
+		scores := &model.SurveyScores{BarberID: barberID}
+		total := 0
+		for _, group := range groups {
+			if group.Score < 1 || group.Score > 5 {
+				continue
+			}
+			scores.ScoreCounts[group.Score-1] = group.Count
+			scores.Responses += group.Count
+			total += group.Score * group.Count
+		}
+
+		if scores.Responses > 0 {
+			scores.AverageScore = float64(total) / float64(scores.Responses)
+		}
+
+		return scores, nil
+	})
+}
+
+// GetUserSurveys retrieves the surveys sent to a user, oldest first
+func (r *MongoSurveyRepository) GetUserSurveys(ctx context.Context, userID string) ([]*model.Survey, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Survey, error) {
+		opts := options.Find().SetSort(bson.D{{Key: "sentAt", Value: 1}})
+
+		cursor, err := tenantCollection(ctx, r.collection).Find(ctx, bson.M{"userId": userID}, opts)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user surveys")
+		}
+		defer cursor.Close(ctx)
+
+		var surveys []*model.Survey
+		if err := cursor.All(ctx, &surveys); err != nil {
+			return nil, errors.Wrap(err, "failed to decode surveys")
+		}
+
+		return surveys, nil
+	})
+}
+
+// AnonymizeUserSurveys strips a user's ID and comments from their surveys,
+// keeping the scores for the barbers' ratings. Unanswered surveys can no
+// longer be answered.
+func (r *MongoSurveyRepository) AnonymizeUserSurveys(ctx context.Context, userID string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		update := bson.M{
+			"$set":   bson.M{"userId": ""},
+			"$unset": bson.M{"comment": "", "token": ""},
+		}
+
+		result, err := tenantCollection(ctx, r.collection).UpdateMany(ctx, bson.M{"userId": userID}, update)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to anonymize user surveys")
+		}
+
+		return result.ModifiedCount, nil
+	})
+}
+
+// DeleteUserSurveys removes the surveys sent to a user
+func (r *MongoSurveyRepository) DeleteUserSurveys(ctx context.Context, userID string) (int64, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (int64, error) {
+		result, err := tenantCollection(ctx, r.collection).DeleteMany(ctx, bson.M{"userId": userID})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to delete user surveys")
+		}
+
+		return result.DeletedCount, nil
+	})
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// MongoTransactor implements repository.Transactor with MongoDB transactions
+type MongoTransactor struct {
+	client *mongo.Client
+	guard  guard
+}
+
+// NewMongoTransactor creates a transactor for the database's deployment,
+// which must be a replica set or sharded cluster
+func NewMongoTransactor(db *mongo.Database, opts ...MongoOption) *MongoTransactor {
+	return &MongoTransactor{client: db.Client(), guard: newMongoOptions(opts).guard()}
+}
+
+// InTransaction runs fn in a transaction. Called within a transaction, fn
+// joins it.
+func (t *MongoTransactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return t.guard.write(ctx, func(ctx context.Context) error {
+		_, err := inTransaction(ctx, t.client, func(sessCtx mongo.SessionContext) (interface{}, error) {
+			return nil, fn(sessCtx)
+		})
+		return err
+	})
+}
+
+// inTransaction runs fn in a new transaction, retried on transient errors,
+// or in the transaction ctx already belongs to
+func inTransaction(ctx context.Context, client *mongo.Client, fn func(sessCtx mongo.SessionContext) (interface{}, error)) (interface{}, error) {
+	if session := mongo.SessionFromContext(ctx); session != nil {
+		return fn(mongo.NewSessionContext(ctx, session))
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start session")
+	}
+	defer session.EndSession(ctx)
+
+	// Transactions must read from the primary, whatever the client's read
+	// preference
+	return session.WithTransaction(ctx, fn, options.Transaction().SetReadPreference(readpref.Primary()))
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// MongoWebhookRepository implements repository.WebhookRepository with MongoDB
+type MongoWebhookRepository struct {
+	collection *mongo.Collection
+	guard      guard
+}
+
+// NewMongoWebhookRepository creates a new MongoDB-backed webhook repository
+func NewMongoWebhookRepository(db *mongo.Database, opts ...MongoOption) *MongoWebhookRepository {
+	return &MongoWebhookRepository{
+		collection: db.Collection("webhooks"),
+		guard:      newMongoOptions(opts).guard(),
+	}
+}
+
+// EnsureIndexes creates the indexes the repository relies on
+func (r *MongoWebhookRepository) EnsureIndexes(ctx context.Context) error {
+	index := mongo.IndexModel{
+		Keys:    bson.D{{Key: "eventTypes", Value: 1}},
+		Options: options.Index().SetName("eventTypes"),
+	}
+
+	if _, err := tenantCollection(ctx, r.collection).Indexes().CreateOne(ctx, index); err != nil {
+		return errors.Wrap(err, "failed to create webhook indexes")
+	}
+
+	return nil
+}
+
+// CreateWebhook stores a new webhook
+func (r *MongoWebhookRepository) CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (*model.Webhook, error) {
+		webhook.ID = primitive.NewObjectID()
+		webhook.CreatedAt = time.Now()
+
+		if _, err := tenantCollection(ctx, r.collection).InsertOne(ctx, webhook); err != nil {
+			return nil, errors.Wrap(err, "failed to insert webhook")
+		}
+
+		return webhook, nil
+	})
+}
+
+// ListWebhooks retrieves every webhook, oldest first
+func (r *MongoWebhookRepository) ListWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Webhook, error) {
+		return r.find(ctx, bson.M{})
+	})
+}
+
+// ListWebhooksForEvent retrieves the webhooks subscribed to an event
+func (r *MongoWebhookRepository) ListWebhooksForEvent(ctx context.Context, eventType string) ([]*model.Webhook, error) {
+	return readValue(ctx, r.guard, func(ctx context.Context) ([]*model.Webhook, error) {
+		return r.find(ctx, bson.M{"eventTypes": eventType})
+	})
+}
+
+// find retrieves the webhooks matching a filter, oldest first
+func (r *MongoWebhookRepository) find(ctx context.Context, filter bson.M) ([]*model.Webhook, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}})
+
+	cursor, err := tenantCollection(ctx, r.collection).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list webhooks")
+	}
+	defer cursor.Close(ctx)
+
+	var webhooks []*model.Webhook
+	if err := cursor.All(ctx, &webhooks); err != nil {
+		return nil, errors.Wrap(err, "failed to decode webhooks")
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes a webhook, reporting whether it existed
+func (r *MongoWebhookRepository) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	return writeValue(ctx, r.guard, func(ctx context.Context) (bool, error) {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			// No webhook can have a malformed ID
+			return false, nil
+		}
+
+		result, err := tenantCollection(ctx, r.collection).DeleteOne(ctx, bson.M{"_id": objectID})
+		if err != nil {
+			return false, errors.Wrap(err, "failed to delete webhook")
+		}
+
+		return result.DeletedCount > 0, nil
+	})
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// OutboxRepository defines the interface for storing events until they're published
+type OutboxRepository interface {
+	AddEvent(ctx context.Context, event *model.OutboxEvent) error
+	ListPendingEvents(ctx context.Context, limit int64) ([]*model.OutboxEvent, error)
+	MarkEventPublished(ctx context.Context, id string, publishedAt time.Time) error
+	RecordEventFailure(ctx context.Context, id string, reason string) error
+}
+
+// Transactor runs changes across repositories atomically
+type Transactor interface {
+	// InTransaction runs fn in a transaction, committing its writes only if
+	// it returns nil. Repository calls made with the context fn is given
+	// join the transaction. fn may run more than once if the transaction is
+	// retried.
+	InTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
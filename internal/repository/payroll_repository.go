@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ErrPayrollPeriodFinalized is returned when saving over a finalized payroll period
+var ErrPayrollPeriodFinalized = errors.New("payroll period is finalized")
+
+// PayrollRepository defines the interface for payroll period storage
+type PayrollRepository interface {
+	GetPayrollPeriod(ctx context.Context, id string) (*model.PayrollPeriod, error)
+	SavePayrollPeriod(ctx context.Context, period *model.PayrollPeriod) error
+}
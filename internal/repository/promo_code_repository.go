@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ErrPromoCodeExists is returned when creating a promo code that already exists
+var ErrPromoCodeExists = errors.New("promo code already exists")
+
+// PromoCodeRepository defines the interface for promo code storage
+type PromoCodeRepository interface {
+	ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error)
+	GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error)
+	CreatePromoCode(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error)
+	// UpdatePromoCode replaces a code's terms, keeping its uses. It returns
+	// nil if the code doesn't exist.
+	UpdatePromoCode(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error)
+	DeletePromoCode(ctx context.Context, code string) (bool, error)
+	// RedeemPromoCode counts a use of a code that's valid at now and not
+	// used up, in one step so concurrent bookings can't exceed its limit.
+	// It returns nil if the code can't be used.
+	RedeemPromoCode(ctx context.Context, code string, now time.Time) (*model.PromoCode, error)
+	// ReleasePromoCode gives back a use counted for a booking that wasn't made
+	ReleasePromoCode(ctx context.Context, code string) error
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// acquireLeaseScript sets the lease key to the holder unless another holder
+// has it, extending it if the holder already does
+var acquireLeaseScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current == false or current == ARGV[1] then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// releaseLeaseScript deletes the lease key if the holder still has it
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RedisLeaseRepository implements repository.LeaseRepository with Redis, one
+// expiring key per lease holding its holder
+type RedisLeaseRepository struct {
+	client *redis.Client
+}
+
+// NewRedisLeaseRepository connects to the Redis server at url, e.g.
+// redis://localhost:6379/0
+func NewRedisLeaseRepository(url string) (*RedisLeaseRepository, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid Redis URL")
+	}
+
+	return &RedisLeaseRepository{client: redis.NewClient(opts)}, nil
+}
+
+// Ping checks that Redis answers
+func (r *RedisLeaseRepository) Ping(ctx context.Context) error {
+	if err := r.client.Ping(ctx).Err(); err != nil {
+		return errors.Wrap(err, "failed to ping Redis")
+	}
+	return nil
+}
+
+// Close closes the connections to Redis
+func (r *RedisLeaseRepository) Close() error {
+	return r.client.Close()
+}
+
+// AcquireLease takes the lease if holder already has it or no one does;
+// Redis drops the key once it expires
+func (r *RedisLeaseRepository) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	acquired, err := acquireLeaseScript.Run(ctx, r.client, []string{leaseKey(name)}, holder, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to acquire lease")
+	}
+
+	return acquired == 1, nil
+}
+
+// ReleaseLease deletes holder's lease, letting another holder take it
+// without waiting for it to expire
+func (r *RedisLeaseRepository) ReleaseLease(ctx context.Context, name, holder string) error {
+	if err := releaseLeaseScript.Run(ctx, r.client, []string{leaseKey(name)}, holder).Err(); err != nil {
+		return errors.Wrap(err, "failed to release lease")
+	}
+
+	return nil
+}
+
+// leaseKey names the key holding a lease
+func leaseKey(name string) string {
+	return "lease:" + name
+}
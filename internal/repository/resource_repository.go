@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ErrResourceExists is returned when creating a resource whose ID is already taken
+var ErrResourceExists = errors.New("resource already exists")
+
+// ResourceRepository defines the interface for storing the shop's shared equipment
+type ResourceRepository interface {
+	ListResources(ctx context.Context) ([]*model.Resource, error)
+	CreateResource(ctx context.Context, resource *model.Resource) (*model.Resource, error)
+	UpdateResource(ctx context.Context, resource *model.Resource) (*model.Resource, error)
+	DeleteResource(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ErrReviewExists is returned when a booking has already been reviewed
+var ErrReviewExists = errors.New("booking has already been reviewed")
+
+// ReviewRepository defines the interface for review storage
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review *model.Review) (*model.Review, error)
+	// ListBarberReviews returns up to limit of a barber's reviews created
+	// before a time, newest first
+	ListBarberReviews(ctx context.Context, barberID string, before time.Time, limit int64) ([]*model.Review, error)
+	GetBarberRating(ctx context.Context, barberID string) (*model.BarberRating, error)
+	GetUserReviews(ctx context.Context, userID string) ([]*model.Review, error)
+	AnonymizeUserReviews(ctx context.Context, userID string) (int64, error)
+	DeleteUserReviews(ctx context.Context, userID string) (int64, error)
+}
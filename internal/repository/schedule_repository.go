@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ScheduleRepository defines the interface for barber schedule storage
+type ScheduleRepository interface {
+	GetSchedule(ctx context.Context, barberID string) (*model.BarberSchedule, error)
+	SaveSchedule(ctx context.Context, schedule *model.BarberSchedule) (*model.BarberSchedule, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// SettingsRepository defines the interface for shop settings storage
+type SettingsRepository interface {
+	GetSettings(ctx context.Context) (*model.ShopSettings, error)
+	UpdateRetentionPolicy(ctx context.Context, policy model.RetentionPolicy, updatedBy string) (*model.ShopSettings, error)
+	UpdateCancellationPolicy(ctx context.Context, policy model.CancellationPolicy, updatedBy string) (*model.ShopSettings, error)
+}
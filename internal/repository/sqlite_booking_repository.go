@@ -0,0 +1,1469 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// sqliteSchema creates the bookings table. Each booking is kept whole as a
+// BSON document, like in MongoDB, next to copies of the fields the queries
+// filter, sort and group on. Times are Unix milliseconds, the precision
+// MongoDB stores.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS bookings (
+	id              TEXT PRIMARY KEY,
+	user_id         TEXT NOT NULL,
+	barber_id       TEXT NOT NULL,
+	status          INTEGER NOT NULL,
+	start_time      INTEGER NOT NULL,
+	end_time        INTEGER NOT NULL,
+	created_at      INTEGER NOT NULL,
+	updated_at      INTEGER NOT NULL,
+	external_ref    TEXT UNIQUE,
+	idempotency_key TEXT,
+	service_type    INTEGER NOT NULL DEFAULT 0,
+	deleted_at      INTEGER,
+	doc             BLOB NOT NULL,
+	UNIQUE (user_id, idempotency_key)
+);
+`
+
+// sqliteIndexes indexes the bookings table, once any columns it's missing
+// have been added
+const sqliteIndexes = `
+CREATE INDEX IF NOT EXISTS bookings_barber_start ON bookings (barber_id, start_time);
+CREATE INDEX IF NOT EXISTS bookings_user_start ON bookings (user_id, start_time);
+CREATE INDEX IF NOT EXISTS bookings_status_created ON bookings (status, created_at);
+CREATE INDEX IF NOT EXISTS bookings_start ON bookings (start_time);
+CREATE INDEX IF NOT EXISTS bookings_deleted ON bookings (deleted_at);
+`
+
+// sqliteAddedColumns are the columns added to the bookings table after it was
+// first released, which databases created before then lack
+var sqliteAddedColumns = []struct{ name, definition string }{
+	{"service_type", "INTEGER NOT NULL DEFAULT 0"},
+	{"deleted_at", "INTEGER"},
+}
+
+// sqliteQuerier is what both a database and a transaction can run
+type sqliteQuerier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// SQLiteBookingRepository implements repository.BookingRepository with a
+// local SQLite file, for single-shop deployments running without MongoDB.
+// Tenants aren't supported.
+type SQLiteBookingRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteBookingRepository opens, creating it if needed, the SQLite
+// database at path
+func NewSQLiteBookingRepository(path string) (*SQLiteBookingRepository, error) {
+	// Transactions take the write lock up front, so availability checks
+	// and the writes they guard can't interleave with another writer's
+	dsn := "file:" + path + "?_txlock=immediate&_busy_timeout=5000&_journal_mode=WAL"
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open SQLite database")
+	}
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "failed to create SQLite schema")
+	}
+	if err := addSQLiteColumns(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if _, err := db.Exec(sqliteIndexes); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "failed to create SQLite indexes")
+	}
+
+	return &SQLiteBookingRepository{db: db}, nil
+}
+
+// addSQLiteColumns adds the columns a database created by an earlier release
+// lacks, filling them in from the stored bookings
+func addSQLiteColumns(db *sql.DB) error {
+	rows, err := db.Query("SELECT name FROM pragma_table_info('bookings')")
+	if err != nil {
+		return errors.Wrap(err, "failed to read SQLite schema")
+	}
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return errors.Wrap(err, "failed to read SQLite schema")
+		}
+		existing[name] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to read SQLite schema")
+	}
+
+	var missing []string
+	for _, column := range sqliteAddedColumns {
+		if !existing[column.name] {
+			missing = append(missing, "ALTER TABLE bookings ADD COLUMN "+column.name+" "+column.definition)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	for _, statement := range missing {
+		if _, err := tx.Exec(statement); err != nil {
+			return errors.Wrap(err, "failed to add SQLite column")
+		}
+	}
+
+	rows, err = tx.Query("SELECT doc FROM bookings")
+	if err != nil {
+		return errors.Wrap(err, "failed to read bookings")
+	}
+	var bookings []*model.Booking
+	for rows.Next() {
+		var doc []byte
+		if err := rows.Scan(&doc); err != nil {
+			rows.Close()
+			return errors.Wrap(err, "failed to read bookings")
+		}
+		var booking model.Booking
+		if err := bson.Unmarshal(doc, &booking); err != nil {
+			rows.Close()
+			return errors.Wrap(err, "failed to decode booking")
+		}
+		bookings = append(bookings, &booking)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to read bookings")
+	}
+
+	for _, booking := range bookings {
+		_, err := tx.Exec("UPDATE bookings SET service_type = ?, deleted_at = ? WHERE id = ?",
+			booking.ServiceType, nullableMillis(booking.DeletedAt), booking.ID.Hex())
+		if err != nil {
+			return errors.Wrap(err, "failed to fill in SQLite columns")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// Close closes the database
+func (r *SQLiteBookingRepository) Close() error {
+	return r.db.Close()
+}
+
+// Ping checks that the bookings table can be read
+func (r *SQLiteBookingRepository) Ping(ctx context.Context) error {
+	var id string
+	err := r.db.QueryRowContext(ctx, "SELECT id FROM bookings LIMIT 1").Scan(&id)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(err, "failed to read bookings")
+	}
+
+	return nil
+}
+
+// CreateBooking adds a new booking, provided the barber has fewer than
+// capacity bookings overlapping it and each of the resources its services
+// need has a unit free. The checks and the insert run in one transaction,
+// which SQLite serializes with every other write.
+func (r *SQLiteBookingRepository) CreateBooking(ctx context.Context, booking *model.Booking, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	// Set timestamps
+	now := time.Now()
+	booking.CreatedAt = now
+	booking.UpdatedAt = now
+	booking.Version = 1
+
+	// Generate new ID if not set
+	if booking.ID.IsZero() {
+		booking.ID = primitive.NewObjectID()
+	}
+
+	err := r.inTransaction(ctx, func(tx *sql.Tx) error {
+		if err := r.checkAvailability(ctx, tx, booking, booking.StartTime, booking.OccupiedUntil(), capacity, resources); err != nil {
+			return err
+		}
+		return r.insert(ctx, tx, booking)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// GetBookingByID retrieves a booking by its ID
+func (r *SQLiteBookingRepository) GetBookingByID(ctx context.Context, id string) (*model.Booking, error) {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		return nil, errors.Wrap(err, "invalid booking ID format")
+	}
+
+	return r.findOne(ctx, r.db, "id = ?", id)
+}
+
+// GetBookingByExternalRef retrieves a booking by its external reference
+func (r *SQLiteBookingRepository) GetBookingByExternalRef(ctx context.Context, externalRef string) (*model.Booking, error) {
+	return r.findOne(ctx, r.db, "external_ref = ?", externalRef)
+}
+
+// GetBookingByIdempotencyKey retrieves the booking a user created with an idempotency key
+func (r *SQLiteBookingRepository) GetBookingByIdempotencyKey(ctx context.Context, userID, key string) (*model.Booking, error) {
+	return r.findOne(ctx, r.db, "user_id = ? AND idempotency_key = ?", userID, key)
+}
+
+// UpdateBooking updates an existing booking
+func (r *SQLiteBookingRepository) UpdateBooking(ctx context.Context, id string, updates map[string]interface{}) (*model.Booking, error) {
+	return r.updateBooking(ctx, id, func(*model.Booking) bool { return true }, updates)
+}
+
+// UpdateBookingAtVersion updates a booking only if it's still at the expected
+// version. It returns nil if the booking doesn't exist or has changed since.
+func (r *SQLiteBookingRepository) UpdateBookingAtVersion(ctx context.Context, id string, version int64, updates map[string]interface{}) (*model.Booking, error) {
+	return r.updateBooking(ctx, id, func(booking *model.Booking) bool { return booking.Version == version }, updates)
+}
+
+// UpdateBookingIfUnset updates a booking only if none of fields is set on it.
+// It returns nil if the booking doesn't exist or one of them is set.
+func (r *SQLiteBookingRepository) UpdateBookingIfUnset(ctx context.Context, id string, fields []string, updates map[string]interface{}) (*model.Booking, error) {
+	return r.updateBooking(ctx, id, func(booking *model.Booking) bool {
+		raw, err := bson.Marshal(booking)
+		if err != nil {
+			return false
+		}
+		for _, field := range fields {
+			if value, err := bson.Raw(raw).LookupErr(strings.Split(field, ".")...); err == nil && value.Type != bson.TypeNull {
+				return false
+			}
+		}
+		return true
+	}, updates)
+}
+
+// updateBooking sets the updated fields of the booking, if match accepts it,
+// and bumps its version
+func (r *SQLiteBookingRepository) updateBooking(ctx context.Context, id string, match func(*model.Booking) bool, updates map[string]interface{}) (*model.Booking, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid booking ID format")
+	}
+
+	var updated *model.Booking
+	err = r.inTransaction(ctx, func(tx *sql.Tx) error {
+		updated, err = r.modify(ctx, tx, objectID, match, func(booking *model.Booking, doc bson.M) {
+			for field, value := range updates {
+				setPath(doc, field, value)
+			}
+			doc["updatedAt"] = time.Now()
+			doc["version"] = booking.Version + 1
+		})
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update booking")
+	}
+
+	return updated, nil
+}
+
+// RescheduleBooking moves a booking to a new time, or changes its length,
+// along with the move's other updates. The availability check and the move
+// run in one transaction; ErrSlotUnavailable is returned if the new time
+// overlaps capacity other bookings, and ErrResourceUnavailable if one of the
+// resources has no unit free then. It returns nil if the booking was moved,
+// cancelled or, with a version, changed concurrently.
+func (r *SQLiteBookingRepository) RescheduleBooking(ctx context.Context, booking *model.Booking, move BookingMove, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	occupiedUntil := model.CalculateOccupiedUntil(move.EndTime, move.CleanupMinutes)
+
+	var updated *model.Booking
+	err := r.inTransaction(ctx, func(tx *sql.Tx) error {
+		if err := r.checkAvailability(ctx, tx, booking, move.StartTime, occupiedUntil, capacity, resources); err != nil {
+			return err
+		}
+
+		match := func(current *model.Booking) bool {
+			return current.StartTime.UnixMilli() == booking.StartTime.UnixMilli() &&
+				(current.Status == model.BookingStatusPending || current.Status == model.BookingStatusConfirmed) &&
+				(move.Version == nil || current.Version == *move.Version)
+		}
+
+		var err error
+		updated, err = r.modify(ctx, tx, booking.ID, match, func(current *model.Booking, doc bson.M) {
+			now := time.Now()
+			doc["startTime"] = move.StartTime
+			doc["endTime"] = move.EndTime
+			for field, value := range move.Updates {
+				setPath(doc, field, value)
+			}
+			if !move.StartTime.Equal(booking.StartTime) {
+				doc["rescheduledFrom"] = booking.StartTime
+				doc["rescheduledAt"] = now
+				// A check-in and a reminder belong to the original appointment
+				delete(doc, "checkedInAt")
+				delete(doc, "reminderSentAt")
+			}
+			doc["updatedAt"] = now
+			doc["version"] = current.Version + 1
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to reschedule booking")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// checkAvailability fails with ErrSlotUnavailable if the barber's bookings
+// other than this one already fill their capacity somewhere in the window,
+// including cleanup buffers, and with ErrResourceUnavailable if the bookings
+// with any barber take every unit of one of the resources
+func (r *SQLiteBookingRepository) checkAvailability(ctx context.Context, q sqliteQuerier, booking *model.Booking, start, occupiedUntil time.Time, capacity int, resources []*model.Resource) error {
+	// Widen the search so earlier bookings whose buffer runs into the window are found
+	from := start.Add(-model.MaxCleanupBuffer)
+
+	others := func(bookings []*model.Booking) []*model.Booking {
+		return slices.DeleteFunc(bookings, func(other *model.Booking) bool { return other.ID == booking.ID })
+	}
+
+	bookings, err := r.bookingsInTimeRange(ctx, q, booking.BarberID, from, occupiedUntil)
+	if err != nil {
+		return err
+	}
+	if !model.FitsCapacity(others(bookings), start, occupiedUntil, capacity) {
+		return ErrSlotUnavailable
+	}
+
+	if len(resources) == 0 {
+		return nil
+	}
+	bookings, err = r.bookingsForServices(ctx, q, model.ResourceServiceTypes(resources), from, occupiedUntil)
+	if err != nil {
+		return err
+	}
+	bookings = others(bookings)
+	for _, resource := range resources {
+		if !model.FitsCapacity(resource.Users(bookings), start, occupiedUntil, resource.Capacity) {
+			return ErrResourceUnavailable
+		}
+	}
+
+	return nil
+}
+
+// TransitionBookingStatus changes a booking's status, along with any other
+// updates, only if the booking is still in the expected status. It returns
+// nil if no booking with the ID is in that status.
+func (r *SQLiteBookingRepository) TransitionBookingStatus(ctx context.Context, id string, from, to model.BookingStatus, updates map[string]interface{}) (*model.Booking, error) {
+	set := map[string]interface{}{}
+	for field, value := range updates {
+		set[field] = value
+	}
+	set["status"] = to
+
+	return r.updateBooking(ctx, id, func(booking *model.Booking) bool { return booking.Status == from }, set)
+}
+
+// AddAttachment appends an attachment to a booking
+func (r *SQLiteBookingRepository) AddAttachment(ctx context.Context, id string, attachment *model.Attachment) (*model.Booking, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid booking ID format")
+	}
+
+	var updated *model.Booking
+	err = r.inTransaction(ctx, func(tx *sql.Tx) error {
+		updated, err = r.modify(ctx, tx, objectID, func(*model.Booking) bool { return true }, func(booking *model.Booking, doc bson.M) {
+			doc["attachments"] = append(booking.Attachments, attachment)
+			doc["updatedAt"] = time.Now()
+			doc["version"] = booking.Version + 1
+		})
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add attachment")
+	}
+
+	return updated, nil
+}
+
+// ListBookings retrieves the bookings matching a filter, sorted as requested
+func (r *SQLiteBookingRepository) ListBookings(ctx context.Context, filter model.BookingFilter) ([]*model.Booking, error) {
+	var where conditions
+	if filter.UserID != "" {
+		where.add("user_id = ?", filter.UserID)
+	}
+	if filter.BarberID != "" {
+		where.add("barber_id = ?", filter.BarberID)
+	}
+	if len(filter.Statuses) > 0 && !filter.NeedsReview {
+		whereIn(&where, "status", filter.Statuses)
+	}
+	if filter.NeedsReview {
+		where.add("status = ?", model.BookingStatusConfirmed)
+	}
+	if filter.StartFrom != nil {
+		where.add("start_time >= ?", filter.StartFrom.UnixMilli())
+	}
+	if filter.StartBefore != nil {
+		where.add("start_time < ?", filter.StartBefore.UnixMilli())
+	}
+
+	sortColumn := "start_time"
+	switch filter.SortBy {
+	case model.SortByCreatedAt:
+		sortColumn = "created_at"
+	case model.SortByUpdatedAt:
+		sortColumn = "updated_at"
+	}
+	order := "ASC"
+	if filter.Descending {
+		order = "DESC"
+	}
+
+	keep := func(booking *model.Booking) bool {
+		if filter.NeedsReview && booking.ReviewFlaggedAt == nil {
+			return false
+		}
+		return len(filter.ServiceTypes) == 0 || includesService(booking, filter.ServiceTypes)
+	}
+
+	bookings, err := r.find(ctx, r.db, where, sortColumn+" "+order+", id "+order, keep, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list bookings")
+	}
+
+	return bookings, nil
+}
+
+// GetUserBookings retrieves all bookings for a specific user
+func (r *SQLiteBookingRepository) GetUserBookings(ctx context.Context, userID string) ([]*model.Booking, error) {
+	var where conditions
+	where.add("user_id = ?", userID)
+
+	bookings, err := r.find(ctx, r.db, where, "", nil, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user bookings")
+	}
+
+	return bookings, nil
+}
+
+// FindUserBookings retrieves all bookings of a user, soft-deleted ones
+// included, for exporting or erasing the user's data
+func (r *SQLiteBookingRepository) FindUserBookings(ctx context.Context, userID string) ([]*model.Booking, error) {
+	var where conditions
+	where.add("user_id = ?", userID)
+
+	bookings, err := r.scan(ctx, r.db, where, "start_time", nil, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user bookings")
+	}
+
+	return bookings, nil
+}
+
+// GetBarberBookings retrieves all bookings for a specific barber
+func (r *SQLiteBookingRepository) GetBarberBookings(ctx context.Context, barberID string, date *time.Time) ([]*model.Booking, error) {
+	var where conditions
+	where.add("barber_id = ?", barberID)
+
+	// Add date filter if specified
+	if date != nil {
+		startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+		endOfDay := startOfDay.AddDate(0, 0, 1) // Not 24h on DST transitions
+
+		where.add("start_time >= ?", startOfDay.UnixMilli())
+		where.add("start_time < ?", endOfDay.UnixMilli())
+	}
+
+	bookings, err := r.find(ctx, r.db, where, "", nil, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get barber bookings")
+	}
+
+	return bookings, nil
+}
+
+// GetBookingsInTimeRange retrieves all bookings for a barber in a time range
+func (r *SQLiteBookingRepository) GetBookingsInTimeRange(ctx context.Context, barberID string, start, end time.Time) ([]*model.Booking, error) {
+	return r.bookingsInTimeRange(ctx, r.db, barberID, start, end)
+}
+
+// bookingsInTimeRange retrieves the barber's active bookings overlapping a
+// time range
+func (r *SQLiteBookingRepository) bookingsInTimeRange(ctx context.Context, q sqliteQuerier, barberID string, start, end time.Time) ([]*model.Booking, error) {
+	var where conditions
+	where.add("barber_id = ?", barberID)
+	where.add("status != ?", model.BookingStatusCancelled)
+	where.add("start_time < ?", end.UnixMilli())
+	where.add("end_time > ?", start.UnixMilli())
+
+	now := time.Now()
+	keep := func(booking *model.Booking) bool { return !booking.HoldExpired(now) }
+
+	bookings, err := r.find(ctx, q, where, "", keep, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bookings in time range")
+	}
+
+	return bookings, nil
+}
+
+// GetBookingsForServices retrieves the active bookings with any barber that
+// include one of the services and overlap a time range
+func (r *SQLiteBookingRepository) GetBookingsForServices(ctx context.Context, serviceTypes []model.ServiceType, start, end time.Time) ([]*model.Booking, error) {
+	return r.bookingsForServices(ctx, r.db, serviceTypes, start, end)
+}
+
+// bookingsForServices is GetBookingsForServices run with q
+func (r *SQLiteBookingRepository) bookingsForServices(ctx context.Context, q sqliteQuerier, serviceTypes []model.ServiceType, start, end time.Time) ([]*model.Booking, error) {
+	var where conditions
+	where.add("status != ?", model.BookingStatusCancelled)
+	where.add("start_time < ?", end.UnixMilli())
+	where.add("end_time > ?", start.UnixMilli())
+
+	now := time.Now()
+	keep := func(booking *model.Booking) bool {
+		return !booking.HoldExpired(now) && includesService(booking, serviceTypes)
+	}
+
+	bookings, err := r.find(ctx, q, where, "", keep, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get bookings for services")
+	}
+
+	return bookings, nil
+}
+
+// GetCompletedBookings retrieves all completed bookings starting in a time range
+func (r *SQLiteBookingRepository) GetCompletedBookings(ctx context.Context, start, end time.Time) ([]*model.Booking, error) {
+	var where conditions
+	where.add("status = ?", model.BookingStatusCompleted)
+	where.add("start_time >= ?", start.UnixMilli())
+	where.add("start_time < ?", end.UnixMilli())
+
+	bookings, err := r.find(ctx, r.db, where, "", nil, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get completed bookings")
+	}
+
+	return bookings, nil
+}
+
+// GetRevenue sums what the completed bookings starting in a time range
+// earned by period, barber, service and currency, with periods taken in
+// start's location. A booking earns what was paid at the point of sale, or
+// its quoted price if no payment was recorded, split evenly between its
+// services. An empty barber ID sums every barber's.
+func (r *SQLiteBookingRepository) GetRevenue(ctx context.Context, barberID string, start, end time.Time, granularity model.RevenueGranularity) (*model.RevenueTotals, error) {
+	var where conditions
+	if barberID != "" {
+		where.add("barber_id = ?", barberID)
+	}
+	where.add("status = ?", model.BookingStatusCompleted)
+	where.add("start_time >= ?", start.UnixMilli())
+	where.add("start_time < ?", end.UnixMilli())
+
+	// SQLite can't read the payments out of the stored documents or take
+	// times in a time zone, so the bookings are summed here
+	bookings, err := r.find(ctx, r.db, where, "", nil, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get completed bookings")
+	}
+
+	type key struct {
+		periodStart time.Time
+		barberID    string
+		serviceType model.ServiceType
+		currency    string
+	}
+	totals := &model.RevenueTotals{Bookings: len(bookings)}
+	lines := make(map[key]*model.RevenueTotal)
+	for _, booking := range bookings {
+		amount, tip, currency := booking.Price, int64(0), booking.Currency
+		if booking.Payment != nil {
+			amount, tip, currency = booking.Payment.Amount, booking.Payment.Tip, booking.Payment.Currency
+		}
+
+		services := booking.RevenueServices()
+		amounts, tips := model.SplitAmount(amount, len(services)), model.SplitAmount(tip, len(services))
+		periodStart := granularity.PeriodStart(booking.StartTime.In(start.Location()))
+		for i, serviceType := range services {
+			k := key{periodStart, booking.BarberID, serviceType, currency}
+			line, ok := lines[k]
+			if !ok {
+				line = &model.RevenueTotal{
+					RevenueLine: model.RevenueLine{PeriodStart: periodStart, BarberID: booking.BarberID, ServiceType: serviceType},
+					Currency:    currency,
+				}
+				lines[k] = line
+				totals.Lines = append(totals.Lines, line)
+			}
+			line.Bookings++
+			line.Revenue += amounts[i]
+			line.Tips += tips[i]
+		}
+	}
+
+	return totals, nil
+}
+
+// GetUserReliability counts a customer's bookings by status
+func (r *SQLiteBookingRepository) GetUserReliability(ctx context.Context, userID string) (*model.UserReliability, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT status, COUNT(*) FROM bookings WHERE user_id = ? AND deleted_at IS NULL GROUP BY status", userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count user bookings")
+	}
+	defer rows.Close()
+
+	reliability := &model.UserReliability{UserID: userID}
+	for rows.Next() {
+		var status model.BookingStatus
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, errors.Wrap(err, "failed to decode user booking counts")
+		}
+
+		reliability.Bookings += count
+		switch status {
+		case model.BookingStatusCompleted:
+			reliability.Completed = count
+		case model.BookingStatusCancelled:
+			reliability.Cancelled = count
+		case model.BookingStatusNoShow:
+			reliability.NoShows = count
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to count user bookings")
+	}
+
+	return reliability, nil
+}
+
+// GetBarberStats counts a barber's bookings starting in a time range by
+// status, and sums the time booked on each day, taken in start's location
+func (r *SQLiteBookingRepository) GetBarberStats(ctx context.Context, barberID string, start, end time.Time) (*model.BarberStats, error) {
+	var where conditions
+	where.add("barber_id = ?", barberID)
+	where.add("start_time >= ?", start.UnixMilli())
+	where.add("start_time < ?", end.UnixMilli())
+	where.add("deleted_at IS NULL")
+
+	stats := &model.BarberStats{
+		BarberID:     barberID,
+		Start:        start,
+		End:          end,
+		StatusCounts: make(map[model.BookingStatus]int),
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT status, COUNT(*) FROM bookings WHERE "+where.sql()+" GROUP BY status", where.args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count barber bookings")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var status model.BookingStatus
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, errors.Wrap(err, "failed to decode barber booking counts")
+		}
+		stats.StatusCounts[status] = count
+		stats.Bookings += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to count barber bookings")
+	}
+
+	// SQLite can't take times in a time zone, so bookings starting at the
+	// same time are summed together and the days are added up here
+	whereIn(&where, "status", model.BookedStatuses)
+	rows, err = r.db.QueryContext(ctx, "SELECT start_time, COUNT(*), SUM(end_time - start_time) FROM bookings WHERE "+where.sql()+" GROUP BY start_time ORDER BY start_time", where.args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum booked time")
+	}
+	defer rows.Close()
+
+	days := make(map[string]*model.DayStats)
+	for rows.Next() {
+		var startMillis, bookedMillis int64
+		var count int
+		if err := rows.Scan(&startMillis, &count, &bookedMillis); err != nil {
+			return nil, errors.Wrap(err, "failed to decode booked time")
+		}
+
+		local := time.UnixMilli(startMillis).In(start.Location())
+		key := local.Format(model.StatsDayFormat)
+		day, ok := days[key]
+		if !ok {
+			day = &model.DayStats{Date: time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, local.Location())}
+			days[key] = day
+			stats.Days = append(stats.Days, day)
+		}
+		booked := time.Duration(bookedMillis) * time.Millisecond
+		day.Bookings += count
+		day.BookedTime += booked
+		stats.BookedTime += booked
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to sum booked time")
+	}
+
+	return stats, nil
+}
+
+// GetDemandHeatmap counts the bookings taking up barbers' time that started
+// in a time range by weekday and hour, taken in start's location. An empty
+// barber ID counts every barber's.
+func (r *SQLiteBookingRepository) GetDemandHeatmap(ctx context.Context, barberID string, start, end time.Time) (*model.DemandHeatmap, error) {
+	var where conditions
+	if barberID != "" {
+		where.add("barber_id = ?", barberID)
+	}
+	whereIn(&where, "status", model.BookedStatuses)
+	where.add("start_time >= ?", start.UnixMilli())
+	where.add("start_time < ?", end.UnixMilli())
+	where.add("deleted_at IS NULL")
+
+	// SQLite can't take times in a time zone, so bookings are counted by
+	// start time and those put in their weekday and hour here
+	rows, err := r.db.QueryContext(ctx, "SELECT start_time, COUNT(*) FROM bookings WHERE "+where.sql()+" GROUP BY start_time", where.args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count bookings")
+	}
+	defer rows.Close()
+
+	heatmap := &model.DemandHeatmap{BarberID: barberID, Start: start, End: end, Timezone: start.Location().String()}
+	for rows.Next() {
+		var startMillis int64
+		var count int
+		if err := rows.Scan(&startMillis, &count); err != nil {
+			return nil, errors.Wrap(err, "failed to decode booking counts")
+		}
+		local := time.UnixMilli(startMillis).In(start.Location())
+		heatmap.Counts[local.Weekday()][local.Hour()] += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to count bookings")
+	}
+
+	return heatmap, nil
+}
+
+// GetReliabilityReport counts how the bookings starting in a period turned
+// out per customer or barber, returning the rows with the highest rate first
+func (r *SQLiteBookingRepository) GetReliabilityReport(ctx context.Context, filter model.ReliabilityFilter) ([]*model.ReliabilityRow, error) {
+	var where conditions
+	where.add("start_time >= ?", filter.Start.UnixMilli())
+	where.add("start_time < ?", filter.End.UnixMilli())
+	where.add("status != ?", model.BookingStatusHeld)
+	if filter.BarberID != "" {
+		where.add("barber_id = ?", filter.BarberID)
+	}
+	column := "barber_id"
+	if filter.GroupBy == model.ReliabilityByUser {
+		column = "user_id"
+		// Anonymized bookings no longer say whose they were
+		where.add("user_id != ''")
+	}
+	where.add("deleted_at IS NULL")
+
+	query := "SELECT " + column + ", status, COUNT(*) FROM bookings WHERE " + where.sql() + " GROUP BY " + column + ", status"
+	result, err := r.db.QueryContext(ctx, query, where.args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count booking outcomes")
+	}
+	defer result.Close()
+
+	counted := make(map[string]*model.ReliabilityRow)
+	for result.Next() {
+		var id string
+		var status model.BookingStatus
+		var count int
+		if err := result.Scan(&id, &status, &count); err != nil {
+			return nil, errors.Wrap(err, "failed to decode booking outcomes")
+		}
+		row, ok := counted[id]
+		if !ok {
+			row = &model.ReliabilityRow{ID: id}
+			counted[id] = row
+		}
+
+		row.Bookings += count
+		switch status {
+		case model.BookingStatusCompleted:
+			row.Completed = count
+		case model.BookingStatusCancelled:
+			row.Cancelled = count
+		case model.BookingStatusNoShow:
+			row.NoShows = count
+		}
+	}
+	if err := result.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to count booking outcomes")
+	}
+
+	rows := make([]*model.ReliabilityRow, 0, len(counted))
+	for _, row := range counted {
+		if row.Bookings >= filter.MinBookings {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return filter.SortBy.Before(rows[i], rows[j])
+	})
+	if filter.Limit > 0 && len(rows) > filter.Limit {
+		rows = rows[:filter.Limit]
+	}
+
+	return rows, nil
+}
+
+// GetCustomerSummary counts a customer's completed bookings, finds their first
+// and last and the service they start with most often, ties going to the one
+// booked last
+func (r *SQLiteBookingRepository) GetCustomerSummary(ctx context.Context, userID string) (*model.CustomerSummary, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT service_type, COUNT(*), MIN(start_time), MAX(start_time) FROM bookings
+		WHERE user_id = ? AND status = ? AND deleted_at IS NULL
+		GROUP BY service_type
+		ORDER BY COUNT(*) DESC, MAX(start_time) DESC, service_type`,
+		userID, model.BookingStatusCompleted)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to count customer bookings")
+	}
+	defer rows.Close()
+
+	summary := &model.CustomerSummary{UserID: userID}
+	for rows.Next() {
+		var serviceType model.ServiceType
+		var count int
+		var firstMillis, lastMillis int64
+		if err := rows.Scan(&serviceType, &count, &firstMillis, &lastMillis); err != nil {
+			return nil, errors.Wrap(err, "failed to decode customer booking counts")
+		}
+
+		// The first row is the usual service
+		if summary.Visits == 0 {
+			summary.UsualService = serviceType
+		}
+		summary.Visits += count
+		first, last := time.UnixMilli(firstMillis).UTC(), time.UnixMilli(lastMillis).UTC()
+		if summary.FirstVisit == nil || first.Before(*summary.FirstVisit) {
+			summary.FirstVisit = &first
+		}
+		if summary.LastVisit == nil || last.After(*summary.LastVisit) {
+			summary.LastVisit = &last
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to count customer bookings")
+	}
+
+	return summary, nil
+}
+
+// FindLateBookings retrieves active bookings that started before a cutoff,
+// are still running and whose customer hasn't checked in
+func (r *SQLiteBookingRepository) FindLateBookings(ctx context.Context, startedBefore, now time.Time) ([]*model.Booking, error) {
+	var where conditions
+	whereIn(&where, "status", []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed})
+	where.add("start_time < ?", startedBefore.UnixMilli())
+	where.add("end_time > ?", now.UnixMilli())
+
+	keep := func(booking *model.Booking) bool {
+		return booking.CheckedInAt == nil && booking.ReleasedAt == nil
+	}
+
+	bookings, err := r.find(ctx, r.db, where, "", keep, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find late bookings")
+	}
+
+	return bookings, nil
+}
+
+// FindUnpaidBookings retrieves pending bookings whose deposit wasn't paid
+// before it expired
+func (r *SQLiteBookingRepository) FindUnpaidBookings(ctx context.Context, expiredBefore time.Time) ([]*model.Booking, error) {
+	var where conditions
+	where.add("status = ?", model.BookingStatusPending)
+
+	keep := func(booking *model.Booking) bool {
+		return booking.Deposit != nil &&
+			booking.Deposit.Status == model.DepositStatusPending &&
+			booking.Deposit.ExpiresAt.Before(expiredBefore)
+	}
+
+	bookings, err := r.find(ctx, r.db, where, "", keep, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find unpaid bookings")
+	}
+
+	return bookings, nil
+}
+
+// FindBookingsToAutoComplete retrieves up to limit confirmed bookings that
+// ended before a cutoff and haven't been flagged for review, oldest first
+func (r *SQLiteBookingRepository) FindBookingsToAutoComplete(ctx context.Context, endedBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var where conditions
+	where.add("status = ?", model.BookingStatusConfirmed)
+	where.add("end_time < ?", endedBefore.UnixMilli())
+
+	keep := func(booking *model.Booking) bool { return booking.ReviewFlaggedAt == nil }
+
+	bookings, err := r.find(ctx, r.db, where, "end_time", keep, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find bookings to complete")
+	}
+
+	return bookings, nil
+}
+
+// FindStalePendingBookings retrieves up to limit bookings created before a
+// cutoff that are still pending, oldest first. Bookings awaiting a deposit
+// are left out, as the deposit's own deadline applies to them.
+func (r *SQLiteBookingRepository) FindStalePendingBookings(ctx context.Context, createdBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var where conditions
+	where.add("status = ?", model.BookingStatusPending)
+	where.add("created_at < ?", createdBefore.UnixMilli())
+
+	keep := func(booking *model.Booking) bool { return booking.Deposit == nil }
+
+	bookings, err := r.find(ctx, r.db, where, "created_at", keep, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find stale pending bookings")
+	}
+
+	return bookings, nil
+}
+
+// FindBookingsDueReminder retrieves up to limit active bookings starting
+// between now and a cutoff that haven't been sent a reminder, soonest first
+func (r *SQLiteBookingRepository) FindBookingsDueReminder(ctx context.Context, now, startsBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var where conditions
+	whereIn(&where, "status", []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed})
+	where.add("start_time > ?", now.UnixMilli())
+	where.add("start_time <= ?", startsBefore.UnixMilli())
+
+	keep := func(booking *model.Booking) bool { return booking.ReminderSentAt == nil }
+
+	bookings, err := r.find(ctx, r.db, where, "start_time", keep, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find bookings due a reminder")
+	}
+
+	return bookings, nil
+}
+
+// MarkReminderSent records that a booking's customer was reminded of it. It
+// returns false if the booking was rescheduled or already marked meanwhile.
+// The booking's version isn't bumped, as this isn't a change to the booking.
+func (r *SQLiteBookingRepository) MarkReminderSent(ctx context.Context, id string, startTime, sentAt time.Time) (bool, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, nil
+	}
+
+	match := func(booking *model.Booking) bool {
+		return booking.StartTime.UnixMilli() == startTime.UnixMilli() && booking.ReminderSentAt == nil
+	}
+
+	var marked *model.Booking
+	err = r.inTransaction(ctx, func(tx *sql.Tx) error {
+		marked, err = r.modify(ctx, tx, objectID, match, func(_ *model.Booking, doc bson.M) {
+			doc["reminderSentAt"] = sentAt
+		})
+		return err
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to mark reminder sent")
+	}
+
+	return marked != nil, nil
+}
+
+// FindExpiredBookings retrieves up to limit bookings with a status that ended
+// before a cutoff, for applying retention policies
+func (r *SQLiteBookingRepository) FindExpiredBookings(ctx context.Context, status model.BookingStatus, endedBefore time.Time, includeAnonymized bool, limit int64) ([]*model.Booking, error) {
+	var where conditions
+	where.add("status = ?", status)
+	where.add("end_time < ?", endedBefore.UnixMilli())
+
+	keep := func(booking *model.Booking) bool { return includeAnonymized || !booking.Anonymized }
+
+	bookings, err := r.find(ctx, r.db, where, "", keep, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find expired bookings")
+	}
+
+	return bookings, nil
+}
+
+// SoftDeleteBooking marks a booking deleted at a time, after which no other
+// method finds it. It returns the deleted booking, or nil if there's no such
+// booking or it was deleted already.
+func (r *SQLiteBookingRepository) SoftDeleteBooking(ctx context.Context, id string, deletedAt time.Time) (*model.Booking, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid booking ID format")
+	}
+
+	var deleted *model.Booking
+	err = r.inTransaction(ctx, func(tx *sql.Tx) error {
+		deleted, err = r.modify(ctx, tx, objectID, func(*model.Booking) bool { return true }, func(booking *model.Booking, doc bson.M) {
+			doc["deletedAt"] = deletedAt
+			doc["updatedAt"] = deletedAt
+			doc["version"] = booking.Version + 1
+		})
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to soft-delete booking")
+	}
+
+	return deleted, nil
+}
+
+// FindDeletedBookings retrieves up to limit bookings soft-deleted before a
+// cutoff, for purging them
+func (r *SQLiteBookingRepository) FindDeletedBookings(ctx context.Context, deletedBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var where conditions
+	where.add("deleted_at < ?", deletedBefore.UnixMilli())
+
+	bookings, err := r.scan(ctx, r.db, where, "deleted_at", nil, limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find deleted bookings")
+	}
+
+	return bookings, nil
+}
+
+// DeleteBookings permanently removes bookings by ID
+func (r *SQLiteBookingRepository) DeleteBookings(ctx context.Context, ids []string) (int64, error) {
+	if _, err := toObjectIDs(ids); err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var where conditions
+	whereIn(&where, "id", ids)
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM bookings WHERE "+where.sql(), where.args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete bookings")
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete bookings")
+	}
+
+	return deleted, nil
+}
+
+// AnonymizeBookings strips personal data from bookings while keeping the
+// figures needed for reporting
+func (r *SQLiteBookingRepository) AnonymizeBookings(ctx context.Context, ids []string) (int64, error) {
+	objectIDs, err := toObjectIDs(ids)
+	if err != nil {
+		return 0, err
+	}
+
+	var anonymized int64
+	err = r.inTransaction(ctx, func(tx *sql.Tx) error {
+		for _, objectID := range objectIDs {
+			updated, err := r.modify(ctx, tx, objectID, func(*model.Booking) bool { return true }, func(booking *model.Booking, doc bson.M) {
+				doc["userId"] = ""
+				doc["anonymized"] = true
+				doc["updatedAt"] = time.Now()
+				doc["version"] = booking.Version + 1
+				for _, field := range []string{"notes", "externalRef", "attachments", "cancellation.cancelledBy", "cancellation.reason"} {
+					unsetPath(doc, field)
+				}
+			})
+			if err != nil {
+				return err
+			}
+			if updated != nil {
+				anonymized++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to anonymize bookings")
+	}
+
+	return anonymized, nil
+}
+
+// FindDuplicateBooking finds an active booking created after createdAfter that
+// matches the same user, barber, start time and services
+func (r *SQLiteBookingRepository) FindDuplicateBooking(ctx context.Context, userID, barberID string, startTime time.Time, serviceTypes []model.ServiceType, createdAfter time.Time) (*model.Booking, error) {
+	var where conditions
+	where.add("user_id = ?", userID)
+	where.add("barber_id = ?", barberID)
+	where.add("start_time = ?", startTime.UnixMilli())
+	whereIn(&where, "status", []model.BookingStatus{model.BookingStatusPending, model.BookingStatusConfirmed})
+	where.add("created_at >= ?", createdAfter.UnixMilli())
+
+	keep := func(booking *model.Booking) bool {
+		// Bookings stored before they could have several services only have the one
+		if len(booking.ServiceTypes) == 0 {
+			return len(serviceTypes) == 1 && booking.ServiceType == serviceTypes[0]
+		}
+		return slices.Equal(booking.ServiceTypes, serviceTypes)
+	}
+
+	bookings, err := r.find(ctx, r.db, where, "", keep, 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find duplicate booking")
+	}
+	if len(bookings) == 0 {
+		return nil, nil // No duplicate found
+	}
+
+	return bookings[0], nil
+}
+
+// ConvertHold replaces a hold that hasn't lapsed by now with the booking
+// made from it, which has the hold's ID. It returns nil if the hold lapsed,
+// was converted already or is gone.
+func (r *SQLiteBookingRepository) ConvertHold(ctx context.Context, booking *model.Booking, now time.Time) (*model.Booking, error) {
+	booking.CreatedAt = now
+	booking.UpdatedAt = now
+	booking.Version++
+
+	var converted *model.Booking
+	err := r.inTransaction(ctx, func(tx *sql.Tx) error {
+		hold, err := r.findOne(ctx, tx, "id = ?", booking.ID.Hex())
+		if err != nil || hold == nil || hold.Status != model.BookingStatusHeld || hold.HoldExpired(now) {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM bookings WHERE id = ?", booking.ID.Hex()); err != nil {
+			return errors.Wrap(err, "failed to convert hold")
+		}
+		if err := r.insert(ctx, tx, booking); err != nil {
+			return err
+		}
+		converted = booking
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converted, nil
+}
+
+// DeleteExpiredHolds removes up to limit holds that lapsed before a cutoff,
+// returning the deleted ones
+func (r *SQLiteBookingRepository) DeleteExpiredHolds(ctx context.Context, expiredBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var where conditions
+	where.add("status = ?", model.BookingStatusHeld)
+
+	keep := func(booking *model.Booking) bool { return booking.HoldExpired(expiredBefore) }
+
+	var holds []*model.Booking
+	err := r.inTransaction(ctx, func(tx *sql.Tx) error {
+		var err error
+		holds, err = r.find(ctx, tx, where, "start_time", keep, limit)
+		if err != nil {
+			return errors.Wrap(err, "failed to find expired holds")
+		}
+		if len(holds) == 0 {
+			return nil
+		}
+
+		var ids conditions
+		whereIn(&ids, "id", holdIDs(holds))
+		if _, err := tx.ExecContext(ctx, "DELETE FROM bookings WHERE "+ids.sql(), ids.args...); err != nil {
+			return errors.Wrap(err, "failed to delete expired holds")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+// inTransaction runs fn in a transaction, committing it if fn succeeds
+func (r *SQLiteBookingRepository) inTransaction(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to start transaction")
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// find retrieves up to limit bookings matching where that keep accepts, in
+// orderBy order, leaving out soft-deleted ones. A nil keep accepts every
+// booking, and a limit of 0 means no limit.
+func (r *SQLiteBookingRepository) find(ctx context.Context, q sqliteQuerier, where conditions, orderBy string, keep func(*model.Booking) bool, limit int64) ([]*model.Booking, error) {
+	where.add("deleted_at IS NULL")
+	return r.scan(ctx, q, where, orderBy, keep, limit)
+}
+
+// scan is find including soft-deleted bookings
+func (r *SQLiteBookingRepository) scan(ctx context.Context, q sqliteQuerier, where conditions, orderBy string, keep func(*model.Booking) bool, limit int64) ([]*model.Booking, error) {
+	query := "SELECT doc FROM bookings"
+	if len(where.clauses) > 0 {
+		query += " WHERE " + where.sql()
+	}
+	if orderBy != "" {
+		query += " ORDER BY " + orderBy
+	}
+
+	rows, err := q.QueryContext(ctx, query, where.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var bookings []*model.Booking
+	for rows.Next() {
+		var doc []byte
+		if err := rows.Scan(&doc); err != nil {
+			return nil, err
+		}
+
+		var booking model.Booking
+		if err := bson.Unmarshal(doc, &booking); err != nil {
+			return nil, errors.Wrap(err, "failed to decode booking")
+		}
+		if keep != nil && !keep(&booking) {
+			continue
+		}
+
+		bookings = append(bookings, &booking)
+		if limit > 0 && int64(len(bookings)) == limit {
+			break
+		}
+	}
+
+	return bookings, rows.Err()
+}
+
+// findOne retrieves the first booking matching a condition, or nil if there's none
+func (r *SQLiteBookingRepository) findOne(ctx context.Context, q sqliteQuerier, clause string, args ...interface{}) (*model.Booking, error) {
+	var where conditions
+	where.add(clause, args...)
+
+	bookings, err := r.find(ctx, q, where, "", nil, 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if len(bookings) == 0 {
+		return nil, nil // No booking found
+	}
+
+	return bookings[0], nil
+}
+
+// modify applies change to the stored document of the booking with the ID,
+// if match accepts the booking, and returns the booking as changed. It
+// returns nil if there's no such booking or match turns it down.
+func (r *SQLiteBookingRepository) modify(ctx context.Context, q sqliteQuerier, id primitive.ObjectID, match func(*model.Booking) bool, change func(booking *model.Booking, doc bson.M)) (*model.Booking, error) {
+	booking, err := r.findOne(ctx, q, "id = ?", id.Hex())
+	if err != nil || booking == nil || !match(booking) {
+		return nil, err
+	}
+
+	raw, err := bson.Marshal(booking)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode booking")
+	}
+	var doc bson.M
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, errors.Wrap(err, "failed to decode booking")
+	}
+
+	change(booking, doc)
+
+	if raw, err = bson.Marshal(doc); err != nil {
+		return nil, errors.Wrap(err, "failed to encode booking")
+	}
+	var changed model.Booking
+	if err := bson.Unmarshal(raw, &changed); err != nil {
+		return nil, errors.Wrap(err, "failed to decode booking")
+	}
+
+	if _, err := q.ExecContext(ctx, "DELETE FROM bookings WHERE id = ?", id.Hex()); err != nil {
+		return nil, err
+	}
+	if err := r.insert(ctx, q, &changed); err != nil {
+		return nil, err
+	}
+
+	return &changed, nil
+}
+
+// insert stores a booking, returning ErrExternalRefExists or
+// ErrIdempotencyKeyUsed if another booking already has its external
+// reference or, for its customer, its idempotency key
+func (r *SQLiteBookingRepository) insert(ctx context.Context, q sqliteQuerier, booking *model.Booking) error {
+	doc, err := bson.Marshal(booking)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode booking")
+	}
+
+	_, err = q.ExecContext(ctx, `INSERT INTO bookings
+		(id, user_id, barber_id, status, start_time, end_time, created_at, updated_at, external_ref, idempotency_key, service_type, deleted_at, doc)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		booking.ID.Hex(), booking.UserID, booking.BarberID, booking.Status,
+		booking.StartTime.UnixMilli(), booking.EndTime.UnixMilli(),
+		booking.CreatedAt.UnixMilli(), booking.UpdatedAt.UnixMilli(),
+		nullIfEmpty(booking.ExternalRef), nullIfEmpty(booking.IdempotencyKey),
+		booking.ServiceType, nullableMillis(booking.DeletedAt), doc,
+	)
+	if err != nil {
+		var sqliteErr sqlite3.Error
+		if errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+			switch {
+			case strings.Contains(sqliteErr.Error(), "bookings.idempotency_key"):
+				return ErrIdempotencyKeyUsed
+			case strings.Contains(sqliteErr.Error(), "bookings.external_ref"):
+				return ErrExternalRefExists
+			}
+		}
+		return errors.Wrap(err, "failed to insert booking")
+	}
+
+	return nil
+}
+
+// conditions builds a SQL WHERE clause from ANDed conditions
+type conditions struct {
+	clauses []string
+	args    []interface{}
+}
+
+// add ANDs a condition with its arguments
+func (c *conditions) add(clause string, args ...interface{}) {
+	c.clauses = append(c.clauses, clause)
+	c.args = append(c.args, args...)
+}
+
+// whereIn ANDs a condition that the column holds one of the values
+func whereIn[T any](c *conditions, column string, values []T) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+	c.clauses = append(c.clauses, column+" IN ("+placeholders+")")
+	for _, value := range values {
+		c.args = append(c.args, value)
+	}
+}
+
+// sql returns the WHERE clause without the keyword
+func (c *conditions) sql() string {
+	return strings.Join(c.clauses, " AND ")
+}
+
+// includesService reports whether a booking includes any of the services
+func includesService(booking *model.Booking, serviceTypes []model.ServiceType) bool {
+	for _, serviceType := range booking.Services() {
+		if slices.Contains(serviceTypes, serviceType) {
+			return true
+		}
+	}
+	return false
+}
+
+// setPath sets a field of a document, creating the embedded documents a
+// dotted path like "deposit.status" goes through
+func setPath(doc bson.M, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := doc[key].(bson.M)
+		if !ok {
+			next = bson.M{}
+			doc[key] = next
+		}
+		doc = next
+	}
+	doc[keys[len(keys)-1]] = value
+}
+
+// unsetPath removes a field of a document, following a dotted path
+func unsetPath(doc bson.M, path string) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := doc[key].(bson.M)
+		if !ok {
+			return
+		}
+		doc = next
+	}
+	delete(doc, keys[len(keys)-1])
+}
+
+// holdIDs returns the IDs of the holds
+func holdIDs(holds []*model.Booking) []string {
+	ids := make([]string, len(holds))
+	for i, hold := range holds {
+		ids[i] = hold.ID.Hex()
+	}
+	return ids
+}
+
+// nullIfEmpty stores empty strings as NULL, which unique constraints ignore
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// nullableMillis returns a time as Unix milliseconds, or nil for SQL NULL if
+// it's unset
+func nullableMillis(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.UnixMilli()
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// SurveyRepository defines the interface for satisfaction survey storage
+type SurveyRepository interface {
+	CreateSurvey(ctx context.Context, survey *model.Survey) (*model.Survey, error)
+	GetSurveyByBookingID(ctx context.Context, bookingID string) (*model.Survey, error)
+	RecordSurveyResponse(ctx context.Context, bookingID, token string, score int, comment string) (bool, error)
+	GetBarberSurveyScores(ctx context.Context, barberID string, start, end *time.Time) (*model.SurveyScores, error)
+	GetUserSurveys(ctx context.Context, userID string) ([]*model.Survey, error)
+	AnonymizeUserSurveys(ctx context.Context, userID string) (int64, error)
+	DeleteUserSurveys(ctx context.Context, userID string) (int64, error)
+}
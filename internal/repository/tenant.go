@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// Every tenant has a database of its own, named after the configured one,
+// so that keys like a holiday's date or a service type's number can't
+// collide between shops and no query can forget to filter by shop. The
+// default tenant keeps the configured database itself.
+
+// TenantDatabaseName returns the name of a tenant's database
+func TenantDatabaseName(base, tenantID string) string {
+	if tenantID == tenant.Default {
+		return base
+	}
+	return base + "_" + tenantID
+}
+
+// tenantCollection returns coll as it is in the database of the tenant ctx
+// is scoped to
+func tenantCollection(ctx context.Context, coll *mongo.Collection) *mongo.Collection {
+	tenantID := tenant.FromContext(ctx)
+	if tenantID == tenant.Default {
+		return coll
+	}
+	db := coll.Database()
+	return db.Client().Database(TenantDatabaseName(db.Name(), tenantID)).Collection(coll.Name())
+}
+
+// TenantDirectory lists the tenants that have data
+type TenantDirectory struct {
+	db *mongo.Database
+
+	mu    sync.Mutex
+	known map[string]bool
+}
+
+// NewTenantDirectory creates a directory of the tenants stored alongside db,
+// the default tenant's database
+func NewTenantDirectory(db *mongo.Database) *TenantDirectory {
+	return &TenantDirectory{db: db, known: make(map[string]bool)}
+}
+
+// Known reports whether a tenant has a database. Tenants found are
+// remembered, as their databases aren't dropped while the service runs.
+func (d *TenantDirectory) Known(ctx context.Context, tenantID string) (bool, error) {
+	if tenantID == tenant.Default {
+		return true, nil
+	}
+
+	d.mu.Lock()
+	known := d.known[tenantID]
+	d.mu.Unlock()
+	if known {
+		return true, nil
+	}
+
+	names, err := d.db.Client().ListDatabaseNames(ctx, bson.M{"name": TenantDatabaseName(d.db.Name(), tenantID)})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to look up tenant database")
+	}
+	if len(names) == 0 {
+		return false, nil
+	}
+
+	d.mu.Lock()
+	d.known[tenantID] = true
+	d.mu.Unlock()
+	return true, nil
+}
+
+// ListTenants returns the default tenant followed by every tenant with a
+// database, in order
+func (d *TenantDirectory) ListTenants(ctx context.Context) ([]string, error) {
+	prefix := d.db.Name() + "_"
+	names, err := d.db.Client().ListDatabaseNames(ctx, bson.M{
+		"name": bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list tenant databases")
+	}
+
+	tenants := []string{tenant.Default}
+	for _, name := range names {
+		if id := name[len(prefix):]; tenant.Valid(id) {
+			tenants = append(tenants, id)
+		}
+	}
+	sort.Strings(tenants[1:])
+	return tenants, nil
+}
+
+// TenantIndexes creates a tenant's indexes the first time it's seen, since
+// a tenant's database springs into being with its first write
+type TenantIndexes struct {
+	ensure []func(ctx context.Context) error
+
+	mu      sync.Mutex
+	tenants map[string]*tenantIndexState
+}
+
+// tenantIndexState tracks one tenant's indexes. Its lock is held while they
+// are created, so concurrent first requests wait rather than race.
+type tenantIndexState struct {
+	mu   sync.Mutex
+	done bool
+}
+
+// NewTenantIndexes creates a TenantIndexes running each repository's
+// EnsureIndexes
+func NewTenantIndexes(ensure ...func(ctx context.Context) error) *TenantIndexes {
+	return &TenantIndexes{ensure: ensure, tenants: make(map[string]*tenantIndexState)}
+}
+
+// Prepare creates the indexes of the tenant ctx is scoped to, unless they
+// already were. A failure is retried on the next call.
+func (t *TenantIndexes) Prepare(ctx context.Context) error {
+	tenantID := tenant.FromContext(ctx)
+
+	t.mu.Lock()
+	state, ok := t.tenants[tenantID]
+	if !ok {
+		state = &tenantIndexState{}
+		t.tenants[tenantID] = state
+	}
+	t.mu.Unlock()
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.done {
+		return nil
+	}
+	for _, ensure := range t.ensure {
+		if err := ensure(ctx); err != nil {
+			return errors.Wrapf(err, "failed to prepare tenant %q", tenantID)
+		}
+	}
+	state.done = true
+	return nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// WebhookRepository defines the interface for webhook registration storage
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]*model.Webhook, error)
+	ListWebhooksForEvent(ctx context.Context, eventType string) ([]*model.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) (bool, error)
+}
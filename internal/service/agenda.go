@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+)
+
+// DailyAgendaInterval is how often agendas that are due are looked for. A
+// barber's agenda is sent by the run falling in the interval after their
+// agenda time, so the job must run exactly this often.
+const DailyAgendaInterval = time.Hour
+
+// GetDailyAgenda returns a barber's bookings on a day, taken in the barber's
+// time zone, in the order they start, with the free time left in their
+// working hours. A zero day means today.
+func (s *BookingService) GetDailyAgenda(ctx context.Context, barberID string, day time.Time) (*model.DailyAgenda, error) {
+	schedule, err := s.GetWorkingHours(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := schedule.Location()
+	if day.IsZero() {
+		day = s.clock.Now().In(loc)
+	}
+	return s.dailyAgenda(ctx, schedule, time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc))
+}
+
+// dailyAgenda compiles a barber's agenda for the day starting at midnight
+func (s *BookingService) dailyAgenda(ctx context.Context, schedule *model.BarberSchedule, midnight time.Time) (*model.DailyAgenda, error) {
+	bookings, err := s.repo.GetBookingsInTimeRange(ctx, schedule.BarberID, midnight, midnight.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get barber bookings")
+	}
+
+	agenda := &model.DailyAgenda{
+		BarberID: schedule.BarberID,
+		Date:     midnight,
+		Bookings: []*model.Booking{},
+		Gaps:     []*model.AgendaGap{},
+	}
+	for _, booking := range bookings {
+		// Holds aren't bookings yet
+		if slices.Contains(model.BookedStatuses, booking.Status) {
+			agenda.Bookings = append(agenda.Bookings, booking)
+		}
+	}
+	sort.Slice(agenda.Bookings, func(i, j int) bool {
+		return agenda.Bookings[i].StartTime.Before(agenda.Bookings[j].StartTime)
+	})
+
+	// Nobody works on shop holidays
+	closed, err := s.isHoliday(ctx, midnight)
+	if err != nil {
+		return nil, err
+	}
+	if !closed {
+		agenda.Gaps = append(agenda.Gaps, schedule.FreeTimeOn(midnight, agenda.Bookings)...)
+	}
+
+	return agenda, nil
+}
+
+// SendDailyAgendas sends active barbers whose agenda time has just passed,
+// in their own time zone, their agenda for the next day, and returns how many
+// were sent. Barbers with nothing booked that day aren't sent one.
+func (s *BookingService) SendDailyAgendas(ctx context.Context) (int, error) {
+	if !s.dailyAgendas || s.notifier == nil || s.barberRepo == nil {
+		return 0, nil
+	}
+
+	barbers, err := s.barberRepo.ListBarbers(ctx, true)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list barbers")
+	}
+
+	sent := 0
+	for _, barber := range barbers {
+		schedule, err := s.GetWorkingHours(ctx, barber.ID)
+		if err != nil {
+			return sent, err
+		}
+
+		now := s.clock.Now().In(schedule.Location())
+		midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		sendAt := time.Date(now.Year(), now.Month(), now.Day(), 0, s.agendaMinute, 0, 0, now.Location())
+		if now.Before(sendAt) || !now.Before(sendAt.Add(DailyAgendaInterval)) {
+			continue
+		}
+
+		agenda, err := s.dailyAgenda(ctx, schedule, midnight.AddDate(0, 0, 1))
+		if err != nil {
+			return sent, err
+		}
+		if len(agenda.Bookings) == 0 {
+			continue
+		}
+
+		err = s.notifier.Notify(ctx, notification.Message{
+			Kind:     notification.KindDailyAgenda,
+			UserID:   barber.ID,
+			Subject:  fmt.Sprintf("Your agenda for %s", agenda.Date.Format("Mon 2 Jan")),
+			Body:     describeAgenda(agenda),
+			Location: agenda.Date.Location(),
+		})
+		if err != nil {
+			log.Error().Err(err).Str("barberID", barber.ID).Msg("Failed to send daily agenda")
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		log.Info().Int("count", sent).Msg("Daily agendas sent")
+	}
+
+	return sent, nil
+}
+
+// describeAgenda lists an agenda's bookings and gaps in the order they come
+func describeAgenda(agenda *model.DailyAgenda) string {
+	type entry struct {
+		start time.Time
+		text  string
+	}
+
+	loc := agenda.Date.Location()
+	entries := make([]entry, 0, len(agenda.Bookings)+len(agenda.Gaps))
+	for _, booking := range agenda.Bookings {
+		text := fmt.Sprintf("%s-%s %s", booking.StartTime.In(loc).Format("15:04"), booking.EndTime.In(loc).Format("15:04"),
+			model.DescribeServices(booking.Services()))
+		if booking.Notes != "" {
+			text += "\n  Notes: " + booking.Notes
+		}
+		entries = append(entries, entry{start: booking.StartTime, text: text})
+	}
+	for _, gap := range agenda.Gaps {
+		entries = append(entries, entry{
+			start: gap.Start,
+			text:  fmt.Sprintf("%s-%s Free", gap.Start.In(loc).Format("15:04"), gap.End.In(loc).Format("15:04")),
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].start.Before(entries[j].start)
+	})
+
+	lines := make([]string, len(entries))
+	for i, e := range entries {
+		lines[i] = e.text
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+)
+
+func TestGetDailyAgenda(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2025, time.June, day, hour, minute, 0, 0, loc)
+	}
+	book := func(start time.Time, status model.BookingStatus, notes string) *model.Booking {
+		return &model.Booking{
+			ID:          primitive.NewObjectID(),
+			UserID:      "user1",
+			BarberID:    "barber1",
+			StartTime:   start,
+			EndTime:     start.Add(30 * time.Minute),
+			ServiceType: model.ServiceTypeHaircut,
+			Status:      status,
+			Notes:       notes,
+		}
+	}
+	late := book(at(2, 15, 0), model.BookingStatusPending, "")
+	early := book(at(2, 9, 30), model.BookingStatusConfirmed, "Short on the sides")
+	repo := &fakeBookingRepo{bookings: []*model.Booking{
+		late,
+		early,
+		book(at(2, 11, 0), model.BookingStatusHeld, ""),
+		book(at(2, 12, 0), model.BookingStatusCancelled, ""),
+		book(at(3, 10, 0), model.BookingStatusConfirmed, ""),
+	}}
+	schedule := model.DailySchedule("barber1", 9*60, 17*60)
+	schedule.Timezone = "Europe/Berlin"
+	s := NewBookingService(repo,
+		WithClock(clockAt(at(2, 8, 0))),
+		WithScheduleRepository(&fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{"barber1": schedule}}),
+	)
+
+	// Today by default, leaving out holds and cancelled bookings
+	agenda, err := s.GetDailyAgenda(context.Background(), "barber1", time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, at(2, 0, 0), agenda.Date)
+	assert.Equal(t, []*model.Booking{early, late}, agenda.Bookings)
+	assert.Equal(t, []*model.AgendaGap{
+		{Start: at(2, 9, 0), End: at(2, 9, 30)},
+		{Start: at(2, 10, 0), End: at(2, 15, 0)},
+		{Start: at(2, 15, 30), End: at(2, 17, 0)},
+	}, agenda.Gaps)
+}
+
+func TestSendDailyAgendas(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	tomorrow := time.Date(2025, time.June, 3, 10, 0, 0, 0, loc)
+	repo := &fakeBookingRepo{bookings: []*model.Booking{{
+		ID:          primitive.NewObjectID(),
+		UserID:      "user1",
+		BarberID:    "barber1",
+		StartTime:   tomorrow,
+		EndTime:     tomorrow.Add(30 * time.Minute),
+		ServiceType: model.ServiceTypeHaircut,
+		Status:      model.BookingStatusConfirmed,
+		Notes:       "Bringing a photo",
+	}}}
+	schedule := model.DailySchedule("barber1", 9*60, 17*60)
+	schedule.Timezone = "Europe/Berlin"
+	barbers := &fakeBarberRepo{barbers: map[string]*model.Barber{
+		"barber1": {ID: "barber1", Active: true},
+		// Nothing booked, so no agenda
+		"barber2": {ID: "barber2", Active: true},
+	}}
+
+	send := func(now time.Time) *fakeNotifier {
+		notifier := &fakeNotifier{}
+		s := NewBookingService(repo,
+			WithNotifier(notifier),
+			WithDailyAgendas(18*60),
+			WithClock(clockAt(now)),
+			WithBarberRepository(barbers),
+			WithScheduleRepository(&fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{"barber1": schedule}}),
+		)
+		_, err := s.SendDailyAgendas(context.Background())
+		require.NoError(t, err)
+		return notifier
+	}
+
+	// Sent by the run in the hour after 18:00 in the barber's time zone
+	notifier := send(time.Date(2025, time.June, 2, 18, 20, 0, 0, loc))
+	require.Len(t, notifier.messages, 1)
+	msg := notifier.messages[0]
+	assert.Equal(t, notification.KindDailyAgenda, msg.Kind)
+	assert.Equal(t, "barber1", msg.UserID)
+	assert.Equal(t, "Your agenda for Tue 3 Jun", msg.Subject)
+	assert.Equal(t, "09:00-10:00 Free\n10:00-10:30 haircut\n  Notes: Bringing a photo\n10:30-17:00 Free", msg.Body)
+
+	assert.Empty(t, send(time.Date(2025, time.June, 2, 17, 50, 0, 0, loc)).messages)
+	assert.Empty(t, send(time.Date(2025, time.June, 2, 19, 0, 0, 0, loc)).messages)
+}
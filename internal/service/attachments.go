@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// maxAttachmentsPerBooking limits how many reference images a booking can carry
+const maxAttachmentsPerBooking = 5
+
+// uploadURLExpiry is how long a pre-signed upload URL stays valid
+const uploadURLExpiry = 15 * time.Minute
+
+// downloadURLExpiry is how long a signed download URL for an uploaded file
+// stays valid
+const downloadURLExpiry = time.Hour
+
+// allowedAttachmentTypes maps accepted image content types to file extensions
+var allowedAttachmentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/heic": ".heic",
+}
+
+// AttachmentParams describes a reference image for a booking: either an
+// external URL, or the content type and size of a file the client will upload
+type AttachmentParams struct {
+	URL         string
+	ContentType string
+	Size        int64
+}
+
+// AddAttachment attaches a reference image to a booking. For uploads it
+// returns a pre-signed URL the client must PUT the file to.
+func (s *BookingService) AddAttachment(ctx context.Context, bookingID string, params AttachmentParams) (*model.Attachment, string, error) {
+	booking, err := s.repo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to get booking")
+	}
+
+	if booking == nil {
+		return nil, "", ErrBookingNotFound
+	}
+
+	if booking.Status == model.BookingStatusCancelled {
+		return nil, "", ErrBookingCancelled
+	}
+
+	if len(booking.Attachments) >= maxAttachmentsPerBooking {
+		return nil, "", ErrTooManyAttachments
+	}
+
+	attachment := &model.Attachment{
+		ID:        primitive.NewObjectID().Hex(),
+		CreatedAt: s.clock.Now(),
+	}
+
+	var uploadURL string
+	if params.URL != "" {
+		u, err := url.Parse(params.URL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, "", errors.Wrap(ErrInvalidAttachment, "url must be an absolute http(s) URL")
+		}
+		attachment.URL = params.URL
+	} else {
+		if s.attachmentStore == nil {
+			return nil, "", errors.Wrap(ErrInvalidAttachment, "uploads are not enabled, provide a url instead")
+		}
+
+		extension, ok := allowedAttachmentTypes[params.ContentType]
+		if !ok {
+			return nil, "", errors.Wrapf(ErrInvalidAttachment, "unsupported content type %q", params.ContentType)
+		}
+
+		if params.Size <= 0 || params.Size > s.maxAttachmentSize {
+			return nil, "", errors.Wrapf(ErrInvalidAttachment, "size must be between 1 and %d bytes", s.maxAttachmentSize)
+		}
+
+		key := fmt.Sprintf("bookings/%s/%s%s", booking.ID.Hex(), attachment.ID, extension)
+		uploadURL, err = s.attachmentStore.PresignUpload(ctx, key, params.ContentType, params.Size, uploadURLExpiry)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to create upload URL")
+		}
+
+		attachment.StorageKey = key
+		attachment.ContentType = params.ContentType
+		attachment.Size = params.Size
+	}
+
+	updatedBooking, err := s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+		return s.repo.AddAttachment(ctx, bookingID, attachment)
+	})
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to add attachment")
+	}
+
+	if updatedBooking == nil {
+		return nil, "", ErrBookingNotFound
+	}
+
+	log.Info().
+		Str("bookingID", bookingID).
+		Str("attachmentID", attachment.ID).
+		Bool("upload", uploadURL != "").
+		Msg("Attachment added to booking")
+
+	s.recordHistory(ctx, model.BookingActionAttachmentAdded, booking, updatedBooking)
+
+	return attachment, uploadURL, nil
+}
+
+// AttachmentURL returns the URL an attachment can be viewed at. Uploaded files
+// get a freshly signed URL, as their storage only serves signed downloads.
+func (s *BookingService) AttachmentURL(attachment *model.Attachment) string {
+	if attachment.StorageKey == "" || s.attachmentStore == nil {
+		return attachment.URL
+	}
+
+	return s.attachmentStore.DownloadURL(attachment.StorageKey, downloadURLExpiry)
+}
+
+// cleanupAttachments deletes uploaded files of a booking from storage and
+// drops them from the booking, keeping only external URLs
+func (s *BookingService) cleanupAttachments(ctx context.Context, booking *model.Booking) error {
+	if s.attachmentStore == nil {
+		return nil
+	}
+
+	var remaining []*model.Attachment
+	removed := 0
+	for _, attachment := range booking.Attachments {
+		if attachment.StorageKey == "" {
+			remaining = append(remaining, attachment)
+			continue
+		}
+
+		if err := s.attachmentStore.Delete(ctx, attachment.StorageKey); err != nil {
+			return errors.Wrap(err, "failed to delete attachment")
+		}
+		removed++
+	}
+
+	if removed == 0 {
+		return nil
+	}
+
+	if _, err := s.repo.UpdateBooking(ctx, booking.ID.Hex(), map[string]interface{}{"attachments": remaining}); err != nil {
+		return errors.Wrap(err, "failed to remove attachments from booking")
+	}
+
+	log.Info().
+		Str("bookingID", booking.ID.Hex()).
+		Int("removed", removed).
+		Msg("Booking attachments cleaned up")
+
+	return nil
+}
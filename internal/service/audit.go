@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// maxAuditEntries caps how many audit entries a single query returns
+const maxAuditEntries = 500
+
+// ListAuditLog retrieves audit entries matching a filter, newest first
+func (s *BookingService) ListAuditLog(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error) {
+	if s.auditRepo == nil {
+		return nil, errors.New("audit log is not configured")
+	}
+
+	if filter.Limit <= 0 || filter.Limit > maxAuditEntries {
+		filter.Limit = maxAuditEntries
+	}
+
+	entries, err := s.auditRepo.ListAuditEntries(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list audit entries")
+	}
+
+	return entries, nil
+}
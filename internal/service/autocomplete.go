@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// autoCompleteBatchSize caps how many bookings one sweep handles
+const autoCompleteBatchSize = 100
+
+// AutoCompleteBookings completes confirmed bookings that ended more than the
+// auto-complete delay ago, so reporting doesn't wait on barbers. When a
+// check-in is required, bookings without one are flagged for review
+// instead, for a barber to complete or mark as a no-show. It returns the
+// number of completed and flagged bookings.
+func (s *BookingService) AutoCompleteBookings(ctx context.Context) (completed, flagged int, err error) {
+	if s.autoCompleteAfter <= 0 {
+		return 0, 0, nil
+	}
+
+	bookings, err := s.repo.FindBookingsToAutoComplete(ctx, s.clock.Now().Add(-s.autoCompleteAfter), autoCompleteBatchSize)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to find bookings to complete")
+	}
+
+	for _, booking := range bookings {
+		if s.autoCompleteRequireCheckIn && booking.CheckedInAt == nil {
+			flaggedBooking, err := s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+				return s.repo.UpdateBookingAtVersion(ctx, booking.ID.Hex(), booking.Version, map[string]interface{}{
+					"reviewFlaggedAt": s.clock.Now(),
+				})
+			})
+			if err != nil {
+				return completed, flagged, errors.Wrap(err, "failed to flag booking for review")
+			}
+			if flaggedBooking == nil {
+				// Changed in the meantime; the next sweep looks again
+				continue
+			}
+			flagged++
+
+			log.Info().
+				Str("bookingID", booking.ID.Hex()).
+				Str("barberID", booking.BarberID).
+				Msg("Booking without check-in flagged for review")
+			continue
+		}
+
+		completedBooking, err := s.transitionStatus(ctx, booking, model.BookingStatusCompleted, nil)
+		if err != nil {
+			if errors.Is(err, ErrInvalidStatusTransition) {
+				continue
+			}
+			return completed, flagged, err
+		}
+		completed++
+
+		log.Info().
+			Str("bookingID", booking.ID.Hex()).
+			Str("barberID", booking.BarberID).
+			Msg("Booking completed automatically")
+
+		s.onBookingCompleted(ctx, completedBooking)
+	}
+
+	return completed, flagged, nil
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// autoCompleteRepo finds finished bookings and flags them in memory
+type autoCompleteRepo struct {
+	depositBookingRepo
+}
+
+func (r *autoCompleteRepo) FindBookingsToAutoComplete(ctx context.Context, endedBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		if b.Status == model.BookingStatusConfirmed && b.EndTime.Before(endedBefore) && b.ReviewFlaggedAt == nil {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func (r *autoCompleteRepo) UpdateBookingAtVersion(ctx context.Context, id string, version int64, updates map[string]interface{}) (*model.Booking, error) {
+	b, _ := r.GetBookingByID(ctx, id)
+	if b == nil || b.Version != version {
+		return nil, nil
+	}
+	flaggedAt := updates["reviewFlaggedAt"].(time.Time)
+	b.ReviewFlaggedAt = &flaggedAt
+	b.Version++
+	return b, nil
+}
+
+func TestAutoCompleteBookings(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 18, 0, 0, 0, time.UTC)
+	book := func(end time.Time, checkedIn bool) *model.Booking {
+		b := &model.Booking{ID: primitive.NewObjectID(), BarberID: "barber1", StartTime: end.Add(-30 * time.Minute), EndTime: end, Status: model.BookingStatusConfirmed}
+		if checkedIn {
+			arrived := b.StartTime
+			b.CheckedInAt = &arrived
+		}
+		return b
+	}
+
+	t.Run("completes finished bookings", func(t *testing.T) {
+		old := book(now.Add(-3*time.Hour), false)
+		recent := book(now.Add(-time.Hour), false)
+		repo := &autoCompleteRepo{}
+		repo.bookings = []*model.Booking{old, recent}
+		s := NewBookingService(repo, WithAutoCompletion(2*time.Hour, false), WithClock(clockAt(now)))
+
+		completed, flagged, err := s.AutoCompleteBookings(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, completed)
+		assert.Zero(t, flagged)
+		assert.Equal(t, model.BookingStatusCompleted, old.Status)
+		assert.Equal(t, model.BookingStatusConfirmed, recent.Status)
+	})
+
+	t.Run("flags bookings without a check-in", func(t *testing.T) {
+		arrived := book(now.Add(-3*time.Hour), true)
+		missing := book(now.Add(-3*time.Hour), false)
+		repo := &autoCompleteRepo{}
+		repo.bookings = []*model.Booking{arrived, missing}
+		s := NewBookingService(repo, WithAutoCompletion(2*time.Hour, true), WithClock(clockAt(now)))
+
+		completed, flagged, err := s.AutoCompleteBookings(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, completed)
+		assert.Equal(t, 1, flagged)
+		assert.Equal(t, model.BookingStatusCompleted, arrived.Status)
+		assert.Equal(t, model.BookingStatusConfirmed, missing.Status)
+		assert.Equal(t, now, *missing.ReviewFlaggedAt)
+
+		// Flagged bookings are left for a barber to decide on
+		completed, flagged, err = s.AutoCompleteBookings(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, completed+flagged)
+	})
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// GetBarberServices returns the services a barber offers with their durations
+// and prices, ordered by service type
+func (s *BookingService) GetBarberServices(ctx context.Context, barberID string) ([]*model.OfferedService, error) {
+	offered, err := s.offeredServices(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sortedServices(offered), nil
+}
+
+// SetBarberServices validates and replaces the services a barber offers. An
+// empty list makes them offer every service in the catalog again.
+func (s *BookingService) SetBarberServices(ctx context.Context, services model.BarberServices) ([]*model.OfferedService, error) {
+	if s.offerRepo == nil {
+		return nil, errors.New("barber services storage is not configured")
+	}
+
+	catalog, err := s.catalogServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make([]model.ServiceType, 0, len(services.Services))
+	for _, service := range services.Services {
+		if _, ok := catalog[service.ServiceType]; !ok {
+			return nil, errors.Wrapf(ErrInvalidBarberServices, "%s is not in the catalog", service.ServiceType)
+		}
+		if slices.Contains(seen, service.ServiceType) {
+			return nil, errors.Wrapf(ErrInvalidBarberServices, "%s is listed more than once", service.ServiceType)
+		}
+		seen = append(seen, service.ServiceType)
+
+		if service.DurationMinutes < 0 || service.DurationMinutes > maxServiceMinutes {
+			return nil, errors.Wrapf(ErrInvalidBarberServices, "duration must be at most %d minutes", maxServiceMinutes)
+		}
+		if service.Price < 0 {
+			return nil, errors.Wrap(ErrInvalidBarberServices, "price can't be negative")
+		}
+	}
+
+	if _, err := s.offerRepo.SaveBarberServices(ctx, &services); err != nil {
+		return nil, errors.Wrap(err, "failed to save barber services")
+	}
+
+	log.Info().
+		Str("barberId", services.BarberID).
+		Int("services", len(services.Services)).
+		Str("updatedBy", services.UpdatedBy).
+		Msg("Barber services updated")
+
+	return s.GetBarberServices(ctx, services.BarberID)
+}
+
+// offeredServices returns the services a barber offers keyed by type: the
+// bookable catalog services, narrowed to the barber's own list if they have one
+// and with their duration and price overrides applied
+func (s *BookingService) offeredServices(ctx context.Context, barberID string) (map[model.ServiceType]*model.OfferedService, error) {
+	catalog, err := s.catalogServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.offerRepo == nil {
+		return catalog, nil
+	}
+
+	barberServices, err := s.offerRepo.GetBarberServices(ctx, barberID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get barber services")
+	}
+	if barberServices == nil || len(barberServices.Services) == 0 {
+		return catalog, nil
+	}
+
+	offered := make(map[model.ServiceType]*model.OfferedService, len(barberServices.Services))
+	for _, service := range barberServices.Services {
+		base, ok := catalog[service.ServiceType]
+		if !ok {
+			// Retired from the catalog since the barber listed it
+			continue
+		}
+
+		own := *base
+		if service.DurationMinutes > 0 {
+			own.DurationMinutes = service.DurationMinutes
+		}
+		if service.Price > 0 {
+			own.Price = service.Price
+		}
+		offered[service.ServiceType] = &own
+	}
+
+	return offered, nil
+}
+
+// sortedServices returns the offered services ordered by service type
+func sortedServices(offered map[model.ServiceType]*model.OfferedService) []*model.OfferedService {
+	services := make([]*model.OfferedService, 0, len(offered))
+	for _, service := range offered {
+		services = append(services, service)
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].ServiceType < services[j].ServiceType
+	})
+	return services
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+type fakeBarberServicesRepo struct {
+	services map[string]*model.BarberServices
+}
+
+func (r *fakeBarberServicesRepo) GetBarberServices(ctx context.Context, barberID string) (*model.BarberServices, error) {
+	return r.services[barberID], nil
+}
+
+func (r *fakeBarberServicesRepo) SaveBarberServices(ctx context.Context, services *model.BarberServices) (*model.BarberServices, error) {
+	r.services[services.BarberID] = services
+	return services, nil
+}
+
+func TestBarberServices_OfferingsAndOverrides(t *testing.T) {
+	catalog := &fakeCatalogRepo{services: map[model.ServiceType]*model.CatalogService{
+		model.ServiceTypeHaircut: {ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Price: 2500, Active: true},
+	}}
+	offers := &fakeBarberServicesRepo{services: map[string]*model.BarberServices{}}
+	s := NewBookingService(&fakeBookingRepo{}, WithCatalogRepository(catalog), WithBarberServicesRepository(offers),
+		WithClock(clockAt(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC))))
+	ctx := context.Background()
+
+	// Barbers without a list offer everything
+	services, err := s.GetBarberServices(ctx, "barber2")
+	assert.NoError(t, err)
+	assert.Len(t, services, len(model.BuiltInServiceTypes))
+
+	services, err = s.SetBarberServices(ctx, model.BarberServices{
+		BarberID: "barber1",
+		Services: []model.BarberService{{ServiceType: model.ServiceTypeHaircut, DurationMinutes: 20}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.OfferedService{
+		{ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 20, Price: 2500},
+	}, services)
+
+	// The barber's own duration applies, and services they don't offer are refused
+	quote, err := s.quote(ctx, "barber1", []model.ServiceType{model.ServiceTypeHaircut})
+	assert.NoError(t, err)
+	assert.Equal(t, 20*time.Minute, quote.Duration())
+
+	_, err = s.quote(ctx, "barber1", []model.ServiceType{model.ServiceTypeBeardTrim})
+	assert.ErrorIs(t, err, ErrServiceNotOffered)
+
+	_, err = s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeBeardTrim},
+		StartTime:    time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC),
+	})
+	assert.ErrorIs(t, err, ErrServiceNotOffered)
+}
+
+func TestBarberServices_Validation(t *testing.T) {
+	s := NewBookingService(&fakeBookingRepo{}, WithBarberServicesRepository(&fakeBarberServicesRepo{services: map[string]*model.BarberServices{}}))
+	ctx := context.Background()
+
+	_, err := s.SetBarberServices(ctx, model.BarberServices{
+		BarberID: "barber1",
+		Services: []model.BarberService{{ServiceType: model.ServiceTypeHaircut}, {ServiceType: model.ServiceTypeHaircut}},
+	})
+	assert.ErrorIs(t, err, ErrInvalidBarberServices)
+
+	_, err = s.SetBarberServices(ctx, model.BarberServices{
+		BarberID: "barber1",
+		Services: []model.BarberService{{ServiceType: model.ServiceType(42)}},
+	})
+	assert.ErrorIs(t, err, ErrInvalidBarberServices)
+}
@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// Limits on barber profiles
+const (
+	maxBarberNameLength = 100
+	maxBarberBioLength  = 2000
+	maxBarberCapacity   = 20
+)
+
+// ListBarbers returns the barbers' profiles with the services they offer,
+// including inactive barbers if asked to
+func (s *BookingService) ListBarbers(ctx context.Context, includeInactive bool) ([]*model.Barber, error) {
+	if s.barberRepo == nil {
+		return nil, nil
+	}
+
+	barbers, err := s.barberRepo.ListBarbers(ctx, !includeInactive)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list barbers")
+	}
+
+	for _, barber := range barbers {
+		if err := s.fillBarberDetails(ctx, barber); err != nil {
+			return nil, err
+		}
+	}
+
+	return barbers, nil
+}
+
+// GetBarber returns a barber's profile with the services they offer
+func (s *BookingService) GetBarber(ctx context.Context, id string) (*model.Barber, error) {
+	if s.barberRepo == nil {
+		return nil, ErrBarberNotFound
+	}
+
+	barber, err := s.barberRepo.GetBarber(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get barber")
+	}
+	if barber == nil {
+		return nil, ErrBarberNotFound
+	}
+
+	if err := s.fillBarberDetails(ctx, barber); err != nil {
+		return nil, err
+	}
+	return barber, nil
+}
+
+// CreateBarber adds a barber's profile
+func (s *BookingService) CreateBarber(ctx context.Context, barber model.Barber) (*model.Barber, error) {
+	if s.barberRepo == nil {
+		return nil, errors.New("barber storage is not configured")
+	}
+	if err := validateBarber(barber); err != nil {
+		return nil, err
+	}
+
+	created, err := s.barberRepo.CreateBarber(ctx, &barber)
+	if err != nil {
+		if errors.Is(err, repository.ErrBarberExists) {
+			return nil, ErrBarberExists
+		}
+		return nil, errors.Wrap(err, "failed to create barber")
+	}
+
+	log.Info().
+		Str("barberId", created.ID).
+		Str("displayName", created.DisplayName).
+		Bool("active", created.Active).
+		Int("capacity", created.ClientCapacity()).
+		Msg("Barber created")
+
+	if err := s.fillBarberDetails(ctx, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateBarber replaces a barber's display name, bio, photo, active flag and
+// capacity. Deactivating a barber, or lowering their capacity, keeps their
+// existing bookings.
+func (s *BookingService) UpdateBarber(ctx context.Context, barber model.Barber) (*model.Barber, error) {
+	if s.barberRepo == nil {
+		return nil, errors.New("barber storage is not configured")
+	}
+	if err := validateBarber(barber); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.barberRepo.UpdateBarber(ctx, &barber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update barber")
+	}
+	if updated == nil {
+		return nil, ErrBarberNotFound
+	}
+
+	log.Info().
+		Str("barberId", updated.ID).
+		Str("displayName", updated.DisplayName).
+		Bool("active", updated.Active).
+		Int("capacity", updated.ClientCapacity()).
+		Msg("Barber updated")
+
+	if err := s.fillBarberDetails(ctx, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteBarber removes a barber's profile, reporting whether they had one.
+// Their schedule, services and bookings are kept.
+func (s *BookingService) DeleteBarber(ctx context.Context, id string) (bool, error) {
+	if s.barberRepo == nil {
+		return false, errors.New("barber storage is not configured")
+	}
+
+	deleted, err := s.barberRepo.DeleteBarber(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete barber")
+	}
+
+	return deleted, nil
+}
+
+// validateBarber checks a barber's profile before it's stored
+func validateBarber(barber model.Barber) error {
+	if barber.ID == "" {
+		return errors.Wrap(ErrInvalidBarber, "id is required")
+	}
+	if barber.DisplayName == "" {
+		return errors.Wrap(ErrInvalidBarber, "display name is required")
+	}
+	if utf8.RuneCountInString(barber.DisplayName) > maxBarberNameLength {
+		return errors.Wrapf(ErrInvalidBarber, "display name can be at most %d characters", maxBarberNameLength)
+	}
+	if utf8.RuneCountInString(barber.Bio) > maxBarberBioLength {
+		return errors.Wrapf(ErrInvalidBarber, "bio can be at most %d characters", maxBarberBioLength)
+	}
+	if barber.Capacity < 0 || barber.Capacity > maxBarberCapacity {
+		return errors.Wrapf(ErrInvalidBarber, "capacity must be between 1 and %d", maxBarberCapacity)
+	}
+	if barber.PhotoURL != "" {
+		photo, err := url.Parse(barber.PhotoURL)
+		if err != nil || (photo.Scheme != "https" && photo.Scheme != "http") || photo.Host == "" {
+			return errors.Wrap(ErrInvalidBarber, "photo URL must be an absolute http(s) URL")
+		}
+	}
+	return nil
+}
+
+// fillBarberDetails sets the services a barber offers and, with reviews
+// configured, their rating on their profile
+func (s *BookingService) fillBarberDetails(ctx context.Context, barber *model.Barber) error {
+	services, err := s.GetBarberServices(ctx, barber.ID)
+	if err != nil {
+		return err
+	}
+	barber.Services = services
+
+	if s.reviewRepo != nil {
+		rating, err := s.reviewRepo.GetBarberRating(ctx, barber.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get barber rating")
+		}
+		barber.Rating = rating
+	}
+	return nil
+}
+
+// checkBarberActive rejects new booking times with barbers whose profile
+// marks them inactive. Barbers without a profile can be booked.
+func (s *BookingService) checkBarberActive(ctx context.Context, barberID string) error {
+	active, err := s.barberActive(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if !active {
+		return ErrBarberInactive
+	}
+	return nil
+}
+
+// barberCapacity returns how many overlapping bookings a barber can take.
+// Barbers without a profile take one at a time.
+func (s *BookingService) barberCapacity(ctx context.Context, barberID string) (int, error) {
+	if s.barberRepo == nil {
+		return 1, nil
+	}
+
+	barber, err := s.barberRepo.GetBarber(ctx, barberID)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get barber")
+	}
+	if barber == nil {
+		return 1, nil
+	}
+	return barber.ClientCapacity(), nil
+}
+
+// barberActive reports whether a barber is taking bookings
+func (s *BookingService) barberActive(ctx context.Context, barberID string) (bool, error) {
+	if s.barberRepo == nil {
+		return true, nil
+	}
+
+	barber, err := s.barberRepo.GetBarber(ctx, barberID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get barber")
+	}
+	return barber == nil || barber.Active, nil
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+type fakeBarberRepo struct {
+	barbers map[string]*model.Barber
+}
+
+func (r *fakeBarberRepo) ListBarbers(ctx context.Context, activeOnly bool) ([]*model.Barber, error) {
+	var barbers []*model.Barber
+	for _, barber := range r.barbers {
+		if activeOnly && !barber.Active {
+			continue
+		}
+		barbers = append(barbers, barber)
+	}
+	return barbers, nil
+}
+
+func (r *fakeBarberRepo) GetBarber(ctx context.Context, id string) (*model.Barber, error) {
+	return r.barbers[id], nil
+}
+
+func (r *fakeBarberRepo) CreateBarber(ctx context.Context, barber *model.Barber) (*model.Barber, error) {
+	if _, ok := r.barbers[barber.ID]; ok {
+		return nil, repository.ErrBarberExists
+	}
+	r.barbers[barber.ID] = barber
+	return barber, nil
+}
+
+func (r *fakeBarberRepo) UpdateBarber(ctx context.Context, barber *model.Barber) (*model.Barber, error) {
+	if _, ok := r.barbers[barber.ID]; !ok {
+		return nil, nil
+	}
+	r.barbers[barber.ID] = barber
+	return barber, nil
+}
+
+func (r *fakeBarberRepo) DeleteBarber(ctx context.Context, id string) (bool, error) {
+	_, ok := r.barbers[id]
+	delete(r.barbers, id)
+	return ok, nil
+}
+
+func TestBarbers_CRUD(t *testing.T) {
+	s := NewBookingService(&fakeBookingRepo{}, WithBarberRepository(&fakeBarberRepo{barbers: map[string]*model.Barber{}}))
+	ctx := context.Background()
+
+	created, err := s.CreateBarber(ctx, model.Barber{ID: "barber1", DisplayName: "Sam", PhotoURL: "https://cdn.example.com/sam.jpg", Active: true})
+	require.NoError(t, err)
+	// Barbers without their own list offer every built-in service
+	assert.Len(t, created.Services, len(model.BuiltInServiceTypes))
+
+	_, err = s.CreateBarber(ctx, model.Barber{ID: "barber1", DisplayName: "Sam"})
+	assert.ErrorIs(t, err, ErrBarberExists)
+
+	_, err = s.CreateBarber(ctx, model.Barber{ID: "barber2", DisplayName: "Alex", Active: false})
+	require.NoError(t, err)
+
+	barbers, err := s.ListBarbers(ctx, false)
+	require.NoError(t, err)
+	if assert.Len(t, barbers, 1) {
+		assert.Equal(t, "barber1", barbers[0].ID)
+	}
+	barbers, err = s.ListBarbers(ctx, true)
+	require.NoError(t, err)
+	assert.Len(t, barbers, 2)
+
+	updated, err := s.UpdateBarber(ctx, model.Barber{ID: "barber1", DisplayName: "Sam", Bio: "Fades and beards", Active: true})
+	require.NoError(t, err)
+	assert.Equal(t, "Fades and beards", updated.Bio)
+
+	_, err = s.UpdateBarber(ctx, model.Barber{ID: "barber3", DisplayName: "Jo"})
+	assert.ErrorIs(t, err, ErrBarberNotFound)
+
+	deleted, err := s.DeleteBarber(ctx, "barber2")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	_, err = s.GetBarber(ctx, "barber2")
+	assert.ErrorIs(t, err, ErrBarberNotFound)
+}
+
+func TestBarbers_Validation(t *testing.T) {
+	s := NewBookingService(&fakeBookingRepo{}, WithBarberRepository(&fakeBarberRepo{barbers: map[string]*model.Barber{}}))
+	ctx := context.Background()
+
+	for _, barber := range []model.Barber{
+		{DisplayName: "Sam"},
+		{ID: "barber1"},
+		{ID: "barber1", DisplayName: "Sam", PhotoURL: "javascript:alert(1)"},
+		{ID: "barber1", DisplayName: "Sam", PhotoURL: "/photos/sam.jpg"},
+		{ID: "barber1", DisplayName: "Sam", Capacity: -1},
+		{ID: "barber1", DisplayName: "Sam", Capacity: 21},
+	} {
+		_, err := s.CreateBarber(ctx, barber)
+		assert.ErrorIs(t, err, ErrInvalidBarber, "%+v", barber)
+	}
+}
+
+func TestBarbers_InactiveBarberCannotBeBooked(t *testing.T) {
+	barbers := &fakeBarberRepo{barbers: map[string]*model.Barber{
+		"barber1": {ID: "barber1", DisplayName: "Sam", Active: false},
+	}}
+	now := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	s := NewBookingService(&fakeBookingRepo{}, WithBarberRepository(barbers), WithClock(clockAt(now)))
+	ctx := context.Background()
+	day := time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+	_, err := s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		StartTime:    day.Add(10 * time.Hour),
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+	})
+	assert.ErrorIs(t, err, ErrBarberInactive)
+
+	slots, err := s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, slots)
+
+	// Barbers without a profile, and active ones, have slots as before
+	slots, err = s.GetAvailableTimeSlots(ctx, "barber2", day, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, slots)
+
+	barbers.barbers["barber1"].Active = true
+	slots, err = s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, slots)
+}
+
+func TestBarbers_Capacity(t *testing.T) {
+	barbers := &fakeBarberRepo{barbers: map[string]*model.Barber{
+		"barber1": {ID: "barber1", DisplayName: "Two chairs", Active: true, Capacity: 2},
+	}}
+	now := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	day := time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC)
+	ten := day.Add(10 * time.Hour)
+	booked := func() *model.Booking {
+		return &model.Booking{
+			ID:          primitive.NewObjectID(),
+			BarberID:    "barber1",
+			StartTime:   ten,
+			EndTime:     ten.Add(30 * time.Minute),
+			ServiceType: model.ServiceTypeHaircut,
+			Status:      model.BookingStatusConfirmed,
+		}
+	}
+	repo := &creatingBookingRepo{fakeBookingRepo: fakeBookingRepo{bookings: []*model.Booking{booked()}}}
+	s := NewBookingService(repo, WithBarberRepository(barbers), WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	slotsAt := func() []time.Time {
+		slots, err := s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+		require.NoError(t, err)
+		var starts []time.Time
+		for _, slot := range slots {
+			starts = append(starts, slot.StartTime)
+		}
+		return starts
+	}
+
+	// A second client fits alongside the first
+	assert.Contains(t, slotsAt(), ten)
+	_, err := s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user2",
+		BarberID:     "barber1",
+		StartTime:    ten,
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, repo.capacity)
+
+	// A third doesn't
+	repo.bookings = []*model.Booking{booked(), booked()}
+	assert.NotContains(t, slotsAt(), ten)
+	_, err = s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user3",
+		BarberID:     "barber1",
+		StartTime:    ten,
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+	})
+	assert.ErrorIs(t, err, ErrSlotUnavailable)
+
+	// With a single chair, the first booking already fills the slot
+	barbers.barbers["barber1"].Capacity = 0
+	repo.bookings = []*model.Booking{booked()}
+	assert.NotContains(t, slotsAt(), ten)
+}
@@ -2,69 +2,622 @@ package service
 
 import (
 	"context"
+	"slices"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
+	"github.com/ita-av/booking-service/internal/calendar"
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/featureflag"
 	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+	"github.com/ita-av/booking-service/internal/payment"
 	"github.com/ita-av/booking-service/internal/repository"
+	"github.com/ita-av/booking-service/internal/storage"
 )
 
+// nextSlotSearchDays is how far ahead GetNextAvailableSlot looks when there's
+// no advance-booking window
+const nextSlotSearchDays = 60
+
+// maxNextSlots caps how many slots GetNextAvailableSlot returns
+const maxNextSlots = 50
+
+// maxSlotAlternatives caps how many free slots are suggested when the
+// requested time is taken
+const maxSlotAlternatives = 3
+
+// maxSlotRangeDays caps how many days GetAvailableTimeSlotsRange covers
+const maxSlotRangeDays = 31
+
 // BookingService handles business logic for bookings
 type BookingService struct {
-	repo repository.BookingRepository
+	repo         repository.BookingRepository
+	payrollRepo  repository.PayrollRepository
+	dedupeWindow time.Duration
+
+	attachmentStore   storage.Store
+	maxAttachmentSize int64
+
+	surveyRepo    repository.SurveyRepository
+	reviewRepo    repository.ReviewRepository
+	favoriteRepo  repository.FavoriteRepository
+	promoRepo     repository.PromoCodeRepository
+	notifier      notification.Notifier
+	surveyBaseURL string
+
+	currency       string
+	commissionRate float64
+
+	paymentProvider payment.Provider
+	depositRate     float64
+	depositTimeout  time.Duration
+
+	noShowDepositThreshold int
+
+	settingsRepo repository.SettingsRepository
+	scheduleRepo repository.ScheduleRepository
+	holidayRepo  repository.HolidayRepository
+	catalogRepo  repository.CatalogRepository
+	offerRepo    repository.BarberServicesRepository
+	barberRepo   repository.BarberRepository
+	resourceRepo repository.ResourceRepository
+	historyRepo  repository.BookingHistoryRepository
+	auditRepo    repository.AuditRepository
+	webhookRepo  repository.WebhookRepository
+	shopLocation *time.Location
+
+	// policy is the current *SchedulingPolicy, swapped when it's reloaded
+	policy atomic.Pointer[SchedulingPolicy]
+
+	lateGracePeriod time.Duration
+
+	reminderLead time.Duration
+
+	dailyAgendas bool
+	agendaMinute int
+
+	pendingTimeout time.Duration
+
+	deletedRetention time.Duration
+
+	autoCompleteAfter          time.Duration
+	autoCompleteRequireCheckIn bool
+
+	allowEarlyCompletion bool
+
+	calendar calendar.Options
+
+	slotCache    SlotCache
+	heatmapCache HeatmapCache
+
+	slotLocks    repository.LeaseRepository
+	slotLockTTL  time.Duration
+	slotLockWait time.Duration
+
+	events         *eventBus
+	externalEvents bool
+	publisher      EventPublisher
+	outboxRepo     repository.OutboxRepository
+	transactor     repository.Transactor
+
+	flags *featureflag.Flags
+
+	clock clock.Clock
 }
 
 var _ BookingServiceInterface = (*BookingService)(nil)
 
+// Option configures optional BookingService behaviour
+type Option func(*BookingService)
+
+// WithClock replaces the clock the service reads the current time from
+func WithClock(c clock.Clock) Option {
+	return func(s *BookingService) {
+		s.clock = c
+	}
+}
+
+// WithDedupeWindow sets how long after creation an identical booking
+// request is rejected as a duplicate. Zero disables the check.
+func WithDedupeWindow(window time.Duration) Option {
+	return func(s *BookingService) {
+		s.dedupeWindow = window
+	}
+}
+
+// WithPayrollRepository sets the storage used for finalized payroll periods
+func WithPayrollRepository(repo repository.PayrollRepository) Option {
+	return func(s *BookingService) {
+		s.payrollRepo = repo
+	}
+}
+
+// WithAttachmentStore enables uploading booking attachments to store,
+// accepting files up to maxSize bytes
+func WithAttachmentStore(store storage.Store, maxSize int64) Option {
+	return func(s *BookingService) {
+		s.attachmentStore = store
+		s.maxAttachmentSize = maxSize
+	}
+}
+
+// WithNotifier sets how notifications are delivered to users
+func WithNotifier(notifier notification.Notifier) Option {
+	return func(s *BookingService) {
+		s.notifier = notifier
+	}
+}
+
+// WithSurveys enables satisfaction surveys after completed bookings, linking
+// to a survey page at baseURL
+func WithSurveys(repo repository.SurveyRepository, baseURL string) Option {
+	return func(s *BookingService) {
+		s.surveyRepo = repo
+		s.surveyBaseURL = baseURL
+	}
+}
+
+// WithReviewRepository lets customers review their completed bookings
+func WithReviewRepository(repo repository.ReviewRepository) Option {
+	return func(s *BookingService) {
+		s.reviewRepo = repo
+	}
+}
+
+// WithFavoriteRepository lets customers keep a list of favorite barbers
+func WithFavoriteRepository(repo repository.FavoriteRepository) Option {
+	return func(s *BookingService) {
+		s.favoriteRepo = repo
+	}
+}
+
+// WithPromoCodeRepository lets customers book with promo codes for a discount
+func WithPromoCodeRepository(repo repository.PromoCodeRepository) Option {
+	return func(s *BookingService) {
+		s.promoRepo = repo
+	}
+}
+
+// WithSettingsRepository enables admin-managed shop settings such as data retention
+func WithSettingsRepository(repo repository.SettingsRepository) Option {
+	return func(s *BookingService) {
+		s.settingsRepo = repo
+	}
+}
+
+// WithScheduleRepository enables per-barber working hours. Without it every
+// barber works the default hours.
+func WithScheduleRepository(repo repository.ScheduleRepository) Option {
+	return func(s *BookingService) {
+		s.scheduleRepo = repo
+	}
+}
+
+// WithHistoryRepository records every change to a booking, with who made it
+func WithHistoryRepository(repo repository.BookingHistoryRepository) Option {
+	return func(s *BookingService) {
+		s.historyRepo = repo
+	}
+}
+
+// WithAuditRepository makes the audit log queryable and subject to the
+// retention policy
+func WithAuditRepository(repo repository.AuditRepository) Option {
+	return func(s *BookingService) {
+		s.auditRepo = repo
+	}
+}
+
+// WithShopTimezone sets the time zone for barbers whose schedule doesn't name one
+func WithShopTimezone(loc *time.Location) Option {
+	return func(s *BookingService) {
+		s.shopLocation = loc
+	}
+}
+
+// WithBookingWindow rejects bookings that start less than minLead from now or
+// more than maxAdvanceDays days ahead; zero disables either limit. Barbers can
+// override both in their schedule.
+func WithBookingWindow(minLead time.Duration, maxAdvanceDays int) Option {
+	return func(s *BookingService) {
+		policy := s.SchedulingPolicy()
+		policy.MinLeadTime = minLead
+		policy.MaxAdvanceDays = maxAdvanceDays
+		s.policy.Store(&policy)
+	}
+}
+
+// WithHolidayRepository enables the shop holiday calendar
+func WithHolidayRepository(repo repository.HolidayRepository) Option {
+	return func(s *BookingService) {
+		s.holidayRepo = repo
+	}
+}
+
+// WithCatalogRepository makes bookings take their durations from the service
+// catalog and enables managing it
+func WithCatalogRepository(repo repository.CatalogRepository) Option {
+	return func(s *BookingService) {
+		s.catalogRepo = repo
+	}
+}
+
+// WithBarberServicesRepository enables per-barber service offerings. Without
+// it every barber offers every service in the catalog.
+func WithBarberServicesRepository(repo repository.BarberServicesRepository) Option {
+	return func(s *BookingService) {
+		s.offerRepo = repo
+	}
+}
+
+// WithBarberRepository sets where barber profiles are stored
+func WithBarberRepository(repo repository.BarberRepository) Option {
+	return func(s *BookingService) {
+		s.barberRepo = repo
+	}
+}
+
+// WithResourceRepository enables managing shared equipment, e.g. wash
+// stations, and makes bookings for services that need it wait for a free unit
+func WithResourceRepository(repo repository.ResourceRepository) Option {
+	return func(s *BookingService) {
+		s.resourceRepo = repo
+	}
+}
+
+// WithWebhookRepository enables registering webhooks for booking events
+func WithWebhookRepository(repo repository.WebhookRepository) Option {
+	return func(s *BookingService) {
+		s.webhookRepo = repo
+	}
+}
+
+// WithEventPublisher publishes booking events to other services as well as
+// to in-process watchers
+func WithEventPublisher(publisher EventPublisher) Option {
+	return func(s *BookingService) {
+		s.publisher = publisher
+	}
+}
+
+// WithExternalEvents stops the service from telling watchers about its own
+// changes, for when every change reaches them through Broadcast instead,
+// e.g. from a MongoDB change stream
+func WithExternalEvents() Option {
+	return func(s *BookingService) {
+		s.externalEvents = true
+	}
+}
+
+// WithOutbox stores booking events in the outbox, in the same transaction
+// as the booking change, instead of publishing them directly. RelayOutbox
+// then publishes them.
+func WithOutbox(repo repository.OutboxRepository, transactor repository.Transactor) Option {
+	return func(s *BookingService) {
+		s.outboxRepo = repo
+		s.transactor = transactor
+	}
+}
+
+// WithLateArrivalRelease releases the remaining time of bookings whose
+// customer hasn't checked in within the grace period after the start time
+func WithLateArrivalRelease(gracePeriod time.Duration) Option {
+	return func(s *BookingService) {
+		s.lateGracePeriod = gracePeriod
+	}
+}
+
+// WithReminders reminds customers of their bookings lead before they start
+func WithReminders(lead time.Duration) Option {
+	return func(s *BookingService) {
+		s.reminderLead = lead
+	}
+}
+
+// WithDailyAgendas sends barbers their agenda for the next day at a time of
+// day, in minutes after midnight in their time zone
+func WithDailyAgendas(minute int) Option {
+	return func(s *BookingService) {
+		s.dailyAgendas = true
+		s.agendaMinute = minute
+	}
+}
+
+// WithPendingTimeout cancels bookings still pending timeout after they were
+// made
+func WithPendingTimeout(timeout time.Duration) Option {
+	return func(s *BookingService) {
+		s.pendingTimeout = timeout
+	}
+}
+
+// WithDeletedRetention keeps soft-deleted bookings for the given period
+// before purging them for good
+func WithDeletedRetention(period time.Duration) Option {
+	return func(s *BookingService) {
+		s.deletedRetention = period
+	}
+}
+
+// WithAutoCompletion completes confirmed bookings after they've been over
+// for the given time. With requireCheckIn, bookings the customer didn't
+// check in for are flagged for review instead.
+func WithAutoCompletion(after time.Duration, requireCheckIn bool) Option {
+	return func(s *BookingService) {
+		s.autoCompleteAfter = after
+		s.autoCompleteRequireCheckIn = requireCheckIn
+	}
+}
+
+// WithDeposits makes customers prepay rate (between 0 and 1) of a booking's
+// price online. Bookings whose deposit isn't paid within timeout are cancelled.
+func WithDeposits(provider payment.Provider, rate float64, timeout time.Duration) Option {
+	return func(s *BookingService) {
+		s.paymentProvider = provider
+		s.depositRate = rate
+		s.depositTimeout = timeout
+	}
+}
+
+// WithNoShowDepositThreshold limits deposits to customers who missed at least
+// threshold bookings. A threshold of zero requires deposits from everyone.
+func WithNoShowDepositThreshold(threshold int) Option {
+	return func(s *BookingService) {
+		s.noShowDepositThreshold = threshold
+	}
+}
+
+// WithEarlyCompletion allows bookings to be completed before their end time
+func WithEarlyCompletion(allowed bool) Option {
+	return func(s *BookingService) {
+		s.allowEarlyCompletion = allowed
+	}
+}
+
+// WithCalendar describes the shop in the calendar files of bookings
+func WithCalendar(opts calendar.Options) Option {
+	return func(s *BookingService) {
+		s.calendar = opts
+	}
+}
+
+// WithCurrency sets the ISO 4217 currency the shop operates in
+func WithCurrency(currency string) Option {
+	return func(s *BookingService) {
+		s.currency = currency
+	}
+}
+
+// WithCommissionRate sets the share of revenue paid to barbers as commission
+func WithCommissionRate(rate float64) Option {
+	return func(s *BookingService) {
+		s.commissionRate = rate
+	}
+}
+
 // NewBookingService creates a new booking service
-func NewBookingService(repo repository.BookingRepository) *BookingService {
-	return &BookingService{
-		repo: repo,
+func NewBookingService(repo repository.BookingRepository, opts ...Option) *BookingService {
+	s := &BookingService{
+		repo:         repo,
+		currency:     "USD",
+		shopLocation: time.UTC,
+		calendar:     calendar.Options{Domain: "booking-service"},
+		events:       newEventBus(),
+		clock:        clock.System,
 	}
+	policy := DefaultSchedulingPolicy()
+	s.policy.Store(&policy)
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateBooking creates a new booking
-func (s *BookingService) CreateBooking(ctx context.Context, userID, barberID string, startTime time.Time, serviceType model.ServiceType, notes string) (*model.Booking, error) {
-	// Check if the barber is available at the requested time
-	endTime := model.CalculateEndTime(startTime, serviceType)
+func (s *BookingService) CreateBooking(ctx context.Context, params CreateBookingParams) (*model.Booking, error) {
+	// The hold already has the slot, so it only needs booking
+	if params.HoldID != "" {
+		return s.bookHold(ctx, params)
+	}
 
-	existingBookings, err := s.repo.GetBookingsInTimeRange(ctx, barberID, startTime, endTime)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to check barber availability")
+	if len(params.ServiceTypes) == 0 {
+		return nil, ErrNoServices
+	}
+
+	// Replayed requests get the booking created the first time
+	if params.IdempotencyKey != "" {
+		original, err := s.repo.GetBookingByIdempotencyKey(ctx, params.UserID, params.IdempotencyKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check idempotency key")
+		}
+		if original != nil {
+			return replayBooking(original, params)
+		}
+	}
+
+	// Catch double-submits of the exact same booking
+	if s.dedupeWindow > 0 {
+		duplicate, err := s.repo.FindDuplicateBooking(ctx, params.UserID, params.BarberID, params.StartTime, params.ServiceTypes, s.clock.Now().Add(-s.dedupeWindow))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check for duplicate booking")
+		}
+
+		if duplicate != nil {
+			log.Warn().
+				Str("bookingID", duplicate.ID.Hex()).
+				Str("userID", params.UserID).
+				Str("barberID", params.BarberID).
+				Msg("Duplicate booking request rejected")
+			return nil, ErrDuplicateBooking
+		}
 	}
 
-	if len(existingBookings) > 0 {
-		return nil, errors.New("barber is not available at the requested time")
+	slot, err := s.checkNewSlot(ctx, params.BarberID, params.StartTime, params.ServiceTypes)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create the booking
+	cleanup := slot.quote.Cleanup()
 	booking := &model.Booking{
-		UserID:      userID,
-		BarberID:    barberID,
-		StartTime:   startTime,
-		EndTime:     endTime,
-		ServiceType: serviceType,
-		Status:      model.BookingStatusPending,
-		Notes:       notes,
+		UserID:         params.UserID,
+		BarberID:       params.BarberID,
+		StartTime:      params.StartTime,
+		EndTime:        slot.endTime,
+		ServiceType:    params.ServiceTypes[0],
+		ServiceTypes:   params.ServiceTypes,
+		CleanupMinutes: &cleanup,
+		Status:         model.BookingStatusPending,
+		Price:          slot.quote.Price,
+		Currency:       slot.quote.Currency,
+		Notes:          params.Notes,
+		ExternalRef:    params.ExternalRef,
+		IdempotencyKey: params.IdempotencyKey,
+	}
+
+	if params.PromoCode != "" {
+		if err := s.applyPromoCode(ctx, booking, params.PromoCode); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.requestDeposit(ctx, booking); err != nil {
+		s.releasePromoCode(ctx, booking)
+		return nil, err
 	}
 
-	createdBooking, err := s.repo.CreateBooking(ctx, booking)
+	unlock, err := s.lockSlot(ctx, params.BarberID, slot.resources)
+	if err != nil {
+		s.cancelDeposit(ctx, booking)
+		s.releasePromoCode(ctx, booking)
+		return nil, errors.Wrap(err, "failed to lock slot")
+	}
+	createdBooking, err := s.changeBooking(ctx, BookingCreated, func(ctx context.Context) (*model.Booking, error) {
+		return s.repo.CreateBooking(ctx, booking, slot.capacity, slot.resources)
+	})
+	unlock()
 	if err != nil {
+		s.cancelDeposit(ctx, booking)
+		s.releasePromoCode(ctx, booking)
+
+		if errors.Is(err, repository.ErrIdempotencyKeyUsed) {
+			// A concurrent replay of the same request won the race
+			original, getErr := s.repo.GetBookingByIdempotencyKey(ctx, params.UserID, params.IdempotencyKey)
+			if getErr != nil || original == nil {
+				return nil, errors.Wrap(err, "failed to create booking")
+			}
+			return replayBooking(original, params)
+		}
+		if errors.Is(err, repository.ErrExternalRefExists) {
+			return nil, ErrExternalRefConflict
+		}
+		if errors.Is(err, repository.ErrSlotUnavailable) {
+			// Taken by a concurrent booking after the check above
+			return nil, s.slotUnavailable(ctx, params.BarberID, params.StartTime, slot.occupiedUntil, params.ServiceTypes, primitive.NilObjectID)
+		}
+		if errors.Is(err, repository.ErrResourceUnavailable) {
+			return nil, s.resourceUnavailable(ctx, slot.resources, params.StartTime, slot.occupiedUntil, primitive.NilObjectID)
+		}
 		return nil, errors.Wrap(err, "failed to create booking")
 	}
 
 	log.Info().
 		Str("bookingID", createdBooking.ID.Hex()).
-		Str("userID", userID).
-		Str("barberID", barberID).
-		Time("startTime", startTime).
+		Str("userID", params.UserID).
+		Str("barberID", params.BarberID).
+		Time("startTime", params.StartTime).
 		Msg("Booking created successfully")
 
+	s.recordHistory(ctx, model.BookingActionCreated, nil, createdBooking)
+	s.notifyCustomer(ctx, notification.KindBookingConfirmation, createdBooking)
+
 	return createdBooking, nil
 }
 
+// newSlot is a time found free for a new booking
+type newSlot struct {
+	quote         *model.Quote
+	endTime       time.Time
+	occupiedUntil time.Time
+	capacity      int
+	resources     []*model.Resource
+}
+
+// checkNewSlot checks that a new booking for the services can start with the
+// barber at start, and prices it
+func (s *BookingService) checkNewSlot(ctx context.Context, barberID string, start time.Time, serviceTypes []model.ServiceType) (*newSlot, error) {
+	if err := s.checkBarberActive(ctx, barberID); err != nil {
+		return nil, err
+	}
+
+	// Check if the barber is available at the requested time
+	quote, err := s.quote(ctx, barberID, serviceTypes)
+	if err != nil {
+		return nil, err
+	}
+	endTime := start.Add(quote.Duration())
+
+	if err := s.checkBookingWindow(ctx, barberID, start); err != nil {
+		return nil, err
+	}
+	if err := s.checkBookable(ctx, barberID, start, endTime); err != nil {
+		return nil, err
+	}
+
+	capacity, err := s.barberCapacity(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	occupiedUntil := model.CalculateOccupiedUntil(endTime, quote.Cleanup())
+	conflicts, err := s.findConflicts(ctx, barberID, start, occupiedUntil, primitive.NilObjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !model.FitsCapacity(conflicts, start, occupiedUntil, capacity) {
+		return nil, s.slotUnavailable(ctx, barberID, start, occupiedUntil, serviceTypes, primitive.NilObjectID)
+	}
+
+	// Equipment the services need is shared by every barber
+	resources, err := s.resourcesFor(ctx, serviceTypes)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkResources(ctx, resources, start, occupiedUntil, primitive.NilObjectID); err != nil {
+		return nil, err
+	}
+
+	return &newSlot{
+		quote:         quote,
+		endTime:       endTime,
+		occupiedUntil: occupiedUntil,
+		capacity:      capacity,
+		resources:     resources,
+	}, nil
+}
+
+// replayBooking returns the booking originally created with an idempotency
+// key, provided the replayed request asks for the same booking
+func replayBooking(original *model.Booking, params CreateBookingParams) (*model.Booking, error) {
+	if original.BarberID != params.BarberID ||
+		!original.StartTime.Equal(params.StartTime) ||
+		!slices.Equal(original.Services(), params.ServiceTypes) {
+		return nil, ErrIdempotencyKeyReused
+	}
+
+	log.Info().
+		Str("bookingID", original.ID.Hex()).
+		Str("idempotencyKey", params.IdempotencyKey).
+		Msg("Replayed booking request")
+
+	return original, nil
+}
+
 // GetBooking retrieves a booking by ID
 func (s *BookingService) GetBooking(ctx context.Context, id string) (*model.Booking, error) {
 	booking, err := s.repo.GetBookingByID(ctx, id)
@@ -73,14 +626,31 @@ func (s *BookingService) GetBooking(ctx context.Context, id string) (*model.Book
 	}
 
 	if booking == nil {
-		return nil, errors.New("booking not found")
+		return nil, ErrBookingNotFound
 	}
 
 	return booking, nil
 }
 
-// UpdateBooking updates an existing booking
-func (s *BookingService) UpdateBooking(ctx context.Context, id string, startTime *time.Time, serviceType *model.ServiceType, notes *string) (*model.Booking, error) {
+// GetBookingByExternalRef retrieves a booking by its external reference
+func (s *BookingService) GetBookingByExternalRef(ctx context.Context, externalRef string) (*model.Booking, error) {
+	booking, err := s.repo.GetBookingByExternalRef(ctx, externalRef)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking by external reference")
+	}
+
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+
+	return booking, nil
+}
+
+// UpdateBooking applies the fields set in params to an existing booking,
+// which must be pending or confirmed. If a version is given, the update only
+// applies while the booking is still at it.
+func (s *BookingService) UpdateBooking(ctx context.Context, id string, params UpdateBookingParams) (*model.Booking, error) {
+
 	// Get the existing booking
 	existingBooking, err := s.repo.GetBookingByID(ctx, id)
 	if err != nil {
@@ -88,106 +658,351 @@ func (s *BookingService) UpdateBooking(ctx context.Context, id string, startTime
 	}
 
 	if existingBooking == nil {
-		return nil, errors.New("booking not found")
+		return nil, ErrBookingNotFound
+	}
+	if err := checkChangeable(existingBooking); err != nil {
+		return nil, err
 	}
 
-	// Prepare updates
-	updates := map[string]interface{}{}
+	if params.Version != nil && existingBooking.Version != *params.Version {
+		return nil, ErrBookingModified
+	}
 
-	if startTime != nil {
-		updates["startTime"] = *startTime
+	if params.ServiceTypes != nil && len(params.ServiceTypes) == 0 {
+		return nil, ErrNoServices
+	}
 
-		// Recalculate end time if start time or service type changes
-		newServiceType := existingBooking.ServiceType
-		if serviceType != nil {
-			newServiceType = *serviceType
+	// Price the new services up front; their length decides the new end time
+	var newQuote *model.Quote
+	if params.ServiceTypes != nil {
+		newQuote, err = s.quote(ctx, existingBooking.BarberID, params.ServiceTypes)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		endTime := model.CalculateEndTime(*startTime, newServiceType)
-		updates["endTime"] = endTime
+	// Prepare updates. The booking keeps its start time and length unless
+	// they're changed.
+	updates := map[string]interface{}{}
+	services := existingBooking.Services()
+	startTime := existingBooking.StartTime
+	duration := existingBooking.EndTime.Sub(existingBooking.StartTime)
+	cleanup := existingBooking.Cleanup()
+
+	if params.StartTime != nil && !params.StartTime.Equal(existingBooking.StartTime) {
+		startTime = *params.StartTime
+		if err := s.checkBarberActive(ctx, existingBooking.BarberID); err != nil {
+			return nil, err
+		}
+		if err := s.checkBookingWindow(ctx, existingBooking.BarberID, startTime); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.ServiceTypes != nil {
+		services = params.ServiceTypes
+		duration = newQuote.Duration()
+		cleanup = newQuote.Cleanup()
+
+		updates["serviceType"] = params.ServiceTypes[0]
+		updates["serviceTypes"] = params.ServiceTypes
+		updates["cleanupMinutes"] = cleanup
+		updates["currency"] = newQuote.Currency
 
-		// Check availability
-		bookings, err := s.repo.GetBookingsInTimeRange(ctx, existingBooking.BarberID, *startTime, endTime)
+		// Bookings made with a promo code keep getting its discount
+		discount, err := s.promoDiscount(ctx, existingBooking, newQuote.Price)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to check barber availability")
+			return nil, err
 		}
+		updates["price"] = newQuote.Price - discount
+		if existingBooking.PromoCode != "" {
+			updates["discount"] = discount
+		}
+	}
+
+	if params.Notes != nil {
+		updates["notes"] = *params.Notes
+	}
 
-		// Filter out the current booking from results
-		for i, b := range bookings {
-			if b.ID == existingBooking.ID {
-				bookings = append(bookings[:i], bookings[i+1:]...)
-				break
+	// A booking that moves or changes services is checked against the
+	// barber's and resources' other bookings while it's written, like a
+	// reschedule
+	endTime := startTime.Add(duration)
+	var updatedBooking *model.Booking
+	if !startTime.Equal(existingBooking.StartTime) || params.ServiceTypes != nil {
+		updatedBooking, err = s.moveBooking(ctx, existingBooking, BookingUpdated, repository.BookingMove{
+			StartTime:      startTime,
+			EndTime:        endTime,
+			CleanupMinutes: cleanup,
+			Updates:        updates,
+			Version:        params.Version,
+		}, services)
+	} else {
+		updatedBooking, err = s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+			if params.Version != nil {
+				return s.repo.UpdateBookingAtVersion(ctx, id, *params.Version, updates)
 			}
+			return s.repo.UpdateBooking(ctx, id, updates)
+		})
+		if err != nil {
+			err = errors.Wrap(err, "failed to update booking")
 		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if updatedBooking == nil {
+		// Changed or deleted since it was read above
+		return nil, ErrBookingModified
+	}
 
-		if len(bookings) > 0 {
-			return nil, errors.New("barber is not available at the requested time")
-		}
+	// The day the booking was moved from has room again
+	s.invalidateSlots(ctx, existingBooking)
+
+	log.Info().
+		Str("bookingID", id).
+		Msg("Booking updated successfully")
+
+	s.recordHistory(ctx, model.BookingActionUpdated, existingBooking, updatedBooking)
+
+	return updatedBooking, nil
+}
+
+// RescheduleBooking moves an active booking to a new start time, keeping its
+// service. The previous start time is recorded on the booking.
+func (s *BookingService) RescheduleBooking(ctx context.Context, id string, startTime time.Time) (*model.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil {
+		return nil, ErrBookingNotFound
 	}
 
-	if serviceType != nil {
-		updates["serviceType"] = *serviceType
+	if err := checkChangeable(booking); err != nil {
+		return nil, err
+	}
+	if startTime.Equal(booking.StartTime) {
+		return booking, nil
+	}
 
-		// Recalculate end time if service type changes but start time doesn't
-		if startTime == nil {
-			endTime := model.CalculateEndTime(existingBooking.StartTime, *serviceType)
-			updates["endTime"] = endTime
+	// The booking keeps its length
+	endTime := startTime.Add(booking.EndTime.Sub(booking.StartTime))
+	if err := s.checkBarberActive(ctx, booking.BarberID); err != nil {
+		return nil, err
+	}
+	if err := s.checkBookingWindow(ctx, booking.BarberID, startTime); err != nil {
+		return nil, err
+	}
+	rescheduledBooking, err := s.moveBooking(ctx, booking, BookingRescheduled, repository.BookingMove{
+		StartTime:      startTime,
+		EndTime:        endTime,
+		CleanupMinutes: booking.Cleanup(),
+	}, booking.Services())
+	if err != nil {
+		return nil, err
+	}
+	if rescheduledBooking == nil {
+		return nil, ErrBookingModified
+	}
 
-			// Check availability with the new end time
-			bookings, err := s.repo.GetBookingsInTimeRange(ctx, existingBooking.BarberID, existingBooking.StartTime, endTime)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to check barber availability")
-			}
+	log.Info().
+		Str("bookingID", id).
+		Time("from", booking.StartTime).
+		Time("to", startTime).
+		Msg("Booking rescheduled")
 
-			// Filter out the current booking from results
-			for i, b := range bookings {
-				if b.ID == existingBooking.ID {
-					bookings = append(bookings[:i], bookings[i+1:]...)
-					break
-				}
-			}
+	s.recordHistory(ctx, model.BookingActionRescheduled, booking, rescheduledBooking)
+	s.notifyCustomer(ctx, notification.KindBookingRescheduled, rescheduledBooking)
 
-			if len(bookings) > 0 {
-				return nil, errors.New("barber is not available for the requested service duration")
-			}
+	return rescheduledBooking, nil
+}
+
+// moveBooking moves a booking to a new time or changes its length, applying
+// the move's other updates with it. The barber's schedule and the services'
+// resources stay locked while the repository checks the new time is free and
+// writes it, so two changes can't both take the last place.
+func (s *BookingService) moveBooking(ctx context.Context, booking *model.Booking, eventType BookingEventType, move repository.BookingMove, services []model.ServiceType) (*model.Booking, error) {
+	if err := s.checkBookable(ctx, booking.BarberID, move.StartTime, move.EndTime); err != nil {
+		return nil, err
+	}
+	capacity, err := s.barberCapacity(ctx, booking.BarberID)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := s.resourcesFor(ctx, services)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock, err := s.lockSlot(ctx, booking.BarberID, resources)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to lock slot")
+	}
+	moved, err := s.changeBooking(ctx, eventType, func(ctx context.Context) (*model.Booking, error) {
+		return s.repo.RescheduleBooking(ctx, booking, move, capacity, resources)
+	})
+	unlock()
+	if err != nil {
+		occupiedUntil := model.CalculateOccupiedUntil(move.EndTime, move.CleanupMinutes)
+		if errors.Is(err, repository.ErrSlotUnavailable) {
+			return nil, s.slotUnavailable(ctx, booking.BarberID, move.StartTime, occupiedUntil, services, booking.ID)
+		}
+		if errors.Is(err, repository.ErrResourceUnavailable) {
+			return nil, s.resourceUnavailable(ctx, resources, move.StartTime, occupiedUntil, booking.ID)
 		}
+		return nil, errors.Wrap(err, "failed to move booking")
 	}
 
-	if notes != nil {
-		updates["notes"] = *notes
+	return moved, nil
+}
+
+// ConfirmBooking confirms a pending booking. Confirming an already confirmed
+// booking is a no-op.
+func (s *BookingService) ConfirmBooking(ctx context.Context, id string) (*model.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+	if booking.Status == model.BookingStatusConfirmed {
+		return booking, nil
 	}
 
-	// Update the booking
-	updatedBooking, err := s.repo.UpdateBooking(ctx, id, updates)
+	confirmedBooking, err := s.transitionStatus(ctx, booking, model.BookingStatusConfirmed, nil)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to update booking")
+		return nil, err
 	}
 
 	log.Info().
 		Str("bookingID", id).
-		Msg("Booking updated successfully")
+		Str("barberID", confirmedBooking.BarberID).
+		Msg("Booking confirmed")
 
-	return updatedBooking, nil
+	return confirmedBooking, nil
 }
 
-// CancelBooking cancels a booking
-func (s *BookingService) CancelBooking(ctx context.Context, id string) (bool, error) {
-	success, err := s.repo.CancelBooking(ctx, id)
+// CompleteBooking marks a pending or confirmed booking as completed. Unless
+// early completion is allowed, the booking must have reached its end time.
+func (s *BookingService) CompleteBooking(ctx context.Context, id string) (*model.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, id)
 	if err != nil {
-		return false, errors.Wrap(err, "failed to cancel booking")
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+	if booking.Status == model.BookingStatusCompleted {
+		return booking, nil
 	}
 
-	if success {
-		log.Info().
-			Str("bookingID", id).
-			Msg("Booking cancelled successfully")
-	} else {
+	if !s.allowEarlyCompletion && booking.Status != model.BookingStatusCancelled && s.clock.Now().Before(booking.EndTime) {
+		return nil, ErrBookingNotEnded
+	}
+
+	completedBooking, err := s.transitionStatus(ctx, booking, model.BookingStatusCompleted, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("bookingID", id).
+		Str("barberID", completedBooking.BarberID).
+		Msg("Booking completed")
+
+	s.onBookingCompleted(ctx, completedBooking)
+
+	return completedBooking, nil
+}
+
+// CancelBooking cancels a booking that hasn't started yet, applying the
+// shop's cancellation policy unless it's waived. It returns nil if the
+// booking doesn't exist or is already cancelled.
+func (s *BookingService) CancelBooking(ctx context.Context, id string, params CancelBookingParams) (*model.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil || booking.Status == model.BookingStatusCancelled {
 		log.Info().
 			Str("bookingID", id).
 			Msg("Booking not found or already cancelled")
+		return nil, nil
 	}
 
-	return success, nil
+	cancellation, err := s.evaluateCancellation(ctx, booking, params)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelledBooking, err := s.transitionStatus(ctx, booking, model.BookingStatusCancelled, map[string]interface{}{
+		"cancellation": cancellation,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("bookingID", id).
+		Str("cancelledBy", params.CancelledBy).
+		Int64("fee", cancellation.Fee).
+		Msg("Booking cancelled successfully")
+
+	s.cancelDeposit(ctx, cancelledBooking)
+	s.notifyCustomer(ctx, notification.KindBookingCancelled, cancelledBooking)
+
+	// Uploaded reference images are no longer needed
+	if err := s.cleanupAttachments(ctx, cancelledBooking); err != nil {
+		log.Error().Err(err).Str("bookingID", id).Msg("Failed to clean up booking attachments")
+	}
+
+	return cancelledBooking, nil
+}
+
+// DeleteBooking soft-deletes a booking made in error, reporting whether it
+// existed. The booking disappears from every query and frees its slot right
+// away, but is only purged with its uploaded files and history once the
+// deleted booking retention period has passed.
+func (s *BookingService) DeleteBooking(ctx context.Context, id string) (bool, error) {
+	booking, err := s.repo.GetBookingByID(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil {
+		return false, nil
+	}
+
+	deletedBooking, err := s.repo.SoftDeleteBooking(ctx, id, s.clock.Now())
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete booking")
+	}
+	if deletedBooking == nil {
+		// Deleted concurrently
+		return false, nil
+	}
+
+	s.cancelDeposit(ctx, deletedBooking)
+	s.invalidateSlots(ctx, deletedBooking)
+	s.recordHistory(ctx, model.BookingActionDeleted, booking, deletedBooking)
+
+	log.Info().
+		Str("bookingID", id).
+		Str("barberID", booking.BarberID).
+		Msg("Booking deleted")
+
+	return true, nil
+}
+
+// ListBookings retrieves the bookings matching a filter
+func (s *BookingService) ListBookings(ctx context.Context, filter model.BookingFilter) ([]*model.Booking, error) {
+	bookings, err := s.repo.ListBookings(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list bookings")
+	}
+
+	return bookings, nil
 }
 
 // GetUserBookings retrieves all bookings for a user
@@ -210,52 +1025,321 @@ func (s *BookingService) GetBarberBookings(ctx context.Context, barberID string,
 	return bookings, nil
 }
 
-// GetAvailableTimeSlots gets available time slots for a barber on a specific day
-func (s *BookingService) GetAvailableTimeSlots(ctx context.Context, barberID string, date time.Time) ([]*model.TimeSlot, error) {
-	// Define working hours (9 AM to 5 PM)
-	workStartHour := 9
-	workEndHour := 17
-
-	// Create start and end of the day
+// GetAvailableTimeSlots gets available time slots for a barber on a specific
+// day. Slots start every slot length of the barber's schedule. Given services,
+// each slot lasts as long as those services back to back and only slots with
+// room for them, including their cleanup buffer, are returned.
+func (s *BookingService) GetAvailableTimeSlots(ctx context.Context, barberID string, date time.Time, serviceTypes []model.ServiceType) ([]*model.TimeSlot, error) {
+	// The requested day, in the zone it was asked for
 	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
-	workStart := time.Date(date.Year(), date.Month(), date.Day(), workStartHour, 0, 0, 0, date.Location())
-	workEnd := time.Date(date.Year(), date.Month(), date.Day(), workEndHour, 0, 0, 0, date.Location())
+	endOfDay := startOfDay.AddDate(0, 0, 1)
+
+	now := s.clock.Now()
+	if !endOfDay.After(now) {
+		return nil, ErrDateInPast
+	}
+
+	day := startOfDay.Format(slotCacheDayFormat)
+	variant := slotCacheVariant(date.Location(), serviceTypes)
+	if slots, ok := s.cachedSlots(ctx, barberID, day, variant, now, date.Location()); ok {
+		return slots, nil
+	}
+
+	// Look up the barber's working hours, which are in their own time zone
+	schedule, err := s.GetWorkingHours(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Today's slots that have already started can't be booked
+	from := startOfDay
+	if from.Before(now) {
+		from = now
+	}
+
+	slots, err := s.findSlots(ctx, schedule, from, endOfDay, serviceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slot := range slots {
+		slot.StartTime = slot.StartTime.In(date.Location())
+		slot.EndTime = slot.EndTime.In(date.Location())
+	}
+
+	s.cacheSlots(ctx, barberID, day, variant, slots)
+	return slots, nil
+}
+
+// GetAvailableTimeSlotsRange returns the available slots for a barber on each
+// day from the first to the last date, inclusive, with days taken in the zone
+// of the first date. Days that are over have no slots.
+func (s *BookingService) GetAvailableTimeSlotsRange(ctx context.Context, barberID string, first, last time.Time, serviceTypes []model.ServiceType) ([]*model.DaySlots, error) {
+	loc := first.Location()
+	rangeStart := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+	lastDay := last.In(loc)
+	rangeEnd := time.Date(lastDay.Year(), lastDay.Month(), lastDay.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+
+	if !rangeEnd.After(rangeStart) {
+		return nil, errors.Wrap(ErrInvalidDateRange, "end date is before start date")
+	}
+	if rangeEnd.After(rangeStart.AddDate(0, 0, maxSlotRangeDays)) {
+		return nil, errors.Wrapf(ErrInvalidDateRange, "range can cover at most %d days", maxSlotRangeDays)
+	}
+
+	now := s.clock.Now()
+	if !rangeEnd.After(now) {
+		return nil, ErrDateInPast
+	}
+
+	schedule, err := s.GetWorkingHours(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := rangeStart
+	if from.Before(now) {
+		from = now
+	}
 
-	// Get all bookings for the barber on that day
-	bookings, err := s.repo.GetBarberBookings(ctx, barberID, &startOfDay)
+	// Bookings for the whole range are fetched in one go
+	slots, err := s.findSlots(ctx, schedule, from, rangeEnd, serviceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []*model.DaySlots
+	for day := rangeStart; day.Before(rangeEnd); day = day.AddDate(0, 0, 1) {
+		days = append(days, &model.DaySlots{Date: day, TimeSlots: []*model.TimeSlot{}})
+	}
+
+	// Slots come back in order, so each belongs to the same day as the
+	// previous one or a later one
+	i := 0
+	for _, slot := range slots {
+		slot.StartTime = slot.StartTime.In(loc)
+		slot.EndTime = slot.EndTime.In(loc)
+		for i+1 < len(days) && !slot.StartTime.Before(days[i+1].Date) {
+			i++
+		}
+		days[i].TimeSlots = append(days[i].TimeSlots, slot)
+	}
+
+	return days, nil
+}
+
+// GetNextAvailableSlot returns the barber's earliest free slots, up to count of
+// them, scanning forward from now across as many days as it takes. Slots are
+// in the barber's time zone and respect the booking lead time and
+// advance-booking window.
+func (s *BookingService) GetNextAvailableSlot(ctx context.Context, barberID string, serviceTypes []model.ServiceType, count int) ([]*model.TimeSlot, error) {
+	if count < 1 {
+		count = 1
+	}
+	if count > maxNextSlots {
+		count = maxNextSlots
+	}
+
+	schedule, err := s.GetWorkingHours(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findNextSlots(ctx, schedule, s.clock.Now(), serviceTypes, count)
+}
+
+// findNextSlots returns up to count of the barber's free slots starting at or
+// after from, within the booking lead time and advance-booking window
+func (s *BookingService) findNextSlots(ctx context.Context, schedule *model.BarberSchedule, from time.Time, serviceTypes []model.ServiceType, count int) ([]*model.TimeSlot, error) {
+	now := s.clock.Now()
+	minLead, maxAdvanceDays := s.bookingWindow(schedule)
+	if maxAdvanceDays <= 0 {
+		maxAdvanceDays = nextSlotSearchDays
+	}
+	horizon := now.AddDate(0, 0, maxAdvanceDays)
+	if earliest := now.Add(minLead); from.Before(earliest) {
+		from = earliest
+	}
+
+	// Look a week at a time so a busy barber doesn't load months of bookings
+	var slots []*model.TimeSlot
+	for ; from.Before(horizon) && len(slots) < count; from = from.AddDate(0, 0, 7) {
+		to := from.AddDate(0, 0, 7)
+		if to.After(horizon) {
+			to = horizon
+		}
+
+		found, err := s.findSlots(ctx, schedule, from, to, serviceTypes)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, found...)
+	}
+
+	if len(slots) > count {
+		slots = slots[:count]
+	}
+	return slots, nil
+}
+
+// findSlots returns the barber's free slots starting within [from, to), in the
+// barber's time zone
+func (s *BookingService) findSlots(ctx context.Context, schedule *model.BarberSchedule, from, to time.Time, serviceTypes []model.ServiceType) ([]*model.TimeSlot, error) {
+	// Barbers who aren't taking bookings have no free slots
+	active, err := s.barberActive(ctx, schedule.BarberID)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, nil
+	}
+	capacity, err := s.barberCapacity(ctx, schedule.BarberID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := schedule.Location()
+	slotStep := schedule.SlotDuration()
+
+	// Slots last as long as the services, or a slot step without any
+	slotLength := slotStep
+	cleanup := 0
+	if len(serviceTypes) > 0 {
+		quote, err := s.quote(ctx, schedule.BarberID, serviceTypes)
+		if err != nil {
+			return nil, err
+		}
+		slotLength = quote.Duration()
+		cleanup = quote.Cleanup()
+	}
+
+	// Get all bookings that could overlap the range, including cleanup buffers
+	maxCleanup, err := s.maxCleanupBuffer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bookings, err := s.repo.GetBookingsInTimeRange(ctx, schedule.BarberID, from.Add(-maxCleanup), to.Add(slotLength))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get barber bookings")
 	}
+	activeBookings := bookings[:0]
+	for _, booking := range bookings {
+		if booking.Status != model.BookingStatusCancelled {
+			activeBookings = append(activeBookings, booking)
+		}
+	}
+
+	// Slots also need a free unit of the equipment the services use, which
+	// bookings with any barber take
+	resources, err := s.resourcesFor(ctx, serviceTypes)
+	if err != nil {
+		return nil, err
+	}
+	var resourceBookings []*model.Booking
+	if len(resources) > 0 {
+		resourceBookings, err = s.repo.GetBookingsForServices(ctx, model.ResourceServiceTypes(resources), from.Add(-maxCleanup), to.Add(slotLength))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get resource bookings")
+		}
+	}
 
-	// Create 30-minute time slots
-	slotDuration := 30 * time.Minute
 	var availableSlots []*model.TimeSlot
 
-	for slotStart := workStart; slotStart.Before(workEnd); slotStart = slotStart.Add(slotDuration) {
-		slotEnd := slotStart.Add(slotDuration)
+	// Walk the barber's local days that overlap the range
+	first := from.In(loc)
+	for day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		// Nobody works on shop holidays
+		closed, err := s.isHoliday(ctx, day)
+		if err != nil {
+			return nil, err
+		}
+		if closed {
+			continue
+		}
 
-		// Check if this slot overlaps with any booking
-		isAvailable := true
-		for _, booking := range bookings {
-			if booking.Status == model.BookingStatusCancelled {
-				continue
-			}
+		for _, shift := range schedule.HoursOn(day.Weekday()) {
+			workStart := time.Date(day.Year(), day.Month(), day.Day(), 0, shift.StartMinute, 0, 0, loc)
+			workEnd := time.Date(day.Year(), day.Month(), day.Day(), 0, shift.EndMinute, 0, 0, loc)
+
+			for slotStart := workStart; slotStart.Before(workEnd); slotStart = slotStart.Add(slotStep) {
+				slotEnd := slotStart.Add(slotLength)
+				occupiedUntil := model.CalculateOccupiedUntil(slotEnd, cleanup)
 
-			if (slotStart.Equal(booking.StartTime) || slotStart.After(booking.StartTime)) && slotStart.Before(booking.EndTime) ||
-				(slotEnd.After(booking.StartTime) && (slotEnd.Equal(booking.EndTime) || slotEnd.Before(booking.EndTime))) ||
-				(slotStart.Before(booking.StartTime) && slotEnd.After(booking.EndTime)) {
-				isAvailable = false
-				break
+				if slotEnd.After(workEnd) || slotStart.Before(from) || !slotStart.Before(to) {
+					continue
+				}
+
+				// Check that this slot misses breaks and that the barber
+				// and equipment have room for it alongside their bookings
+				// and cleanup buffers
+				isAvailable := !schedule.OverlapsBreak(slotStart, slotEnd) &&
+					model.FitsCapacity(activeBookings, slotStart, occupiedUntil, capacity) &&
+					fullResource(resources, resourceBookings, slotStart, occupiedUntil) == nil
+
+				if isAvailable {
+					availableSlots = append(availableSlots, &model.TimeSlot{
+						StartTime: slotStart,
+						EndTime:   slotEnd,
+					})
+				}
 			}
 		}
+	}
+
+	return availableSlots, nil
+}
+
+// slotUnavailable builds the error for a booking occupying the barber from
+// start to occupiedUntil that overlaps others, with the times of the
+// overlapping bookings and the barber's next free slots from start. The
+// booking with excludeID is ignored, as in findConflicts.
+func (s *BookingService) slotUnavailable(ctx context.Context, barberID string, start, occupiedUntil time.Time, serviceTypes []model.ServiceType, excludeID primitive.ObjectID) error {
+	slotErr := &SlotUnavailableError{}
 
-		if isAvailable {
-			availableSlots = append(availableSlots, &model.TimeSlot{
-				StartTime: slotStart,
-				EndTime:   slotEnd,
-			})
+	// The details are a courtesy, so failing to look them up doesn't hide
+	// that the slot is taken
+	conflicts, err := s.findConflicts(ctx, barberID, start, occupiedUntil, excludeID)
+	if err != nil {
+		log.Warn().Err(err).Str("barberID", barberID).Msg("Failed to look up conflicting bookings")
+	}
+	for _, conflict := range conflicts {
+		slotErr.Conflicts = append(slotErr.Conflicts, &model.TimeSlot{StartTime: conflict.StartTime, EndTime: conflict.EndTime})
+	}
+
+	schedule, err := s.GetWorkingHours(ctx, barberID)
+	if err == nil {
+		slotErr.Alternatives, err = s.findNextSlots(ctx, schedule, start, serviceTypes, maxSlotAlternatives)
+	}
+	if err != nil {
+		log.Warn().Err(err).Str("barberID", barberID).Msg("Failed to find alternative slots")
+	}
+
+	return slotErr
+}
+
+// findConflicts returns the active bookings of a barber whose occupied window,
+// including cleanup buffers, overlaps [start, occupiedUntil). The booking with
+// excludeID is ignored so a booking doesn't conflict with itself on update.
+func (s *BookingService) findConflicts(ctx context.Context, barberID string, start, occupiedUntil time.Time, excludeID primitive.ObjectID) ([]*model.Booking, error) {
+	// Widen the search so earlier bookings whose buffer runs into the window are found
+	maxCleanup, err := s.maxCleanupBuffer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bookings, err := s.repo.GetBookingsInTimeRange(ctx, barberID, start.Add(-maxCleanup), occupiedUntil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check barber availability")
+	}
+
+	var conflicts []*model.Booking
+	for _, booking := range bookings {
+		if booking.ID == excludeID {
+			continue
+		}
+		if booking.Overlaps(start, occupiedUntil) {
+			conflicts = append(conflicts, booking)
 		}
 	}
 
-	return availableSlots, nil
+	return conflicts, nil
 }
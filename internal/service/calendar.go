@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/calendar"
+)
+
+// GetBookingICS renders a booking as an iCalendar file, with times in the
+// barber's time zone
+func (s *BookingService) GetBookingICS(ctx context.Context, id string) ([]byte, error) {
+	booking, err := s.GetBooking(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar.Render(booking, s.barberLocation(ctx, booking.BarberID), s.calendar), nil
+}
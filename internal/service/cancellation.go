@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// maxCancellationWindowMinutes bounds cancellation rule windows to 30 days
+const maxCancellationWindowMinutes = 30 * 24 * 60
+
+// GetCancellationPolicy returns the shop's current cancellation policy
+func (s *BookingService) GetCancellationPolicy(ctx context.Context) (*model.CancellationPolicy, error) {
+	if s.settingsRepo == nil {
+		return &model.CancellationPolicy{}, nil
+	}
+
+	settings, err := s.settingsRepo.GetSettings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get settings")
+	}
+
+	return &settings.Cancellation, nil
+}
+
+// UpdateCancellationPolicy validates and stores a new cancellation policy
+func (s *BookingService) UpdateCancellationPolicy(ctx context.Context, policy model.CancellationPolicy, updatedBy string) (*model.CancellationPolicy, error) {
+	if s.settingsRepo == nil {
+		return nil, errors.New("settings storage is not configured")
+	}
+
+	windows := map[int]bool{}
+	for _, rule := range policy.Rules {
+		if rule.WithinMinutes <= 0 || rule.WithinMinutes > maxCancellationWindowMinutes {
+			return nil, errors.Wrapf(ErrInvalidCancellationPolicy, "windows must be between 1 and %d minutes", maxCancellationWindowMinutes)
+		}
+		if rule.FeePercent < 0 || rule.FeePercent > 100 {
+			return nil, errors.Wrap(ErrInvalidCancellationPolicy, "fees must be between 0 and 100 percent")
+		}
+		if windows[rule.WithinMinutes] {
+			return nil, errors.Wrapf(ErrInvalidCancellationPolicy, "more than one rule for %d minutes", rule.WithinMinutes)
+		}
+		windows[rule.WithinMinutes] = true
+	}
+
+	settings, err := s.settingsRepo.UpdateCancellationPolicy(ctx, policy, updatedBy)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update cancellation policy")
+	}
+
+	log.Info().
+		Int("rules", len(policy.Rules)).
+		Str("updatedBy", updatedBy).
+		Msg("Cancellation policy updated")
+
+	return &settings.Cancellation, nil
+}
+
+// evaluateCancellation applies the cancellation policy to a booking being
+// cancelled now, returning the cancellation to record or
+// ErrCancellationNotAllowed if it's too late to cancel
+func (s *BookingService) evaluateCancellation(ctx context.Context, booking *model.Booking, params CancelBookingParams) (*model.Cancellation, error) {
+	now := s.clock.Now()
+	cancellation := &model.Cancellation{
+		CancelledAt: now,
+		CancelledBy: params.CancelledBy,
+		Reason:      params.Reason,
+		Currency:    booking.Currency,
+	}
+	if params.WaivePolicy {
+		return cancellation, nil
+	}
+
+	policy, err := s.GetCancellationPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := policy.RuleFor(booking.StartTime.Sub(now))
+	if rule == nil {
+		return cancellation, nil
+	}
+	if rule.Forbidden {
+		return nil, errors.Wrapf(ErrCancellationNotAllowed, "cancellations must be made at least %s before the start time",
+			formatWindow(rule.WithinMinutes))
+	}
+
+	cancellation.Fee = booking.Price * int64(rule.FeePercent) / 100
+	return cancellation, nil
+}
+
+// formatWindow describes a window in minutes, e.g. "24 hours" or "90 minutes"
+func formatWindow(minutes int) string {
+	window := time.Duration(minutes) * time.Minute
+	if window%time.Hour == 0 {
+		hours := int(window / time.Hour)
+		if hours == 1 {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", hours)
+	}
+	return fmt.Sprintf("%d minutes", minutes)
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// fakeSettingsRepo holds the shop settings in memory
+type fakeSettingsRepo struct {
+	repository.SettingsRepository
+	settings model.ShopSettings
+}
+
+func (r *fakeSettingsRepo) GetSettings(ctx context.Context) (*model.ShopSettings, error) {
+	return &r.settings, nil
+}
+
+func (r *fakeSettingsRepo) UpdateCancellationPolicy(ctx context.Context, policy model.CancellationPolicy, updatedBy string) (*model.ShopSettings, error) {
+	r.settings.Cancellation = policy
+	return &r.settings, nil
+}
+
+func TestCancelBooking_Policy(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	repo := &depositBookingRepo{}
+	s := NewBookingService(repo, WithSettingsRepository(&fakeSettingsRepo{}), WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	_, err := s.UpdateCancellationPolicy(ctx, model.CancellationPolicy{Rules: []model.CancellationRule{
+		{WithinMinutes: 60, Forbidden: true},
+		{WithinMinutes: 24 * 60, FeePercent: 50},
+	}}, "admin1")
+	assert.NoError(t, err)
+
+	book := func(start time.Time) *model.Booking {
+		booking := &model.Booking{
+			ID:        primitive.NewObjectID(),
+			BarberID:  "barber1",
+			StartTime: start,
+			EndTime:   start.Add(30 * time.Minute),
+			Status:    model.BookingStatusConfirmed,
+			Price:     3000,
+			Currency:  "EUR",
+		}
+		repo.bookings = append(repo.bookings, booking)
+		return booking
+	}
+
+	// More than a day ahead is free
+	cancelled, err := s.CancelBooking(ctx, book(now.Add(48*time.Hour)).ID.Hex(), CancelBookingParams{CancelledBy: "user1", Reason: "sick"})
+	assert.NoError(t, err)
+	assert.Equal(t, &model.Cancellation{CancelledAt: now, CancelledBy: "user1", Reason: "sick", Currency: "EUR"}, cancelled.Cancellation)
+
+	// Within a day costs half the price
+	cancelled, err = s.CancelBooking(ctx, book(now.Add(3*time.Hour)).ID.Hex(), CancelBookingParams{CancelledBy: "user1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), cancelled.Cancellation.Fee)
+
+	// Within an hour customers can't cancel, but the shop can
+	late := book(now.Add(30 * time.Minute))
+	_, err = s.CancelBooking(ctx, late.ID.Hex(), CancelBookingParams{CancelledBy: "user1"})
+	assert.ErrorIs(t, err, ErrCancellationNotAllowed)
+	assert.Contains(t, err.Error(), "1 hour")
+
+	cancelled, err = s.CancelBooking(ctx, late.ID.Hex(), CancelBookingParams{CancelledBy: "barber1", WaivePolicy: true})
+	assert.NoError(t, err)
+	assert.Zero(t, cancelled.Cancellation.Fee)
+}
+
+func TestUpdateCancellationPolicy_Validation(t *testing.T) {
+	s := NewBookingService(nil, WithSettingsRepository(&fakeSettingsRepo{}))
+
+	for _, rules := range [][]model.CancellationRule{
+		{{WithinMinutes: 0, FeePercent: 50}},
+		{{WithinMinutes: 60, FeePercent: 150}},
+		{{WithinMinutes: 60, FeePercent: 50}, {WithinMinutes: 60, Forbidden: true}},
+	} {
+		_, err := s.UpdateCancellationPolicy(context.Background(), model.CancellationPolicy{Rules: rules}, "admin1")
+		assert.ErrorIs(t, err, ErrInvalidCancellationPolicy)
+	}
+}
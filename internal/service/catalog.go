@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// maxServiceMinutes caps how long a catalog service can take
+const maxServiceMinutes = 8 * 60
+
+// ListCatalogServices returns the services the shop offers, including the
+// inactive ones if asked to
+func (s *BookingService) ListCatalogServices(ctx context.Context, includeInactive bool) ([]*model.CatalogService, error) {
+	if s.catalogRepo == nil {
+		return nil, nil
+	}
+
+	services, err := s.catalogRepo.ListServices(ctx, !includeInactive)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list services")
+	}
+
+	return services, nil
+}
+
+// CreateCatalogService adds a service to the catalog
+func (s *BookingService) CreateCatalogService(ctx context.Context, service model.CatalogService) (*model.CatalogService, error) {
+	if s.catalogRepo == nil {
+		return nil, errors.New("service catalog storage is not configured")
+	}
+	if err := validateCatalogService(service); err != nil {
+		return nil, err
+	}
+
+	created, err := s.catalogRepo.CreateService(ctx, &service)
+	if err != nil {
+		if errors.Is(err, repository.ErrCatalogServiceExists) {
+			return nil, ErrCatalogServiceExists
+		}
+		return nil, errors.Wrap(err, "failed to create service")
+	}
+
+	log.Info().
+		Int("serviceType", int(created.ServiceType)).
+		Str("name", created.Name).
+		Int("durationMinutes", created.DurationMinutes).
+		Msg("Catalog service created")
+
+	return created, nil
+}
+
+// UpdateCatalogService replaces a catalog service's name, duration, price,
+// cleanup buffer and active flag. Existing bookings keep their times and
+// buffers.
+func (s *BookingService) UpdateCatalogService(ctx context.Context, service model.CatalogService) (*model.CatalogService, error) {
+	if s.catalogRepo == nil {
+		return nil, errors.New("service catalog storage is not configured")
+	}
+	if err := validateCatalogService(service); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.catalogRepo.UpdateService(ctx, &service)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update service")
+	}
+	if updated == nil {
+		return nil, ErrCatalogServiceNotFound
+	}
+
+	log.Info().
+		Int("serviceType", int(updated.ServiceType)).
+		Str("name", updated.Name).
+		Int("durationMinutes", updated.DurationMinutes).
+		Bool("active", updated.Active).
+		Msg("Catalog service updated")
+
+	return updated, nil
+}
+
+// DeleteCatalogService removes a service from the catalog, reporting whether
+// it was there. Its service type falls back to the built-in duration.
+func (s *BookingService) DeleteCatalogService(ctx context.Context, serviceType model.ServiceType) (bool, error) {
+	if s.catalogRepo == nil {
+		return false, errors.New("service catalog storage is not configured")
+	}
+
+	deleted, err := s.catalogRepo.DeleteService(ctx, serviceType)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete service")
+	}
+
+	return deleted, nil
+}
+
+// validateCatalogService checks a catalog service before it's stored
+func validateCatalogService(service model.CatalogService) error {
+	if service.ServiceType < 0 {
+		return errors.Wrap(ErrInvalidCatalogService, "service type can't be negative")
+	}
+	if service.Name == "" {
+		return errors.Wrap(ErrInvalidCatalogService, "name is required")
+	}
+	if service.DurationMinutes <= 0 || service.DurationMinutes > maxServiceMinutes {
+		return errors.Wrapf(ErrInvalidCatalogService, "duration must be between 1 and %d minutes", maxServiceMinutes)
+	}
+	if service.Price < 0 {
+		return errors.Wrap(ErrInvalidCatalogService, "price can't be negative")
+	}
+	if service.CleanupMinutes != nil && (*service.CleanupMinutes < 0 || *service.CleanupMinutes > model.MaxCleanupMinutes) {
+		return errors.Wrapf(ErrInvalidCatalogService, "cleanup must be between 0 and %d minutes", model.MaxCleanupMinutes)
+	}
+	return nil
+}
+
+// catalogServices returns the bookable services: the built-in ones, with the
+// catalog's entries replacing them or adding to them and its inactive ones
+// removed
+func (s *BookingService) catalogServices(ctx context.Context) (map[model.ServiceType]*model.OfferedService, error) {
+	offered := make(map[model.ServiceType]*model.OfferedService, len(model.BuiltInServiceTypes))
+	for _, serviceType := range model.BuiltInServiceTypes {
+		offered[serviceType] = &model.OfferedService{
+			ServiceType:     serviceType,
+			Name:            serviceType.String(),
+			DurationMinutes: serviceType.GetDuration(),
+			CleanupMinutes:  serviceType.GetCleanupBuffer(),
+		}
+	}
+
+	if s.catalogRepo == nil {
+		return offered, nil
+	}
+
+	services, err := s.catalogRepo.ListServices(ctx, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to look up service durations")
+	}
+	for _, service := range services {
+		if !service.Active {
+			delete(offered, service.ServiceType)
+			continue
+		}
+		offered[service.ServiceType] = &model.OfferedService{
+			ServiceType:     service.ServiceType,
+			Name:            service.Name,
+			DurationMinutes: service.DurationMinutes,
+			Price:           service.Price,
+			CleanupMinutes:  catalogCleanup(service),
+		}
+	}
+
+	return offered, nil
+}
+
+// catalogCleanup returns the minutes of cleanup a catalog service needs
+func catalogCleanup(service *model.CatalogService) int {
+	if service.CleanupMinutes != nil {
+		return *service.CleanupMinutes
+	}
+	return service.ServiceType.GetCleanupBuffer()
+}
+
+// maxCleanupBuffer returns the longest cleanup buffer of any service, retired
+// ones included, for widening searches so earlier bookings whose buffer runs
+// into a window are found
+func (s *BookingService) maxCleanupBuffer(ctx context.Context) (time.Duration, error) {
+	longest := 0
+	for _, serviceType := range model.BuiltInServiceTypes {
+		longest = max(longest, serviceType.GetCleanupBuffer())
+	}
+
+	if s.catalogRepo != nil {
+		services, err := s.catalogRepo.ListServices(ctx, false)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to look up cleanup buffers")
+		}
+		for _, service := range services {
+			longest = max(longest, catalogCleanup(service))
+		}
+	}
+
+	return time.Duration(longest) * time.Minute, nil
+}
+
+// GetQuote returns what the services would cost with the barber and how long
+// they'd take
+func (s *BookingService) GetQuote(ctx context.Context, barberID string, serviceTypes []model.ServiceType) (*model.Quote, error) {
+	if len(serviceTypes) == 0 {
+		return nil, ErrNoServices
+	}
+	return s.quote(ctx, barberID, serviceTypes)
+}
+
+// quote prices the services with the barber. Services the barber doesn't
+// offer, or that the catalog has retired, can't be booked.
+func (s *BookingService) quote(ctx context.Context, barberID string, serviceTypes []model.ServiceType) (*model.Quote, error) {
+	offered, err := s.offeredServices(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &model.Quote{
+		BarberID: barberID,
+		Services: make([]*model.OfferedService, len(serviceTypes)),
+		Currency: s.currency,
+	}
+	for i, serviceType := range serviceTypes {
+		service, ok := offered[serviceType]
+		if !ok {
+			return nil, errors.Wrapf(ErrServiceNotOffered, "%s", serviceType)
+		}
+		quote.Services[i] = service
+		quote.Price += service.Price
+	}
+
+	return quote, nil
+}
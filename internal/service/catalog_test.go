@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+type fakeCatalogRepo struct {
+	services map[model.ServiceType]*model.CatalogService
+}
+
+func (r *fakeCatalogRepo) ListServices(ctx context.Context, activeOnly bool) ([]*model.CatalogService, error) {
+	var services []*model.CatalogService
+	for _, service := range r.services {
+		if activeOnly && !service.Active {
+			continue
+		}
+		services = append(services, service)
+	}
+	return services, nil
+}
+
+func (r *fakeCatalogRepo) CreateService(ctx context.Context, service *model.CatalogService) (*model.CatalogService, error) {
+	r.services[service.ServiceType] = service
+	return service, nil
+}
+
+func (r *fakeCatalogRepo) UpdateService(ctx context.Context, service *model.CatalogService) (*model.CatalogService, error) {
+	if _, ok := r.services[service.ServiceType]; !ok {
+		return nil, nil
+	}
+	r.services[service.ServiceType] = service
+	return service, nil
+}
+
+func (r *fakeCatalogRepo) DeleteService(ctx context.Context, serviceType model.ServiceType) (bool, error) {
+	_, ok := r.services[serviceType]
+	delete(r.services, serviceType)
+	return ok, nil
+}
+
+func TestCatalog_ResolvesDurations(t *testing.T) {
+	catalog := &fakeCatalogRepo{services: map[model.ServiceType]*model.CatalogService{}}
+	s := NewBookingService(&fakeBookingRepo{}, WithCatalogRepository(catalog))
+	ctx := context.Background()
+
+	_, err := s.CreateCatalogService(ctx, model.CatalogService{
+		ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 45, Price: 2500, Active: true,
+	})
+	assert.NoError(t, err)
+
+	// The catalog's duration wins; services missing from it keep the built-in one
+	quote, err := s.quote(ctx, "barber1", []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim})
+	assert.NoError(t, err)
+	assert.Equal(t, 45*time.Minute+time.Duration(model.ServiceTypeBeardTrim.GetDuration())*time.Minute, quote.Duration())
+
+	// Retired services can't be booked
+	_, err = s.UpdateCatalogService(ctx, model.CatalogService{
+		ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 45, Price: 2500,
+	})
+	assert.NoError(t, err)
+
+	_, err = s.quote(ctx, "barber1", []model.ServiceType{model.ServiceTypeHaircut})
+	assert.ErrorIs(t, err, ErrServiceNotOffered)
+}
+
+func TestCatalog_Validation(t *testing.T) {
+	s := NewBookingService(&fakeBookingRepo{}, WithCatalogRepository(&fakeCatalogRepo{services: map[model.ServiceType]*model.CatalogService{}}))
+	ctx := context.Background()
+
+	_, err := s.CreateCatalogService(ctx, model.CatalogService{ServiceType: model.ServiceTypeHaircut, DurationMinutes: 30})
+	assert.ErrorIs(t, err, ErrInvalidCatalogService)
+
+	_, err = s.CreateCatalogService(ctx, model.CatalogService{ServiceType: model.ServiceTypeHaircut, Name: "Haircut"})
+	assert.ErrorIs(t, err, ErrInvalidCatalogService)
+
+	_, err = s.UpdateCatalogService(ctx, model.CatalogService{ServiceType: model.ServiceTypeHairWash, Name: "Hair Wash", DurationMinutes: 10})
+	assert.ErrorIs(t, err, ErrCatalogServiceNotFound)
+}
+
+// creatingBookingRepo stores created bookings alongside the fixed ones, and
+// the capacity and resources the last one was checked against
+type creatingBookingRepo struct {
+	fakeBookingRepo
+	capacity  int
+	resources []*model.Resource
+}
+
+func (r *creatingBookingRepo) CreateBooking(ctx context.Context, booking *model.Booking, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	r.bookings = append(r.bookings, booking)
+	r.capacity = capacity
+	r.resources = resources
+	return booking, nil
+}
+
+func TestCatalog_PricesBookings(t *testing.T) {
+	catalog := &fakeCatalogRepo{services: map[model.ServiceType]*model.CatalogService{
+		model.ServiceTypeHaircut:   {ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Price: 2500, Active: true},
+		model.ServiceTypeBeardTrim: {ServiceType: model.ServiceTypeBeardTrim, Name: "Beard Trim", DurationMinutes: 15, Price: 1200, Active: true},
+	}}
+	s := NewBookingService(&creatingBookingRepo{}, WithCatalogRepository(catalog), WithCurrency("EUR"),
+		WithClock(clockAt(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC))))
+	ctx := context.Background()
+	services := []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim}
+
+	quote, err := s.GetQuote(ctx, "barber1", services)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3700), quote.Price)
+	assert.Equal(t, "EUR", quote.Currency)
+	assert.Equal(t, 45*time.Minute, quote.Duration())
+
+	booking, err := s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: services,
+		StartTime:    time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3700), booking.Price)
+	assert.Equal(t, "EUR", booking.Currency)
+	assert.Equal(t, booking.StartTime.Add(45*time.Minute), booking.EndTime)
+
+	_, err = s.GetQuote(ctx, "barber1", nil)
+	assert.ErrorIs(t, err, ErrNoServices)
+}
+
+func TestCatalog_CleanupBuffers(t *testing.T) {
+	cleanup := 15
+	catalog := &fakeCatalogRepo{services: map[model.ServiceType]*model.CatalogService{
+		model.ServiceTypeHaircut: {ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Price: 2500, CleanupMinutes: &cleanup, Active: true},
+	}}
+	ten := time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC)
+	repo := &creatingBookingRepo{fakeBookingRepo: fakeBookingRepo{bookings: []*model.Booking{{
+		ID:             primitive.NewObjectID(),
+		BarberID:       "barber1",
+		StartTime:      ten,
+		EndTime:        ten.Add(30 * time.Minute),
+		ServiceType:    model.ServiceTypeHaircut,
+		CleanupMinutes: &cleanup,
+		Status:         model.BookingStatusConfirmed,
+	}}}}
+	s := NewBookingService(repo, WithCatalogRepository(catalog),
+		WithClock(clockAt(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC))))
+	ctx := context.Background()
+
+	// The catalog's buffer keeps the barber busy after the haircut ends
+	_, err := s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		StartTime:    ten.Add(30 * time.Minute),
+	})
+	assert.ErrorIs(t, err, ErrSlotUnavailable)
+
+	// New bookings record it
+	booking, err := s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		StartTime:    ten.Add(45 * time.Minute),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, booking.CleanupMinutes)
+	assert.Equal(t, 15, *booking.CleanupMinutes)
+	assert.Equal(t, ten.Add(90*time.Minute), booking.OccupiedUntil())
+
+	// Buffers are capped
+	tooLong := model.MaxCleanupMinutes + 1
+	_, err = s.UpdateCatalogService(ctx, model.CatalogService{
+		ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Price: 2500, CleanupMinutes: &tooLong, Active: true,
+	})
+	assert.ErrorIs(t, err, ErrInvalidCatalogService)
+}
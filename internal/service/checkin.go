@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+)
+
+// CheckInBooking records that the customer has arrived for a booking.
+// Checking in an already checked-in booking is a no-op.
+func (s *BookingService) CheckInBooking(ctx context.Context, id string) (*model.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+
+	if booking.Status == model.BookingStatusCancelled {
+		return nil, ErrBookingCancelled
+	}
+	if booking.Status == model.BookingStatusNoShow {
+		return nil, ErrBookingNoShow
+	}
+	if booking.ReleasedAt != nil {
+		return nil, ErrSlotReleased
+	}
+	if booking.CheckedInAt != nil {
+		return booking, nil
+	}
+
+	updatedBooking, err := s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+		return s.repo.UpdateBooking(ctx, id, map[string]interface{}{
+			"checkedInAt": s.clock.Now(),
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check in booking")
+	}
+	if updatedBooking == nil {
+		return nil, ErrBookingNotFound
+	}
+
+	log.Info().
+		Str("bookingID", id).
+		Msg("Customer checked in")
+
+	s.recordHistory(ctx, model.BookingActionCheckedIn, booking, updatedBooking)
+
+	return updatedBooking, nil
+}
+
+// ReleaseLateBookings frees the remaining time of bookings whose customer
+// hasn't checked in within the grace period, so it shows up as available
+// again, and notifies the barber. It returns the number of released bookings.
+func (s *BookingService) ReleaseLateBookings(ctx context.Context) (int, error) {
+	if s.lateGracePeriod <= 0 {
+		return 0, nil
+	}
+
+	now := s.clock.Now()
+	bookings, err := s.repo.FindLateBookings(ctx, now.Add(-s.lateGracePeriod), now)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find late bookings")
+	}
+
+	released := 0
+	for _, booking := range bookings {
+		// Slots start on whole minutes; the booking keeps its times for
+		// payroll and stats
+		releasedAt := now.Truncate(time.Minute)
+		if releasedAt.Before(booking.StartTime) {
+			releasedAt = booking.StartTime
+		}
+
+		// Unless the customer checked in since the booking was found
+		updatedBooking, err := s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+			return s.repo.UpdateBookingIfUnset(ctx, booking.ID.Hex(), []string{"checkedInAt", "releasedAt"}, map[string]interface{}{
+				"releasedAt": releasedAt,
+			})
+		})
+		if err != nil {
+			return released, errors.Wrap(err, "failed to release booking")
+		}
+		if updatedBooking == nil {
+			continue
+		}
+		released++
+
+		s.recordHistory(ctx, model.BookingActionReleased, booking, updatedBooking)
+
+		log.Info().
+			Str("bookingID", booking.ID.Hex()).
+			Str("barberID", booking.BarberID).
+			Time("releasedFrom", releasedAt).
+			Msg("Late booking released")
+
+		if s.notifier == nil {
+			continue
+		}
+
+		err = s.notifier.Notify(ctx, notification.Message{
+			Kind:      notification.KindSlotReleased,
+			UserID:    booking.BarberID,
+			BookingID: booking.ID.Hex(),
+			Subject:   "Late arrival: slot released",
+			Body: fmt.Sprintf("The customer for the %s at %s hasn't checked in. The time until %s is available again.",
+				model.DescribeServices(booking.Services()), booking.StartTime.Format("15:04"), booking.EndTime.Format("15:04")),
+		})
+		if err != nil {
+			log.Error().Err(err).Str("bookingID", booking.ID.Hex()).Msg("Failed to notify barber of released slot")
+		}
+	}
+
+	return released, nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// lateBookingRepo finds every booking as late and releases those without a
+// check-in or an earlier release
+type lateBookingRepo struct {
+	fakeBookingRepo
+}
+
+func (r *lateBookingRepo) FindLateBookings(ctx context.Context, startedBefore, now time.Time) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		// Copies, as read before anything changes them
+		booking := *b
+		bookings = append(bookings, &booking)
+	}
+	return bookings, nil
+}
+
+func (r *lateBookingRepo) UpdateBookingIfUnset(ctx context.Context, id string, fields []string, updates map[string]interface{}) (*model.Booking, error) {
+	for _, b := range r.bookings {
+		if b.ID.Hex() != id || b.CheckedInAt != nil || b.ReleasedAt != nil {
+			continue
+		}
+		releasedAt := updates["releasedAt"].(time.Time)
+		b.ReleasedAt = &releasedAt
+		return b, nil
+	}
+	return nil, nil
+}
+
+func TestReleaseLateBookings(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 10, 20, 30, 0, time.UTC)
+	book := func(start time.Time) *model.Booking {
+		return &model.Booking{
+			ID:          primitive.NewObjectID(),
+			BarberID:    "barber1",
+			ServiceType: model.ServiceTypeHaircut,
+			StartTime:   start,
+			EndTime:     start.Add(30 * time.Minute),
+			Status:      model.BookingStatusConfirmed,
+		}
+	}
+	late := book(now.Add(-20 * time.Minute).Truncate(time.Minute))
+	// Starting within the minute the release runs in
+	justStarted := book(now.Add(-10 * time.Second))
+	checkedIn := book(now.Add(-15 * time.Minute))
+
+	repo := &lateBookingRepo{}
+	repo.bookings = []*model.Booking{late, justStarted, checkedIn}
+	clock := clockAt(now)
+	s := NewBookingService(repo, WithClock(clock), WithLateArrivalRelease(time.Second))
+
+	// The customer checks in after the booking was found late
+	checkedInAt := now
+	checkedIn.CheckedInAt = &checkedInAt
+
+	released, err := s.ReleaseLateBookings(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, released)
+
+	// Bookings keep their times and are released on the minute
+	assert.Equal(t, now.Truncate(time.Minute), *late.ReleasedAt)
+	assert.Equal(t, now.Add(-20*time.Minute).Truncate(time.Minute).Add(30*time.Minute), late.EndTime)
+	assert.Equal(t, model.CalculateOccupiedUntil(now.Truncate(time.Minute), model.ServiceTypeHaircut.GetCleanupBuffer()), late.OccupiedUntil())
+
+	// But never before they start
+	assert.Equal(t, justStarted.StartTime, *justStarted.ReleasedAt)
+
+	assert.Nil(t, checkedIn.ReleasedAt)
+}
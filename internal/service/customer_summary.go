@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// GetCustomerSummary sums up a customer's visits: how many, when the last
+// was, the service they usually book and how often they come
+func (s *BookingService) GetCustomerSummary(ctx context.Context, userID string) (*model.CustomerSummary, error) {
+	summary, err := s.repo.GetCustomerSummary(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get customer summary")
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// deletedPurgeBatchSize caps how many soft-deleted bookings one sweep purges
+const deletedPurgeBatchSize = 100
+
+// PurgeDeletedBookings permanently removes bookings soft-deleted longer than
+// the deleted booking retention period ago, along with their uploaded files
+// and history. It returns the number of purged bookings.
+func (s *BookingService) PurgeDeletedBookings(ctx context.Context) (int, error) {
+	bookings, err := s.repo.FindDeletedBookings(ctx, s.clock.Now().Add(-s.deletedRetention), deletedPurgeBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find deleted bookings")
+	}
+	if len(bookings) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]string, len(bookings))
+	for i, booking := range bookings {
+		if err := s.deleteStoredAttachments(ctx, booking); err != nil {
+			return 0, err
+		}
+		ids[i] = booking.ID.Hex()
+	}
+
+	purged, err := s.repo.DeleteBookings(ctx, ids)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to purge deleted bookings")
+	}
+
+	if s.historyRepo != nil {
+		if _, err := s.historyRepo.DeleteBookingHistory(ctx, ids); err != nil {
+			return int(purged), errors.Wrap(err, "failed to delete booking history")
+		}
+	}
+
+	log.Info().
+		Int64("purged", purged).
+		Msg("Deleted bookings purged")
+
+	return int(purged), nil
+}
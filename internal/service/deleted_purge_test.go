@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// softDeletingRepo soft-deletes and purges bookings in memory
+type softDeletingRepo struct {
+	fakeBookingRepo
+}
+
+func (r *softDeletingRepo) GetBookingByID(ctx context.Context, id string) (*model.Booking, error) {
+	for _, b := range r.bookings {
+		if b.ID.Hex() == id && b.DeletedAt == nil {
+			return b, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *softDeletingRepo) SoftDeleteBooking(ctx context.Context, id string, deletedAt time.Time) (*model.Booking, error) {
+	booking, _ := r.GetBookingByID(ctx, id)
+	if booking != nil {
+		booking.DeletedAt = &deletedAt
+	}
+	return booking, nil
+}
+
+func (r *softDeletingRepo) FindDeletedBookings(ctx context.Context, deletedBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		if b.DeletedAt != nil && b.DeletedAt.Before(deletedBefore) {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func (r *softDeletingRepo) DeleteBookings(ctx context.Context, ids []string) (int64, error) {
+	var purged int64
+	for _, id := range ids {
+		for i, b := range r.bookings {
+			if b.ID.Hex() == id {
+				r.bookings = append(r.bookings[:i], r.bookings[i+1:]...)
+				purged++
+				break
+			}
+		}
+	}
+	return purged, nil
+}
+
+func TestDeleteBooking_PurgedAfterRetention(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	booking := &model.Booking{ID: primitive.NewObjectID(), UserID: "user1", BarberID: "barber1", StartTime: now.Add(24 * time.Hour)}
+	repo := &softDeletingRepo{}
+	repo.bookings = []*model.Booking{booking}
+
+	clk := clock.NewFake(now)
+	s := NewBookingService(repo, WithDeletedRetention(7*24*time.Hour), WithClock(clk))
+	ctx := context.Background()
+
+	deleted, err := s.DeleteBooking(ctx, booking.ID.Hex())
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, &now, booking.DeletedAt)
+
+	// Deleting it again finds nothing
+	deleted, err = s.DeleteBooking(ctx, booking.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	// Kept for the retention period
+	clk.Set(now.Add(6 * 24 * time.Hour))
+	purged, err := s.PurgeDeletedBookings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, purged)
+	assert.Len(t, repo.bookings, 1)
+
+	// And purged after it
+	clk.Set(now.Add(8 * 24 * time.Hour))
+	purged, err = s.PurgeDeletedBookings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Empty(t, repo.bookings)
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+	"github.com/ita-av/booking-service/internal/payment"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// requestDeposit starts collecting a new booking's deposit, if deposits are
+// enabled and the booking has a price
+func (s *BookingService) requestDeposit(ctx context.Context, booking *model.Booking) error {
+	if s.paymentProvider == nil || booking.Price <= 0 || !s.featureEnabled(ctx, FlagDeposits) {
+		return nil
+	}
+
+	amount := int64(math.Round(float64(booking.Price) * s.depositRate))
+	if amount <= 0 {
+		return nil
+	}
+
+	required, err := s.requiresDeposit(ctx, booking.UserID)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return nil
+	}
+
+	// The intent is tagged with the booking, so it needs its ID before it's stored
+	if booking.ID.IsZero() {
+		booking.ID = primitive.NewObjectID()
+	}
+
+	intent, err := s.paymentProvider.CreateIntent(ctx, payment.IntentParams{
+		BookingID: booking.ID.Hex(),
+		TenantID:  tenant.FromContext(ctx),
+		Amount:    amount,
+		Currency:  booking.Currency,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to request deposit")
+	}
+
+	// Unpaid bookings shouldn't hold the slot past their start
+	expiresAt := s.clock.Now().Add(s.depositTimeout)
+	if booking.StartTime.Before(expiresAt) {
+		expiresAt = booking.StartTime
+	}
+
+	booking.Deposit = &model.Deposit{
+		PaymentIntentID: intent.ID,
+		ClientSecret:    intent.ClientSecret,
+		Amount:          amount,
+		Currency:        booking.Currency,
+		Status:          model.DepositStatusPending,
+		ExpiresAt:       expiresAt,
+	}
+
+	return nil
+}
+
+// cancelDeposit stops collecting a booking's unpaid deposit. Failures are
+// logged; an abandoned intent can't be paid once the booking is gone.
+func (s *BookingService) cancelDeposit(ctx context.Context, booking *model.Booking) {
+	if s.paymentProvider == nil || booking.Deposit == nil || booking.Deposit.Status == model.DepositStatusPaid {
+		return
+	}
+
+	if err := s.paymentProvider.CancelIntent(ctx, booking.Deposit.PaymentIntentID); err != nil {
+		log.Error().Err(err).
+			Str("bookingID", booking.ID.Hex()).
+			Str("paymentIntentID", booking.Deposit.PaymentIntentID).
+			Msg("Failed to cancel deposit payment")
+	}
+}
+
+// RecordDepositPayment marks a booking's deposit as paid and confirms the
+// booking. Recording the same payment again returns the booking unchanged.
+func (s *BookingService) RecordDepositPayment(ctx context.Context, bookingID, intentID string, amount int64, currency string) (*model.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+
+	deposit := booking.Deposit
+	if deposit == nil || deposit.PaymentIntentID != intentID {
+		return nil, ErrDepositMismatch
+	}
+	if deposit.Status == model.DepositStatusPaid {
+		return booking, nil
+	}
+	if amount < deposit.Amount || currency != deposit.Currency {
+		return nil, errors.Wrapf(ErrDepositMismatch, "paid %d %s, expected %d %s", amount, currency, deposit.Amount, deposit.Currency)
+	}
+	if booking.Status == model.BookingStatusCancelled {
+		// Paid after the booking expired or was cancelled; it has to be refunded
+		log.Warn().
+			Str("bookingID", bookingID).
+			Str("paymentIntentID", intentID).
+			Int64("amount", amount).
+			Msg("Deposit paid for a cancelled booking")
+		return nil, ErrBookingCancelled
+	}
+
+	paidAt := s.clock.Now()
+	updates := map[string]interface{}{
+		"deposit.status": model.DepositStatusPaid,
+		"deposit.paidAt": paidAt,
+	}
+
+	var updatedBooking *model.Booking
+	if booking.Status == model.BookingStatusPending {
+		updatedBooking, err = s.transitionStatus(ctx, booking, model.BookingStatusConfirmed, updates)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Already confirmed by the barber
+		updatedBooking, err = s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+			return s.repo.UpdateBooking(ctx, bookingID, updates)
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to record deposit payment")
+		}
+		if updatedBooking == nil {
+			return nil, ErrBookingNotFound
+		}
+
+		s.recordHistory(ctx, model.BookingActionDepositPaid, booking, updatedBooking)
+	}
+
+	log.Info().
+		Str("bookingID", bookingID).
+		Str("paymentIntentID", intentID).
+		Int64("amount", amount).
+		Str("currency", currency).
+		Msg("Deposit paid")
+
+	return updatedBooking, nil
+}
+
+// ExpireUnpaidBookings cancels pending bookings whose deposit wasn't paid in
+// time, freeing their slots
+func (s *BookingService) ExpireUnpaidBookings(ctx context.Context) (int, error) {
+	if s.paymentProvider == nil {
+		return 0, nil
+	}
+
+	bookings, err := s.repo.FindUnpaidBookings(ctx, s.clock.Now())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find unpaid bookings")
+	}
+
+	expired := 0
+	for _, booking := range bookings {
+		// Expiry isn't a customer cancellation, so it may happen after the start
+		cancelledBooking, err := s.changeBooking(ctx, BookingCancelled, func(ctx context.Context) (*model.Booking, error) {
+			return s.repo.TransitionBookingStatus(ctx, booking.ID.Hex(), model.BookingStatusPending, model.BookingStatusCancelled, map[string]interface{}{
+				"deposit.status": model.DepositStatusExpired,
+				"cancellation": &model.Cancellation{
+					CancelledAt: s.clock.Now(),
+					Reason:      "deposit not paid",
+				},
+			})
+		})
+		if err != nil {
+			return expired, errors.Wrap(err, "failed to expire booking")
+		}
+		if cancelledBooking == nil {
+			// Paid or changed in the meantime
+			continue
+		}
+		expired++
+
+		s.recordHistory(ctx, model.BookingActionCancelled, booking, cancelledBooking)
+		s.cancelDeposit(ctx, booking)
+		if err := s.cleanupAttachments(ctx, cancelledBooking); err != nil {
+			log.Error().Err(err).Str("bookingID", booking.ID.Hex()).Msg("Failed to clean up booking attachments")
+		}
+
+		log.Info().
+			Str("bookingID", booking.ID.Hex()).
+			Str("userID", booking.UserID).
+			Msg("Unpaid booking expired")
+
+		if s.notifier == nil {
+			continue
+		}
+
+		err = s.notifier.Notify(ctx, notification.Message{
+			Kind:      notification.KindDepositUnpaid,
+			UserID:    booking.UserID,
+			BookingID: booking.ID.Hex(),
+			Subject:   "Booking cancelled: deposit not paid",
+			Body: fmt.Sprintf("Your %s on %s was cancelled because the deposit wasn't paid in time.",
+				model.DescribeServices(booking.Services()), booking.StartTime.Format("Mon 2 Jan 15:04")),
+		})
+		if err != nil {
+			log.Error().Err(err).Str("bookingID", booking.ID.Hex()).Msg("Failed to notify customer of expired booking")
+		}
+	}
+
+	return expired, nil
+}
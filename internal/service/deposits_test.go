@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/payment"
+)
+
+// fakeProvider hands out intents and records cancellations
+type fakeProvider struct {
+	cancelled []string
+}
+
+func (p *fakeProvider) CreateIntent(ctx context.Context, params payment.IntentParams) (*payment.Intent, error) {
+	return &payment.Intent{ID: "pi_" + params.BookingID, ClientSecret: "secret"}, nil
+}
+
+func (p *fakeProvider) CancelIntent(ctx context.Context, intentID string) error {
+	p.cancelled = append(p.cancelled, intentID)
+	return nil
+}
+
+func (p *fakeProvider) ParseEvent(payload []byte, header http.Header) (*payment.Event, error) {
+	return nil, payment.ErrInvalidSignature
+}
+
+// depositBookingRepo supports the status changes deposits make
+type depositBookingRepo struct {
+	creatingBookingRepo
+}
+
+func (r *depositBookingRepo) GetBookingByID(ctx context.Context, id string) (*model.Booking, error) {
+	for _, b := range r.bookings {
+		if b.ID.Hex() == id {
+			return b, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *depositBookingRepo) TransitionBookingStatus(ctx context.Context, id string, from, to model.BookingStatus, updates map[string]interface{}) (*model.Booking, error) {
+	b, _ := r.GetBookingByID(ctx, id)
+	if b == nil || b.Status != from {
+		return nil, nil
+	}
+	b.Status = to
+	if status, ok := updates["deposit.status"]; ok {
+		b.Deposit.Status = status.(model.DepositStatus)
+	}
+	if cancellation, ok := updates["cancellation"]; ok {
+		b.Cancellation = cancellation.(*model.Cancellation)
+	}
+	return b, nil
+}
+
+func (r *depositBookingRepo) FindUnpaidBookings(ctx context.Context, expiredBefore time.Time) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		if b.Status == model.BookingStatusPending && b.Deposit != nil &&
+			b.Deposit.Status == model.DepositStatusPending && b.Deposit.ExpiresAt.Before(expiredBefore) {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func TestDeposits_PayOrExpire(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(now)
+	provider := &fakeProvider{}
+
+	// Built-in services have no price, so the catalog has to set one for a deposit
+	catalog := &fakeCatalogRepo{services: map[model.ServiceType]*model.CatalogService{
+		model.ServiceTypeHaircut: {ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Price: 2500, Active: true},
+	}}
+	s := NewBookingService(&depositBookingRepo{}, WithCatalogRepository(catalog), WithCurrency("EUR"),
+		WithDeposits(provider, 0.5, 15*time.Minute), WithClock(clk))
+	ctx := context.Background()
+
+	create := func(start time.Time) *model.Booking {
+		booking, err := s.CreateBooking(ctx, CreateBookingParams{
+			UserID:       "user1",
+			BarberID:     "barber1",
+			ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+			StartTime:    start,
+		})
+		assert.NoError(t, err)
+		return booking
+	}
+
+	paid := create(time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC))
+	assert.Equal(t, &model.Deposit{
+		PaymentIntentID: "pi_" + paid.ID.Hex(),
+		ClientSecret:    "secret",
+		Amount:          1250,
+		Currency:        "EUR",
+		Status:          model.DepositStatusPending,
+		ExpiresAt:       now.Add(15 * time.Minute),
+	}, paid.Deposit)
+
+	// Underpaying doesn't confirm the booking
+	_, err := s.RecordDepositPayment(ctx, paid.ID.Hex(), paid.Deposit.PaymentIntentID, 1000, "EUR")
+	assert.ErrorIs(t, err, ErrDepositMismatch)
+
+	confirmed, err := s.RecordDepositPayment(ctx, paid.ID.Hex(), paid.Deposit.PaymentIntentID, 1250, "EUR")
+	assert.NoError(t, err)
+	assert.Equal(t, model.BookingStatusConfirmed, confirmed.Status)
+	assert.Equal(t, model.DepositStatusPaid, confirmed.Deposit.Status)
+
+	unpaid := create(time.Date(2025, time.June, 2, 11, 0, 0, 0, time.UTC))
+
+	// Nothing expires before the deadline
+	expired, err := s.ExpireUnpaidBookings(ctx)
+	assert.NoError(t, err)
+	assert.Zero(t, expired)
+
+	clk.Set(now.Add(16 * time.Minute))
+	expired, err = s.ExpireUnpaidBookings(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Equal(t, model.BookingStatusCancelled, unpaid.Status)
+	assert.Equal(t, model.DepositStatusExpired, unpaid.Deposit.Status)
+	assert.Equal(t, []string{unpaid.Deposit.PaymentIntentID}, provider.cancelled)
+
+	// A late payment for the expired booking isn't applied
+	_, err = s.RecordDepositPayment(ctx, unpaid.ID.Hex(), unpaid.Deposit.PaymentIntentID, 1250, "EUR")
+	assert.ErrorIs(t, err, ErrBookingCancelled)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// Domain errors returned by the booking service
+var (
+	ErrBookingNotFound         = errors.New("booking not found")
+	ErrExternalRefConflict     = errors.New("external reference is already attached to another booking")
+	ErrIdempotencyKeyReused    = errors.New("idempotency key was already used for a different booking")
+	ErrDuplicateBooking        = errors.New("an identical booking was just created")
+	ErrBookingCancelled        = errors.New("booking is cancelled")
+	ErrBookingAlreadyPaid      = errors.New("booking has already been paid")
+	ErrInvalidAttachment       = errors.New("invalid attachment")
+	ErrTooManyAttachments      = errors.New("booking has too many attachments")
+	ErrSurveyNotFound          = errors.New("survey not found or already answered")
+	ErrInvalidSurveyScore      = errors.New("survey score must be between 1 and 5")
+	ErrInvalidReviewRating     = errors.New("review rating must be between 1 and 5")
+	ErrReviewExists            = errors.New("booking has already been reviewed")
+	ErrBookingNotCompleted     = errors.New("only completed bookings can be reviewed")
+	ErrInvalidStatusTransition = errors.New("booking cannot move to the requested status")
+	ErrBookingNotEnded         = errors.New("booking has not ended yet")
+	ErrBookingCompleted        = errors.New("booking is completed")
+	ErrBookingNoShow           = errors.New("booking was marked as a no-show")
+	ErrCustomerCheckedIn       = errors.New("customer has checked in")
+	ErrNoServices              = errors.New("at least one service is required")
+	ErrServiceNotOffered       = errors.New("service is not currently offered")
+	ErrSlotUnavailable         = errors.New("barber is not available at the requested time")
+	ErrSlotBusy                = errors.New("barber's schedule is being changed by another booking, try again")
+	ErrHoldNotFound            = errors.New("hold not found")
+	ErrHoldExpired             = errors.New("hold has expired")
+	ErrInvalidHoldDuration     = errors.New("hold duration is out of range")
+	ErrBookingHeld             = errors.New("slot is only held; book it with CreateBooking first")
+	ErrBookingModified         = errors.New("booking was modified concurrently")
+	ErrSlotReleased            = errors.New("booking slot was released after the late-arrival grace period")
+	ErrDuringBreak             = errors.New("barber is on a break at the requested time")
+	ErrShopClosed              = errors.New("shop is closed for a holiday on the requested date")
+	ErrStartTimeInPast         = errors.New("start time is in the past")
+	ErrDateInPast              = errors.New("requested date is in the past")
+	ErrInvalidDateRange        = errors.New("invalid date range")
+	ErrInvalidHeatmapWeeks     = errors.New("invalid heatmap lookback")
+	ErrBookingTooSoon          = errors.New("booking starts too soon")
+	ErrBookingTooFarAhead      = errors.New("booking starts too far in the future")
+	ErrDepositMismatch         = errors.New("payment does not match the booking's deposit")
+
+	ErrPayrollPeriodOpen      = errors.New("payroll period has not ended yet")
+	ErrPayrollPeriodFinalized = errors.New("payroll period is already finalized")
+
+	ErrInvalidRetentionPolicy = errors.New("invalid retention policy")
+	ErrUserIDRequired         = errors.New("user ID is required")
+	ErrInvalidErasureMode     = errors.New("invalid erasure mode")
+	ErrInvalidWorkingHours    = errors.New("invalid working hours")
+	ErrInvalidPolicy          = errors.New("invalid scheduling policy")
+
+	ErrInvalidCancellationPolicy = errors.New("invalid cancellation policy")
+	ErrCancellationNotAllowed    = errors.New("booking can no longer be cancelled")
+
+	ErrInvalidCatalogService  = errors.New("invalid catalog service")
+	ErrCatalogServiceExists   = errors.New("service is already in the catalog")
+	ErrCatalogServiceNotFound = errors.New("service not found in the catalog")
+	ErrInvalidBarberServices  = errors.New("invalid barber services")
+
+	ErrInvalidBarber  = errors.New("invalid barber")
+	ErrBarberExists   = errors.New("barber already exists")
+	ErrBarberNotFound = errors.New("barber not found")
+	ErrBarberInactive = errors.New("barber is not taking bookings")
+
+	ErrNothingToRebook = errors.New("no completed booking to book again")
+	ErrNoSlotToRebook  = errors.New("barber has no free slot for the same services")
+
+	ErrInvalidResource     = errors.New("invalid resource")
+	ErrResourceExists      = errors.New("resource already exists")
+	ErrResourceNotFound    = errors.New("resource not found")
+	ErrResourceUnavailable = errors.New("resource is fully booked at the requested time")
+
+	ErrInvalidWebhook = errors.New("invalid webhook")
+
+	ErrInvalidPromoCode     = errors.New("invalid promo code")
+	ErrPromoCodeExists      = errors.New("promo code already exists")
+	ErrPromoCodeNotFound    = errors.New("promo code not found")
+	ErrPromoCodeUnavailable = errors.New("promo code is not valid or has been used up")
+)
+
+// SlotUnavailableError is the ErrSlotUnavailable returned when a booking
+// would overlap others. It says when the overlapping bookings take place and
+// suggests free slots to book instead.
+type SlotUnavailableError struct {
+	// Conflicts are the times of the overlapping bookings
+	Conflicts []*model.TimeSlot
+	// Alternatives are the barber's next free slots with room for the same services
+	Alternatives []*model.TimeSlot
+}
+
+func (e *SlotUnavailableError) Error() string {
+	return ErrSlotUnavailable.Error()
+}
+
+// Unwrap makes the error match ErrSlotUnavailable
+func (e *SlotUnavailableError) Unwrap() error {
+	return ErrSlotUnavailable
+}
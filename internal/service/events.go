@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// eventBufferSize is how many events a subscriber can fall behind before
+// further events are dropped for it
+const eventBufferSize = 64
+
+// BookingEventType identifies what happened to a booking
+type BookingEventType int
+
+// Constants for BookingEventType
+const (
+	BookingCreated BookingEventType = iota
+	BookingUpdated
+	BookingCancelled
+	BookingRescheduled
+	BookingConfirmed
+)
+
+// String returns the name other services know the event type by, e.g.
+// booking.created
+func (t BookingEventType) String() string {
+	switch t {
+	case BookingCreated:
+		return model.WebhookEventBookingCreated
+	case BookingUpdated:
+		return model.WebhookEventBookingUpdated
+	case BookingCancelled:
+		return model.WebhookEventBookingCancelled
+	case BookingRescheduled:
+		return model.WebhookEventBookingRescheduled
+	case BookingConfirmed:
+		return model.WebhookEventBookingConfirmed
+	default:
+		return "booking.unknown"
+	}
+}
+
+// BookingEvent is a change to a booking pushed to watchers. Tenant is the
+// shop the booking belongs to.
+type BookingEvent struct {
+	ID         string
+	Type       BookingEventType
+	Booking    *model.Booking
+	OccurredAt time.Time
+	Tenant     string
+}
+
+// EventPublisher sends booking events to other services, e.g. through a
+// message broker
+type EventPublisher interface {
+	Publish(ctx context.Context, event BookingEvent) error
+}
+
+// eventBus fans booking events out to in-process subscribers
+type eventBus struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+// subscriber receives the events for a user's or a barber's bookings at a
+// tenant, or with allTenants, every event
+type subscriber struct {
+	tenant     string
+	allTenants bool
+	userID     string
+	barberID   string
+	events     chan BookingEvent
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// subscribe registers a subscriber to the events of the tenant ctx is scoped
+// to until ctx is done, then closes its channel
+func (b *eventBus) subscribe(ctx context.Context, userID, barberID string) <-chan BookingEvent {
+	return b.add(ctx, &subscriber{
+		tenant:   tenant.FromContext(ctx),
+		userID:   userID,
+		barberID: barberID,
+	})
+}
+
+// subscribeAll registers a subscriber to every tenant's events until ctx is
+// done, then closes its channel
+func (b *eventBus) subscribeAll(ctx context.Context) <-chan BookingEvent {
+	return b.add(ctx, &subscriber{allTenants: true})
+}
+
+// add registers sub until ctx is done
+func (b *eventBus) add(ctx context.Context, sub *subscriber) <-chan BookingEvent {
+	sub.events = make(chan BookingEvent, eventBufferSize)
+
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		delete(b.subscribers, sub)
+		close(sub.events)
+		b.mu.Unlock()
+	}()
+
+	return sub.events
+}
+
+// publish delivers an event to every matching subscriber without blocking
+func (b *eventBus) publish(event BookingEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscribers {
+		if !sub.allTenants && sub.tenant != event.Tenant {
+			continue
+		}
+		if sub.userID != "" && sub.userID != event.Booking.UserID {
+			continue
+		}
+		if sub.barberID != "" && sub.barberID != event.Booking.BarberID {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			log.Warn().
+				Str("bookingID", event.Booking.ID.Hex()).
+				Msg("Dropped booking event for slow watcher")
+		}
+	}
+}
+
+// WatchBookings streams changes to a user's or a barber's bookings until ctx
+// is done. Events are delivered in-process, so only changes made through
+// this service instance are seen, unless WithExternalEvents feeds watchers
+// every change.
+func (s *BookingService) WatchBookings(ctx context.Context, userID, barberID string) <-chan BookingEvent {
+	return s.events.subscribe(ctx, userID, barberID)
+}
+
+// WatchAllBookings streams changes to every tenant's bookings until ctx is
+// done, for in-process consumers such as the webhook dispatcher
+func (s *BookingService) WatchAllBookings(ctx context.Context) <-chan BookingEvent {
+	return s.events.subscribeAll(ctx)
+}
+
+// parseBookingEventType returns the event type with a name, e.g. booking.created
+func parseBookingEventType(name string) (BookingEventType, bool) {
+	for t := BookingCreated; t <= BookingConfirmed; t++ {
+		if t.String() == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// newEvent returns the event of a change to a booking of the tenant ctx is
+// scoped to
+func (s *BookingService) newEvent(ctx context.Context, eventType BookingEventType, booking *model.Booking) BookingEvent {
+	return BookingEvent{
+		ID:         newEventID(),
+		Type:       eventType,
+		Booking:    booking,
+		OccurredAt: s.clock.Now().UTC(),
+		Tenant:     tenant.FromContext(ctx),
+	}
+}
+
+// Broadcast delivers an event to this instance's watchers. With
+// WithExternalEvents, it's how they get any events.
+func (s *BookingService) Broadcast(event BookingEvent) {
+	s.events.publish(event)
+}
+
+// publishEvent notifies watchers of a booking change and, without an outbox
+// relaying events, hands it to the event publisher. The change is already
+// stored, so failing to publish it is only logged.
+func (s *BookingService) publishEvent(ctx context.Context, event BookingEvent) {
+	if !s.externalEvents {
+		s.events.publish(event)
+	}
+
+	if s.publisher == nil || s.outboxRepo != nil {
+		return
+	}
+	// A caller hanging up after the change was stored mustn't drop its event
+	if err := s.publisher.Publish(context.WithoutCancel(ctx), event); err != nil {
+		log.Error().Err(err).
+			Str("bookingID", event.Booking.ID.Hex()).
+			Str("event", event.Type.String()).
+			Msg("Failed to publish booking event")
+	}
+}
+
+// newEventID returns a random ID for an event, letting consumers drop
+// duplicate deliveries
+func newEventID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+func TestEventBus_FiltersBySubscriber(t *testing.T) {
+	bus := newEventBus()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	barberEvents := bus.subscribe(ctx, "", "barber1")
+	userEvents := bus.subscribe(ctx, "user2", "")
+
+	booking := &model.Booking{ID: primitive.NewObjectID(), UserID: "user1", BarberID: "barber1"}
+	bus.publish(BookingEvent{Type: BookingCreated, Booking: booking})
+
+	event := <-barberEvents
+	assert.Equal(t, BookingCreated, event.Type)
+	assert.Equal(t, booking, event.Booking)
+	assert.Len(t, userEvents, 0)
+
+	// Cancelling the context unsubscribes and closes the channel
+	cancel()
+	_, ok := <-barberEvents
+	assert.False(t, ok)
+}
+
+func TestEventBus_FiltersByTenant(t *testing.T) {
+	bus := newEventBus()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	acmeEvents := bus.subscribe(tenant.WithID(ctx, "acme"), "", "barber1")
+	defaultEvents := bus.subscribe(ctx, "", "barber1")
+	allEvents := bus.subscribeAll(ctx)
+
+	booking := &model.Booking{ID: primitive.NewObjectID(), UserID: "user1", BarberID: "barber1"}
+	bus.publish(BookingEvent{Type: BookingCreated, Booking: booking, Tenant: "acme"})
+
+	assert.Equal(t, "acme", (<-acmeEvents).Tenant)
+	assert.Equal(t, "acme", (<-allEvents).Tenant)
+	assert.Len(t, defaultEvents, 0)
+}
+
+// fakePublisher records the events it's given
+type fakePublisher struct {
+	events []BookingEvent
+	err    error
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, event BookingEvent) error {
+	p.events = append(p.events, event)
+	return p.err
+}
+
+func TestPublishEvent_ReachesPublisherAndWatchers(t *testing.T) {
+	publisher := &fakePublisher{err: errors.New("broker unavailable")}
+	s := NewBookingService(&fakeBookingRepo{}, WithEventPublisher(publisher))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watched := s.WatchBookings(ctx, "", "")
+	booking := &model.Booking{ID: primitive.NewObjectID(), UserID: "user1", BarberID: "barber1"}
+	_, err := s.changeBooking(ctx, BookingConfirmed, func(ctx context.Context) (*model.Booking, error) {
+		return booking, nil
+	})
+	assert.NoError(t, err)
+
+	// Failing to publish still notifies watchers
+	event := <-watched
+	assert.Equal(t, BookingConfirmed, event.Type)
+	assert.NotEmpty(t, event.ID)
+	assert.Equal(t, []BookingEvent{event}, publisher.events)
+	assert.Equal(t, "booking.confirmed", event.Type.String())
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// rebookAttempts is how many of the barber's next free slots RebookLast tries
+// when others take them first
+const rebookAttempts = 3
+
+// AddFavoriteBarber marks a barber as one of the customer's favorites. Adding
+// a favorite again keeps when it was first added.
+func (s *BookingService) AddFavoriteBarber(ctx context.Context, userID, barberID string) (*model.FavoriteBarber, error) {
+	if s.favoriteRepo == nil {
+		return nil, errors.New("favorites are not configured")
+	}
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+	if barberID == "" {
+		return nil, ErrInvalidBarber
+	}
+
+	favorite, err := s.favoriteRepo.AddFavorite(ctx, &model.FavoriteBarber{
+		UserID:   userID,
+		BarberID: barberID,
+		AddedAt:  s.clock.Now(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add favorite")
+	}
+
+	return favorite, nil
+}
+
+// RemoveFavoriteBarber removes a barber from the customer's favorites,
+// reporting whether they were one
+func (s *BookingService) RemoveFavoriteBarber(ctx context.Context, userID, barberID string) (bool, error) {
+	if s.favoriteRepo == nil {
+		return false, errors.New("favorites are not configured")
+	}
+
+	removed, err := s.favoriteRepo.RemoveFavorite(ctx, userID, barberID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to remove favorite")
+	}
+
+	return removed, nil
+}
+
+// ListFavorites returns the customer's favorite barbers, most recently added
+// first, with the profiles of those who have one
+func (s *BookingService) ListFavorites(ctx context.Context, userID string) ([]*model.FavoriteBarber, error) {
+	if s.favoriteRepo == nil {
+		return nil, errors.New("favorites are not configured")
+	}
+
+	favorites, err := s.favoriteRepo.ListFavorites(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list favorites")
+	}
+
+	for _, favorite := range favorites {
+		barber, err := s.GetBarber(ctx, favorite.BarberID)
+		if err != nil && !errors.Is(err, ErrBarberNotFound) {
+			return nil, err
+		}
+		favorite.Barber = barber
+	}
+
+	return favorites, nil
+}
+
+// RebookLast books the customer's most recent completed booking again: the
+// same barber, services and notes, at the barber's next free slot. Replaying
+// an idempotency key returns the booking it made the first time.
+func (s *BookingService) RebookLast(ctx context.Context, userID, idempotencyKey string) (*model.Booking, error) {
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	// The slot a replay would find is past the booking made the first time,
+	// so the key is checked here rather than by CreateBooking
+	if idempotencyKey != "" {
+		original, err := s.repo.GetBookingByIdempotencyKey(ctx, userID, idempotencyKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check idempotency key")
+		}
+		if original != nil {
+			return original, nil
+		}
+	}
+
+	bookings, err := s.repo.FindUserBookings(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user bookings")
+	}
+
+	var last *model.Booking
+	for _, booking := range bookings {
+		if booking.DeletedAt != nil || booking.Status != model.BookingStatusCompleted {
+			continue
+		}
+		if last == nil || booking.StartTime.After(last.StartTime) {
+			last = booking
+		}
+	}
+	if last == nil {
+		return nil, ErrNothingToRebook
+	}
+
+	services := last.Services()
+	slots, err := s.GetNextAvailableSlot(ctx, last.BarberID, services, rebookAttempts)
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) == 0 {
+		return nil, ErrNoSlotToRebook
+	}
+
+	// Someone may book a slot between finding and taking it, so later ones
+	// are tried too
+	for _, slot := range slots {
+		booking, err := s.CreateBooking(ctx, CreateBookingParams{
+			UserID:         userID,
+			BarberID:       last.BarberID,
+			StartTime:      slot.StartTime,
+			ServiceTypes:   services,
+			Notes:          last.Notes,
+			IdempotencyKey: idempotencyKey,
+		})
+		if errors.Is(err, ErrSlotUnavailable) || errors.Is(err, ErrSlotBusy) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info().
+			Str("bookingID", booking.ID.Hex()).
+			Str("rebookedFrom", last.ID.Hex()).
+			Str("userID", userID).
+			Msg("Booking rebooked")
+
+		return booking, nil
+	}
+
+	return nil, ErrNoSlotToRebook
+}
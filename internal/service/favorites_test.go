@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// fakeFavoriteRepo keeps favorites in memory
+type fakeFavoriteRepo struct {
+	favorites []*model.FavoriteBarber
+}
+
+func (r *fakeFavoriteRepo) AddFavorite(ctx context.Context, favorite *model.FavoriteBarber) (*model.FavoriteBarber, error) {
+	for _, existing := range r.favorites {
+		if existing.UserID == favorite.UserID && existing.BarberID == favorite.BarberID {
+			return existing, nil
+		}
+	}
+	r.favorites = append(r.favorites, favorite)
+	return favorite, nil
+}
+
+func (r *fakeFavoriteRepo) RemoveFavorite(ctx context.Context, userID, barberID string) (bool, error) {
+	for i, favorite := range r.favorites {
+		if favorite.UserID == userID && favorite.BarberID == barberID {
+			r.favorites = append(r.favorites[:i], r.favorites[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeFavoriteRepo) ListFavorites(ctx context.Context, userID string) ([]*model.FavoriteBarber, error) {
+	var favorites []*model.FavoriteBarber
+	for _, favorite := range r.favorites {
+		if favorite.UserID == userID {
+			favorites = append(favorites, favorite)
+		}
+	}
+	sort.SliceStable(favorites, func(i, j int) bool {
+		return favorites[i].AddedAt.After(favorites[j].AddedAt)
+	})
+	return favorites, nil
+}
+
+func (r *fakeFavoriteRepo) DeleteUserFavorites(ctx context.Context, userID string) (int64, error) {
+	var kept []*model.FavoriteBarber
+	for _, favorite := range r.favorites {
+		if favorite.UserID != userID {
+			kept = append(kept, favorite)
+		}
+	}
+	deleted := int64(len(r.favorites) - len(kept))
+	r.favorites = kept
+	return deleted, nil
+}
+
+func TestFavorites(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(now)
+	barbers := &fakeBarberRepo{barbers: map[string]*model.Barber{
+		"barber1": {ID: "barber1", DisplayName: "Sam", Active: true},
+	}}
+	favorites := &fakeFavoriteRepo{}
+	s := NewBookingService(&erasingRepo{}, WithClock(clk), WithBarberRepository(barbers), WithFavoriteRepository(favorites))
+	ctx := context.Background()
+
+	first, err := s.AddFavoriteBarber(ctx, "user1", "barber1")
+	require.NoError(t, err)
+	assert.Equal(t, now, first.AddedAt)
+
+	// Adding a favorite again keeps when it was first added
+	clk.Advance(time.Hour)
+	again, err := s.AddFavoriteBarber(ctx, "user1", "barber1")
+	require.NoError(t, err)
+	assert.Equal(t, now, again.AddedAt)
+
+	_, err = s.AddFavoriteBarber(ctx, "user1", "barber2")
+	require.NoError(t, err)
+
+	// Newest first, with the profiles of barbers who have one
+	list, err := s.ListFavorites(ctx, "user1")
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "barber2", list[0].BarberID)
+	assert.Nil(t, list[0].Barber)
+	assert.Equal(t, "Sam", list[1].Barber.DisplayName)
+
+	removed, err := s.RemoveFavoriteBarber(ctx, "user1", "barber2")
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = s.RemoveFavoriteBarber(ctx, "user1", "barber2")
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	// Erasing the customer's data removes their favorites
+	result, err := s.EraseUserData(ctx, "user1", model.RetentionModeAnonymize)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), result.Favorites)
+	assert.Empty(t, favorites.favorites)
+}
+
+// rebookingRepo finds a user's bookings and bookings by idempotency key
+// among the stored ones
+type rebookingRepo struct {
+	creatingBookingRepo
+}
+
+func (r *rebookingRepo) FindUserBookings(ctx context.Context, userID string) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		if b.UserID == userID {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func (r *rebookingRepo) GetBookingByIdempotencyKey(ctx context.Context, userID, key string) (*model.Booking, error) {
+	for _, b := range r.bookings {
+		if b.UserID == userID && b.IdempotencyKey == key {
+			return b, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestRebookLast(t *testing.T) {
+	now := time.Date(2025, time.June, 2, 9, 0, 0, 0, time.UTC)
+	book := func(barberID string, start time.Time, status model.BookingStatus) *model.Booking {
+		return &model.Booking{
+			ID:           primitive.NewObjectID(),
+			UserID:       "user1",
+			BarberID:     barberID,
+			StartTime:    start,
+			EndTime:      start.Add(45 * time.Minute),
+			ServiceType:  model.ServiceTypeHaircut,
+			ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim},
+			Status:       status,
+			Notes:        "Skin fade",
+		}
+	}
+	repo := &rebookingRepo{}
+	repo.bookings = []*model.Booking{
+		book("barber1", now.AddDate(0, 0, -14), model.BookingStatusCompleted),
+		book("barber2", now.AddDate(0, 0, -7), model.BookingStatusCompleted),
+		// Later, but not completed
+		book("barber3", now.AddDate(0, 0, -2), model.BookingStatusCancelled),
+	}
+	s := NewBookingService(repo, WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	services := []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim}
+	next, err := s.GetNextAvailableSlot(ctx, "barber2", services, 1)
+	require.NoError(t, err)
+	require.NotEmpty(t, next)
+
+	booking, err := s.RebookLast(ctx, "user1", "rebook-1")
+	require.NoError(t, err)
+	assert.Equal(t, "barber2", booking.BarberID)
+	assert.Equal(t, next[0].StartTime, booking.StartTime)
+	assert.Equal(t, services, booking.Services())
+	assert.Equal(t, "Skin fade", booking.Notes)
+
+	// Replaying the key returns the same booking rather than the next slot
+	replayed, err := s.RebookLast(ctx, "user1", "rebook-1")
+	require.NoError(t, err)
+	assert.Same(t, booking, replayed)
+
+	// Without a key, the taken slot is skipped
+	another, err := s.RebookLast(ctx, "user1", "")
+	require.NoError(t, err)
+	assert.False(t, another.StartTime.Before(booking.EndTime))
+
+	_, err = s.RebookLast(ctx, "user2", "")
+	assert.ErrorIs(t, err, ErrNothingToRebook)
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ita-av/booking-service/internal/featureflag"
+)
+
+// Feature flags the service checks. Each guards a behavior that can be
+// turned off, for every shop or some, if it misbehaves.
+const (
+	// FlagDeposits asks new bookings for a deposit, when payments are set up
+	FlagDeposits = "deposits"
+	// FlagSlotCache serves available slots from the slot cache, when one is
+	// set up. Bookings keep invalidating it while it's off, so it's safe to
+	// turn back on.
+	FlagSlotCache = "slot-cache"
+)
+
+// WithFeatureFlags decides the service's feature flags with flags. Without
+// it every flag is on.
+func WithFeatureFlags(flags *featureflag.Flags) Option {
+	return func(s *BookingService) {
+		s.flags = flags
+	}
+}
+
+// featureEnabled reports whether the flag is on for the request
+func (s *BookingService) featureEnabled(ctx context.Context, flag string) bool {
+	return s.flags.Enabled(ctx, flag, true)
+}
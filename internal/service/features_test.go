@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/featureflag"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+func TestFeatureFlags_Deposits(t *testing.T) {
+	flags, err := featureflag.NewStatic("deposits=shop-a")
+	require.NoError(t, err)
+	s := NewBookingService(&depositBookingRepo{}, WithDeposits(&fakeProvider{}, 1, 15*time.Minute),
+		WithFeatureFlags(featureflag.New(flags)))
+	start := time.Now().Add(24 * time.Hour)
+
+	pilot := &model.Booking{UserID: "user1", Price: 2500, StartTime: start}
+	require.NoError(t, s.requestDeposit(tenant.WithID(context.Background(), "shop-a"), pilot))
+	assert.NotNil(t, pilot.Deposit)
+
+	other := &model.Booking{UserID: "user1", Price: 2500, StartTime: start}
+	require.NoError(t, s.requestDeposit(tenant.WithID(context.Background(), "shop-b"), other))
+	assert.Nil(t, other.Deposit)
+}
+
+func TestFeatureFlags_SlotCache(t *testing.T) {
+	day := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber1": {
+			BarberID: "barber1",
+			Hours:    []model.WorkingHours{{Weekday: time.Tuesday, StartMinute: 9 * 60, EndMinute: 11 * 60}},
+		},
+	}}
+	cache := &fakeSlotCache{slots: map[string][]*model.TimeSlot{}}
+	flags, err := featureflag.NewStatic("slot-cache=off")
+	require.NoError(t, err)
+	s := NewBookingService(&fakeBookingRepo{}, WithScheduleRepository(schedules), WithSlotCache(cache),
+		WithClock(clock.NewFake(day.AddDate(0, 0, -1))), WithFeatureFlags(featureflag.New(flags)))
+	ctx := context.Background()
+
+	slots, err := s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	require.NoError(t, err)
+	assert.Len(t, slots, 4)
+	assert.Empty(t, cache.slots, "nothing is cached while the flag is off")
+
+	// Turning the flag on starts using the cache
+	require.NoError(t, flags.Update("slot-cache=on"))
+	_, err = s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	require.NoError(t, err)
+	assert.Len(t, cache.slots, 1)
+}
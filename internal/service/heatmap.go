@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// HeatmapCache keeps the demand heatmaps worked out on a day, which only
+// change when the day does. Implementations scope entries to the tenant of
+// ctx and expire them after a day.
+type HeatmapCache interface {
+	GetHeatmap(ctx context.Context, key string) (*model.DemandHeatmap, bool, error)
+	SetHeatmap(ctx context.Context, key string, heatmap *model.DemandHeatmap) error
+}
+
+// WithHeatmapCache caches the demand heatmaps GetDemandHeatmap returns
+func WithHeatmapCache(cache HeatmapCache) Option {
+	return func(s *BookingService) {
+		s.heatmapCache = cache
+	}
+}
+
+// GetDemandHeatmap counts the bookings of the given number of weeks up to
+// yesterday by the weekday and hour they started at, in the barber's time
+// zone, or in the shop's for every barber. Zero weeks means the default.
+func (s *BookingService) GetDemandHeatmap(ctx context.Context, barberID string, weeks int) (*model.DemandHeatmap, error) {
+	if weeks == 0 {
+		weeks = model.DefaultHeatmapWeeks
+	}
+	if weeks < 1 || weeks > model.MaxHeatmapWeeks {
+		return nil, errors.Wrapf(ErrInvalidHeatmapWeeks, "heatmap must cover 1 to %d weeks", model.MaxHeatmapWeeks)
+	}
+
+	loc := s.shopLocation
+	if barberID != "" {
+		schedule, err := s.GetWorkingHours(ctx, barberID)
+		if err != nil {
+			return nil, err
+		}
+		loc = schedule.Location()
+	}
+
+	now := s.clock.Now().In(loc)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	start := end.AddDate(0, 0, -7*weeks)
+
+	key := end.Format("2006-01-02") + ":" + loc.String() + ":" + strconv.Itoa(weeks) + ":" + barberID
+	if heatmap, ok := s.cachedHeatmap(ctx, key); ok {
+		return heatmap, nil
+	}
+
+	heatmap, err := s.repo.GetDemandHeatmap(ctx, barberID, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get demand heatmap")
+	}
+	heatmap.Weeks = weeks
+	heatmap.GeneratedAt = s.clock.Now()
+
+	if s.heatmapCache != nil {
+		if err := s.heatmapCache.SetHeatmap(ctx, key, heatmap); err != nil {
+			log.Warn().Err(err).Str("key", key).Msg("Failed to cache demand heatmap")
+		}
+	}
+
+	return heatmap, nil
+}
+
+// cachedHeatmap returns a heatmap worked out earlier in the day. A failing
+// cache is only logged, and treated as empty.
+func (s *BookingService) cachedHeatmap(ctx context.Context, key string) (*model.DemandHeatmap, bool) {
+	if s.heatmapCache == nil {
+		return nil, false
+	}
+
+	heatmap, ok, err := s.heatmapCache.GetHeatmap(ctx, key)
+	if err != nil {
+		log.Warn().Err(err).Str("key", key).Msg("Failed to read cached demand heatmap")
+		return nil, false
+	}
+	return heatmap, ok
+}
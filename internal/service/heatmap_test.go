@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// heatmapBookingRepo counts its bookings' start times
+type heatmapBookingRepo struct {
+	fakeBookingRepo
+	queries int
+}
+
+func (r *heatmapBookingRepo) GetDemandHeatmap(ctx context.Context, barberID string, start, end time.Time) (*model.DemandHeatmap, error) {
+	r.queries++
+	heatmap := &model.DemandHeatmap{BarberID: barberID, Start: start, End: end, Timezone: start.Location().String()}
+	for _, b := range r.bookings {
+		if (barberID == "" || b.BarberID == barberID) && !b.StartTime.Before(start) && b.StartTime.Before(end) {
+			local := b.StartTime.In(start.Location())
+			heatmap.Counts[local.Weekday()][local.Hour()]++
+		}
+	}
+	return heatmap, nil
+}
+
+// memoryHeatmapCache keeps heatmaps in memory
+type memoryHeatmapCache map[string]*model.DemandHeatmap
+
+func (c memoryHeatmapCache) GetHeatmap(ctx context.Context, key string) (*model.DemandHeatmap, bool, error) {
+	heatmap, ok := c[key]
+	return heatmap, ok, nil
+}
+
+func (c memoryHeatmapCache) SetHeatmap(ctx context.Context, key string, heatmap *model.DemandHeatmap) error {
+	c[key] = heatmap
+	return nil
+}
+
+func TestGetDemandHeatmap(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	clk := clock.NewFake(time.Date(2025, time.June, 11, 15, 0, 0, 0, berlin))
+
+	repo := &heatmapBookingRepo{fakeBookingRepo: fakeBookingRepo{bookings: []*model.Booking{
+		{BarberID: "barber1", StartTime: time.Date(2025, time.June, 7, 10, 0, 0, 0, berlin)},
+		{BarberID: "barber2", StartTime: time.Date(2025, time.May, 31, 10, 30, 0, 0, berlin)},
+		// Today's bookings aren't history yet
+		{BarberID: "barber1", StartTime: time.Date(2025, time.June, 11, 10, 0, 0, 0, berlin)},
+	}}}
+	s := NewBookingService(repo, WithShopTimezone(berlin), WithClock(clk), WithHeatmapCache(memoryHeatmapCache{}))
+	ctx := context.Background()
+
+	heatmap, err := s.GetDemandHeatmap(ctx, "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, time.May, 28, 0, 0, 0, 0, berlin), heatmap.Start)
+	assert.Equal(t, time.Date(2025, time.June, 11, 0, 0, 0, 0, berlin), heatmap.End)
+	assert.Equal(t, 2, heatmap.Counts[time.Saturday][10])
+	assert.Equal(t, 2, heatmap.Weeks)
+
+	// The same day's heatmap comes from the cache
+	_, err = s.GetDemandHeatmap(ctx, "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, repo.queries)
+
+	// The next day's is worked out again
+	clk.Advance(12 * time.Hour)
+	heatmap, err = s.GetDemandHeatmap(ctx, "", 2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, repo.queries)
+	assert.Equal(t, 1, heatmap.Counts[time.Wednesday][10])
+
+	_, err = s.GetDemandHeatmap(ctx, "", model.MaxHeatmapWeeks+1)
+	assert.ErrorIs(t, err, ErrInvalidHeatmapWeeks)
+}
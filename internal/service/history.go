@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// untrackedFields change with every write, so they'd only add noise to the history
+var untrackedFields = map[string]bool{
+	"id":        true,
+	"version":   true,
+	"createdAt": true,
+	"updatedAt": true,
+}
+
+// statusActions is the history action recorded for each status a booking moves to
+var statusActions = map[model.BookingStatus]model.BookingAction{
+	model.BookingStatusConfirmed: model.BookingActionConfirmed,
+	model.BookingStatusCompleted: model.BookingActionCompleted,
+	model.BookingStatusCancelled: model.BookingActionCancelled,
+	model.BookingStatusNoShow:    model.BookingActionNoShow,
+}
+
+// GetBookingHistory retrieves the changes made to a booking, oldest first
+func (s *BookingService) GetBookingHistory(ctx context.Context, bookingID string) ([]*model.BookingHistoryEntry, error) {
+	if s.historyRepo == nil {
+		return nil, nil
+	}
+
+	entries, err := s.historyRepo.GetBookingHistory(ctx, bookingID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking history")
+	}
+
+	return entries, nil
+}
+
+// recordHistory stores a change to a booking, attributed to the signed-in
+// user or else the system. Failures are logged rather than returned, since
+// the change itself succeeded.
+func (s *BookingService) recordHistory(ctx context.Context, action model.BookingAction, before, after *model.Booking) {
+	if s.historyRepo == nil {
+		return
+	}
+
+	actorID, err := auth.GetUserIDFromContext(ctx)
+	if err != nil {
+		actorID = model.SystemActor
+	}
+
+	changes, err := diffBookings(before, after)
+	if err != nil {
+		log.Error().Err(err).Str("bookingID", after.ID.Hex()).Msg("Failed to diff booking for history")
+	}
+
+	err = s.historyRepo.AddHistoryEntry(ctx, &model.BookingHistoryEntry{
+		BookingID: after.ID.Hex(),
+		Action:    action,
+		ActorID:   actorID,
+		Changes:   changes,
+		At:        s.clock.Now(),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("bookingID", after.ID.Hex()).Str("action", string(action)).Msg("Failed to record booking history")
+	}
+}
+
+// diffBookings lists the fields that differ between two versions of a
+// booking, compared in their JSON form. A nil before lists every field set
+// on after.
+func diffBookings(before, after *model.Booking) ([]model.FieldChange, error) {
+	beforeFields, err := bookingFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := bookingFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(afterFields))
+	for name := range afterFields {
+		names = append(names, name)
+	}
+	for name := range beforeFields {
+		if _, ok := afterFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []model.FieldChange
+	for _, name := range names {
+		if untrackedFields[name] {
+			continue
+		}
+		beforeValue, afterValue := string(beforeFields[name]), string(afterFields[name])
+		if beforeValue != afterValue {
+			changes = append(changes, model.FieldChange{Field: name, Before: beforeValue, After: afterValue})
+		}
+	}
+
+	return changes, nil
+}
+
+// bookingFields splits a booking into its top-level JSON fields
+func bookingFields(booking *model.Booking) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if booking == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(booking)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode booking")
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.Wrap(err, "failed to decode booking")
+	}
+
+	return fields, nil
+}
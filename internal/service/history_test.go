@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// fakeHistoryRepo keeps history entries in memory
+type fakeHistoryRepo struct {
+	entries []*model.BookingHistoryEntry
+}
+
+func (r *fakeHistoryRepo) AddHistoryEntry(ctx context.Context, entry *model.BookingHistoryEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *fakeHistoryRepo) GetBookingHistory(ctx context.Context, bookingID string) ([]*model.BookingHistoryEntry, error) {
+	var entries []*model.BookingHistoryEntry
+	for _, entry := range r.entries {
+		if entry.BookingID == bookingID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (r *fakeHistoryRepo) DeleteBookingHistory(ctx context.Context, bookingIDs []string) (int64, error) {
+	return 0, nil
+}
+
+func TestDiffBookings(t *testing.T) {
+	start := time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC)
+	before := &model.Booking{
+		ID:        primitive.NewObjectID(),
+		UserID:    "user1",
+		StartTime: start,
+		EndTime:   start.Add(30 * time.Minute),
+		Notes:     "short on the sides",
+		Version:   1,
+	}
+	after := *before
+	after.StartTime = start.Add(time.Hour)
+	after.EndTime = start.Add(90 * time.Minute)
+	after.Notes = ""
+	after.Version = 2
+	after.UpdatedAt = start
+
+	changes, err := diffBookings(before, &after)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.FieldChange{
+		{Field: "endTime", Before: `"2025-06-02T10:30:00Z"`, After: `"2025-06-02T11:30:00Z"`},
+		{Field: "notes", Before: `"short on the sides"`},
+		{Field: "startTime", Before: `"2025-06-02T10:00:00Z"`, After: `"2025-06-02T11:00:00Z"`},
+	}, changes)
+
+	// A new booking lists the fields it was created with
+	changes, err = diffBookings(nil, before)
+	assert.NoError(t, err)
+	fields := make([]string, len(changes))
+	for i, change := range changes {
+		fields[i] = change.Field
+		assert.Empty(t, change.Before)
+	}
+	assert.Contains(t, fields, "userId")
+	assert.Contains(t, fields, "notes")
+	assert.NotContains(t, fields, "version")
+}
+
+func TestRecordHistory_Actor(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	history := &fakeHistoryRepo{}
+	s := NewBookingService(&depositBookingRepo{}, WithHistoryRepository(history), WithClock(clockAt(now)))
+
+	claims := &auth.Claims{}
+	claims.Subject = "user1"
+	ctx := auth.WithClaims(context.Background(), claims)
+
+	booking, err := s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		StartTime:    time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	// Jobs and webhooks act without a signed-in user
+	_, err = s.ConfirmBooking(context.Background(), booking.ID.Hex())
+	assert.NoError(t, err)
+
+	entries, err := s.GetBookingHistory(ctx, booking.ID.Hex())
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, model.BookingActionCreated, entries[0].Action)
+		assert.Equal(t, "user1", entries[0].ActorID)
+		assert.Equal(t, now, entries[0].At)
+		assert.NotEmpty(t, entries[0].Changes)
+
+		assert.Equal(t, model.BookingActionConfirmed, entries[1].Action)
+		assert.Equal(t, model.SystemActor, entries[1].ActorID)
+	}
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// maxHoldDuration caps how long a slot can be held
+const maxHoldDuration = 30 * time.Minute
+
+// holdExpiryBatchSize caps how many lapsed holds one sweep deletes
+const holdExpiryBatchSize = 100
+
+// HoldTimeSlot reserves a slot for the customer while they finish booking
+// it, e.g. enter their details or pay. The hold takes the slot like a booking
+// until it's booked with CreateBooking or lapses after the duration. Holds
+// aren't published as booking events or sent to the customer.
+func (s *BookingService) HoldTimeSlot(ctx context.Context, params HoldTimeSlotParams) (*model.Booking, error) {
+	if len(params.ServiceTypes) == 0 {
+		return nil, ErrNoServices
+	}
+	if params.Duration <= 0 || params.Duration > maxHoldDuration {
+		return nil, ErrInvalidHoldDuration
+	}
+
+	slot, err := s.checkNewSlot(ctx, params.BarberID, params.StartTime, params.ServiceTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := s.clock.Now().Add(params.Duration)
+	cleanup := slot.quote.Cleanup()
+	hold := &model.Booking{
+		UserID:         params.UserID,
+		BarberID:       params.BarberID,
+		StartTime:      params.StartTime,
+		EndTime:        slot.endTime,
+		ServiceType:    params.ServiceTypes[0],
+		ServiceTypes:   params.ServiceTypes,
+		CleanupMinutes: &cleanup,
+		Status:         model.BookingStatusHeld,
+		Price:          slot.quote.Price,
+		Currency:       slot.quote.Currency,
+		HoldExpiresAt:  &expiresAt,
+	}
+
+	unlock, err := s.lockSlot(ctx, params.BarberID, slot.resources)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to lock slot")
+	}
+	createdHold, err := s.repo.CreateBooking(ctx, hold, slot.capacity, slot.resources)
+	unlock()
+	if err != nil {
+		if errors.Is(err, repository.ErrSlotUnavailable) {
+			return nil, s.slotUnavailable(ctx, params.BarberID, params.StartTime, slot.occupiedUntil, params.ServiceTypes, primitive.NilObjectID)
+		}
+		if errors.Is(err, repository.ErrResourceUnavailable) {
+			return nil, s.resourceUnavailable(ctx, slot.resources, params.StartTime, slot.occupiedUntil, primitive.NilObjectID)
+		}
+		return nil, errors.Wrap(err, "failed to hold slot")
+	}
+	s.invalidateSlots(ctx, createdHold)
+
+	log.Info().
+		Str("holdID", createdHold.ID.Hex()).
+		Str("userID", params.UserID).
+		Str("barberID", params.BarberID).
+		Time("startTime", params.StartTime).
+		Time("expiresAt", expiresAt).
+		Msg("Time slot held")
+
+	return createdHold, nil
+}
+
+// bookHold turns the customer's hold into a booking, priced as when it was
+// held
+func (s *BookingService) bookHold(ctx context.Context, params CreateBookingParams) (*model.Booking, error) {
+	// A replayed request finds its hold booked already
+	if params.IdempotencyKey != "" {
+		original, err := s.repo.GetBookingByIdempotencyKey(ctx, params.UserID, params.IdempotencyKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check idempotency key")
+		}
+		if original != nil {
+			if original.ID.Hex() != params.HoldID {
+				return nil, ErrIdempotencyKeyReused
+			}
+			return original, nil
+		}
+	}
+
+	if _, err := primitive.ObjectIDFromHex(params.HoldID); err != nil {
+		return nil, ErrHoldNotFound
+	}
+	hold, err := s.repo.GetBookingByID(ctx, params.HoldID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get hold")
+	}
+	if hold == nil || hold.Status != model.BookingStatusHeld || hold.UserID != params.UserID {
+		return nil, ErrHoldNotFound
+	}
+	if hold.HoldExpired(s.clock.Now()) {
+		return nil, ErrHoldExpired
+	}
+
+	booking := *hold
+	booking.Status = model.BookingStatusPending
+	booking.HoldExpiresAt = nil
+	booking.Notes = params.Notes
+	booking.ExternalRef = params.ExternalRef
+	booking.IdempotencyKey = params.IdempotencyKey
+
+	if params.PromoCode != "" {
+		if err := s.applyPromoCode(ctx, &booking, params.PromoCode); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.requestDeposit(ctx, &booking); err != nil {
+		s.releasePromoCode(ctx, &booking)
+		return nil, err
+	}
+
+	createdBooking, err := s.changeBooking(ctx, BookingCreated, func(ctx context.Context) (*model.Booking, error) {
+		return s.repo.ConvertHold(ctx, &booking, s.clock.Now())
+	})
+	if err == nil && createdBooking == nil {
+		// Lapsed, or booked by a concurrent request, in the meantime
+		err = ErrHoldExpired
+	}
+	if err != nil {
+		s.cancelDeposit(ctx, &booking)
+		s.releasePromoCode(ctx, &booking)
+
+		if errors.Is(err, repository.ErrIdempotencyKeyUsed) {
+			return nil, ErrIdempotencyKeyReused
+		}
+		if errors.Is(err, repository.ErrExternalRefExists) {
+			return nil, ErrExternalRefConflict
+		}
+		if errors.Is(err, ErrHoldExpired) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "failed to book hold")
+	}
+
+	log.Info().
+		Str("bookingID", createdBooking.ID.Hex()).
+		Str("userID", params.UserID).
+		Str("barberID", createdBooking.BarberID).
+		Time("startTime", createdBooking.StartTime).
+		Msg("Held slot booked")
+
+	s.recordHistory(ctx, model.BookingActionCreated, nil, createdBooking)
+	s.notifyCustomer(ctx, notification.KindBookingConfirmation, createdBooking)
+
+	return createdBooking, nil
+}
+
+// ExpireHolds deletes holds that lapsed without being booked, freeing their
+// slots in cached availability too; lapsed holds already don't take slots
+// otherwise. It returns the number of deleted holds.
+func (s *BookingService) ExpireHolds(ctx context.Context) (int, error) {
+	holds, err := s.repo.DeleteExpiredHolds(ctx, s.clock.Now(), holdExpiryBatchSize)
+	s.invalidateSlots(ctx, holds...)
+	if err != nil {
+		return len(holds), errors.Wrap(err, "failed to delete expired holds")
+	}
+
+	for _, hold := range holds {
+		log.Info().
+			Str("holdID", hold.ID.Hex()).
+			Str("userID", hold.UserID).
+			Time("expiresAt", *hold.HoldExpiresAt).
+			Msg("Hold expired")
+	}
+
+	return len(holds), nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// holdingBookingRepo stores holds and converts them into bookings
+type holdingBookingRepo struct {
+	creatingBookingRepo
+}
+
+func (r *holdingBookingRepo) CreateBooking(ctx context.Context, booking *model.Booking, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	booking.ID = primitive.NewObjectID()
+	return r.creatingBookingRepo.CreateBooking(ctx, booking, capacity, resources)
+}
+
+func (r *holdingBookingRepo) GetBookingByID(ctx context.Context, id string) (*model.Booking, error) {
+	for _, booking := range r.bookings {
+		if booking.ID.Hex() == id {
+			copied := *booking
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *holdingBookingRepo) GetBookingByIdempotencyKey(ctx context.Context, userID, key string) (*model.Booking, error) {
+	return nil, nil
+}
+
+func (r *holdingBookingRepo) ConvertHold(ctx context.Context, booking *model.Booking, now time.Time) (*model.Booking, error) {
+	for i, hold := range r.bookings {
+		if hold.ID == booking.ID && hold.Status == model.BookingStatusHeld && !hold.HoldExpired(now) {
+			r.bookings[i] = booking
+			return booking, nil
+		}
+	}
+	return nil, nil
+}
+
+func TestHoldTimeSlot_BookedWithCreateBooking(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	repo := &holdingBookingRepo{}
+	s := NewBookingService(repo, WithClock(clockAt(now)))
+	ctx := context.Background()
+	start := time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC)
+
+	hold, err := s.HoldTimeSlot(ctx, HoldTimeSlotParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		StartTime:    start,
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		Duration:     10 * time.Minute,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.BookingStatusHeld, hold.Status)
+	assert.Equal(t, now.Add(10*time.Minute), *hold.HoldExpiresAt)
+
+	// The slot is taken while it's held
+	_, err = s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user2",
+		BarberID:     "barber1",
+		StartTime:    start,
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+	})
+	assert.ErrorIs(t, err, ErrSlotUnavailable)
+
+	// Only the customer holding it can book it
+	_, err = s.CreateBooking(ctx, CreateBookingParams{UserID: "user2", HoldID: hold.ID.Hex()})
+	assert.ErrorIs(t, err, ErrHoldNotFound)
+
+	booking, err := s.CreateBooking(ctx, CreateBookingParams{UserID: "user1", HoldID: hold.ID.Hex(), Notes: "Short on the sides"})
+	require.NoError(t, err)
+	assert.Equal(t, hold.ID, booking.ID)
+	assert.Equal(t, model.BookingStatusPending, booking.Status)
+	assert.Equal(t, start, booking.StartTime)
+	assert.Equal(t, "Short on the sides", booking.Notes)
+	assert.Nil(t, booking.HoldExpiresAt)
+
+	// A booked hold can't be booked again
+	_, err = s.CreateBooking(ctx, CreateBookingParams{UserID: "user1", HoldID: hold.ID.Hex()})
+	assert.ErrorIs(t, err, ErrHoldNotFound)
+}
+
+func TestHoldTimeSlot_Expired(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	repo := &holdingBookingRepo{}
+	clk := clock.NewFake(now)
+	s := NewBookingService(repo, WithClock(clk))
+	ctx := context.Background()
+	params := HoldTimeSlotParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		StartTime:    time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC),
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		Duration:     time.Hour,
+	}
+
+	_, err := s.HoldTimeSlot(ctx, params)
+	assert.ErrorIs(t, err, ErrInvalidHoldDuration)
+
+	params.Duration = 5 * time.Minute
+	_, err = s.HoldTimeSlot(ctx, params)
+	require.NoError(t, err)
+
+	clk.Advance(5 * time.Minute)
+	_, err = s.CreateBooking(ctx, CreateBookingParams{UserID: "user1", HoldID: repo.bookings[0].ID.Hex()})
+	assert.ErrorIs(t, err, ErrHoldExpired)
+}
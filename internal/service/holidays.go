@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// AddHoliday closes the shop on a calendar day. Adding an existing holiday
+// again updates its name.
+func (s *BookingService) AddHoliday(ctx context.Context, date time.Time, name, createdBy string) (*model.Holiday, error) {
+	if s.holidayRepo == nil {
+		return nil, errors.New("holiday storage is not configured")
+	}
+
+	holiday, err := s.holidayRepo.AddHoliday(ctx, &model.Holiday{
+		Date:      date.Format(model.HolidayDateFormat),
+		Name:      name,
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to add holiday")
+	}
+
+	log.Info().
+		Str("date", holiday.Date).
+		Str("name", holiday.Name).
+		Str("createdBy", createdBy).
+		Msg("Holiday added")
+
+	return holiday, nil
+}
+
+// RemoveHoliday reopens the shop on a calendar day, reporting whether it was a holiday
+func (s *BookingService) RemoveHoliday(ctx context.Context, date time.Time) (bool, error) {
+	if s.holidayRepo == nil {
+		return false, errors.New("holiday storage is not configured")
+	}
+
+	removed, err := s.holidayRepo.DeleteHoliday(ctx, date.Format(model.HolidayDateFormat))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to remove holiday")
+	}
+
+	return removed, nil
+}
+
+// ListHolidays returns the holidays between two calendar days, both inclusive.
+// Nil bounds are open.
+func (s *BookingService) ListHolidays(ctx context.Context, from, to *time.Time) ([]*model.Holiday, error) {
+	if s.holidayRepo == nil {
+		return nil, nil
+	}
+
+	var fromDate, toDate string
+	if from != nil {
+		fromDate = from.Format(model.HolidayDateFormat)
+	}
+	if to != nil {
+		toDate = to.Format(model.HolidayDateFormat)
+	}
+
+	holidays, err := s.holidayRepo.ListHolidays(ctx, fromDate, toDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list holidays")
+	}
+
+	return holidays, nil
+}
+
+// isHoliday reports whether the shop is closed on the calendar day of t, taken
+// in t's location
+func (s *BookingService) isHoliday(ctx context.Context, t time.Time) (bool, error) {
+	if s.holidayRepo == nil {
+		return false, nil
+	}
+
+	holiday, err := s.holidayRepo.GetHoliday(ctx, t.Format(model.HolidayDateFormat))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to check holidays")
+	}
+
+	return holiday != nil, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// fakeHolidayRepo stores holidays in memory
+type fakeHolidayRepo struct {
+	holidays map[string]*model.Holiday
+}
+
+func (r *fakeHolidayRepo) GetHoliday(ctx context.Context, date string) (*model.Holiday, error) {
+	return r.holidays[date], nil
+}
+
+func (r *fakeHolidayRepo) ListHolidays(ctx context.Context, from, to string) ([]*model.Holiday, error) {
+	return nil, nil
+}
+
+func (r *fakeHolidayRepo) AddHoliday(ctx context.Context, holiday *model.Holiday) (*model.Holiday, error) {
+	r.holidays[holiday.Date] = holiday
+	return holiday, nil
+}
+
+func (r *fakeHolidayRepo) DeleteHoliday(ctx context.Context, date string) (bool, error) {
+	_, ok := r.holidays[date]
+	delete(r.holidays, date)
+	return ok, nil
+}
+
+func TestHolidays_CloseTheShop(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	holidays := &fakeHolidayRepo{holidays: map[string]*model.Holiday{}}
+	s := NewBookingService(&fakeBookingRepo{}, WithHolidayRepository(holidays), WithShopTimezone(berlin),
+		WithClock(clockAt(time.Date(2025, time.December, 1, 0, 0, 0, 0, time.UTC))))
+	ctx := context.Background()
+
+	// The holiday is a calendar day in the barber's zone
+	day := time.Date(2025, time.December, 25, 0, 0, 0, 0, berlin)
+
+	_, err = s.AddHoliday(ctx, day, "Christmas Day", "admin1")
+	assert.NoError(t, err)
+
+	slots, err := s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, slots)
+
+	err = s.checkBookable(ctx, "barber1", day.Add(10*time.Hour), day.Add(10*time.Hour+30*time.Minute))
+	assert.ErrorIs(t, err, ErrShopClosed)
+
+	// 23:30 UTC on Christmas Eve is already Christmas Day in Berlin
+	eve := time.Date(2025, time.December, 24, 23, 30, 0, 0, time.UTC)
+	assert.ErrorIs(t, s.checkBookable(ctx, "barber1", eve, eve.Add(30*time.Minute)), ErrShopClosed)
+	assert.NoError(t, s.checkBookable(ctx, "barber1", eve.Add(-time.Hour), eve.Add(-30*time.Minute)))
+
+	removed, err := s.RemoveHoliday(ctx, day)
+	assert.NoError(t, err)
+	assert.True(t, removed)
+}
@@ -8,13 +8,143 @@ import (
 	"github.com/ita-av/booking-service/internal/model"
 )
 
+// CreateBookingParams holds the inputs for creating a booking
+type CreateBookingParams struct {
+	UserID       string
+	BarberID     string
+	StartTime    time.Time
+	ServiceTypes []model.ServiceType // Done back to back, in order
+	Notes        string
+	ExternalRef  string
+	PromoCode    string // Optional; takes the code's discount off the price
+
+	// IdempotencyKey lets clients retry a create safely: replaying a key
+	// returns the booking created the first time
+	IdempotencyKey string
+
+	// HoldID books a hold from HoldTimeSlot instead; the barber, time and
+	// services are the hold's
+	HoldID string
+}
+
+// HoldTimeSlotParams holds the inputs for holding a slot
+type HoldTimeSlotParams struct {
+	UserID       string
+	BarberID     string
+	StartTime    time.Time
+	ServiceTypes []model.ServiceType // Done back to back, in order
+	Duration     time.Duration       // How long the slot is held
+}
+
+// UpdateBookingParams holds the changes to a booking. Nil fields are left
+// untouched, so a pointer to an empty string clears the notes.
+type UpdateBookingParams struct {
+	StartTime    *time.Time
+	ServiceTypes []model.ServiceType
+	Notes        *string
+
+	// Version, if set, makes the update fail unless the booking is still at it
+	Version *int64
+}
+
+// CancelBookingParams describes who is cancelling a booking and why
+type CancelBookingParams struct {
+	CancelledBy string
+	Reason      string
+
+	// WaivePolicy skips the cancellation policy, for cancellations by the shop
+	WaivePolicy bool
+}
+
 // BookingServiceInterface defines the interface for booking operations
 type BookingServiceInterface interface {
-	CreateBooking(ctx context.Context, userID, barberID string, startTime time.Time, serviceType model.ServiceType, notes string) (*model.Booking, error)
+	CreateBooking(ctx context.Context, params CreateBookingParams) (*model.Booking, error)
+	HoldTimeSlot(ctx context.Context, params HoldTimeSlotParams) (*model.Booking, error)
+	RebookLast(ctx context.Context, userID, idempotencyKey string) (*model.Booking, error)
 	GetBooking(ctx context.Context, id string) (*model.Booking, error)
-	UpdateBooking(ctx context.Context, id string, startTime *time.Time, serviceType *model.ServiceType, notes *string) (*model.Booking, error)
-	CancelBooking(ctx context.Context, id string) (bool, error)
+	GetBookingByExternalRef(ctx context.Context, externalRef string) (*model.Booking, error)
+	UpdateBooking(ctx context.Context, id string, params UpdateBookingParams) (*model.Booking, error)
+	RescheduleBooking(ctx context.Context, id string, startTime time.Time) (*model.Booking, error)
+	ConfirmBooking(ctx context.Context, id string) (*model.Booking, error)
+	CompleteBooking(ctx context.Context, id string) (*model.Booking, error)
+	CancelBooking(ctx context.Context, id string, params CancelBookingParams) (*model.Booking, error)
+	DeleteBooking(ctx context.Context, id string) (bool, error)
+	CheckInBooking(ctx context.Context, id string) (*model.Booking, error)
+	MarkNoShow(ctx context.Context, id string) (*model.Booking, error)
+	GetUserReliability(ctx context.Context, userID string) (*model.UserReliability, error)
+	GetReliabilityReport(ctx context.Context, filter model.ReliabilityFilter) ([]*model.ReliabilityRow, error)
+	GetCustomerSummary(ctx context.Context, userID string) (*model.CustomerSummary, error)
+	GetBookingHistory(ctx context.Context, bookingID string) ([]*model.BookingHistoryEntry, error)
+	GetBookingICS(ctx context.Context, id string) ([]byte, error)
+	ListAuditLog(ctx context.Context, filter model.AuditFilter) ([]*model.AuditEntry, error)
+	ReleaseLateBookings(ctx context.Context) (int, error)
+	SendBookingReminders(ctx context.Context) (int, error)
+	SendDailyAgendas(ctx context.Context) (int, error)
+	AddAttachment(ctx context.Context, bookingID string, params AttachmentParams) (*model.Attachment, string, error)
+	AttachmentURL(attachment *model.Attachment) string
+	ListBookings(ctx context.Context, filter model.BookingFilter) ([]*model.Booking, error)
+	WatchBookings(ctx context.Context, userID, barberID string) <-chan BookingEvent
 	GetUserBookings(ctx context.Context, userID string) ([]*model.Booking, error)
 	GetBarberBookings(ctx context.Context, barberID string, date *time.Time) ([]*model.Booking, error)
-	GetAvailableTimeSlots(ctx context.Context, barberID string, date time.Time) ([]*model.TimeSlot, error)
+	GetAvailableTimeSlots(ctx context.Context, barberID string, date time.Time, serviceTypes []model.ServiceType) ([]*model.TimeSlot, error)
+	GetAvailableTimeSlotsRange(ctx context.Context, barberID string, first, last time.Time, serviceTypes []model.ServiceType) ([]*model.DaySlots, error)
+	ListCatalogServices(ctx context.Context, includeInactive bool) ([]*model.CatalogService, error)
+	CreateCatalogService(ctx context.Context, service model.CatalogService) (*model.CatalogService, error)
+	UpdateCatalogService(ctx context.Context, service model.CatalogService) (*model.CatalogService, error)
+	DeleteCatalogService(ctx context.Context, serviceType model.ServiceType) (bool, error)
+	ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error)
+	GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error)
+	CreatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error)
+	UpdatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error)
+	DeletePromoCode(ctx context.Context, code string) (bool, error)
+	GetBarberServices(ctx context.Context, barberID string) ([]*model.OfferedService, error)
+	ListBarbers(ctx context.Context, includeInactive bool) ([]*model.Barber, error)
+	GetBarber(ctx context.Context, id string) (*model.Barber, error)
+	CreateBarber(ctx context.Context, barber model.Barber) (*model.Barber, error)
+	UpdateBarber(ctx context.Context, barber model.Barber) (*model.Barber, error)
+	DeleteBarber(ctx context.Context, id string) (bool, error)
+	AddFavoriteBarber(ctx context.Context, userID, barberID string) (*model.FavoriteBarber, error)
+	RemoveFavoriteBarber(ctx context.Context, userID, barberID string) (bool, error)
+	ListFavorites(ctx context.Context, userID string) ([]*model.FavoriteBarber, error)
+	ListResources(ctx context.Context) ([]*model.Resource, error)
+	CreateResource(ctx context.Context, resource model.Resource) (*model.Resource, error)
+	UpdateResource(ctx context.Context, resource model.Resource) (*model.Resource, error)
+	DeleteResource(ctx context.Context, id string) (bool, error)
+	GetQuote(ctx context.Context, barberID string, serviceTypes []model.ServiceType) (*model.Quote, error)
+	RecordDepositPayment(ctx context.Context, bookingID, intentID string, amount int64, currency string) (*model.Booking, error)
+	ExpireUnpaidBookings(ctx context.Context) (int, error)
+	ExpireStalePendingBookings(ctx context.Context) (int, error)
+	ExpireHolds(ctx context.Context) (int, error)
+	PurgeDeletedBookings(ctx context.Context) (int, error)
+	ExportUserData(ctx context.Context, userID string) (*model.UserDataExport, error)
+	EraseUserData(ctx context.Context, userID string, mode model.RetentionMode) (*ErasureResult, error)
+	AutoCompleteBookings(ctx context.Context) (completed, flagged int, err error)
+	RelayOutbox(ctx context.Context) (int, error)
+	SetBarberServices(ctx context.Context, services model.BarberServices) ([]*model.OfferedService, error)
+	GetNextAvailableSlot(ctx context.Context, barberID string, serviceTypes []model.ServiceType, count int) ([]*model.TimeSlot, error)
+	RecordPOSCompletion(ctx context.Context, params POSCompletionParams) (*model.Booking, error)
+	SubmitSurveyResponse(ctx context.Context, bookingID, token string, score int, comment string) error
+	GetBarberSurveyScores(ctx context.Context, barberID string, start, end *time.Time) (*model.SurveyScores, error)
+	SubmitReview(ctx context.Context, bookingID string, rating int, comment string) (*model.Review, error)
+	ListBarberReviews(ctx context.Context, barberID string, before time.Time, limit int) ([]*model.Review, error)
+	GetBarberRating(ctx context.Context, barberID string) (*model.BarberRating, error)
+	GetBarberStats(ctx context.Context, barberID string, period model.StatsPeriod, day time.Time) (*model.BarberStats, error)
+	GetDailyAgenda(ctx context.Context, barberID string, day time.Time) (*model.DailyAgenda, error)
+	GetDemandHeatmap(ctx context.Context, barberID string, weeks int) (*model.DemandHeatmap, error)
+	GetPayrollPeriod(ctx context.Context, year int, month time.Month) (*model.PayrollPeriod, error)
+	FinalizePayrollPeriod(ctx context.Context, year int, month time.Month) (*model.PayrollPeriod, error)
+	GetRevenueReport(ctx context.Context, filter model.RevenueFilter) (*model.RevenueReport, error)
+	GetRetentionPolicy(ctx context.Context) (*model.RetentionPolicy, error)
+	UpdateRetentionPolicy(ctx context.Context, policy model.RetentionPolicy, updatedBy string) (*model.RetentionPolicy, error)
+	GetCancellationPolicy(ctx context.Context) (*model.CancellationPolicy, error)
+	UpdateCancellationPolicy(ctx context.Context, policy model.CancellationPolicy, updatedBy string) (*model.CancellationPolicy, error)
+	ApplyRetentionPolicy(ctx context.Context) (*RetentionResult, error)
+	GetWorkingHours(ctx context.Context, barberID string) (*model.BarberSchedule, error)
+	SetWorkingHours(ctx context.Context, schedule model.BarberSchedule) (*model.BarberSchedule, error)
+	AddHoliday(ctx context.Context, date time.Time, name, createdBy string) (*model.Holiday, error)
+	RemoveHoliday(ctx context.Context, date time.Time) (bool, error)
+	ListHolidays(ctx context.Context, from, to *time.Time) ([]*model.Holiday, error)
+	CreateWebhook(ctx context.Context, webhook model.Webhook) (*model.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]*model.Webhook, error)
+	DeleteWebhook(ctx context.Context, id string) (bool, error)
 }
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// maxReliabilityRows caps how many rows a reliability report returns
+const maxReliabilityRows = 500
+
+// MarkNoShow records that the customer didn't turn up for a booking that has
+// started. Marking a booking that's already a no-show is a no-op.
+func (s *BookingService) MarkNoShow(ctx context.Context, id string) (*model.Booking, error) {
+	booking, err := s.repo.GetBookingByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+	if booking.Status == model.BookingStatusNoShow {
+		return booking, nil
+	}
+	if booking.CheckedInAt != nil {
+		return nil, ErrCustomerCheckedIn
+	}
+
+	// A deposit that hasn't been paid by now won't be
+	var updates map[string]interface{}
+	if booking.Deposit != nil && booking.Deposit.Status == model.DepositStatusPending {
+		updates = map[string]interface{}{"deposit.status": model.DepositStatusExpired}
+	}
+
+	noShowBooking, err := s.transitionStatus(ctx, booking, model.BookingStatusNoShow, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cancelDeposit(ctx, booking)
+
+	log.Info().
+		Str("bookingID", id).
+		Str("barberID", noShowBooking.BarberID).
+		Str("userID", noShowBooking.UserID).
+		Msg("Booking marked as no-show")
+
+	return noShowBooking, nil
+}
+
+// GetUserReliability summarizes how a customer's bookings turned out
+func (s *BookingService) GetUserReliability(ctx context.Context, userID string) (*model.UserReliability, error) {
+	reliability, err := s.repo.GetUserReliability(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user reliability")
+	}
+
+	return reliability, nil
+}
+
+// GetReliabilityReport summarizes how the bookings starting between two
+// days, both inclusive and taken in the shop's time zone, turned out per
+// customer or barber, with the highest no-show or cancellation rate first
+func (s *BookingService) GetReliabilityReport(ctx context.Context, filter model.ReliabilityFilter) ([]*model.ReliabilityRow, error) {
+	start, end, err := s.shopDayRange(filter.Start, filter.End, maxReportRangeDays)
+	if err != nil {
+		return nil, err
+	}
+	filter.Start, filter.End = start, end
+
+	if filter.Limit <= 0 || filter.Limit > maxReliabilityRows {
+		filter.Limit = maxReliabilityRows
+	}
+
+	rows, err := s.repo.GetReliabilityReport(ctx, filter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get reliability report")
+	}
+
+	return rows, nil
+}
+
+// requiresDeposit reports whether a customer has to prepay their bookings.
+// Without a no-show threshold everyone does; otherwise only customers who
+// missed at least that many bookings.
+func (s *BookingService) requiresDeposit(ctx context.Context, userID string) (bool, error) {
+	if s.noShowDepositThreshold <= 0 {
+		return true, nil
+	}
+
+	reliability, err := s.GetUserReliability(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return reliability.NoShows >= s.noShowDepositThreshold, nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// reliabilityBookingRepo counts bookings by status for each customer
+type reliabilityBookingRepo struct {
+	depositBookingRepo
+}
+
+func (r *reliabilityBookingRepo) GetUserReliability(ctx context.Context, userID string) (*model.UserReliability, error) {
+	reliability := &model.UserReliability{UserID: userID}
+	for _, b := range r.bookings {
+		if b.UserID != userID {
+			continue
+		}
+		reliability.Bookings++
+		switch b.Status {
+		case model.BookingStatusCompleted:
+			reliability.Completed++
+		case model.BookingStatusCancelled:
+			reliability.Cancelled++
+		case model.BookingStatusNoShow:
+			reliability.NoShows++
+		}
+	}
+	return reliability, nil
+}
+
+// reliabilityReportRepo records the filter a reliability report was asked for
+type reliabilityReportRepo struct {
+	fakeBookingRepo
+	filter model.ReliabilityFilter
+}
+
+func (r *reliabilityReportRepo) GetReliabilityReport(ctx context.Context, filter model.ReliabilityFilter) ([]*model.ReliabilityRow, error) {
+	r.filter = filter
+	return []*model.ReliabilityRow{{ID: "user1", Bookings: 4, Completed: 3, NoShows: 1}}, nil
+}
+
+func TestMarkNoShow(t *testing.T) {
+	now := time.Date(2025, time.June, 2, 10, 30, 0, 0, time.UTC)
+	checkedInAt := now.Add(-25 * time.Minute)
+	booking := func(start time.Time, checkedIn *time.Time) *model.Booking {
+		return &model.Booking{
+			ID:          primitive.NewObjectID(),
+			UserID:      "user1",
+			BarberID:    "barber1",
+			StartTime:   start,
+			EndTime:     start.Add(30 * time.Minute),
+			Status:      model.BookingStatusConfirmed,
+			CheckedInAt: checkedIn,
+		}
+	}
+	missed := booking(now.Add(-30*time.Minute), nil)
+	upcoming := booking(now.Add(time.Hour), nil)
+	attended := booking(now.Add(-30*time.Minute), &checkedInAt)
+
+	repo := &reliabilityBookingRepo{}
+	repo.bookings = []*model.Booking{missed, upcoming, attended}
+	s := NewBookingService(repo, WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	marked, err := s.MarkNoShow(ctx, missed.ID.Hex())
+	assert.NoError(t, err)
+	assert.Equal(t, model.BookingStatusNoShow, marked.Status)
+
+	// Marking it again is a no-op
+	marked, err = s.MarkNoShow(ctx, missed.ID.Hex())
+	assert.NoError(t, err)
+	assert.Equal(t, model.BookingStatusNoShow, marked.Status)
+
+	_, err = s.MarkNoShow(ctx, upcoming.ID.Hex())
+	assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+
+	_, err = s.MarkNoShow(ctx, attended.ID.Hex())
+	assert.ErrorIs(t, err, ErrCustomerCheckedIn)
+
+	_, err = s.MarkNoShow(ctx, primitive.NewObjectID().Hex())
+	assert.ErrorIs(t, err, ErrBookingNotFound)
+
+	reliability, err := s.GetUserReliability(ctx, "user1")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.UserReliability{UserID: "user1", Bookings: 3, NoShows: 1}, reliability)
+}
+
+func TestDeposits_NoShowThreshold(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	catalog := &fakeCatalogRepo{services: map[model.ServiceType]*model.CatalogService{
+		model.ServiceTypeHaircut: {ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Price: 2500, Active: true},
+	}}
+
+	repo := &reliabilityBookingRepo{}
+	repo.bookings = []*model.Booking{
+		{ID: primitive.NewObjectID(), UserID: "offender", Status: model.BookingStatusNoShow},
+	}
+	s := NewBookingService(repo, WithCatalogRepository(catalog), WithDeposits(&fakeProvider{}, 1, 15*time.Minute),
+		WithNoShowDepositThreshold(1), WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	create := func(userID string, start time.Time) *model.Booking {
+		booking, err := s.CreateBooking(ctx, CreateBookingParams{
+			UserID:       userID,
+			BarberID:     "barber1",
+			ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+			StartTime:    start,
+		})
+		assert.NoError(t, err)
+		return booking
+	}
+
+	// Customers who always turned up don't prepay
+	reliable := create("user1", time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC))
+	assert.Nil(t, reliable.Deposit)
+
+	offender := create("offender", time.Date(2025, time.June, 2, 11, 0, 0, 0, time.UTC))
+	if assert.NotNil(t, offender.Deposit) {
+		assert.Equal(t, int64(2500), offender.Deposit.Amount)
+	}
+}
+
+func TestGetReliabilityReport(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Rome")
+	assert.NoError(t, err)
+	repo := &reliabilityReportRepo{}
+	s := NewBookingService(repo, WithShopTimezone(loc))
+	ctx := context.Background()
+
+	rows, err := s.GetReliabilityReport(ctx, model.ReliabilityFilter{
+		GroupBy: model.ReliabilityByBarber,
+		Start:   time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+		End:     time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+		SortBy:  model.SortByCancellationRate,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+
+	// The days are taken in the shop's zone, and the limit defaults to the cap
+	assert.Equal(t, time.Date(2025, time.June, 1, 0, 0, 0, 0, loc), repo.filter.Start)
+	assert.Equal(t, time.Date(2025, time.July, 1, 0, 0, 0, 0, loc), repo.filter.End)
+	assert.Equal(t, model.ReliabilityByBarber, repo.filter.GroupBy)
+	assert.Equal(t, model.SortByCancellationRate, repo.filter.SortBy)
+	assert.Equal(t, maxReliabilityRows, repo.filter.Limit)
+
+	_, err = s.GetReliabilityReport(ctx, model.ReliabilityFilter{
+		Start: time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+
+	_, err = s.GetReliabilityReport(ctx, model.ReliabilityFilter{
+		Start: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+)
+
+// notifyCustomer tells a booking's customer it was made, rescheduled or
+// cancelled. The booking is already stored, so a failure is only logged.
+func (s *BookingService) notifyCustomer(ctx context.Context, kind notification.Kind, booking *model.Booking) {
+	if s.notifier == nil {
+		return
+	}
+
+	if err := s.notifier.Notify(ctx, s.customerMessage(ctx, kind, booking)); err != nil {
+		log.Error().Err(err).
+			Str("bookingID", booking.ID.Hex()).
+			Str("kind", string(kind)).
+			Msg("Failed to notify customer of booking change")
+	}
+}
+
+// customerMessage builds the notification telling a booking's customer
+// about it, with times in the barber's time zone
+func (s *BookingService) customerMessage(ctx context.Context, kind notification.Kind, booking *model.Booking) notification.Message {
+	loc := s.barberLocation(ctx, booking.BarberID)
+	start := booking.StartTime.In(loc)
+	services := model.DescribeServices(booking.Services())
+
+	msg := notification.Message{
+		Kind:      kind,
+		UserID:    booking.UserID,
+		BookingID: booking.ID.Hex(),
+		Booking:   booking,
+		Location:  loc,
+	}
+	switch kind {
+	case notification.KindBookingConfirmation:
+		msg.Subject = "Booking confirmed"
+		msg.Body = fmt.Sprintf("Your %s is booked for %s.", services, start.Format("Mon 2 Jan 15:04 MST"))
+	case notification.KindBookingRescheduled:
+		msg.Subject = "Booking rescheduled"
+		msg.Body = fmt.Sprintf("Your %s has moved to %s.", services, start.Format("Mon 2 Jan 15:04 MST"))
+	case notification.KindBookingCancelled:
+		msg.Subject = "Booking cancelled"
+		msg.Body = fmt.Sprintf("Your %s on %s has been cancelled.", services, start.Format("Mon 2 Jan 15:04 MST"))
+	case notification.KindBookingReminder:
+		msg.Subject = "Booking reminder"
+		msg.Body = fmt.Sprintf("A reminder that your %s is at %s.", services, start.Format("Mon 2 Jan 15:04 MST"))
+	}
+
+	return msg
+}
+
+// barberLocation returns the time zone a barber works in, falling back to
+// the shop's when their schedule can't be loaded
+func (s *BookingService) barberLocation(ctx context.Context, barberID string) *time.Location {
+	if schedule, err := s.GetWorkingHours(ctx, barberID); err == nil {
+		return schedule.Location()
+	}
+	return s.shopLocation
+}
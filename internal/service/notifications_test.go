@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+)
+
+// fakeNotifier records the notifications it's given
+type fakeNotifier struct {
+	messages []notification.Message
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, msg notification.Message) error {
+	n.messages = append(n.messages, msg)
+	return nil
+}
+
+func TestCancelBooking_NotifiesCustomer(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	booking := &model.Booking{
+		ID:        primitive.NewObjectID(),
+		UserID:    "user1",
+		BarberID:  "barber1",
+		StartTime: now.Add(48 * time.Hour),
+		EndTime:   now.Add(48*time.Hour + 30*time.Minute),
+		Status:    model.BookingStatusConfirmed,
+	}
+	repo := &depositBookingRepo{}
+	repo.bookings = []*model.Booking{booking}
+	notifier := &fakeNotifier{}
+	s := NewBookingService(repo,
+		WithNotifier(notifier),
+		WithClock(clockAt(now)),
+		WithScheduleRepository(&fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+			"barber1": {BarberID: "barber1", Timezone: "Europe/Berlin"},
+		}}),
+	)
+
+	_, err := s.CancelBooking(context.Background(), booking.ID.Hex(), CancelBookingParams{CancelledBy: "user1"})
+	require.NoError(t, err)
+
+	require.Len(t, notifier.messages, 1)
+	msg := notifier.messages[0]
+	assert.Equal(t, notification.KindBookingCancelled, msg.Kind)
+	assert.Equal(t, "user1", msg.UserID)
+	assert.Equal(t, model.BookingStatusCancelled, msg.Booking.Status)
+	assert.Equal(t, "Europe/Berlin", msg.Location.String())
+	assert.Equal(t, "Your haircut on Tue 3 Jun 11:00 CEST has been cancelled.", msg.Body)
+}
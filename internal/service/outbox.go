@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// outboxBatchSize is how many outbox events are read at a time
+const outboxBatchSize = 100
+
+// changeBooking applies a change to a booking and, if the change returns the
+// changed booking, drops its day's cached slots and publishes its event.
+// With an outbox, the event is
+// stored in the same transaction as the change, so it's published even if
+// the process dies right after the change.
+func (s *BookingService) changeBooking(ctx context.Context, eventType BookingEventType, change func(ctx context.Context) (*model.Booking, error)) (*model.Booking, error) {
+	if s.outboxRepo == nil {
+		booking, err := change(ctx)
+		if err != nil || booking == nil {
+			return booking, err
+		}
+		s.invalidateSlots(ctx, booking)
+		s.publishEvent(ctx, s.newEvent(ctx, eventType, booking))
+		return booking, nil
+	}
+
+	var booking *model.Booking
+	var event BookingEvent
+	err := s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		booking, err = change(ctx)
+		if err != nil || booking == nil {
+			return err
+		}
+
+		event = s.newEvent(ctx, eventType, booking)
+		return s.outboxRepo.AddEvent(ctx, &model.OutboxEvent{
+			EventID:    event.ID,
+			Type:       eventType.String(),
+			Booking:    booking,
+			OccurredAt: event.OccurredAt,
+		})
+	})
+	if err != nil || booking == nil {
+		return nil, err
+	}
+
+	s.invalidateSlots(ctx, booking)
+	s.publishEvent(ctx, event)
+	return booking, nil
+}
+
+// RelayOutbox publishes the events waiting in the outbox, oldest first, and
+// returns how many it published. It stops at the first failure, keeping the
+// events in order; they're tried again on the next run. Events are marked
+// after they're published, so one may be published twice if marking fails.
+func (s *BookingService) RelayOutbox(ctx context.Context) (int, error) {
+	if s.outboxRepo == nil || s.publisher == nil {
+		return 0, nil
+	}
+
+	published := 0
+	for {
+		events, err := s.outboxRepo.ListPendingEvents(ctx, outboxBatchSize)
+		if err != nil {
+			return published, errors.Wrap(err, "failed to list outbox events")
+		}
+
+		for _, outboxEvent := range events {
+			if err := s.relayEvent(ctx, outboxEvent); err != nil {
+				return published, err
+			}
+			published++
+		}
+
+		if len(events) < outboxBatchSize {
+			return published, nil
+		}
+	}
+}
+
+// relayEvent publishes an outbox event and marks it published
+func (s *BookingService) relayEvent(ctx context.Context, outboxEvent *model.OutboxEvent) error {
+	id := outboxEvent.ID.Hex()
+
+	eventType, ok := parseBookingEventType(outboxEvent.Type)
+	if !ok {
+		// Retrying can't help, and mustn't hold up the events behind it
+		log.Error().
+			Str("outboxEventID", id).
+			Str("type", outboxEvent.Type).
+			Msg("Skipped outbox event of unknown type")
+	} else {
+		err := s.publisher.Publish(ctx, BookingEvent{
+			ID:         outboxEvent.EventID,
+			Type:       eventType,
+			Booking:    outboxEvent.Booking,
+			OccurredAt: outboxEvent.OccurredAt,
+			Tenant:     tenant.FromContext(ctx),
+		})
+		if err != nil {
+			if recordErr := s.outboxRepo.RecordEventFailure(ctx, id, err.Error()); recordErr != nil {
+				log.Error().Err(recordErr).Str("outboxEventID", id).Msg("Failed to record outbox event failure")
+			}
+			return errors.Wrap(err, "failed to publish outbox event")
+		}
+	}
+
+	if err := s.outboxRepo.MarkEventPublished(ctx, id, s.clock.Now()); err != nil {
+		return errors.Wrap(err, "failed to mark outbox event published")
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// fakeOutbox stores events in memory. Its transactions keep the events added
+// in them only if they succeed.
+type fakeOutbox struct {
+	events   []*model.OutboxEvent
+	failures map[string]string
+}
+
+func (o *fakeOutbox) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	stored := len(o.events)
+	if err := fn(ctx); err != nil {
+		o.events = o.events[:stored]
+		return err
+	}
+	return nil
+}
+
+func (o *fakeOutbox) AddEvent(ctx context.Context, event *model.OutboxEvent) error {
+	event.ID = primitive.NewObjectID()
+	o.events = append(o.events, event)
+	return nil
+}
+
+func (o *fakeOutbox) ListPendingEvents(ctx context.Context, limit int64) ([]*model.OutboxEvent, error) {
+	var pending []*model.OutboxEvent
+	for _, event := range o.events {
+		if event.PublishedAt == nil && int64(len(pending)) < limit {
+			pending = append(pending, event)
+		}
+	}
+	return pending, nil
+}
+
+func (o *fakeOutbox) MarkEventPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	for _, event := range o.events {
+		if event.ID.Hex() == id {
+			event.PublishedAt = &publishedAt
+		}
+	}
+	return nil
+}
+
+func (o *fakeOutbox) RecordEventFailure(ctx context.Context, id string, reason string) error {
+	if o.failures == nil {
+		o.failures = map[string]string{}
+	}
+	o.failures[id] = reason
+	return nil
+}
+
+func TestOutbox_StoresEventsWithTheChange(t *testing.T) {
+	outbox := &fakeOutbox{}
+	publisher := &fakePublisher{}
+	s := NewBookingService(&fakeBookingRepo{}, WithEventPublisher(publisher), WithOutbox(outbox, outbox))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watched := s.WatchBookings(ctx, "", "")
+	booking := &model.Booking{ID: primitive.NewObjectID(), UserID: "user1"}
+
+	_, err := s.changeBooking(ctx, BookingCreated, func(ctx context.Context) (*model.Booking, error) {
+		return booking, nil
+	})
+	require.NoError(t, err)
+
+	// Watchers are told right away, the publisher only by the relay
+	event := <-watched
+	assert.Empty(t, publisher.events)
+	require.Len(t, outbox.events, 1)
+	assert.Equal(t, event.ID, outbox.events[0].EventID)
+	assert.Equal(t, "booking.created", outbox.events[0].Type)
+
+	// A failed change stores no event
+	_, err = s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+		return nil, errors.New("write conflict")
+	})
+	assert.Error(t, err)
+	assert.Len(t, outbox.events, 1)
+
+	// Neither does a change that found nothing to change
+	_, err = s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+		return nil, nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, outbox.events, 1)
+}
+
+func TestRelayOutbox(t *testing.T) {
+	outbox := &fakeOutbox{}
+	publisher := &fakePublisher{err: errors.New("broker unavailable")}
+	s := NewBookingService(&fakeBookingRepo{}, WithEventPublisher(publisher), WithOutbox(outbox, outbox))
+	ctx := context.Background()
+
+	for _, eventType := range []BookingEventType{BookingCreated, BookingCancelled} {
+		_, err := s.changeBooking(ctx, eventType, func(ctx context.Context) (*model.Booking, error) {
+			return &model.Booking{ID: primitive.NewObjectID()}, nil
+		})
+		require.NoError(t, err)
+	}
+
+	// A failure stops the relay, keeping the events in order
+	published, err := s.RelayOutbox(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 0, published)
+	assert.Len(t, publisher.events, 1)
+	assert.Equal(t, "broker unavailable", outbox.failures[outbox.events[0].ID.Hex()])
+
+	publisher.err = nil
+	publisher.events = nil
+	published, err = s.RelayOutbox(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+	require.Len(t, publisher.events, 2)
+	assert.Equal(t, BookingCreated, publisher.events[0].Type)
+	assert.Equal(t, outbox.events[0].EventID, publisher.events[0].ID)
+	assert.Equal(t, BookingCancelled, publisher.events[1].Type)
+
+	// Published events aren't published again
+	published, err = s.RelayOutbox(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, published)
+}
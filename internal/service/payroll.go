@@ -0,0 +1,174 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// PayrollFormat selects the encoding of a payroll export
+type PayrollFormat int
+
+// Constants for PayrollFormat
+const (
+	PayrollFormatCSV PayrollFormat = iota
+	PayrollFormatJSON
+)
+
+// GetPayrollPeriod returns the payroll for a month. Finalized periods are
+// returned as their locked snapshot; open periods are computed on the fly.
+func (s *BookingService) GetPayrollPeriod(ctx context.Context, year int, month time.Month) (*model.PayrollPeriod, error) {
+	if s.payrollRepo == nil {
+		return nil, errors.New("payroll storage is not configured")
+	}
+
+	stored, err := s.payrollRepo.GetPayrollPeriod(ctx, model.PayrollPeriodID(year, month))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get payroll period")
+	}
+
+	if stored != nil && stored.Finalized {
+		return stored, nil
+	}
+
+	return s.computePayrollPeriod(ctx, year, month)
+}
+
+// FinalizePayrollPeriod computes the payroll for a past month and locks it
+// so later exports return exactly the same figures
+func (s *BookingService) FinalizePayrollPeriod(ctx context.Context, year int, month time.Month) (*model.PayrollPeriod, error) {
+	if s.payrollRepo == nil {
+		return nil, errors.New("payroll storage is not configured")
+	}
+
+	_, end := model.PayrollPeriodRange(year, month)
+	if end.After(s.clock.Now()) {
+		return nil, ErrPayrollPeriodOpen
+	}
+
+	period, err := s.computePayrollPeriod(ctx, year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	period.Finalized = true
+	period.FinalizedAt = &now
+
+	if err := s.payrollRepo.SavePayrollPeriod(ctx, period); err != nil {
+		if errors.Is(err, repository.ErrPayrollPeriodFinalized) {
+			return nil, ErrPayrollPeriodFinalized
+		}
+		return nil, errors.Wrap(err, "failed to save payroll period")
+	}
+
+	log.Info().
+		Str("period", period.ID).
+		Int("barbers", len(period.Entries)).
+		Msg("Payroll period finalized")
+
+	return period, nil
+}
+
+// computePayrollPeriod aggregates completed bookings of a month per barber
+func (s *BookingService) computePayrollPeriod(ctx context.Context, year int, month time.Month) (*model.PayrollPeriod, error) {
+	start, end := model.PayrollPeriodRange(year, month)
+
+	bookings, err := s.repo.GetCompletedBookings(ctx, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get completed bookings")
+	}
+
+	entries := map[string]*model.PayrollEntry{}
+	for _, booking := range bookings {
+		entry, ok := entries[booking.BarberID]
+		if !ok {
+			entry = &model.PayrollEntry{BarberID: booking.BarberID}
+			entries[booking.BarberID] = entry
+		}
+
+		entry.CompletedBookings++
+		entry.HoursWorked += booking.EndTime.Sub(booking.StartTime).Hours()
+
+		if booking.Payment == nil {
+			continue
+		}
+
+		if booking.Payment.Currency != s.currency {
+			log.Warn().
+				Str("bookingID", booking.ID.Hex()).
+				Str("currency", booking.Payment.Currency).
+				Msg("Payment currency does not match payroll currency, excluding amounts")
+			continue
+		}
+
+		entry.Revenue += booking.Payment.Amount
+		entry.Tips += booking.Payment.Tip
+	}
+
+	period := &model.PayrollPeriod{
+		ID:             model.PayrollPeriodID(year, month),
+		Year:           year,
+		Month:          month,
+		Currency:       s.currency,
+		CommissionRate: s.commissionRate,
+		Entries:        make([]*model.PayrollEntry, 0, len(entries)),
+		GeneratedAt:    s.clock.Now(),
+	}
+
+	for _, entry := range entries {
+		entry.HoursWorked = math.Round(entry.HoursWorked*100) / 100
+		entry.Commission = int64(math.Round(float64(entry.Revenue) * s.commissionRate))
+		period.Entries = append(period.Entries, entry)
+	}
+
+	sort.Slice(period.Entries, func(i, j int) bool {
+		return period.Entries[i].BarberID < period.Entries[j].BarberID
+	})
+
+	return period, nil
+}
+
+// EncodePayroll renders a payroll period in a format consumable by payroll software
+func EncodePayroll(period *model.PayrollPeriod, format PayrollFormat) ([]byte, error) {
+	switch format {
+	case PayrollFormatJSON:
+		return json.MarshalIndent(period, "", "  ")
+	case PayrollFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+
+		w.Write([]string{"period", "barber_id", "completed_bookings", "hours_worked", "revenue", "commission", "tips", "currency"})
+		for _, entry := range period.Entries {
+			w.Write([]string{
+				period.ID,
+				entry.BarberID,
+				strconv.Itoa(entry.CompletedBookings),
+				strconv.FormatFloat(entry.HoursWorked, 'f', 2, 64),
+				strconv.FormatInt(entry.Revenue, 10),
+				strconv.FormatInt(entry.Commission, 10),
+				strconv.FormatInt(entry.Tips, 10),
+				period.Currency,
+			})
+		}
+
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, errors.Wrap(err, "failed to encode payroll csv")
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errors.Errorf("unsupported payroll format %d", format)
+	}
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+)
+
+// pendingExpiryBatchSize caps how many bookings one sweep cancels
+const pendingExpiryBatchSize = 100
+
+// ExpireStalePendingBookings cancels bookings still pending the pending
+// timeout after they were made, so unconfirmed bookings don't hold their
+// slots forever. It returns the number of cancelled bookings.
+func (s *BookingService) ExpireStalePendingBookings(ctx context.Context) (int, error) {
+	if s.pendingTimeout <= 0 {
+		return 0, nil
+	}
+
+	bookings, err := s.repo.FindStalePendingBookings(ctx, s.clock.Now().Add(-s.pendingTimeout), pendingExpiryBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find stale pending bookings")
+	}
+
+	expired := 0
+	for _, booking := range bookings {
+		// Like deposit expiry, this isn't a customer cancellation, so the
+		// cancellation policy doesn't apply
+		cancelledBooking, err := s.changeBooking(ctx, BookingCancelled, func(ctx context.Context) (*model.Booking, error) {
+			return s.repo.TransitionBookingStatus(ctx, booking.ID.Hex(), model.BookingStatusPending, model.BookingStatusCancelled, map[string]interface{}{
+				"cancellation": &model.Cancellation{
+					CancelledAt: s.clock.Now(),
+					Reason:      "not confirmed in time",
+				},
+			})
+		})
+		if err != nil {
+			return expired, errors.Wrap(err, "failed to expire booking")
+		}
+		if cancelledBooking == nil {
+			// Confirmed or changed in the meantime
+			continue
+		}
+		expired++
+
+		s.recordHistory(ctx, model.BookingActionCancelled, booking, cancelledBooking)
+		if err := s.cleanupAttachments(ctx, cancelledBooking); err != nil {
+			log.Error().Err(err).Str("bookingID", booking.ID.Hex()).Msg("Failed to clean up booking attachments")
+		}
+
+		log.Info().
+			Str("bookingID", booking.ID.Hex()).
+			Str("userID", booking.UserID).
+			Time("createdAt", booking.CreatedAt).
+			Msg("Stale pending booking expired")
+
+		s.notifyCustomer(ctx, notification.KindBookingCancelled, cancelledBooking)
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// stalePendingRepo finds stale pending bookings in memory
+type stalePendingRepo struct {
+	depositBookingRepo
+}
+
+func (r *stalePendingRepo) FindStalePendingBookings(ctx context.Context, createdBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		if b.Status == model.BookingStatusPending && b.Deposit == nil && b.CreatedAt.Before(createdBefore) {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func TestExpireStalePendingBookings(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	book := func(created time.Time, status model.BookingStatus) *model.Booking {
+		return &model.Booking{ID: primitive.NewObjectID(), UserID: "user1", BarberID: "barber1", StartTime: now.Add(24 * time.Hour), Status: status, CreatedAt: created}
+	}
+	stale := book(now.Add(-time.Hour), model.BookingStatusPending)
+	recent := book(now.Add(-10*time.Minute), model.BookingStatusPending)
+	confirmed := book(now.Add(-time.Hour), model.BookingStatusConfirmed)
+	repo := &stalePendingRepo{}
+	repo.bookings = []*model.Booking{stale, recent, confirmed}
+
+	notifier := &fakeNotifier{}
+	s := NewBookingService(repo, WithPendingTimeout(30*time.Minute), WithNotifier(notifier), WithClock(clockAt(now)))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watched := s.WatchBookings(ctx, "", "")
+
+	expired, err := s.ExpireStalePendingBookings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, expired)
+
+	assert.Equal(t, model.BookingStatusCancelled, stale.Status)
+	assert.Equal(t, &model.Cancellation{CancelledAt: now, Reason: "not confirmed in time"}, stale.Cancellation)
+	assert.Equal(t, model.BookingStatusPending, recent.Status)
+	assert.Equal(t, model.BookingStatusConfirmed, confirmed.Status)
+
+	// Watchers and the customer hear about it
+	event := <-watched
+	assert.Equal(t, BookingCancelled, event.Type)
+	assert.Equal(t, stale.ID, event.Booking.ID)
+	require.Len(t, notifier.messages, 1)
+	assert.Equal(t, stale.ID.Hex(), notifier.messages[0].BookingID)
+}
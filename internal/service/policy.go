@@ -0,0 +1,76 @@
+package service
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// SchedulingPolicy holds the shop's scheduling defaults. Barbers can override
+// each of them in their own schedule. The policy can be replaced while the
+// service runs, e.g. when its configuration is reloaded.
+type SchedulingPolicy struct {
+	// MinLeadTime is how soon a booking may start; zero means no limit
+	MinLeadTime time.Duration
+	// MaxAdvanceDays is how many days ahead a booking may start; zero means
+	// no limit
+	MaxAdvanceDays int
+	// DefaultSlotMinutes is how far apart offered start times are for
+	// barbers who haven't chosen
+	DefaultSlotMinutes int
+	// DefaultWorkStartMinute and DefaultWorkEndMinute are the daily working
+	// hours of barbers without a schedule, in minutes after midnight
+	DefaultWorkStartMinute int
+	DefaultWorkEndMinute   int
+}
+
+// DefaultSchedulingPolicy returns the policy used unless another is given:
+// no booking window, 30 minute slots and 9 AM to 5 PM working hours
+func DefaultSchedulingPolicy() SchedulingPolicy {
+	return SchedulingPolicy{
+		DefaultSlotMinutes:     model.DefaultSlotMinutes,
+		DefaultWorkStartMinute: model.DefaultWorkStartMinute,
+		DefaultWorkEndMinute:   model.DefaultWorkEndMinute,
+	}
+}
+
+// Validate checks that the policy's limits and defaults are usable
+func (p SchedulingPolicy) Validate() error {
+	switch {
+	case p.MinLeadTime < 0 || p.MaxAdvanceDays < 0:
+		return errors.Wrap(ErrInvalidPolicy, "the booking window must not be negative")
+	case !slices.Contains(model.SlotMinuteOptions, p.DefaultSlotMinutes):
+		return errors.Wrapf(ErrInvalidPolicy, "the default slot length must be one of %v minutes", model.SlotMinuteOptions)
+	case p.DefaultWorkStartMinute < 0 || p.DefaultWorkEndMinute > 24*60 || p.DefaultWorkStartMinute >= p.DefaultWorkEndMinute:
+		return errors.Wrap(ErrInvalidPolicy, "the default working hours must start before they end, within a day")
+	}
+	return nil
+}
+
+// WithSchedulingPolicy sets the shop's scheduling defaults. An invalid policy
+// is ignored, keeping the defaults.
+func WithSchedulingPolicy(policy SchedulingPolicy) Option {
+	return func(s *BookingService) {
+		if policy.Validate() == nil {
+			s.policy.Store(&policy)
+		}
+	}
+}
+
+// SchedulingPolicy returns the scheduling defaults currently applied
+func (s *BookingService) SchedulingPolicy() SchedulingPolicy {
+	return *s.policy.Load()
+}
+
+// SetSchedulingPolicy replaces the scheduling defaults for every request from
+// now on. Slots already cached keep the old defaults until they expire.
+func (s *BookingService) SetSchedulingPolicy(policy SchedulingPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	s.policy.Store(&policy)
+	return nil
+}
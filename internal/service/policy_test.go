@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+func TestSchedulingPolicy_Defaults(t *testing.T) {
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber2": {BarberID: "barber2", Hours: []model.WorkingHours{{Weekday: time.Monday, StartMinute: 600, EndMinute: 720}}, SlotMinutes: 15},
+	}}
+	s := NewBookingService(nil, WithScheduleRepository(schedules), WithSchedulingPolicy(SchedulingPolicy{
+		DefaultSlotMinutes:     60,
+		DefaultWorkStartMinute: 10 * 60,
+		DefaultWorkEndMinute:   18 * 60,
+	}))
+	ctx := context.Background()
+
+	schedule, err := s.GetWorkingHours(ctx, "barber1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, schedule.SlotDuration())
+	assert.Equal(t, []model.WorkingHours{{Weekday: time.Monday, StartMinute: 600, EndMinute: 1080}}, schedule.HoursOn(time.Monday))
+
+	// Barbers' own settings win
+	schedule, err = s.GetWorkingHours(ctx, "barber2")
+	require.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, schedule.SlotDuration())
+	assert.Equal(t, 720, schedule.HoursOn(time.Monday)[0].EndMinute)
+}
+
+func TestSetSchedulingPolicy(t *testing.T) {
+	now := time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC)
+	s := NewBookingService(&fakeBookingRepo{}, WithBookingWindow(time.Hour, 0), WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	assert.ErrorIs(t, s.checkBookingWindow(ctx, "barber1", now.Add(30*time.Minute)), ErrBookingTooSoon)
+
+	policy := s.SchedulingPolicy()
+	policy.MinLeadTime = 0
+	policy.MaxAdvanceDays = 7
+	require.NoError(t, s.SetSchedulingPolicy(policy))
+
+	assert.NoError(t, s.checkBookingWindow(ctx, "barber1", now.Add(30*time.Minute)))
+	assert.ErrorIs(t, s.checkBookingWindow(ctx, "barber1", now.AddDate(0, 0, 8)), ErrBookingTooFarAhead)
+	assert.Equal(t, model.DefaultSlotMinutes, s.SchedulingPolicy().DefaultSlotMinutes)
+}
+
+func TestSetSchedulingPolicy_Invalid(t *testing.T) {
+	s := NewBookingService(nil)
+
+	for name, change := range map[string]func(p *SchedulingPolicy){
+		"negative lead time":  func(p *SchedulingPolicy) { p.MinLeadTime = -time.Minute },
+		"unknown slot length": func(p *SchedulingPolicy) { p.DefaultSlotMinutes = 25 },
+		"hours ending early":  func(p *SchedulingPolicy) { p.DefaultWorkEndMinute = p.DefaultWorkStartMinute },
+		"hours past midnight": func(p *SchedulingPolicy) { p.DefaultWorkEndMinute = 25 * 60 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultSchedulingPolicy()
+			change(&policy)
+
+			assert.ErrorIs(t, s.SetSchedulingPolicy(policy), ErrInvalidPolicy)
+			assert.Equal(t, DefaultSchedulingPolicy(), s.SchedulingPolicy())
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// POSCompletionParams holds a settlement reported by a point-of-sale system.
+// The booking is identified either by BookingID or by ExternalRef.
+type POSCompletionParams struct {
+	BookingID        string
+	ExternalRef      string
+	Amount           int64
+	Tip              int64
+	Currency         string
+	RenderedServices []model.ServiceType
+	PaidAt           time.Time
+}
+
+// RecordPOSCompletion marks a booking as paid and completed with the final
+// amount and services reported by the point of sale. Differences against what
+// was booked are recorded on the payment so revenue reporting can reconcile them.
+func (s *BookingService) RecordPOSCompletion(ctx context.Context, params POSCompletionParams) (*model.Booking, error) {
+	var booking *model.Booking
+	var err error
+	if params.BookingID != "" {
+		booking, err = s.repo.GetBookingByID(ctx, params.BookingID)
+	} else {
+		booking, err = s.repo.GetBookingByExternalRef(ctx, params.ExternalRef)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking for completion")
+	}
+
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+
+	if booking.Payment != nil {
+		return nil, ErrBookingAlreadyPaid
+	}
+
+	renderedServices := params.RenderedServices
+	if len(renderedServices) == 0 {
+		renderedServices = booking.Services()
+	}
+
+	paidAt := params.PaidAt
+	if paidAt.IsZero() {
+		paidAt = s.clock.Now()
+	}
+
+	payment := &model.Payment{
+		Amount:           params.Amount,
+		Tip:              params.Tip,
+		Currency:         params.Currency,
+		RenderedServices: renderedServices,
+		Discrepancies:    reconcileServices(booking.Services(), renderedServices),
+		PaidAt:           paidAt,
+	}
+
+	var updatedBooking *model.Booking
+	if booking.Status == model.BookingStatusCompleted {
+		// Completed by the barber before it was settled
+		// Only if nothing else recorded a payment since it was read
+		updatedBooking, err = s.changeBooking(ctx, BookingUpdated, func(ctx context.Context) (*model.Booking, error) {
+			return s.repo.UpdateBookingIfUnset(ctx, booking.ID.Hex(), []string{"payment"}, map[string]interface{}{
+				"payment": payment,
+			})
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to record point-of-sale completion")
+		}
+		if updatedBooking == nil {
+			return nil, ErrBookingAlreadyPaid
+		}
+
+		s.recordHistory(ctx, model.BookingActionPaid, booking, updatedBooking)
+	} else {
+		updatedBooking, err = s.transitionStatus(ctx, booking, model.BookingStatusCompleted, map[string]interface{}{
+			"payment": payment,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logEvent := log.Info()
+	if len(payment.Discrepancies) > 0 {
+		logEvent = log.Warn().Strs("discrepancies", payment.Discrepancies)
+	}
+	logEvent.
+		Str("bookingID", updatedBooking.ID.Hex()).
+		Int64("amount", payment.Amount).
+		Int64("tip", payment.Tip).
+		Str("currency", payment.Currency).
+		Msg("Booking completed at point of sale")
+
+	s.onBookingCompleted(ctx, updatedBooking)
+
+	return updatedBooking, nil
+}
+
+// reconcileServices describes differences between the booked services and the
+// services actually rendered
+func reconcileServices(booked, rendered []model.ServiceType) []string {
+	var discrepancies []string
+
+	// Each booked service accounts for one rendered service of the same type
+	remaining := slices.Clone(booked)
+	for _, serviceType := range rendered {
+		if i := slices.Index(remaining, serviceType); i >= 0 {
+			remaining = slices.Delete(remaining, i, i+1)
+			continue
+		}
+		discrepancies = append(discrepancies, fmt.Sprintf("%s rendered but not booked", serviceType))
+	}
+
+	for _, serviceType := range remaining {
+		discrepancies = append(discrepancies, fmt.Sprintf("%s booked but not rendered", serviceType))
+	}
+
+	return discrepancies
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// payingRepo serves a booking read before another delivery recorded its
+// payment, so conditional payment updates find it paid
+type payingRepo struct {
+	softDeletingRepo
+	paidConcurrently bool
+}
+
+func (r *payingRepo) UpdateBookingIfUnset(ctx context.Context, id string, fields []string, updates map[string]interface{}) (*model.Booking, error) {
+	if r.paidConcurrently {
+		return nil, nil
+	}
+	booking, _ := r.GetBookingByID(ctx, id)
+	booking.Payment = updates["payment"].(*model.Payment)
+	return booking, nil
+}
+
+func TestReconcileServices(t *testing.T) {
+	haircut, beardTrim, hairWash := model.ServiceTypeHaircut, model.ServiceTypeBeardTrim, model.ServiceTypeHairWash
+
+	tests := []struct {
+		name     string
+		booked   []model.ServiceType
+		rendered []model.ServiceType
+		want     []string
+	}{
+		{"as booked", []model.ServiceType{haircut, beardTrim}, []model.ServiceType{beardTrim, haircut}, nil},
+		{"extra service", []model.ServiceType{haircut}, []model.ServiceType{haircut, hairWash}, []string{"hair wash rendered but not booked"}},
+		{"missed service", []model.ServiceType{haircut, beardTrim}, []model.ServiceType{haircut}, []string{"beard trim booked but not rendered"}},
+		{"same service twice", []model.ServiceType{haircut}, []model.ServiceType{haircut, haircut}, []string{"haircut rendered but not booked"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reconcileServices(tt.booked, tt.rendered))
+		})
+	}
+}
+
+func TestRecordPOSCompletion_AlreadyCompleted(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	booking := &model.Booking{
+		ID:           primitive.NewObjectID(),
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		StartTime:    now.Add(-time.Hour),
+		EndTime:      now.Add(-30 * time.Minute),
+		Status:       model.BookingStatusCompleted,
+	}
+	params := POSCompletionParams{BookingID: booking.ID.Hex(), Amount: 2500, Currency: "EUR"}
+
+	// Another delivery recorded a payment after the booking was read
+	repo := &payingRepo{paidConcurrently: true}
+	repo.bookings = []*model.Booking{booking}
+	s := NewBookingService(repo, WithClock(clockAt(now)))
+
+	_, err := s.RecordPOSCompletion(context.Background(), params)
+	assert.ErrorIs(t, err, ErrBookingAlreadyPaid)
+	assert.Nil(t, booking.Payment)
+
+	repo.paidConcurrently = false
+	paid, err := s.RecordPOSCompletion(context.Background(), params)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2500), paid.Payment.Amount)
+	assert.Equal(t, now, paid.Payment.PaidAt)
+}
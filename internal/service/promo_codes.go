@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// promoCodePattern is what promo codes may look like once upper-cased
+var promoCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// ListPromoCodes returns every promo code with how often it was used
+func (s *BookingService) ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error) {
+	if s.promoRepo == nil {
+		return nil, errors.New("promo codes are not configured")
+	}
+
+	promos, err := s.promoRepo.ListPromoCodes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list promo codes")
+	}
+
+	return promos, nil
+}
+
+// GetPromoCode returns a promo code with how often it was used
+func (s *BookingService) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	if s.promoRepo == nil {
+		return nil, errors.New("promo codes are not configured")
+	}
+
+	promo, err := s.promoRepo.GetPromoCode(ctx, model.NormalizePromoCode(code))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get promo code")
+	}
+	if promo == nil {
+		return nil, ErrPromoCodeNotFound
+	}
+
+	return promo, nil
+}
+
+// CreatePromoCode adds a promo code. Codes are stored upper case.
+func (s *BookingService) CreatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error) {
+	if s.promoRepo == nil {
+		return nil, errors.New("promo codes are not configured")
+	}
+
+	promo.Code = model.NormalizePromoCode(promo.Code)
+	if err := validatePromoCode(promo); err != nil {
+		return nil, err
+	}
+
+	created, err := s.promoRepo.CreatePromoCode(ctx, &promo)
+	if err != nil {
+		if errors.Is(err, repository.ErrPromoCodeExists) {
+			return nil, ErrPromoCodeExists
+		}
+		return nil, errors.Wrap(err, "failed to create promo code")
+	}
+
+	log.Info().
+		Str("code", created.Code).
+		Int("percentOff", created.PercentOff).
+		Int64("amountOff", created.AmountOff).
+		Int("maxUses", created.MaxUses).
+		Msg("Promo code created")
+
+	return created, nil
+}
+
+// UpdatePromoCode replaces a promo code's discount, validity window, limit
+// and active flag. Its uses are kept, and bookings already made with it keep
+// their discount.
+func (s *BookingService) UpdatePromoCode(ctx context.Context, promo model.PromoCode) (*model.PromoCode, error) {
+	if s.promoRepo == nil {
+		return nil, errors.New("promo codes are not configured")
+	}
+
+	promo.Code = model.NormalizePromoCode(promo.Code)
+	if err := validatePromoCode(promo); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.promoRepo.UpdatePromoCode(ctx, &promo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update promo code")
+	}
+	if updated == nil {
+		return nil, ErrPromoCodeNotFound
+	}
+
+	log.Info().
+		Str("code", updated.Code).
+		Int("percentOff", updated.PercentOff).
+		Int64("amountOff", updated.AmountOff).
+		Int("maxUses", updated.MaxUses).
+		Bool("active", updated.Active).
+		Msg("Promo code updated")
+
+	return updated, nil
+}
+
+// DeletePromoCode removes a promo code, reporting whether it existed.
+// Bookings made with it keep their discount and code.
+func (s *BookingService) DeletePromoCode(ctx context.Context, code string) (bool, error) {
+	if s.promoRepo == nil {
+		return false, errors.New("promo codes are not configured")
+	}
+
+	deleted, err := s.promoRepo.DeletePromoCode(ctx, model.NormalizePromoCode(code))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete promo code")
+	}
+
+	return deleted, nil
+}
+
+// validatePromoCode checks a promo code before it's stored
+func validatePromoCode(promo model.PromoCode) error {
+	if !promoCodePattern.MatchString(promo.Code) {
+		return errors.Wrap(ErrInvalidPromoCode, "code must be 3 to 32 letters, digits, dashes or underscores")
+	}
+	if (promo.PercentOff != 0) == (promo.AmountOff != 0) {
+		return errors.Wrap(ErrInvalidPromoCode, "exactly one of percent off and amount off is required")
+	}
+	if promo.PercentOff < 0 || promo.PercentOff > 100 {
+		return errors.Wrap(ErrInvalidPromoCode, "percent off must be between 1 and 100")
+	}
+	if promo.AmountOff < 0 {
+		return errors.Wrap(ErrInvalidPromoCode, "amount off can't be negative")
+	}
+	if promo.ValidFrom != nil && promo.ValidUntil != nil && !promo.ValidUntil.After(*promo.ValidFrom) {
+		return errors.Wrap(ErrInvalidPromoCode, "valid until must be after valid from")
+	}
+	if promo.MaxUses < 0 {
+		return errors.Wrap(ErrInvalidPromoCode, "max uses can't be negative")
+	}
+	return nil
+}
+
+// applyPromoCode counts a use of the code and takes its discount off the
+// booking's price. Bookings that aren't made after all give the use back with
+// releasePromoCode.
+func (s *BookingService) applyPromoCode(ctx context.Context, booking *model.Booking, code string) error {
+	if s.promoRepo == nil {
+		return ErrPromoCodeUnavailable
+	}
+
+	promo, err := s.promoRepo.RedeemPromoCode(ctx, model.NormalizePromoCode(code), s.clock.Now())
+	if err != nil {
+		return errors.Wrap(err, "failed to redeem promo code")
+	}
+	if promo == nil {
+		return ErrPromoCodeUnavailable
+	}
+
+	booking.PromoCode = promo.Code
+	booking.Discount = promo.Discount(booking.Price)
+	booking.Price -= booking.Discount
+	return nil
+}
+
+// releasePromoCode gives back the use of a promo code counted for a booking
+// that wasn't made
+func (s *BookingService) releasePromoCode(ctx context.Context, booking *model.Booking) {
+	if booking.PromoCode == "" || s.promoRepo == nil {
+		return
+	}
+
+	if err := s.promoRepo.ReleasePromoCode(ctx, booking.PromoCode); err != nil {
+		log.Error().
+			Err(err).
+			Str("code", booking.PromoCode).
+			Msg("Failed to release promo code")
+	}
+}
+
+// promoDiscount returns the discount a booking made with a promo code gets
+// on its services' new price. The code's current terms apply, but not its
+// validity window or limit, as the booking already used it. Bookings whose
+// code was deleted keep their discount, up to the new price.
+func (s *BookingService) promoDiscount(ctx context.Context, booking *model.Booking, price int64) (int64, error) {
+	if booking.PromoCode == "" {
+		return 0, nil
+	}
+
+	if s.promoRepo != nil {
+		promo, err := s.promoRepo.GetPromoCode(ctx, booking.PromoCode)
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to get promo code")
+		}
+		if promo != nil {
+			return promo.Discount(price), nil
+		}
+	}
+
+	return min(booking.Discount, price), nil
+}
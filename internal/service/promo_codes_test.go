@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// fakePromoRepo keeps promo codes in memory
+type fakePromoRepo struct {
+	promos map[string]*model.PromoCode
+}
+
+func (r *fakePromoRepo) ListPromoCodes(ctx context.Context) ([]*model.PromoCode, error) {
+	var promos []*model.PromoCode
+	for _, promo := range r.promos {
+		promos = append(promos, promo)
+	}
+	return promos, nil
+}
+
+func (r *fakePromoRepo) GetPromoCode(ctx context.Context, code string) (*model.PromoCode, error) {
+	return r.promos[code], nil
+}
+
+func (r *fakePromoRepo) CreatePromoCode(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error) {
+	if _, ok := r.promos[promo.Code]; ok {
+		return nil, repository.ErrPromoCodeExists
+	}
+	r.promos[promo.Code] = promo
+	return promo, nil
+}
+
+func (r *fakePromoRepo) UpdatePromoCode(ctx context.Context, promo *model.PromoCode) (*model.PromoCode, error) {
+	existing, ok := r.promos[promo.Code]
+	if !ok {
+		return nil, nil
+	}
+	promo.Uses = existing.Uses
+	r.promos[promo.Code] = promo
+	return promo, nil
+}
+
+func (r *fakePromoRepo) DeletePromoCode(ctx context.Context, code string) (bool, error) {
+	_, ok := r.promos[code]
+	delete(r.promos, code)
+	return ok, nil
+}
+
+func (r *fakePromoRepo) RedeemPromoCode(ctx context.Context, code string, now time.Time) (*model.PromoCode, error) {
+	promo, ok := r.promos[code]
+	if !ok || !promo.ValidAt(now) || (promo.MaxUses > 0 && promo.Uses >= promo.MaxUses) {
+		return nil, nil
+	}
+	promo.Uses++
+	return promo, nil
+}
+
+func (r *fakePromoRepo) ReleasePromoCode(ctx context.Context, code string) error {
+	if promo, ok := r.promos[code]; ok && promo.Uses > 0 {
+		promo.Uses--
+	}
+	return nil
+}
+
+// failingBookingRepo fails to store bookings
+type failingBookingRepo struct {
+	fakeBookingRepo
+}
+
+func (r *failingBookingRepo) CreateBooking(ctx context.Context, booking *model.Booking, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	return nil, errors.New("connection reset")
+}
+
+func TestPromoCodes_CRUD(t *testing.T) {
+	s := NewBookingService(&fakeBookingRepo{}, WithPromoCodeRepository(&fakePromoRepo{promos: map[string]*model.PromoCode{}}))
+	ctx := context.Background()
+
+	created, err := s.CreatePromoCode(ctx, model.PromoCode{Code: "spring15", PercentOff: 15, MaxUses: 100, Active: true})
+	require.NoError(t, err)
+	assert.Equal(t, "SPRING15", created.Code)
+
+	_, err = s.CreatePromoCode(ctx, model.PromoCode{Code: "Spring15", PercentOff: 10})
+	assert.ErrorIs(t, err, ErrPromoCodeExists)
+
+	// Looked up whatever the case
+	promo, err := s.GetPromoCode(ctx, "Spring15")
+	require.NoError(t, err)
+	assert.Equal(t, 15, promo.PercentOff)
+
+	updated, err := s.UpdatePromoCode(ctx, model.PromoCode{Code: "SPRING15", AmountOff: 500, Active: false})
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), updated.AmountOff)
+	assert.False(t, updated.Active)
+
+	_, err = s.UpdatePromoCode(ctx, model.PromoCode{Code: "SUMMER", PercentOff: 10})
+	assert.ErrorIs(t, err, ErrPromoCodeNotFound)
+
+	deleted, err := s.DeletePromoCode(ctx, "spring15")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	_, err = s.GetPromoCode(ctx, "SPRING15")
+	assert.ErrorIs(t, err, ErrPromoCodeNotFound)
+}
+
+func TestPromoCodes_Validation(t *testing.T) {
+	s := NewBookingService(&fakeBookingRepo{}, WithPromoCodeRepository(&fakePromoRepo{promos: map[string]*model.PromoCode{}}))
+	ctx := context.Background()
+	from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, promo := range []model.PromoCode{
+		{Code: "AB", PercentOff: 10},
+		{Code: "SPRING 15", PercentOff: 10},
+		{Code: "SPRING15"},
+		{Code: "SPRING15", PercentOff: 10, AmountOff: 500},
+		{Code: "SPRING15", PercentOff: 101},
+		{Code: "SPRING15", AmountOff: -500},
+		{Code: "SPRING15", PercentOff: 10, MaxUses: -1},
+		{Code: "SPRING15", PercentOff: 10, ValidFrom: &from, ValidUntil: &from},
+	} {
+		_, err := s.CreatePromoCode(ctx, promo)
+		assert.ErrorIs(t, err, ErrInvalidPromoCode, "%+v", promo)
+	}
+}
+
+func TestPromoCodes_AppliedWhenBooking(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	catalog := &fakeCatalogRepo{services: map[model.ServiceType]*model.CatalogService{
+		model.ServiceTypeHaircut: {ServiceType: model.ServiceTypeHaircut, Name: "Haircut", DurationMinutes: 30, Price: 2500, Active: true},
+	}}
+	promos := &fakePromoRepo{promos: map[string]*model.PromoCode{
+		"SPRING15": {Code: "SPRING15", PercentOff: 15, MaxUses: 1, Active: true},
+	}}
+	repo := &creatingBookingRepo{}
+	s := NewBookingService(repo, WithCatalogRepository(catalog), WithPromoCodeRepository(promos), WithClock(clockAt(now)))
+	ctx := context.Background()
+	params := func(start time.Time) CreateBookingParams {
+		return CreateBookingParams{
+			UserID:       "user1",
+			BarberID:     "barber1",
+			ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+			StartTime:    start,
+			PromoCode:    "spring15",
+		}
+	}
+
+	booking, err := s.CreateBooking(ctx, params(now.Add(34*time.Hour)))
+	require.NoError(t, err)
+	assert.Equal(t, "SPRING15", booking.PromoCode)
+	assert.Equal(t, int64(375), booking.Discount)
+	assert.Equal(t, int64(2125), booking.Price)
+	assert.Equal(t, 1, promos.promos["SPRING15"].Uses)
+
+	// The code is used up
+	_, err = s.CreateBooking(ctx, params(now.Add(36*time.Hour)))
+	assert.ErrorIs(t, err, ErrPromoCodeUnavailable)
+
+	_, err = s.CreateBooking(ctx, CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		StartTime:    now.Add(36 * time.Hour),
+		PromoCode:    "NOSUCHCODE",
+	})
+	assert.ErrorIs(t, err, ErrPromoCodeUnavailable)
+
+	// Changing the booking's services applies the code's terms to the new price
+	discount, err := s.promoDiscount(ctx, booking, 4000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(600), discount)
+
+	// Bookings whose code was deleted keep their discount
+	delete(promos.promos, "SPRING15")
+	discount, err = s.promoDiscount(ctx, booking, 4000)
+	require.NoError(t, err)
+	assert.Equal(t, int64(375), discount)
+}
+
+func TestPromoCodes_ReleasedWhenBookingFails(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	promos := &fakePromoRepo{promos: map[string]*model.PromoCode{
+		"FIVEOFF": {Code: "FIVEOFF", AmountOff: 500, MaxUses: 1, Active: true},
+	}}
+	s := NewBookingService(&failingBookingRepo{}, WithPromoCodeRepository(promos), WithClock(clockAt(now)))
+
+	_, err := s.CreateBooking(context.Background(), CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		StartTime:    now.Add(34 * time.Hour),
+		PromoCode:    "FIVEOFF",
+	})
+	assert.Error(t, err)
+	assert.Zero(t, promos.promos["FIVEOFF"].Uses)
+}
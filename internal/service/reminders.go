@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/notification"
+)
+
+// reminderBatchSize caps how many reminders one run sends, so a backlog
+// after downtime is worked off over several runs
+const reminderBatchSize = 100
+
+// SendBookingReminders reminds customers of their bookings starting within
+// the reminder lead time, once per booking, and returns how many were sent.
+// A booking is marked once its reminder is sent, so a reminder that failed
+// is tried again on the next run; one sent just before a crash may be sent
+// twice.
+func (s *BookingService) SendBookingReminders(ctx context.Context) (int, error) {
+	if s.reminderLead <= 0 || s.notifier == nil {
+		return 0, nil
+	}
+
+	now := s.clock.Now()
+	bookings, err := s.repo.FindBookingsDueReminder(ctx, now, now.Add(s.reminderLead), reminderBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find bookings due a reminder")
+	}
+
+	sent := 0
+	for _, booking := range bookings {
+		if err := s.notifier.Notify(ctx, s.customerMessage(ctx, notification.KindBookingReminder, booking)); err != nil {
+			log.Error().Err(err).Str("bookingID", booking.ID.Hex()).Msg("Failed to send booking reminder")
+			continue
+		}
+
+		marked, err := s.repo.MarkReminderSent(ctx, booking.ID.Hex(), booking.StartTime, s.clock.Now())
+		if err != nil {
+			return sent, errors.Wrap(err, "failed to mark reminder sent")
+		}
+		if !marked {
+			// Rescheduled while the reminder was sent; the new time gets its own
+			log.Warn().Str("bookingID", booking.ID.Hex()).Msg("Booking changed while sending its reminder")
+			continue
+		}
+		sent++
+	}
+
+	if sent > 0 {
+		log.Info().Int("count", sent).Msg("Booking reminders sent")
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+)
+
+// reminderBookingRepo finds bookings due a reminder in memory
+type reminderBookingRepo struct {
+	fakeBookingRepo
+}
+
+func (r *reminderBookingRepo) FindBookingsDueReminder(ctx context.Context, now, startsBefore time.Time, limit int64) ([]*model.Booking, error) {
+	var due []*model.Booking
+	for _, b := range r.bookings {
+		if b.ReminderSentAt == nil && b.StartTime.After(now) && !b.StartTime.After(startsBefore) && b.Status <= model.BookingStatusConfirmed {
+			due = append(due, b)
+		}
+	}
+	return due, nil
+}
+
+func (r *reminderBookingRepo) MarkReminderSent(ctx context.Context, id string, startTime, sentAt time.Time) (bool, error) {
+	for _, b := range r.bookings {
+		if b.ID.Hex() == id && b.StartTime.Equal(startTime) && b.ReminderSentAt == nil {
+			b.ReminderSentAt = &sentAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestSendBookingReminders(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	book := func(start time.Time, status model.BookingStatus) *model.Booking {
+		return &model.Booking{ID: primitive.NewObjectID(), UserID: "user1", BarberID: "barber1", StartTime: start, EndTime: start.Add(30 * time.Minute), Status: status}
+	}
+	tomorrow := book(now.Add(20*time.Hour), model.BookingStatusConfirmed)
+	repo := &reminderBookingRepo{}
+	repo.bookings = []*model.Booking{
+		tomorrow,
+		book(now.Add(48*time.Hour), model.BookingStatusConfirmed),
+		book(now.Add(2*time.Hour), model.BookingStatusCancelled),
+		book(now.Add(-time.Hour), model.BookingStatusConfirmed),
+	}
+	notifier := &fakeNotifier{}
+	s := NewBookingService(repo, WithNotifier(notifier), WithReminders(24*time.Hour), WithClock(clockAt(now)))
+
+	sent, err := s.SendBookingReminders(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	require.Len(t, notifier.messages, 1)
+	assert.Equal(t, notification.KindBookingReminder, notifier.messages[0].Kind)
+	assert.Equal(t, tomorrow.ID.Hex(), notifier.messages[0].BookingID)
+	assert.Equal(t, now, *tomorrow.ReminderSentAt)
+
+	// Each booking is only reminded once
+	sent, err = s.SendBookingReminders(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, sent)
+	assert.Len(t, notifier.messages, 1)
+}
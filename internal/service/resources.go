@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// Limits on resources
+const (
+	maxResourceNameLength = 100
+	maxResourceCapacity   = 100
+)
+
+// resourceIDPattern is what resource IDs look like, e.g. "wash-station"
+var resourceIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,63}$`)
+
+// ListResources returns the shop's shared equipment
+func (s *BookingService) ListResources(ctx context.Context) ([]*model.Resource, error) {
+	if s.resourceRepo == nil {
+		return nil, nil
+	}
+
+	resources, err := s.resourceRepo.ListResources(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list resources")
+	}
+
+	return resources, nil
+}
+
+// CreateResource adds a piece of shared equipment
+func (s *BookingService) CreateResource(ctx context.Context, resource model.Resource) (*model.Resource, error) {
+	if s.resourceRepo == nil {
+		return nil, errors.New("resource storage is not configured")
+	}
+	if err := validateResource(resource); err != nil {
+		return nil, err
+	}
+
+	created, err := s.resourceRepo.CreateResource(ctx, &resource)
+	if err != nil {
+		if errors.Is(err, repository.ErrResourceExists) {
+			return nil, ErrResourceExists
+		}
+		return nil, errors.Wrap(err, "failed to create resource")
+	}
+
+	log.Info().
+		Str("resourceID", created.ID).
+		Int("capacity", created.Capacity).
+		Msg("Resource created")
+
+	return created, nil
+}
+
+// UpdateResource replaces a resource's name, capacity and the services that
+// need it. Existing bookings stay as they are, even if they now overbook it.
+func (s *BookingService) UpdateResource(ctx context.Context, resource model.Resource) (*model.Resource, error) {
+	if s.resourceRepo == nil {
+		return nil, errors.New("resource storage is not configured")
+	}
+	if err := validateResource(resource); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.resourceRepo.UpdateResource(ctx, &resource)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update resource")
+	}
+	if updated == nil {
+		return nil, ErrResourceNotFound
+	}
+
+	log.Info().
+		Str("resourceID", updated.ID).
+		Int("capacity", updated.Capacity).
+		Msg("Resource updated")
+
+	return updated, nil
+}
+
+// DeleteResource removes a resource, reporting whether it was there. Its
+// services no longer wait for it.
+func (s *BookingService) DeleteResource(ctx context.Context, id string) (bool, error) {
+	if s.resourceRepo == nil {
+		return false, errors.New("resource storage is not configured")
+	}
+
+	deleted, err := s.resourceRepo.DeleteResource(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete resource")
+	}
+
+	return deleted, nil
+}
+
+// validateResource checks a resource before it's stored
+func validateResource(resource model.Resource) error {
+	if !resourceIDPattern.MatchString(resource.ID) {
+		return errors.Wrap(ErrInvalidResource, "id must be lower-case letters, digits and dashes")
+	}
+	if resource.Name == "" {
+		return errors.Wrap(ErrInvalidResource, "name is required")
+	}
+	if utf8.RuneCountInString(resource.Name) > maxResourceNameLength {
+		return errors.Wrapf(ErrInvalidResource, "name can be at most %d characters", maxResourceNameLength)
+	}
+	if resource.Capacity < 1 || resource.Capacity > maxResourceCapacity {
+		return errors.Wrapf(ErrInvalidResource, "capacity must be between 1 and %d", maxResourceCapacity)
+	}
+	for _, serviceType := range resource.ServiceTypes {
+		if serviceType < 0 {
+			return errors.Wrap(ErrInvalidResource, "service type can't be negative")
+		}
+	}
+	return nil
+}
+
+// resourcesFor returns the resources any of the services need
+func (s *BookingService) resourcesFor(ctx context.Context, serviceTypes []model.ServiceType) ([]*model.Resource, error) {
+	if s.resourceRepo == nil || len(serviceTypes) == 0 {
+		return nil, nil
+	}
+
+	resources, err := s.resourceRepo.ListResources(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to look up resources")
+	}
+
+	var needed []*model.Resource
+	for _, resource := range resources {
+		if resource.NeededFor(serviceTypes...) {
+			needed = append(needed, resource)
+		}
+	}
+	return needed, nil
+}
+
+// checkResources fails with ErrResourceUnavailable, naming the resource, if
+// the bookings other than excludeID leave one of the resources no unit free
+// for a booking occupying [start, occupiedUntil)
+func (s *BookingService) checkResources(ctx context.Context, resources []*model.Resource, start, occupiedUntil time.Time, excludeID primitive.ObjectID) error {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	// Widen the search so earlier bookings whose buffer runs into the window are found
+	maxCleanup, err := s.maxCleanupBuffer(ctx)
+	if err != nil {
+		return err
+	}
+	bookings, err := s.repo.GetBookingsForServices(ctx, model.ResourceServiceTypes(resources), start.Add(-maxCleanup), occupiedUntil)
+	if err != nil {
+		return errors.Wrap(err, "failed to check resource availability")
+	}
+
+	others := bookings[:0]
+	for _, booking := range bookings {
+		if booking.ID != excludeID {
+			others = append(others, booking)
+		}
+	}
+
+	if full := fullResource(resources, others, start, occupiedUntil); full != nil {
+		return errors.Wrapf(ErrResourceUnavailable, "%s", full.Name)
+	}
+	return nil
+}
+
+// resourceUnavailable builds the error for a booking a repository turned down
+// because a resource was taken concurrently, naming the resource if it's
+// still full
+func (s *BookingService) resourceUnavailable(ctx context.Context, resources []*model.Resource, start, occupiedUntil time.Time, excludeID primitive.ObjectID) error {
+	if err := s.checkResources(ctx, resources, start, occupiedUntil, excludeID); errors.Is(err, ErrResourceUnavailable) {
+		return err
+	}
+	return ErrResourceUnavailable
+}
+
+// fullResource returns the first of the resources that the bookings leave no
+// unit free for a booking occupying [start, occupiedUntil), or nil if they
+// all have room
+func fullResource(resources []*model.Resource, bookings []*model.Booking, start, occupiedUntil time.Time) *model.Resource {
+	for _, resource := range resources {
+		if !model.FitsCapacity(resource.Users(bookings), start, occupiedUntil, resource.Capacity) {
+			return resource
+		}
+	}
+	return nil
+}
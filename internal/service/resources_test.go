@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// fakeResourceRepo stores resources in memory
+type fakeResourceRepo struct {
+	repository.ResourceRepository
+	resources []*model.Resource
+}
+
+func (r *fakeResourceRepo) ListResources(ctx context.Context) ([]*model.Resource, error) {
+	return r.resources, nil
+}
+
+func TestResources_SharedAcrossBarbers(t *testing.T) {
+	now := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	ten := time.Date(2025, time.March, 4, 10, 0, 0, 0, time.UTC)
+	wash := &model.Resource{ID: "wash-station", Name: "Wash station", Capacity: 1, ServiceTypes: []model.ServiceType{model.ServiceTypeHairWash}}
+
+	// Another barber's client is at the only wash station
+	repo := &creatingBookingRepo{fakeBookingRepo: fakeBookingRepo{bookings: []*model.Booking{{
+		ID:          primitive.NewObjectID(),
+		BarberID:    "barber2",
+		StartTime:   ten,
+		EndTime:     model.CalculateEndTime(ten, model.ServiceTypeHairWash),
+		ServiceType: model.ServiceTypeHairWash,
+		Status:      model.BookingStatusConfirmed,
+	}}}}
+	s := NewBookingService(repo, WithResourceRepository(&fakeResourceRepo{resources: []*model.Resource{wash}}), WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	slotsAt := func(barberID string, serviceType model.ServiceType) []time.Time {
+		slots, err := s.GetAvailableTimeSlots(ctx, barberID, ten, []model.ServiceType{serviceType})
+		require.NoError(t, err)
+		var starts []time.Time
+		for _, slot := range slots {
+			starts = append(starts, slot.StartTime)
+		}
+		return starts
+	}
+	book := func(barberID string, serviceType model.ServiceType) error {
+		_, err := s.CreateBooking(ctx, CreateBookingParams{
+			UserID:       "user1",
+			BarberID:     barberID,
+			StartTime:    ten,
+			ServiceTypes: []model.ServiceType{serviceType},
+		})
+		return err
+	}
+
+	// The barber is free, but a wash has to wait for the station
+	assert.NotContains(t, slotsAt("barber1", model.ServiceTypeHairWash), ten)
+	err := book("barber1", model.ServiceTypeHairWash)
+	assert.ErrorIs(t, err, ErrResourceUnavailable)
+	assert.ErrorContains(t, err, "Wash station")
+
+	// Services that don't need it go ahead
+	assert.Contains(t, slotsAt("barber1", model.ServiceTypeHaircut), ten)
+	require.NoError(t, book("barber1", model.ServiceTypeHaircut))
+	assert.Empty(t, repo.resources)
+
+	// A second station makes room
+	wash.Capacity = 2
+	assert.Contains(t, slotsAt("barber3", model.ServiceTypeHairWash), ten)
+	require.NoError(t, book("barber3", model.ServiceTypeHairWash))
+	assert.Equal(t, []*model.Resource{wash}, repo.resources)
+}
+
+func TestValidateResource(t *testing.T) {
+	valid := model.Resource{ID: "wash-station", Name: "Wash station", Capacity: 2, ServiceTypes: []model.ServiceType{model.ServiceTypeHairWash}}
+	assert.NoError(t, validateResource(valid))
+
+	for _, mutate := range []func(r *model.Resource){
+		func(r *model.Resource) { r.ID = "" },
+		func(r *model.Resource) { r.ID = "Wash Station" },
+		func(r *model.Resource) { r.Name = "" },
+		func(r *model.Resource) { r.Capacity = 0 },
+		func(r *model.Resource) { r.Capacity = maxResourceCapacity + 1 },
+		func(r *model.Resource) { r.ServiceTypes = []model.ServiceType{-1} },
+	} {
+		resource := valid
+		mutate(&resource)
+		assert.ErrorIs(t, validateResource(resource), ErrInvalidResource)
+	}
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// retentionBatchSize caps how many bookings are purged per repository call
+const retentionBatchSize = 500
+
+// maxRetentionDays bounds retention periods to something a shop could mean
+const maxRetentionDays = 100 * 365
+
+// RetentionResult summarizes a retention run
+type RetentionResult struct {
+	Anonymized   int64
+	Deleted      int64
+	AuditDeleted int64
+}
+
+// GetRetentionPolicy returns the shop's current data retention policy
+func (s *BookingService) GetRetentionPolicy(ctx context.Context) (*model.RetentionPolicy, error) {
+	if s.settingsRepo == nil {
+		return nil, errors.New("settings storage is not configured")
+	}
+
+	settings, err := s.settingsRepo.GetSettings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get settings")
+	}
+
+	return &settings.Retention, nil
+}
+
+// UpdateRetentionPolicy validates and stores a new data retention policy
+func (s *BookingService) UpdateRetentionPolicy(ctx context.Context, policy model.RetentionPolicy, updatedBy string) (*model.RetentionPolicy, error) {
+	if s.settingsRepo == nil {
+		return nil, errors.New("settings storage is not configured")
+	}
+
+	for _, days := range []int{policy.CompletedDays, policy.CancelledDays, policy.NoShowDays, policy.AuditDays} {
+		if days < 0 || days > maxRetentionDays {
+			return nil, ErrInvalidRetentionPolicy
+		}
+	}
+	if policy.Mode != model.RetentionModeAnonymize && policy.Mode != model.RetentionModeDelete {
+		return nil, ErrInvalidRetentionPolicy
+	}
+
+	settings, err := s.settingsRepo.UpdateRetentionPolicy(ctx, policy, updatedBy)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update retention policy")
+	}
+
+	log.Info().
+		Int("completedDays", policy.CompletedDays).
+		Int("cancelledDays", policy.CancelledDays).
+		Int("noShowDays", policy.NoShowDays).
+		Int("auditDays", policy.AuditDays).
+		Int("mode", int(policy.Mode)).
+		Str("updatedBy", updatedBy).
+		Msg("Retention policy updated")
+
+	return &settings.Retention, nil
+}
+
+// ApplyRetentionPolicy anonymizes or deletes finished bookings that are
+// older than the retention period configured for their status
+func (s *BookingService) ApplyRetentionPolicy(ctx context.Context) (*RetentionResult, error) {
+	policy, err := s.GetRetentionPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RetentionResult{}
+	now := s.clock.Now()
+	periods := map[model.BookingStatus]int{
+		model.BookingStatusCompleted: policy.CompletedDays,
+		model.BookingStatusCancelled: policy.CancelledDays,
+		model.BookingStatusNoShow:    policy.NoShowDays,
+	}
+
+	for bookingStatus, days := range periods {
+		if days == 0 {
+			continue
+		}
+
+		cutoff := now.AddDate(0, 0, -days)
+		if err := s.purgeBookings(ctx, bookingStatus, cutoff, policy.Mode, result); err != nil {
+			return result, err
+		}
+	}
+
+	if policy.AuditDays > 0 && s.auditRepo != nil {
+		deleted, err := s.auditRepo.DeleteAuditEntriesBefore(ctx, now.AddDate(0, 0, -policy.AuditDays))
+		if err != nil {
+			return result, errors.Wrap(err, "failed to delete audit entries")
+		}
+		result.AuditDeleted = deleted
+	}
+
+	if result.Anonymized > 0 || result.Deleted > 0 || result.AuditDeleted > 0 {
+		log.Info().
+			Int64("anonymized", result.Anonymized).
+			Int64("deleted", result.Deleted).
+			Int64("auditDeleted", result.AuditDeleted).
+			Msg("Retention policy applied")
+	}
+
+	return result, nil
+}
+
+// purgeBookings applies the retention mode to all bookings with a status
+// that ended before the cutoff, in batches
+func (s *BookingService) purgeBookings(ctx context.Context, bookingStatus model.BookingStatus, cutoff time.Time, mode model.RetentionMode, result *RetentionResult) error {
+	includeAnonymized := mode == model.RetentionModeDelete
+
+	for {
+		bookings, err := s.repo.FindExpiredBookings(ctx, bookingStatus, cutoff, includeAnonymized, retentionBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "failed to find expired bookings")
+		}
+		if len(bookings) == 0 {
+			return nil
+		}
+
+		ids := make([]string, len(bookings))
+		for i, booking := range bookings {
+			if err := s.deleteStoredAttachments(ctx, booking); err != nil {
+				return err
+			}
+			ids[i] = booking.ID.Hex()
+		}
+
+		if mode == model.RetentionModeDelete {
+			deleted, err := s.repo.DeleteBookings(ctx, ids)
+			if err != nil {
+				return errors.Wrap(err, "failed to delete bookings")
+			}
+			result.Deleted += deleted
+		} else {
+			anonymized, err := s.repo.AnonymizeBookings(ctx, ids)
+			if err != nil {
+				return errors.Wrap(err, "failed to anonymize bookings")
+			}
+			result.Anonymized += anonymized
+		}
+
+		// The history holds the same personal data as the bookings
+		if s.historyRepo != nil {
+			if _, err := s.historyRepo.DeleteBookingHistory(ctx, ids); err != nil {
+				return errors.Wrap(err, "failed to delete booking history")
+			}
+		}
+
+		if len(bookings) < retentionBatchSize {
+			return nil
+		}
+	}
+}
+
+// deleteStoredAttachments removes a booking's uploaded files from storage
+// without touching the booking itself
+func (s *BookingService) deleteStoredAttachments(ctx context.Context, booking *model.Booking) error {
+	if s.attachmentStore == nil {
+		return nil
+	}
+
+	for _, attachment := range booking.Attachments {
+		if attachment.StorageKey == "" {
+			continue
+		}
+		if err := s.attachmentStore.Delete(ctx, attachment.StorageKey); err != nil {
+			return errors.Wrap(err, "failed to delete attachment")
+		}
+	}
+
+	return nil
+}
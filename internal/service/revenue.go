@@ -0,0 +1,141 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// maxReportRangeDays limits how many days a revenue or reliability report
+// can cover
+const maxReportRangeDays = 366
+
+// revenueKey identifies a line of a revenue report
+type revenueKey struct {
+	periodStart time.Time
+	barberID    string
+	serviceType model.ServiceType
+}
+
+// GetRevenueReport breaks down the revenue of bookings completed between two
+// days, both inclusive and taken in the shop's time zone, by period, barber
+// and service. A booking earns what was paid at the point of sale, or its
+// quoted price if no payment was recorded, split evenly between the services
+// rendered or, without a payment recording them, booked.
+func (s *BookingService) GetRevenueReport(ctx context.Context, filter model.RevenueFilter) (*model.RevenueReport, error) {
+	start, end, err := s.shopDayRange(filter.First, filter.Last, maxReportRangeDays)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.repo.GetRevenue(ctx, filter.BarberID, start, end, filter.Granularity)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sum revenue")
+	}
+
+	report := &model.RevenueReport{
+		Start:       start,
+		End:         end,
+		Granularity: filter.Granularity,
+		Currency:    s.currency,
+		Lines:       []*model.RevenueLine{},
+		Bookings:    totals.Bookings,
+	}
+
+	// Totals come one per currency, so lines can have several
+	lines := map[revenueKey]*model.RevenueLine{}
+	for _, total := range totals.Lines {
+		key := revenueKey{
+			periodStart: total.PeriodStart,
+			barberID:    total.BarberID,
+			serviceType: total.ServiceType,
+		}
+		line, ok := lines[key]
+		if !ok {
+			line = &model.RevenueLine{PeriodStart: key.periodStart, BarberID: key.barberID, ServiceType: key.serviceType}
+			lines[key] = line
+			report.Lines = append(report.Lines, line)
+		}
+
+		line.Bookings += total.Bookings
+
+		if total.Revenue == 0 && total.Tips == 0 {
+			continue
+		}
+		if total.Currency != s.currency {
+			log.Warn().
+				Str("barberID", total.BarberID).
+				Time("periodStart", total.PeriodStart).
+				Str("currency", total.Currency).
+				Msg("Booking currency does not match the shop's, excluding amounts from revenue")
+			continue
+		}
+
+		line.Revenue += total.Revenue
+		line.Tips += total.Tips
+		report.Revenue += total.Revenue
+		report.Tips += total.Tips
+	}
+
+	sort.Slice(report.Lines, func(i, j int) bool {
+		a, b := report.Lines[i], report.Lines[j]
+		if !a.PeriodStart.Equal(b.PeriodStart) {
+			return a.PeriodStart.Before(b.PeriodStart)
+		}
+		if a.BarberID != b.BarberID {
+			return a.BarberID < b.BarberID
+		}
+		return a.ServiceType < b.ServiceType
+	})
+
+	return report, nil
+}
+
+// shopDayRange returns the midnights starting the first calendar day and
+// ending the last in the shop's time zone, checking the range covers at
+// most maxDays
+func (s *BookingService) shopDayRange(first, last time.Time, maxDays int) (time.Time, time.Time, error) {
+	start := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, s.shopLocation)
+	end := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, s.shopLocation).AddDate(0, 0, 1)
+
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, errors.Wrap(ErrInvalidDateRange, "end date is before start date")
+	}
+	if end.After(start.AddDate(0, 0, maxDays)) {
+		return time.Time{}, time.Time{}, errors.Wrapf(ErrInvalidDateRange, "range can cover at most %d days", maxDays)
+	}
+	return start, end, nil
+}
+
+// EncodeRevenueCSV renders a revenue report as CSV for accounting software
+func EncodeRevenueCSV(report *model.RevenueReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	w.Write([]string{"period_start", "barber_id", "service_type", "bookings", "revenue", "tips", "currency"})
+	for _, line := range report.Lines {
+		w.Write([]string{
+			line.PeriodStart.Format("2006-01-02"),
+			line.BarberID,
+			line.ServiceType.String(),
+			strconv.Itoa(line.Bookings),
+			strconv.FormatInt(line.Revenue, 10),
+			strconv.FormatInt(line.Tips, 10),
+			report.Currency,
+		})
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, errors.Wrap(err, "failed to encode revenue csv")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// revenueRepo serves fixed revenue totals, recording what they were asked for
+type revenueRepo struct {
+	fakeBookingRepo
+	totals      *model.RevenueTotals
+	barberID    string
+	start, end  time.Time
+	granularity model.RevenueGranularity
+}
+
+func (r *revenueRepo) GetRevenue(ctx context.Context, barberID string, start, end time.Time, granularity model.RevenueGranularity) (*model.RevenueTotals, error) {
+	r.barberID, r.start, r.end, r.granularity = barberID, start, end, granularity
+	return r.totals, nil
+}
+
+func TestGetRevenueReport(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	at := func(day, hour int) time.Time { return time.Date(2025, time.June, day, hour, 0, 0, 0, newYork) }
+	total := func(periodStart time.Time, barberID string, serviceType model.ServiceType, bookings int, revenue, tips int64, currency string) *model.RevenueTotal {
+		return &model.RevenueTotal{
+			RevenueLine: model.RevenueLine{PeriodStart: periodStart, BarberID: barberID, ServiceType: serviceType, Bookings: bookings, Revenue: revenue, Tips: tips},
+			Currency:    currency,
+		}
+	}
+
+	// One booking was for both a haircut and a beard trim
+	repo := &revenueRepo{totals: &model.RevenueTotals{Bookings: 5, Lines: []*model.RevenueTotal{
+		total(at(9, 0), "barber2", model.ServiceTypeHaircut, 1, 2500, 0, "USD"),
+		total(at(2, 0), "barber1", model.ServiceTypeBeardTrim, 1, 1500, 0, "USD"),
+		total(at(2, 0), "barber1", model.ServiceTypeHaircut, 3, 9500, 500, "USD"),
+		total(at(2, 0), "barber1", model.ServiceTypeHaircut, 1, 2000, 0, "EUR"),
+	}}}
+	s := NewBookingService(repo, WithShopTimezone(newYork), WithCurrency("USD"))
+
+	report, err := s.GetRevenueReport(context.Background(), model.RevenueFilter{
+		First:       time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC),
+		Last:        time.Date(2025, time.June, 15, 0, 0, 0, 0, time.UTC),
+		Granularity: model.RevenueByWeek,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, at(2, 0), report.Start)
+	assert.Equal(t, at(16, 0), report.End)
+	assert.Equal(t, at(2, 0), repo.start)
+	assert.Equal(t, at(16, 0), repo.end)
+	assert.Equal(t, model.RevenueByWeek, repo.granularity)
+	assert.Equal(t, []*model.RevenueLine{
+		{PeriodStart: at(2, 0), BarberID: "barber1", ServiceType: model.ServiceTypeHaircut, Bookings: 4, Revenue: 9500, Tips: 500},
+		{PeriodStart: at(2, 0), BarberID: "barber1", ServiceType: model.ServiceTypeBeardTrim, Bookings: 1, Revenue: 1500},
+		{PeriodStart: at(9, 0), BarberID: "barber2", ServiceType: model.ServiceTypeHaircut, Bookings: 1, Revenue: 2500},
+	}, report.Lines)
+	assert.Equal(t, int64(13500), report.Revenue, "amounts in another currency are left out")
+	assert.Equal(t, 5, report.Bookings)
+
+	csv, err := EncodeRevenueCSV(report)
+	require.NoError(t, err)
+	assert.Equal(t, "period_start,barber_id,service_type,bookings,revenue,tips,currency\n"+
+		"2025-06-02,barber1,haircut,4,9500,500,USD\n"+
+		"2025-06-02,barber1,beard trim,1,1500,0,USD\n"+
+		"2025-06-09,barber2,haircut,1,2500,0,USD\n", string(csv))
+
+	// A single barber's report
+	report, err = s.GetRevenueReport(context.Background(), model.RevenueFilter{
+		BarberID:    "barber2",
+		First:       time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+		Last:        time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+		Granularity: model.RevenueByMonth,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "barber2", repo.barberID)
+	assert.Equal(t, model.RevenueByMonth, repo.granularity)
+
+	_, err = s.GetRevenueReport(context.Background(), model.RevenueFilter{
+		First: time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC),
+		Last:  time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// maxReviews caps how many reviews a single listing returns
+const maxReviews = 100
+
+// SubmitReview records the customer's rating of a completed booking, and
+// optionally a comment. A booking can only be reviewed once.
+func (s *BookingService) SubmitReview(ctx context.Context, bookingID string, rating int, comment string) (*model.Review, error) {
+	if s.reviewRepo == nil {
+		return nil, errors.New("reviews are not configured")
+	}
+
+	if rating < model.MinReviewRating || rating > model.MaxReviewRating {
+		return nil, ErrInvalidReviewRating
+	}
+
+	booking, err := s.repo.GetBookingByID(ctx, bookingID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get booking")
+	}
+	if booking == nil {
+		return nil, ErrBookingNotFound
+	}
+	if booking.Status != model.BookingStatusCompleted {
+		return nil, ErrBookingNotCompleted
+	}
+
+	review, err := s.reviewRepo.CreateReview(ctx, &model.Review{
+		BookingID: bookingID,
+		UserID:    booking.UserID,
+		BarberID:  booking.BarberID,
+		Rating:    rating,
+		Comment:   comment,
+		CreatedAt: s.clock.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrReviewExists) {
+			return nil, ErrReviewExists
+		}
+		return nil, errors.Wrap(err, "failed to create review")
+	}
+
+	log.Info().
+		Str("bookingID", bookingID).
+		Str("barberID", review.BarberID).
+		Int("rating", rating).
+		Msg("Review submitted")
+
+	return review, nil
+}
+
+// ListBarberReviews returns up to limit of a barber's reviews, newest first.
+// Pages after the first start before the oldest review of the previous one;
+// a zero before starts from the newest.
+func (s *BookingService) ListBarberReviews(ctx context.Context, barberID string, before time.Time, limit int) ([]*model.Review, error) {
+	if s.reviewRepo == nil {
+		return nil, errors.New("reviews are not configured")
+	}
+
+	if before.IsZero() {
+		before = s.clock.Now().Add(time.Second)
+	}
+	if limit <= 0 || limit > maxReviews {
+		limit = maxReviews
+	}
+
+	reviews, err := s.reviewRepo.ListBarberReviews(ctx, barberID, before, int64(limit))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list barber reviews")
+	}
+
+	return reviews, nil
+}
+
+// GetBarberRating returns the number of a barber's reviews and their average
+// rating
+func (s *BookingService) GetBarberRating(ctx context.Context, barberID string) (*model.BarberRating, error) {
+	if s.reviewRepo == nil {
+		return nil, errors.New("reviews are not configured")
+	}
+
+	rating, err := s.reviewRepo.GetBarberRating(ctx, barberID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get barber rating")
+	}
+
+	return rating, nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// fakeReviewRepo keeps reviews in memory
+type fakeReviewRepo struct {
+	reviews []*model.Review
+}
+
+func (r *fakeReviewRepo) CreateReview(ctx context.Context, review *model.Review) (*model.Review, error) {
+	for _, existing := range r.reviews {
+		if existing.BookingID == review.BookingID {
+			return nil, repository.ErrReviewExists
+		}
+	}
+	review.ID = primitive.NewObjectID()
+	r.reviews = append(r.reviews, review)
+	return review, nil
+}
+
+func (r *fakeReviewRepo) ListBarberReviews(ctx context.Context, barberID string, before time.Time, limit int64) ([]*model.Review, error) {
+	var reviews []*model.Review
+	for _, review := range r.reviews {
+		if review.BarberID == barberID && review.CreatedAt.Before(before) {
+			reviews = append(reviews, review)
+		}
+	}
+	sort.Slice(reviews, func(i, j int) bool {
+		return reviews[i].CreatedAt.After(reviews[j].CreatedAt)
+	})
+	if int64(len(reviews)) > limit {
+		reviews = reviews[:limit]
+	}
+	return reviews, nil
+}
+
+func (r *fakeReviewRepo) GetBarberRating(ctx context.Context, barberID string) (*model.BarberRating, error) {
+	rating := &model.BarberRating{BarberID: barberID}
+	total := 0
+	for _, review := range r.reviews {
+		if review.BarberID == barberID {
+			rating.Reviews++
+			total += review.Rating
+		}
+	}
+	if rating.Reviews > 0 {
+		rating.AverageRating = float64(total) / float64(rating.Reviews)
+	}
+	return rating, nil
+}
+
+func (r *fakeReviewRepo) GetUserReviews(ctx context.Context, userID string) ([]*model.Review, error) {
+	var reviews []*model.Review
+	for _, review := range r.reviews {
+		if review.UserID == userID {
+			reviews = append(reviews, review)
+		}
+	}
+	return reviews, nil
+}
+
+func (r *fakeReviewRepo) AnonymizeUserReviews(ctx context.Context, userID string) (int64, error) {
+	var anonymized int64
+	for _, review := range r.reviews {
+		if review.UserID == userID {
+			review.UserID = ""
+			anonymized++
+		}
+	}
+	return anonymized, nil
+}
+
+func (r *fakeReviewRepo) DeleteUserReviews(ctx context.Context, userID string) (int64, error) {
+	var kept []*model.Review
+	for _, review := range r.reviews {
+		if review.UserID != userID {
+			kept = append(kept, review)
+		}
+	}
+	deleted := int64(len(r.reviews) - len(kept))
+	r.reviews = kept
+	return deleted, nil
+}
+
+func TestSubmitReview(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	book := func(status model.BookingStatus) *model.Booking {
+		start := now.Add(-24 * time.Hour)
+		return &model.Booking{ID: primitive.NewObjectID(), UserID: "user1", BarberID: "barber1", StartTime: start, EndTime: start.Add(30 * time.Minute), Status: status}
+	}
+	completed := book(model.BookingStatusCompleted)
+	confirmed := book(model.BookingStatusConfirmed)
+	repo := &softDeletingRepo{}
+	repo.bookings = []*model.Booking{completed, confirmed}
+	reviewRepo := &fakeReviewRepo{}
+
+	s := NewBookingService(repo, WithClock(clockAt(now)), WithReviewRepository(reviewRepo))
+	ctx := context.Background()
+
+	// Ratings outside 1-5 are refused
+	_, err := s.SubmitReview(ctx, completed.ID.Hex(), 6, "")
+	assert.ErrorIs(t, err, ErrInvalidReviewRating)
+
+	// Only completed bookings can be reviewed
+	_, err = s.SubmitReview(ctx, confirmed.ID.Hex(), 5, "")
+	assert.ErrorIs(t, err, ErrBookingNotCompleted)
+
+	_, err = s.SubmitReview(ctx, primitive.NewObjectID().Hex(), 5, "")
+	assert.ErrorIs(t, err, ErrBookingNotFound)
+
+	review, err := s.SubmitReview(ctx, completed.ID.Hex(), 4, "Sharp lines")
+	require.NoError(t, err)
+	assert.Equal(t, "user1", review.UserID)
+	assert.Equal(t, "barber1", review.BarberID)
+	assert.Equal(t, now, review.CreatedAt)
+
+	// Once per booking
+	_, err = s.SubmitReview(ctx, completed.ID.Hex(), 5, "")
+	assert.ErrorIs(t, err, ErrReviewExists)
+
+	reviews, err := s.ListBarberReviews(ctx, "barber1", time.Time{}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.Review{review}, reviews)
+}
+
+func TestGetBarber_Rating(t *testing.T) {
+	barbers := func() *fakeBarberRepo {
+		return &fakeBarberRepo{barbers: map[string]*model.Barber{
+			"barber1": {ID: "barber1", DisplayName: "Sam", Active: true},
+		}}
+	}
+	reviewRepo := &fakeReviewRepo{reviews: []*model.Review{
+		{BookingID: "b1", BarberID: "barber1", Rating: 5},
+		{BookingID: "b2", BarberID: "barber1", Rating: 4},
+		{BookingID: "b3", BarberID: "barber2", Rating: 1},
+	}}
+
+	s := NewBookingService(&fakeBookingRepo{}, WithBarberRepository(barbers()), WithReviewRepository(reviewRepo))
+
+	barber, err := s.GetBarber(context.Background(), "barber1")
+	require.NoError(t, err)
+	assert.Equal(t, &model.BarberRating{BarberID: "barber1", Reviews: 2, AverageRating: 4.5}, barber.Rating)
+
+	// Without stored reviews, profiles have no rating
+	s = NewBookingService(&fakeBookingRepo{}, WithBarberRepository(barbers()))
+	barber, err = s.GetBarber(context.Background(), "barber1")
+	require.NoError(t, err)
+	assert.Nil(t, barber.Rating)
+}
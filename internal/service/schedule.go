@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// minutesPerDay bounds working hours to a single calendar day
+const minutesPerDay = 24 * 60
+
+// GetWorkingHours returns a barber's weekly schedule, or the default hours if
+// they haven't configured any. Schedules without a time zone are in the shop's.
+func (s *BookingService) GetWorkingHours(ctx context.Context, barberID string) (*model.BarberSchedule, error) {
+	var schedule *model.BarberSchedule
+	if s.scheduleRepo != nil {
+		var err error
+		schedule, err = s.scheduleRepo.GetSchedule(ctx, barberID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get schedule")
+		}
+	}
+	policy := s.SchedulingPolicy()
+	if schedule == nil {
+		schedule = model.DailySchedule(barberID, policy.DefaultWorkStartMinute, policy.DefaultWorkEndMinute)
+	}
+	if schedule.SlotMinutes == 0 {
+		schedule.SlotMinutes = policy.DefaultSlotMinutes
+	}
+
+	if schedule.Timezone == "" {
+		schedule.Timezone = s.shopLocation.String()
+	}
+
+	return schedule, nil
+}
+
+// SetWorkingHours validates and replaces a barber's weekly working hours,
+// daily breaks, time zone and booking policies. Weekdays left out become days
+// off.
+func (s *BookingService) SetWorkingHours(ctx context.Context, schedule model.BarberSchedule) (*model.BarberSchedule, error) {
+	if s.scheduleRepo == nil {
+		return nil, errors.New("schedule storage is not configured")
+	}
+
+	if schedule.Timezone != "" {
+		if _, err := time.LoadLocation(schedule.Timezone); err != nil {
+			return nil, errors.Wrapf(ErrInvalidWorkingHours, "unknown time zone %q", schedule.Timezone)
+		}
+	}
+
+	if schedule.SlotMinutes != 0 && !slices.Contains(model.SlotMinuteOptions, schedule.SlotMinutes) {
+		return nil, errors.Wrapf(ErrInvalidWorkingHours, "slot length must be one of %v minutes", model.SlotMinuteOptions)
+	}
+
+	if schedule.MinLeadMinutes < 0 || schedule.MaxAdvanceDays < 0 {
+		return nil, errors.Wrap(ErrInvalidWorkingHours, "booking lead time and advance window can't be negative")
+	}
+
+	sorted := make([]model.WorkingHours, len(schedule.Hours))
+	copy(sorted, schedule.Hours)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Weekday != sorted[j].Weekday {
+			return sorted[i].Weekday < sorted[j].Weekday
+		}
+		return sorted[i].StartMinute < sorted[j].StartMinute
+	})
+
+	for i, h := range sorted {
+		if h.Weekday < time.Sunday || h.Weekday > time.Saturday {
+			return nil, errors.Wrapf(ErrInvalidWorkingHours, "unknown weekday %d", h.Weekday)
+		}
+		if h.StartMinute < 0 || h.EndMinute > minutesPerDay || h.StartMinute >= h.EndMinute {
+			return nil, errors.Wrapf(ErrInvalidWorkingHours, "%s shift must start before it ends within the day", h.Weekday)
+		}
+		if i > 0 && sorted[i-1].Overlaps(h) {
+			return nil, errors.Wrapf(ErrInvalidWorkingHours, "%s shifts overlap", h.Weekday)
+		}
+	}
+
+	sortedBreaks := make([]model.BreakPeriod, len(schedule.Breaks))
+	copy(sortedBreaks, schedule.Breaks)
+	sort.Slice(sortedBreaks, func(i, j int) bool {
+		return sortedBreaks[i].StartMinute < sortedBreaks[j].StartMinute
+	})
+
+	for i, b := range sortedBreaks {
+		if b.StartMinute < 0 || b.EndMinute > minutesPerDay || b.StartMinute >= b.EndMinute {
+			return nil, errors.Wrap(ErrInvalidWorkingHours, "break must start before it ends within the day")
+		}
+		if i > 0 && sortedBreaks[i-1].EndMinute > b.StartMinute {
+			return nil, errors.Wrap(ErrInvalidWorkingHours, "breaks overlap")
+		}
+	}
+
+	schedule.Hours = sorted
+	schedule.Breaks = sortedBreaks
+
+	saved, err := s.scheduleRepo.SaveSchedule(ctx, &schedule)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update working hours")
+	}
+
+	log.Info().
+		Str("barberID", saved.BarberID).
+		Int("shifts", len(sorted)).
+		Int("breaks", len(sortedBreaks)).
+		Str("timezone", saved.Timezone).
+		Int("slotMinutes", saved.SlotMinutes).
+		Str("updatedBy", saved.UpdatedBy).
+		Msg("Working hours updated")
+
+	if saved.Timezone == "" {
+		saved.Timezone = s.shopLocation.String()
+	}
+
+	return saved, nil
+}
+
+// checkBookable rejects a booking time on a shop holiday or that runs into one
+// of the barber's breaks, both taken in the barber's time zone
+func (s *BookingService) checkBookable(ctx context.Context, barberID string, start, end time.Time) error {
+	schedule, err := s.GetWorkingHours(ctx, barberID)
+	if err != nil {
+		return err
+	}
+
+	closed, err := s.isHoliday(ctx, start.In(schedule.Location()))
+	if err != nil {
+		return err
+	}
+	if closed {
+		return ErrShopClosed
+	}
+
+	if schedule.OverlapsBreak(start, end) {
+		return ErrDuringBreak
+	}
+	return nil
+}
+
+// checkBookingWindow rejects a new start time that is in the past, sooner than
+// the minimum lead time or further ahead than the advance-booking window
+func (s *BookingService) checkBookingWindow(ctx context.Context, barberID string, start time.Time) error {
+	now := s.clock.Now()
+	if start.Before(now) {
+		return ErrStartTimeInPast
+	}
+
+	schedule, err := s.GetWorkingHours(ctx, barberID)
+	if err != nil {
+		return err
+	}
+
+	minLead, maxAdvanceDays := s.bookingWindow(schedule)
+	if minLead > 0 && start.Before(now.Add(minLead)) {
+		return errors.Wrapf(ErrBookingTooSoon, "bookings must start at least %d minutes from now", int(minLead/time.Minute))
+	}
+	if maxAdvanceDays > 0 && start.After(now.AddDate(0, 0, maxAdvanceDays)) {
+		return errors.Wrapf(ErrBookingTooFarAhead, "bookings can be made at most %d days ahead", maxAdvanceDays)
+	}
+	return nil
+}
+
+// bookingWindow returns the minimum lead time and advance-booking window that
+// apply to a barber, preferring their own limits over the shop's
+func (s *BookingService) bookingWindow(schedule *model.BarberSchedule) (time.Duration, int) {
+	policy := s.SchedulingPolicy()
+	minLead := policy.MinLeadTime
+	if schedule.MinLeadMinutes > 0 {
+		minLead = time.Duration(schedule.MinLeadMinutes) * time.Minute
+	}
+	maxAdvanceDays := policy.MaxAdvanceDays
+	if schedule.MaxAdvanceDays > 0 {
+		maxAdvanceDays = schedule.MaxAdvanceDays
+	}
+	return minLead, maxAdvanceDays
+}
@@ -0,0 +1,467 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// fakeScheduleRepo stores schedules in memory
+type fakeScheduleRepo struct {
+	schedules map[string]*model.BarberSchedule
+}
+
+func (r *fakeScheduleRepo) GetSchedule(ctx context.Context, barberID string) (*model.BarberSchedule, error) {
+	return r.schedules[barberID], nil
+}
+
+func (r *fakeScheduleRepo) SaveSchedule(ctx context.Context, schedule *model.BarberSchedule) (*model.BarberSchedule, error) {
+	saved := *schedule
+	r.schedules[schedule.BarberID] = &saved
+	return &saved, nil
+}
+
+func TestSetWorkingHours_Validation(t *testing.T) {
+	tests := []struct {
+		name     string
+		hours    []model.WorkingHours
+		breaks   []model.BreakPeriod
+		timezone string
+		wantErr  bool
+	}{
+		{"single shift", []model.WorkingHours{{Weekday: time.Monday, StartMinute: 540, EndMinute: 1020}}, nil, "", false},
+		{"split shift", []model.WorkingHours{{Weekday: time.Monday, StartMinute: 780, EndMinute: 1020}, {Weekday: time.Monday, StartMinute: 540, EndMinute: 720}}, nil, "", false},
+		{"shifts touching", []model.WorkingHours{{Weekday: time.Monday, StartMinute: 540, EndMinute: 720}, {Weekday: time.Monday, StartMinute: 720, EndMinute: 1020}}, nil, "", false},
+		{"no hours at all", nil, nil, "", false},
+		{"overlapping shifts", []model.WorkingHours{{Weekday: time.Monday, StartMinute: 540, EndMinute: 780}, {Weekday: time.Monday, StartMinute: 720, EndMinute: 1020}}, nil, "", true},
+		{"ends before it starts", []model.WorkingHours{{Weekday: time.Monday, StartMinute: 1020, EndMinute: 540}}, nil, "", true},
+		{"past midnight", []model.WorkingHours{{Weekday: time.Friday, StartMinute: 1200, EndMinute: 1500}}, nil, "", true},
+		{"lunch break", []model.WorkingHours{{Weekday: time.Monday, StartMinute: 540, EndMinute: 1020}}, []model.BreakPeriod{{StartMinute: 720, EndMinute: 780}}, "", false},
+		{"overlapping breaks", nil, []model.BreakPeriod{{StartMinute: 720, EndMinute: 780}, {StartMinute: 750, EndMinute: 800}}, "", true},
+		{"break ends before it starts", nil, []model.BreakPeriod{{StartMinute: 780, EndMinute: 720}}, "", true},
+		{"barber time zone", nil, nil, "America/New_York", false},
+		{"unknown time zone", nil, nil, "Mars/Olympus_Mons", true},
+		{"unknown weekday", []model.WorkingHours{{Weekday: 7, StartMinute: 540, EndMinute: 1020}}, nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewBookingService(nil, WithScheduleRepository(&fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{}}))
+
+			schedule, err := s.SetWorkingHours(context.Background(), model.BarberSchedule{
+				BarberID:  "barber1",
+				Hours:     tt.hours,
+				Breaks:    tt.breaks,
+				Timezone:  tt.timezone,
+				UpdatedBy: "admin1",
+			})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidWorkingHours)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, schedule.Hours, len(tt.hours))
+		})
+	}
+}
+
+func TestGetWorkingHours_DefaultsWithoutSchedule(t *testing.T) {
+	s := NewBookingService(nil, WithScheduleRepository(&fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{}}))
+
+	schedule, err := s.GetWorkingHours(context.Background(), "barber1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.WorkingHours{{Weekday: time.Wednesday, StartMinute: 540, EndMinute: 1020}}, schedule.HoursOn(time.Wednesday))
+}
+
+// clockAt returns a clock stopped at t
+func clockAt(t time.Time) clock.Clock {
+	return clock.NewFake(t)
+}
+
+// fakeBookingRepo serves a fixed set of bookings; other methods aren't used
+type fakeBookingRepo struct {
+	repository.BookingRepository
+	bookings []*model.Booking
+}
+
+func (r *fakeBookingRepo) GetBookingsInTimeRange(ctx context.Context, barberID string, start, end time.Time) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		if b.BarberID == barberID && b.StartTime.Before(end) && b.EndTime.After(start) {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func (r *fakeBookingRepo) GetBookingsForServices(ctx context.Context, serviceTypes []model.ServiceType, start, end time.Time) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		needed := slices.ContainsFunc(b.Services(), func(serviceType model.ServiceType) bool {
+			return slices.Contains(serviceTypes, serviceType)
+		})
+		if needed && b.Status != model.BookingStatusCancelled && b.StartTime.Before(end) && b.EndTime.After(start) {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func TestGetAvailableTimeSlots_BarberTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber1": {
+			BarberID: "barber1",
+			Hours:    []model.WorkingHours{{Weekday: time.Tuesday, StartMinute: 9 * 60, EndMinute: 11 * 60}},
+			Breaks:   []model.BreakPeriod{{StartMinute: 10 * 60, EndMinute: 10*60 + 30}},
+			Timezone: "America/New_York",
+		},
+	}}
+	bookings := &fakeBookingRepo{bookings: []*model.Booking{{
+		BarberID:  "barber1",
+		StartTime: time.Date(2025, time.April, 1, 9, 0, 0, 0, newYork),
+		EndTime:   time.Date(2025, time.April, 1, 9, 30, 0, 0, newYork),
+	}}}
+	s := NewBookingService(bookings, WithScheduleRepository(schedules), WithClock(clockAt(time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC))))
+
+	// Tuesday in New York; 9:00 is booked and 10:00 is the break
+	slots, err := s.GetAvailableTimeSlots(context.Background(), "barber1", time.Date(2025, time.April, 1, 0, 0, 0, 0, newYork), nil)
+	assert.NoError(t, err)
+	if assert.Len(t, slots, 2) {
+		assert.Equal(t, time.Date(2025, time.April, 1, 9, 30, 0, 0, newYork), slots[0].StartTime)
+		assert.Equal(t, time.Date(2025, time.April, 1, 10, 30, 0, 0, newYork), slots[1].StartTime)
+	}
+
+	// The same hours asked for in UTC are still 9:00-11:00 New York time
+	slots, err = s.GetAvailableTimeSlots(context.Background(), "barber1", time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), nil)
+	assert.NoError(t, err)
+	if assert.Len(t, slots, 2) {
+		assert.True(t, slots[0].StartTime.Equal(time.Date(2025, time.April, 1, 13, 30, 0, 0, time.UTC)))
+		assert.Equal(t, time.UTC, slots[0].StartTime.Location())
+	}
+
+	// Tuesday in Kiritimati is over before the New York shift starts
+	kiritimati, err := time.LoadLocation("Pacific/Kiritimati")
+	assert.NoError(t, err)
+	slots, err = s.GetAvailableTimeSlots(context.Background(), "barber1", time.Date(2025, time.April, 1, 0, 0, 0, 0, kiritimati), nil)
+	assert.NoError(t, err)
+	assert.Empty(t, slots)
+}
+
+func TestGetAvailableTimeSlots_SlotLengthAndService(t *testing.T) {
+	day := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber1": {
+			BarberID:    "barber1",
+			Hours:       []model.WorkingHours{{Weekday: time.Tuesday, StartMinute: 9 * 60, EndMinute: 12 * 60}},
+			SlotMinutes: 15,
+		},
+	}}
+	bookings := &fakeBookingRepo{bookings: []*model.Booking{{
+		BarberID:    "barber1",
+		StartTime:   day.Add(10 * time.Hour),
+		EndTime:     day.Add(10*time.Hour + 30*time.Minute),
+		ServiceType: model.ServiceTypeHaircut,
+	}}}
+	s := NewBookingService(bookings, WithScheduleRepository(schedules), WithClock(clockAt(day.AddDate(0, 0, -1))))
+
+	// Every 15 minutes from 9:00 to 11:45, except the two taken by the haircut
+	slots, err := s.GetAvailableTimeSlots(context.Background(), "barber1", day, nil)
+	assert.NoError(t, err)
+	assert.Len(t, slots, 10)
+	assert.Equal(t, 15*time.Minute, slots[0].EndTime.Sub(slots[0].StartTime))
+
+	// A full service needs an hour plus its cleanup buffer before the haircut,
+	// and must end by noon
+	slots, err = s.GetAvailableTimeSlots(context.Background(), "barber1", day, []model.ServiceType{model.ServiceTypeFullService})
+	assert.NoError(t, err)
+	if assert.Len(t, slots, 3) {
+		assert.Equal(t, day.Add(10*time.Hour+30*time.Minute), slots[0].StartTime)
+		assert.Equal(t, day.Add(11*time.Hour+30*time.Minute), slots[0].EndTime)
+		assert.Equal(t, day.Add(11*time.Hour), slots[2].StartTime)
+	}
+}
+
+func TestSetWorkingHours_SlotMinutes(t *testing.T) {
+	repo := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{}}
+	s := NewBookingService(nil, WithScheduleRepository(repo))
+
+	_, err := s.SetWorkingHours(context.Background(), model.BarberSchedule{BarberID: "barber1", SlotMinutes: 25})
+	assert.ErrorIs(t, err, ErrInvalidWorkingHours)
+
+	_, err = s.SetWorkingHours(context.Background(), model.BarberSchedule{BarberID: "barber1", SlotMinutes: 15})
+	assert.NoError(t, err)
+	assert.Equal(t, 15*time.Minute, repo.schedules["barber1"].SlotDuration())
+}
+
+func TestCheckBookingWindow(t *testing.T) {
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber2": {BarberID: "barber2", MinLeadMinutes: 24 * 60, MaxAdvanceDays: 7},
+	}}
+	now := time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC)
+	s := NewBookingService(&fakeBookingRepo{}, WithScheduleRepository(schedules), WithBookingWindow(2*time.Hour, 30), WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	// Shop policy: at least two hours ahead, at most 30 days
+	assert.ErrorIs(t, s.checkBookingWindow(ctx, "barber1", now.Add(time.Hour)), ErrBookingTooSoon)
+	assert.NoError(t, s.checkBookingWindow(ctx, "barber1", now.Add(3*time.Hour)))
+	assert.NoError(t, s.checkBookingWindow(ctx, "barber1", now.AddDate(0, 0, 20)))
+	assert.ErrorIs(t, s.checkBookingWindow(ctx, "barber1", now.AddDate(0, 0, 31)), ErrBookingTooFarAhead)
+
+	// The barber's own limits take precedence
+	assert.ErrorIs(t, s.checkBookingWindow(ctx, "barber2", now.Add(3*time.Hour)), ErrBookingTooSoon)
+	assert.ErrorIs(t, s.checkBookingWindow(ctx, "barber2", now.AddDate(0, 0, 20)), ErrBookingTooFarAhead)
+	assert.NoError(t, s.checkBookingWindow(ctx, "barber2", now.AddDate(0, 0, 3)))
+
+	// No limits by default, but never in the past
+	unlimited := NewBookingService(&fakeBookingRepo{}, WithClock(clockAt(now)))
+	assert.NoError(t, unlimited.checkBookingWindow(ctx, "barber1", now))
+	assert.NoError(t, unlimited.checkBookingWindow(ctx, "barber1", now.AddDate(1, 0, 0)))
+	assert.ErrorIs(t, unlimited.checkBookingWindow(ctx, "barber1", now.Add(-time.Minute)), ErrStartTimeInPast)
+	assert.ErrorIs(t, s.checkBookingWindow(ctx, "barber1", now.Add(-time.Minute)), ErrStartTimeInPast)
+}
+
+func TestGetAvailableTimeSlots_Past(t *testing.T) {
+	day := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{}}
+	ctx := context.Background()
+
+	// Yesterday can't be queried
+	s := NewBookingService(&fakeBookingRepo{}, WithScheduleRepository(schedules), WithClock(clockAt(day.AddDate(0, 0, 1))))
+	_, err := s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	assert.ErrorIs(t, err, ErrDateInPast)
+
+	// Today only offers the slots that haven't started yet
+	s = NewBookingService(&fakeBookingRepo{}, WithScheduleRepository(schedules), WithClock(clockAt(day.Add(16*time.Hour+10*time.Minute))))
+	slots, err := s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, slots, 1) {
+		assert.Equal(t, day.Add(16*time.Hour+30*time.Minute), slots[0].StartTime)
+	}
+}
+
+func TestGetNextAvailableSlot(t *testing.T) {
+	// A Monday morning; the barber only works Tuesdays 9:00-11:00
+	now := time.Date(2025, time.March, 31, 8, 0, 0, 0, time.UTC)
+	tuesday := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber1": {
+			BarberID: "barber1",
+			Hours:    []model.WorkingHours{{Weekday: time.Tuesday, StartMinute: 9 * 60, EndMinute: 11 * 60}},
+		},
+	}}
+	bookings := &fakeBookingRepo{bookings: []*model.Booking{{
+		BarberID:    "barber1",
+		StartTime:   tuesday.Add(9*time.Hour + 30*time.Minute),
+		EndTime:     tuesday.Add(10*time.Hour + 30*time.Minute),
+		ServiceType: model.ServiceTypeFullService,
+	}}}
+	holidays := &fakeHolidayRepo{holidays: map[string]*model.Holiday{}}
+	s := NewBookingService(bookings, WithScheduleRepository(schedules), WithHolidayRepository(holidays), WithClock(clockAt(now)))
+	ctx := context.Background()
+
+	// The first Tuesday only has room for a haircut at 9:00, so the next ones
+	// are a week later
+	slots, err := s.GetNextAvailableSlot(ctx, "barber1", []model.ServiceType{model.ServiceTypeHaircut}, 3)
+	assert.NoError(t, err)
+	if assert.Len(t, slots, 3) {
+		assert.Equal(t, tuesday.Add(9*time.Hour), slots[0].StartTime)
+		assert.Equal(t, tuesday.AddDate(0, 0, 7).Add(9*time.Hour), slots[1].StartTime)
+		assert.Equal(t, tuesday.AddDate(0, 0, 7).Add(9*time.Hour+30*time.Minute), slots[2].StartTime)
+	}
+
+	// Holidays are skipped
+	_, err = s.AddHoliday(ctx, tuesday, "Closed", "admin1")
+	assert.NoError(t, err)
+	slots, err = s.GetNextAvailableSlot(ctx, "barber1", nil, 0)
+	assert.NoError(t, err)
+	if assert.Len(t, slots, 1) {
+		assert.Equal(t, tuesday.AddDate(0, 0, 7).Add(9*time.Hour), slots[0].StartTime)
+	}
+
+	// Nothing within the advance-booking window
+	limited := NewBookingService(bookings, WithScheduleRepository(schedules), WithHolidayRepository(holidays),
+		WithBookingWindow(0, 7), WithClock(clockAt(now)))
+	slots, err = limited.GetNextAvailableSlot(ctx, "barber1", nil, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, slots)
+}
+
+func TestSlotUnavailable(t *testing.T) {
+	now := time.Date(2025, time.March, 31, 8, 0, 0, 0, time.UTC)
+	tuesday := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber1": {
+			BarberID: "barber1",
+			Hours:    []model.WorkingHours{{Weekday: time.Tuesday, StartMinute: 9 * 60, EndMinute: 11 * 60}},
+		},
+	}}
+	taken := &model.Booking{
+		ID:          primitive.NewObjectID(),
+		BarberID:    "barber1",
+		StartTime:   tuesday.Add(9*time.Hour + 30*time.Minute),
+		EndTime:     tuesday.Add(10*time.Hour + 30*time.Minute),
+		ServiceType: model.ServiceTypeFullService,
+	}
+	bookings := &fakeBookingRepo{bookings: []*model.Booking{taken}}
+	holidays := &fakeHolidayRepo{holidays: map[string]*model.Holiday{}}
+	s := NewBookingService(bookings, WithScheduleRepository(schedules), WithHolidayRepository(holidays), WithClock(clockAt(now)))
+
+	start := tuesday.Add(10 * time.Hour)
+	err := s.slotUnavailable(context.Background(), "barber1", start, start.Add(30*time.Minute),
+		[]model.ServiceType{model.ServiceTypeHaircut}, primitive.NilObjectID)
+	assert.ErrorIs(t, err, ErrSlotUnavailable)
+
+	var slotErr *SlotUnavailableError
+	if assert.ErrorAs(t, err, &slotErr) {
+		assert.Equal(t, []*model.TimeSlot{{StartTime: taken.StartTime, EndTime: taken.EndTime}}, slotErr.Conflicts)
+
+		// The rest of the morning is taken, so the suggestions are a week later
+		nextWeek := tuesday.AddDate(0, 0, 7)
+		if assert.Len(t, slotErr.Alternatives, maxSlotAlternatives) {
+			assert.Equal(t, nextWeek.Add(9*time.Hour), slotErr.Alternatives[0].StartTime)
+			assert.Equal(t, nextWeek.Add(9*time.Hour+30*time.Minute), slotErr.Alternatives[1].StartTime)
+			assert.Equal(t, nextWeek.Add(10*time.Hour), slotErr.Alternatives[2].StartTime)
+		}
+	}
+}
+
+type countingBookingRepo struct {
+	fakeBookingRepo
+	queries int
+}
+
+func (r *countingBookingRepo) GetBookingsInTimeRange(ctx context.Context, barberID string, start, end time.Time) ([]*model.Booking, error) {
+	r.queries++
+	return r.fakeBookingRepo.GetBookingsInTimeRange(ctx, barberID, start, end)
+}
+
+func TestGetAvailableTimeSlotsRange(t *testing.T) {
+	monday := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber1": {
+			BarberID: "barber1",
+			Hours: []model.WorkingHours{
+				{Weekday: time.Monday, StartMinute: 9 * 60, EndMinute: 10 * 60},
+				{Weekday: time.Wednesday, StartMinute: 9 * 60, EndMinute: 10 * 60},
+			},
+		},
+	}}
+	bookings := &countingBookingRepo{fakeBookingRepo: fakeBookingRepo{bookings: []*model.Booking{{
+		BarberID:  "barber1",
+		StartTime: monday.AddDate(0, 0, 2).Add(9 * time.Hour),
+		EndTime:   monday.AddDate(0, 0, 2).Add(9*time.Hour + 30*time.Minute),
+	}}}}
+	// Monday's shift is already underway
+	s := NewBookingService(bookings, WithScheduleRepository(schedules), WithClock(clockAt(monday.Add(9*time.Hour+10*time.Minute))))
+	ctx := context.Background()
+
+	days, err := s.GetAvailableTimeSlotsRange(ctx, "barber1", monday, monday.AddDate(0, 0, 2), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, bookings.queries)
+	if assert.Len(t, days, 3) {
+		assert.Equal(t, monday, days[0].Date)
+		assert.Len(t, days[0].TimeSlots, 1)
+		assert.Empty(t, days[1].TimeSlots)
+		if assert.Len(t, days[2].TimeSlots, 1) {
+			assert.Equal(t, monday.AddDate(0, 0, 2).Add(9*time.Hour+30*time.Minute), days[2].TimeSlots[0].StartTime)
+		}
+	}
+
+	_, err = s.GetAvailableTimeSlotsRange(ctx, "barber1", monday.AddDate(0, 0, 2), monday, nil)
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+
+	_, err = s.GetAvailableTimeSlotsRange(ctx, "barber1", monday, monday.AddDate(0, 0, 31), nil)
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+
+	_, err = s.GetAvailableTimeSlotsRange(ctx, "barber1", monday.AddDate(0, 0, -7), monday.AddDate(0, 0, -1), nil)
+	assert.ErrorIs(t, err, ErrDateInPast)
+}
+
+// busySchedule is a barber working 09:00 to 18:00 every day with a lunch
+// break, offering a slot every 15 minutes
+func busySchedule(barberID string) *model.BarberSchedule {
+	schedule := &model.BarberSchedule{
+		BarberID:    barberID,
+		Breaks:      []model.BreakPeriod{{StartMinute: 13 * 60, EndMinute: 14 * 60}},
+		SlotMinutes: 15,
+	}
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		schedule.Hours = append(schedule.Hours, model.WorkingHours{Weekday: weekday, StartMinute: 9 * 60, EndMinute: 18 * 60})
+	}
+	return schedule
+}
+
+// busyBookings fills the barber's days from first with back-to-back
+// haircuts, leaving every third half hour free
+func busyBookings(barberID string, first time.Time, days int) []*model.Booking {
+	var bookings []*model.Booking
+	for day := 0; day < days; day++ {
+		opening := first.AddDate(0, 0, day).Add(9 * time.Hour)
+		for i := 0; i < 18; i++ {
+			if i%3 == 2 {
+				continue
+			}
+			start := opening.Add(time.Duration(i) * 30 * time.Minute)
+			bookings = append(bookings, &model.Booking{
+				ID:          primitive.NewObjectID(),
+				BarberID:    barberID,
+				StartTime:   start,
+				EndTime:     model.CalculateEndTime(start, model.ServiceTypeHaircut),
+				ServiceType: model.ServiceTypeHaircut,
+				Status:      model.BookingStatusConfirmed,
+			})
+		}
+	}
+	return bookings
+}
+
+// newBusyService returns a service whose barber1 is busy for days days from first
+func newBusyService(first time.Time, days int) *BookingService {
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{"barber1": busySchedule("barber1")}}
+	bookings := &fakeBookingRepo{bookings: busyBookings("barber1", first, days)}
+	return NewBookingService(bookings, WithScheduleRepository(schedules), WithClock(clockAt(first.AddDate(0, 0, -1))))
+}
+
+func BenchmarkGetAvailableTimeSlots(b *testing.B) {
+	day := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	s := newBusyService(day, 1)
+	ctx := context.Background()
+	services := []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := s.GetAvailableTimeSlots(ctx, "barber1", day, services); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetAvailableTimeSlotsRange(b *testing.B) {
+	first := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	s := newBusyService(first, maxSlotRangeDays)
+	ctx := context.Background()
+	last := first.AddDate(0, 0, maxSlotRangeDays-1)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := s.GetAvailableTimeSlotsRange(ctx, "barber1", first, last, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// slotCacheDayFormat is how cached days are named, e.g. 2025-03-31
+const slotCacheDayFormat = "2006-01-02"
+
+// SlotCache keeps barbers' available slots for a day, so calendars showing
+// the same day don't work them out again each time. A day's slots vary with
+// the services asked for and the time zone days are taken in, which the
+// variant names. Implementations scope entries to the tenant of ctx and
+// expire them after a short time.
+type SlotCache interface {
+	GetSlots(ctx context.Context, barberID, day, variant string) ([]*model.TimeSlot, bool, error)
+	SetSlots(ctx context.Context, barberID, day, variant string, slots []*model.TimeSlot) error
+	InvalidateSlots(ctx context.Context, barberID string, days []string) error
+}
+
+// WithSlotCache caches the available slots GetAvailableTimeSlots returns.
+// Entries are dropped when a booking on their day changes; changes to
+// working hours, holidays, barbers and resources show once they expire.
+func WithSlotCache(cache SlotCache) Option {
+	return func(s *BookingService) {
+		s.slotCache = cache
+	}
+}
+
+// slotCacheVariant names the slots of a day asked for in loc for the services
+func slotCacheVariant(loc *time.Location, serviceTypes []model.ServiceType) string {
+	services := make([]string, len(serviceTypes))
+	for i, serviceType := range serviceTypes {
+		services[i] = strconv.Itoa(int(serviceType))
+	}
+	return loc.String() + "|" + strings.Join(services, ",")
+}
+
+// cachedSlots returns the cached slots of a barber's day still starting at
+// or after now. A failing cache is only logged, and treated as empty.
+func (s *BookingService) cachedSlots(ctx context.Context, barberID, day, variant string, now time.Time, loc *time.Location) ([]*model.TimeSlot, bool) {
+	if s.slotCache == nil || !s.featureEnabled(ctx, FlagSlotCache) {
+		return nil, false
+	}
+
+	slots, ok, err := s.slotCache.GetSlots(ctx, barberID, day, variant)
+	if err != nil {
+		log.Warn().Err(err).Str("barberID", barberID).Str("day", day).Msg("Failed to read cached slots")
+		return nil, false
+	}
+	if !ok {
+		return nil, false
+	}
+
+	// Slots may have started since they were cached
+	upcoming := slots[:0]
+	for _, slot := range slots {
+		if !slot.StartTime.Before(now) {
+			slot.StartTime = slot.StartTime.In(loc)
+			slot.EndTime = slot.EndTime.In(loc)
+			upcoming = append(upcoming, slot)
+		}
+	}
+	return upcoming, true
+}
+
+// cacheSlots stores a barber's available slots for a day. A failing cache is
+// only logged.
+func (s *BookingService) cacheSlots(ctx context.Context, barberID, day, variant string, slots []*model.TimeSlot) {
+	if s.slotCache == nil || !s.featureEnabled(ctx, FlagSlotCache) {
+		return
+	}
+
+	if err := s.slotCache.SetSlots(ctx, barberID, day, variant, slots); err != nil {
+		log.Warn().Err(err).Str("barberID", barberID).Str("day", day).Msg("Failed to cache slots")
+	}
+}
+
+// invalidateSlots drops the cached slots of the days the bookings take, and
+// were moved from, in any time zone
+func (s *BookingService) invalidateSlots(ctx context.Context, bookings ...*model.Booking) {
+	if s.slotCache == nil {
+		return
+	}
+
+	for _, booking := range bookings {
+		times := []time.Time{booking.StartTime}
+		if booking.RescheduledFrom != nil {
+			times = append(times, *booking.RescheduledFrom)
+		}
+
+		// Time zones are within a day of UTC, so the day before and after
+		// cover the day the booking is on wherever it's asked for
+		var days []string
+		for _, t := range times {
+			day := t.UTC()
+			for _, offset := range []int{-1, 0, 1} {
+				days = append(days, day.AddDate(0, 0, offset).Format(slotCacheDayFormat))
+			}
+		}
+
+		if err := s.slotCache.InvalidateSlots(ctx, booking.BarberID, days); err != nil {
+			log.Warn().Err(err).Str("barberID", booking.BarberID).Msg("Failed to invalidate cached slots")
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/clock"
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// fakeSlotCache keeps slots in memory and records invalidated days
+type fakeSlotCache struct {
+	slots       map[string][]*model.TimeSlot
+	invalidated []string
+}
+
+func (c *fakeSlotCache) GetSlots(ctx context.Context, barberID, day, variant string) ([]*model.TimeSlot, bool, error) {
+	slots, ok := c.slots[barberID+"/"+day+"/"+variant]
+	// Hand out copies, as a real cache would
+	var copied []*model.TimeSlot
+	for _, slot := range slots {
+		copied = append(copied, &model.TimeSlot{StartTime: slot.StartTime, EndTime: slot.EndTime})
+	}
+	return copied, ok, nil
+}
+
+func (c *fakeSlotCache) SetSlots(ctx context.Context, barberID, day, variant string, slots []*model.TimeSlot) error {
+	c.slots[barberID+"/"+day+"/"+variant] = slots
+	return nil
+}
+
+func (c *fakeSlotCache) InvalidateSlots(ctx context.Context, barberID string, days []string) error {
+	for _, day := range days {
+		c.invalidated = append(c.invalidated, barberID+"/"+day)
+		for key := range c.slots {
+			if strings.HasPrefix(key, barberID+"/"+day+"/") {
+				delete(c.slots, key)
+			}
+		}
+	}
+	return nil
+}
+
+func TestGetAvailableTimeSlots_Cached(t *testing.T) {
+	day := time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber1": {
+			BarberID: "barber1",
+			Hours:    []model.WorkingHours{{Weekday: time.Tuesday, StartMinute: 9 * 60, EndMinute: 11 * 60}},
+		},
+	}}
+	bookings := &fakeBookingRepo{}
+	cache := &fakeSlotCache{slots: map[string][]*model.TimeSlot{}}
+	clk := clock.NewFake(day.AddDate(0, 0, -1))
+	s := NewBookingService(bookings, WithScheduleRepository(schedules), WithSlotCache(cache), WithClock(clk))
+	ctx := context.Background()
+
+	slots, err := s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	require.NoError(t, err)
+	assert.Len(t, slots, 4)
+
+	// A booking stored behind the service's back isn't seen while the day is cached
+	booking := &model.Booking{
+		ID:        primitive.NewObjectID(),
+		BarberID:  "barber1",
+		StartTime: day.Add(9 * time.Hour),
+		EndTime:   day.Add(9*time.Hour + 30*time.Minute),
+		Status:    model.BookingStatusConfirmed,
+	}
+	bookings.bookings = append(bookings.bookings, booking)
+
+	slots, err = s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	require.NoError(t, err)
+	assert.Len(t, slots, 4)
+
+	// Changing a booking through the service drops the day
+	_, err = s.changeBooking(ctx, BookingCreated, func(ctx context.Context) (*model.Booking, error) {
+		return booking, nil
+	})
+	require.NoError(t, err)
+
+	slots, err = s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	require.NoError(t, err)
+	require.Len(t, slots, 3)
+	assert.Equal(t, day.Add(9*time.Hour+30*time.Minute), slots[0].StartTime)
+
+	// Cached slots that have started since aren't offered
+	clk.Set(day.Add(10 * time.Hour))
+	slots, err = s.GetAvailableTimeSlots(ctx, "barber1", day, nil)
+	require.NoError(t, err)
+	require.Len(t, slots, 2)
+	assert.Equal(t, day.Add(10*time.Hour), slots[0].StartTime)
+}
+
+func TestInvalidateSlots_CoversEveryTimeZone(t *testing.T) {
+	cache := &fakeSlotCache{slots: map[string][]*model.TimeSlot{}}
+	s := NewBookingService(&fakeBookingRepo{}, WithSlotCache(cache))
+
+	from := time.Date(2025, time.March, 28, 23, 30, 0, 0, time.UTC)
+	s.invalidateSlots(context.Background(), &model.Booking{
+		BarberID:        "barber1",
+		StartTime:       time.Date(2025, time.April, 1, 9, 0, 0, 0, time.UTC),
+		RescheduledFrom: &from,
+	})
+
+	assert.Equal(t, []string{
+		"barber1/2025-03-31", "barber1/2025-04-01", "barber1/2025-04-02",
+		"barber1/2025-03-27", "barber1/2025-03-28", "barber1/2025-03-29",
+	}, cache.invalidated)
+}
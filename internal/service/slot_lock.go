@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// slotLockRetryInterval is how long a booking waits before trying again for
+// a lock another one holds
+const slotLockRetryInterval = 25 * time.Millisecond
+
+// WithSlotLocks makes creating and rescheduling bookings hold short-lived
+// locks on the barber and on the resources the services need, from the
+// availability check until the booking is stored. Replicas sharing the
+// leases then can't both book the same time, without the repository running
+// the check in a transaction. A lock is given up after ttl if its holder
+// stops, and a booking waits up to wait for a lock before failing with
+// ErrSlotBusy.
+func WithSlotLocks(leases repository.LeaseRepository, ttl, wait time.Duration) Option {
+	return func(s *BookingService) {
+		s.slotLocks = leases
+		s.slotLockTTL = ttl
+		s.slotLockWait = wait
+	}
+}
+
+// lockSlot takes the locks on the barber and the resources, returning a
+// function that releases them. The barber's whole schedule is locked rather
+// than the requested time, since bookings at different times can overlap.
+func (s *BookingService) lockSlot(ctx context.Context, barberID string, resources []*model.Resource) (func(), error) {
+	if s.slotLocks == nil {
+		return func() {}, nil
+	}
+
+	// Locks are always taken in the same order, so two bookings needing the
+	// same ones don't each hold what the other waits for
+	prefix := "slot:" + tenant.FromContext(ctx) + ":"
+	names := []string{prefix + "barber:" + barberID}
+	var resourceNames []string
+	for _, resource := range resources {
+		resourceNames = append(resourceNames, prefix+"resource:"+resource.ID)
+	}
+	sort.Strings(resourceNames)
+	names = append(names, resourceNames...)
+
+	holder := primitive.NewObjectID().Hex()
+	var held []string
+	release := func() {
+		// Released even if the request was cancelled; otherwise it lingers until the ttl
+		ctx := context.WithoutCancel(ctx)
+		for _, name := range held {
+			if err := s.slotLocks.ReleaseLease(ctx, name, holder); err != nil {
+				log.Warn().Err(err).Str("lock", name).Msg("Failed to release slot lock")
+			}
+		}
+	}
+
+	deadline := time.Now().Add(s.slotLockWait)
+	for _, name := range names {
+		for {
+			acquired, err := s.slotLocks.AcquireLease(ctx, name, holder, s.slotLockTTL)
+			if err != nil {
+				release()
+				return nil, err
+			}
+			if acquired {
+				held = append(held, name)
+				break
+			}
+			if time.Now().Add(slotLockRetryInterval).After(deadline) {
+				release()
+				return nil, ErrSlotBusy
+			}
+
+			select {
+			case <-ctx.Done():
+				release()
+				return nil, ctx.Err()
+			case <-time.After(slotLockRetryInterval):
+			}
+		}
+	}
+
+	return release, nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+)
+
+// fakeLeases keeps leases in memory
+type fakeLeases struct {
+	mu      sync.Mutex
+	holders map[string]string
+}
+
+func (l *fakeLeases) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if current, ok := l.holders[name]; ok && current != holder {
+		return false, nil
+	}
+	l.holders[name] = holder
+	return true, nil
+}
+
+func (l *fakeLeases) ReleaseLease(ctx context.Context, name, holder string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.holders[name] == holder {
+		delete(l.holders, name)
+	}
+	return nil
+}
+
+func (l *fakeLeases) held() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var names []string
+	for name := range l.holders {
+		names = append(names, name)
+	}
+	return names
+}
+
+// lockCheckingBookingRepo records the leases held while a booking is created
+type lockCheckingBookingRepo struct {
+	creatingBookingRepo
+	leases     *fakeLeases
+	heldLeases []string
+}
+
+func (r *lockCheckingBookingRepo) CreateBooking(ctx context.Context, booking *model.Booking, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	r.heldLeases = r.leases.held()
+	return r.creatingBookingRepo.CreateBooking(ctx, booking, capacity, resources)
+}
+
+func TestCreateBooking_HoldsSlotLock(t *testing.T) {
+	leases := &fakeLeases{holders: map[string]string{}}
+	repo := &lockCheckingBookingRepo{leases: leases}
+	s := NewBookingService(repo, WithSlotLocks(leases, time.Minute, 100*time.Millisecond),
+		WithClock(clockAt(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC))))
+	ctx := context.Background()
+	params := CreateBookingParams{
+		UserID:       "user1",
+		BarberID:     "barber1",
+		ServiceTypes: []model.ServiceType{model.ServiceTypeHaircut},
+		StartTime:    time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC),
+	}
+
+	_, err := s.CreateBooking(ctx, params)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"slot::barber:barber1"}, repo.heldLeases, "locked while storing")
+	assert.Empty(t, leases.held(), "released afterwards")
+
+	// Another replica holding the barber's lock keeps the booking out until it's released
+	leases.holders["slot::barber:barber1"] = "other"
+	params.StartTime = params.StartTime.Add(time.Hour)
+	_, err = s.CreateBooking(ctx, params)
+	assert.ErrorIs(t, err, ErrSlotBusy)
+	assert.Len(t, repo.bookings, 1)
+}
+
+// movingBookingRepo records the move and the leases held while a booking is
+// moved
+type movingBookingRepo struct {
+	updatingBookingRepo
+	leases     *fakeLeases
+	heldLeases []string
+	moves      []repository.BookingMove
+}
+
+func (r *movingBookingRepo) RescheduleBooking(ctx context.Context, booking *model.Booking, move repository.BookingMove, capacity int, resources []*model.Resource) (*model.Booking, error) {
+	r.heldLeases = r.leases.held()
+	r.moves = append(r.moves, move)
+	moved := *booking
+	moved.StartTime = move.StartTime
+	moved.EndTime = move.EndTime
+	return &moved, nil
+}
+
+func TestUpdateBooking_MovesUnderSlotLock(t *testing.T) {
+	leases := &fakeLeases{holders: map[string]string{}}
+	repo := &movingBookingRepo{leases: leases}
+	start := time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC)
+	booking := &model.Booking{
+		ID:          primitive.NewObjectID(),
+		BarberID:    "barber1",
+		ServiceType: model.ServiceTypeHaircut,
+		Status:      model.BookingStatusConfirmed,
+		StartTime:   start,
+		EndTime:     start.Add(30 * time.Minute),
+	}
+	repo.bookings = []*model.Booking{booking}
+	s := NewBookingService(repo, WithSlotLocks(leases, time.Minute, 100*time.Millisecond),
+		WithClock(clockAt(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC))))
+	ctx := context.Background()
+	newStart := start.Add(time.Hour)
+	notes := "running late"
+	version := int64(0)
+
+	updated, err := s.UpdateBooking(ctx, booking.ID.Hex(), UpdateBookingParams{StartTime: &newStart, Notes: &notes, Version: &version})
+	require.NoError(t, err)
+	assert.Equal(t, newStart, updated.StartTime)
+	assert.Equal(t, []string{"slot::barber:barber1"}, repo.heldLeases, "locked while moving")
+	assert.Empty(t, leases.held(), "released afterwards")
+	require.Len(t, repo.moves, 1)
+	assert.Equal(t, newStart.Add(30*time.Minute), repo.moves[0].EndTime)
+	assert.Equal(t, notes, repo.moves[0].Updates["notes"], "applied with the move")
+	assert.Equal(t, &version, repo.moves[0].Version)
+
+	// Changing only the notes doesn't move the booking
+	_, err = s.UpdateBooking(ctx, booking.ID.Hex(), UpdateBookingParams{Notes: &notes})
+	require.NoError(t, err)
+	assert.Len(t, repo.moves, 1)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// GetBarberStats summarizes a barber's bookings over the week or month
+// containing day, taken in the barber's time zone, and compares the time
+// booked with their working hours. A zero day means today.
+func (s *BookingService) GetBarberStats(ctx context.Context, barberID string, period model.StatsPeriod, day time.Time) (*model.BarberStats, error) {
+	schedule, err := s.GetWorkingHours(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := schedule.Location()
+	if day.IsZero() {
+		day = s.clock.Now().In(loc)
+	}
+	start, end := period.PeriodRange(time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc))
+
+	stats, err := s.repo.GetBarberStats(ctx, barberID, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get barber stats")
+	}
+
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		// Nobody works on shop holidays
+		closed, err := s.isHoliday(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		if !closed {
+			stats.WorkingTime += schedule.WorkingTimeOn(d.Weekday())
+		}
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// statsBookingRepo returns empty stats and records the range asked for
+type statsBookingRepo struct {
+	fakeBookingRepo
+	start, end time.Time
+}
+
+func (r *statsBookingRepo) GetBarberStats(ctx context.Context, barberID string, start, end time.Time) (*model.BarberStats, error) {
+	r.start, r.end = start, end
+	return &model.BarberStats{BarberID: barberID, Start: start, End: end, BookedTime: 14 * time.Hour}, nil
+}
+
+func TestGetBarberStats_WorkingTime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	// Weekdays from 9 to 5 with an hour for lunch
+	var hours []model.WorkingHours
+	for day := time.Monday; day <= time.Friday; day++ {
+		hours = append(hours, model.WorkingHours{Weekday: day, StartMinute: 9 * 60, EndMinute: 17 * 60})
+	}
+	schedules := &fakeScheduleRepo{schedules: map[string]*model.BarberSchedule{
+		"barber1": {BarberID: "barber1", Hours: hours, Breaks: []model.BreakPeriod{{StartMinute: 12 * 60, EndMinute: 13 * 60}}, Timezone: "Europe/Berlin"},
+	}}
+	holidays := &fakeHolidayRepo{holidays: map[string]*model.Holiday{"2025-06-04": {Date: "2025-06-04"}}}
+	repo := &statsBookingRepo{}
+
+	// Late on Sunday in UTC is already Monday in Berlin
+	s := NewBookingService(repo,
+		WithScheduleRepository(schedules),
+		WithHolidayRepository(holidays),
+		WithClock(clockAt(time.Date(2025, time.June, 1, 22, 30, 0, 0, time.UTC))))
+
+	stats, err := s.GetBarberStats(context.Background(), "barber1", model.StatsPeriodWeek, time.Time{})
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Date(2025, time.June, 2, 0, 0, 0, 0, berlin), repo.start)
+	assert.Equal(t, time.Date(2025, time.June, 9, 0, 0, 0, 0, berlin), repo.end)
+	// Four working days of seven hours, without the holiday
+	assert.Equal(t, 28*time.Hour, stats.WorkingTime)
+	assert.InDelta(t, 50, stats.Utilization(), 0.001)
+
+	_, err = s.GetBarberStats(context.Background(), "barber1", model.StatsPeriodMonth, time.Date(2025, time.February, 14, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, time.February, 1, 0, 0, 0, 0, berlin), repo.start)
+	assert.Equal(t, time.Date(2025, time.March, 1, 0, 0, 0, 0, berlin), repo.end)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// allowedTransitions lists the statuses a booking may move to from each
+// status. Cancelled, completed and no-show bookings are final.
+var allowedTransitions = map[model.BookingStatus][]model.BookingStatus{
+	model.BookingStatusPending: {
+		model.BookingStatusConfirmed,
+		model.BookingStatusCompleted,
+		model.BookingStatusCancelled,
+		model.BookingStatusNoShow,
+	},
+	model.BookingStatusConfirmed: {
+		model.BookingStatusCompleted,
+		model.BookingStatusCancelled,
+		model.BookingStatusNoShow,
+	},
+}
+
+// TransitionError reports a booking status change that isn't allowed.
+// It matches ErrInvalidStatusTransition with errors.Is.
+type TransitionError struct {
+	From   model.BookingStatus
+	To     model.BookingStatus
+	Reason string
+}
+
+func (e *TransitionError) Error() string {
+	msg := fmt.Sprintf("booking cannot move from %s to %s", e.From, e.To)
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	return msg
+}
+
+// Is reports whether target is ErrInvalidStatusTransition
+func (e *TransitionError) Is(target error) bool {
+	return target == ErrInvalidStatusTransition
+}
+
+// validateTransition checks that a booking may move to a new status at the given time
+func validateTransition(booking *model.Booking, to model.BookingStatus, now time.Time) error {
+	if booking.Status == model.BookingStatusCancelled {
+		return ErrBookingCancelled
+	}
+
+	allowed := false
+	for _, status := range allowedTransitions[booking.Status] {
+		if status == to {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return &TransitionError{From: booking.Status, To: to}
+	}
+
+	if to == model.BookingStatusCancelled && !now.Before(booking.StartTime) {
+		return &TransitionError{From: booking.Status, To: to, Reason: "booking has already started"}
+	}
+	if to == model.BookingStatusNoShow && now.Before(booking.StartTime) {
+		return &TransitionError{From: booking.Status, To: to, Reason: "booking hasn't started yet"}
+	}
+
+	return nil
+}
+
+// checkChangeable checks that a booking can still be moved or changed: it
+// must be pending or confirmed, and still hold its slot
+func checkChangeable(booking *model.Booking) error {
+	switch {
+	case booking.Status == model.BookingStatusCancelled:
+		return ErrBookingCancelled
+	case booking.Status == model.BookingStatusCompleted:
+		return ErrBookingCompleted
+	case booking.Status == model.BookingStatusNoShow:
+		return ErrBookingNoShow
+	case booking.Status == model.BookingStatusHeld:
+		return ErrBookingHeld
+	case booking.ReleasedAt != nil:
+		return ErrSlotReleased
+	}
+	return nil
+}
+
+// transitionStatus validates and applies a status change, together with any
+// other updates, guarding against concurrent status changes
+func (s *BookingService) transitionStatus(ctx context.Context, booking *model.Booking, to model.BookingStatus, updates map[string]interface{}) (*model.Booking, error) {
+	if err := validateTransition(booking, to, s.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	eventType := BookingUpdated
+	switch to {
+	case model.BookingStatusCancelled:
+		eventType = BookingCancelled
+	case model.BookingStatusConfirmed:
+		eventType = BookingConfirmed
+	}
+
+	updatedBooking, err := s.changeBooking(ctx, eventType, func(ctx context.Context) (*model.Booking, error) {
+		return s.repo.TransitionBookingStatus(ctx, booking.ID.Hex(), booking.Status, to, updates)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update booking status")
+	}
+	if updatedBooking == nil {
+		return nil, &TransitionError{From: booking.Status, To: to, Reason: "booking was changed concurrently"}
+	}
+
+	s.recordHistory(ctx, statusActions[to], booking, updatedBooking)
+
+	return updatedBooking, nil
+}
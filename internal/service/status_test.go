@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+func TestValidateTransition(t *testing.T) {
+	start := time.Date(2025, time.April, 1, 10, 0, 0, 0, time.UTC)
+	before := start.Add(-time.Hour)
+	after := start.Add(time.Hour)
+
+	booking := func(status model.BookingStatus) *model.Booking {
+		return &model.Booking{StartTime: start, Status: status}
+	}
+
+	tests := []struct {
+		name    string
+		booking *model.Booking
+		to      model.BookingStatus
+		now     time.Time
+		wantErr error
+	}{
+		{"pending to confirmed", booking(model.BookingStatusPending), model.BookingStatusConfirmed, before, nil},
+		{"pending to completed", booking(model.BookingStatusPending), model.BookingStatusCompleted, after, nil},
+		{"confirmed to completed", booking(model.BookingStatusConfirmed), model.BookingStatusCompleted, after, nil},
+		{"confirmed to cancelled before start", booking(model.BookingStatusConfirmed), model.BookingStatusCancelled, before, nil},
+		{"pending to cancelled after start", booking(model.BookingStatusPending), model.BookingStatusCancelled, after, ErrInvalidStatusTransition},
+		{"confirmed to pending", booking(model.BookingStatusConfirmed), model.BookingStatusPending, before, ErrInvalidStatusTransition},
+		{"completed to cancelled", booking(model.BookingStatusCompleted), model.BookingStatusCancelled, before, ErrInvalidStatusTransition},
+		{"cancelled to confirmed", booking(model.BookingStatusCancelled), model.BookingStatusConfirmed, before, ErrBookingCancelled},
+		{"confirmed to no-show after start", booking(model.BookingStatusConfirmed), model.BookingStatusNoShow, after, nil},
+		{"pending to no-show before start", booking(model.BookingStatusPending), model.BookingStatusNoShow, before, ErrInvalidStatusTransition},
+		{"no-show to completed", booking(model.BookingStatusNoShow), model.BookingStatusCompleted, after, ErrInvalidStatusTransition},
+		{"cancelled to no-show", booking(model.BookingStatusCancelled), model.BookingStatusNoShow, after, ErrBookingCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTransition(tt.booking, tt.to, tt.now)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+}
+
+// updatingBookingRepo applies updates to the bookings it stores
+type updatingBookingRepo struct {
+	holdingBookingRepo
+}
+
+func (r *updatingBookingRepo) UpdateBooking(ctx context.Context, id string, updates map[string]interface{}) (*model.Booking, error) {
+	for _, booking := range r.bookings {
+		if booking.ID.Hex() == id {
+			if notes, ok := updates["notes"].(string); ok {
+				booking.Notes = notes
+			}
+			return r.GetBookingByID(ctx, id)
+		}
+	}
+	return nil, nil
+}
+
+func TestUpdateBooking_OnlyActiveBookings(t *testing.T) {
+	start := time.Date(2025, time.June, 2, 10, 0, 0, 0, time.UTC)
+	releasedAt := start.Add(15 * time.Minute)
+	notes := "fade on the sides"
+
+	tests := []struct {
+		name    string
+		booking *model.Booking
+		wantErr error
+	}{
+		{"confirmed", &model.Booking{Status: model.BookingStatusConfirmed}, nil},
+		{"cancelled", &model.Booking{Status: model.BookingStatusCancelled}, ErrBookingCancelled},
+		{"completed", &model.Booking{Status: model.BookingStatusCompleted}, ErrBookingCompleted},
+		{"no-show", &model.Booking{Status: model.BookingStatusNoShow}, ErrBookingNoShow},
+		{"held", &model.Booking{Status: model.BookingStatusHeld}, ErrBookingHeld},
+		{"released", &model.Booking{Status: model.BookingStatusConfirmed, ReleasedAt: &releasedAt}, ErrSlotReleased},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.booking.ID = primitive.NewObjectID()
+			tt.booking.BarberID = "barber1"
+			tt.booking.StartTime = start
+			tt.booking.EndTime = start.Add(30 * time.Minute)
+			repo := &updatingBookingRepo{}
+			repo.bookings = []*model.Booking{tt.booking}
+			s := NewBookingService(repo)
+
+			updated, err := s.UpdateBooking(context.Background(), tt.booking.ID.Hex(), UpdateBookingParams{Notes: &notes})
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, notes, updated.Notes)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
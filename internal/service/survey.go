@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/notification"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// onBookingCompleted runs follow-up work once a booking has been completed.
+// Failures are logged rather than returned, since the completion itself succeeded.
+func (s *BookingService) onBookingCompleted(ctx context.Context, booking *model.Booking) {
+	if err := s.dispatchSurvey(ctx, booking); err != nil {
+		log.Error().Err(err).Str("bookingID", booking.ID.Hex()).Msg("Failed to dispatch satisfaction survey")
+	}
+}
+
+// dispatchSurvey sends a satisfaction survey link for a completed booking,
+// at most once per booking
+func (s *BookingService) dispatchSurvey(ctx context.Context, booking *model.Booking) error {
+	if s.surveyRepo == nil || s.notifier == nil || s.surveyBaseURL == "" {
+		return nil
+	}
+
+	existing, err := s.surveyRepo.GetSurveyByBookingID(ctx, booking.ID.Hex())
+	if err != nil {
+		return errors.Wrap(err, "failed to check for existing survey")
+	}
+
+	if existing != nil {
+		return nil
+	}
+
+	token, err := newSurveyToken()
+	if err != nil {
+		return err
+	}
+
+	survey, err := s.surveyRepo.CreateSurvey(ctx, &model.Survey{
+		BookingID: booking.ID.Hex(),
+		UserID:    booking.UserID,
+		BarberID:  booking.BarberID,
+		Token:     token,
+		SentAt:    s.clock.Now(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create survey")
+	}
+
+	query := url.Values{}
+	query.Set("booking", survey.BookingID)
+	query.Set("token", survey.Token)
+	// The survey page sends the tenant back when submitting, since the
+	// customer submits it without signing in
+	if tenantID := tenant.FromContext(ctx); tenantID != tenant.Default {
+		query.Set("tenant", tenantID)
+	}
+	link := fmt.Sprintf("%s?%s", s.surveyBaseURL, query.Encode())
+
+	err = s.notifier.Notify(ctx, notification.Message{
+		Kind:      notification.KindSurvey,
+		UserID:    booking.UserID,
+		BookingID: survey.BookingID,
+		Subject:   "How was your appointment?",
+		Body:      fmt.Sprintf("Thanks for visiting! Tell us how your %s went: %s", model.DescribeServices(booking.Services()), link),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to send survey notification")
+	}
+
+	log.Info().
+		Str("bookingID", survey.BookingID).
+		Str("userID", survey.UserID).
+		Msg("Satisfaction survey sent")
+
+	return nil
+}
+
+// SubmitSurveyResponse records the response to a booking's survey. The token
+// from the survey link authenticates the response.
+func (s *BookingService) SubmitSurveyResponse(ctx context.Context, bookingID, token string, score int, comment string) error {
+	if s.surveyRepo == nil {
+		return errors.New("surveys are not configured")
+	}
+
+	if score < 1 || score > 5 {
+		return ErrInvalidSurveyScore
+	}
+
+	recorded, err := s.surveyRepo.RecordSurveyResponse(ctx, bookingID, token, score, comment)
+	if err != nil {
+		return errors.Wrap(err, "failed to record survey response")
+	}
+
+	if !recorded {
+		return ErrSurveyNotFound
+	}
+
+	log.Info().
+		Str("bookingID", bookingID).
+		Int("score", score).
+		Msg("Survey response recorded")
+
+	return nil
+}
+
+// GetBarberSurveyScores returns aggregate survey scores for a barber,
+// optionally limited to responses in [start, end)
+func (s *BookingService) GetBarberSurveyScores(ctx context.Context, barberID string, start, end *time.Time) (*model.SurveyScores, error) {
+	if s.surveyRepo == nil {
+		return nil, errors.New("surveys are not configured")
+	}
+
+	scores, err := s.surveyRepo.GetBarberSurveyScores(ctx, barberID, start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get survey scores")
+	}
+
+	return scores, nil
+}
+
+// newSurveyToken generates an unguessable token for a survey link
+func newSurveyToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate survey token")
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// ErasureResult summarizes erasing a customer's data
+type ErasureResult struct {
+	Anonymized   int64 // Bookings kept for reporting without personal data
+	Deleted      int64 // Bookings removed
+	Surveys      int64 // Surveys anonymized or removed
+	Reviews      int64 // Reviews anonymized or removed
+	Favorites    int64 // Favorite barbers removed
+	AuditEntries int64 // Audit entries anonymized or removed
+}
+
+// ExportUserData collects everything stored about a customer: their
+// bookings, soft-deleted ones included, with the bookings' history, the
+// surveys they were sent, the reviews they wrote, their favorite barbers and
+// the audit entries of their calls
+func (s *BookingService) ExportUserData(ctx context.Context, userID string) (*model.UserDataExport, error) {
+	if userID == "" {
+		return nil, ErrUserIDRequired
+	}
+
+	export := &model.UserDataExport{
+		UserID:       userID,
+		ExportedAt:   s.clock.Now(),
+		Bookings:     []*model.Booking{},
+		History:      []*model.BookingHistoryEntry{},
+		Surveys:      []*model.Survey{},
+		Reviews:      []*model.Review{},
+		Favorites:    []*model.FavoriteBarber{},
+		AuditEntries: []*model.AuditEntry{},
+	}
+
+	bookings, err := s.repo.FindUserBookings(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user bookings")
+	}
+	export.Bookings = append(export.Bookings, bookings...)
+	for _, booking := range bookings {
+		for _, attachment := range booking.Attachments {
+			attachment.URL = s.AttachmentURL(attachment)
+		}
+	}
+
+	if s.historyRepo != nil {
+		for _, booking := range bookings {
+			entries, err := s.historyRepo.GetBookingHistory(ctx, booking.ID.Hex())
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get booking history")
+			}
+			export.History = append(export.History, entries...)
+		}
+	}
+
+	if s.surveyRepo != nil {
+		surveys, err := s.surveyRepo.GetUserSurveys(ctx, userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user surveys")
+		}
+		export.Surveys = append(export.Surveys, surveys...)
+	}
+
+	if s.reviewRepo != nil {
+		reviews, err := s.reviewRepo.GetUserReviews(ctx, userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user reviews")
+		}
+		export.Reviews = append(export.Reviews, reviews...)
+	}
+
+	if s.favoriteRepo != nil {
+		favorites, err := s.favoriteRepo.ListFavorites(ctx, userID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list favorites")
+		}
+		export.Favorites = append(export.Favorites, favorites...)
+	}
+
+	if s.auditRepo != nil {
+		entries, err := s.auditRepo.ListAuditEntries(ctx, model.AuditFilter{ActorID: userID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list audit entries")
+		}
+		export.AuditEntries = append(export.AuditEntries, entries...)
+	}
+
+	log.Info().
+		Str("userID", userID).
+		Int("bookings", len(export.Bookings)).
+		Msg("User data exported")
+
+	return export, nil
+}
+
+// EraseUserData erases a customer's personal data, e.g. when they ask to be
+// forgotten. Their bookings are anonymized or deleted according to mode, like
+// by the retention policy, except that upcoming and soft-deleted bookings are
+// deleted either way. The bookings' uploaded files and history are deleted,
+// and the customer's surveys, reviews and audit entries anonymized or
+// deleted too. Their favorites are deleted either way.
+func (s *BookingService) EraseUserData(ctx context.Context, userID string, mode model.RetentionMode) (*ErasureResult, error) {
+	if userID == "" {
+		// Anonymized bookings have no user ID, so this would match them all
+		return nil, ErrUserIDRequired
+	}
+	if mode != model.RetentionModeAnonymize && mode != model.RetentionModeDelete {
+		return nil, ErrInvalidErasureMode
+	}
+
+	bookings, err := s.repo.FindUserBookings(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find user bookings")
+	}
+
+	now := s.clock.Now()
+	var ids, deleteIDs, anonymizeIDs []string
+	var upcoming []*model.Booking
+	for _, booking := range bookings {
+		if err := s.deleteStoredAttachments(ctx, booking); err != nil {
+			return nil, err
+		}
+
+		id := booking.ID.Hex()
+		ids = append(ids, id)
+		// Nobody is left to serve, so upcoming bookings give their slots back
+		isUpcoming := booking.DeletedAt == nil && booking.EndTime.After(now) &&
+			(booking.Status == model.BookingStatusPending || booking.Status == model.BookingStatusConfirmed || booking.Status == model.BookingStatusHeld)
+		switch {
+		case isUpcoming:
+			upcoming = append(upcoming, booking)
+			deleteIDs = append(deleteIDs, id)
+		case mode == model.RetentionModeDelete || booking.DeletedAt != nil:
+			deleteIDs = append(deleteIDs, id)
+		default:
+			anonymizeIDs = append(anonymizeIDs, id)
+		}
+	}
+
+	result := &ErasureResult{}
+	if len(deleteIDs) > 0 {
+		if result.Deleted, err = s.repo.DeleteBookings(ctx, deleteIDs); err != nil {
+			return nil, errors.Wrap(err, "failed to delete bookings")
+		}
+	}
+	if len(anonymizeIDs) > 0 {
+		if result.Anonymized, err = s.repo.AnonymizeBookings(ctx, anonymizeIDs); err != nil {
+			return result, errors.Wrap(err, "failed to anonymize bookings")
+		}
+	}
+	for _, booking := range upcoming {
+		s.cancelDeposit(ctx, booking)
+	}
+	s.invalidateSlots(ctx, upcoming...)
+
+	// The history holds the same personal data as the bookings
+	if s.historyRepo != nil && len(ids) > 0 {
+		if _, err := s.historyRepo.DeleteBookingHistory(ctx, ids); err != nil {
+			return result, errors.Wrap(err, "failed to delete booking history")
+		}
+	}
+
+	if s.surveyRepo != nil {
+		erase := s.surveyRepo.AnonymizeUserSurveys
+		if mode == model.RetentionModeDelete {
+			erase = s.surveyRepo.DeleteUserSurveys
+		}
+		if result.Surveys, err = erase(ctx, userID); err != nil {
+			return result, errors.Wrap(err, "failed to erase user surveys")
+		}
+	}
+
+	if s.reviewRepo != nil {
+		erase := s.reviewRepo.AnonymizeUserReviews
+		if mode == model.RetentionModeDelete {
+			erase = s.reviewRepo.DeleteUserReviews
+		}
+		if result.Reviews, err = erase(ctx, userID); err != nil {
+			return result, errors.Wrap(err, "failed to erase user reviews")
+		}
+	}
+
+	if s.favoriteRepo != nil {
+		if result.Favorites, err = s.favoriteRepo.DeleteUserFavorites(ctx, userID); err != nil {
+			return result, errors.Wrap(err, "failed to delete user favorites")
+		}
+	}
+
+	if s.auditRepo != nil {
+		erase := s.auditRepo.AnonymizeActorEntries
+		if mode == model.RetentionModeDelete {
+			erase = s.auditRepo.DeleteActorEntries
+		}
+		if result.AuditEntries, err = erase(ctx, userID); err != nil {
+			return result, errors.Wrap(err, "failed to erase audit entries")
+		}
+	}
+
+	// The user's ID is left out, as it's what was erased
+	log.Info().
+		Int64("anonymized", result.Anonymized).
+		Int64("deleted", result.Deleted).
+		Int64("surveys", result.Surveys).
+		Int64("reviews", result.Reviews).
+		Int64("favorites", result.Favorites).
+		Int64("auditEntries", result.AuditEntries).
+		Int("mode", int(mode)).
+		Msg("User data erased")
+
+	return result, nil
+}
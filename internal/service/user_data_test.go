@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// erasingRepo finds and anonymizes a user's bookings in memory
+type erasingRepo struct {
+	softDeletingRepo
+}
+
+func (r *erasingRepo) FindUserBookings(ctx context.Context, userID string) ([]*model.Booking, error) {
+	var bookings []*model.Booking
+	for _, b := range r.bookings {
+		if b.UserID == userID {
+			bookings = append(bookings, b)
+		}
+	}
+	return bookings, nil
+}
+
+func (r *erasingRepo) AnonymizeBookings(ctx context.Context, ids []string) (int64, error) {
+	var anonymized int64
+	for _, b := range r.bookings {
+		for _, id := range ids {
+			if b.ID.Hex() == id {
+				b.UserID = ""
+				b.Notes = ""
+				b.Anonymized = true
+				anonymized++
+			}
+		}
+	}
+	return anonymized, nil
+}
+
+func TestEraseUserData(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 9, 0, 0, 0, time.UTC)
+	book := func(userID string, start time.Time, status model.BookingStatus) *model.Booking {
+		return &model.Booking{ID: primitive.NewObjectID(), UserID: userID, BarberID: "barber1", StartTime: start, EndTime: start.Add(30 * time.Minute), Status: status, Notes: "skin fade"}
+	}
+	past := book("user1", now.Add(-48*time.Hour), model.BookingStatusCompleted)
+	upcoming := book("user1", now.Add(24*time.Hour), model.BookingStatusConfirmed)
+	deleted := book("user1", now.Add(-72*time.Hour), model.BookingStatusCompleted)
+	deleted.DeletedAt = &now
+	other := book("user2", now.Add(-48*time.Hour), model.BookingStatusCompleted)
+	repo := &erasingRepo{}
+	repo.bookings = []*model.Booking{past, upcoming, deleted, other}
+
+	s := NewBookingService(repo, WithClock(clockAt(now)))
+
+	// Past bookings are kept anonymized, while the upcoming and the
+	// soft-deleted ones go
+	result, err := s.EraseUserData(context.Background(), "user1", model.RetentionModeAnonymize)
+	require.NoError(t, err)
+	assert.Equal(t, &ErasureResult{Anonymized: 1, Deleted: 2}, result)
+	assert.Equal(t, []*model.Booking{past, other}, repo.bookings)
+	assert.True(t, past.Anonymized)
+	assert.Empty(t, past.UserID)
+	assert.Equal(t, "user2", other.UserID)
+
+	// Nothing of theirs is left to export
+	export, err := s.ExportUserData(context.Background(), "user1")
+	require.NoError(t, err)
+	assert.Empty(t, export.Bookings)
+
+	// Anonymized bookings have no user ID, so erasing an empty one is refused
+	_, err = s.EraseUserData(context.Background(), "", model.RetentionModeDelete)
+	assert.ErrorIs(t, err, ErrUserIDRequired)
+}
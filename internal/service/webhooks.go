@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// webhookSecretBytes is how many random bytes a webhook's signing secret has
+const webhookSecretBytes = 32
+
+// CreateWebhook registers a URL to be sent booking events, generating the
+// secret its deliveries are signed with. The returned webhook is the only
+// one carrying the secret back to the caller.
+func (s *BookingService) CreateWebhook(ctx context.Context, webhook model.Webhook) (*model.Webhook, error) {
+	if s.webhookRepo == nil {
+		return nil, errors.New("webhook storage is not configured")
+	}
+
+	target, err := url.Parse(webhook.URL)
+	if err != nil || (target.Scheme != "https" && target.Scheme != "http") || target.Host == "" {
+		return nil, errors.Wrap(ErrInvalidWebhook, "url must be an absolute http(s) URL")
+	}
+	if len(webhook.EventTypes) == 0 {
+		return nil, errors.Wrap(ErrInvalidWebhook, "at least one event type is required")
+	}
+	for _, eventType := range webhook.EventTypes {
+		if !slices.Contains(model.WebhookEvents, eventType) {
+			return nil, errors.Wrapf(ErrInvalidWebhook, "unknown event type %q", eventType)
+		}
+	}
+
+	secret := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(err, "failed to generate webhook secret")
+	}
+	webhook.Secret = hex.EncodeToString(secret)
+	webhook.EventTypes = slices.Compact(slices.Sorted(slices.Values(webhook.EventTypes)))
+
+	created, err := s.webhookRepo.CreateWebhook(ctx, &webhook)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook")
+	}
+
+	log.Info().
+		Str("webhookID", created.ID.Hex()).
+		Str("url", created.URL).
+		Strs("eventTypes", created.EventTypes).
+		Str("createdBy", created.CreatedBy).
+		Msg("Webhook created")
+
+	return created, nil
+}
+
+// ListWebhooks returns the registered webhooks
+func (s *BookingService) ListWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	if s.webhookRepo == nil {
+		return nil, nil
+	}
+
+	webhooks, err := s.webhookRepo.ListWebhooks(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list webhooks")
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook stops sending events to a webhook, reporting whether it existed
+func (s *BookingService) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	if s.webhookRepo == nil {
+		return false, errors.New("webhook storage is not configured")
+	}
+
+	deleted, err := s.webhookRepo.DeleteWebhook(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete webhook")
+	}
+
+	if deleted {
+		log.Info().Str("webhookID", id).Msg("Webhook deleted")
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+)
+
+// fakeWebhookRepo stores webhooks in memory
+type fakeWebhookRepo struct {
+	webhooks []*model.Webhook
+}
+
+func (r *fakeWebhookRepo) CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	webhook.ID = primitive.NewObjectID()
+	r.webhooks = append(r.webhooks, webhook)
+	return webhook, nil
+}
+
+func (r *fakeWebhookRepo) ListWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	return r.webhooks, nil
+}
+
+func (r *fakeWebhookRepo) ListWebhooksForEvent(ctx context.Context, eventType string) ([]*model.Webhook, error) {
+	return nil, nil
+}
+
+func (r *fakeWebhookRepo) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	return false, nil
+}
+
+func TestCreateWebhook(t *testing.T) {
+	webhooks := &fakeWebhookRepo{}
+	s := NewBookingService(&fakeBookingRepo{}, WithWebhookRepository(webhooks))
+	ctx := context.Background()
+
+	created, err := s.CreateWebhook(ctx, model.Webhook{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{model.WebhookEventBookingCreated, model.WebhookEventBookingCancelled, model.WebhookEventBookingCreated},
+	})
+	require.NoError(t, err)
+	assert.Len(t, created.Secret, 2*webhookSecretBytes)
+	assert.Equal(t, []string{model.WebhookEventBookingCancelled, model.WebhookEventBookingCreated}, created.EventTypes)
+
+	invalid := []model.Webhook{
+		{URL: "ftp://example.com", EventTypes: []string{model.WebhookEventBookingCreated}},
+		{URL: "/hooks", EventTypes: []string{model.WebhookEventBookingCreated}},
+		{URL: "https://example.com/hooks"},
+		{URL: "https://example.com/hooks", EventTypes: []string{"booking.deleted"}},
+	}
+	for _, webhook := range invalid {
+		_, err := s.CreateWebhook(ctx, webhook)
+		assert.ErrorIs(t, err, ErrInvalidWebhook, webhook)
+	}
+	assert.Len(t, webhooks.webhooks, 1)
+}
@@ -0,0 +1,185 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+)
+
+// LocalStore implements Store on the local filesystem. It also serves as the
+// HTTP handler for signed uploads and downloads under its path prefix.
+type LocalStore struct {
+	dir        string
+	baseURL    string
+	signingKey []byte
+}
+
+// NewLocalStore creates a filesystem-backed store. baseURL is the public URL
+// the handler is mounted at, e.g. "http://localhost:8080/attachments".
+func NewLocalStore(dir, baseURL, signingKey string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrap(err, "failed to create attachment directory")
+	}
+
+	return &LocalStore{
+		dir:        dir,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		signingKey: []byte(signingKey),
+	}, nil
+}
+
+// PresignUpload returns a signed URL for uploading a single object
+func (s *LocalStore) PresignUpload(ctx context.Context, key, contentType string, size int64, expiry time.Duration) (string, error) {
+	expires := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
+	sizeStr := strconv.FormatInt(size, 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("size", sizeStr)
+	query.Set("sig", s.sign(key, contentType, sizeStr, expires))
+
+	return fmt.Sprintf("%s/%s?%s", s.baseURL, key, query.Encode()), nil
+}
+
+// DownloadURL returns a signed URL for downloading an object
+func (s *LocalStore) DownloadURL(key string, expiry time.Duration) string {
+	expires := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
+
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("sig", s.sign(http.MethodGet, key, expires))
+
+	return fmt.Sprintf("%s/%s?%s", s.baseURL, key, query.Encode())
+}
+
+// Delete removes an object from disk
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete attachment")
+	}
+
+	return nil
+}
+
+// ServeHTTP handles signed PUT uploads and signed GET downloads. It must be mounted
+// with http.StripPrefix so the request path is the object key.
+func (s *LocalStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	path, err := s.path(key)
+	if err != nil {
+		http.Error(w, "invalid key", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		expires := r.URL.Query().Get("expires")
+		if !s.verify(w, r, s.sign(http.MethodGet, key, expires), expires, "download URL expired") {
+			return
+		}
+		if _, err := os.Stat(path); err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeFile(w, r, path)
+	case http.MethodPut:
+		s.handleUpload(w, r, key, path)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *LocalStore) handleUpload(w http.ResponseWriter, r *http.Request, key, path string) {
+	query := r.URL.Query()
+	expires, sizeStr := query.Get("expires"), query.Get("size")
+	contentType := r.Header.Get("Content-Type")
+
+	if !s.verify(w, r, s.sign(key, contentType, sizeStr, expires), expires, "upload URL expired") {
+		return
+	}
+
+	size, err := strconv.ParseInt(sizeStr, 10, 64)
+	if err != nil || r.ContentLength > size {
+		http.Error(w, "upload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	// Read one byte more than allowed to detect oversized bodies without Content-Length
+	written, err := io.Copy(file, io.LimitReader(r.Body, size+1))
+	file.Close()
+	if err != nil || written > size {
+		os.Remove(path)
+		if written > size {
+			http.Error(w, "upload too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		log.Error().Err(err).Str("key", key).Msg("Failed to store attachment")
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+// verify checks a request's signature against the expected one and that its
+// URL hasn't expired, replying with 403 Forbidden if either fails
+func (s *LocalStore) verify(w http.ResponseWriter, r *http.Request, expected, expires, expiredMessage string) bool {
+	if !hmac.Equal([]byte(expected), []byte(r.URL.Query().Get("sig"))) {
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		http.Error(w, expiredMessage, http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+// path maps an object key to a file path inside the store directory
+func (s *LocalStore) path(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if key == "" || cleaned == "/" || cleaned != "/"+key {
+		return "", errors.Errorf("invalid object key %q", key)
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(cleaned)), nil
+}
+
+func (s *LocalStore) sign(parts ...string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(strings.Join(parts, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStore(t *testing.T) (*LocalStore, *httptest.Server) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	store, err := NewLocalStore(t.TempDir(), server.URL+"/attachments", "signing-key")
+	assert.NoError(t, err)
+	mux.Handle("/attachments/", http.StripPrefix("/attachments", store))
+
+	return store, server
+}
+
+func put(t *testing.T, url, contentType, body string) int {
+	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// Test: Signed upload can be downloaded and deleted
+func TestLocalStore_UploadAndDelete(t *testing.T) {
+	store, _ := newTestStore(t)
+	ctx := context.Background()
+
+	uploadURL, err := store.PresignUpload(ctx, "bookings/b1/a1.jpg", "image/jpeg", 5, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, put(t, uploadURL, "image/jpeg", "image"))
+
+	resp, err := http.Get(store.DownloadURL("bookings/b1/a1.jpg", time.Minute))
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "image", string(body))
+
+	assert.NoError(t, store.Delete(ctx, "bookings/b1/a1.jpg"))
+
+	resp, err = http.Get(store.DownloadURL("bookings/b1/a1.jpg", time.Minute))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+// Test: Uploads that don't match the signed parameters are rejected
+func TestLocalStore_RejectsUnsignedUploads(t *testing.T) {
+	store, _ := newTestStore(t)
+	ctx := context.Background()
+
+	uploadURL, err := store.PresignUpload(ctx, "bookings/b1/a1.jpg", "image/jpeg", 5, time.Minute)
+	assert.NoError(t, err)
+
+	// Different content type than signed
+	assert.Equal(t, http.StatusForbidden, put(t, uploadURL, "text/html", "image"))
+
+	// Larger than signed
+	assert.Equal(t, http.StatusRequestEntityTooLarge, put(t, uploadURL, "image/jpeg", "too large"))
+
+	// Different key than signed
+	assert.Equal(t, http.StatusForbidden, put(t, strings.Replace(uploadURL, "a1.jpg", "a2.jpg", 1), "image/jpeg", "image"))
+
+	// Expired
+	expiredURL, err := store.PresignUpload(ctx, "bookings/b1/a3.jpg", "image/jpeg", 5, -time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, put(t, expiredURL, "image/jpeg", "image"))
+}
+
+// Test: Downloads need a valid, unexpired signature for the same key
+func TestLocalStore_RejectsUnsignedDownloads(t *testing.T) {
+	store, server := newTestStore(t)
+	ctx := context.Background()
+
+	uploadURL, err := store.PresignUpload(ctx, "bookings/b1/a1.jpg", "image/jpeg", 5, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, put(t, uploadURL, "image/jpeg", "image"))
+
+	get := func(url string) int {
+		resp, err := http.Get(url)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	// Unsigned
+	assert.Equal(t, http.StatusForbidden, get(server.URL+"/attachments/bookings/b1/a1.jpg"))
+
+	// Signed for a different key
+	assert.Equal(t, http.StatusForbidden, get(strings.Replace(store.DownloadURL("bookings/b1/a2.jpg", time.Minute), "a2.jpg", "a1.jpg", 1)))
+
+	// Expired
+	assert.Equal(t, http.StatusForbidden, get(store.DownloadURL("bookings/b1/a1.jpg", -time.Minute)))
+
+	// The upload URL doesn't allow downloading
+	assert.Equal(t, http.StatusForbidden, get(uploadURL))
+
+	assert.Equal(t, http.StatusOK, get(store.DownloadURL("bookings/b1/a1.jpg", time.Minute)))
+}
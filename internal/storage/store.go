@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrNotFound is returned when an object doesn't exist
+var ErrNotFound = errors.New("object not found")
+
+// Store abstracts the object storage holding booking attachments.
+// Uploads and downloads go directly between the client and the store through
+// pre-signed URLs.
+type Store interface {
+	// PresignUpload returns a URL the client can PUT an object of the given
+	// content type and maximum size to until expiry
+	PresignUpload(ctx context.Context, key, contentType string, size int64, expiry time.Duration) (string, error)
+	// DownloadURL returns a URL the object can be downloaded from until
+	// expiry
+	DownloadURL(key string, expiry time.Duration) string
+	// Delete removes an object; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,41 @@
+// Package tenant carries the shop a request is for. Every shop's data lives
+// apart from every other's, and the tenant in a request's context decides
+// whose data it reads and writes.
+package tenant
+
+import (
+	"context"
+	"regexp"
+)
+
+// MetadataKey is the gRPC metadata key (and, canonicalised, the HTTP header)
+// anonymous callers name the shop they're browsing with. Signed-in callers'
+// tenant comes from their token.
+const MetadataKey = "x-tenant-id"
+
+// Default is the tenant of a single-shop deployment, and of callers that
+// don't name one. Its data lives where it always has.
+const Default = ""
+
+// idPattern is what a tenant ID looks like. IDs become part of database
+// names, so they're kept short, lower case and free of separators.
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// Valid reports whether id is a well-formed tenant ID
+func Valid(id string) bool {
+	return idPattern.MatchString(id)
+}
+
+// idKey is the context key the tenant ID is stored under
+type idKey struct{}
+
+// WithID returns a copy of ctx scoped to the tenant
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, idKey{}, id)
+}
+
+// FromContext returns the tenant ctx is scoped to, Default when it isn't
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(idKey{}).(string)
+	return id
+}
@@ -0,0 +1,25 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid("acme"))
+	assert.True(t, Valid("shop-42"))
+	assert.False(t, Valid(""))
+	assert.False(t, Valid("-acme"))
+	assert.False(t, Valid("Acme"))
+	assert.False(t, Valid("acme_shop"))
+	assert.False(t, Valid("acme.shop"))
+	assert.False(t, Valid("a23456789012345678901234567890123"))
+}
+
+func TestFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, Default, FromContext(ctx))
+	assert.Equal(t, "acme", FromContext(WithID(ctx, "acme")))
+}
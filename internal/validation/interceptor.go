@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryInterceptor rejects requests that break the validation rules declared
+// in the proto with InvalidArgument, before they reach the handler. The
+// status carries a BadRequest detail listing every invalid field.
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := check(req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor is the streaming counterpart of UnaryInterceptor; it
+// validates each message the client sends
+func StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatedStream{ServerStream: ss})
+}
+
+// validatedStream validates the messages received on a server stream
+type validatedStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg receives the next message and validates it
+func (s *validatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return check(m)
+}
+
+// check validates a request, returning the InvalidArgument status to fail
+// the call with, or nil if the request is valid
+func check(req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	violations := Validate(msg)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, len(violations))
+	for i, v := range violations {
+		descriptions[i] = v.Field + " " + v.Description
+	}
+	st := status.New(codes.InvalidArgument, "invalid request: "+strings.Join(descriptions, "; "))
+
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		// Still fail the call, just without the details
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,74 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/booking.BookingService/CancelBooking"}
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return &pb.CancelBookingResponse{Success: true}, nil
+	}
+
+	t.Run("valid request", func(t *testing.T) {
+		called = false
+		_, err := UnaryInterceptor(context.Background(), &pb.CancelBookingRequest{Id: "booking1"}, info, handler)
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		called = false
+		_, err := UnaryInterceptor(context.Background(), &pb.CancelBookingRequest{Reason: string(make([]byte, 501))}, info, handler)
+		assert.False(t, called)
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "invalid request: id length must be at least 1 runes; reason length must be at most 500 runes", st.Message())
+
+		require.Len(t, st.Details(), 1)
+		badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, map[string]string{
+			"id":     "length must be at least 1 runes",
+			"reason": "length must be at most 500 runes",
+		}, fields(badRequest.FieldViolations))
+	})
+}
+
+// fakeStream is a server stream that receives a single message
+type fakeStream struct {
+	grpc.ServerStream
+	msg proto.Message
+}
+
+func (s *fakeStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.msg)
+	return nil
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/booking.BookingService/WatchBookings", IsServerStream: true}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&pb.ListBookingsRequest{})
+	}
+
+	err := StreamInterceptor(nil, &fakeStream{msg: &pb.ListBookingsRequest{SortBy: pb.BookingSortField(5)}}, info, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = StreamInterceptor(nil, &fakeStream{msg: &pb.ListBookingsRequest{SortBy: pb.BookingSortField_CREATED_AT}}, info, handler)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// validator is a message with protoc-gen-validate's generated checks
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError is the error the generated checks return for a field that
+// breaks its rules, or contains a message that does
+type fieldError interface {
+	error
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is the error the generated checks return for several fields
+type multiError interface {
+	error
+	AllErrors() []error
+}
+
+// Validate checks a message against the protoc-gen-validate rules declared
+// on its fields, and on the fields of any messages it contains, with the
+// checks generated from booking.proto. It returns one violation per broken
+// rule, with the field's path from the root message, e.g.
+// "policy.rules[0].fee_percent".
+func Validate(msg proto.Message) []*errdetails.BadRequest_FieldViolation {
+	v, ok := msg.(validator)
+	if !ok {
+		// Only messages from other protos, which declare no rules
+		return nil
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	collect(v.ValidateAll(), msg.ProtoReflect().Descriptor(), "", &violations)
+	return violations
+}
+
+// collect adds the violations a generated check reported for a message,
+// with paths starting with prefix
+func collect(err error, md protoreflect.MessageDescriptor, prefix string, violations *[]*errdetails.BadRequest_FieldViolation) {
+	switch e := err.(type) {
+	case nil:
+		return
+
+	case multiError:
+		for _, err := range e.AllErrors() {
+			collect(err, md, prefix, violations)
+		}
+
+	case fieldError:
+		// Fields are named in Go, with an index for list items
+		name, index, _ := strings.Cut(e.Field(), "[")
+		path := prefix + name
+		fd := fieldByGoName(md, name)
+		if fd != nil {
+			path = prefix + string(fd.Name())
+		}
+		if index != "" {
+			path += "[" + index
+		}
+
+		// Broken rules within a nested message are reported as its cause
+		switch e.Cause().(type) {
+		case multiError, fieldError:
+			if fd != nil && fd.Message() != nil {
+				collect(e.Cause(), fd.Message(), path+".", violations)
+				return
+			}
+		}
+		*violations = append(*violations, &errdetails.BadRequest_FieldViolation{
+			Field:       path,
+			Description: strings.TrimPrefix(e.Reason(), "value "),
+		})
+
+	default:
+		*violations = append(*violations, &errdetails.BadRequest_FieldViolation{
+			Field:       strings.TrimSuffix(prefix, "."),
+			Description: err.Error(),
+		})
+	}
+}
+
+// fieldByGoName finds a message's field by the name the generated checks
+// give it, e.g. FeePercent for fee_percent
+func fieldByGoName(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if strings.EqualFold(strings.ReplaceAll(string(fd.Name()), "_", ""), name) {
+			return fd
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// fields returns the paths of the violated fields, keyed to their descriptions
+func fields(violations []*errdetails.BadRequest_FieldViolation) map[string]string {
+	paths := make(map[string]string, len(violations))
+	for _, v := range violations {
+		paths[v.Field] = v.Description
+	}
+	return paths
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  proto.Message
+		want map[string]string
+	}{
+		{
+			name: "valid request",
+			req:  &pb.GetBookingRequest{Id: "booking1"},
+			want: map[string]string{},
+		},
+		{
+			name: "empty ID",
+			req:  &pb.GetBookingRequest{},
+			want: map[string]string{"id": "length must be at least 1 runes"},
+		},
+		{
+			name: "too long",
+			req:  &pb.CancelBookingRequest{Id: "booking1", Reason: string(make([]byte, 501))},
+			want: map[string]string{"reason": "length must be at most 500 runes"},
+		},
+		{
+			name: "exact length",
+			req:  &pb.RecordPOSCompletionRequest{BookingId: "booking1", Currency: "EURO"},
+			want: map[string]string{"currency": "length must be 3 runes"},
+		},
+		{
+			name: "integer ranges",
+			req:  &pb.ExportPayrollRequest{Year: 1999, Month: 13},
+			want: map[string]string{"year": "must be inside range [2000, 9999]", "month": "must be inside range [1, 12]"},
+		},
+		{
+			name: "undefined enum value",
+			req:  &pb.ExportPayrollRequest{Year: 2024, Month: 1, Format: pb.ExportFormat(7)},
+			want: map[string]string{"format": "must be one of the defined enum values"},
+		},
+		{
+			name: "undefined enum in a list",
+			req:  &pb.ListBookingsRequest{Statuses: []pb.BookingStatus{pb.BookingStatus_CONFIRMED, pb.BookingStatus(9)}},
+			want: map[string]string{"statuses[1]": "must be one of the defined enum values"},
+		},
+		{
+			name: "optional pattern left empty",
+			req:  &pb.ListAuditLogRequest{},
+			want: map[string]string{},
+		},
+		{
+			name: "pattern",
+			req:  &pb.ListAuditLogRequest{StartTime: "2024-01-01T10:00:00+01:00", EndTime: "yesterday"},
+			want: map[string]string{"end_time": `does not match regex pattern "^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2})$"`},
+		},
+		{
+			name: "date or timestamp",
+			req:  &pb.ListBookingsRequest{StartDate: "2024-01-01", EndDate: "2024-01-31T23:59:59Z"},
+			want: map[string]string{},
+		},
+		{
+			name: "required message",
+			req:  &pb.UpdateCancellationPolicyRequest{},
+			want: map[string]string{"policy": "is required"},
+		},
+		{
+			name: "nested messages",
+			req: &pb.UpdateCancellationPolicyRequest{Policy: &pb.CancellationPolicy{Rules: []*pb.CancellationRule{
+				{WithinMinutes: 60, FeePercent: 50},
+				{WithinMinutes: 0, FeePercent: 150},
+			}}},
+			want: map[string]string{
+				"policy.rules[1].within_minutes": "must be greater than 0",
+				"policy.rules[1].fee_percent":    "must be inside range [0, 100]",
+			},
+		},
+		{
+			name: "time of day",
+			req: &pb.SetWorkingHoursRequest{Hours: []*pb.WorkingHours{
+				{Weekday: pb.Weekday_MONDAY, Start: "09:00", End: "5pm"},
+			}},
+			want: map[string]string{"hours[0].end": `does not match regex pattern "^\\d{2}:\\d{2}$"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fields(Validate(tt.req)))
+		})
+	}
+}
+
+func TestEveryMessageHasGeneratedChecks(t *testing.T) {
+	messages := pb.File_pkg_api_proto_booking_proto.Messages()
+	for i := 0; i < messages.Len(); i++ {
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(messages.Get(i).FullName())
+		require.NoError(t, err)
+		_, ok := mt.New().Interface().(validator)
+		assert.True(t, ok, "%s has no generated checks; regenerate with protoc-gen-validate", mt.Descriptor().FullName())
+	}
+}
@@ -0,0 +1,282 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/repository"
+	"github.com/ita-av/booking-service/internal/service"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// Headers of outgoing webhook deliveries
+const (
+	EventHeader             = "X-Webhook-Event"
+	DeliveryIDHeader        = "X-Webhook-ID"
+	TimestampHeader         = "X-Webhook-Timestamp"
+	DeliverySignatureHeader = "X-Webhook-Signature"
+)
+
+// Event is the JSON body POSTed to webhooks. Its ID stays the same across
+// retries, so receivers can drop duplicate deliveries.
+type Event struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	OccurredAt time.Time      `json:"occurredAt"`
+	Booking    *model.Booking `json:"booking"`
+}
+
+// DispatcherConfig tunes how webhooks are delivered
+type DispatcherConfig struct {
+	// MaxAttempts is how many times a delivery is tried in total
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, doubled after each
+	// one up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Timeout limits each delivery attempt
+	Timeout time.Duration
+	// Workers is how many deliveries are made concurrently
+	Workers int
+	// QueueSize is how many deliveries can wait for a worker before further
+	// ones are dropped
+	QueueSize int
+}
+
+// DefaultDispatcherConfig tries deliveries five times over about a minute
+var DefaultDispatcherConfig = DispatcherConfig{
+	MaxAttempts:    5,
+	InitialBackoff: 2 * time.Second,
+	MaxBackoff:     time.Minute,
+	Timeout:        10 * time.Second,
+	Workers:        4,
+	QueueSize:      256,
+}
+
+// delivery is an event to send to one webhook
+type delivery struct {
+	webhook *model.Webhook
+	event   Event
+	body    []byte
+}
+
+// Dispatcher POSTs booking events to the webhooks subscribed to them,
+// retrying failed deliveries with exponential backoff
+type Dispatcher struct {
+	repo   repository.WebhookRepository
+	config DispatcherConfig
+	client *http.Client
+
+	queue  chan delivery
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewDispatcher creates a webhook dispatcher. Zero config fields take their
+// value from DefaultDispatcherConfig.
+func NewDispatcher(repo repository.WebhookRepository, config DispatcherConfig) *Dispatcher {
+	if config.MaxAttempts <= 0 {
+		config.MaxAttempts = DefaultDispatcherConfig.MaxAttempts
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = DefaultDispatcherConfig.InitialBackoff
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = DefaultDispatcherConfig.MaxBackoff
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultDispatcherConfig.Timeout
+	}
+	if config.Workers <= 0 {
+		config.Workers = DefaultDispatcherConfig.Workers
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = DefaultDispatcherConfig.QueueSize
+	}
+
+	return &Dispatcher{
+		repo:   repo,
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		queue:  make(chan delivery, config.QueueSize),
+	}
+}
+
+// Start delivers the events from events in the background until ctx is done
+// or Stop is called
+func (d *Dispatcher) Start(ctx context.Context, events <-chan service.BookingEvent) {
+	ctx, d.cancel = context.WithCancel(ctx)
+
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		d.receive(ctx, events)
+	}()
+
+	for i := 0; i < d.config.Workers; i++ {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			d.work(ctx)
+		}()
+	}
+}
+
+// Stop abandons pending deliveries and waits for the workers to return
+func (d *Dispatcher) Stop() {
+	if d.cancel != nil {
+		d.cancel()
+	}
+	d.wg.Wait()
+}
+
+// receive queues a delivery of each event to every webhook subscribed to it
+func (d *Dispatcher) receive(ctx context.Context, events <-chan service.BookingEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case bookingEvent, ok := <-events:
+			if !ok {
+				return
+			}
+			d.enqueue(ctx, bookingEvent)
+		}
+	}
+}
+
+func (d *Dispatcher) enqueue(ctx context.Context, bookingEvent service.BookingEvent) {
+	eventType := bookingEvent.Type.String()
+	// Only the booking's own shop's webhooks hear about it
+	webhooks, err := d.repo.ListWebhooksForEvent(tenant.WithID(ctx, bookingEvent.Tenant), eventType)
+	if err != nil {
+		log.Error().Err(err).Str("event", eventType).Msg("Failed to look up webhooks")
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	event := Event{
+		ID:         bookingEvent.ID,
+		Type:       eventType,
+		OccurredAt: bookingEvent.OccurredAt,
+		Booking:    bookingEvent.Booking,
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		log.Error().Err(err).Str("event", eventType).Msg("Failed to encode webhook event")
+		return
+	}
+
+	for _, webhook := range webhooks {
+		select {
+		case d.queue <- delivery{webhook: webhook, event: event, body: body}:
+		default:
+			log.Warn().
+				Str("webhookID", webhook.ID.Hex()).
+				Str("eventID", event.ID).
+				Msg("Dropped webhook delivery, queue is full")
+		}
+	}
+}
+
+// work makes queued deliveries until ctx is done
+func (d *Dispatcher) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case del := <-d.queue:
+			d.deliver(ctx, del)
+		}
+	}
+}
+
+// deliver sends a delivery, retrying with exponential backoff
+func (d *Dispatcher) deliver(ctx context.Context, del delivery) {
+	backoff := d.config.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		retry, err := d.send(ctx, del)
+		if err == nil {
+			log.Debug().
+				Str("webhookID", del.webhook.ID.Hex()).
+				Str("eventID", del.event.ID).
+				Int("attempt", attempt).
+				Msg("Webhook delivered")
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if !retry || attempt >= d.config.MaxAttempts {
+			log.Error().Err(err).
+				Str("webhookID", del.webhook.ID.Hex()).
+				Str("url", del.webhook.URL).
+				Str("eventID", del.event.ID).
+				Int("attempts", attempt).
+				Msg("Webhook delivery failed")
+			return
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, d.config.MaxBackoff)
+	}
+}
+
+// send makes one delivery attempt, reporting whether a failure is worth
+// retrying: network errors, rate limiting and server errors are
+func (d *Dispatcher) send(ctx context.Context, del delivery) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, del.webhook.URL, bytes.NewReader(del.body))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to build webhook request")
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, del.event.Type)
+	req.Header.Set(DeliveryIDHeader, del.event.ID)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(DeliverySignatureHeader, Sign([]byte(del.webhook.Secret), timestamp, del.body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return true, errors.Wrap(err, "failed to send webhook")
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of a delivery, computed over its
+// timestamp header, a dot and its body
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,164 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// fakeWebhookRepo returns the webhooks subscribed to an event
+type fakeWebhookRepo struct {
+	webhooks []*model.Webhook
+}
+
+func (r *fakeWebhookRepo) CreateWebhook(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	r.webhooks = append(r.webhooks, webhook)
+	return webhook, nil
+}
+
+func (r *fakeWebhookRepo) ListWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	return r.webhooks, nil
+}
+
+func (r *fakeWebhookRepo) ListWebhooksForEvent(ctx context.Context, eventType string) ([]*model.Webhook, error) {
+	var webhooks []*model.Webhook
+	for _, webhook := range r.webhooks {
+		if webhook.Subscribes(eventType) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+func (r *fakeWebhookRepo) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	return false, nil
+}
+
+// receiver records the deliveries it gets, answering them with the queued statuses
+type receiver struct {
+	mu         sync.Mutex
+	statuses   []int
+	requests   []*http.Request
+	bodies     [][]byte
+	deliveries chan struct{}
+}
+
+func newReceiver(statuses ...int) *receiver {
+	return &receiver{statuses: statuses, deliveries: make(chan struct{}, 16)}
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	rc.requests = append(rc.requests, r)
+	rc.bodies = append(rc.bodies, body)
+	status := http.StatusNoContent
+	if len(rc.statuses) > 0 {
+		status = rc.statuses[0]
+		rc.statuses = rc.statuses[1:]
+	}
+	rc.mu.Unlock()
+
+	w.WriteHeader(status)
+	rc.deliveries <- struct{}{}
+}
+
+// wait waits for n deliveries
+func (rc *receiver) wait(t *testing.T, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		select {
+		case <-rc.deliveries:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("got %d of %d deliveries", i, n)
+		}
+	}
+}
+
+// startDispatcher starts a dispatcher of the events sent on the returned channel
+func startDispatcher(t *testing.T, webhooks ...*model.Webhook) chan<- service.BookingEvent {
+	t.Helper()
+	d := NewDispatcher(&fakeWebhookRepo{webhooks: webhooks}, DispatcherConfig{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	})
+	events := make(chan service.BookingEvent, 8)
+	d.Start(context.Background(), events)
+	t.Cleanup(d.Stop)
+	return events
+}
+
+func TestDispatcher_DeliversSignedEvents(t *testing.T) {
+	rc := newReceiver()
+	server := httptest.NewServer(rc)
+	defer server.Close()
+
+	events := startDispatcher(t,
+		&model.Webhook{ID: primitive.NewObjectID(), URL: server.URL, Secret: "secret", EventTypes: []string{model.WebhookEventBookingConfirmed}},
+		&model.Webhook{ID: primitive.NewObjectID(), URL: server.URL, Secret: "other", EventTypes: []string{model.WebhookEventBookingCancelled}},
+	)
+
+	booking := &model.Booking{ID: primitive.NewObjectID(), UserID: "alice"}
+	events <- service.BookingEvent{ID: "event1", Type: service.BookingConfirmed, Booking: booking}
+	rc.wait(t, 1)
+
+	req, body := rc.requests[0], rc.bodies[0]
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(t, model.WebhookEventBookingConfirmed, req.Header.Get(EventHeader))
+	assert.Equal(t, Sign([]byte("secret"), req.Header.Get(TimestampHeader), body), req.Header.Get(DeliverySignatureHeader))
+
+	var event Event
+	require.NoError(t, json.Unmarshal(body, &event))
+	assert.Equal(t, "event1", event.ID)
+	assert.Equal(t, "event1", req.Header.Get(DeliveryIDHeader))
+	assert.Equal(t, model.WebhookEventBookingConfirmed, event.Type)
+	assert.Equal(t, booking.ID, event.Booking.ID)
+}
+
+func TestDispatcher_Retries(t *testing.T) {
+	t.Run("server errors are retried with the same event ID", func(t *testing.T) {
+		rc := newReceiver(http.StatusServiceUnavailable, http.StatusTooManyRequests)
+		server := httptest.NewServer(rc)
+		defer server.Close()
+
+		events := startDispatcher(t, &model.Webhook{ID: primitive.NewObjectID(), URL: server.URL, Secret: "secret", EventTypes: model.WebhookEvents})
+		events <- service.BookingEvent{ID: "event1", Type: service.BookingCreated, Booking: &model.Booking{ID: primitive.NewObjectID()}}
+		rc.wait(t, 3)
+
+		ids := []string{}
+		for _, req := range rc.requests {
+			ids = append(ids, req.Header.Get(DeliveryIDHeader))
+		}
+		assert.Equal(t, []string{"event1", "event1", "event1"}, ids)
+	})
+
+	t.Run("client errors aren't retried", func(t *testing.T) {
+		rc := newReceiver(http.StatusBadRequest)
+		server := httptest.NewServer(rc)
+		defer server.Close()
+
+		events := startDispatcher(t, &model.Webhook{ID: primitive.NewObjectID(), URL: server.URL, Secret: "secret", EventTypes: model.WebhookEvents})
+		events <- service.BookingEvent{Type: service.BookingCreated, Booking: &model.Booking{ID: primitive.NewObjectID()}}
+		rc.wait(t, 1)
+
+		select {
+		case <-rc.deliveries:
+			t.Fatal("rejected delivery was retried")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/payment"
+	"github.com/ita-av/booking-service/internal/service"
+)
+
+// PaymentHandler consumes payment provider webhooks and confirms bookings
+// whose deposit has been paid
+type PaymentHandler struct {
+	service  service.BookingServiceInterface
+	provider payment.Provider
+	tenants  *auth.TenantGuard
+}
+
+// NewPaymentHandler creates a new payment webhook handler
+func NewPaymentHandler(service service.BookingServiceInterface, provider payment.Provider, tenants *auth.TenantGuard) *PaymentHandler {
+	return &PaymentHandler{
+		service:  service,
+		provider: provider,
+		tenants:  tenants,
+	}
+}
+
+// ServeHTTP verifies the event and records successful deposit payments.
+// Events the service doesn't act on are acknowledged so they aren't retried.
+func (h *PaymentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	event, err := h.provider.ParseEvent(body, r.Header)
+	if err != nil {
+		if errors.Is(err, payment.ErrInvalidSignature) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if event.Type != payment.EventPaymentSucceeded || event.BookingID == "" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// The booking's tenant rides along in the payment's metadata
+	ctx, ok := scopeTenant(w, r, h.tenants, event.TenantID)
+	if !ok {
+		return
+	}
+
+	booking, err := h.service.RecordDepositPayment(ctx, event.BookingID, event.IntentID, event.Amount, event.Currency)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound), errors.Is(err, service.ErrDepositMismatch),
+			errors.Is(err, service.ErrBookingCancelled):
+			// Retrying won't help; the payment needs looking into by staff
+			log.Warn().Err(err).
+				Str("bookingID", event.BookingID).
+				Str("paymentIntentID", event.IntentID).
+				Msg("Deposit payment not applied to booking")
+			w.WriteHeader(http.StatusOK)
+		default:
+			log.Error().Err(err).Msg("Failed to process payment webhook")
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"bookingId": booking.ID.Hex(),
+		"status":    booking.Status.String(),
+	})
+}
@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/payment"
+	"github.com/ita-av/booking-service/internal/service"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// fakeProvider returns a fixed event, or ErrInvalidSignature if there is none
+type fakeProvider struct {
+	payment.Provider
+	event *payment.Event
+}
+
+func (p *fakeProvider) ParseEvent(payload []byte, header http.Header) (*payment.Event, error) {
+	if p.event == nil {
+		return nil, payment.ErrInvalidSignature
+	}
+	return p.event, nil
+}
+
+// fakeDepositService records the deposit payment it receives
+type fakeDepositService struct {
+	service.BookingServiceInterface
+	intentID string
+	tenant   string
+	err      error
+}
+
+func (f *fakeDepositService) RecordDepositPayment(ctx context.Context, bookingID, intentID string, amount int64, currency string) (*model.Booking, error) {
+	f.intentID = intentID
+	f.tenant = tenant.FromContext(ctx)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &model.Booking{ID: primitive.NewObjectID(), Status: model.BookingStatusConfirmed}, nil
+}
+
+// Test: Webhook the provider didn't sign is rejected
+func TestPaymentHandler_InvalidSignature(t *testing.T) {
+	svc := &fakeDepositService{}
+	handler := NewPaymentHandler(svc, &fakeProvider{}, &auth.TenantGuard{})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", strings.NewReader(`{}`))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, svc.intentID)
+}
+
+// Test: Successful payment confirms the booking
+func TestPaymentHandler_PaymentSucceeded(t *testing.T) {
+	svc := &fakeDepositService{}
+	handler := NewPaymentHandler(svc, &fakeProvider{event: &payment.Event{
+		Type: payment.EventPaymentSucceeded, IntentID: "pi_1", BookingID: "b1", TenantID: "acme", Amount: 2500, Currency: "EUR",
+	}}, &auth.TenantGuard{Enabled: true, Known: knownTenant})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", strings.NewReader(`{}`))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "pi_1", svc.intentID)
+	assert.Equal(t, "acme", svc.tenant)
+	assert.Contains(t, rec.Body.String(), `"status":"confirmed"`)
+}
+
+// Test: Payment for a booking that already expired is acknowledged, not retried
+func TestPaymentHandler_BookingCancelled(t *testing.T) {
+	svc := &fakeDepositService{err: service.ErrBookingCancelled}
+	handler := NewPaymentHandler(svc, &fakeProvider{event: &payment.Event{
+		Type: payment.EventPaymentSucceeded, IntentID: "pi_1", BookingID: "b1", Amount: 2500, Currency: "EUR",
+	}}, &auth.TenantGuard{})
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/stripe", strings.NewReader(`{}`))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
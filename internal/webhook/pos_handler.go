@@ -0,0 +1,166 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body
+const SignatureHeader = "X-Signature"
+
+// maxBodySize limits the size of webhook payloads
+const maxBodySize = 64 << 10
+
+// POSCompletionEvent is the payload a point-of-sale system posts when a
+// booking has been paid. TenantID is the shop it was paid at, empty for the
+// default shop.
+type POSCompletionEvent struct {
+	TenantID    string   `json:"tenantId,omitempty"`
+	BookingID   string   `json:"bookingId"`
+	ExternalRef string   `json:"externalRef"`
+	Amount      int64    `json:"amount"`
+	Tip         int64    `json:"tip"`
+	Currency    string   `json:"currency"`
+	Services    []string `json:"services"`
+	PaidAt      string   `json:"paidAt"`
+}
+
+// POSHandler consumes point-of-sale completion webhooks
+type POSHandler struct {
+	service service.BookingServiceInterface
+	secret  []byte
+	tenants *auth.TenantGuard
+}
+
+// NewPOSHandler creates a new point-of-sale webhook handler
+func NewPOSHandler(service service.BookingServiceInterface, secret string, tenants *auth.TenantGuard) *POSHandler {
+	return &POSHandler{
+		service: service,
+		secret:  []byte(secret),
+		tenants: tenants,
+	}
+}
+
+// ServeHTTP verifies the payload signature and records the completion
+func (h *POSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if !VerifySignature(h.secret, body, r.Header.Get(SignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var event POSCompletionEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	params, err := event.toParams()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx, ok := scopeTenant(w, r, h.tenants, event.TenantID)
+	if !ok {
+		return
+	}
+
+	booking, err := h.service.RecordPOSCompletion(ctx, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBookingNotFound):
+			http.Error(w, "booking not found", http.StatusNotFound)
+		case errors.Is(err, service.ErrBookingCancelled), errors.Is(err, service.ErrBookingAlreadyPaid),
+			errors.Is(err, service.ErrInvalidStatusTransition):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			log.Error().Err(err).Msg("Failed to process point-of-sale webhook")
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"bookingId":     booking.ID.Hex(),
+		"discrepancies": booking.Payment.Discrepancies,
+	})
+}
+
+// VerifySignature checks a hex encoded HMAC-SHA256 signature of body
+func VerifySignature(secret, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || len(secret) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// toParams validates the event and converts it to service parameters
+func (e *POSCompletionEvent) toParams() (service.POSCompletionParams, error) {
+	params := service.POSCompletionParams{
+		BookingID:   e.BookingID,
+		ExternalRef: e.ExternalRef,
+		Amount:      e.Amount,
+		Tip:         e.Tip,
+		Currency:    strings.ToUpper(e.Currency),
+	}
+
+	if e.BookingID == "" && e.ExternalRef == "" {
+		return params, errors.New("bookingId or externalRef is required")
+	}
+	if e.Amount < 0 || e.Tip < 0 {
+		return params, errors.New("amount and tip must not be negative")
+	}
+	if len(e.Currency) != 3 {
+		return params, errors.New("currency must be a 3-letter ISO 4217 code")
+	}
+
+	for _, name := range e.Services {
+		value, ok := pb.ServiceType_value[strings.ToUpper(name)]
+		if !ok {
+			return params, fmt.Errorf("unknown service %q", name)
+		}
+		params.RenderedServices = append(params.RenderedServices, model.ServiceType(value))
+	}
+
+	if e.PaidAt != "" {
+		paidAt, err := time.Parse(time.RFC3339, e.PaidAt)
+		if err != nil {
+			return params, errors.Wrap(err, "invalid paidAt")
+		}
+		params.PaidAt = paidAt
+	}
+
+	return params, nil
+}
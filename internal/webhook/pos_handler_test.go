@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/ita-av/booking-service/internal/auth"
+	"github.com/ita-av/booking-service/internal/model"
+	"github.com/ita-av/booking-service/internal/service"
+	"github.com/ita-av/booking-service/internal/tenant"
+)
+
+// fakeService records the completion it receives, and its tenant
+type fakeService struct {
+	service.BookingServiceInterface
+	params *service.POSCompletionParams
+	tenant string
+}
+
+func (f *fakeService) RecordPOSCompletion(ctx context.Context, params service.POSCompletionParams) (*model.Booking, error) {
+	f.params = &params
+	f.tenant = tenant.FromContext(ctx)
+	return &model.Booking{ID: primitive.NewObjectID(), Payment: &model.Payment{}}, nil
+}
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Test: Webhook with a bad signature is rejected
+func TestPOSHandler_InvalidSignature(t *testing.T) {
+	svc := &fakeService{}
+	handler := NewPOSHandler(svc, "secret", &auth.TenantGuard{})
+
+	body := `{"externalRef":"pos-1001","amount":3500,"currency":"EUR"}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/pos", strings.NewReader(body))
+	req.Header.Set(SignatureHeader, sign("other-secret", body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Nil(t, svc.params)
+}
+
+// Test: Signed webhook records the completion
+func TestPOSHandler_ValidSignature(t *testing.T) {
+	svc := &fakeService{}
+	handler := NewPOSHandler(svc, "secret", &auth.TenantGuard{})
+
+	body := `{"externalRef":"pos-1001","amount":3500,"tip":500,"currency":"eur","services":["haircut","BEARD_TRIM"]}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/pos", strings.NewReader(body))
+	req.Header.Set(SignatureHeader, sign("secret", body))
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	if assert.NotNil(t, svc.params) {
+		assert.Equal(t, "pos-1001", svc.params.ExternalRef)
+		assert.Equal(t, "EUR", svc.params.Currency)
+		assert.Equal(t, []model.ServiceType{model.ServiceTypeHaircut, model.ServiceTypeBeardTrim}, svc.params.RenderedServices)
+	}
+}
+
+// Test: Webhook is applied to the bookings of the tenant it names
+func TestPOSHandler_Tenant(t *testing.T) {
+	svc := &fakeService{}
+	handler := NewPOSHandler(svc, "secret", &auth.TenantGuard{Enabled: true, Known: knownTenant})
+
+	serve := func(body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/webhooks/pos", strings.NewReader(body))
+		req.Header.Set(SignatureHeader, sign("secret", body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve(`{"tenantId":"acme","externalRef":"pos-1001","amount":3500,"currency":"EUR"}`))
+	assert.Equal(t, "acme", svc.tenant)
+
+	svc.params = nil
+	assert.Equal(t, http.StatusBadRequest, serve(`{"tenantId":"../acme","externalRef":"pos-1001","amount":3500,"currency":"EUR"}`))
+	assert.Nil(t, svc.params)
+
+	assert.Equal(t, http.StatusNotFound, serve(`{"tenantId":"globex","externalRef":"pos-1001","amount":3500,"currency":"EUR"}`))
+	assert.Nil(t, svc.params)
+}
+
+// knownTenant knows the acme tenant only
+func knownTenant(ctx context.Context, tenantID string) (bool, error) {
+	return tenantID == "acme", nil
+}
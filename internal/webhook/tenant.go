@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+
+	"github.com/ita-av/booking-service/internal/auth"
+)
+
+// scopeTenant scopes a webhook's request to the tenant its signed payload
+// names. It writes the error response and returns false if it can't.
+func scopeTenant(w http.ResponseWriter, r *http.Request, tenants *auth.TenantGuard, tenantID string) (context.Context, bool) {
+	ctx, err := tenants.Scope(r.Context(), tenantID)
+	switch {
+	case errors.Is(err, auth.ErrInvalidTenant):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	case errors.Is(err, auth.ErrUnknownTenant):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return nil, false
+	case err != nil:
+		log.Error().Err(err).Str("tenant", tenantID).Msg("Failed to prepare tenant for webhook")
+		http.Error(w, "tenant is unavailable", http.StatusServiceUnavailable)
+		return nil, false
+	}
+	return ctx, true
+}
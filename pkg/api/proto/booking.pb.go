@@ -7,8 +7,11 @@
 package generated
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,6 +32,8 @@ const (
 	BookingStatus_CONFIRMED BookingStatus = 1
 	BookingStatus_CANCELLED BookingStatus = 2
 	BookingStatus_COMPLETED BookingStatus = 3
+	BookingStatus_NO_SHOW   BookingStatus = 4
+	BookingStatus_HELD      BookingStatus = 5 // Reserved by HoldTimeSlot until hold_expires_at
 )
 
 // Enum value maps for BookingStatus.
@@ -38,12 +43,16 @@ var (
 		1: "CONFIRMED",
 		2: "CANCELLED",
 		3: "COMPLETED",
+		4: "NO_SHOW",
+		5: "HELD",
 	}
 	BookingStatus_value = map[string]int32{
 		"PENDING":   0,
 		"CONFIRMED": 1,
 		"CANCELLED": 2,
 		"COMPLETED": 3,
+		"NO_SHOW":   4,
+		"HELD":      5,
 	}
 )
 
@@ -127,30 +136,8927 @@ func (ServiceType) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{1}
 }
 
+// Export file format
+type ExportFormat int32
+
+const (
+	ExportFormat_CSV  ExportFormat = 0
+	ExportFormat_JSON ExportFormat = 1
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "CSV",
+		1: "JSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"CSV":  0,
+		"JSON": 1,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[2].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[2]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{2}
+}
+
+// Length of the periods a report is broken down into
+type ReportGranularity int32
+
+const (
+	ReportGranularity_DAILY   ReportGranularity = 0
+	ReportGranularity_WEEKLY  ReportGranularity = 1 // Monday to Sunday
+	ReportGranularity_MONTHLY ReportGranularity = 2
+)
+
+// Enum value maps for ReportGranularity.
+var (
+	ReportGranularity_name = map[int32]string{
+		0: "DAILY",
+		1: "WEEKLY",
+		2: "MONTHLY",
+	}
+	ReportGranularity_value = map[string]int32{
+		"DAILY":   0,
+		"WEEKLY":  1,
+		"MONTHLY": 2,
+	}
+)
+
+func (x ReportGranularity) Enum() *ReportGranularity {
+	p := new(ReportGranularity)
+	*p = x
+	return p
+}
+
+func (x ReportGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[3].Descriptor()
+}
+
+func (ReportGranularity) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[3]
+}
+
+func (x ReportGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportGranularity.Descriptor instead.
+func (ReportGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{3}
+}
+
+// Who a reliability report's rows are about
+type ReliabilityGroup int32
+
+const (
+	ReliabilityGroup_BY_USER   ReliabilityGroup = 0
+	ReliabilityGroup_BY_BARBER ReliabilityGroup = 1
+)
+
+// Enum value maps for ReliabilityGroup.
+var (
+	ReliabilityGroup_name = map[int32]string{
+		0: "BY_USER",
+		1: "BY_BARBER",
+	}
+	ReliabilityGroup_value = map[string]int32{
+		"BY_USER":   0,
+		"BY_BARBER": 1,
+	}
+)
+
+func (x ReliabilityGroup) Enum() *ReliabilityGroup {
+	p := new(ReliabilityGroup)
+	*p = x
+	return p
+}
+
+func (x ReliabilityGroup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReliabilityGroup) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[4].Descriptor()
+}
+
+func (ReliabilityGroup) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[4]
+}
+
+func (x ReliabilityGroup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReliabilityGroup.Descriptor instead.
+func (ReliabilityGroup) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{4}
+}
+
+// Rate a reliability report is ordered by, highest first
+type ReliabilitySort int32
+
+const (
+	ReliabilitySort_NO_SHOW_RATE      ReliabilitySort = 0
+	ReliabilitySort_CANCELLATION_RATE ReliabilitySort = 1
+)
+
+// Enum value maps for ReliabilitySort.
+var (
+	ReliabilitySort_name = map[int32]string{
+		0: "NO_SHOW_RATE",
+		1: "CANCELLATION_RATE",
+	}
+	ReliabilitySort_value = map[string]int32{
+		"NO_SHOW_RATE":      0,
+		"CANCELLATION_RATE": 1,
+	}
+)
+
+func (x ReliabilitySort) Enum() *ReliabilitySort {
+	p := new(ReliabilitySort)
+	*p = x
+	return p
+}
+
+func (x ReliabilitySort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReliabilitySort) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[5].Descriptor()
+}
+
+func (ReliabilitySort) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[5]
+}
+
+func (x ReliabilitySort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReliabilitySort.Descriptor instead.
+func (ReliabilitySort) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{5}
+}
+
+// Span of time statistics cover
+type StatsPeriod int32
+
+const (
+	StatsPeriod_WEEK  StatsPeriod = 0 // Monday to Sunday
+	StatsPeriod_MONTH StatsPeriod = 1 // Calendar month
+)
+
+// Enum value maps for StatsPeriod.
+var (
+	StatsPeriod_name = map[int32]string{
+		0: "WEEK",
+		1: "MONTH",
+	}
+	StatsPeriod_value = map[string]int32{
+		"WEEK":  0,
+		"MONTH": 1,
+	}
+)
+
+func (x StatsPeriod) Enum() *StatsPeriod {
+	p := new(StatsPeriod)
+	*p = x
+	return p
+}
+
+func (x StatsPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[6].Descriptor()
+}
+
+func (StatsPeriod) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[6]
+}
+
+func (x StatsPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsPeriod.Descriptor instead.
+func (StatsPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{6}
+}
+
+// What happened to a booking
+type BookingEventType int32
+
+const (
+	BookingEventType_BOOKING_CREATED     BookingEventType = 0
+	BookingEventType_BOOKING_UPDATED     BookingEventType = 1
+	BookingEventType_BOOKING_CANCELLED   BookingEventType = 2
+	BookingEventType_BOOKING_RESCHEDULED BookingEventType = 3
+	BookingEventType_BOOKING_CONFIRMED   BookingEventType = 4
+)
+
+// Enum value maps for BookingEventType.
+var (
+	BookingEventType_name = map[int32]string{
+		0: "BOOKING_CREATED",
+		1: "BOOKING_UPDATED",
+		2: "BOOKING_CANCELLED",
+		3: "BOOKING_RESCHEDULED",
+		4: "BOOKING_CONFIRMED",
+	}
+	BookingEventType_value = map[string]int32{
+		"BOOKING_CREATED":     0,
+		"BOOKING_UPDATED":     1,
+		"BOOKING_CANCELLED":   2,
+		"BOOKING_RESCHEDULED": 3,
+		"BOOKING_CONFIRMED":   4,
+	}
+)
+
+func (x BookingEventType) Enum() *BookingEventType {
+	p := new(BookingEventType)
+	*p = x
+	return p
+}
+
+func (x BookingEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[7].Descriptor()
+}
+
+func (BookingEventType) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[7]
+}
+
+func (x BookingEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingEventType.Descriptor instead.
+func (BookingEventType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{7}
+}
+
+// Field bookings are sorted by
+type BookingSortField int32
+
+const (
+	BookingSortField_START_TIME BookingSortField = 0
+	BookingSortField_CREATED_AT BookingSortField = 1
+	BookingSortField_UPDATED_AT BookingSortField = 2
+)
+
+// Enum value maps for BookingSortField.
+var (
+	BookingSortField_name = map[int32]string{
+		0: "START_TIME",
+		1: "CREATED_AT",
+		2: "UPDATED_AT",
+	}
+	BookingSortField_value = map[string]int32{
+		"START_TIME": 0,
+		"CREATED_AT": 1,
+		"UPDATED_AT": 2,
+	}
+)
+
+func (x BookingSortField) Enum() *BookingSortField {
+	p := new(BookingSortField)
+	*p = x
+	return p
+}
+
+func (x BookingSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BookingSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[8].Descriptor()
+}
+
+func (BookingSortField) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[8]
+}
+
+func (x BookingSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BookingSortField.Descriptor instead.
+func (BookingSortField) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{8}
+}
+
+// What happens to bookings past their retention period
+type RetentionMode int32
+
+const (
+	RetentionMode_ANONYMIZE RetentionMode = 0
+	RetentionMode_DELETE    RetentionMode = 1
+)
+
+// Enum value maps for RetentionMode.
+var (
+	RetentionMode_name = map[int32]string{
+		0: "ANONYMIZE",
+		1: "DELETE",
+	}
+	RetentionMode_value = map[string]int32{
+		"ANONYMIZE": 0,
+		"DELETE":    1,
+	}
+)
+
+func (x RetentionMode) Enum() *RetentionMode {
+	p := new(RetentionMode)
+	*p = x
+	return p
+}
+
+func (x RetentionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetentionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[9].Descriptor()
+}
+
+func (RetentionMode) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[9]
+}
+
+func (x RetentionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetentionMode.Descriptor instead.
+func (RetentionMode) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{9}
+}
+
+// Day of the week
+type Weekday int32
+
+const (
+	Weekday_SUNDAY    Weekday = 0
+	Weekday_MONDAY    Weekday = 1
+	Weekday_TUESDAY   Weekday = 2
+	Weekday_WEDNESDAY Weekday = 3
+	Weekday_THURSDAY  Weekday = 4
+	Weekday_FRIDAY    Weekday = 5
+	Weekday_SATURDAY  Weekday = 6
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "SUNDAY",
+		1: "MONDAY",
+		2: "TUESDAY",
+		3: "WEDNESDAY",
+		4: "THURSDAY",
+		5: "FRIDAY",
+		6: "SATURDAY",
+	}
+	Weekday_value = map[string]int32{
+		"SUNDAY":    0,
+		"MONDAY":    1,
+		"TUESDAY":   2,
+		"WEDNESDAY": 3,
+		"THURSDAY":  4,
+		"FRIDAY":    5,
+		"SATURDAY":  6,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_api_proto_booking_proto_enumTypes[10].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_pkg_api_proto_booking_proto_enumTypes[10]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{10}
+}
+
 // Time slot model
 type TimeSlot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	StartTime string `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // ISO format datetime string, use start_time_ts
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	EndTime       string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"` // ISO format datetime string, use end_time_ts
+	StartTimeTs   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time_ts,json=startTimeTs,proto3" json:"start_time_ts,omitempty"`
+	EndTimeTs     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time_ts,json=endTimeTs,proto3" json:"end_time_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSlot) Reset() {
+	*x = TimeSlot{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlot) ProtoMessage() {}
+
+func (x *TimeSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlot.ProtoReflect.Descriptor instead.
+func (*TimeSlot) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{0}
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *TimeSlot) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *TimeSlot) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *TimeSlot) GetStartTimeTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTimeTs
+	}
+	return nil
+}
+
+func (x *TimeSlot) GetEndTimeTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTimeTs
+	}
+	return nil
+}
+
+// Available time slots response
+type TimeSlotList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeSlots     []*TimeSlot            `protobuf:"bytes,1,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeSlotList) Reset() {
+	*x = TimeSlotList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeSlotList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlotList) ProtoMessage() {}
+
+func (x *TimeSlotList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlotList.ProtoReflect.Descriptor instead.
+func (*TimeSlotList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimeSlotList) GetTimeSlots() []*TimeSlot {
+	if x != nil {
+		return x.TimeSlots
+	}
+	return nil
+}
+
+// Error detail sent with FAILED_PRECONDITION when the requested time overlaps other bookings
+type SlotUnavailableDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts     []*TimeSlot            `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`       // When the overlapping bookings take place
+	Alternatives  []*TimeSlot            `protobuf:"bytes,2,rep,name=alternatives,proto3" json:"alternatives,omitempty"` // The barber's next free slots with room for the same services
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotUnavailableDetail) Reset() {
+	*x = SlotUnavailableDetail{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotUnavailableDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotUnavailableDetail) ProtoMessage() {}
+
+func (x *SlotUnavailableDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotUnavailableDetail.ProtoReflect.Descriptor instead.
+func (*SlotUnavailableDetail) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SlotUnavailableDetail) GetConflicts() []*TimeSlot {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *SlotUnavailableDetail) GetAlternatives() []*TimeSlot {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+// Booking model
+type Booking struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId   string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BarberId string                 `protobuf:"bytes,3,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	StartTime string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // ISO format datetime string, use start_time_ts
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	EndTime     string        `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"` // ISO format datetime string, use end_time_ts
+	ServiceType ServiceType   `protobuf:"varint,6,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
+	Status      BookingStatus `protobuf:"varint,7,opt,name=status,proto3,enum=booking.BookingStatus" json:"status,omitempty"`
+	Notes       string        `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	CreatedAt string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO format datetime string, use created_at_ts
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	UpdatedAt   string        `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`       // ISO format datetime string, use updated_at_ts
+	ExternalRef string        `protobuf:"bytes,11,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"` // Reference from an external system such as a POS
+	Payment     *Payment      `protobuf:"bytes,12,opt,name=payment,proto3" json:"payment,omitempty"`                            // Set once the booking is settled at the point of sale
+	Attachments []*Attachment `protobuf:"bytes,13,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	CheckedInAt string `protobuf:"bytes,14,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"` // ISO format datetime string, use checked_in_at_ts
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	ReleasedAt string `protobuf:"bytes,15,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"` // ISO format datetime string, use released_at_ts
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	RescheduledFrom   string                 `protobuf:"bytes,16,opt,name=rescheduled_from,json=rescheduledFrom,proto3" json:"rescheduled_from,omitempty"` // ISO format datetime string, use rescheduled_from_ts
+	Version           int64                  `protobuf:"varint,17,opt,name=version,proto3" json:"version,omitempty"`                                       // Incremented on every change, used for optimistic concurrency
+	StartTimeTs       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=start_time_ts,json=startTimeTs,proto3" json:"start_time_ts,omitempty"`
+	EndTimeTs         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=end_time_ts,json=endTimeTs,proto3" json:"end_time_ts,omitempty"`
+	CreatedAtTs       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	UpdatedAtTs       *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at_ts,json=updatedAtTs,proto3" json:"updated_at_ts,omitempty"`
+	CheckedInAtTs     *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=checked_in_at_ts,json=checkedInAtTs,proto3" json:"checked_in_at_ts,omitempty"`                           // Set once the customer has arrived
+	ReleasedAtTs      *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=released_at_ts,json=releasedAtTs,proto3" json:"released_at_ts,omitempty"`                                // Set if the slot was released after a late arrival
+	RescheduledFromTs *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=rescheduled_from_ts,json=rescheduledFromTs,proto3" json:"rescheduled_from_ts,omitempty"`                 // The start time before the last reschedule
+	ServiceTypes      []ServiceType          `protobuf:"varint,25,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"` // All services, done back to back; service_type is the first
+	Price             int64                  `protobuf:"varint,26,opt,name=price,proto3" json:"price,omitempty"`                                                                   // Quoted when booked less any discount, in minor currency units
+	Currency          string                 `protobuf:"bytes,27,opt,name=currency,proto3" json:"currency,omitempty"`
+	Deposit           *Deposit               `protobuf:"bytes,28,opt,name=deposit,proto3" json:"deposit,omitempty"`                                                  // Set if the booking needs an online deposit
+	Cancellation      *Cancellation          `protobuf:"bytes,29,opt,name=cancellation,proto3" json:"cancellation,omitempty"`                                        // Set once the booking is cancelled
+	ReviewFlaggedAtTs *timestamppb.Timestamp `protobuf:"bytes,30,opt,name=review_flagged_at_ts,json=reviewFlaggedAtTs,proto3" json:"review_flagged_at_ts,omitempty"` // Set if the booking ended without a check-in and needs completing or marking a no-show
+	HoldExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,31,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"`               // When a HELD slot is freed unless booked
+	PromoCode         string                 `protobuf:"bytes,32,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`                             // The promo code the booking was made with
+	Discount          int64                  `protobuf:"varint,33,opt,name=discount,proto3" json:"discount,omitempty"`                                               // Taken off the price by the promo code
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Booking) Reset() {
+	*x = Booking{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Booking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Booking) ProtoMessage() {}
+
+func (x *Booking) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
+func (*Booking) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Booking) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Booking) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Booking) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Booking) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Booking) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *Booking) GetServiceType() ServiceType {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *Booking) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_PENDING
+}
+
+func (x *Booking) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Booking) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Booking) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Booking) GetExternalRef() string {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return ""
+}
+
+func (x *Booking) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *Booking) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Booking) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Booking) GetReleasedAt() string {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Booking) GetRescheduledFrom() string {
+	if x != nil {
+		return x.RescheduledFrom
+	}
+	return ""
+}
+
+func (x *Booking) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Booking) GetStartTimeTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTimeTs
+	}
+	return nil
+}
+
+func (x *Booking) GetEndTimeTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTimeTs
+	}
+	return nil
+}
+
+func (x *Booking) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+func (x *Booking) GetUpdatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAtTs
+	}
+	return nil
+}
+
+func (x *Booking) GetCheckedInAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedInAtTs
+	}
+	return nil
+}
+
+func (x *Booking) GetReleasedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAtTs
+	}
+	return nil
+}
+
+func (x *Booking) GetRescheduledFromTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RescheduledFromTs
+	}
+	return nil
+}
+
+func (x *Booking) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+func (x *Booking) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Booking) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Booking) GetDeposit() *Deposit {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *Booking) GetCancellation() *Cancellation {
+	if x != nil {
+		return x.Cancellation
+	}
+	return nil
+}
+
+func (x *Booking) GetReviewFlaggedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewFlaggedAtTs
+	}
+	return nil
+}
+
+func (x *Booking) GetHoldExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return nil
+}
+
+func (x *Booking) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Booking) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+// Who cancelled a booking, when and why, and the fee charged
+type Cancellation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancelledBy   string                 `protobuf:"bytes,2,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Fee           int64                  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"` // In minor currency units, per the cancellation policy
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cancellation) Reset() {
+	*x = Cancellation{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cancellation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cancellation) ProtoMessage() {}
+
+func (x *Cancellation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cancellation.ProtoReflect.Descriptor instead.
+func (*Cancellation) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Cancellation) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Cancellation) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+func (x *Cancellation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Cancellation) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Cancellation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Online prepayment for a booking, collected with a Stripe PaymentIntent
+type Deposit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"` // In minor currency units (e.g. cents)
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                 // pending, paid or expired
+	ClientSecret  string                 `protobuf:"bytes,4,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"` // Pass to Stripe on the client to pay; only sent to the customer, and empty once paid
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`          // The booking is cancelled if it's still unpaid by then
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deposit) Reset() {
+	*x = Deposit{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deposit) ProtoMessage() {}
+
+func (x *Deposit) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deposit.ProtoReflect.Descriptor instead.
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Deposit) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Deposit) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Deposit) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Deposit) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *Deposit) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Deposit) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+// Reference image attached to a booking
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url         string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes   int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO format datetime string, use created_at_ts
+	CreatedAtTs   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at_ts,json=createdAtTs,proto3" json:"created_at_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Attachment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Attachment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTs
+	}
+	return nil
+}
+
+// Point-of-sale settlement of a booking
+type Payment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Amount           int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`    // Final amount in minor currency units (e.g. cents)
+	Tip              int64                  `protobuf:"varint,2,opt,name=tip,proto3" json:"tip,omitempty"`          // Tip in minor currency units
+	Currency         string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 currency code
+	RenderedServices []ServiceType          `protobuf:"varint,4,rep,packed,name=rendered_services,json=renderedServices,proto3,enum=booking.ServiceType" json:"rendered_services,omitempty"`
+	Discrepancies    []string               `protobuf:"bytes,5,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"` // Differences between what was booked and what was rendered
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	PaidAt        string                 `protobuf:"bytes,6,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"` // ISO format datetime string, use paid_at_ts
+	PaidAtTs      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=paid_at_ts,json=paidAtTs,proto3" json:"paid_at_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Payment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetTip() int64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+func (x *Payment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payment) GetRenderedServices() []ServiceType {
+	if x != nil {
+		return x.RenderedServices
+	}
+	return nil
+}
+
+func (x *Payment) GetDiscrepancies() []string {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *Payment) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *Payment) GetPaidAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAtTs
+	}
+	return nil
+}
+
+// List of bookings
+type BookingList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingList) Reset() {
+	*x = BookingList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingList) ProtoMessage() {}
+
+func (x *BookingList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingList.ProtoReflect.Descriptor instead.
+func (*BookingList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BookingList) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+// Create booking request
+type CreateBookingRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BarberId string                 `protobuf:"bytes,2,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	StartTime      string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // ISO format datetime string, use start_time_ts
+	ServiceType    ServiceType            `protobuf:"varint,4,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
+	Notes          string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExternalRef    string                 `protobuf:"bytes,6,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`                                     // Optional reference from an external system such as a POS
+	IdempotencyKey string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`                            // Optional client-generated key; retries with the same key return the original booking
+	StartTimeTs    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_time_ts,json=startTimeTs,proto3" json:"start_time_ts,omitempty"`                                   // Takes precedence over start_time
+	ServiceTypes   []ServiceType          `protobuf:"varint,9,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"` // Several services done back to back; takes precedence over service_type
+	HoldId         string                 `protobuf:"bytes,10,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`                                                   // Books a hold from HoldTimeSlot; its barber, time and services are used
+	PromoCode      string                 `protobuf:"bytes,11,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`                                          // Optional; takes the code's discount off the price
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateBookingRequest) Reset() {
+	*x = CreateBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingRequest) ProtoMessage() {}
+
+func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
+func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateBookingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *CreateBookingRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetServiceType() ServiceType {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *CreateBookingRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetExternalRef() string {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetStartTimeTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTimeTs
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+// Hold time slot request
+type HoldTimeSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BarberId      string                 `protobuf:"bytes,2,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	ServiceTypes  []ServiceType          `protobuf:"varint,4,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"`
+	HoldMinutes   int32                  `protobuf:"varint,5,opt,name=hold_minutes,json=holdMinutes,proto3" json:"hold_minutes,omitempty"` // How long the slot is held
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldTimeSlotRequest) Reset() {
+	*x = HoldTimeSlotRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldTimeSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldTimeSlotRequest) ProtoMessage() {}
+
+func (x *HoldTimeSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldTimeSlotRequest.ProtoReflect.Descriptor instead.
+func (*HoldTimeSlotRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HoldTimeSlotRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HoldTimeSlotRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *HoldTimeSlotRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *HoldTimeSlotRequest) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+func (x *HoldTimeSlotRequest) GetHoldMinutes() int32 {
+	if x != nil {
+		return x.HoldMinutes
+	}
+	return 0
+}
+
+// Rebook last request
+type RebookLastRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Optional client-generated key; retries with the same key return the original booking
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RebookLastRequest) Reset() {
+	*x = RebookLastRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebookLastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebookLastRequest) ProtoMessage() {}
+
+func (x *RebookLastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebookLastRequest.ProtoReflect.Descriptor instead.
+func (*RebookLastRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RebookLastRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RebookLastRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// Get booking request
+type GetBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Update booking request
+type UpdateBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // ISO format datetime string, use start_time_ts
+	ServiceType   ServiceType            `protobuf:"varint,3,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Version       *int64                 `protobuf:"varint,5,opt,name=version,proto3,oneof" json:"version,omitempty"`                                                         // If set, the update fails unless the booking is still at this version
+	StartTimeTs   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time_ts,json=startTimeTs,proto3" json:"start_time_ts,omitempty"`                                   // Takes precedence over start_time
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`                                        // Fields to update: start_time, service_type, service_types, notes
+	ServiceTypes  []ServiceType          `protobuf:"varint,8,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"` // Replaces all services; takes precedence over service_type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBookingRequest) Reset() {
+	*x = UpdateBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingRequest) ProtoMessage() {}
+
+func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *UpdateBookingRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateBookingRequest) GetServiceType() ServiceType {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *UpdateBookingRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateBookingRequest) GetVersion() int64 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *UpdateBookingRequest) GetStartTimeTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTimeTs
+	}
+	return nil
+}
+
+func (x *UpdateBookingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateBookingRequest) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+// Cancel booking request
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Optional, up to 500 characters
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelBookingRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Cancel booking response
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Fee           int64                  `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"` // Charged under the cancellation policy, in minor currency units
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	CancelledAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelBookingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelBookingResponse) GetFee() int64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *CancelBookingResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CancelBookingResponse) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+// Get user bookings request
+type GetUserBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserBookingsRequest) Reset() {
+	*x = GetUserBookingsRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBookingsRequest) ProtoMessage() {}
+
+func (x *GetUserBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBookingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserBookingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Calendar date without a time of day
+type CalendarDate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"` // 1-12
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`     // 1-31
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarDate) Reset() {
+	*x = CalendarDate{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDate) ProtoMessage() {}
+
+func (x *CalendarDate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDate.ProtoReflect.Descriptor instead.
+func (*CalendarDate) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CalendarDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CalendarDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *CalendarDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+// Get barber bookings request
+type GetBarberBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`         // ISO format date string (optional)
+	Day           *CalendarDate          `protobuf:"bytes,3,opt,name=day,proto3" json:"day,omitempty"`           // Structured alternative to date (optional)
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA timezone for day boundaries, e.g. "Europe/Berlin" (defaults to the barber's)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBarberBookingsRequest) Reset() {
+	*x = GetBarberBookingsRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBarberBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBarberBookingsRequest) ProtoMessage() {}
+
+func (x *GetBarberBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBarberBookingsRequest.ProtoReflect.Descriptor instead.
+func (*GetBarberBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBarberBookingsRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetBarberBookingsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetBarberBookingsRequest) GetDay() *CalendarDate {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *GetBarberBookingsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Get booking by external reference request
+type GetBookingByExternalRefRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalRef   string                 `protobuf:"bytes,1,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingByExternalRefRequest) Reset() {
+	*x = GetBookingByExternalRefRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingByExternalRefRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingByExternalRefRequest) ProtoMessage() {}
+
+func (x *GetBookingByExternalRefRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingByExternalRefRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingByExternalRefRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetBookingByExternalRefRequest) GetExternalRef() string {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return ""
+}
+
+// Get available time slots request
+type GetAvailableTimeSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                                                                      // ISO format date string
+	Day           *CalendarDate          `protobuf:"bytes,3,opt,name=day,proto3" json:"day,omitempty"`                                                                        // Structured alternative to date
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                              // IANA timezone for day boundaries, e.g. "Europe/Berlin" (defaults to the barber's)
+	ServiceType   *ServiceType           `protobuf:"varint,5,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType,oneof" json:"service_type,omitempty"`     // Only return slots with room for this service
+	ServiceTypes  []ServiceType          `protobuf:"varint,6,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"` // Only return slots with room for these services back to back
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableTimeSlotsRequest) Reset() {
+	*x = GetAvailableTimeSlotsRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableTimeSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableTimeSlotsRequest) ProtoMessage() {}
+
+func (x *GetAvailableTimeSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableTimeSlotsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableTimeSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAvailableTimeSlotsRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetAvailableTimeSlotsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetAvailableTimeSlotsRequest) GetDay() *CalendarDate {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *GetAvailableTimeSlotsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetAvailableTimeSlotsRequest) GetServiceType() ServiceType {
+	if x != nil && x.ServiceType != nil {
+		return *x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *GetAvailableTimeSlotsRequest) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+// Record point-of-sale completion request
+type RecordPOSCompletionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BookingId        string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"` // Either booking_id or external_ref is required
+	ExternalRef      string                 `protobuf:"bytes,2,opt,name=external_ref,json=externalRef,proto3" json:"external_ref,omitempty"`
+	Amount           int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`    // Final amount in minor currency units (e.g. cents)
+	Tip              int64                  `protobuf:"varint,4,opt,name=tip,proto3" json:"tip,omitempty"`          // Tip in minor currency units
+	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"` // ISO 4217 currency code
+	RenderedServices []ServiceType          `protobuf:"varint,6,rep,packed,name=rendered_services,json=renderedServices,proto3,enum=booking.ServiceType" json:"rendered_services,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	PaidAt        string                 `protobuf:"bytes,7,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`         // ISO format datetime string, use paid_at_ts
+	PaidAtTs      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=paid_at_ts,json=paidAtTs,proto3" json:"paid_at_ts,omitempty"` // Optional, defaults to now; takes precedence over paid_at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordPOSCompletionRequest) Reset() {
+	*x = RecordPOSCompletionRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordPOSCompletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordPOSCompletionRequest) ProtoMessage() {}
+
+func (x *RecordPOSCompletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordPOSCompletionRequest.ProtoReflect.Descriptor instead.
+func (*RecordPOSCompletionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RecordPOSCompletionRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *RecordPOSCompletionRequest) GetExternalRef() string {
+	if x != nil {
+		return x.ExternalRef
+	}
+	return ""
+}
+
+func (x *RecordPOSCompletionRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordPOSCompletionRequest) GetTip() int64 {
+	if x != nil {
+		return x.Tip
+	}
+	return 0
+}
+
+func (x *RecordPOSCompletionRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RecordPOSCompletionRequest) GetRenderedServices() []ServiceType {
+	if x != nil {
+		return x.RenderedServices
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *RecordPOSCompletionRequest) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *RecordPOSCompletionRequest) GetPaidAtTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAtTs
+	}
+	return nil
+}
+
+// Export payroll request
+type ExportPayrollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"` // 1-12
+	Format        ExportFormat           `protobuf:"varint,3,opt,name=format,proto3,enum=booking.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPayrollRequest) Reset() {
+	*x = ExportPayrollRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPayrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPayrollRequest) ProtoMessage() {}
+
+func (x *ExportPayrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPayrollRequest.ProtoReflect.Descriptor instead.
+func (*ExportPayrollRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportPayrollRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ExportPayrollRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *ExportPayrollRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_CSV
+}
+
+// Finalize payroll period request
+type FinalizePayrollPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`                             // 1-12
+	Format        ExportFormat           `protobuf:"varint,3,opt,name=format,proto3,enum=booking.ExportFormat" json:"format,omitempty"` // Format of the returned export
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinalizePayrollPeriodRequest) Reset() {
+	*x = FinalizePayrollPeriodRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinalizePayrollPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizePayrollPeriodRequest) ProtoMessage() {}
+
+func (x *FinalizePayrollPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizePayrollPeriodRequest.ProtoReflect.Descriptor instead.
+func (*FinalizePayrollPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FinalizePayrollPeriodRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *FinalizePayrollPeriodRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *FinalizePayrollPeriodRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_CSV
+}
+
+// Payroll export file
+type PayrollExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`        // YYYY-MM
+	Finalized     bool                   `protobuf:"varint,2,opt,name=finalized,proto3" json:"finalized,omitempty"` // Finalized periods are locked and no longer change
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayrollExport) Reset() {
+	*x = PayrollExport{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayrollExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayrollExport) ProtoMessage() {}
+
+func (x *PayrollExport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayrollExport.ProtoReflect.Descriptor instead.
+func (*PayrollExport) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PayrollExport) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *PayrollExport) GetFinalized() bool {
+	if x != nil {
+		return x.Finalized
+	}
+	return false
+}
+
+func (x *PayrollExport) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *PayrollExport) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PayrollExport) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// Add booking attachment request
+type AddBookingAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                    // External image URL; leave empty to upload instead
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // Content type of the file to upload (image/jpeg, image/png, image/webp, image/heic)
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`      // Size of the file to upload
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookingAttachmentRequest) Reset() {
+	*x = AddBookingAttachmentRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookingAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookingAttachmentRequest) ProtoMessage() {}
+
+func (x *AddBookingAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookingAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*AddBookingAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AddBookingAttachmentRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *AddBookingAttachmentRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddBookingAttachmentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AddBookingAttachmentRequest) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// Add booking attachment response
+type AddBookingAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,2,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"` // Pre-signed URL to PUT the file to (uploads only)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBookingAttachmentResponse) Reset() {
+	*x = AddBookingAttachmentResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBookingAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBookingAttachmentResponse) ProtoMessage() {}
+
+func (x *AddBookingAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBookingAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*AddBookingAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddBookingAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AddBookingAttachmentResponse) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+// Submit survey response request
+type SubmitSurveyResponseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`  // Token from the survey link
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"` // 1-5
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitSurveyResponseRequest) Reset() {
+	*x = SubmitSurveyResponseRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSurveyResponseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSurveyResponseRequest) ProtoMessage() {}
+
+func (x *SubmitSurveyResponseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSurveyResponseRequest.ProtoReflect.Descriptor instead.
+func (*SubmitSurveyResponseRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SubmitSurveyResponseRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *SubmitSurveyResponseRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SubmitSurveyResponseRequest) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SubmitSurveyResponseRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// Submit survey response response
+type SubmitSurveyResponseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitSurveyResponseResponse) Reset() {
+	*x = SubmitSurveyResponseResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitSurveyResponseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitSurveyResponseResponse) ProtoMessage() {}
+
+func (x *SubmitSurveyResponseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitSurveyResponseResponse.ProtoReflect.Descriptor instead.
+func (*SubmitSurveyResponseResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SubmitSurveyResponseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SubmitSurveyResponseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Get barber survey scores request
+type GetBarberSurveyScoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO format date string (optional, inclusive)
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // ISO format date string (optional, exclusive)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBarberSurveyScoresRequest) Reset() {
+	*x = GetBarberSurveyScoresRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBarberSurveyScoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBarberSurveyScoresRequest) ProtoMessage() {}
+
+func (x *GetBarberSurveyScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBarberSurveyScoresRequest.ProtoReflect.Descriptor instead.
+func (*GetBarberSurveyScoresRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetBarberSurveyScoresRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetBarberSurveyScoresRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetBarberSurveyScoresRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// Aggregate survey scores for a barber
+type SurveyScores struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Responses     int32                  `protobuf:"varint,2,opt,name=responses,proto3" json:"responses,omitempty"`
+	AverageScore  float64                `protobuf:"fixed64,3,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	ScoreCounts   []int32                `protobuf:"varint,4,rep,packed,name=score_counts,json=scoreCounts,proto3" json:"score_counts,omitempty"` // Number of responses per score, from 1 to 5
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SurveyScores) Reset() {
+	*x = SurveyScores{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurveyScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurveyScores) ProtoMessage() {}
+
+func (x *SurveyScores) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurveyScores.ProtoReflect.Descriptor instead.
+func (*SurveyScores) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SurveyScores) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *SurveyScores) GetResponses() int32 {
+	if x != nil {
+		return x.Responses
+	}
+	return 0
+}
+
+func (x *SurveyScores) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *SurveyScores) GetScoreCounts() []int32 {
+	if x != nil {
+		return x.ScoreCounts
+	}
+	return nil
+}
+
+// Submit review request
+type SubmitReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewRequest) Reset() {
+	*x = SubmitReviewRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewRequest) ProtoMessage() {}
+
+func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubmitReviewRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *SubmitReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// A customer's review of a completed booking
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId     string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Barbers and admins only; empty once the customer's data is erased
+	BarberId      string                 `protobuf:"bytes,4,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"` // 1-5
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Review) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Review) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// List barber reviews request
+type ListBarberReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"` // Only reviews created before this, for the next page (optional)
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`  // Defaults to 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBarberReviewsRequest) Reset() {
+	*x = ListBarberReviewsRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBarberReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBarberReviewsRequest) ProtoMessage() {}
+
+func (x *ListBarberReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBarberReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListBarberReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListBarberReviewsRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *ListBarberReviewsRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ListBarberReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A page of a barber's reviews
+type ReviewList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`                         // Newest first
+	NextBefore    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_before,json=nextBefore,proto3" json:"next_before,omitempty"` // Pass as before for the next page; unset on an empty page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewList) Reset() {
+	*x = ReviewList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewList) ProtoMessage() {}
+
+func (x *ReviewList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewList.ProtoReflect.Descriptor instead.
+func (*ReviewList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReviewList) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ReviewList) GetNextBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextBefore
+	}
+	return nil
+}
+
+// Get barber rating request
+type GetBarberRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBarberRatingRequest) Reset() {
+	*x = GetBarberRatingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBarberRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBarberRatingRequest) ProtoMessage() {}
+
+func (x *GetBarberRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBarberRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetBarberRatingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetBarberRatingRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+// The average of a barber's review ratings
+type BarberRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Reviews       int32                  `protobuf:"varint,2,opt,name=reviews,proto3" json:"reviews,omitempty"`
+	AverageRating float64                `protobuf:"fixed64,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"` // Zero without reviews
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BarberRating) Reset() {
+	*x = BarberRating{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarberRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarberRating) ProtoMessage() {}
+
+func (x *BarberRating) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarberRating.ProtoReflect.Descriptor instead.
+func (*BarberRating) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BarberRating) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *BarberRating) GetReviews() int32 {
+	if x != nil {
+		return x.Reviews
+	}
+	return 0
+}
+
+func (x *BarberRating) GetAverageRating() float64 {
+	if x != nil {
+		return x.AverageRating
+	}
+	return 0
+}
+
+// Get barber stats request
+type GetBarberStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Period        StatsPeriod            `protobuf:"varint,2,opt,name=period,proto3,enum=booking.StatsPeriod" json:"period,omitempty"`
+	Day           *CalendarDate          `protobuf:"bytes,3,opt,name=day,proto3" json:"day,omitempty"` // A day within the period, in the barber's time zone (optional, defaults to today)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBarberStatsRequest) Reset() {
+	*x = GetBarberStatsRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBarberStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBarberStatsRequest) ProtoMessage() {}
+
+func (x *GetBarberStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBarberStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetBarberStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetBarberStatsRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetBarberStatsRequest) GetPeriod() StatsPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return StatsPeriod_WEEK
+}
+
+func (x *GetBarberStatsRequest) GetDay() *CalendarDate {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+// Booking count for one status
+type StatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        BookingStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=booking.BookingStatus" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusCount) Reset() {
+	*x = StatusCount{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCount) ProtoMessage() {}
+
+func (x *StatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCount.ProtoReflect.Descriptor instead.
+func (*StatusCount) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StatusCount) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_PENDING
+}
+
+func (x *StatusCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Time booked with a barber on one day
+type DayStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           *CalendarDate          `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Bookings      int32                  `protobuf:"varint,2,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	BookedHours   float64                `protobuf:"fixed64,3,opt,name=booked_hours,json=bookedHours,proto3" json:"booked_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayStats) Reset() {
+	*x = DayStats{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayStats) ProtoMessage() {}
+
+func (x *DayStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayStats.ProtoReflect.Descriptor instead.
+func (*DayStats) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DayStats) GetDay() *CalendarDate {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *DayStats) GetBookings() int32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *DayStats) GetBookedHours() float64 {
+	if x != nil {
+		return x.BookedHours
+	}
+	return 0
+}
+
+// A barber's booking statistics for a period
+type BarberStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	StartDay      *CalendarDate          `protobuf:"bytes,2,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`             // First day of the period
+	EndDay        *CalendarDate          `protobuf:"bytes,3,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`                   // Last day of the period
+	StatusCounts  []*StatusCount         `protobuf:"bytes,4,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"` // Bookings starting in the period, by status
+	Bookings      int32                  `protobuf:"varint,5,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	BookedHours   float64                `protobuf:"fixed64,6,opt,name=booked_hours,json=bookedHours,proto3" json:"booked_hours,omitempty"`    // Pending, confirmed, completed and no-show bookings
+	WorkingHours  float64                `protobuf:"fixed64,7,opt,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"` // Scheduled hours, less breaks and shop holidays
+	Utilization   float64                `protobuf:"fixed64,8,opt,name=utilization,proto3" json:"utilization,omitempty"`                       // Booked hours as a percentage of working hours
+	BusiestDays   []*DayStats            `protobuf:"bytes,9,rep,name=busiest_days,json=busiestDays,proto3" json:"busiest_days,omitempty"`      // Up to three days with the most booked hours, busiest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BarberStats) Reset() {
+	*x = BarberStats{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarberStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarberStats) ProtoMessage() {}
+
+func (x *BarberStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarberStats.ProtoReflect.Descriptor instead.
+func (*BarberStats) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BarberStats) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *BarberStats) GetStartDay() *CalendarDate {
+	if x != nil {
+		return x.StartDay
+	}
+	return nil
+}
+
+func (x *BarberStats) GetEndDay() *CalendarDate {
+	if x != nil {
+		return x.EndDay
+	}
+	return nil
+}
+
+func (x *BarberStats) GetStatusCounts() []*StatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *BarberStats) GetBookings() int32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *BarberStats) GetBookedHours() float64 {
+	if x != nil {
+		return x.BookedHours
+	}
+	return 0
+}
+
+func (x *BarberStats) GetWorkingHours() float64 {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return 0
+}
+
+func (x *BarberStats) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *BarberStats) GetBusiestDays() []*DayStats {
+	if x != nil {
+		return x.BusiestDays
+	}
+	return nil
+}
+
+// Get demand heatmap request
+type GetDemandHeatmapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"` // Optional, defaults to the whole shop
+	Weeks         int32                  `protobuf:"varint,2,opt,name=weeks,proto3" json:"weeks,omitempty"`                      // Weeks up to yesterday to cover (optional, defaults to 12)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDemandHeatmapRequest) Reset() {
+	*x = GetDemandHeatmapRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDemandHeatmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDemandHeatmapRequest) ProtoMessage() {}
+
+func (x *GetDemandHeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDemandHeatmapRequest.ProtoReflect.Descriptor instead.
+func (*GetDemandHeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetDemandHeatmapRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetDemandHeatmapRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+// Bookings that started in one hour of a weekday
+type HeatmapCell struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       Weekday                `protobuf:"varint,1,opt,name=weekday,proto3,enum=booking.Weekday" json:"weekday,omitempty"`
+	Hour          int32                  `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"` // 0-23
+	Bookings      int32                  `protobuf:"varint,3,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	WeeklyAverage float64                `protobuf:"fixed64,4,opt,name=weekly_average,json=weeklyAverage,proto3" json:"weekly_average,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeatmapCell) Reset() {
+	*x = HeatmapCell{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeatmapCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapCell) ProtoMessage() {}
+
+func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapCell.ProtoReflect.Descriptor instead.
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *HeatmapCell) GetWeekday() Weekday {
+	if x != nil {
+		return x.Weekday
+	}
+	return Weekday_SUNDAY
+}
+
+func (x *HeatmapCell) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetBookings() int32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetWeeklyAverage() float64 {
+	if x != nil {
+		return x.WeeklyAverage
+	}
+	return 0
+}
+
+// Past bookings by the weekday and hour they started at
+type DemandHeatmap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	StartDay      *CalendarDate          `protobuf:"bytes,2,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndDay        *CalendarDate          `protobuf:"bytes,3,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	Weeks         int32                  `protobuf:"varint,4,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`                          // The barber's, or the shop's for the whole shop
+	Cells         []*HeatmapCell         `protobuf:"bytes,6,rep,name=cells,proto3" json:"cells,omitempty"`                                // Every hour of every weekday, from Sunday midnight
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"` // Heatmaps are worked out once a day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DemandHeatmap) Reset() {
+	*x = DemandHeatmap{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DemandHeatmap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DemandHeatmap) ProtoMessage() {}
+
+func (x *DemandHeatmap) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DemandHeatmap.ProtoReflect.Descriptor instead.
+func (*DemandHeatmap) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DemandHeatmap) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *DemandHeatmap) GetStartDay() *CalendarDate {
+	if x != nil {
+		return x.StartDay
+	}
+	return nil
+}
+
+func (x *DemandHeatmap) GetEndDay() *CalendarDate {
+	if x != nil {
+		return x.EndDay
+	}
+	return nil
+}
+
+func (x *DemandHeatmap) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *DemandHeatmap) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DemandHeatmap) GetCells() []*HeatmapCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *DemandHeatmap) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+// Get daily agenda request
+type GetDailyAgendaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Day           *CalendarDate          `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"` // In the barber's time zone (optional, defaults to today)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyAgendaRequest) Reset() {
+	*x = GetDailyAgendaRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyAgendaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyAgendaRequest) ProtoMessage() {}
+
+func (x *GetDailyAgendaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyAgendaRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyAgendaRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetDailyAgendaRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetDailyAgendaRequest) GetDay() *CalendarDate {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+// Free time in a barber's working hours
+type AgendaGap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AgendaGap) Reset() {
+	*x = AgendaGap{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgendaGap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgendaGap) ProtoMessage() {}
+
+func (x *AgendaGap) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgendaGap.ProtoReflect.Descriptor instead.
+func (*AgendaGap) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AgendaGap) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AgendaGap) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// A barber's bookings for a day with the free time between them
+type DailyAgenda struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Day           *CalendarDate          `protobuf:"bytes,2,opt,name=day,proto3" json:"day,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"` // The barber's
+	Bookings      []*Booking             `protobuf:"bytes,4,rep,name=bookings,proto3" json:"bookings,omitempty"` // In the order they start, with their notes
+	Gaps          []*AgendaGap           `protobuf:"bytes,5,rep,name=gaps,proto3" json:"gaps,omitempty"`         // Working hours not taken by bookings, their cleanup time or breaks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyAgenda) Reset() {
+	*x = DailyAgenda{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyAgenda) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyAgenda) ProtoMessage() {}
+
+func (x *DailyAgenda) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyAgenda.ProtoReflect.Descriptor instead.
+func (*DailyAgenda) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DailyAgenda) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *DailyAgenda) GetDay() *CalendarDate {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *DailyAgenda) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *DailyAgenda) GetBookings() []*Booking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *DailyAgenda) GetGaps() []*AgendaGap {
+	if x != nil {
+		return x.Gaps
+	}
+	return nil
+}
+
+// Get revenue report request
+type GetRevenueReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"` // Optional, defaults to all barbers
+	StartDay      *CalendarDate          `protobuf:"bytes,2,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"` // In the shop's time zone
+	EndDay        *CalendarDate          `protobuf:"bytes,3,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`       // Inclusive
+	Granularity   ReportGranularity      `protobuf:"varint,4,opt,name=granularity,proto3,enum=booking.ReportGranularity" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRevenueReportRequest) Reset() {
+	*x = GetRevenueReportRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRevenueReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRevenueReportRequest) ProtoMessage() {}
+
+func (x *GetRevenueReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRevenueReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRevenueReportRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetRevenueReportRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetRevenueReportRequest) GetStartDay() *CalendarDate {
+	if x != nil {
+		return x.StartDay
+	}
+	return nil
+}
+
+func (x *GetRevenueReportRequest) GetEndDay() *CalendarDate {
+	if x != nil {
+		return x.EndDay
+	}
+	return nil
+}
+
+func (x *GetRevenueReportRequest) GetGranularity() ReportGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return ReportGranularity_DAILY
+}
+
+// Revenue of one barber's bookings for one service in a period
+type RevenueLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *CalendarDate          `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	BarberId      string                 `protobuf:"bytes,2,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	ServiceType   ServiceType            `protobuf:"varint,3,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"` // Bookings with several services count under each
+	Bookings      int32                  `protobuf:"varint,4,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	Revenue       int64                  `protobuf:"varint,5,opt,name=revenue,proto3" json:"revenue,omitempty"` // In minor currency units (e.g. cents)
+	Tips          int64                  `protobuf:"varint,6,opt,name=tips,proto3" json:"tips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueLine) Reset() {
+	*x = RevenueLine{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueLine) ProtoMessage() {}
+
+func (x *RevenueLine) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueLine.ProtoReflect.Descriptor instead.
+func (*RevenueLine) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RevenueLine) GetPeriodStart() *CalendarDate {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *RevenueLine) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *RevenueLine) GetServiceType() ServiceType {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *RevenueLine) GetBookings() int32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *RevenueLine) GetRevenue() int64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *RevenueLine) GetTips() int64 {
+	if x != nil {
+		return x.Tips
+	}
+	return 0
+}
+
+// Revenue of completed bookings by period, barber and service
+type RevenueReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDay      *CalendarDate          `protobuf:"bytes,1,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndDay        *CalendarDate          `protobuf:"bytes,2,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	Granularity   ReportGranularity      `protobuf:"varint,3,opt,name=granularity,proto3,enum=booking.ReportGranularity" json:"granularity,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Lines         []*RevenueLine         `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"` // By period, then barber, then service
+	Bookings      int32                  `protobuf:"varint,6,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	Revenue       int64                  `protobuf:"varint,7,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	Tips          int64                  `protobuf:"varint,8,opt,name=tips,proto3" json:"tips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueReport) Reset() {
+	*x = RevenueReport{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueReport) ProtoMessage() {}
+
+func (x *RevenueReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueReport.ProtoReflect.Descriptor instead.
+func (*RevenueReport) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RevenueReport) GetStartDay() *CalendarDate {
+	if x != nil {
+		return x.StartDay
+	}
+	return nil
+}
+
+func (x *RevenueReport) GetEndDay() *CalendarDate {
+	if x != nil {
+		return x.EndDay
+	}
+	return nil
+}
+
+func (x *RevenueReport) GetGranularity() ReportGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return ReportGranularity_DAILY
+}
+
+func (x *RevenueReport) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RevenueReport) GetLines() []*RevenueLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *RevenueReport) GetBookings() int32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *RevenueReport) GetRevenue() int64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+func (x *RevenueReport) GetTips() int64 {
+	if x != nil {
+		return x.Tips
+	}
+	return 0
+}
+
+// Revenue report export file
+type RevenueExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevenueExport) Reset() {
+	*x = RevenueExport{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevenueExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevenueExport) ProtoMessage() {}
+
+func (x *RevenueExport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevenueExport.ProtoReflect.Descriptor instead.
+func (*RevenueExport) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RevenueExport) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *RevenueExport) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RevenueExport) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// Get retention policy request
+type GetRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRetentionPolicyRequest) Reset() {
+	*x = GetRetentionPolicyRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *GetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{51}
+}
+
+// Data retention policy; a period of 0 days keeps bookings forever
+type RetentionPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompletedDays int32                  `protobuf:"varint,1,opt,name=completed_days,json=completedDays,proto3" json:"completed_days,omitempty"`
+	CancelledDays int32                  `protobuf:"varint,2,opt,name=cancelled_days,json=cancelledDays,proto3" json:"cancelled_days,omitempty"`
+	NoShowDays    int32                  `protobuf:"varint,3,opt,name=no_show_days,json=noShowDays,proto3" json:"no_show_days,omitempty"`
+	Mode          RetentionMode          `protobuf:"varint,4,opt,name=mode,proto3,enum=booking.RetentionMode" json:"mode,omitempty"`
+	AuditDays     int32                  `protobuf:"varint,5,opt,name=audit_days,json=auditDays,proto3" json:"audit_days,omitempty"` // How long audit log entries are kept
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RetentionPolicy) GetCompletedDays() int32 {
+	if x != nil {
+		return x.CompletedDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetCancelledDays() int32 {
+	if x != nil {
+		return x.CancelledDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetNoShowDays() int32 {
+	if x != nil {
+		return x.NoShowDays
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetMode() RetentionMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RetentionMode_ANONYMIZE
+}
+
+func (x *RetentionPolicy) GetAuditDays() int32 {
+	if x != nil {
+		return x.AuditDays
+	}
+	return 0
+}
+
+// Update retention policy request
+type UpdateRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *RetentionPolicy       `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRetentionPolicyRequest) Reset() {
+	*x = UpdateRetentionPolicyRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateRetentionPolicyRequest) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Export user data request
+type ExportUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUserDataRequest) ProtoMessage() {}
+
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ExportUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// User data export file
+type UserDataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // JSON document
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDataExport) Reset() {
+	*x = UserDataExport{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExport) ProtoMessage() {}
+
+func (x *UserDataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExport.ProtoReflect.Descriptor instead.
+func (*UserDataExport) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UserDataExport) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UserDataExport) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UserDataExport) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// Erase user data request
+type EraseUserDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Mode          RetentionMode          `protobuf:"varint,2,opt,name=mode,proto3,enum=booking.RetentionMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseUserDataRequest) Reset() {
+	*x = EraseUserDataRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataRequest) ProtoMessage() {}
+
+func (x *EraseUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *EraseUserDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EraseUserDataRequest) GetMode() RetentionMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RetentionMode_ANONYMIZE
+}
+
+// Erase user data response
+type EraseUserDataResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BookingsAnonymized int64                  `protobuf:"varint,1,opt,name=bookings_anonymized,json=bookingsAnonymized,proto3" json:"bookings_anonymized,omitempty"`
+	BookingsDeleted    int64                  `protobuf:"varint,2,opt,name=bookings_deleted,json=bookingsDeleted,proto3" json:"bookings_deleted,omitempty"`
+	SurveysErased      int64                  `protobuf:"varint,3,opt,name=surveys_erased,json=surveysErased,proto3" json:"surveys_erased,omitempty"`                  // Anonymized or deleted, following the mode
+	AuditEntriesErased int64                  `protobuf:"varint,4,opt,name=audit_entries_erased,json=auditEntriesErased,proto3" json:"audit_entries_erased,omitempty"` // Anonymized or deleted, following the mode
+	ReviewsErased      int64                  `protobuf:"varint,5,opt,name=reviews_erased,json=reviewsErased,proto3" json:"reviews_erased,omitempty"`                  // Anonymized or deleted, following the mode
+	FavoritesErased    int64                  `protobuf:"varint,6,opt,name=favorites_erased,json=favoritesErased,proto3" json:"favorites_erased,omitempty"`            // Deleted in both modes
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *EraseUserDataResponse) Reset() {
+	*x = EraseUserDataResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseUserDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseUserDataResponse) ProtoMessage() {}
+
+func (x *EraseUserDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseUserDataResponse.ProtoReflect.Descriptor instead.
+func (*EraseUserDataResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *EraseUserDataResponse) GetBookingsAnonymized() int64 {
+	if x != nil {
+		return x.BookingsAnonymized
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetBookingsDeleted() int64 {
+	if x != nil {
+		return x.BookingsDeleted
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetSurveysErased() int64 {
+	if x != nil {
+		return x.SurveysErased
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetAuditEntriesErased() int64 {
+	if x != nil {
+		return x.AuditEntriesErased
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetReviewsErased() int64 {
+	if x != nil {
+		return x.ReviewsErased
+	}
+	return 0
+}
+
+func (x *EraseUserDataResponse) GetFavoritesErased() int64 {
+	if x != nil {
+		return x.FavoritesErased
+	}
+	return 0
+}
+
+// Get cancellation policy request
+type GetCancellationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCancellationPolicyRequest) Reset() {
+	*x = GetCancellationPolicyRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCancellationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCancellationPolicyRequest) ProtoMessage() {}
+
+func (x *GetCancellationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCancellationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetCancellationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{58}
+}
+
+// Applies to customer cancellations made less than within_minutes before the start
+type CancellationRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WithinMinutes int32                  `protobuf:"varint,1,opt,name=within_minutes,json=withinMinutes,proto3" json:"within_minutes,omitempty"`
+	FeePercent    int32                  `protobuf:"varint,2,opt,name=fee_percent,json=feePercent,proto3" json:"fee_percent,omitempty"` // Share of the booking's price charged
+	Forbidden     bool                   `protobuf:"varint,3,opt,name=forbidden,proto3" json:"forbidden,omitempty"`                     // Cancellations in the window are refused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancellationRule) Reset() {
+	*x = CancellationRule{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationRule) ProtoMessage() {}
+
+func (x *CancellationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationRule.ProtoReflect.Descriptor instead.
+func (*CancellationRule) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CancellationRule) GetWithinMinutes() int32 {
+	if x != nil {
+		return x.WithinMinutes
+	}
+	return 0
+}
+
+func (x *CancellationRule) GetFeePercent() int32 {
+	if x != nil {
+		return x.FeePercent
+	}
+	return 0
+}
+
+func (x *CancellationRule) GetForbidden() bool {
+	if x != nil {
+		return x.Forbidden
+	}
+	return false
+}
+
+// Cancellation policy; the rule with the narrowest matching window applies,
+// and cancellations outside every window are free
+type CancellationPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*CancellationRule    `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancellationPolicy) Reset() {
+	*x = CancellationPolicy{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancellationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationPolicy) ProtoMessage() {}
+
+func (x *CancellationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationPolicy.ProtoReflect.Descriptor instead.
+func (*CancellationPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CancellationPolicy) GetRules() []*CancellationRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Update cancellation policy request
+type UpdateCancellationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *CancellationPolicy    `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCancellationPolicyRequest) Reset() {
+	*x = UpdateCancellationPolicyRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCancellationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCancellationPolicyRequest) ProtoMessage() {}
+
+func (x *UpdateCancellationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCancellationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCancellationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateCancellationPolicyRequest) GetPolicy() *CancellationPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// Check in booking request
+type CheckInBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInBookingRequest) Reset() {
+	*x = CheckInBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInBookingRequest) ProtoMessage() {}
+
+func (x *CheckInBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInBookingRequest.ProtoReflect.Descriptor instead.
+func (*CheckInBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CheckInBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Mark no-show request
+type MarkNoShowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNoShowRequest) Reset() {
+	*x = MarkNoShowRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNoShowRequest) ProtoMessage() {}
+
+func (x *MarkNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNoShowRequest.ProtoReflect.Descriptor instead.
+func (*MarkNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MarkNoShowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Get user reliability request
+type GetUserReliabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReliabilityRequest) Reset() {
+	*x = GetUserReliabilityRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReliabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReliabilityRequest) ProtoMessage() {}
+
+func (x *GetUserReliabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReliabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReliabilityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetUserReliabilityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Booking outcomes for a customer
+type UserReliability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Bookings      int32                  `protobuf:"varint,2,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	Completed     int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Cancelled     int32                  `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	NoShows       int32                  `protobuf:"varint,5,opt,name=no_shows,json=noShows,proto3" json:"no_shows,omitempty"`
+	NoShowRate    float64                `protobuf:"fixed64,6,opt,name=no_show_rate,json=noShowRate,proto3" json:"no_show_rate,omitempty"` // Share of attended or missed bookings that were missed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserReliability) Reset() {
+	*x = UserReliability{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReliability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReliability) ProtoMessage() {}
+
+func (x *UserReliability) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReliability.ProtoReflect.Descriptor instead.
+func (*UserReliability) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UserReliability) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserReliability) GetBookings() int32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *UserReliability) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *UserReliability) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *UserReliability) GetNoShows() int32 {
+	if x != nil {
+		return x.NoShows
+	}
+	return 0
+}
+
+func (x *UserReliability) GetNoShowRate() float64 {
+	if x != nil {
+		return x.NoShowRate
+	}
+	return 0
+}
+
+// Get reliability report request
+type GetReliabilityReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupBy       ReliabilityGroup       `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=booking.ReliabilityGroup" json:"group_by,omitempty"`
+	StartDay      *CalendarDate          `protobuf:"bytes,2,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`           // In the shop's time zone
+	EndDay        *CalendarDate          `protobuf:"bytes,3,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`                 // Inclusive
+	BarberId      string                 `protobuf:"bytes,4,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`           // Optional, only this barber's bookings
+	MinBookings   int32                  `protobuf:"varint,5,opt,name=min_bookings,json=minBookings,proto3" json:"min_bookings,omitempty"` // Leave out rows with fewer bookings
+	SortBy        ReliabilitySort        `protobuf:"varint,6,opt,name=sort_by,json=sortBy,proto3,enum=booking.ReliabilitySort" json:"sort_by,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReliabilityReportRequest) Reset() {
+	*x = GetReliabilityReportRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReliabilityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReliabilityReportRequest) ProtoMessage() {}
+
+func (x *GetReliabilityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReliabilityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReliabilityReportRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetReliabilityReportRequest) GetGroupBy() ReliabilityGroup {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ReliabilityGroup_BY_USER
+}
+
+func (x *GetReliabilityReportRequest) GetStartDay() *CalendarDate {
+	if x != nil {
+		return x.StartDay
+	}
+	return nil
+}
+
+func (x *GetReliabilityReportRequest) GetEndDay() *CalendarDate {
+	if x != nil {
+		return x.EndDay
+	}
+	return nil
+}
+
+func (x *GetReliabilityReportRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetReliabilityReportRequest) GetMinBookings() int32 {
+	if x != nil {
+		return x.MinBookings
+	}
+	return 0
+}
+
+func (x *GetReliabilityReportRequest) GetSortBy() ReliabilitySort {
+	if x != nil {
+		return x.SortBy
+	}
+	return ReliabilitySort_NO_SHOW_RATE
+}
+
+func (x *GetReliabilityReportRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Booking outcomes for a customer or barber in a period
+type ReliabilityRow struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // The user or barber ID
+	Bookings         int32                  `protobuf:"varint,2,opt,name=bookings,proto3" json:"bookings,omitempty"`
+	Completed        int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	Cancelled        int32                  `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	NoShows          int32                  `protobuf:"varint,5,opt,name=no_shows,json=noShows,proto3" json:"no_shows,omitempty"`
+	NoShowRate       float64                `protobuf:"fixed64,6,opt,name=no_show_rate,json=noShowRate,proto3" json:"no_show_rate,omitempty"`                 // Share of attended or missed bookings that were missed
+	CancellationRate float64                `protobuf:"fixed64,7,opt,name=cancellation_rate,json=cancellationRate,proto3" json:"cancellation_rate,omitempty"` // Share of bookings that were cancelled
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReliabilityRow) Reset() {
+	*x = ReliabilityRow{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReliabilityRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReliabilityRow) ProtoMessage() {}
+
+func (x *ReliabilityRow) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReliabilityRow.ProtoReflect.Descriptor instead.
+func (*ReliabilityRow) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReliabilityRow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReliabilityRow) GetBookings() int32 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *ReliabilityRow) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *ReliabilityRow) GetCancelled() int32 {
+	if x != nil {
+		return x.Cancelled
+	}
+	return 0
+}
+
+func (x *ReliabilityRow) GetNoShows() int32 {
+	if x != nil {
+		return x.NoShows
+	}
+	return 0
+}
+
+func (x *ReliabilityRow) GetNoShowRate() float64 {
+	if x != nil {
+		return x.NoShowRate
+	}
+	return 0
+}
+
+func (x *ReliabilityRow) GetCancellationRate() float64 {
+	if x != nil {
+		return x.CancellationRate
+	}
+	return 0
+}
+
+// No-show and cancellation rates over a period
+type ReliabilityReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupBy       ReliabilityGroup       `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=booking.ReliabilityGroup" json:"group_by,omitempty"`
+	StartDay      *CalendarDate          `protobuf:"bytes,2,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndDay        *CalendarDate          `protobuf:"bytes,3,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	Rows          []*ReliabilityRow      `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReliabilityReport) Reset() {
+	*x = ReliabilityReport{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReliabilityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReliabilityReport) ProtoMessage() {}
+
+func (x *ReliabilityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReliabilityReport.ProtoReflect.Descriptor instead.
+func (*ReliabilityReport) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReliabilityReport) GetGroupBy() ReliabilityGroup {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ReliabilityGroup_BY_USER
+}
+
+func (x *ReliabilityReport) GetStartDay() *CalendarDate {
+	if x != nil {
+		return x.StartDay
+	}
+	return nil
+}
+
+func (x *ReliabilityReport) GetEndDay() *CalendarDate {
+	if x != nil {
+		return x.EndDay
+	}
+	return nil
+}
+
+func (x *ReliabilityReport) GetRows() []*ReliabilityRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// Get customer summary request
+type GetCustomerSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerSummaryRequest) Reset() {
+	*x = GetCustomerSummaryRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerSummaryRequest) ProtoMessage() {}
+
+func (x *GetCustomerSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetCustomerSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// A customer's visits, i.e. their completed bookings
+type CustomerSummary struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TotalVisits         int32                  `protobuf:"varint,2,opt,name=total_visits,json=totalVisits,proto3" json:"total_visits,omitempty"`
+	FirstVisit          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_visit,json=firstVisit,proto3" json:"first_visit,omitempty"` // Unset before the first visit
+	LastVisit           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_visit,json=lastVisit,proto3" json:"last_visit,omitempty"`
+	UsualService        ServiceType            `protobuf:"varint,5,opt,name=usual_service,json=usualService,proto3,enum=booking.ServiceType" json:"usual_service,omitempty"` // The first service they book most often
+	AverageIntervalDays float64                `protobuf:"fixed64,6,opt,name=average_interval_days,json=averageIntervalDays,proto3" json:"average_interval_days,omitempty"`  // Between visits, zero before the second
+	NextVisitDue        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_visit_due,json=nextVisitDue,proto3" json:"next_visit_due,omitempty"`                         // Last visit plus the average interval, unset before the second visit
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CustomerSummary) Reset() {
+	*x = CustomerSummary{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerSummary) ProtoMessage() {}
+
+func (x *CustomerSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerSummary.ProtoReflect.Descriptor instead.
+func (*CustomerSummary) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CustomerSummary) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CustomerSummary) GetTotalVisits() int32 {
+	if x != nil {
+		return x.TotalVisits
+	}
+	return 0
+}
+
+func (x *CustomerSummary) GetFirstVisit() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstVisit
+	}
+	return nil
+}
+
+func (x *CustomerSummary) GetLastVisit() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastVisit
+	}
+	return nil
+}
+
+func (x *CustomerSummary) GetUsualService() ServiceType {
+	if x != nil {
+		return x.UsualService
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *CustomerSummary) GetAverageIntervalDays() float64 {
+	if x != nil {
+		return x.AverageIntervalDays
+	}
+	return 0
+}
+
+func (x *CustomerSummary) GetNextVisitDue() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextVisitDue
+	}
+	return nil
+}
+
+// Confirm booking request
+type ConfirmBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingRequest) Reset() {
+	*x = ConfirmBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingRequest) ProtoMessage() {}
+
+func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ConfirmBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Complete booking request
+type CompleteBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteBookingRequest) Reset() {
+	*x = CompleteBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteBookingRequest) ProtoMessage() {}
+
+func (x *CompleteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteBookingRequest.ProtoReflect.Descriptor instead.
+func (*CompleteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CompleteBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// List bookings request; empty fields don't filter
+type ListBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Defaults to the caller for regular users
+	BarberId      string                 `protobuf:"bytes,2,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Statuses      []BookingStatus        `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=booking.BookingStatus" json:"statuses,omitempty"`
+	ServiceTypes  []ServiceType          `protobuf:"varint,4,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"`
+	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO format date string (inclusive)
+	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // ISO format date string (inclusive)
+	Timezone      string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`                    // IANA timezone for the dates, e.g. "Europe/Rome" (defaults to UTC)
+	SortBy        BookingSortField       `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=booking.BookingSortField" json:"sort_by,omitempty"`
+	Descending    bool                   `protobuf:"varint,9,opt,name=descending,proto3" json:"descending,omitempty"`
+	NeedsReview   bool                   `protobuf:"varint,10,opt,name=needs_review,json=needsReview,proto3" json:"needs_review,omitempty"` // Only confirmed bookings flagged for review; overrides statuses
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingsRequest) Reset() {
+	*x = ListBookingsRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingsRequest) ProtoMessage() {}
+
+func (x *ListBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListBookingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetStatuses() []BookingStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+func (x *ListBookingsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ListBookingsRequest) GetSortBy() BookingSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return BookingSortField_START_TIME
+}
+
+func (x *ListBookingsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListBookingsRequest) GetNeedsReview() bool {
+	if x != nil {
+		return x.NeedsReview
+	}
+	return false
+}
+
+// Watch bookings request; exactly one of user_id or barber_id is required
+type WatchBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BarberId      string                 `protobuf:"bytes,2,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchBookingsRequest) Reset() {
+	*x = WatchBookingsRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBookingsRequest) ProtoMessage() {}
+
+func (x *WatchBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBookingsRequest.ProtoReflect.Descriptor instead.
+func (*WatchBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *WatchBookingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WatchBookingsRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+// Change to a booking
+type BookingEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          BookingEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=booking.BookingEventType" json:"type,omitempty"`
+	Booking       *Booking               `protobuf:"bytes,2,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingEvent) Reset() {
+	*x = BookingEvent{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingEvent) ProtoMessage() {}
+
+func (x *BookingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingEvent.ProtoReflect.Descriptor instead.
+func (*BookingEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *BookingEvent) GetType() BookingEventType {
+	if x != nil {
+		return x.Type
+	}
+	return BookingEventType_BOOKING_CREATED
+}
+
+func (x *BookingEvent) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// Reschedule booking request
+type RescheduleBookingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`         // ISO format datetime string, use start_time_ts
+	StartTimeTs   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time_ts,json=startTimeTs,proto3" json:"start_time_ts,omitempty"` // Takes precedence over start_time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleBookingRequest) Reset() {
+	*x = RescheduleBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleBookingRequest) ProtoMessage() {}
+
+func (x *RescheduleBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleBookingRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RescheduleBookingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in pkg/api/proto/booking.proto.
+func (x *RescheduleBookingRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *RescheduleBookingRequest) GetStartTimeTs() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTimeTs
+	}
+	return nil
+}
+
+// Shift on one weekday
+type WorkingHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       Weekday                `protobuf:"varint,1,opt,name=weekday,proto3,enum=booking.Weekday" json:"weekday,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"` // Local time of day, "HH:MM"
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`     // Local time of day, "HH:MM" ("24:00" for midnight)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *WorkingHours) GetWeekday() Weekday {
+	if x != nil {
+		return x.Weekday
+	}
+	return Weekday_SUNDAY
+}
+
+func (x *WorkingHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// Recurring daily break
+type BreakPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // Local time of day, "HH:MM"
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // Local time of day, "HH:MM"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BreakPeriod) Reset() {
+	*x = BreakPeriod{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BreakPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakPeriod) ProtoMessage() {}
+
+func (x *BreakPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakPeriod.ProtoReflect.Descriptor instead.
+func (*BreakPeriod) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *BreakPeriod) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *BreakPeriod) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// Barber's weekly working hours; weekdays without hours are days off
+type BarberSchedule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BarberId       string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Hours          []*WorkingHours        `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours,omitempty"`
+	Breaks         []*BreakPeriod         `protobuf:"bytes,3,rep,name=breaks,proto3" json:"breaks,omitempty"`
+	Timezone       string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                                      // IANA timezone the hours and breaks are in, e.g. "Europe/Berlin"
+	SlotMinutes    int32                  `protobuf:"varint,5,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes,omitempty"`            // How far apart offered start times are
+	MinLeadMinutes int32                  `protobuf:"varint,6,opt,name=min_lead_minutes,json=minLeadMinutes,proto3" json:"min_lead_minutes,omitempty"` // Barber's minimum booking lead time (0 means the shop policy)
+	MaxAdvanceDays int32                  `protobuf:"varint,7,opt,name=max_advance_days,json=maxAdvanceDays,proto3" json:"max_advance_days,omitempty"` // Barber's advance-booking window (0 means the shop policy)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BarberSchedule) Reset() {
+	*x = BarberSchedule{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarberSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarberSchedule) ProtoMessage() {}
+
+func (x *BarberSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarberSchedule.ProtoReflect.Descriptor instead.
+func (*BarberSchedule) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BarberSchedule) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *BarberSchedule) GetHours() []*WorkingHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *BarberSchedule) GetBreaks() []*BreakPeriod {
+	if x != nil {
+		return x.Breaks
+	}
+	return nil
+}
+
+func (x *BarberSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *BarberSchedule) GetSlotMinutes() int32 {
+	if x != nil {
+		return x.SlotMinutes
+	}
+	return 0
+}
+
+func (x *BarberSchedule) GetMinLeadMinutes() int32 {
+	if x != nil {
+		return x.MinLeadMinutes
+	}
+	return 0
+}
+
+func (x *BarberSchedule) GetMaxAdvanceDays() int32 {
+	if x != nil {
+		return x.MaxAdvanceDays
+	}
+	return 0
+}
+
+// Get working hours request
+type GetWorkingHoursRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkingHoursRequest) Reset() {
+	*x = GetWorkingHoursRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkingHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkingHoursRequest) ProtoMessage() {}
+
+func (x *GetWorkingHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkingHoursRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkingHoursRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetWorkingHoursRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+// Set working hours request
+type SetWorkingHoursRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BarberId       string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Hours          []*WorkingHours        `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours,omitempty"`
+	Breaks         []*BreakPeriod         `protobuf:"bytes,3,rep,name=breaks,proto3" json:"breaks,omitempty"`                                          // Replaces any existing breaks
+	Timezone       string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                                      // IANA timezone the hours and breaks are in (defaults to the shop's)
+	SlotMinutes    int32                  `protobuf:"varint,5,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes,omitempty"`            // 15, 20, 30 or 60 (defaults to 30)
+	MinLeadMinutes int32                  `protobuf:"varint,6,opt,name=min_lead_minutes,json=minLeadMinutes,proto3" json:"min_lead_minutes,omitempty"` // How soon a booking may start (0 uses the shop policy)
+	MaxAdvanceDays int32                  `protobuf:"varint,7,opt,name=max_advance_days,json=maxAdvanceDays,proto3" json:"max_advance_days,omitempty"` // How many days ahead a booking may start (0 uses the shop policy)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetWorkingHoursRequest) Reset() {
+	*x = SetWorkingHoursRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWorkingHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWorkingHoursRequest) ProtoMessage() {}
+
+func (x *SetWorkingHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorkingHoursRequest.ProtoReflect.Descriptor instead.
+func (*SetWorkingHoursRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SetWorkingHoursRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *SetWorkingHoursRequest) GetHours() []*WorkingHours {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *SetWorkingHoursRequest) GetBreaks() []*BreakPeriod {
+	if x != nil {
+		return x.Breaks
+	}
+	return nil
+}
+
+func (x *SetWorkingHoursRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SetWorkingHoursRequest) GetSlotMinutes() int32 {
+	if x != nil {
+		return x.SlotMinutes
+	}
+	return 0
+}
+
+func (x *SetWorkingHoursRequest) GetMinLeadMinutes() int32 {
+	if x != nil {
+		return x.MinLeadMinutes
+	}
+	return 0
+}
+
+func (x *SetWorkingHoursRequest) GetMaxAdvanceDays() int32 {
+	if x != nil {
+		return x.MaxAdvanceDays
+	}
+	return 0
+}
+
+// Day the whole shop is closed
+type Holiday struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // ISO format date string
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // E.g. "Christmas Day"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Holiday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// List of holidays
+type HolidayList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*Holiday             `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HolidayList) Reset() {
+	*x = HolidayList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HolidayList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HolidayList) ProtoMessage() {}
+
+func (x *HolidayList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HolidayList.ProtoReflect.Descriptor instead.
+func (*HolidayList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *HolidayList) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+// List holidays request
+type ListHolidaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // ISO format date string (optional, inclusive)
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // ISO format date string (optional, inclusive)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHolidaysRequest) Reset() {
+	*x = ListHolidaysRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHolidaysRequest) ProtoMessage() {}
+
+func (x *ListHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*ListHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListHolidaysRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListHolidaysRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// Add holiday request
+type AddHolidayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // ISO format date string
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddHolidayRequest) Reset() {
+	*x = AddHolidayRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddHolidayRequest) ProtoMessage() {}
+
+func (x *AddHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddHolidayRequest.ProtoReflect.Descriptor instead.
+func (*AddHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AddHolidayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AddHolidayRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Remove holiday request
+type RemoveHolidayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // ISO format date string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHolidayRequest) Reset() {
+	*x = RemoveHolidayRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHolidayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHolidayRequest) ProtoMessage() {}
+
+func (x *RemoveHolidayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHolidayRequest.ProtoReflect.Descriptor instead.
+func (*RemoveHolidayRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RemoveHolidayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// Remove holiday response
+type RemoveHolidayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveHolidayResponse) Reset() {
+	*x = RemoveHolidayResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveHolidayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveHolidayResponse) ProtoMessage() {}
+
+func (x *RemoveHolidayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveHolidayResponse.ProtoReflect.Descriptor instead.
+func (*RemoveHolidayResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RemoveHolidayResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Get next available slot request
+type GetNextAvailableSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	ServiceType   *ServiceType           `protobuf:"varint,2,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType,oneof" json:"service_type,omitempty"`     // Only return slots with room for this service
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`                                                                   // How many slots to return (defaults to 1, at most 50)
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                              // IANA timezone to return slots in (defaults to the barber's)
+	ServiceTypes  []ServiceType          `protobuf:"varint,5,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"` // Only return slots with room for these services back to back
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextAvailableSlotRequest) Reset() {
+	*x = GetNextAvailableSlotRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextAvailableSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextAvailableSlotRequest) ProtoMessage() {}
+
+func (x *GetNextAvailableSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextAvailableSlotRequest.ProtoReflect.Descriptor instead.
+func (*GetNextAvailableSlotRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetNextAvailableSlotRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetNextAvailableSlotRequest) GetServiceType() ServiceType {
+	if x != nil && x.ServiceType != nil {
+		return *x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *GetNextAvailableSlotRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetNextAvailableSlotRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetNextAvailableSlotRequest) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+// Get available time slots for a date range request
+type GetAvailableTimeSlotsRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                                           // ISO format date string, first day of the range
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                                 // ISO format date string, last day of the range (inclusive, at most 31 days)
+	StartDay      *CalendarDate          `protobuf:"bytes,4,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`                                              // Structured alternative to start_date
+	EndDay        *CalendarDate          `protobuf:"bytes,5,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`                                                    // Structured alternative to end_date
+	Timezone      string                 `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                              // IANA timezone for day boundaries (defaults to the barber's)
+	ServiceType   *ServiceType           `protobuf:"varint,7,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType,oneof" json:"service_type,omitempty"`     // Only return slots with room for this service
+	ServiceTypes  []ServiceType          `protobuf:"varint,8,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"` // Only return slots with room for these services back to back
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) Reset() {
+	*x = GetAvailableTimeSlotsRangeRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailableTimeSlotsRangeRequest) ProtoMessage() {}
+
+func (x *GetAvailableTimeSlotsRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailableTimeSlotsRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableTimeSlotsRangeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) GetStartDay() *CalendarDate {
+	if x != nil {
+		return x.StartDay
+	}
+	return nil
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) GetEndDay() *CalendarDate {
+	if x != nil {
+		return x.EndDay
+	}
+	return nil
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) GetServiceType() ServiceType {
+	if x != nil && x.ServiceType != nil {
+		return *x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *GetAvailableTimeSlotsRangeRequest) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+// Available time slots on one day
+type DayTimeSlots struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           *CalendarDate          `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	TimeSlots     []*TimeSlot            `protobuf:"bytes,2,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayTimeSlots) Reset() {
+	*x = DayTimeSlots{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayTimeSlots) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayTimeSlots) ProtoMessage() {}
+
+func (x *DayTimeSlots) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayTimeSlots.ProtoReflect.Descriptor instead.
+func (*DayTimeSlots) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DayTimeSlots) GetDay() *CalendarDate {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *DayTimeSlots) GetTimeSlots() []*TimeSlot {
+	if x != nil {
+		return x.TimeSlots
+	}
+	return nil
+}
+
+// Available time slots grouped by day
+type DayTimeSlotsList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*DayTimeSlots        `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayTimeSlotsList) Reset() {
+	*x = DayTimeSlotsList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayTimeSlotsList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayTimeSlotsList) ProtoMessage() {}
+
+func (x *DayTimeSlotsList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayTimeSlotsList.ProtoReflect.Descriptor instead.
+func (*DayTimeSlotsList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DayTimeSlotsList) GetDays() []*DayTimeSlots {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// Service the shop offers
+type CatalogService struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceType     ServiceType            `protobuf:"varint,1,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`    // How long bookings for the service take
+	Price           int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                                               // In minor currency units (e.g. cents)
+	Active          bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`                                             // Inactive services can't be booked
+	CleanupMinutes  *int32                 `protobuf:"varint,6,opt,name=cleanup_minutes,json=cleanupMinutes,proto3,oneof" json:"cleanup_minutes,omitempty"` // Needed after the service; unset uses the built-in buffer
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CatalogService) Reset() {
+	*x = CatalogService{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogService) ProtoMessage() {}
+
+func (x *CatalogService) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogService.ProtoReflect.Descriptor instead.
+func (*CatalogService) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CatalogService) GetServiceType() ServiceType {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *CatalogService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CatalogService) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *CatalogService) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CatalogService) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *CatalogService) GetCleanupMinutes() int32 {
+	if x != nil && x.CleanupMinutes != nil {
+		return *x.CleanupMinutes
+	}
+	return 0
+}
+
+// List catalog services request
+type ListCatalogServicesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // Barbers and admins only
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCatalogServicesRequest) Reset() {
+	*x = ListCatalogServicesRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogServicesRequest) ProtoMessage() {}
+
+func (x *ListCatalogServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogServicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListCatalogServicesRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+// Catalog services list response
+type CatalogServiceList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*CatalogService      `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogServiceList) Reset() {
+	*x = CatalogServiceList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogServiceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogServiceList) ProtoMessage() {}
+
+func (x *CatalogServiceList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogServiceList.ProtoReflect.Descriptor instead.
+func (*CatalogServiceList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CatalogServiceList) GetServices() []*CatalogService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// Create catalog service request
+type CreateCatalogServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *CatalogService        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCatalogServiceRequest) Reset() {
+	*x = CreateCatalogServiceRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCatalogServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCatalogServiceRequest) ProtoMessage() {}
+
+func (x *CreateCatalogServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCatalogServiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateCatalogServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateCatalogServiceRequest) GetService() *CatalogService {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+// Update catalog service request
+type UpdateCatalogServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *CatalogService        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"` // Identified by its service_type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCatalogServiceRequest) Reset() {
+	*x = UpdateCatalogServiceRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCatalogServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCatalogServiceRequest) ProtoMessage() {}
+
+func (x *UpdateCatalogServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCatalogServiceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCatalogServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateCatalogServiceRequest) GetService() *CatalogService {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+// Delete catalog service request
+type DeleteCatalogServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceType   ServiceType            `protobuf:"varint,1,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCatalogServiceRequest) Reset() {
+	*x = DeleteCatalogServiceRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCatalogServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCatalogServiceRequest) ProtoMessage() {}
+
+func (x *DeleteCatalogServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCatalogServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCatalogServiceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DeleteCatalogServiceRequest) GetServiceType() ServiceType {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+// Delete catalog service response
+type DeleteCatalogServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCatalogServiceResponse) Reset() {
+	*x = DeleteCatalogServiceResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCatalogServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCatalogServiceResponse) ProtoMessage() {}
+
+func (x *DeleteCatalogServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCatalogServiceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCatalogServiceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeleteCatalogServiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// A code customers enter when booking for a discount: either a percentage or
+// a fixed amount off the price
+type PromoCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Stored upper case
+	PercentOff    int32                  `protobuf:"varint,2,opt,name=percent_off,json=percentOff,proto3" json:"percent_off,omitempty"`
+	AmountOff     int64                  `protobuf:"varint,3,opt,name=amount_off,json=amountOff,proto3" json:"amount_off,omitempty"`   // In minor currency units (e.g. cents)
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`    // Optional
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"` // Optional, exclusive
+	MaxUses       int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`         // Unlimited if zero
+	Uses          int32                  `protobuf:"varint,7,opt,name=uses,proto3" json:"uses,omitempty"`                              // Output only; bookings made with the code
+	Active        bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetPercentOff() int32 {
+	if x != nil {
+		return x.PercentOff
+	}
+	return 0
+}
+
+func (x *PromoCode) GetAmountOff() int64 {
+	if x != nil {
+		return x.AmountOff
+	}
+	return 0
+}
+
+func (x *PromoCode) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *PromoCode) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+func (x *PromoCode) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *PromoCode) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *PromoCode) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// List promo codes request
+type ListPromoCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesRequest) Reset() {
+	*x = ListPromoCodesRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesRequest) ProtoMessage() {}
+
+func (x *ListPromoCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{100}
+}
+
+// Promo codes list response
+type PromoCodeList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCodes    []*PromoCode           `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoCodeList) Reset() {
+	*x = PromoCodeList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCodeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCodeList) ProtoMessage() {}
+
+func (x *PromoCodeList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCodeList.ProtoReflect.Descriptor instead.
+func (*PromoCodeList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *PromoCodeList) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+// Get promo code request
+type GetPromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPromoCodeRequest) Reset() {
+	*x = GetPromoCodeRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPromoCodeRequest) ProtoMessage() {}
+
+func (x *GetPromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetPromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetPromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Create promo code request
+type CreatePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreatePromoCodeRequest) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+// Update promo code request
+type UpdatePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"` // Identified by its code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePromoCodeRequest) Reset() {
+	*x = UpdatePromoCodeRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePromoCodeRequest) ProtoMessage() {}
+
+func (x *UpdatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UpdatePromoCodeRequest) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+// Delete promo code request
+type DeletePromoCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromoCodeRequest) Reset() {
+	*x = DeletePromoCodeRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoCodeRequest) ProtoMessage() {}
+
+func (x *DeletePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*DeletePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeletePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Delete promo code response
+type DeletePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePromoCodeResponse) Reset() {
+	*x = DeletePromoCodeResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePromoCodeResponse) ProtoMessage() {}
+
+func (x *DeletePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*DeletePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DeletePromoCodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Shared equipment that some services need, e.g. a wash station. Bookings for
+// those services, with any barber, each take a unit of it.
+type Resource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // e.g. "wash-station"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`                                                             // How many units the shop has
+	ServiceTypes  []ServiceType          `protobuf:"varint,4,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"` // The services that need a unit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Resource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Resource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Resource) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Resource) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+// List resources request
+type ListResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResourcesRequest) Reset() {
+	*x = ListResourcesRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResourcesRequest) ProtoMessage() {}
+
+func (x *ListResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResourcesRequest.ProtoReflect.Descriptor instead.
+func (*ListResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{108}
+}
+
+// Resources list response
+type ResourceList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resources     []*Resource            `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceList) Reset() {
+	*x = ResourceList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceList) ProtoMessage() {}
+
+func (x *ResourceList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceList.ProtoReflect.Descriptor instead.
+func (*ResourceList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ResourceList) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// Create resource request
+type CreateResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateResourceRequest) Reset() {
+	*x = CreateResourceRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateResourceRequest) ProtoMessage() {}
+
+func (x *CreateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateResourceRequest.ProtoReflect.Descriptor instead.
+func (*CreateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CreateResourceRequest) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// Update resource request
+type UpdateResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Resource      *Resource              `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"` // Identified by its id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateResourceRequest) Reset() {
+	*x = UpdateResourceRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResourceRequest) ProtoMessage() {}
+
+func (x *UpdateResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResourceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateResourceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateResourceRequest) GetResource() *Resource {
+	if x != nil {
+		return x.Resource
+	}
+	return nil
+}
+
+// Delete resource request
+type DeleteResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceRequest) Reset() {
+	*x = DeleteResourceRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceRequest) ProtoMessage() {}
+
+func (x *DeleteResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResourceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DeleteResourceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete resource response
+type DeleteResourceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResourceResponse) Reset() {
+	*x = DeleteResourceResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResourceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResourceResponse) ProtoMessage() {}
+
+func (x *DeleteResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResourceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResourceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *DeleteResourceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// A service as a barber offers it
+type BarberService struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceType     ServiceType            `protobuf:"varint,1,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                               // Output only
+	DurationMinutes int32                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // 0 on input uses the catalog's duration
+	Price           int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`                                            // In minor currency units; 0 on input uses the catalog's price
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BarberService) Reset() {
+	*x = BarberService{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarberService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarberService) ProtoMessage() {}
+
+func (x *BarberService) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarberService.ProtoReflect.Descriptor instead.
+func (*BarberService) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *BarberService) GetServiceType() ServiceType {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ServiceType_HAIRCUT
+}
+
+func (x *BarberService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BarberService) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *BarberService) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Get barber services request
+type GetBarberServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBarberServicesRequest) Reset() {
+	*x = GetBarberServicesRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBarberServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBarberServicesRequest) ProtoMessage() {}
+
+func (x *GetBarberServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBarberServicesRequest.ProtoReflect.Descriptor instead.
+func (*GetBarberServicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetBarberServicesRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+// Barber services list response
+type BarberServiceList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Services      []*BarberService       `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BarberServiceList) Reset() {
+	*x = BarberServiceList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarberServiceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarberServiceList) ProtoMessage() {}
+
+func (x *BarberServiceList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarberServiceList.ProtoReflect.Descriptor instead.
+func (*BarberServiceList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *BarberServiceList) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *BarberServiceList) GetServices() []*BarberService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// Set barber services request
+type SetBarberServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"` // Defaults to the calling barber
+	Services      []*BarberService       `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"`                 // Empty to offer every catalog service
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBarberServicesRequest) Reset() {
+	*x = SetBarberServicesRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBarberServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBarberServicesRequest) ProtoMessage() {}
+
+func (x *SetBarberServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBarberServicesRequest.ProtoReflect.Descriptor instead.
+func (*SetBarberServicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SetBarberServicesRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *SetBarberServicesRequest) GetServices() []*BarberService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// A barber's profile
+type Barber struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // The barber's user ID
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Bio         string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	PhotoUrl    string                 `protobuf:"bytes,4,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	Active      bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`    // Inactive barbers can't be booked
+	Services    []*BarberService       `protobuf:"bytes,6,rep,name=services,proto3" json:"services,omitempty"` // Output only; set with SetBarberServices
+	// How many clients the barber takes at once, e.g. 2 for a pair of chairs
+	// with an assistant; 0 means 1. Only admins can change it.
+	Capacity      int32         `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Rating        *BarberRating `protobuf:"bytes,8,opt,name=rating,proto3" json:"rating,omitempty"` // Output only; unset when reviews aren't stored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Barber) Reset() {
+	*x = Barber{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Barber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Barber) ProtoMessage() {}
+
+func (x *Barber) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Barber.ProtoReflect.Descriptor instead.
+func (*Barber) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Barber) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Barber) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Barber) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Barber) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *Barber) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Barber) GetServices() []*BarberService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Barber) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Barber) GetRating() *BarberRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+// List barbers request
+type ListBarbersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"` // Barbers and admins only
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListBarbersRequest) Reset() {
+	*x = ListBarbersRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBarbersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBarbersRequest) ProtoMessage() {}
+
+func (x *ListBarbersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBarbersRequest.ProtoReflect.Descriptor instead.
+func (*ListBarbersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListBarbersRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+// Barbers list response
+type BarberList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barbers       []*Barber              `protobuf:"bytes,1,rep,name=barbers,proto3" json:"barbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BarberList) Reset() {
+	*x = BarberList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BarberList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BarberList) ProtoMessage() {}
+
+func (x *BarberList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BarberList.ProtoReflect.Descriptor instead.
+func (*BarberList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *BarberList) GetBarbers() []*Barber {
+	if x != nil {
+		return x.Barbers
+	}
+	return nil
+}
+
+// Get barber request
+type GetBarberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBarberRequest) Reset() {
+	*x = GetBarberRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBarberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBarberRequest) ProtoMessage() {}
+
+func (x *GetBarberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBarberRequest.ProtoReflect.Descriptor instead.
+func (*GetBarberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetBarberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Create barber request
+type CreateBarberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barber        *Barber                `protobuf:"bytes,1,opt,name=barber,proto3" json:"barber,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBarberRequest) Reset() {
+	*x = CreateBarberRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBarberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBarberRequest) ProtoMessage() {}
+
+func (x *CreateBarberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBarberRequest.ProtoReflect.Descriptor instead.
+func (*CreateBarberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CreateBarberRequest) GetBarber() *Barber {
+	if x != nil {
+		return x.Barber
+	}
+	return nil
+}
+
+// Update barber request
+type UpdateBarberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barber        *Barber                `protobuf:"bytes,1,opt,name=barber,proto3" json:"barber,omitempty"` // Identified by its id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBarberRequest) Reset() {
+	*x = UpdateBarberRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBarberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBarberRequest) ProtoMessage() {}
+
+func (x *UpdateBarberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBarberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBarberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *UpdateBarberRequest) GetBarber() *Barber {
+	if x != nil {
+		return x.Barber
+	}
+	return nil
+}
+
+// Delete barber request
+type DeleteBarberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBarberRequest) Reset() {
+	*x = DeleteBarberRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBarberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBarberRequest) ProtoMessage() {}
+
+func (x *DeleteBarberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBarberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBarberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *DeleteBarberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Delete barber response
+type DeleteBarberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBarberResponse) Reset() {
+	*x = DeleteBarberResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBarberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBarberResponse) ProtoMessage() {}
+
+func (x *DeleteBarberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBarberResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBarberResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *DeleteBarberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Add favorite barber request
+type AddFavoriteBarberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteBarberRequest) Reset() {
+	*x = AddFavoriteBarberRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteBarberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteBarberRequest) ProtoMessage() {}
+
+func (x *AddFavoriteBarberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteBarberRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteBarberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *AddFavoriteBarberRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+// A barber a customer marked as a favorite
+type FavoriteBarber struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	Barber        *Barber                `protobuf:"bytes,3,opt,name=barber,proto3" json:"barber,omitempty"` // The barber's profile; unset for barbers without one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteBarber) Reset() {
+	*x = FavoriteBarber{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteBarber) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteBarber) ProtoMessage() {}
+
+func (x *FavoriteBarber) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteBarber.ProtoReflect.Descriptor instead.
+func (*FavoriteBarber) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *FavoriteBarber) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *FavoriteBarber) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *FavoriteBarber) GetBarber() *Barber {
+	if x != nil {
+		return x.Barber
+	}
+	return nil
+}
+
+// Remove favorite barber request
+type RemoveFavoriteBarberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteBarberRequest) Reset() {
+	*x = RemoveFavoriteBarberRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteBarberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteBarberRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteBarberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteBarberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteBarberRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *RemoveFavoriteBarberRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+// Remove favorite barber response
+type RemoveFavoriteBarberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       bool                   `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"` // False if the barber wasn't a favorite
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteBarberResponse) Reset() {
+	*x = RemoveFavoriteBarberResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteBarberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteBarberResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteBarberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteBarberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteBarberResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RemoveFavoriteBarberResponse) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+// List favorites request
+type ListFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{130}
+}
+
+// Favorite barbers response
+type FavoriteBarberList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Favorites     []*FavoriteBarber      `protobuf:"bytes,1,rep,name=favorites,proto3" json:"favorites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteBarberList) Reset() {
+	*x = FavoriteBarberList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteBarberList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteBarberList) ProtoMessage() {}
+
+func (x *FavoriteBarberList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteBarberList.ProtoReflect.Descriptor instead.
+func (*FavoriteBarberList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *FavoriteBarberList) GetFavorites() []*FavoriteBarber {
+	if x != nil {
+		return x.Favorites
+	}
+	return nil
+}
+
+// Get quote request
+type GetQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	ServiceTypes  []ServiceType          `protobuf:"varint,2,rep,packed,name=service_types,json=serviceTypes,proto3,enum=booking.ServiceType" json:"service_types,omitempty"` // Done back to back, in order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuoteRequest) Reset() {
+	*x = GetQuoteRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteRequest) ProtoMessage() {}
+
+func (x *GetQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetQuoteRequest) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetServiceTypes() []ServiceType {
+	if x != nil {
+		return x.ServiceTypes
+	}
+	return nil
+}
+
+// Price and duration of a prospective booking
+type Quote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BarberId        string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
+	Services        []*BarberService       `protobuf:"bytes,2,rep,name=services,proto3" json:"services,omitempty"` // Each service's own duration and price
+	DurationMinutes int32                  `protobuf:"varint,3,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	Price           int64                  `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"` // In minor currency units (e.g. cents)
+	Currency        string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *Quote) GetBarberId() string {
+	if x != nil {
+		return x.BarberId
+	}
+	return ""
+}
+
+func (x *Quote) GetServices() []*BarberService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Quote) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *Quote) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Quote) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Get booking history request
+type GetBookingHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingHistoryRequest) Reset() {
+	*x = GetBookingHistoryRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingHistoryRequest) ProtoMessage() {}
+
+func (x *GetBookingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetBookingHistoryRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetBookingICSRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartTime     string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // ISO format datetime string
-	EndTime       string                 `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // ISO format datetime string
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeSlot) Reset() {
-	*x = TimeSlot{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[0]
+func (x *GetBookingICSRequest) Reset() {
+	*x = GetBookingICSRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeSlot) String() string {
+func (x *GetBookingICSRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSlot) ProtoMessage() {}
+func (*GetBookingICSRequest) ProtoMessage() {}
 
-func (x *TimeSlot) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[0]
+func (x *GetBookingICSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,48 +9067,104 @@ func (x *TimeSlot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSlot.ProtoReflect.Descriptor instead.
-func (*TimeSlot) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetBookingICSRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingICSRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *TimeSlot) GetStartTime() string {
+func (x *GetBookingICSRequest) GetId() string {
 	if x != nil {
-		return x.StartTime
+		return x.Id
 	}
 	return ""
 }
 
-func (x *TimeSlot) GetEndTime() string {
+// An iCalendar (RFC 5545) file
+type BookingICS struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // text/calendar; charset=utf-8
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`                          // e.g. booking-<id>.ics
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingICS) Reset() {
+	*x = BookingICS{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingICS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingICS) ProtoMessage() {}
+
+func (x *BookingICS) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[136]
 	if x != nil {
-		return x.EndTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingICS.ProtoReflect.Descriptor instead.
+func (*BookingICS) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *BookingICS) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
 	return ""
 }
 
-// Available time slots response
-type TimeSlotList struct {
+func (x *BookingICS) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *BookingICS) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A booking field's value before and after a change, JSON encoded
+type FieldChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimeSlots     []*TimeSlot            `protobuf:"bytes,1,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before        string                 `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"` // Empty if the field wasn't set
+	After         string                 `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`   // Empty if the field was cleared
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeSlotList) Reset() {
-	*x = TimeSlotList{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[1]
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeSlotList) String() string {
+func (x *FieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeSlotList) ProtoMessage() {}
+func (*FieldChange) ProtoMessage() {}
 
-func (x *TimeSlotList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[1]
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -213,50 +9175,58 @@ func (x *TimeSlotList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeSlotList.ProtoReflect.Descriptor instead.
-func (*TimeSlotList) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *TimeSlotList) GetTimeSlots() []*TimeSlot {
+func (x *FieldChange) GetField() string {
 	if x != nil {
-		return x.TimeSlots
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-// Booking model
-type Booking struct {
+func (x *FieldChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *FieldChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+// One change to a booking
+type BookingHistoryEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BarberId      string                 `protobuf:"bytes,3,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
-	StartTime     string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // ISO format datetime string
-	EndTime       string                 `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // ISO format datetime string
-	ServiceType   ServiceType            `protobuf:"varint,6,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
-	Status        BookingStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=booking.BookingStatus" json:"status,omitempty"`
-	Notes         string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // ISO format datetime string
-	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // ISO format datetime string
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`                  // e.g. "created", "rescheduled", "cancelled"
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // "system" for changes made by jobs and webhooks
+	At            string                 `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	Changes       []*FieldChange         `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Booking) Reset() {
-	*x = Booking{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[2]
+func (x *BookingHistoryEntry) Reset() {
+	*x = BookingHistoryEntry{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Booking) String() string {
+func (x *BookingHistoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Booking) ProtoMessage() {}
+func (*BookingHistoryEntry) ProtoMessage() {}
 
-func (x *Booking) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[2]
+func (x *BookingHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,104 +9237,200 @@ func (x *Booking) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Booking.ProtoReflect.Descriptor instead.
-func (*Booking) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use BookingHistoryEntry.ProtoReflect.Descriptor instead.
+func (*BookingHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *Booking) GetId() string {
+func (x *BookingHistoryEntry) GetAction() string {
 	if x != nil {
-		return x.Id
+		return x.Action
 	}
 	return ""
 }
 
-func (x *Booking) GetUserId() string {
+func (x *BookingHistoryEntry) GetActorId() string {
 	if x != nil {
-		return x.UserId
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *Booking) GetBarberId() string {
+func (x *BookingHistoryEntry) GetAt() string {
 	if x != nil {
-		return x.BarberId
+		return x.At
 	}
 	return ""
 }
 
-func (x *Booking) GetStartTime() string {
+func (x *BookingHistoryEntry) GetChanges() []*FieldChange {
 	if x != nil {
-		return x.StartTime
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-func (x *Booking) GetEndTime() string {
+// Changes to a booking, oldest first
+type BookingHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Entries       []*BookingHistoryEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingHistory) Reset() {
+	*x = BookingHistory{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingHistory) ProtoMessage() {}
+
+func (x *BookingHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[139]
 	if x != nil {
-		return x.EndTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingHistory.ProtoReflect.Descriptor instead.
+func (*BookingHistory) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *BookingHistory) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
 	}
 	return ""
 }
 
-func (x *Booking) GetServiceType() ServiceType {
+func (x *BookingHistory) GetEntries() []*BookingHistoryEntry {
 	if x != nil {
-		return x.ServiceType
+		return x.Entries
 	}
-	return ServiceType_HAIRCUT
+	return nil
 }
 
-func (x *Booking) GetStatus() BookingStatus {
+// List audit log request
+type ListAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorId       string                 `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`                        // Full method name, e.g. "/booking.BookingService/CancelBooking"
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // RFC 3339, inclusive
+	EndTime       string                 `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // RFC 3339, exclusive
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                         // At most 500, the default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditLogRequest) Reset() {
+	*x = ListAuditLogRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditLogRequest) ProtoMessage() {}
+
+func (x *ListAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[140]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return BookingStatus_PENDING
+	return mi.MessageOf(x)
 }
 
-func (x *Booking) GetNotes() string {
+// Deprecated: Use ListAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ListAuditLogRequest) GetActorId() string {
 	if x != nil {
-		return x.Notes
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *Booking) GetCreatedAt() string {
+func (x *ListAuditLogRequest) GetMethod() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Method
 	}
 	return ""
 }
 
-func (x *Booking) GetUpdatedAt() string {
+func (x *ListAuditLogRequest) GetStartTime() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.StartTime
 	}
 	return ""
 }
 
-// List of bookings
-type BookingList struct {
+func (x *ListAuditLogRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ListAuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// An authenticated call that changed something
+type AuditEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	IsBarber      bool                   `protobuf:"varint,3,opt,name=is_barber,json=isBarber,proto3" json:"is_barber,omitempty"`
+	IsAdmin       bool                   `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Request       string                 `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"` // JSON summary, truncated to 1 KiB
+	Code          string                 `protobuf:"bytes,6,opt,name=code,proto3" json:"code,omitempty"`       // gRPC status code, e.g. "OK"
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,8,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	At            string                 `protobuf:"bytes,9,opt,name=at,proto3" json:"at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BookingList) Reset() {
-	*x = BookingList{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[3]
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookingList) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookingList) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *BookingList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[3]
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,45 +9441,97 @@ func (x *BookingList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookingList.ProtoReflect.Descriptor instead.
-func (*BookingList) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *BookingList) GetBookings() []*Booking {
+func (x *AuditEntry) GetMethod() string {
 	if x != nil {
-		return x.Bookings
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-// Create booking request
-type CreateBookingRequest struct {
+func (x *AuditEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetIsBarber() bool {
+	if x != nil {
+		return x.IsBarber
+	}
+	return false
+}
+
+func (x *AuditEntry) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *AuditEntry) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+// Audit entries, newest first
+type AuditLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BarberId      string                 `protobuf:"bytes,2,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
-	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // ISO format datetime string
-	ServiceType   ServiceType            `protobuf:"varint,4,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
-	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateBookingRequest) Reset() {
-	*x = CreateBookingRequest{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[4]
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingRequest) String() string {
+func (x *AuditLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingRequest) ProtoMessage() {}
+func (*AuditLog) ProtoMessage() {}
 
-func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[4]
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -424,69 +9542,86 @@ func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
-func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *AuditLog) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Delete booking request
+type DeleteBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBookingRequest) Reset() {
+	*x = DeleteBookingRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+func (x *DeleteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateBookingRequest) GetBarberId() string {
-	if x != nil {
-		return x.BarberId
-	}
-	return ""
-}
+func (*DeleteBookingRequest) ProtoMessage() {}
 
-func (x *CreateBookingRequest) GetStartTime() string {
+func (x *DeleteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[143]
 	if x != nil {
-		return x.StartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateBookingRequest) GetServiceType() ServiceType {
-	if x != nil {
-		return x.ServiceType
-	}
-	return ServiceType_HAIRCUT
+// Deprecated: Use DeleteBookingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *CreateBookingRequest) GetNotes() string {
+func (x *DeleteBookingRequest) GetId() string {
 	if x != nil {
-		return x.Notes
+		return x.Id
 	}
 	return ""
 }
 
-// Get booking request
-type GetBookingRequest struct {
+// Delete booking response
+type DeleteBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"` // False if the booking didn't exist
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookingRequest) Reset() {
-	*x = GetBookingRequest{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[5]
+func (x *DeleteBookingResponse) Reset() {
+	*x = DeleteBookingResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingRequest) String() string {
+func (x *DeleteBookingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingRequest) ProtoMessage() {}
+func (*DeleteBookingResponse) ProtoMessage() {}
 
-func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[5]
+func (x *DeleteBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,44 +9632,46 @@ func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
-func (*GetBookingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DeleteBookingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookingResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *GetBookingRequest) GetId() string {
+func (x *DeleteBookingResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Id
+		return x.Deleted
 	}
-	return ""
+	return false
 }
 
-// Update booking request
-type UpdateBookingRequest struct {
+// A URL booking events are POSTed to
+type Webhook struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	StartTime     string                 `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // ISO format datetime string
-	ServiceType   ServiceType            `protobuf:"varint,3,opt,name=service_type,json=serviceType,proto3,enum=booking.ServiceType" json:"service_type,omitempty"`
-	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // E.g. "booking.created"
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`                           // Key of the deliveries' HMAC signatures; only returned when created
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateBookingRequest) Reset() {
-	*x = UpdateBookingRequest{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[6]
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBookingRequest) String() string {
+func (x *Webhook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBookingRequest) ProtoMessage() {}
+func (*Webhook) ProtoMessage() {}
 
-func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[6]
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,62 +9682,79 @@ func (x *UpdateBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBookingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *UpdateBookingRequest) GetId() string {
+func (x *Webhook) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateBookingRequest) GetStartTime() string {
+func (x *Webhook) GetUrl() string {
 	if x != nil {
-		return x.StartTime
+		return x.Url
 	}
 	return ""
 }
 
-func (x *UpdateBookingRequest) GetServiceType() ServiceType {
+func (x *Webhook) GetEventTypes() []string {
 	if x != nil {
-		return x.ServiceType
+		return x.EventTypes
 	}
-	return ServiceType_HAIRCUT
+	return nil
 }
 
-func (x *UpdateBookingRequest) GetNotes() string {
+func (x *Webhook) GetSecret() string {
 	if x != nil {
-		return x.Notes
+		return x.Secret
 	}
 	return ""
 }
 
-// Cancel booking request
-type CancelBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *Webhook) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Create webhook request
+type CreateWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Events to send; booking.created, booking.updated, booking.rescheduled,
+	// booking.confirmed or booking.cancelled
+	EventTypes    []string `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingRequest) Reset() {
-	*x = CancelBookingRequest{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[7]
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingRequest) String() string {
+func (x *CreateWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingRequest) ProtoMessage() {}
+func (*CreateWebhookRequest) ProtoMessage() {}
 
-func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[7]
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,42 +9765,47 @@ func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
-func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *CancelBookingRequest) GetId() string {
+func (x *CreateWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Id
+		return x.Url
 	}
 	return ""
 }
 
-// Cancel booking response
-type CancelBookingResponse struct {
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+// List webhooks request
+type ListWebhooksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingResponse) Reset() {
-	*x = CancelBookingResponse{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[8]
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingResponse) String() string {
+func (x *ListWebhooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingResponse) ProtoMessage() {}
+func (*ListWebhooksRequest) ProtoMessage() {}
 
-func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[8]
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,48 +9816,34 @@ func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
-func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *CancelBookingResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *CancelBookingResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{147}
 }
 
-// Get user bookings request
-type GetUserBookingsRequest struct {
+// Registered webhooks, oldest first
+type WebhookList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserBookingsRequest) Reset() {
-	*x = GetUserBookingsRequest{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[9]
+func (x *WebhookList) Reset() {
+	*x = WebhookList{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserBookingsRequest) String() string {
+func (x *WebhookList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserBookingsRequest) ProtoMessage() {}
+func (*WebhookList) ProtoMessage() {}
 
-func (x *GetUserBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[9]
+func (x *WebhookList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,42 +9854,41 @@ func (x *GetUserBookingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserBookingsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use WebhookList.ProtoReflect.Descriptor instead.
+func (*WebhookList) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *GetUserBookingsRequest) GetUserId() string {
+func (x *WebhookList) GetWebhooks() []*Webhook {
 	if x != nil {
-		return x.UserId
+		return x.Webhooks
 	}
-	return ""
+	return nil
 }
 
-// Get barber bookings request
-type GetBarberBookingsRequest struct {
+// Delete webhook request
+type DeleteWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // ISO format date string (optional)
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBarberBookingsRequest) Reset() {
-	*x = GetBarberBookingsRequest{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[10]
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBarberBookingsRequest) String() string {
+func (x *DeleteWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBarberBookingsRequest) ProtoMessage() {}
+func (*DeleteWebhookRequest) ProtoMessage() {}
 
-func (x *GetBarberBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[10]
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,49 +9899,41 @@ func (x *GetBarberBookingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBarberBookingsRequest.ProtoReflect.Descriptor instead.
-func (*GetBarberBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetBarberBookingsRequest) GetBarberId() string {
-	if x != nil {
-		return x.BarberId
-	}
-	return ""
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *GetBarberBookingsRequest) GetDate() string {
+func (x *DeleteWebhookRequest) GetId() string {
 	if x != nil {
-		return x.Date
+		return x.Id
 	}
 	return ""
 }
 
-// Get available time slots request
-type GetAvailableTimeSlotsRequest struct {
+// Delete webhook response
+type DeleteWebhookResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BarberId      string                 `protobuf:"bytes,1,opt,name=barber_id,json=barberId,proto3" json:"barber_id,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // ISO format date string
+	Deleted       bool                   `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"` // False if the webhook didn't exist
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailableTimeSlotsRequest) Reset() {
-	*x = GetAvailableTimeSlotsRequest{}
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[11]
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableTimeSlotsRequest) String() string {
+func (x *DeleteWebhookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableTimeSlotsRequest) ProtoMessage() {}
+func (*DeleteWebhookResponse) ProtoMessage() {}
 
-func (x *GetAvailableTimeSlotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_proto_booking_proto_msgTypes[11]
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_proto_booking_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,102 +9944,857 @@ func (x *GetAvailableTimeSlotsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableTimeSlotsRequest.ProtoReflect.Descriptor instead.
-func (*GetAvailableTimeSlotsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *GetAvailableTimeSlotsRequest) GetBarberId() string {
-	if x != nil {
-		return x.BarberId
-	}
-	return ""
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_proto_booking_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *GetAvailableTimeSlotsRequest) GetDate() string {
+func (x *DeleteWebhookResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Date
+		return x.Deleted
 	}
-	return ""
+	return false
 }
 
 var File_pkg_api_proto_booking_proto protoreflect.FileDescriptor
 
 const file_pkg_api_proto_booking_proto_rawDesc = "" +
 	"\n" +
-	"\x1bpkg/api/proto/booking.proto\x12\abooking\"D\n" +
-	"\bTimeSlot\x12\x1d\n" +
+	"\x1bpkg/api/proto/booking.proto\x12\abooking\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xc8\x01\n" +
+	"\bTimeSlot\x12!\n" +
 	"\n" +
-	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x02 \x01(\tR\aendTime\"@\n" +
+	"start_time\x18\x01 \x01(\tB\x02\x18\x01R\tstartTime\x12\x1d\n" +
+	"\bend_time\x18\x02 \x01(\tB\x02\x18\x01R\aendTime\x12>\n" +
+	"\rstart_time_ts\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vstartTimeTs\x12:\n" +
+	"\vend_time_ts\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tendTimeTs\"@\n" +
 	"\fTimeSlotList\x120\n" +
 	"\n" +
-	"time_slots\x18\x01 \x03(\v2\x11.booking.TimeSlotR\ttimeSlots\"\xc6\x02\n" +
+	"time_slots\x18\x01 \x03(\v2\x11.booking.TimeSlotR\ttimeSlots\"\x7f\n" +
+	"\x15SlotUnavailableDetail\x12/\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x11.booking.TimeSlotR\tconflicts\x125\n" +
+	"\falternatives\x18\x02 \x03(\v2\x11.booking.TimeSlotR\falternatives\"\xe1\v\n" +
 	"\aBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tbarber_id\x18\x03 \x01(\tR\bbarberId\x12\x1d\n" +
+	"\tbarber_id\x18\x03 \x01(\tR\bbarberId\x12!\n" +
 	"\n" +
-	"start_time\x18\x04 \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x05 \x01(\tR\aendTime\x127\n" +
+	"start_time\x18\x04 \x01(\tB\x02\x18\x01R\tstartTime\x12\x1d\n" +
+	"\bend_time\x18\x05 \x01(\tB\x02\x18\x01R\aendTime\x127\n" +
 	"\fservice_type\x18\x06 \x01(\x0e2\x14.booking.ServiceTypeR\vserviceType\x12.\n" +
 	"\x06status\x18\a \x01(\x0e2\x16.booking.BookingStatusR\x06status\x12\x14\n" +
-	"\x05notes\x18\b \x01(\tR\x05notes\x12\x1d\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x12!\n" +
 	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"created_at\x18\t \x01(\tB\x02\x18\x01R\tcreatedAt\x12!\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\";\n" +
+	" \x01(\tB\x02\x18\x01R\tupdatedAt\x12!\n" +
+	"\fexternal_ref\x18\v \x01(\tR\vexternalRef\x12*\n" +
+	"\apayment\x18\f \x01(\v2\x10.booking.PaymentR\apayment\x125\n" +
+	"\vattachments\x18\r \x03(\v2\x13.booking.AttachmentR\vattachments\x12&\n" +
+	"\rchecked_in_at\x18\x0e \x01(\tB\x02\x18\x01R\vcheckedInAt\x12#\n" +
+	"\vreleased_at\x18\x0f \x01(\tB\x02\x18\x01R\n" +
+	"releasedAt\x12-\n" +
+	"\x10rescheduled_from\x18\x10 \x01(\tB\x02\x18\x01R\x0frescheduledFrom\x12\x18\n" +
+	"\aversion\x18\x11 \x01(\x03R\aversion\x12>\n" +
+	"\rstart_time_ts\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vstartTimeTs\x12:\n" +
+	"\vend_time_ts\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tendTimeTs\x12>\n" +
+	"\rcreated_at_ts\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\x12>\n" +
+	"\rupdated_at_ts\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedAtTs\x12C\n" +
+	"\x10checked_in_at_ts\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\rcheckedInAtTs\x12@\n" +
+	"\x0ereleased_at_ts\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\freleasedAtTs\x12J\n" +
+	"\x13rescheduled_from_ts\x18\x18 \x01(\v2\x1a.google.protobuf.TimestampR\x11rescheduledFromTs\x129\n" +
+	"\rservice_types\x18\x19 \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypes\x12\x14\n" +
+	"\x05price\x18\x1a \x01(\x03R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\x1b \x01(\tR\bcurrency\x12*\n" +
+	"\adeposit\x18\x1c \x01(\v2\x10.booking.DepositR\adeposit\x129\n" +
+	"\fcancellation\x18\x1d \x01(\v2\x15.booking.CancellationR\fcancellation\x12K\n" +
+	"\x14review_flagged_at_ts\x18\x1e \x01(\v2\x1a.google.protobuf.TimestampR\x11reviewFlaggedAtTs\x12B\n" +
+	"\x0fhold_expires_at\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampR\rholdExpiresAt\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18  \x01(\tR\tpromoCode\x12\x1a\n" +
+	"\bdiscount\x18! \x01(\x03R\bdiscount\"\xb6\x01\n" +
+	"\fCancellation\x12=\n" +
+	"\fcancelled_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12!\n" +
+	"\fcancelled_by\x18\x02 \x01(\tR\vcancelledBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x10\n" +
+	"\x03fee\x18\x04 \x01(\x03R\x03fee\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\xea\x01\n" +
+	"\aDeposit\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12#\n" +
+	"\rclient_secret\x18\x04 \x01(\tR\fclientSecret\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x123\n" +
+	"\apaid_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\"\xd3\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\x12!\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tB\x02\x18\x01R\tcreatedAt\x12>\n" +
+	"\rcreated_at_ts\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTs\"\x8f\x02\n" +
+	"\aPayment\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x10\n" +
+	"\x03tip\x18\x02 \x01(\x03R\x03tip\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12A\n" +
+	"\x11rendered_services\x18\x04 \x03(\x0e2\x14.booking.ServiceTypeR\x10renderedServices\x12$\n" +
+	"\rdiscrepancies\x18\x05 \x03(\tR\rdiscrepancies\x12\x1b\n" +
+	"\apaid_at\x18\x06 \x01(\tB\x02\x18\x01R\x06paidAt\x128\n" +
+	"\n" +
+	"paid_at_ts\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bpaidAtTs\";\n" +
 	"\vBookingList\x12,\n" +
-	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\"\xba\x01\n" +
+	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\"\xd0\x03\n" +
 	"\x14CreateBookingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
-	"\tbarber_id\x18\x02 \x01(\tR\bbarberId\x12\x1d\n" +
+	"\tbarber_id\x18\x02 \x01(\tR\bbarberId\x12!\n" +
 	"\n" +
-	"start_time\x18\x03 \x01(\tR\tstartTime\x127\n" +
+	"start_time\x18\x03 \x01(\tB\x02\x18\x01R\tstartTime\x127\n" +
 	"\fservice_type\x18\x04 \x01(\x0e2\x14.booking.ServiceTypeR\vserviceType\x12\x14\n" +
-	"\x05notes\x18\x05 \x01(\tR\x05notes\"#\n" +
-	"\x11GetBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x94\x01\n" +
-	"\x14UpdateBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x12!\n" +
+	"\fexternal_ref\x18\x06 \x01(\tR\vexternalRef\x121\n" +
+	"\x0fidempotency_key\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x0eidempotencyKey\x12>\n" +
+	"\rstart_time_ts\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vstartTimeTs\x129\n" +
+	"\rservice_types\x18\t \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypes\x12\x17\n" +
+	"\ahold_id\x18\n" +
+	" \x01(\tR\x06holdId\x12&\n" +
+	"\n" +
+	"promo_code\x18\v \x01(\tB\a\xfaB\x04r\x02\x18 R\tpromoCode\"\x8c\x02\n" +
+	"\x13HoldTimeSlotRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\tbarber_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\x12C\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\tstartTime\x12C\n" +
+	"\rservice_types\x18\x04 \x03(\x0e2\x14.booking.ServiceTypeB\b\xfaB\x05\x92\x01\x02\b\x01R\fserviceTypes\x12,\n" +
+	"\fhold_minutes\x18\x05 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x1e(\x01R\vholdMinutes\"h\n" +
+	"\x11RebookLastRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x121\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x0eidempotencyKey\",\n" +
+	"\x11GetBookingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"\x84\x03\n" +
+	"\x14UpdateBookingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12!\n" +
 	"\n" +
-	"start_time\x18\x02 \x01(\tR\tstartTime\x127\n" +
+	"start_time\x18\x02 \x01(\tB\x02\x18\x01R\tstartTime\x127\n" +
 	"\fservice_type\x18\x03 \x01(\x0e2\x14.booking.ServiceTypeR\vserviceType\x12\x14\n" +
-	"\x05notes\x18\x04 \x01(\tR\x05notes\"&\n" +
-	"\x14CancelBookingRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"K\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12\x1d\n" +
+	"\aversion\x18\x05 \x01(\x03H\x00R\aversion\x88\x01\x01\x12>\n" +
+	"\rstart_time_ts\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vstartTimeTs\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x129\n" +
+	"\rservice_types\x18\b \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypesB\n" +
+	"\n" +
+	"\b_version\"Q\n" +
+	"\x14CancelBookingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x06reason\"\xb8\x01\n" +
 	"\x15CancelBookingResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"1\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\x03R\x03fee\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12=\n" +
+	"\fcancelled_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\"1\n" +
 	"\x16GetUserBookingsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"K\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"`\n" +
+	"\fCalendarDate\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x1f\n" +
+	"\x05month\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x01R\x05month\x12\x1b\n" +
+	"\x03day\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x1f(\x01R\x03day\"\xdf\x01\n" +
 	"\x18GetBarberBookingsRequest\x12\x1b\n" +
-	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12\x12\n" +
-	"\x04date\x18\x02 \x01(\tR\x04date\"O\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12a\n" +
+	"\x04date\x18\x02 \x01(\tBM\xfaBJrH2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$\xd0\x01\x01R\x04date\x12'\n" +
+	"\x03day\x18\x03 \x01(\v2\x15.booking.CalendarDateR\x03day\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"L\n" +
+	"\x1eGetBookingByExternalRefRequest\x12*\n" +
+	"\fexternal_ref\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vexternalRef\"\xed\x02\n" +
 	"\x1cGetAvailableTimeSlotsRequest\x12\x1b\n" +
-	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12\x12\n" +
-	"\x04date\x18\x02 \x01(\tR\x04date*I\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12a\n" +
+	"\x04date\x18\x02 \x01(\tBM\xfaBJrH2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$\xd0\x01\x01R\x04date\x12'\n" +
+	"\x03day\x18\x03 \x01(\v2\x15.booking.CalendarDateR\x03day\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12<\n" +
+	"\fservice_type\x18\x05 \x01(\x0e2\x14.booking.ServiceTypeH\x00R\vserviceType\x88\x01\x01\x129\n" +
+	"\rservice_types\x18\x06 \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypesB\x0f\n" +
+	"\r_service_type\"\xda\x02\n" +
+	"\x1aRecordPOSCompletionRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12!\n" +
+	"\fexternal_ref\x18\x02 \x01(\tR\vexternalRef\x12\x1f\n" +
+	"\x06amount\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06amount\x12\x19\n" +
+	"\x03tip\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x03tip\x12$\n" +
+	"\bcurrency\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x98\x01\x03R\bcurrency\x12A\n" +
+	"\x11rendered_services\x18\x06 \x03(\x0e2\x14.booking.ServiceTypeR\x10renderedServices\x12\x1b\n" +
+	"\apaid_at\x18\a \x01(\tB\x02\x18\x01R\x06paidAt\x128\n" +
+	"\n" +
+	"paid_at_ts\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bpaidAtTs\"\x91\x01\n" +
+	"\x14ExportPayrollRequest\x12\x1f\n" +
+	"\x04year\x18\x01 \x01(\x05B\v\xfaB\b\x1a\x06\x18\x8fN(\xd0\x0fR\x04year\x12\x1f\n" +
+	"\x05month\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x01R\x05month\x127\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x15.booking.ExportFormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06format\"\x99\x01\n" +
+	"\x1cFinalizePayrollPeriodRequest\x12\x1f\n" +
+	"\x04year\x18\x01 \x01(\x05B\v\xfaB\b\x1a\x06\x18\x8fN(\xd0\x0fR\x04year\x12\x1f\n" +
+	"\x05month\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\f(\x01R\x05month\x127\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x15.booking.ExportFormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06format\"\x9e\x01\n" +
+	"\rPayrollExport\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x1c\n" +
+	"\tfinalized\x18\x02 \x01(\bR\tfinalized\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\fR\acontent\"\x99\x01\n" +
+	"\x1bAddBookingAttachmentRequest\x12&\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tbookingId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03R\tsizeBytes\"r\n" +
+	"\x1cAddBookingAttachmentResponse\x123\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x13.booking.AttachmentR\n" +
+	"attachment\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x02 \x01(\tR\tuploadUrl\"\xa9\x01\n" +
+	"\x1bSubmitSurveyResponseRequest\x12&\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tbookingId\x12\x1d\n" +
+	"\x05token\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\x12\x1f\n" +
+	"\x05score\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x01R\x05score\x12\"\n" +
+	"\acomment\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\acomment\"R\n" +
+	"\x1cSubmitSurveyResponseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xb3\x01\n" +
+	"\x1cGetBarberSurveyScoresRequest\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12<\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tB\x1d\xfaB\x1ar\x182\x13^\\d{4}-\\d{2}-\\d{2}$\xd0\x01\x01R\tstartDate\x128\n" +
+	"\bend_date\x18\x03 \x01(\tB\x1d\xfaB\x1ar\x182\x13^\\d{4}-\\d{2}-\\d{2}$\xd0\x01\x01R\aendDate\"\x91\x01\n" +
+	"\fSurveyScores\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12\x1c\n" +
+	"\tresponses\x18\x02 \x01(\x05R\tresponses\x12#\n" +
+	"\raverage_score\x18\x03 \x01(\x01R\faverageScore\x12!\n" +
+	"\fscore_counts\x18\x04 \x03(\x05R\vscoreCounts\"\x84\x01\n" +
+	"\x13SubmitReviewRequest\x12&\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tbookingId\x12!\n" +
+	"\x06rating\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x05(\x01R\x06rating\x12\"\n" +
+	"\acomment\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\acomment\"\xda\x01\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tbarber_id\x18\x04 \x01(\tR\bbarberId\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x95\x01\n" +
+	"\x18ListBarberReviewsRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\x122\n" +
+	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x05limit\"t\n" +
+	"\n" +
+	"ReviewList\x12)\n" +
+	"\areviews\x18\x01 \x03(\v2\x0f.booking.ReviewR\areviews\x12;\n" +
+	"\vnext_before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"nextBefore\">\n" +
+	"\x16GetBarberRatingRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\"l\n" +
+	"\fBarberRating\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12\x18\n" +
+	"\areviews\x18\x02 \x01(\x05R\areviews\x12%\n" +
+	"\x0eaverage_rating\x18\x03 \x01(\x01R\raverageRating\"\x9e\x01\n" +
+	"\x15GetBarberStatsRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\x126\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x14.booking.StatsPeriodB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06period\x12'\n" +
+	"\x03day\x18\x03 \x01(\v2\x15.booking.CalendarDateR\x03day\"S\n" +
+	"\vStatusCount\x12.\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x16.booking.BookingStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"r\n" +
+	"\bDayStats\x12'\n" +
+	"\x03day\x18\x01 \x01(\v2\x15.booking.CalendarDateR\x03day\x12\x1a\n" +
+	"\bbookings\x18\x02 \x01(\x05R\bbookings\x12!\n" +
+	"\fbooked_hours\x18\x03 \x01(\x01R\vbookedHours\"\x85\x03\n" +
+	"\vBarberStats\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x122\n" +
+	"\tstart_day\x18\x02 \x01(\v2\x15.booking.CalendarDateR\bstartDay\x12.\n" +
+	"\aend_day\x18\x03 \x01(\v2\x15.booking.CalendarDateR\x06endDay\x129\n" +
+	"\rstatus_counts\x18\x04 \x03(\v2\x14.booking.StatusCountR\fstatusCounts\x12\x1a\n" +
+	"\bbookings\x18\x05 \x01(\x05R\bbookings\x12!\n" +
+	"\fbooked_hours\x18\x06 \x01(\x01R\vbookedHours\x12#\n" +
+	"\rworking_hours\x18\a \x01(\x01R\fworkingHours\x12 \n" +
+	"\vutilization\x18\b \x01(\x01R\vutilization\x124\n" +
+	"\fbusiest_days\x18\t \x03(\v2\x11.booking.DayStatsR\vbusiestDays\"W\n" +
+	"\x17GetDemandHeatmapRequest\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12\x1f\n" +
+	"\x05weeks\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x184(\x00R\x05weeks\"\x90\x01\n" +
+	"\vHeatmapCell\x12*\n" +
+	"\aweekday\x18\x01 \x01(\x0e2\x10.booking.WeekdayR\aweekday\x12\x12\n" +
+	"\x04hour\x18\x02 \x01(\x05R\x04hour\x12\x1a\n" +
+	"\bbookings\x18\x03 \x01(\x05R\bbookings\x12%\n" +
+	"\x0eweekly_average\x18\x04 \x01(\x01R\rweeklyAverage\"\xad\x02\n" +
+	"\rDemandHeatmap\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x122\n" +
+	"\tstart_day\x18\x02 \x01(\v2\x15.booking.CalendarDateR\bstartDay\x12.\n" +
+	"\aend_day\x18\x03 \x01(\v2\x15.booking.CalendarDateR\x06endDay\x12\x14\n" +
+	"\x05weeks\x18\x04 \x01(\x05R\x05weeks\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12*\n" +
+	"\x05cells\x18\x06 \x03(\v2\x14.booking.HeatmapCellR\x05cells\x12=\n" +
+	"\fgenerated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"f\n" +
+	"\x15GetDailyAgendaRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\x12'\n" +
+	"\x03day\x18\x02 \x01(\v2\x15.booking.CalendarDateR\x03day\"k\n" +
+	"\tAgendaGap\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\xc5\x01\n" +
+	"\vDailyAgenda\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12'\n" +
+	"\x03day\x18\x02 \x01(\v2\x15.booking.CalendarDateR\x03day\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12,\n" +
+	"\bbookings\x18\x04 \x03(\v2\x10.booking.BookingR\bbookings\x12&\n" +
+	"\x04gaps\x18\x05 \x03(\v2\x12.booking.AgendaGapR\x04gaps\"\xf6\x01\n" +
+	"\x17GetRevenueReportRequest\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12<\n" +
+	"\tstart_day\x18\x02 \x01(\v2\x15.booking.CalendarDateB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bstartDay\x128\n" +
+	"\aend_day\x18\x03 \x01(\v2\x15.booking.CalendarDateB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06endDay\x12F\n" +
+	"\vgranularity\x18\x04 \x01(\x0e2\x1a.booking.ReportGranularityB\b\xfaB\x05\x82\x01\x02\x10\x01R\vgranularity\"\xe7\x01\n" +
+	"\vRevenueLine\x128\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x15.booking.CalendarDateR\vperiodStart\x12\x1b\n" +
+	"\tbarber_id\x18\x02 \x01(\tR\bbarberId\x127\n" +
+	"\fservice_type\x18\x03 \x01(\x0e2\x14.booking.ServiceTypeR\vserviceType\x12\x1a\n" +
+	"\bbookings\x18\x04 \x01(\x05R\bbookings\x12\x18\n" +
+	"\arevenue\x18\x05 \x01(\x03R\arevenue\x12\x12\n" +
+	"\x04tips\x18\x06 \x01(\x03R\x04tips\"\xc3\x02\n" +
+	"\rRevenueReport\x122\n" +
+	"\tstart_day\x18\x01 \x01(\v2\x15.booking.CalendarDateR\bstartDay\x12.\n" +
+	"\aend_day\x18\x02 \x01(\v2\x15.booking.CalendarDateR\x06endDay\x12<\n" +
+	"\vgranularity\x18\x03 \x01(\x0e2\x1a.booking.ReportGranularityR\vgranularity\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12*\n" +
+	"\x05lines\x18\x05 \x03(\v2\x14.booking.RevenueLineR\x05lines\x12\x1a\n" +
+	"\bbookings\x18\x06 \x01(\x05R\bbookings\x12\x18\n" +
+	"\arevenue\x18\a \x01(\x03R\arevenue\x12\x12\n" +
+	"\x04tips\x18\b \x01(\x03R\x04tips\"h\n" +
+	"\rRevenueExport\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\x1b\n" +
+	"\x19GetRetentionPolicyRequest\"\xfa\x01\n" +
+	"\x0fRetentionPolicy\x12.\n" +
+	"\x0ecompleted_days\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rcompletedDays\x12.\n" +
+	"\x0ecancelled_days\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rcancelledDays\x12)\n" +
+	"\fno_show_days\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"noShowDays\x124\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x16.booking.RetentionModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04mode\x12&\n" +
+	"\n" +
+	"audit_days\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\tauditDays\"Z\n" +
+	"\x1cUpdateRetentionPolicyRequest\x12:\n" +
+	"\x06policy\x18\x01 \x01(\v2\x18.booking.RetentionPolicyB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06policy\"9\n" +
+	"\x15ExportUserDataRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"i\n" +
+	"\x0eUserDataExport\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"n\n" +
+	"\x14EraseUserDataRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\x124\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x16.booking.RetentionModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04mode\"\x9e\x02\n" +
+	"\x15EraseUserDataResponse\x12/\n" +
+	"\x13bookings_anonymized\x18\x01 \x01(\x03R\x12bookingsAnonymized\x12)\n" +
+	"\x10bookings_deleted\x18\x02 \x01(\x03R\x0fbookingsDeleted\x12%\n" +
+	"\x0esurveys_erased\x18\x03 \x01(\x03R\rsurveysErased\x120\n" +
+	"\x14audit_entries_erased\x18\x04 \x01(\x03R\x12auditEntriesErased\x12%\n" +
+	"\x0ereviews_erased\x18\x05 \x01(\x03R\rreviewsErased\x12)\n" +
+	"\x10favorites_erased\x18\x06 \x01(\x03R\x0ffavoritesErased\"\x1e\n" +
+	"\x1cGetCancellationPolicyRequest\"\x8c\x01\n" +
+	"\x10CancellationRule\x12.\n" +
+	"\x0ewithin_minutes\x18\x01 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\rwithinMinutes\x12*\n" +
+	"\vfee_percent\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\n" +
+	"feePercent\x12\x1c\n" +
+	"\tforbidden\x18\x03 \x01(\bR\tforbidden\"E\n" +
+	"\x12CancellationPolicy\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.booking.CancellationRuleR\x05rules\"`\n" +
+	"\x1fUpdateCancellationPolicyRequest\x12=\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1b.booking.CancellationPolicyB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06policy\"0\n" +
+	"\x15CheckInBookingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\",\n" +
+	"\x11MarkNoShowRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"=\n" +
+	"\x19GetUserReliabilityRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"\xbf\x01\n" +
+	"\x0fUserReliability\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bbookings\x18\x02 \x01(\x05R\bbookings\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\x12\x1c\n" +
+	"\tcancelled\x18\x04 \x01(\x05R\tcancelled\x12\x19\n" +
+	"\bno_shows\x18\x05 \x01(\x05R\anoShows\x12 \n" +
+	"\fno_show_rate\x18\x06 \x01(\x01R\n" +
+	"noShowRate\"\xfd\x02\n" +
+	"\x1bGetReliabilityReportRequest\x12>\n" +
+	"\bgroup_by\x18\x01 \x01(\x0e2\x19.booking.ReliabilityGroupB\b\xfaB\x05\x82\x01\x02\x10\x01R\agroupBy\x12<\n" +
+	"\tstart_day\x18\x02 \x01(\v2\x15.booking.CalendarDateB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bstartDay\x128\n" +
+	"\aend_day\x18\x03 \x01(\v2\x15.booking.CalendarDateB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06endDay\x12\x1b\n" +
+	"\tbarber_id\x18\x04 \x01(\tR\bbarberId\x12*\n" +
+	"\fmin_bookings\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\vminBookings\x12;\n" +
+	"\asort_by\x18\x06 \x01(\x0e2\x18.booking.ReliabilitySortB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06sortBy\x12 \n" +
+	"\x05limit\x18\a \x01(\x05B\n" +
+	"\xfaB\a\x1a\x05\x18\xf4\x03(\x00R\x05limit\"\xe2\x01\n" +
+	"\x0eReliabilityRow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bbookings\x18\x02 \x01(\x05R\bbookings\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\x12\x1c\n" +
+	"\tcancelled\x18\x04 \x01(\x05R\tcancelled\x12\x19\n" +
+	"\bno_shows\x18\x05 \x01(\x05R\anoShows\x12 \n" +
+	"\fno_show_rate\x18\x06 \x01(\x01R\n" +
+	"noShowRate\x12+\n" +
+	"\x11cancellation_rate\x18\a \x01(\x01R\x10cancellationRate\"\xda\x01\n" +
+	"\x11ReliabilityReport\x124\n" +
+	"\bgroup_by\x18\x01 \x01(\x0e2\x19.booking.ReliabilityGroupR\agroupBy\x122\n" +
+	"\tstart_day\x18\x02 \x01(\v2\x15.booking.CalendarDateR\bstartDay\x12.\n" +
+	"\aend_day\x18\x03 \x01(\v2\x15.booking.CalendarDateR\x06endDay\x12+\n" +
+	"\x04rows\x18\x04 \x03(\v2\x17.booking.ReliabilityRowR\x04rows\"=\n" +
+	"\x19GetCustomerSummaryRequest\x12 \n" +
+	"\auser_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06userId\"\xf6\x02\n" +
+	"\x0fCustomerSummary\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\ftotal_visits\x18\x02 \x01(\x05R\vtotalVisits\x12;\n" +
+	"\vfirst_visit\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"firstVisit\x129\n" +
+	"\n" +
+	"last_visit\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tlastVisit\x129\n" +
+	"\rusual_service\x18\x05 \x01(\x0e2\x14.booking.ServiceTypeR\fusualService\x122\n" +
+	"\x15average_interval_days\x18\x06 \x01(\x01R\x13averageIntervalDays\x12@\n" +
+	"\x0enext_visit_due\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\fnextVisitDue\"0\n" +
+	"\x15ConfirmBookingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"1\n" +
+	"\x16CompleteBookingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"\xbe\x04\n" +
+	"\x13ListBookingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tbarber_id\x18\x02 \x01(\tR\bbarberId\x12A\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x16.booking.BookingStatusB\r\xfaB\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\bstatuses\x129\n" +
+	"\rservice_types\x18\x04 \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypes\x12l\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tBM\xfaBJrH2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$\xd0\x01\x01R\tstartDate\x12h\n" +
+	"\bend_date\x18\x06 \x01(\tBM\xfaBJrH2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$\xd0\x01\x01R\aendDate\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12<\n" +
+	"\asort_by\x18\b \x01(\x0e2\x19.booking.BookingSortFieldB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\t \x01(\bR\n" +
+	"descending\x12!\n" +
+	"\fneeds_review\x18\n" +
+	" \x01(\bR\vneedsReview\"L\n" +
+	"\x14WatchBookingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tbarber_id\x18\x02 \x01(\tR\bbarberId\"i\n" +
+	"\fBookingEvent\x12-\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x19.booking.BookingEventTypeR\x04type\x12*\n" +
+	"\abooking\x18\x02 \x01(\v2\x10.booking.BookingR\abooking\"\x96\x01\n" +
+	"\x18RescheduleBookingRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12!\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\tB\x02\x18\x01R\tstartTime\x12>\n" +
+	"\rstart_time_ts\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vstartTimeTs\"\x98\x01\n" +
+	"\fWorkingHours\x124\n" +
+	"\aweekday\x18\x01 \x01(\x0e2\x10.booking.WeekdayB\b\xfaB\x05\x82\x01\x02\x10\x01R\aweekday\x12*\n" +
+	"\x05start\x18\x02 \x01(\tB\x14\xfaB\x11r\x0f2\r^\\d{2}:\\d{2}$R\x05start\x12&\n" +
+	"\x03end\x18\x03 \x01(\tB\x14\xfaB\x11r\x0f2\r^\\d{2}:\\d{2}$R\x03end\"a\n" +
+	"\vBreakPeriod\x12*\n" +
+	"\x05start\x18\x01 \x01(\tB\x14\xfaB\x11r\x0f2\r^\\d{2}:\\d{2}$R\x05start\x12&\n" +
+	"\x03end\x18\x02 \x01(\tB\x14\xfaB\x11r\x0f2\r^\\d{2}:\\d{2}$R\x03end\"\x9b\x02\n" +
+	"\x0eBarberSchedule\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12+\n" +
+	"\x05hours\x18\x02 \x03(\v2\x15.booking.WorkingHoursR\x05hours\x12,\n" +
+	"\x06breaks\x18\x03 \x03(\v2\x14.booking.BreakPeriodR\x06breaks\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12!\n" +
+	"\fslot_minutes\x18\x05 \x01(\x05R\vslotMinutes\x12(\n" +
+	"\x10min_lead_minutes\x18\x06 \x01(\x05R\x0eminLeadMinutes\x12(\n" +
+	"\x10max_advance_days\x18\a \x01(\x05R\x0emaxAdvanceDays\">\n" +
+	"\x16GetWorkingHoursRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\"\xa3\x02\n" +
+	"\x16SetWorkingHoursRequest\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12+\n" +
+	"\x05hours\x18\x02 \x03(\v2\x15.booking.WorkingHoursR\x05hours\x12,\n" +
+	"\x06breaks\x18\x03 \x03(\v2\x14.booking.BreakPeriodR\x06breaks\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12!\n" +
+	"\fslot_minutes\x18\x05 \x01(\x05R\vslotMinutes\x12(\n" +
+	"\x10min_lead_minutes\x18\x06 \x01(\x05R\x0eminLeadMinutes\x12(\n" +
+	"\x10max_advance_days\x18\a \x01(\x05R\x0emaxAdvanceDays\"1\n" +
+	"\aHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\";\n" +
+	"\vHolidayList\x12,\n" +
+	"\bholidays\x18\x01 \x03(\v2\x10.booking.HolidayR\bholidays\"\xed\x01\n" +
+	"\x13ListHolidaysRequest\x12l\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tBM\xfaBJrH2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$\xd0\x01\x01R\tstartDate\x12h\n" +
+	"\bend_date\x18\x02 \x01(\tBM\xfaBJrH2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$\xd0\x01\x01R\aendDate\"\x87\x01\n" +
+	"\x11AddHolidayRequest\x12^\n" +
+	"\x04date\x18\x01 \x01(\tBJ\xfaBGrE2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$R\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"v\n" +
+	"\x14RemoveHolidayRequest\x12^\n" +
+	"\x04date\x18\x01 \x01(\tBJ\xfaBGrE2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$R\x04date\"1\n" +
+	"\x15RemoveHolidayResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x88\x02\n" +
+	"\x1bGetNextAvailableSlotRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\x12<\n" +
+	"\fservice_type\x18\x02 \x01(\x0e2\x14.booking.ServiceTypeH\x00R\vserviceType\x88\x01\x01\x12\x1d\n" +
+	"\x05count\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05count\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x129\n" +
+	"\rservice_types\x18\x05 \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypesB\x0f\n" +
+	"\r_service_type\"\xa2\x04\n" +
+	"!GetAvailableTimeSlotsRangeRequest\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x12l\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tBM\xfaBJrH2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$\xd0\x01\x01R\tstartDate\x12h\n" +
+	"\bend_date\x18\x03 \x01(\tBM\xfaBJrH2C^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$\xd0\x01\x01R\aendDate\x122\n" +
+	"\tstart_day\x18\x04 \x01(\v2\x15.booking.CalendarDateR\bstartDay\x12.\n" +
+	"\aend_day\x18\x05 \x01(\v2\x15.booking.CalendarDateR\x06endDay\x12\x1a\n" +
+	"\btimezone\x18\x06 \x01(\tR\btimezone\x12<\n" +
+	"\fservice_type\x18\a \x01(\x0e2\x14.booking.ServiceTypeH\x00R\vserviceType\x88\x01\x01\x129\n" +
+	"\rservice_types\x18\b \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypesB\x0f\n" +
+	"\r_service_type\"i\n" +
+	"\fDayTimeSlots\x12'\n" +
+	"\x03day\x18\x01 \x01(\v2\x15.booking.CalendarDateR\x03day\x120\n" +
+	"\n" +
+	"time_slots\x18\x02 \x03(\v2\x11.booking.TimeSlotR\ttimeSlots\"=\n" +
+	"\x10DayTimeSlotsList\x12)\n" +
+	"\x04days\x18\x01 \x03(\v2\x15.booking.DayTimeSlotsR\x04days\"\x9e\x02\n" +
+	"\x0eCatalogService\x127\n" +
+	"\fservice_type\x18\x01 \x01(\x0e2\x14.booking.ServiceTypeR\vserviceType\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x122\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x0fdurationMinutes\x12\x1d\n" +
+	"\x05price\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x05price\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x127\n" +
+	"\x0fcleanup_minutes\x18\x06 \x01(\x05B\t\xfaB\x06\x1a\x04\x18x(\x00H\x00R\x0ecleanupMinutes\x88\x01\x01B\x12\n" +
+	"\x10_cleanup_minutes\"G\n" +
+	"\x1aListCatalogServicesRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"I\n" +
+	"\x12CatalogServiceList\x123\n" +
+	"\bservices\x18\x01 \x03(\v2\x17.booking.CatalogServiceR\bservices\"Z\n" +
+	"\x1bCreateCatalogServiceRequest\x12;\n" +
+	"\aservice\x18\x01 \x01(\v2\x17.booking.CatalogServiceB\b\xfaB\x05\x8a\x01\x02\x10\x01R\aservice\"Z\n" +
+	"\x1bUpdateCatalogServiceRequest\x12;\n" +
+	"\aservice\x18\x01 \x01(\v2\x17.booking.CatalogServiceB\b\xfaB\x05\x8a\x01\x02\x10\x01R\aservice\"V\n" +
+	"\x1bDeleteCatalogServiceRequest\x127\n" +
+	"\fservice_type\x18\x01 \x01(\x0e2\x14.booking.ServiceTypeR\vserviceType\"8\n" +
+	"\x1cDeleteCatalogServiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd9\x02\n" +
+	"\tPromoCode\x120\n" +
+	"\x04code\x18\x01 \x01(\tB\x1c\xfaB\x19r\x172\x15^[A-Za-z0-9_-]{3,32}$R\x04code\x12*\n" +
+	"\vpercent_off\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\n" +
+	"percentOff\x12&\n" +
+	"\n" +
+	"amount_off\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\tamountOff\x129\n" +
+	"\n" +
+	"valid_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x12;\n" +
+	"\vvalid_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\x12\"\n" +
+	"\bmax_uses\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\a \x01(\x05R\x04uses\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\"\x17\n" +
+	"\x15ListPromoCodesRequest\"D\n" +
+	"\rPromoCodeList\x123\n" +
+	"\vpromo_codes\x18\x01 \x03(\v2\x12.booking.PromoCodeR\n" +
+	"promoCodes\"2\n" +
+	"\x13GetPromoCodeRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"U\n" +
+	"\x16CreatePromoCodeRequest\x12;\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x12.booking.PromoCodeB\b\xfaB\x05\x8a\x01\x02\x10\x01R\tpromoCode\"U\n" +
+	"\x16UpdatePromoCodeRequest\x12;\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x12.booking.PromoCodeB\b\xfaB\x05\x8a\x01\x02\x10\x01R\tpromoCode\"5\n" +
+	"\x16DeletePromoCodeRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"3\n" +
+	"\x17DeletePromoCodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbd\x01\n" +
+	"\bResource\x120\n" +
+	"\x02id\x18\x01 \x01(\tB \xfaB\x1dr\x1b2\x19^[a-z0-9][a-z0-9-]{0,63}$R\x02id\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\x04name\x12%\n" +
+	"\bcapacity\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x01R\bcapacity\x129\n" +
+	"\rservice_types\x18\x04 \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypes\"\x16\n" +
+	"\x14ListResourcesRequest\"?\n" +
+	"\fResourceList\x12/\n" +
+	"\tresources\x18\x01 \x03(\v2\x11.booking.ResourceR\tresources\"P\n" +
+	"\x15CreateResourceRequest\x127\n" +
+	"\bresource\x18\x01 \x01(\v2\x11.booking.ResourceB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bresource\"P\n" +
+	"\x15UpdateResourceRequest\x127\n" +
+	"\bresource\x18\x01 \x01(\v2\x11.booking.ResourceB\b\xfaB\x05\x8a\x01\x02\x10\x01R\bresource\"0\n" +
+	"\x15DeleteResourceRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"2\n" +
+	"\x16DeleteResourceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xaf\x01\n" +
+	"\rBarberService\x127\n" +
+	"\fservice_type\x18\x01 \x01(\x0e2\x14.booking.ServiceTypeR\vserviceType\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x122\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x0fdurationMinutes\x12\x1d\n" +
+	"\x05price\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x05price\"@\n" +
+	"\x18GetBarberServicesRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\"d\n" +
+	"\x11BarberServiceList\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x122\n" +
+	"\bservices\x18\x02 \x03(\v2\x16.booking.BarberServiceR\bservices\"k\n" +
+	"\x18SetBarberServicesRequest\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x122\n" +
+	"\bservices\x18\x02 \x03(\v2\x16.booking.BarberServiceR\bservices\"\xaa\x02\n" +
+	"\x06Barber\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\x12,\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18dR\vdisplayName\x12\x1a\n" +
+	"\x03bio\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x18\xd0\x0fR\x03bio\x12\x1b\n" +
+	"\tphoto_url\x18\x04 \x01(\tR\bphotoUrl\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x122\n" +
+	"\bservices\x18\x06 \x03(\v2\x16.booking.BarberServiceR\bservices\x12%\n" +
+	"\bcapacity\x18\a \x01(\x05B\t\xfaB\x06\x1a\x04\x18\x14(\x00R\bcapacity\x12-\n" +
+	"\x06rating\x18\b \x01(\v2\x15.booking.BarberRatingR\x06rating\"?\n" +
+	"\x12ListBarbersRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"7\n" +
+	"\n" +
+	"BarberList\x12)\n" +
+	"\abarbers\x18\x01 \x03(\v2\x0f.booking.BarberR\abarbers\"+\n" +
+	"\x10GetBarberRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"H\n" +
+	"\x13CreateBarberRequest\x121\n" +
+	"\x06barber\x18\x01 \x01(\v2\x0f.booking.BarberB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06barber\"H\n" +
+	"\x13UpdateBarberRequest\x121\n" +
+	"\x06barber\x18\x01 \x01(\v2\x0f.booking.BarberB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06barber\".\n" +
+	"\x13DeleteBarberRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"0\n" +
+	"\x14DeleteBarberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"@\n" +
+	"\x18AddFavoriteBarberRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\"\x8d\x01\n" +
+	"\x0eFavoriteBarber\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x125\n" +
+	"\badded_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12'\n" +
+	"\x06barber\x18\x03 \x01(\v2\x0f.booking.BarberR\x06barber\"C\n" +
+	"\x1bRemoveFavoriteBarberRequest\x12$\n" +
+	"\tbarber_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bbarberId\"8\n" +
+	"\x1cRemoveFavoriteBarberResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\bR\aremoved\"\x16\n" +
+	"\x14ListFavoritesRequest\"K\n" +
+	"\x12FavoriteBarberList\x125\n" +
+	"\tfavorites\x18\x01 \x03(\v2\x17.booking.FavoriteBarberR\tfavorites\"i\n" +
+	"\x0fGetQuoteRequest\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x129\n" +
+	"\rservice_types\x18\x02 \x03(\x0e2\x14.booking.ServiceTypeR\fserviceTypes\"\xb5\x01\n" +
+	"\x05Quote\x12\x1b\n" +
+	"\tbarber_id\x18\x01 \x01(\tR\bbarberId\x122\n" +
+	"\bservices\x18\x02 \x03(\v2\x16.booking.BarberServiceR\bservices\x12)\n" +
+	"\x10duration_minutes\x18\x03 \x01(\x05R\x0fdurationMinutes\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x03R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"B\n" +
+	"\x18GetBookingHistoryRequest\x12&\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tbookingId\"/\n" +
+	"\x14GetBookingICSRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"_\n" +
+	"\n" +
+	"BookingICS\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"Q\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\tR\x05after\"\x88\x01\n" +
+	"\x13BookingHistoryEntry\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x0e\n" +
+	"\x02at\x18\x03 \x01(\tR\x02at\x12.\n" +
+	"\achanges\x18\x04 \x03(\v2\x14.booking.FieldChangeR\achanges\"g\n" +
+	"\x0eBookingHistory\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x126\n" +
+	"\aentries\x18\x02 \x03(\v2\x1c.booking.BookingHistoryEntryR\aentries\"\xb9\x02\n" +
+	"\x13ListAuditLogRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\tR\aactorId\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12i\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tBJ\xfaBGrE2@^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\tstartTime\x12e\n" +
+	"\bend_time\x18\x04 \x01(\tBJ\xfaBGrE2@^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2})$\xd0\x01\x01R\aendTime\x12\x1d\n" +
+	"\x05limit\x18\x05 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05limit\"\xec\x01\n" +
+	"\n" +
+	"AuditEntry\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1b\n" +
+	"\tis_barber\x18\x03 \x01(\bR\bisBarber\x12\x19\n" +
+	"\bis_admin\x18\x04 \x01(\bR\aisAdmin\x12\x18\n" +
+	"\arequest\x18\x05 \x01(\tR\arequest\x12\x12\n" +
+	"\x04code\x18\x06 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\b \x01(\x03R\n" +
+	"durationMs\x12\x0e\n" +
+	"\x02at\x18\t \x01(\tR\x02at\"9\n" +
+	"\bAuditLog\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.booking.AuditEntryR\aentries\"&\n" +
+	"\x14DeleteBookingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x15DeleteBookingResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted\"\xbe\x01\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd2\x01\n" +
+	"\x14CreateWebhookRequest\x12-\n" +
+	"\x03url\x18\x01 \x01(\tB\x1b\xfaB\x18r\x16\x18\x80\x102\x11^https?://[^\\s/]+R\x03url\x12\x8a\x01\n" +
+	"\vevent_types\x18\x02 \x03(\tBi\xfaBf\x92\x01c\b\x01\"_r]R\x0fbooking.createdR\x0fbooking.updatedR\x13booking.rescheduledR\x11booking.confirmedR\x11booking.cancelledR\n" +
+	"eventTypes\"\x15\n" +
+	"\x13ListWebhooksRequest\";\n" +
+	"\vWebhookList\x12,\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x10.booking.WebhookR\bwebhooks\"/\n" +
+	"\x14DeleteWebhookRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x02id\"1\n" +
+	"\x15DeleteWebhookResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\bR\adeleted*`\n" +
 	"\rBookingStatus\x12\v\n" +
 	"\aPENDING\x10\x00\x12\r\n" +
 	"\tCONFIRMED\x10\x01\x12\r\n" +
 	"\tCANCELLED\x10\x02\x12\r\n" +
-	"\tCOMPLETED\x10\x03*K\n" +
+	"\tCOMPLETED\x10\x03\x12\v\n" +
+	"\aNO_SHOW\x10\x04\x12\b\n" +
+	"\x04HELD\x10\x05*K\n" +
 	"\vServiceType\x12\v\n" +
 	"\aHAIRCUT\x10\x00\x12\x0e\n" +
 	"\n" +
 	"BEARD_TRIM\x10\x01\x12\r\n" +
 	"\tHAIR_WASH\x10\x02\x12\x10\n" +
-	"\fFULL_SERVICE\x10\x032\x8f\x04\n" +
+	"\fFULL_SERVICE\x10\x03*!\n" +
+	"\fExportFormat\x12\a\n" +
+	"\x03CSV\x10\x00\x12\b\n" +
+	"\x04JSON\x10\x01*7\n" +
+	"\x11ReportGranularity\x12\t\n" +
+	"\x05DAILY\x10\x00\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x01\x12\v\n" +
+	"\aMONTHLY\x10\x02*.\n" +
+	"\x10ReliabilityGroup\x12\v\n" +
+	"\aBY_USER\x10\x00\x12\r\n" +
+	"\tBY_BARBER\x10\x01*:\n" +
+	"\x0fReliabilitySort\x12\x10\n" +
+	"\fNO_SHOW_RATE\x10\x00\x12\x15\n" +
+	"\x11CANCELLATION_RATE\x10\x01*\"\n" +
+	"\vStatsPeriod\x12\b\n" +
+	"\x04WEEK\x10\x00\x12\t\n" +
+	"\x05MONTH\x10\x01*\x83\x01\n" +
+	"\x10BookingEventType\x12\x13\n" +
+	"\x0fBOOKING_CREATED\x10\x00\x12\x13\n" +
+	"\x0fBOOKING_UPDATED\x10\x01\x12\x15\n" +
+	"\x11BOOKING_CANCELLED\x10\x02\x12\x17\n" +
+	"\x13BOOKING_RESCHEDULED\x10\x03\x12\x15\n" +
+	"\x11BOOKING_CONFIRMED\x10\x04*B\n" +
+	"\x10BookingSortField\x12\x0e\n" +
+	"\n" +
+	"START_TIME\x10\x00\x12\x0e\n" +
+	"\n" +
+	"CREATED_AT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"UPDATED_AT\x10\x02**\n" +
+	"\rRetentionMode\x12\r\n" +
+	"\tANONYMIZE\x10\x00\x12\n" +
+	"\n" +
+	"\x06DELETE\x10\x01*e\n" +
+	"\aWeekday\x12\n" +
+	"\n" +
+	"\x06SUNDAY\x10\x00\x12\n" +
+	"\n" +
+	"\x06MONDAY\x10\x01\x12\v\n" +
+	"\aTUESDAY\x10\x02\x12\r\n" +
+	"\tWEDNESDAY\x10\x03\x12\f\n" +
+	"\bTHURSDAY\x10\x04\x12\n" +
+	"\n" +
+	"\x06FRIDAY\x10\x05\x12\f\n" +
+	"\bSATURDAY\x10\x062\xf9.\n" +
 	"\x0eBookingService\x12@\n" +
-	"\rCreateBooking\x12\x1d.booking.CreateBookingRequest\x1a\x10.booking.Booking\x12:\n" +
+	"\rCreateBooking\x12\x1d.booking.CreateBookingRequest\x1a\x10.booking.Booking\x12>\n" +
+	"\fHoldTimeSlot\x12\x1c.booking.HoldTimeSlotRequest\x1a\x10.booking.Booking\x12:\n" +
+	"\n" +
+	"RebookLast\x12\x1a.booking.RebookLastRequest\x1a\x10.booking.Booking\x12:\n" +
 	"\n" +
 	"GetBooking\x12\x1a.booking.GetBookingRequest\x1a\x10.booking.Booking\x12@\n" +
-	"\rUpdateBooking\x12\x1d.booking.UpdateBookingRequest\x1a\x10.booking.Booking\x12N\n" +
-	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x1e.booking.CancelBookingResponse\x12H\n" +
+	"\rUpdateBooking\x12\x1d.booking.UpdateBookingRequest\x1a\x10.booking.Booking\x12H\n" +
+	"\x11RescheduleBooking\x12!.booking.RescheduleBookingRequest\x1a\x10.booking.Booking\x12B\n" +
+	"\x0eConfirmBooking\x12\x1e.booking.ConfirmBookingRequest\x1a\x10.booking.Booking\x12D\n" +
+	"\x0fCompleteBooking\x12\x1f.booking.CompleteBookingRequest\x1a\x10.booking.Booking\x12N\n" +
+	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x1e.booking.CancelBookingResponse\x12N\n" +
+	"\rDeleteBooking\x12\x1d.booking.DeleteBookingRequest\x1a\x1e.booking.DeleteBookingResponse\x12B\n" +
+	"\fListBookings\x12\x1c.booking.ListBookingsRequest\x1a\x14.booking.BookingList\x12G\n" +
+	"\rWatchBookings\x12\x1d.booking.WatchBookingsRequest\x1a\x15.booking.BookingEvent0\x01\x12H\n" +
 	"\x0fGetUserBookings\x12\x1f.booking.GetUserBookingsRequest\x1a\x14.booking.BookingList\x12L\n" +
 	"\x11GetBarberBookings\x12!.booking.GetBarberBookingsRequest\x1a\x14.booking.BookingList\x12U\n" +
-	"\x15GetAvailableTimeSlots\x12%.booking.GetAvailableTimeSlotsRequest\x1a\x15.booking.TimeSlotListB5Z3github.com/ita-av/booking-service/pkg/api/generatedb\x06proto3"
+	"\x15GetAvailableTimeSlots\x12%.booking.GetAvailableTimeSlotsRequest\x1a\x15.booking.TimeSlotList\x12B\n" +
+	"\x0eCheckInBooking\x12\x1e.booking.CheckInBookingRequest\x1a\x10.booking.Booking\x12:\n" +
+	"\n" +
+	"MarkNoShow\x12\x1a.booking.MarkNoShowRequest\x1a\x10.booking.Booking\x12R\n" +
+	"\x12GetUserReliability\x12\".booking.GetUserReliabilityRequest\x1a\x18.booking.UserReliability\x12X\n" +
+	"\x14GetReliabilityReport\x12$.booking.GetReliabilityReportRequest\x1a\x1a.booking.ReliabilityReport\x12R\n" +
+	"\x12GetCustomerSummary\x12\".booking.GetCustomerSummaryRequest\x1a\x18.booking.CustomerSummary\x12O\n" +
+	"\x11GetBookingHistory\x12!.booking.GetBookingHistoryRequest\x1a\x17.booking.BookingHistory\x12C\n" +
+	"\rGetBookingICS\x12\x1d.booking.GetBookingICSRequest\x1a\x13.booking.BookingICS\x12?\n" +
+	"\fListAuditLog\x12\x1c.booking.ListAuditLogRequest\x1a\x11.booking.AuditLog\x12c\n" +
+	"\x14AddBookingAttachment\x12$.booking.AddBookingAttachmentRequest\x1a%.booking.AddBookingAttachmentResponse\x12T\n" +
+	"\x17GetBookingByExternalRef\x12'.booking.GetBookingByExternalRefRequest\x1a\x10.booking.Booking\x12L\n" +
+	"\x13RecordPOSCompletion\x12#.booking.RecordPOSCompletionRequest\x1a\x10.booking.Booking\x12c\n" +
+	"\x14SubmitSurveyResponse\x12$.booking.SubmitSurveyResponseRequest\x1a%.booking.SubmitSurveyResponseResponse\x12U\n" +
+	"\x15GetBarberSurveyScores\x12%.booking.GetBarberSurveyScoresRequest\x1a\x15.booking.SurveyScores\x12=\n" +
+	"\fSubmitReview\x12\x1c.booking.SubmitReviewRequest\x1a\x0f.booking.Review\x12K\n" +
+	"\x11ListBarberReviews\x12!.booking.ListBarberReviewsRequest\x1a\x13.booking.ReviewList\x12I\n" +
+	"\x0fGetBarberRating\x12\x1f.booking.GetBarberRatingRequest\x1a\x15.booking.BarberRating\x12F\n" +
+	"\x0eGetBarberStats\x12\x1e.booking.GetBarberStatsRequest\x1a\x14.booking.BarberStats\x12L\n" +
+	"\x10GetDemandHeatmap\x12 .booking.GetDemandHeatmapRequest\x1a\x16.booking.DemandHeatmap\x12F\n" +
+	"\x0eGetDailyAgenda\x12\x1e.booking.GetDailyAgendaRequest\x1a\x14.booking.DailyAgenda\x12F\n" +
+	"\rExportPayroll\x12\x1d.booking.ExportPayrollRequest\x1a\x16.booking.PayrollExport\x12V\n" +
+	"\x15FinalizePayrollPeriod\x12%.booking.FinalizePayrollPeriodRequest\x1a\x16.booking.PayrollExport\x12L\n" +
+	"\x10GetRevenueReport\x12 .booking.GetRevenueReportRequest\x1a\x16.booking.RevenueReport\x12O\n" +
+	"\x13ExportRevenueReport\x12 .booking.GetRevenueReportRequest\x1a\x16.booking.RevenueExport\x12R\n" +
+	"\x12GetRetentionPolicy\x12\".booking.GetRetentionPolicyRequest\x1a\x18.booking.RetentionPolicy\x12X\n" +
+	"\x15UpdateRetentionPolicy\x12%.booking.UpdateRetentionPolicyRequest\x1a\x18.booking.RetentionPolicy\x12I\n" +
+	"\x0eExportUserData\x12\x1e.booking.ExportUserDataRequest\x1a\x17.booking.UserDataExport\x12N\n" +
+	"\rEraseUserData\x12\x1d.booking.EraseUserDataRequest\x1a\x1e.booking.EraseUserDataResponse\x12[\n" +
+	"\x15GetCancellationPolicy\x12%.booking.GetCancellationPolicyRequest\x1a\x1b.booking.CancellationPolicy\x12a\n" +
+	"\x18UpdateCancellationPolicy\x12(.booking.UpdateCancellationPolicyRequest\x1a\x1b.booking.CancellationPolicy\x12K\n" +
+	"\x0fGetWorkingHours\x12\x1f.booking.GetWorkingHoursRequest\x1a\x17.booking.BarberSchedule\x12K\n" +
+	"\x0fSetWorkingHours\x12\x1f.booking.SetWorkingHoursRequest\x1a\x17.booking.BarberSchedule\x12B\n" +
+	"\fListHolidays\x12\x1c.booking.ListHolidaysRequest\x1a\x14.booking.HolidayList\x12:\n" +
+	"\n" +
+	"AddHoliday\x12\x1a.booking.AddHolidayRequest\x1a\x10.booking.Holiday\x12N\n" +
+	"\rRemoveHoliday\x12\x1d.booking.RemoveHolidayRequest\x1a\x1e.booking.RemoveHolidayResponse\x12S\n" +
+	"\x14GetNextAvailableSlot\x12$.booking.GetNextAvailableSlotRequest\x1a\x15.booking.TimeSlotList\x12c\n" +
+	"\x1aGetAvailableTimeSlotsRange\x12*.booking.GetAvailableTimeSlotsRangeRequest\x1a\x19.booking.DayTimeSlotsList\x12W\n" +
+	"\x13ListCatalogServices\x12#.booking.ListCatalogServicesRequest\x1a\x1b.booking.CatalogServiceList\x12U\n" +
+	"\x14CreateCatalogService\x12$.booking.CreateCatalogServiceRequest\x1a\x17.booking.CatalogService\x12U\n" +
+	"\x14UpdateCatalogService\x12$.booking.UpdateCatalogServiceRequest\x1a\x17.booking.CatalogService\x12c\n" +
+	"\x14DeleteCatalogService\x12$.booking.DeleteCatalogServiceRequest\x1a%.booking.DeleteCatalogServiceResponse\x12H\n" +
+	"\x0eListPromoCodes\x12\x1e.booking.ListPromoCodesRequest\x1a\x16.booking.PromoCodeList\x12@\n" +
+	"\fGetPromoCode\x12\x1c.booking.GetPromoCodeRequest\x1a\x12.booking.PromoCode\x12F\n" +
+	"\x0fCreatePromoCode\x12\x1f.booking.CreatePromoCodeRequest\x1a\x12.booking.PromoCode\x12F\n" +
+	"\x0fUpdatePromoCode\x12\x1f.booking.UpdatePromoCodeRequest\x1a\x12.booking.PromoCode\x12T\n" +
+	"\x0fDeletePromoCode\x12\x1f.booking.DeletePromoCodeRequest\x1a .booking.DeletePromoCodeResponse\x12E\n" +
+	"\rListResources\x12\x1d.booking.ListResourcesRequest\x1a\x15.booking.ResourceList\x12C\n" +
+	"\x0eCreateResource\x12\x1e.booking.CreateResourceRequest\x1a\x11.booking.Resource\x12C\n" +
+	"\x0eUpdateResource\x12\x1e.booking.UpdateResourceRequest\x1a\x11.booking.Resource\x12Q\n" +
+	"\x0eDeleteResource\x12\x1e.booking.DeleteResourceRequest\x1a\x1f.booking.DeleteResourceResponse\x12R\n" +
+	"\x11GetBarberServices\x12!.booking.GetBarberServicesRequest\x1a\x1a.booking.BarberServiceList\x12R\n" +
+	"\x11SetBarberServices\x12!.booking.SetBarberServicesRequest\x1a\x1a.booking.BarberServiceList\x12?\n" +
+	"\vListBarbers\x12\x1b.booking.ListBarbersRequest\x1a\x13.booking.BarberList\x127\n" +
+	"\tGetBarber\x12\x19.booking.GetBarberRequest\x1a\x0f.booking.Barber\x12=\n" +
+	"\fCreateBarber\x12\x1c.booking.CreateBarberRequest\x1a\x0f.booking.Barber\x12=\n" +
+	"\fUpdateBarber\x12\x1c.booking.UpdateBarberRequest\x1a\x0f.booking.Barber\x12K\n" +
+	"\fDeleteBarber\x12\x1c.booking.DeleteBarberRequest\x1a\x1d.booking.DeleteBarberResponse\x12O\n" +
+	"\x11AddFavoriteBarber\x12!.booking.AddFavoriteBarberRequest\x1a\x17.booking.FavoriteBarber\x12c\n" +
+	"\x14RemoveFavoriteBarber\x12$.booking.RemoveFavoriteBarberRequest\x1a%.booking.RemoveFavoriteBarberResponse\x12K\n" +
+	"\rListFavorites\x12\x1d.booking.ListFavoritesRequest\x1a\x1b.booking.FavoriteBarberList\x124\n" +
+	"\bGetQuote\x12\x18.booking.GetQuoteRequest\x1a\x0e.booking.Quote\x12@\n" +
+	"\rCreateWebhook\x12\x1d.booking.CreateWebhookRequest\x1a\x10.booking.Webhook\x12B\n" +
+	"\fListWebhooks\x12\x1c.booking.ListWebhooksRequest\x1a\x14.booking.WebhookList\x12N\n" +
+	"\rDeleteWebhook\x12\x1d.booking.DeleteWebhookRequest\x1a\x1e.booking.DeleteWebhookResponseB5Z3github.com/ita-av/booking-service/pkg/api/generatedb\x06proto3"
 
 var (
 	file_pkg_api_proto_booking_proto_rawDescOnce sync.Once
@@ -917,50 +10808,485 @@ func file_pkg_api_proto_booking_proto_rawDescGZIP() []byte {
 	return file_pkg_api_proto_booking_proto_rawDescData
 }
 
-var file_pkg_api_proto_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_pkg_api_proto_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_pkg_api_proto_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_pkg_api_proto_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 151)
 var file_pkg_api_proto_booking_proto_goTypes = []any{
-	(BookingStatus)(0),                   // 0: booking.BookingStatus
-	(ServiceType)(0),                     // 1: booking.ServiceType
-	(*TimeSlot)(nil),                     // 2: booking.TimeSlot
-	(*TimeSlotList)(nil),                 // 3: booking.TimeSlotList
-	(*Booking)(nil),                      // 4: booking.Booking
-	(*BookingList)(nil),                  // 5: booking.BookingList
-	(*CreateBookingRequest)(nil),         // 6: booking.CreateBookingRequest
-	(*GetBookingRequest)(nil),            // 7: booking.GetBookingRequest
-	(*UpdateBookingRequest)(nil),         // 8: booking.UpdateBookingRequest
-	(*CancelBookingRequest)(nil),         // 9: booking.CancelBookingRequest
-	(*CancelBookingResponse)(nil),        // 10: booking.CancelBookingResponse
-	(*GetUserBookingsRequest)(nil),       // 11: booking.GetUserBookingsRequest
-	(*GetBarberBookingsRequest)(nil),     // 12: booking.GetBarberBookingsRequest
-	(*GetAvailableTimeSlotsRequest)(nil), // 13: booking.GetAvailableTimeSlotsRequest
+	(BookingStatus)(0),                        // 0: booking.BookingStatus
+	(ServiceType)(0),                          // 1: booking.ServiceType
+	(ExportFormat)(0),                         // 2: booking.ExportFormat
+	(ReportGranularity)(0),                    // 3: booking.ReportGranularity
+	(ReliabilityGroup)(0),                     // 4: booking.ReliabilityGroup
+	(ReliabilitySort)(0),                      // 5: booking.ReliabilitySort
+	(StatsPeriod)(0),                          // 6: booking.StatsPeriod
+	(BookingEventType)(0),                     // 7: booking.BookingEventType
+	(BookingSortField)(0),                     // 8: booking.BookingSortField
+	(RetentionMode)(0),                        // 9: booking.RetentionMode
+	(Weekday)(0),                              // 10: booking.Weekday
+	(*TimeSlot)(nil),                          // 11: booking.TimeSlot
+	(*TimeSlotList)(nil),                      // 12: booking.TimeSlotList
+	(*SlotUnavailableDetail)(nil),             // 13: booking.SlotUnavailableDetail
+	(*Booking)(nil),                           // 14: booking.Booking
+	(*Cancellation)(nil),                      // 15: booking.Cancellation
+	(*Deposit)(nil),                           // 16: booking.Deposit
+	(*Attachment)(nil),                        // 17: booking.Attachment
+	(*Payment)(nil),                           // 18: booking.Payment
+	(*BookingList)(nil),                       // 19: booking.BookingList
+	(*CreateBookingRequest)(nil),              // 20: booking.CreateBookingRequest
+	(*HoldTimeSlotRequest)(nil),               // 21: booking.HoldTimeSlotRequest
+	(*RebookLastRequest)(nil),                 // 22: booking.RebookLastRequest
+	(*GetBookingRequest)(nil),                 // 23: booking.GetBookingRequest
+	(*UpdateBookingRequest)(nil),              // 24: booking.UpdateBookingRequest
+	(*CancelBookingRequest)(nil),              // 25: booking.CancelBookingRequest
+	(*CancelBookingResponse)(nil),             // 26: booking.CancelBookingResponse
+	(*GetUserBookingsRequest)(nil),            // 27: booking.GetUserBookingsRequest
+	(*CalendarDate)(nil),                      // 28: booking.CalendarDate
+	(*GetBarberBookingsRequest)(nil),          // 29: booking.GetBarberBookingsRequest
+	(*GetBookingByExternalRefRequest)(nil),    // 30: booking.GetBookingByExternalRefRequest
+	(*GetAvailableTimeSlotsRequest)(nil),      // 31: booking.GetAvailableTimeSlotsRequest
+	(*RecordPOSCompletionRequest)(nil),        // 32: booking.RecordPOSCompletionRequest
+	(*ExportPayrollRequest)(nil),              // 33: booking.ExportPayrollRequest
+	(*FinalizePayrollPeriodRequest)(nil),      // 34: booking.FinalizePayrollPeriodRequest
+	(*PayrollExport)(nil),                     // 35: booking.PayrollExport
+	(*AddBookingAttachmentRequest)(nil),       // 36: booking.AddBookingAttachmentRequest
+	(*AddBookingAttachmentResponse)(nil),      // 37: booking.AddBookingAttachmentResponse
+	(*SubmitSurveyResponseRequest)(nil),       // 38: booking.SubmitSurveyResponseRequest
+	(*SubmitSurveyResponseResponse)(nil),      // 39: booking.SubmitSurveyResponseResponse
+	(*GetBarberSurveyScoresRequest)(nil),      // 40: booking.GetBarberSurveyScoresRequest
+	(*SurveyScores)(nil),                      // 41: booking.SurveyScores
+	(*SubmitReviewRequest)(nil),               // 42: booking.SubmitReviewRequest
+	(*Review)(nil),                            // 43: booking.Review
+	(*ListBarberReviewsRequest)(nil),          // 44: booking.ListBarberReviewsRequest
+	(*ReviewList)(nil),                        // 45: booking.ReviewList
+	(*GetBarberRatingRequest)(nil),            // 46: booking.GetBarberRatingRequest
+	(*BarberRating)(nil),                      // 47: booking.BarberRating
+	(*GetBarberStatsRequest)(nil),             // 48: booking.GetBarberStatsRequest
+	(*StatusCount)(nil),                       // 49: booking.StatusCount
+	(*DayStats)(nil),                          // 50: booking.DayStats
+	(*BarberStats)(nil),                       // 51: booking.BarberStats
+	(*GetDemandHeatmapRequest)(nil),           // 52: booking.GetDemandHeatmapRequest
+	(*HeatmapCell)(nil),                       // 53: booking.HeatmapCell
+	(*DemandHeatmap)(nil),                     // 54: booking.DemandHeatmap
+	(*GetDailyAgendaRequest)(nil),             // 55: booking.GetDailyAgendaRequest
+	(*AgendaGap)(nil),                         // 56: booking.AgendaGap
+	(*DailyAgenda)(nil),                       // 57: booking.DailyAgenda
+	(*GetRevenueReportRequest)(nil),           // 58: booking.GetRevenueReportRequest
+	(*RevenueLine)(nil),                       // 59: booking.RevenueLine
+	(*RevenueReport)(nil),                     // 60: booking.RevenueReport
+	(*RevenueExport)(nil),                     // 61: booking.RevenueExport
+	(*GetRetentionPolicyRequest)(nil),         // 62: booking.GetRetentionPolicyRequest
+	(*RetentionPolicy)(nil),                   // 63: booking.RetentionPolicy
+	(*UpdateRetentionPolicyRequest)(nil),      // 64: booking.UpdateRetentionPolicyRequest
+	(*ExportUserDataRequest)(nil),             // 65: booking.ExportUserDataRequest
+	(*UserDataExport)(nil),                    // 66: booking.UserDataExport
+	(*EraseUserDataRequest)(nil),              // 67: booking.EraseUserDataRequest
+	(*EraseUserDataResponse)(nil),             // 68: booking.EraseUserDataResponse
+	(*GetCancellationPolicyRequest)(nil),      // 69: booking.GetCancellationPolicyRequest
+	(*CancellationRule)(nil),                  // 70: booking.CancellationRule
+	(*CancellationPolicy)(nil),                // 71: booking.CancellationPolicy
+	(*UpdateCancellationPolicyRequest)(nil),   // 72: booking.UpdateCancellationPolicyRequest
+	(*CheckInBookingRequest)(nil),             // 73: booking.CheckInBookingRequest
+	(*MarkNoShowRequest)(nil),                 // 74: booking.MarkNoShowRequest
+	(*GetUserReliabilityRequest)(nil),         // 75: booking.GetUserReliabilityRequest
+	(*UserReliability)(nil),                   // 76: booking.UserReliability
+	(*GetReliabilityReportRequest)(nil),       // 77: booking.GetReliabilityReportRequest
+	(*ReliabilityRow)(nil),                    // 78: booking.ReliabilityRow
+	(*ReliabilityReport)(nil),                 // 79: booking.ReliabilityReport
+	(*GetCustomerSummaryRequest)(nil),         // 80: booking.GetCustomerSummaryRequest
+	(*CustomerSummary)(nil),                   // 81: booking.CustomerSummary
+	(*ConfirmBookingRequest)(nil),             // 82: booking.ConfirmBookingRequest
+	(*CompleteBookingRequest)(nil),            // 83: booking.CompleteBookingRequest
+	(*ListBookingsRequest)(nil),               // 84: booking.ListBookingsRequest
+	(*WatchBookingsRequest)(nil),              // 85: booking.WatchBookingsRequest
+	(*BookingEvent)(nil),                      // 86: booking.BookingEvent
+	(*RescheduleBookingRequest)(nil),          // 87: booking.RescheduleBookingRequest
+	(*WorkingHours)(nil),                      // 88: booking.WorkingHours
+	(*BreakPeriod)(nil),                       // 89: booking.BreakPeriod
+	(*BarberSchedule)(nil),                    // 90: booking.BarberSchedule
+	(*GetWorkingHoursRequest)(nil),            // 91: booking.GetWorkingHoursRequest
+	(*SetWorkingHoursRequest)(nil),            // 92: booking.SetWorkingHoursRequest
+	(*Holiday)(nil),                           // 93: booking.Holiday
+	(*HolidayList)(nil),                       // 94: booking.HolidayList
+	(*ListHolidaysRequest)(nil),               // 95: booking.ListHolidaysRequest
+	(*AddHolidayRequest)(nil),                 // 96: booking.AddHolidayRequest
+	(*RemoveHolidayRequest)(nil),              // 97: booking.RemoveHolidayRequest
+	(*RemoveHolidayResponse)(nil),             // 98: booking.RemoveHolidayResponse
+	(*GetNextAvailableSlotRequest)(nil),       // 99: booking.GetNextAvailableSlotRequest
+	(*GetAvailableTimeSlotsRangeRequest)(nil), // 100: booking.GetAvailableTimeSlotsRangeRequest
+	(*DayTimeSlots)(nil),                      // 101: booking.DayTimeSlots
+	(*DayTimeSlotsList)(nil),                  // 102: booking.DayTimeSlotsList
+	(*CatalogService)(nil),                    // 103: booking.CatalogService
+	(*ListCatalogServicesRequest)(nil),        // 104: booking.ListCatalogServicesRequest
+	(*CatalogServiceList)(nil),                // 105: booking.CatalogServiceList
+	(*CreateCatalogServiceRequest)(nil),       // 106: booking.CreateCatalogServiceRequest
+	(*UpdateCatalogServiceRequest)(nil),       // 107: booking.UpdateCatalogServiceRequest
+	(*DeleteCatalogServiceRequest)(nil),       // 108: booking.DeleteCatalogServiceRequest
+	(*DeleteCatalogServiceResponse)(nil),      // 109: booking.DeleteCatalogServiceResponse
+	(*PromoCode)(nil),                         // 110: booking.PromoCode
+	(*ListPromoCodesRequest)(nil),             // 111: booking.ListPromoCodesRequest
+	(*PromoCodeList)(nil),                     // 112: booking.PromoCodeList
+	(*GetPromoCodeRequest)(nil),               // 113: booking.GetPromoCodeRequest
+	(*CreatePromoCodeRequest)(nil),            // 114: booking.CreatePromoCodeRequest
+	(*UpdatePromoCodeRequest)(nil),            // 115: booking.UpdatePromoCodeRequest
+	(*DeletePromoCodeRequest)(nil),            // 116: booking.DeletePromoCodeRequest
+	(*DeletePromoCodeResponse)(nil),           // 117: booking.DeletePromoCodeResponse
+	(*Resource)(nil),                          // 118: booking.Resource
+	(*ListResourcesRequest)(nil),              // 119: booking.ListResourcesRequest
+	(*ResourceList)(nil),                      // 120: booking.ResourceList
+	(*CreateResourceRequest)(nil),             // 121: booking.CreateResourceRequest
+	(*UpdateResourceRequest)(nil),             // 122: booking.UpdateResourceRequest
+	(*DeleteResourceRequest)(nil),             // 123: booking.DeleteResourceRequest
+	(*DeleteResourceResponse)(nil),            // 124: booking.DeleteResourceResponse
+	(*BarberService)(nil),                     // 125: booking.BarberService
+	(*GetBarberServicesRequest)(nil),          // 126: booking.GetBarberServicesRequest
+	(*BarberServiceList)(nil),                 // 127: booking.BarberServiceList
+	(*SetBarberServicesRequest)(nil),          // 128: booking.SetBarberServicesRequest
+	(*Barber)(nil),                            // 129: booking.Barber
+	(*ListBarbersRequest)(nil),                // 130: booking.ListBarbersRequest
+	(*BarberList)(nil),                        // 131: booking.BarberList
+	(*GetBarberRequest)(nil),                  // 132: booking.GetBarberRequest
+	(*CreateBarberRequest)(nil),               // 133: booking.CreateBarberRequest
+	(*UpdateBarberRequest)(nil),               // 134: booking.UpdateBarberRequest
+	(*DeleteBarberRequest)(nil),               // 135: booking.DeleteBarberRequest
+	(*DeleteBarberResponse)(nil),              // 136: booking.DeleteBarberResponse
+	(*AddFavoriteBarberRequest)(nil),          // 137: booking.AddFavoriteBarberRequest
+	(*FavoriteBarber)(nil),                    // 138: booking.FavoriteBarber
+	(*RemoveFavoriteBarberRequest)(nil),       // 139: booking.RemoveFavoriteBarberRequest
+	(*RemoveFavoriteBarberResponse)(nil),      // 140: booking.RemoveFavoriteBarberResponse
+	(*ListFavoritesRequest)(nil),              // 141: booking.ListFavoritesRequest
+	(*FavoriteBarberList)(nil),                // 142: booking.FavoriteBarberList
+	(*GetQuoteRequest)(nil),                   // 143: booking.GetQuoteRequest
+	(*Quote)(nil),                             // 144: booking.Quote
+	(*GetBookingHistoryRequest)(nil),          // 145: booking.GetBookingHistoryRequest
+	(*GetBookingICSRequest)(nil),              // 146: booking.GetBookingICSRequest
+	(*BookingICS)(nil),                        // 147: booking.BookingICS
+	(*FieldChange)(nil),                       // 148: booking.FieldChange
+	(*BookingHistoryEntry)(nil),               // 149: booking.BookingHistoryEntry
+	(*BookingHistory)(nil),                    // 150: booking.BookingHistory
+	(*ListAuditLogRequest)(nil),               // 151: booking.ListAuditLogRequest
+	(*AuditEntry)(nil),                        // 152: booking.AuditEntry
+	(*AuditLog)(nil),                          // 153: booking.AuditLog
+	(*DeleteBookingRequest)(nil),              // 154: booking.DeleteBookingRequest
+	(*DeleteBookingResponse)(nil),             // 155: booking.DeleteBookingResponse
+	(*Webhook)(nil),                           // 156: booking.Webhook
+	(*CreateWebhookRequest)(nil),              // 157: booking.CreateWebhookRequest
+	(*ListWebhooksRequest)(nil),               // 158: booking.ListWebhooksRequest
+	(*WebhookList)(nil),                       // 159: booking.WebhookList
+	(*DeleteWebhookRequest)(nil),              // 160: booking.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),             // 161: booking.DeleteWebhookResponse
+	(*timestamppb.Timestamp)(nil),             // 162: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),             // 163: google.protobuf.FieldMask
 }
 var file_pkg_api_proto_booking_proto_depIdxs = []int32{
-	2,  // 0: booking.TimeSlotList.time_slots:type_name -> booking.TimeSlot
-	1,  // 1: booking.Booking.service_type:type_name -> booking.ServiceType
-	0,  // 2: booking.Booking.status:type_name -> booking.BookingStatus
-	4,  // 3: booking.BookingList.bookings:type_name -> booking.Booking
-	1,  // 4: booking.CreateBookingRequest.service_type:type_name -> booking.ServiceType
-	1,  // 5: booking.UpdateBookingRequest.service_type:type_name -> booking.ServiceType
-	6,  // 6: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
-	7,  // 7: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
-	8,  // 8: booking.BookingService.UpdateBooking:input_type -> booking.UpdateBookingRequest
-	9,  // 9: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
-	11, // 10: booking.BookingService.GetUserBookings:input_type -> booking.GetUserBookingsRequest
-	12, // 11: booking.BookingService.GetBarberBookings:input_type -> booking.GetBarberBookingsRequest
-	13, // 12: booking.BookingService.GetAvailableTimeSlots:input_type -> booking.GetAvailableTimeSlotsRequest
-	4,  // 13: booking.BookingService.CreateBooking:output_type -> booking.Booking
-	4,  // 14: booking.BookingService.GetBooking:output_type -> booking.Booking
-	4,  // 15: booking.BookingService.UpdateBooking:output_type -> booking.Booking
-	10, // 16: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
-	5,  // 17: booking.BookingService.GetUserBookings:output_type -> booking.BookingList
-	5,  // 18: booking.BookingService.GetBarberBookings:output_type -> booking.BookingList
-	3,  // 19: booking.BookingService.GetAvailableTimeSlots:output_type -> booking.TimeSlotList
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	162, // 0: booking.TimeSlot.start_time_ts:type_name -> google.protobuf.Timestamp
+	162, // 1: booking.TimeSlot.end_time_ts:type_name -> google.protobuf.Timestamp
+	11,  // 2: booking.TimeSlotList.time_slots:type_name -> booking.TimeSlot
+	11,  // 3: booking.SlotUnavailableDetail.conflicts:type_name -> booking.TimeSlot
+	11,  // 4: booking.SlotUnavailableDetail.alternatives:type_name -> booking.TimeSlot
+	1,   // 5: booking.Booking.service_type:type_name -> booking.ServiceType
+	0,   // 6: booking.Booking.status:type_name -> booking.BookingStatus
+	18,  // 7: booking.Booking.payment:type_name -> booking.Payment
+	17,  // 8: booking.Booking.attachments:type_name -> booking.Attachment
+	162, // 9: booking.Booking.start_time_ts:type_name -> google.protobuf.Timestamp
+	162, // 10: booking.Booking.end_time_ts:type_name -> google.protobuf.Timestamp
+	162, // 11: booking.Booking.created_at_ts:type_name -> google.protobuf.Timestamp
+	162, // 12: booking.Booking.updated_at_ts:type_name -> google.protobuf.Timestamp
+	162, // 13: booking.Booking.checked_in_at_ts:type_name -> google.protobuf.Timestamp
+	162, // 14: booking.Booking.released_at_ts:type_name -> google.protobuf.Timestamp
+	162, // 15: booking.Booking.rescheduled_from_ts:type_name -> google.protobuf.Timestamp
+	1,   // 16: booking.Booking.service_types:type_name -> booking.ServiceType
+	16,  // 17: booking.Booking.deposit:type_name -> booking.Deposit
+	15,  // 18: booking.Booking.cancellation:type_name -> booking.Cancellation
+	162, // 19: booking.Booking.review_flagged_at_ts:type_name -> google.protobuf.Timestamp
+	162, // 20: booking.Booking.hold_expires_at:type_name -> google.protobuf.Timestamp
+	162, // 21: booking.Cancellation.cancelled_at:type_name -> google.protobuf.Timestamp
+	162, // 22: booking.Deposit.expires_at:type_name -> google.protobuf.Timestamp
+	162, // 23: booking.Deposit.paid_at:type_name -> google.protobuf.Timestamp
+	162, // 24: booking.Attachment.created_at_ts:type_name -> google.protobuf.Timestamp
+	1,   // 25: booking.Payment.rendered_services:type_name -> booking.ServiceType
+	162, // 26: booking.Payment.paid_at_ts:type_name -> google.protobuf.Timestamp
+	14,  // 27: booking.BookingList.bookings:type_name -> booking.Booking
+	1,   // 28: booking.CreateBookingRequest.service_type:type_name -> booking.ServiceType
+	162, // 29: booking.CreateBookingRequest.start_time_ts:type_name -> google.protobuf.Timestamp
+	1,   // 30: booking.CreateBookingRequest.service_types:type_name -> booking.ServiceType
+	162, // 31: booking.HoldTimeSlotRequest.start_time:type_name -> google.protobuf.Timestamp
+	1,   // 32: booking.HoldTimeSlotRequest.service_types:type_name -> booking.ServiceType
+	1,   // 33: booking.UpdateBookingRequest.service_type:type_name -> booking.ServiceType
+	162, // 34: booking.UpdateBookingRequest.start_time_ts:type_name -> google.protobuf.Timestamp
+	163, // 35: booking.UpdateBookingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,   // 36: booking.UpdateBookingRequest.service_types:type_name -> booking.ServiceType
+	162, // 37: booking.CancelBookingResponse.cancelled_at:type_name -> google.protobuf.Timestamp
+	28,  // 38: booking.GetBarberBookingsRequest.day:type_name -> booking.CalendarDate
+	28,  // 39: booking.GetAvailableTimeSlotsRequest.day:type_name -> booking.CalendarDate
+	1,   // 40: booking.GetAvailableTimeSlotsRequest.service_type:type_name -> booking.ServiceType
+	1,   // 41: booking.GetAvailableTimeSlotsRequest.service_types:type_name -> booking.ServiceType
+	1,   // 42: booking.RecordPOSCompletionRequest.rendered_services:type_name -> booking.ServiceType
+	162, // 43: booking.RecordPOSCompletionRequest.paid_at_ts:type_name -> google.protobuf.Timestamp
+	2,   // 44: booking.ExportPayrollRequest.format:type_name -> booking.ExportFormat
+	2,   // 45: booking.FinalizePayrollPeriodRequest.format:type_name -> booking.ExportFormat
+	17,  // 46: booking.AddBookingAttachmentResponse.attachment:type_name -> booking.Attachment
+	162, // 47: booking.Review.created_at:type_name -> google.protobuf.Timestamp
+	162, // 48: booking.ListBarberReviewsRequest.before:type_name -> google.protobuf.Timestamp
+	43,  // 49: booking.ReviewList.reviews:type_name -> booking.Review
+	162, // 50: booking.ReviewList.next_before:type_name -> google.protobuf.Timestamp
+	6,   // 51: booking.GetBarberStatsRequest.period:type_name -> booking.StatsPeriod
+	28,  // 52: booking.GetBarberStatsRequest.day:type_name -> booking.CalendarDate
+	0,   // 53: booking.StatusCount.status:type_name -> booking.BookingStatus
+	28,  // 54: booking.DayStats.day:type_name -> booking.CalendarDate
+	28,  // 55: booking.BarberStats.start_day:type_name -> booking.CalendarDate
+	28,  // 56: booking.BarberStats.end_day:type_name -> booking.CalendarDate
+	49,  // 57: booking.BarberStats.status_counts:type_name -> booking.StatusCount
+	50,  // 58: booking.BarberStats.busiest_days:type_name -> booking.DayStats
+	10,  // 59: booking.HeatmapCell.weekday:type_name -> booking.Weekday
+	28,  // 60: booking.DemandHeatmap.start_day:type_name -> booking.CalendarDate
+	28,  // 61: booking.DemandHeatmap.end_day:type_name -> booking.CalendarDate
+	53,  // 62: booking.DemandHeatmap.cells:type_name -> booking.HeatmapCell
+	162, // 63: booking.DemandHeatmap.generated_at:type_name -> google.protobuf.Timestamp
+	28,  // 64: booking.GetDailyAgendaRequest.day:type_name -> booking.CalendarDate
+	162, // 65: booking.AgendaGap.start:type_name -> google.protobuf.Timestamp
+	162, // 66: booking.AgendaGap.end:type_name -> google.protobuf.Timestamp
+	28,  // 67: booking.DailyAgenda.day:type_name -> booking.CalendarDate
+	14,  // 68: booking.DailyAgenda.bookings:type_name -> booking.Booking
+	56,  // 69: booking.DailyAgenda.gaps:type_name -> booking.AgendaGap
+	28,  // 70: booking.GetRevenueReportRequest.start_day:type_name -> booking.CalendarDate
+	28,  // 71: booking.GetRevenueReportRequest.end_day:type_name -> booking.CalendarDate
+	3,   // 72: booking.GetRevenueReportRequest.granularity:type_name -> booking.ReportGranularity
+	28,  // 73: booking.RevenueLine.period_start:type_name -> booking.CalendarDate
+	1,   // 74: booking.RevenueLine.service_type:type_name -> booking.ServiceType
+	28,  // 75: booking.RevenueReport.start_day:type_name -> booking.CalendarDate
+	28,  // 76: booking.RevenueReport.end_day:type_name -> booking.CalendarDate
+	3,   // 77: booking.RevenueReport.granularity:type_name -> booking.ReportGranularity
+	59,  // 78: booking.RevenueReport.lines:type_name -> booking.RevenueLine
+	9,   // 79: booking.RetentionPolicy.mode:type_name -> booking.RetentionMode
+	63,  // 80: booking.UpdateRetentionPolicyRequest.policy:type_name -> booking.RetentionPolicy
+	9,   // 81: booking.EraseUserDataRequest.mode:type_name -> booking.RetentionMode
+	70,  // 82: booking.CancellationPolicy.rules:type_name -> booking.CancellationRule
+	71,  // 83: booking.UpdateCancellationPolicyRequest.policy:type_name -> booking.CancellationPolicy
+	4,   // 84: booking.GetReliabilityReportRequest.group_by:type_name -> booking.ReliabilityGroup
+	28,  // 85: booking.GetReliabilityReportRequest.start_day:type_name -> booking.CalendarDate
+	28,  // 86: booking.GetReliabilityReportRequest.end_day:type_name -> booking.CalendarDate
+	5,   // 87: booking.GetReliabilityReportRequest.sort_by:type_name -> booking.ReliabilitySort
+	4,   // 88: booking.ReliabilityReport.group_by:type_name -> booking.ReliabilityGroup
+	28,  // 89: booking.ReliabilityReport.start_day:type_name -> booking.CalendarDate
+	28,  // 90: booking.ReliabilityReport.end_day:type_name -> booking.CalendarDate
+	78,  // 91: booking.ReliabilityReport.rows:type_name -> booking.ReliabilityRow
+	162, // 92: booking.CustomerSummary.first_visit:type_name -> google.protobuf.Timestamp
+	162, // 93: booking.CustomerSummary.last_visit:type_name -> google.protobuf.Timestamp
+	1,   // 94: booking.CustomerSummary.usual_service:type_name -> booking.ServiceType
+	162, // 95: booking.CustomerSummary.next_visit_due:type_name -> google.protobuf.Timestamp
+	0,   // 96: booking.ListBookingsRequest.statuses:type_name -> booking.BookingStatus
+	1,   // 97: booking.ListBookingsRequest.service_types:type_name -> booking.ServiceType
+	8,   // 98: booking.ListBookingsRequest.sort_by:type_name -> booking.BookingSortField
+	7,   // 99: booking.BookingEvent.type:type_name -> booking.BookingEventType
+	14,  // 100: booking.BookingEvent.booking:type_name -> booking.Booking
+	162, // 101: booking.RescheduleBookingRequest.start_time_ts:type_name -> google.protobuf.Timestamp
+	10,  // 102: booking.WorkingHours.weekday:type_name -> booking.Weekday
+	88,  // 103: booking.BarberSchedule.hours:type_name -> booking.WorkingHours
+	89,  // 104: booking.BarberSchedule.breaks:type_name -> booking.BreakPeriod
+	88,  // 105: booking.SetWorkingHoursRequest.hours:type_name -> booking.WorkingHours
+	89,  // 106: booking.SetWorkingHoursRequest.breaks:type_name -> booking.BreakPeriod
+	93,  // 107: booking.HolidayList.holidays:type_name -> booking.Holiday
+	1,   // 108: booking.GetNextAvailableSlotRequest.service_type:type_name -> booking.ServiceType
+	1,   // 109: booking.GetNextAvailableSlotRequest.service_types:type_name -> booking.ServiceType
+	28,  // 110: booking.GetAvailableTimeSlotsRangeRequest.start_day:type_name -> booking.CalendarDate
+	28,  // 111: booking.GetAvailableTimeSlotsRangeRequest.end_day:type_name -> booking.CalendarDate
+	1,   // 112: booking.GetAvailableTimeSlotsRangeRequest.service_type:type_name -> booking.ServiceType
+	1,   // 113: booking.GetAvailableTimeSlotsRangeRequest.service_types:type_name -> booking.ServiceType
+	28,  // 114: booking.DayTimeSlots.day:type_name -> booking.CalendarDate
+	11,  // 115: booking.DayTimeSlots.time_slots:type_name -> booking.TimeSlot
+	101, // 116: booking.DayTimeSlotsList.days:type_name -> booking.DayTimeSlots
+	1,   // 117: booking.CatalogService.service_type:type_name -> booking.ServiceType
+	103, // 118: booking.CatalogServiceList.services:type_name -> booking.CatalogService
+	103, // 119: booking.CreateCatalogServiceRequest.service:type_name -> booking.CatalogService
+	103, // 120: booking.UpdateCatalogServiceRequest.service:type_name -> booking.CatalogService
+	1,   // 121: booking.DeleteCatalogServiceRequest.service_type:type_name -> booking.ServiceType
+	162, // 122: booking.PromoCode.valid_from:type_name -> google.protobuf.Timestamp
+	162, // 123: booking.PromoCode.valid_until:type_name -> google.protobuf.Timestamp
+	110, // 124: booking.PromoCodeList.promo_codes:type_name -> booking.PromoCode
+	110, // 125: booking.CreatePromoCodeRequest.promo_code:type_name -> booking.PromoCode
+	110, // 126: booking.UpdatePromoCodeRequest.promo_code:type_name -> booking.PromoCode
+	1,   // 127: booking.Resource.service_types:type_name -> booking.ServiceType
+	118, // 128: booking.ResourceList.resources:type_name -> booking.Resource
+	118, // 129: booking.CreateResourceRequest.resource:type_name -> booking.Resource
+	118, // 130: booking.UpdateResourceRequest.resource:type_name -> booking.Resource
+	1,   // 131: booking.BarberService.service_type:type_name -> booking.ServiceType
+	125, // 132: booking.BarberServiceList.services:type_name -> booking.BarberService
+	125, // 133: booking.SetBarberServicesRequest.services:type_name -> booking.BarberService
+	125, // 134: booking.Barber.services:type_name -> booking.BarberService
+	47,  // 135: booking.Barber.rating:type_name -> booking.BarberRating
+	129, // 136: booking.BarberList.barbers:type_name -> booking.Barber
+	129, // 137: booking.CreateBarberRequest.barber:type_name -> booking.Barber
+	129, // 138: booking.UpdateBarberRequest.barber:type_name -> booking.Barber
+	162, // 139: booking.FavoriteBarber.added_at:type_name -> google.protobuf.Timestamp
+	129, // 140: booking.FavoriteBarber.barber:type_name -> booking.Barber
+	138, // 141: booking.FavoriteBarberList.favorites:type_name -> booking.FavoriteBarber
+	1,   // 142: booking.GetQuoteRequest.service_types:type_name -> booking.ServiceType
+	125, // 143: booking.Quote.services:type_name -> booking.BarberService
+	148, // 144: booking.BookingHistoryEntry.changes:type_name -> booking.FieldChange
+	149, // 145: booking.BookingHistory.entries:type_name -> booking.BookingHistoryEntry
+	152, // 146: booking.AuditLog.entries:type_name -> booking.AuditEntry
+	162, // 147: booking.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	156, // 148: booking.WebhookList.webhooks:type_name -> booking.Webhook
+	20,  // 149: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
+	21,  // 150: booking.BookingService.HoldTimeSlot:input_type -> booking.HoldTimeSlotRequest
+	22,  // 151: booking.BookingService.RebookLast:input_type -> booking.RebookLastRequest
+	23,  // 152: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
+	24,  // 153: booking.BookingService.UpdateBooking:input_type -> booking.UpdateBookingRequest
+	87,  // 154: booking.BookingService.RescheduleBooking:input_type -> booking.RescheduleBookingRequest
+	82,  // 155: booking.BookingService.ConfirmBooking:input_type -> booking.ConfirmBookingRequest
+	83,  // 156: booking.BookingService.CompleteBooking:input_type -> booking.CompleteBookingRequest
+	25,  // 157: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
+	154, // 158: booking.BookingService.DeleteBooking:input_type -> booking.DeleteBookingRequest
+	84,  // 159: booking.BookingService.ListBookings:input_type -> booking.ListBookingsRequest
+	85,  // 160: booking.BookingService.WatchBookings:input_type -> booking.WatchBookingsRequest
+	27,  // 161: booking.BookingService.GetUserBookings:input_type -> booking.GetUserBookingsRequest
+	29,  // 162: booking.BookingService.GetBarberBookings:input_type -> booking.GetBarberBookingsRequest
+	31,  // 163: booking.BookingService.GetAvailableTimeSlots:input_type -> booking.GetAvailableTimeSlotsRequest
+	73,  // 164: booking.BookingService.CheckInBooking:input_type -> booking.CheckInBookingRequest
+	74,  // 165: booking.BookingService.MarkNoShow:input_type -> booking.MarkNoShowRequest
+	75,  // 166: booking.BookingService.GetUserReliability:input_type -> booking.GetUserReliabilityRequest
+	77,  // 167: booking.BookingService.GetReliabilityReport:input_type -> booking.GetReliabilityReportRequest
+	80,  // 168: booking.BookingService.GetCustomerSummary:input_type -> booking.GetCustomerSummaryRequest
+	145, // 169: booking.BookingService.GetBookingHistory:input_type -> booking.GetBookingHistoryRequest
+	146, // 170: booking.BookingService.GetBookingICS:input_type -> booking.GetBookingICSRequest
+	151, // 171: booking.BookingService.ListAuditLog:input_type -> booking.ListAuditLogRequest
+	36,  // 172: booking.BookingService.AddBookingAttachment:input_type -> booking.AddBookingAttachmentRequest
+	30,  // 173: booking.BookingService.GetBookingByExternalRef:input_type -> booking.GetBookingByExternalRefRequest
+	32,  // 174: booking.BookingService.RecordPOSCompletion:input_type -> booking.RecordPOSCompletionRequest
+	38,  // 175: booking.BookingService.SubmitSurveyResponse:input_type -> booking.SubmitSurveyResponseRequest
+	40,  // 176: booking.BookingService.GetBarberSurveyScores:input_type -> booking.GetBarberSurveyScoresRequest
+	42,  // 177: booking.BookingService.SubmitReview:input_type -> booking.SubmitReviewRequest
+	44,  // 178: booking.BookingService.ListBarberReviews:input_type -> booking.ListBarberReviewsRequest
+	46,  // 179: booking.BookingService.GetBarberRating:input_type -> booking.GetBarberRatingRequest
+	48,  // 180: booking.BookingService.GetBarberStats:input_type -> booking.GetBarberStatsRequest
+	52,  // 181: booking.BookingService.GetDemandHeatmap:input_type -> booking.GetDemandHeatmapRequest
+	55,  // 182: booking.BookingService.GetDailyAgenda:input_type -> booking.GetDailyAgendaRequest
+	33,  // 183: booking.BookingService.ExportPayroll:input_type -> booking.ExportPayrollRequest
+	34,  // 184: booking.BookingService.FinalizePayrollPeriod:input_type -> booking.FinalizePayrollPeriodRequest
+	58,  // 185: booking.BookingService.GetRevenueReport:input_type -> booking.GetRevenueReportRequest
+	58,  // 186: booking.BookingService.ExportRevenueReport:input_type -> booking.GetRevenueReportRequest
+	62,  // 187: booking.BookingService.GetRetentionPolicy:input_type -> booking.GetRetentionPolicyRequest
+	64,  // 188: booking.BookingService.UpdateRetentionPolicy:input_type -> booking.UpdateRetentionPolicyRequest
+	65,  // 189: booking.BookingService.ExportUserData:input_type -> booking.ExportUserDataRequest
+	67,  // 190: booking.BookingService.EraseUserData:input_type -> booking.EraseUserDataRequest
+	69,  // 191: booking.BookingService.GetCancellationPolicy:input_type -> booking.GetCancellationPolicyRequest
+	72,  // 192: booking.BookingService.UpdateCancellationPolicy:input_type -> booking.UpdateCancellationPolicyRequest
+	91,  // 193: booking.BookingService.GetWorkingHours:input_type -> booking.GetWorkingHoursRequest
+	92,  // 194: booking.BookingService.SetWorkingHours:input_type -> booking.SetWorkingHoursRequest
+	95,  // 195: booking.BookingService.ListHolidays:input_type -> booking.ListHolidaysRequest
+	96,  // 196: booking.BookingService.AddHoliday:input_type -> booking.AddHolidayRequest
+	97,  // 197: booking.BookingService.RemoveHoliday:input_type -> booking.RemoveHolidayRequest
+	99,  // 198: booking.BookingService.GetNextAvailableSlot:input_type -> booking.GetNextAvailableSlotRequest
+	100, // 199: booking.BookingService.GetAvailableTimeSlotsRange:input_type -> booking.GetAvailableTimeSlotsRangeRequest
+	104, // 200: booking.BookingService.ListCatalogServices:input_type -> booking.ListCatalogServicesRequest
+	106, // 201: booking.BookingService.CreateCatalogService:input_type -> booking.CreateCatalogServiceRequest
+	107, // 202: booking.BookingService.UpdateCatalogService:input_type -> booking.UpdateCatalogServiceRequest
+	108, // 203: booking.BookingService.DeleteCatalogService:input_type -> booking.DeleteCatalogServiceRequest
+	111, // 204: booking.BookingService.ListPromoCodes:input_type -> booking.ListPromoCodesRequest
+	113, // 205: booking.BookingService.GetPromoCode:input_type -> booking.GetPromoCodeRequest
+	114, // 206: booking.BookingService.CreatePromoCode:input_type -> booking.CreatePromoCodeRequest
+	115, // 207: booking.BookingService.UpdatePromoCode:input_type -> booking.UpdatePromoCodeRequest
+	116, // 208: booking.BookingService.DeletePromoCode:input_type -> booking.DeletePromoCodeRequest
+	119, // 209: booking.BookingService.ListResources:input_type -> booking.ListResourcesRequest
+	121, // 210: booking.BookingService.CreateResource:input_type -> booking.CreateResourceRequest
+	122, // 211: booking.BookingService.UpdateResource:input_type -> booking.UpdateResourceRequest
+	123, // 212: booking.BookingService.DeleteResource:input_type -> booking.DeleteResourceRequest
+	126, // 213: booking.BookingService.GetBarberServices:input_type -> booking.GetBarberServicesRequest
+	128, // 214: booking.BookingService.SetBarberServices:input_type -> booking.SetBarberServicesRequest
+	130, // 215: booking.BookingService.ListBarbers:input_type -> booking.ListBarbersRequest
+	132, // 216: booking.BookingService.GetBarber:input_type -> booking.GetBarberRequest
+	133, // 217: booking.BookingService.CreateBarber:input_type -> booking.CreateBarberRequest
+	134, // 218: booking.BookingService.UpdateBarber:input_type -> booking.UpdateBarberRequest
+	135, // 219: booking.BookingService.DeleteBarber:input_type -> booking.DeleteBarberRequest
+	137, // 220: booking.BookingService.AddFavoriteBarber:input_type -> booking.AddFavoriteBarberRequest
+	139, // 221: booking.BookingService.RemoveFavoriteBarber:input_type -> booking.RemoveFavoriteBarberRequest
+	141, // 222: booking.BookingService.ListFavorites:input_type -> booking.ListFavoritesRequest
+	143, // 223: booking.BookingService.GetQuote:input_type -> booking.GetQuoteRequest
+	157, // 224: booking.BookingService.CreateWebhook:input_type -> booking.CreateWebhookRequest
+	158, // 225: booking.BookingService.ListWebhooks:input_type -> booking.ListWebhooksRequest
+	160, // 226: booking.BookingService.DeleteWebhook:input_type -> booking.DeleteWebhookRequest
+	14,  // 227: booking.BookingService.CreateBooking:output_type -> booking.Booking
+	14,  // 228: booking.BookingService.HoldTimeSlot:output_type -> booking.Booking
+	14,  // 229: booking.BookingService.RebookLast:output_type -> booking.Booking
+	14,  // 230: booking.BookingService.GetBooking:output_type -> booking.Booking
+	14,  // 231: booking.BookingService.UpdateBooking:output_type -> booking.Booking
+	14,  // 232: booking.BookingService.RescheduleBooking:output_type -> booking.Booking
+	14,  // 233: booking.BookingService.ConfirmBooking:output_type -> booking.Booking
+	14,  // 234: booking.BookingService.CompleteBooking:output_type -> booking.Booking
+	26,  // 235: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
+	155, // 236: booking.BookingService.DeleteBooking:output_type -> booking.DeleteBookingResponse
+	19,  // 237: booking.BookingService.ListBookings:output_type -> booking.BookingList
+	86,  // 238: booking.BookingService.WatchBookings:output_type -> booking.BookingEvent
+	19,  // 239: booking.BookingService.GetUserBookings:output_type -> booking.BookingList
+	19,  // 240: booking.BookingService.GetBarberBookings:output_type -> booking.BookingList
+	12,  // 241: booking.BookingService.GetAvailableTimeSlots:output_type -> booking.TimeSlotList
+	14,  // 242: booking.BookingService.CheckInBooking:output_type -> booking.Booking
+	14,  // 243: booking.BookingService.MarkNoShow:output_type -> booking.Booking
+	76,  // 244: booking.BookingService.GetUserReliability:output_type -> booking.UserReliability
+	79,  // 245: booking.BookingService.GetReliabilityReport:output_type -> booking.ReliabilityReport
+	81,  // 246: booking.BookingService.GetCustomerSummary:output_type -> booking.CustomerSummary
+	150, // 247: booking.BookingService.GetBookingHistory:output_type -> booking.BookingHistory
+	147, // 248: booking.BookingService.GetBookingICS:output_type -> booking.BookingICS
+	153, // 249: booking.BookingService.ListAuditLog:output_type -> booking.AuditLog
+	37,  // 250: booking.BookingService.AddBookingAttachment:output_type -> booking.AddBookingAttachmentResponse
+	14,  // 251: booking.BookingService.GetBookingByExternalRef:output_type -> booking.Booking
+	14,  // 252: booking.BookingService.RecordPOSCompletion:output_type -> booking.Booking
+	39,  // 253: booking.BookingService.SubmitSurveyResponse:output_type -> booking.SubmitSurveyResponseResponse
+	41,  // 254: booking.BookingService.GetBarberSurveyScores:output_type -> booking.SurveyScores
+	43,  // 255: booking.BookingService.SubmitReview:output_type -> booking.Review
+	45,  // 256: booking.BookingService.ListBarberReviews:output_type -> booking.ReviewList
+	47,  // 257: booking.BookingService.GetBarberRating:output_type -> booking.BarberRating
+	51,  // 258: booking.BookingService.GetBarberStats:output_type -> booking.BarberStats
+	54,  // 259: booking.BookingService.GetDemandHeatmap:output_type -> booking.DemandHeatmap
+	57,  // 260: booking.BookingService.GetDailyAgenda:output_type -> booking.DailyAgenda
+	35,  // 261: booking.BookingService.ExportPayroll:output_type -> booking.PayrollExport
+	35,  // 262: booking.BookingService.FinalizePayrollPeriod:output_type -> booking.PayrollExport
+	60,  // 263: booking.BookingService.GetRevenueReport:output_type -> booking.RevenueReport
+	61,  // 264: booking.BookingService.ExportRevenueReport:output_type -> booking.RevenueExport
+	63,  // 265: booking.BookingService.GetRetentionPolicy:output_type -> booking.RetentionPolicy
+	63,  // 266: booking.BookingService.UpdateRetentionPolicy:output_type -> booking.RetentionPolicy
+	66,  // 267: booking.BookingService.ExportUserData:output_type -> booking.UserDataExport
+	68,  // 268: booking.BookingService.EraseUserData:output_type -> booking.EraseUserDataResponse
+	71,  // 269: booking.BookingService.GetCancellationPolicy:output_type -> booking.CancellationPolicy
+	71,  // 270: booking.BookingService.UpdateCancellationPolicy:output_type -> booking.CancellationPolicy
+	90,  // 271: booking.BookingService.GetWorkingHours:output_type -> booking.BarberSchedule
+	90,  // 272: booking.BookingService.SetWorkingHours:output_type -> booking.BarberSchedule
+	94,  // 273: booking.BookingService.ListHolidays:output_type -> booking.HolidayList
+	93,  // 274: booking.BookingService.AddHoliday:output_type -> booking.Holiday
+	98,  // 275: booking.BookingService.RemoveHoliday:output_type -> booking.RemoveHolidayResponse
+	12,  // 276: booking.BookingService.GetNextAvailableSlot:output_type -> booking.TimeSlotList
+	102, // 277: booking.BookingService.GetAvailableTimeSlotsRange:output_type -> booking.DayTimeSlotsList
+	105, // 278: booking.BookingService.ListCatalogServices:output_type -> booking.CatalogServiceList
+	103, // 279: booking.BookingService.CreateCatalogService:output_type -> booking.CatalogService
+	103, // 280: booking.BookingService.UpdateCatalogService:output_type -> booking.CatalogService
+	109, // 281: booking.BookingService.DeleteCatalogService:output_type -> booking.DeleteCatalogServiceResponse
+	112, // 282: booking.BookingService.ListPromoCodes:output_type -> booking.PromoCodeList
+	110, // 283: booking.BookingService.GetPromoCode:output_type -> booking.PromoCode
+	110, // 284: booking.BookingService.CreatePromoCode:output_type -> booking.PromoCode
+	110, // 285: booking.BookingService.UpdatePromoCode:output_type -> booking.PromoCode
+	117, // 286: booking.BookingService.DeletePromoCode:output_type -> booking.DeletePromoCodeResponse
+	120, // 287: booking.BookingService.ListResources:output_type -> booking.ResourceList
+	118, // 288: booking.BookingService.CreateResource:output_type -> booking.Resource
+	118, // 289: booking.BookingService.UpdateResource:output_type -> booking.Resource
+	124, // 290: booking.BookingService.DeleteResource:output_type -> booking.DeleteResourceResponse
+	127, // 291: booking.BookingService.GetBarberServices:output_type -> booking.BarberServiceList
+	127, // 292: booking.BookingService.SetBarberServices:output_type -> booking.BarberServiceList
+	131, // 293: booking.BookingService.ListBarbers:output_type -> booking.BarberList
+	129, // 294: booking.BookingService.GetBarber:output_type -> booking.Barber
+	129, // 295: booking.BookingService.CreateBarber:output_type -> booking.Barber
+	129, // 296: booking.BookingService.UpdateBarber:output_type -> booking.Barber
+	136, // 297: booking.BookingService.DeleteBarber:output_type -> booking.DeleteBarberResponse
+	138, // 298: booking.BookingService.AddFavoriteBarber:output_type -> booking.FavoriteBarber
+	140, // 299: booking.BookingService.RemoveFavoriteBarber:output_type -> booking.RemoveFavoriteBarberResponse
+	142, // 300: booking.BookingService.ListFavorites:output_type -> booking.FavoriteBarberList
+	144, // 301: booking.BookingService.GetQuote:output_type -> booking.Quote
+	156, // 302: booking.BookingService.CreateWebhook:output_type -> booking.Webhook
+	159, // 303: booking.BookingService.ListWebhooks:output_type -> booking.WebhookList
+	161, // 304: booking.BookingService.DeleteWebhook:output_type -> booking.DeleteWebhookResponse
+	227, // [227:305] is the sub-list for method output_type
+	149, // [149:227] is the sub-list for method input_type
+	149, // [149:149] is the sub-list for extension type_name
+	149, // [149:149] is the sub-list for extension extendee
+	0,   // [0:149] is the sub-list for field type_name
 }
 
 func init() { file_pkg_api_proto_booking_proto_init() }
@@ -968,13 +11294,18 @@ func file_pkg_api_proto_booking_proto_init() {
 	if File_pkg_api_proto_booking_proto != nil {
 		return
 	}
+	file_pkg_api_proto_booking_proto_msgTypes[13].OneofWrappers = []any{}
+	file_pkg_api_proto_booking_proto_msgTypes[20].OneofWrappers = []any{}
+	file_pkg_api_proto_booking_proto_msgTypes[88].OneofWrappers = []any{}
+	file_pkg_api_proto_booking_proto_msgTypes[89].OneofWrappers = []any{}
+	file_pkg_api_proto_booking_proto_msgTypes[92].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_api_proto_booking_proto_rawDesc), len(file_pkg_api_proto_booking_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   12,
+			NumEnums:      11,
+			NumMessages:   151,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,20635 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: pkg/api/proto/booking.proto
+
+package generated
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TimeSlot with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeSlot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSlot with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeSlotMultiError, or nil
+// if none found.
+func (m *TimeSlot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSlot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	if all {
+		switch v := interface{}(m.GetStartTimeTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSlotValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSlotValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTimeTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSlotValidationError{
+				field:  "StartTimeTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTimeTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeSlotValidationError{
+					field:  "EndTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeSlotValidationError{
+					field:  "EndTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTimeTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeSlotValidationError{
+				field:  "EndTimeTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TimeSlotMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSlotMultiError is an error wrapping multiple validation errors returned
+// by TimeSlot.ValidateAll() if the designated constraints aren't met.
+type TimeSlotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSlotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSlotMultiError) AllErrors() []error { return m }
+
+// TimeSlotValidationError is the validation error returned by
+// TimeSlot.Validate if the designated constraints aren't met.
+type TimeSlotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSlotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSlotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSlotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSlotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSlotValidationError) ErrorName() string { return "TimeSlotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeSlotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSlot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSlotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSlotValidationError{}
+
+// Validate checks the field values on TimeSlotList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeSlotList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeSlotList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeSlotListMultiError, or
+// nil if none found.
+func (m *TimeSlotList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeSlotList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTimeSlots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TimeSlotListValidationError{
+						field:  fmt.Sprintf("TimeSlots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TimeSlotListValidationError{
+						field:  fmt.Sprintf("TimeSlots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TimeSlotListValidationError{
+					field:  fmt.Sprintf("TimeSlots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TimeSlotListMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeSlotListMultiError is an error wrapping multiple validation errors
+// returned by TimeSlotList.ValidateAll() if the designated constraints aren't met.
+type TimeSlotListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeSlotListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeSlotListMultiError) AllErrors() []error { return m }
+
+// TimeSlotListValidationError is the validation error returned by
+// TimeSlotList.Validate if the designated constraints aren't met.
+type TimeSlotListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeSlotListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeSlotListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeSlotListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeSlotListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeSlotListValidationError) ErrorName() string { return "TimeSlotListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeSlotListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeSlotList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeSlotListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeSlotListValidationError{}
+
+// Validate checks the field values on SlotUnavailableDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SlotUnavailableDetail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SlotUnavailableDetail with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SlotUnavailableDetailMultiError, or nil if none found.
+func (m *SlotUnavailableDetail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SlotUnavailableDetail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConflicts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SlotUnavailableDetailValidationError{
+						field:  fmt.Sprintf("Conflicts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SlotUnavailableDetailValidationError{
+						field:  fmt.Sprintf("Conflicts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SlotUnavailableDetailValidationError{
+					field:  fmt.Sprintf("Conflicts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAlternatives() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SlotUnavailableDetailValidationError{
+						field:  fmt.Sprintf("Alternatives[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SlotUnavailableDetailValidationError{
+						field:  fmt.Sprintf("Alternatives[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SlotUnavailableDetailValidationError{
+					field:  fmt.Sprintf("Alternatives[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SlotUnavailableDetailMultiError(errors)
+	}
+
+	return nil
+}
+
+// SlotUnavailableDetailMultiError is an error wrapping multiple validation
+// errors returned by SlotUnavailableDetail.ValidateAll() if the designated
+// constraints aren't met.
+type SlotUnavailableDetailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SlotUnavailableDetailMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SlotUnavailableDetailMultiError) AllErrors() []error { return m }
+
+// SlotUnavailableDetailValidationError is the validation error returned by
+// SlotUnavailableDetail.Validate if the designated constraints aren't met.
+type SlotUnavailableDetailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SlotUnavailableDetailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SlotUnavailableDetailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SlotUnavailableDetailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SlotUnavailableDetailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SlotUnavailableDetailValidationError) ErrorName() string {
+	return "SlotUnavailableDetailValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SlotUnavailableDetailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSlotUnavailableDetail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SlotUnavailableDetailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SlotUnavailableDetailValidationError{}
+
+// Validate checks the field values on Booking with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Booking) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Booking with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BookingMultiError, or nil if none found.
+func (m *Booking) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Booking) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for BarberId
+
+	// no validation rules for StartTime
+
+	// no validation rules for EndTime
+
+	// no validation rules for ServiceType
+
+	// no validation rules for Status
+
+	// no validation rules for Notes
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	// no validation rules for ExternalRef
+
+	if all {
+		switch v := interface{}(m.GetPayment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "Payment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "Payment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "Payment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookingValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookingValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookingValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CheckedInAt
+
+	// no validation rules for ReleasedAt
+
+	// no validation rules for RescheduledFrom
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetStartTimeTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTimeTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "StartTimeTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTimeTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "EndTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "EndTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTimeTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "EndTimeTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAtTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "CreatedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "CreatedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAtTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "CreatedAtTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAtTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "UpdatedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "UpdatedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAtTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "UpdatedAtTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCheckedInAtTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "CheckedInAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "CheckedInAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheckedInAtTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "CheckedInAtTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReleasedAtTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "ReleasedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "ReleasedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReleasedAtTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "ReleasedAtTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRescheduledFromTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "RescheduledFromTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "RescheduledFromTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRescheduledFromTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "RescheduledFromTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Price
+
+	// no validation rules for Currency
+
+	if all {
+		switch v := interface{}(m.GetDeposit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "Deposit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "Deposit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeposit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "Deposit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCancellation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "Cancellation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "Cancellation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCancellation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "Cancellation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReviewFlaggedAtTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "ReviewFlaggedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "ReviewFlaggedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReviewFlaggedAtTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "ReviewFlaggedAtTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHoldExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "HoldExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingValidationError{
+					field:  "HoldExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHoldExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingValidationError{
+				field:  "HoldExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PromoCode
+
+	// no validation rules for Discount
+
+	if len(errors) > 0 {
+		return BookingMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookingMultiError is an error wrapping multiple validation errors returned
+// by Booking.ValidateAll() if the designated constraints aren't met.
+type BookingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookingMultiError) AllErrors() []error { return m }
+
+// BookingValidationError is the validation error returned by Booking.Validate
+// if the designated constraints aren't met.
+type BookingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookingValidationError) ErrorName() string { return "BookingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBooking.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookingValidationError{}
+
+// Validate checks the field values on Cancellation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Cancellation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Cancellation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CancellationMultiError, or
+// nil if none found.
+func (m *Cancellation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Cancellation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCancelledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancellationValidationError{
+					field:  "CancelledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancellationValidationError{
+					field:  "CancelledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCancelledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancellationValidationError{
+				field:  "CancelledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CancelledBy
+
+	// no validation rules for Reason
+
+	// no validation rules for Fee
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return CancellationMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancellationMultiError is an error wrapping multiple validation errors
+// returned by Cancellation.ValidateAll() if the designated constraints aren't met.
+type CancellationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancellationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancellationMultiError) AllErrors() []error { return m }
+
+// CancellationValidationError is the validation error returned by
+// Cancellation.Validate if the designated constraints aren't met.
+type CancellationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancellationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancellationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancellationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancellationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancellationValidationError) ErrorName() string { return "CancellationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CancellationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancellation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancellationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancellationValidationError{}
+
+// Validate checks the field values on Deposit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Deposit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Deposit with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in DepositMultiError, or nil if none found.
+func (m *Deposit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Deposit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	// no validation rules for Status
+
+	// no validation rules for ClientSecret
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DepositValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DepositValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DepositValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPaidAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DepositValidationError{
+					field:  "PaidAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DepositValidationError{
+					field:  "PaidAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaidAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DepositValidationError{
+				field:  "PaidAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DepositMultiError(errors)
+	}
+
+	return nil
+}
+
+// DepositMultiError is an error wrapping multiple validation errors returned
+// by Deposit.ValidateAll() if the designated constraints aren't met.
+type DepositMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DepositMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DepositMultiError) AllErrors() []error { return m }
+
+// DepositValidationError is the validation error returned by Deposit.Validate
+// if the designated constraints aren't met.
+type DepositValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DepositValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DepositValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DepositValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DepositValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DepositValidationError) ErrorName() string { return "DepositValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DepositValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeposit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DepositValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DepositValidationError{}
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for ContentType
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreatedAtTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttachmentValidationError{
+					field:  "CreatedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttachmentValidationError{
+					field:  "CreatedAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAtTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttachmentValidationError{
+				field:  "CreatedAtTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on Payment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Payment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Payment with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PaymentMultiError, or nil if none found.
+func (m *Payment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Payment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	// no validation rules for Tip
+
+	// no validation rules for Currency
+
+	// no validation rules for PaidAt
+
+	if all {
+		switch v := interface{}(m.GetPaidAtTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "PaidAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentValidationError{
+					field:  "PaidAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaidAtTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentValidationError{
+				field:  "PaidAtTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PaymentMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentMultiError is an error wrapping multiple validation errors returned
+// by Payment.ValidateAll() if the designated constraints aren't met.
+type PaymentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentMultiError) AllErrors() []error { return m }
+
+// PaymentValidationError is the validation error returned by Payment.Validate
+// if the designated constraints aren't met.
+type PaymentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentValidationError) ErrorName() string { return "PaymentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentValidationError{}
+
+// Validate checks the field values on BookingList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookingList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookingList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookingListMultiError, or
+// nil if none found.
+func (m *BookingList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookingList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBookings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookingListValidationError{
+						field:  fmt.Sprintf("Bookings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookingListValidationError{
+						field:  fmt.Sprintf("Bookings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookingListValidationError{
+					field:  fmt.Sprintf("Bookings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BookingListMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookingListMultiError is an error wrapping multiple validation errors
+// returned by BookingList.ValidateAll() if the designated constraints aren't met.
+type BookingListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookingListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookingListMultiError) AllErrors() []error { return m }
+
+// BookingListValidationError is the validation error returned by
+// BookingList.Validate if the designated constraints aren't met.
+type BookingListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookingListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookingListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookingListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookingListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookingListValidationError) ErrorName() string { return "BookingListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookingListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookingList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookingListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookingListValidationError{}
+
+// Validate checks the field values on CreateBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBookingRequestMultiError, or nil if none found.
+func (m *CreateBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for BarberId
+
+	// no validation rules for StartTime
+
+	// no validation rules for ServiceType
+
+	// no validation rules for Notes
+
+	// no validation rules for ExternalRef
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 255 {
+		err := CreateBookingRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTimeTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBookingRequestValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBookingRequestValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTimeTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBookingRequestValidationError{
+				field:  "StartTimeTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HoldId
+
+	if utf8.RuneCountInString(m.GetPromoCode()) > 32 {
+		err := CreateBookingRequestValidationError{
+			field:  "PromoCode",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBookingRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBookingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBookingRequestMultiError) AllErrors() []error { return m }
+
+// CreateBookingRequestValidationError is the validation error returned by
+// CreateBookingRequest.Validate if the designated constraints aren't met.
+type CreateBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBookingRequestValidationError) ErrorName() string {
+	return "CreateBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBookingRequestValidationError{}
+
+// Validate checks the field values on HoldTimeSlotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HoldTimeSlotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HoldTimeSlotRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HoldTimeSlotRequestMultiError, or nil if none found.
+func (m *HoldTimeSlotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HoldTimeSlotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := HoldTimeSlotRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartTime() == nil {
+		err := HoldTimeSlotRequestValidationError{
+			field:  "StartTime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetServiceTypes()) < 1 {
+		err := HoldTimeSlotRequestValidationError{
+			field:  "ServiceTypes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetHoldMinutes(); val < 1 || val > 30 {
+		err := HoldTimeSlotRequestValidationError{
+			field:  "HoldMinutes",
+			reason: "value must be inside range [1, 30]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return HoldTimeSlotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// HoldTimeSlotRequestMultiError is an error wrapping multiple validation
+// errors returned by HoldTimeSlotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type HoldTimeSlotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HoldTimeSlotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HoldTimeSlotRequestMultiError) AllErrors() []error { return m }
+
+// HoldTimeSlotRequestValidationError is the validation error returned by
+// HoldTimeSlotRequest.Validate if the designated constraints aren't met.
+type HoldTimeSlotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HoldTimeSlotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HoldTimeSlotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HoldTimeSlotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HoldTimeSlotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HoldTimeSlotRequestValidationError) ErrorName() string {
+	return "HoldTimeSlotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HoldTimeSlotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHoldTimeSlotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HoldTimeSlotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HoldTimeSlotRequestValidationError{}
+
+// Validate checks the field values on RebookLastRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RebookLastRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebookLastRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RebookLastRequestMultiError, or nil if none found.
+func (m *RebookLastRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebookLastRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := RebookLastRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 255 {
+		err := RebookLastRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RebookLastRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RebookLastRequestMultiError is an error wrapping multiple validation errors
+// returned by RebookLastRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RebookLastRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebookLastRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RebookLastRequestMultiError) AllErrors() []error { return m }
+
+// RebookLastRequestValidationError is the validation error returned by
+// RebookLastRequest.Validate if the designated constraints aren't met.
+type RebookLastRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebookLastRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebookLastRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebookLastRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebookLastRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebookLastRequestValidationError) ErrorName() string {
+	return "RebookLastRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebookLastRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebookLastRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebookLastRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebookLastRequestValidationError{}
+
+// Validate checks the field values on GetBookingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookingRequestMultiError, or nil if none found.
+func (m *GetBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetBookingRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookingRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBookingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookingRequestMultiError) AllErrors() []error { return m }
+
+// GetBookingRequestValidationError is the validation error returned by
+// GetBookingRequest.Validate if the designated constraints aren't met.
+type GetBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookingRequestValidationError) ErrorName() string {
+	return "GetBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookingRequestValidationError{}
+
+// Validate checks the field values on UpdateBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBookingRequestMultiError, or nil if none found.
+func (m *UpdateBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := UpdateBookingRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StartTime
+
+	// no validation rules for ServiceType
+
+	// no validation rules for Notes
+
+	if all {
+		switch v := interface{}(m.GetStartTimeTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookingRequestValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookingRequestValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTimeTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookingRequestValidationError{
+				field:  "StartTimeTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBookingRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBookingRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBookingRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if len(errors) > 0 {
+		return UpdateBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBookingRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBookingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBookingRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBookingRequestValidationError is the validation error returned by
+// UpdateBookingRequest.Validate if the designated constraints aren't met.
+type UpdateBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBookingRequestValidationError) ErrorName() string {
+	return "UpdateBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBookingRequestValidationError{}
+
+// Validate checks the field values on CancelBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelBookingRequestMultiError, or nil if none found.
+func (m *CancelBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := CancelBookingRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 500 {
+		err := CancelBookingRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelBookingRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelBookingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelBookingRequestMultiError) AllErrors() []error { return m }
+
+// CancelBookingRequestValidationError is the validation error returned by
+// CancelBookingRequest.Validate if the designated constraints aren't met.
+type CancelBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelBookingRequestValidationError) ErrorName() string {
+	return "CancelBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelBookingRequestValidationError{}
+
+// Validate checks the field values on CancelBookingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelBookingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelBookingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelBookingResponseMultiError, or nil if none found.
+func (m *CancelBookingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelBookingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	// no validation rules for Fee
+
+	// no validation rules for Currency
+
+	if all {
+		switch v := interface{}(m.GetCancelledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelBookingResponseValidationError{
+					field:  "CancelledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelBookingResponseValidationError{
+					field:  "CancelledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCancelledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelBookingResponseValidationError{
+				field:  "CancelledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelBookingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelBookingResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelBookingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelBookingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelBookingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelBookingResponseMultiError) AllErrors() []error { return m }
+
+// CancelBookingResponseValidationError is the validation error returned by
+// CancelBookingResponse.Validate if the designated constraints aren't met.
+type CancelBookingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelBookingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelBookingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelBookingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelBookingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelBookingResponseValidationError) ErrorName() string {
+	return "CancelBookingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelBookingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelBookingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelBookingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelBookingResponseValidationError{}
+
+// Validate checks the field values on GetUserBookingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserBookingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserBookingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserBookingsRequestMultiError, or nil if none found.
+func (m *GetUserBookingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserBookingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return GetUserBookingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserBookingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserBookingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserBookingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserBookingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserBookingsRequestMultiError) AllErrors() []error { return m }
+
+// GetUserBookingsRequestValidationError is the validation error returned by
+// GetUserBookingsRequest.Validate if the designated constraints aren't met.
+type GetUserBookingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserBookingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserBookingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserBookingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserBookingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserBookingsRequestValidationError) ErrorName() string {
+	return "GetUserBookingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserBookingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserBookingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserBookingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserBookingsRequestValidationError{}
+
+// Validate checks the field values on CalendarDate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CalendarDate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalendarDate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CalendarDateMultiError, or
+// nil if none found.
+func (m *CalendarDate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalendarDate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Year
+
+	if val := m.GetMonth(); val < 1 || val > 12 {
+		err := CalendarDateValidationError{
+			field:  "Month",
+			reason: "value must be inside range [1, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetDay(); val < 1 || val > 31 {
+		err := CalendarDateValidationError{
+			field:  "Day",
+			reason: "value must be inside range [1, 31]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CalendarDateMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalendarDateMultiError is an error wrapping multiple validation errors
+// returned by CalendarDate.ValidateAll() if the designated constraints aren't met.
+type CalendarDateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalendarDateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalendarDateMultiError) AllErrors() []error { return m }
+
+// CalendarDateValidationError is the validation error returned by
+// CalendarDate.Validate if the designated constraints aren't met.
+type CalendarDateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalendarDateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalendarDateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalendarDateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalendarDateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalendarDateValidationError) ErrorName() string { return "CalendarDateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CalendarDateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalendarDate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalendarDateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalendarDateValidationError{}
+
+// Validate checks the field values on GetBarberBookingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBarberBookingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBarberBookingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBarberBookingsRequestMultiError, or nil if none found.
+func (m *GetBarberBookingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBarberBookingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if m.GetDate() != "" {
+
+		if !_GetBarberBookingsRequest_Date_Pattern.MatchString(m.GetDate()) {
+			err := GetBarberBookingsRequestValidationError{
+				field:  "Date",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBarberBookingsRequestValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBarberBookingsRequestValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBarberBookingsRequestValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	if len(errors) > 0 {
+		return GetBarberBookingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBarberBookingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBarberBookingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBarberBookingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBarberBookingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBarberBookingsRequestMultiError) AllErrors() []error { return m }
+
+// GetBarberBookingsRequestValidationError is the validation error returned by
+// GetBarberBookingsRequest.Validate if the designated constraints aren't met.
+type GetBarberBookingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBarberBookingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBarberBookingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBarberBookingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBarberBookingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBarberBookingsRequestValidationError) ErrorName() string {
+	return "GetBarberBookingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBarberBookingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBarberBookingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBarberBookingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBarberBookingsRequestValidationError{}
+
+var _GetBarberBookingsRequest_Date_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+// Validate checks the field values on GetBookingByExternalRefRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookingByExternalRefRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookingByExternalRefRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetBookingByExternalRefRequestMultiError, or nil if none found.
+func (m *GetBookingByExternalRefRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookingByExternalRefRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetExternalRef()) < 1 {
+		err := GetBookingByExternalRefRequestValidationError{
+			field:  "ExternalRef",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBookingByExternalRefRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookingByExternalRefRequestMultiError is an error wrapping multiple
+// validation errors returned by GetBookingByExternalRefRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetBookingByExternalRefRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookingByExternalRefRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookingByExternalRefRequestMultiError) AllErrors() []error { return m }
+
+// GetBookingByExternalRefRequestValidationError is the validation error
+// returned by GetBookingByExternalRefRequest.Validate if the designated
+// constraints aren't met.
+type GetBookingByExternalRefRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookingByExternalRefRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookingByExternalRefRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookingByExternalRefRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookingByExternalRefRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookingByExternalRefRequestValidationError) ErrorName() string {
+	return "GetBookingByExternalRefRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookingByExternalRefRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookingByExternalRefRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookingByExternalRefRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookingByExternalRefRequestValidationError{}
+
+// Validate checks the field values on GetAvailableTimeSlotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAvailableTimeSlotsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAvailableTimeSlotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAvailableTimeSlotsRequestMultiError, or nil if none found.
+func (m *GetAvailableTimeSlotsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAvailableTimeSlotsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if m.GetDate() != "" {
+
+		if !_GetAvailableTimeSlotsRequest_Date_Pattern.MatchString(m.GetDate()) {
+			err := GetAvailableTimeSlotsRequestValidationError{
+				field:  "Date",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAvailableTimeSlotsRequestValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAvailableTimeSlotsRequestValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAvailableTimeSlotsRequestValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	if m.ServiceType != nil {
+		// no validation rules for ServiceType
+	}
+
+	if len(errors) > 0 {
+		return GetAvailableTimeSlotsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAvailableTimeSlotsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetAvailableTimeSlotsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetAvailableTimeSlotsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAvailableTimeSlotsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAvailableTimeSlotsRequestMultiError) AllErrors() []error { return m }
+
+// GetAvailableTimeSlotsRequestValidationError is the validation error returned
+// by GetAvailableTimeSlotsRequest.Validate if the designated constraints
+// aren't met.
+type GetAvailableTimeSlotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAvailableTimeSlotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAvailableTimeSlotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAvailableTimeSlotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAvailableTimeSlotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAvailableTimeSlotsRequestValidationError) ErrorName() string {
+	return "GetAvailableTimeSlotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAvailableTimeSlotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAvailableTimeSlotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAvailableTimeSlotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAvailableTimeSlotsRequestValidationError{}
+
+var _GetAvailableTimeSlotsRequest_Date_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+// Validate checks the field values on RecordPOSCompletionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecordPOSCompletionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecordPOSCompletionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecordPOSCompletionRequestMultiError, or nil if none found.
+func (m *RecordPOSCompletionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecordPOSCompletionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookingId
+
+	// no validation rules for ExternalRef
+
+	if m.GetAmount() < 0 {
+		err := RecordPOSCompletionRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTip() < 0 {
+		err := RecordPOSCompletionRequestValidationError{
+			field:  "Tip",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCurrency()) != 3 {
+		err := RecordPOSCompletionRequestValidationError{
+			field:  "Currency",
+			reason: "value length must be 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	// no validation rules for PaidAt
+
+	if all {
+		switch v := interface{}(m.GetPaidAtTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecordPOSCompletionRequestValidationError{
+					field:  "PaidAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecordPOSCompletionRequestValidationError{
+					field:  "PaidAtTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaidAtTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecordPOSCompletionRequestValidationError{
+				field:  "PaidAtTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecordPOSCompletionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecordPOSCompletionRequestMultiError is an error wrapping multiple
+// validation errors returned by RecordPOSCompletionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RecordPOSCompletionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecordPOSCompletionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecordPOSCompletionRequestMultiError) AllErrors() []error { return m }
+
+// RecordPOSCompletionRequestValidationError is the validation error returned
+// by RecordPOSCompletionRequest.Validate if the designated constraints aren't met.
+type RecordPOSCompletionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecordPOSCompletionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecordPOSCompletionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecordPOSCompletionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecordPOSCompletionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecordPOSCompletionRequestValidationError) ErrorName() string {
+	return "RecordPOSCompletionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecordPOSCompletionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecordPOSCompletionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecordPOSCompletionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecordPOSCompletionRequestValidationError{}
+
+// Validate checks the field values on ExportPayrollRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportPayrollRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportPayrollRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportPayrollRequestMultiError, or nil if none found.
+func (m *ExportPayrollRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportPayrollRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetYear(); val < 2000 || val > 9999 {
+		err := ExportPayrollRequestValidationError{
+			field:  "Year",
+			reason: "value must be inside range [2000, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMonth(); val < 1 || val > 12 {
+		err := ExportPayrollRequestValidationError{
+			field:  "Month",
+			reason: "value must be inside range [1, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ExportFormat_name[int32(m.GetFormat())]; !ok {
+		err := ExportPayrollRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportPayrollRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportPayrollRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportPayrollRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportPayrollRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportPayrollRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportPayrollRequestMultiError) AllErrors() []error { return m }
+
+// ExportPayrollRequestValidationError is the validation error returned by
+// ExportPayrollRequest.Validate if the designated constraints aren't met.
+type ExportPayrollRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportPayrollRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportPayrollRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportPayrollRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportPayrollRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportPayrollRequestValidationError) ErrorName() string {
+	return "ExportPayrollRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportPayrollRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportPayrollRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportPayrollRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportPayrollRequestValidationError{}
+
+// Validate checks the field values on FinalizePayrollPeriodRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinalizePayrollPeriodRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinalizePayrollPeriodRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinalizePayrollPeriodRequestMultiError, or nil if none found.
+func (m *FinalizePayrollPeriodRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinalizePayrollPeriodRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetYear(); val < 2000 || val > 9999 {
+		err := FinalizePayrollPeriodRequestValidationError{
+			field:  "Year",
+			reason: "value must be inside range [2000, 9999]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetMonth(); val < 1 || val > 12 {
+		err := FinalizePayrollPeriodRequestValidationError{
+			field:  "Month",
+			reason: "value must be inside range [1, 12]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ExportFormat_name[int32(m.GetFormat())]; !ok {
+		err := FinalizePayrollPeriodRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FinalizePayrollPeriodRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinalizePayrollPeriodRequestMultiError is an error wrapping multiple
+// validation errors returned by FinalizePayrollPeriodRequest.ValidateAll() if
+// the designated constraints aren't met.
+type FinalizePayrollPeriodRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinalizePayrollPeriodRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinalizePayrollPeriodRequestMultiError) AllErrors() []error { return m }
+
+// FinalizePayrollPeriodRequestValidationError is the validation error returned
+// by FinalizePayrollPeriodRequest.Validate if the designated constraints
+// aren't met.
+type FinalizePayrollPeriodRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinalizePayrollPeriodRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinalizePayrollPeriodRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinalizePayrollPeriodRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinalizePayrollPeriodRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinalizePayrollPeriodRequestValidationError) ErrorName() string {
+	return "FinalizePayrollPeriodRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinalizePayrollPeriodRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinalizePayrollPeriodRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinalizePayrollPeriodRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinalizePayrollPeriodRequestValidationError{}
+
+// Validate checks the field values on PayrollExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PayrollExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PayrollExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PayrollExportMultiError, or
+// nil if none found.
+func (m *PayrollExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PayrollExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Period
+
+	// no validation rules for Finalized
+
+	// no validation rules for Filename
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return PayrollExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// PayrollExportMultiError is an error wrapping multiple validation errors
+// returned by PayrollExport.ValidateAll() if the designated constraints
+// aren't met.
+type PayrollExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PayrollExportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PayrollExportMultiError) AllErrors() []error { return m }
+
+// PayrollExportValidationError is the validation error returned by
+// PayrollExport.Validate if the designated constraints aren't met.
+type PayrollExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayrollExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayrollExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayrollExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayrollExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayrollExportValidationError) ErrorName() string { return "PayrollExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PayrollExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayrollExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayrollExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayrollExportValidationError{}
+
+// Validate checks the field values on AddBookingAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddBookingAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBookingAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBookingAttachmentRequestMultiError, or nil if none found.
+func (m *AddBookingAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBookingAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookingId()) < 1 {
+		err := AddBookingAttachmentRequestValidationError{
+			field:  "BookingId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Url
+
+	// no validation rules for ContentType
+
+	// no validation rules for SizeBytes
+
+	if len(errors) > 0 {
+		return AddBookingAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBookingAttachmentRequestMultiError is an error wrapping multiple
+// validation errors returned by AddBookingAttachmentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AddBookingAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBookingAttachmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBookingAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// AddBookingAttachmentRequestValidationError is the validation error returned
+// by AddBookingAttachmentRequest.Validate if the designated constraints
+// aren't met.
+type AddBookingAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBookingAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBookingAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBookingAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBookingAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBookingAttachmentRequestValidationError) ErrorName() string {
+	return "AddBookingAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBookingAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBookingAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBookingAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBookingAttachmentRequestValidationError{}
+
+// Validate checks the field values on AddBookingAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddBookingAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBookingAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBookingAttachmentResponseMultiError, or nil if none found.
+func (m *AddBookingAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBookingAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttachment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddBookingAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddBookingAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddBookingAttachmentResponseValidationError{
+				field:  "Attachment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UploadUrl
+
+	if len(errors) > 0 {
+		return AddBookingAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBookingAttachmentResponseMultiError is an error wrapping multiple
+// validation errors returned by AddBookingAttachmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AddBookingAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBookingAttachmentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBookingAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// AddBookingAttachmentResponseValidationError is the validation error returned
+// by AddBookingAttachmentResponse.Validate if the designated constraints
+// aren't met.
+type AddBookingAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBookingAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBookingAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBookingAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBookingAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBookingAttachmentResponseValidationError) ErrorName() string {
+	return "AddBookingAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBookingAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBookingAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBookingAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBookingAttachmentResponseValidationError{}
+
+// Validate checks the field values on SubmitSurveyResponseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitSurveyResponseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitSurveyResponseRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitSurveyResponseRequestMultiError, or nil if none found.
+func (m *SubmitSurveyResponseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitSurveyResponseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookingId()) < 1 {
+		err := SubmitSurveyResponseRequestValidationError{
+			field:  "BookingId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := SubmitSurveyResponseRequestValidationError{
+			field:  "Token",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetScore(); val < 1 || val > 5 {
+		err := SubmitSurveyResponseRequestValidationError{
+			field:  "Score",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 2000 {
+		err := SubmitSurveyResponseRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitSurveyResponseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitSurveyResponseRequestMultiError is an error wrapping multiple
+// validation errors returned by SubmitSurveyResponseRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SubmitSurveyResponseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitSurveyResponseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitSurveyResponseRequestMultiError) AllErrors() []error { return m }
+
+// SubmitSurveyResponseRequestValidationError is the validation error returned
+// by SubmitSurveyResponseRequest.Validate if the designated constraints
+// aren't met.
+type SubmitSurveyResponseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitSurveyResponseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitSurveyResponseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitSurveyResponseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitSurveyResponseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitSurveyResponseRequestValidationError) ErrorName() string {
+	return "SubmitSurveyResponseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitSurveyResponseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitSurveyResponseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitSurveyResponseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitSurveyResponseRequestValidationError{}
+
+// Validate checks the field values on SubmitSurveyResponseResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitSurveyResponseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitSurveyResponseResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitSurveyResponseResponseMultiError, or nil if none found.
+func (m *SubmitSurveyResponseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitSurveyResponseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return SubmitSurveyResponseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitSurveyResponseResponseMultiError is an error wrapping multiple
+// validation errors returned by SubmitSurveyResponseResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SubmitSurveyResponseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitSurveyResponseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitSurveyResponseResponseMultiError) AllErrors() []error { return m }
+
+// SubmitSurveyResponseResponseValidationError is the validation error returned
+// by SubmitSurveyResponseResponse.Validate if the designated constraints
+// aren't met.
+type SubmitSurveyResponseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitSurveyResponseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitSurveyResponseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitSurveyResponseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitSurveyResponseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitSurveyResponseResponseValidationError) ErrorName() string {
+	return "SubmitSurveyResponseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitSurveyResponseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitSurveyResponseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitSurveyResponseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitSurveyResponseResponseValidationError{}
+
+// Validate checks the field values on GetBarberSurveyScoresRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBarberSurveyScoresRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBarberSurveyScoresRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBarberSurveyScoresRequestMultiError, or nil if none found.
+func (m *GetBarberSurveyScoresRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBarberSurveyScoresRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if m.GetStartDate() != "" {
+
+		if !_GetBarberSurveyScoresRequest_StartDate_Pattern.MatchString(m.GetStartDate()) {
+			err := GetBarberSurveyScoresRequestValidationError{
+				field:  "StartDate",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndDate() != "" {
+
+		if !_GetBarberSurveyScoresRequest_EndDate_Pattern.MatchString(m.GetEndDate()) {
+			err := GetBarberSurveyScoresRequestValidationError{
+				field:  "EndDate",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetBarberSurveyScoresRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBarberSurveyScoresRequestMultiError is an error wrapping multiple
+// validation errors returned by GetBarberSurveyScoresRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetBarberSurveyScoresRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBarberSurveyScoresRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBarberSurveyScoresRequestMultiError) AllErrors() []error { return m }
+
+// GetBarberSurveyScoresRequestValidationError is the validation error returned
+// by GetBarberSurveyScoresRequest.Validate if the designated constraints
+// aren't met.
+type GetBarberSurveyScoresRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBarberSurveyScoresRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBarberSurveyScoresRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBarberSurveyScoresRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBarberSurveyScoresRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBarberSurveyScoresRequestValidationError) ErrorName() string {
+	return "GetBarberSurveyScoresRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBarberSurveyScoresRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBarberSurveyScoresRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBarberSurveyScoresRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBarberSurveyScoresRequestValidationError{}
+
+var _GetBarberSurveyScoresRequest_StartDate_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}$")
+
+var _GetBarberSurveyScoresRequest_EndDate_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}$")
+
+// Validate checks the field values on SurveyScores with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SurveyScores) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SurveyScores with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SurveyScoresMultiError, or
+// nil if none found.
+func (m *SurveyScores) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SurveyScores) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	// no validation rules for Responses
+
+	// no validation rules for AverageScore
+
+	if len(errors) > 0 {
+		return SurveyScoresMultiError(errors)
+	}
+
+	return nil
+}
+
+// SurveyScoresMultiError is an error wrapping multiple validation errors
+// returned by SurveyScores.ValidateAll() if the designated constraints aren't met.
+type SurveyScoresMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SurveyScoresMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SurveyScoresMultiError) AllErrors() []error { return m }
+
+// SurveyScoresValidationError is the validation error returned by
+// SurveyScores.Validate if the designated constraints aren't met.
+type SurveyScoresValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SurveyScoresValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SurveyScoresValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SurveyScoresValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SurveyScoresValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SurveyScoresValidationError) ErrorName() string { return "SurveyScoresValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SurveyScoresValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSurveyScores.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SurveyScoresValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SurveyScoresValidationError{}
+
+// Validate checks the field values on SubmitReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitReviewRequestMultiError, or nil if none found.
+func (m *SubmitReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookingId()) < 1 {
+		err := SubmitReviewRequestValidationError{
+			field:  "BookingId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetRating(); val < 1 || val > 5 {
+		err := SubmitReviewRequestValidationError{
+			field:  "Rating",
+			reason: "value must be inside range [1, 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 2000 {
+		err := SubmitReviewRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubmitReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitReviewRequestMultiError) AllErrors() []error { return m }
+
+// SubmitReviewRequestValidationError is the validation error returned by
+// SubmitReviewRequest.Validate if the designated constraints aren't met.
+type SubmitReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitReviewRequestValidationError) ErrorName() string {
+	return "SubmitReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitReviewRequestValidationError{}
+
+// Validate checks the field values on Review with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Review) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Review with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReviewMultiError, or nil if none found.
+func (m *Review) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Review) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for BookingId
+
+	// no validation rules for UserId
+
+	// no validation rules for BarberId
+
+	// no validation rules for Rating
+
+	// no validation rules for Comment
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewMultiError is an error wrapping multiple validation errors returned by
+// Review.ValidateAll() if the designated constraints aren't met.
+type ReviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewMultiError) AllErrors() []error { return m }
+
+// ReviewValidationError is the validation error returned by Review.Validate if
+// the designated constraints aren't met.
+type ReviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewValidationError) ErrorName() string { return "ReviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewValidationError{}
+
+// Validate checks the field values on ListBarberReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBarberReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBarberReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBarberReviewsRequestMultiError, or nil if none found.
+func (m *ListBarberReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBarberReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := ListBarberReviewsRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListBarberReviewsRequestValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListBarberReviewsRequestValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListBarberReviewsRequestValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListBarberReviewsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListBarberReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBarberReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBarberReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBarberReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBarberReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBarberReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListBarberReviewsRequestValidationError is the validation error returned by
+// ListBarberReviewsRequest.Validate if the designated constraints aren't met.
+type ListBarberReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBarberReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBarberReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBarberReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBarberReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBarberReviewsRequestValidationError) ErrorName() string {
+	return "ListBarberReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBarberReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBarberReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBarberReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBarberReviewsRequestValidationError{}
+
+// Validate checks the field values on ReviewList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewListMultiError, or
+// nil if none found.
+func (m *ReviewList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReviewListValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReviewListValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReviewListValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewListValidationError{
+					field:  "NextBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewListValidationError{
+					field:  "NextBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewListValidationError{
+				field:  "NextBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReviewListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewListMultiError is an error wrapping multiple validation errors
+// returned by ReviewList.ValidateAll() if the designated constraints aren't met.
+type ReviewListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewListMultiError) AllErrors() []error { return m }
+
+// ReviewListValidationError is the validation error returned by
+// ReviewList.Validate if the designated constraints aren't met.
+type ReviewListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewListValidationError) ErrorName() string { return "ReviewListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewListValidationError{}
+
+// Validate checks the field values on GetBarberRatingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBarberRatingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBarberRatingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBarberRatingRequestMultiError, or nil if none found.
+func (m *GetBarberRatingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBarberRatingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := GetBarberRatingRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBarberRatingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBarberRatingRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBarberRatingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBarberRatingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBarberRatingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBarberRatingRequestMultiError) AllErrors() []error { return m }
+
+// GetBarberRatingRequestValidationError is the validation error returned by
+// GetBarberRatingRequest.Validate if the designated constraints aren't met.
+type GetBarberRatingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBarberRatingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBarberRatingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBarberRatingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBarberRatingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBarberRatingRequestValidationError) ErrorName() string {
+	return "GetBarberRatingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBarberRatingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBarberRatingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBarberRatingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBarberRatingRequestValidationError{}
+
+// Validate checks the field values on BarberRating with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BarberRating) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BarberRating with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BarberRatingMultiError, or
+// nil if none found.
+func (m *BarberRating) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BarberRating) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	// no validation rules for Reviews
+
+	// no validation rules for AverageRating
+
+	if len(errors) > 0 {
+		return BarberRatingMultiError(errors)
+	}
+
+	return nil
+}
+
+// BarberRatingMultiError is an error wrapping multiple validation errors
+// returned by BarberRating.ValidateAll() if the designated constraints aren't met.
+type BarberRatingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BarberRatingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BarberRatingMultiError) AllErrors() []error { return m }
+
+// BarberRatingValidationError is the validation error returned by
+// BarberRating.Validate if the designated constraints aren't met.
+type BarberRatingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BarberRatingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BarberRatingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BarberRatingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BarberRatingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BarberRatingValidationError) ErrorName() string { return "BarberRatingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BarberRatingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBarberRating.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BarberRatingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BarberRatingValidationError{}
+
+// Validate checks the field values on GetBarberStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBarberStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBarberStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBarberStatsRequestMultiError, or nil if none found.
+func (m *GetBarberStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBarberStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := GetBarberStatsRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := StatsPeriod_name[int32(m.GetPeriod())]; !ok {
+		err := GetBarberStatsRequestValidationError{
+			field:  "Period",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBarberStatsRequestValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBarberStatsRequestValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBarberStatsRequestValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBarberStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBarberStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBarberStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBarberStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBarberStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBarberStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetBarberStatsRequestValidationError is the validation error returned by
+// GetBarberStatsRequest.Validate if the designated constraints aren't met.
+type GetBarberStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBarberStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBarberStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBarberStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBarberStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBarberStatsRequestValidationError) ErrorName() string {
+	return "GetBarberStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBarberStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBarberStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBarberStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBarberStatsRequestValidationError{}
+
+// Validate checks the field values on StatusCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusCountMultiError, or
+// nil if none found.
+func (m *StatusCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return StatusCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusCountMultiError is an error wrapping multiple validation errors
+// returned by StatusCount.ValidateAll() if the designated constraints aren't met.
+type StatusCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusCountMultiError) AllErrors() []error { return m }
+
+// StatusCountValidationError is the validation error returned by
+// StatusCount.Validate if the designated constraints aren't met.
+type StatusCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusCountValidationError) ErrorName() string { return "StatusCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusCountValidationError{}
+
+// Validate checks the field values on DayStats with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DayStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DayStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DayStatsMultiError, or nil
+// if none found.
+func (m *DayStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DayStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DayStatsValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DayStatsValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DayStatsValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Bookings
+
+	// no validation rules for BookedHours
+
+	if len(errors) > 0 {
+		return DayStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DayStatsMultiError is an error wrapping multiple validation errors returned
+// by DayStats.ValidateAll() if the designated constraints aren't met.
+type DayStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DayStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DayStatsMultiError) AllErrors() []error { return m }
+
+// DayStatsValidationError is the validation error returned by
+// DayStats.Validate if the designated constraints aren't met.
+type DayStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DayStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DayStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DayStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DayStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DayStatsValidationError) ErrorName() string { return "DayStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DayStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDayStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DayStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DayStatsValidationError{}
+
+// Validate checks the field values on BarberStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BarberStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BarberStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BarberStatsMultiError, or
+// nil if none found.
+func (m *BarberStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BarberStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if all {
+		switch v := interface{}(m.GetStartDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BarberStatsValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BarberStatsValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BarberStatsValidationError{
+				field:  "StartDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BarberStatsValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BarberStatsValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BarberStatsValidationError{
+				field:  "EndDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetStatusCounts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BarberStatsValidationError{
+						field:  fmt.Sprintf("StatusCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BarberStatsValidationError{
+						field:  fmt.Sprintf("StatusCounts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BarberStatsValidationError{
+					field:  fmt.Sprintf("StatusCounts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Bookings
+
+	// no validation rules for BookedHours
+
+	// no validation rules for WorkingHours
+
+	// no validation rules for Utilization
+
+	for idx, item := range m.GetBusiestDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BarberStatsValidationError{
+						field:  fmt.Sprintf("BusiestDays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BarberStatsValidationError{
+						field:  fmt.Sprintf("BusiestDays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BarberStatsValidationError{
+					field:  fmt.Sprintf("BusiestDays[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BarberStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// BarberStatsMultiError is an error wrapping multiple validation errors
+// returned by BarberStats.ValidateAll() if the designated constraints aren't met.
+type BarberStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BarberStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BarberStatsMultiError) AllErrors() []error { return m }
+
+// BarberStatsValidationError is the validation error returned by
+// BarberStats.Validate if the designated constraints aren't met.
+type BarberStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BarberStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BarberStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BarberStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BarberStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BarberStatsValidationError) ErrorName() string { return "BarberStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BarberStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBarberStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BarberStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BarberStatsValidationError{}
+
+// Validate checks the field values on GetDemandHeatmapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDemandHeatmapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDemandHeatmapRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDemandHeatmapRequestMultiError, or nil if none found.
+func (m *GetDemandHeatmapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDemandHeatmapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if val := m.GetWeeks(); val < 0 || val > 52 {
+		err := GetDemandHeatmapRequestValidationError{
+			field:  "Weeks",
+			reason: "value must be inside range [0, 52]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDemandHeatmapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDemandHeatmapRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDemandHeatmapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDemandHeatmapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDemandHeatmapRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDemandHeatmapRequestMultiError) AllErrors() []error { return m }
+
+// GetDemandHeatmapRequestValidationError is the validation error returned by
+// GetDemandHeatmapRequest.Validate if the designated constraints aren't met.
+type GetDemandHeatmapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDemandHeatmapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDemandHeatmapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDemandHeatmapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDemandHeatmapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDemandHeatmapRequestValidationError) ErrorName() string {
+	return "GetDemandHeatmapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDemandHeatmapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDemandHeatmapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDemandHeatmapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDemandHeatmapRequestValidationError{}
+
+// Validate checks the field values on HeatmapCell with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HeatmapCell) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HeatmapCell with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HeatmapCellMultiError, or
+// nil if none found.
+func (m *HeatmapCell) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HeatmapCell) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Weekday
+
+	// no validation rules for Hour
+
+	// no validation rules for Bookings
+
+	// no validation rules for WeeklyAverage
+
+	if len(errors) > 0 {
+		return HeatmapCellMultiError(errors)
+	}
+
+	return nil
+}
+
+// HeatmapCellMultiError is an error wrapping multiple validation errors
+// returned by HeatmapCell.ValidateAll() if the designated constraints aren't met.
+type HeatmapCellMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HeatmapCellMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HeatmapCellMultiError) AllErrors() []error { return m }
+
+// HeatmapCellValidationError is the validation error returned by
+// HeatmapCell.Validate if the designated constraints aren't met.
+type HeatmapCellValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HeatmapCellValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HeatmapCellValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HeatmapCellValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HeatmapCellValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HeatmapCellValidationError) ErrorName() string { return "HeatmapCellValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HeatmapCellValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHeatmapCell.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HeatmapCellValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HeatmapCellValidationError{}
+
+// Validate checks the field values on DemandHeatmap with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DemandHeatmap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DemandHeatmap with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DemandHeatmapMultiError, or
+// nil if none found.
+func (m *DemandHeatmap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DemandHeatmap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if all {
+		switch v := interface{}(m.GetStartDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DemandHeatmapValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DemandHeatmapValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DemandHeatmapValidationError{
+				field:  "StartDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DemandHeatmapValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DemandHeatmapValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DemandHeatmapValidationError{
+				field:  "EndDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Weeks
+
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetCells() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DemandHeatmapValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DemandHeatmapValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DemandHeatmapValidationError{
+					field:  fmt.Sprintf("Cells[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetGeneratedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DemandHeatmapValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DemandHeatmapValidationError{
+					field:  "GeneratedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGeneratedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DemandHeatmapValidationError{
+				field:  "GeneratedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DemandHeatmapMultiError(errors)
+	}
+
+	return nil
+}
+
+// DemandHeatmapMultiError is an error wrapping multiple validation errors
+// returned by DemandHeatmap.ValidateAll() if the designated constraints
+// aren't met.
+type DemandHeatmapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DemandHeatmapMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DemandHeatmapMultiError) AllErrors() []error { return m }
+
+// DemandHeatmapValidationError is the validation error returned by
+// DemandHeatmap.Validate if the designated constraints aren't met.
+type DemandHeatmapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DemandHeatmapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DemandHeatmapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DemandHeatmapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DemandHeatmapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DemandHeatmapValidationError) ErrorName() string { return "DemandHeatmapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DemandHeatmapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDemandHeatmap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DemandHeatmapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DemandHeatmapValidationError{}
+
+// Validate checks the field values on GetDailyAgendaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDailyAgendaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDailyAgendaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDailyAgendaRequestMultiError, or nil if none found.
+func (m *GetDailyAgendaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDailyAgendaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := GetDailyAgendaRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetDailyAgendaRequestValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetDailyAgendaRequestValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetDailyAgendaRequestValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetDailyAgendaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDailyAgendaRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDailyAgendaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDailyAgendaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDailyAgendaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDailyAgendaRequestMultiError) AllErrors() []error { return m }
+
+// GetDailyAgendaRequestValidationError is the validation error returned by
+// GetDailyAgendaRequest.Validate if the designated constraints aren't met.
+type GetDailyAgendaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDailyAgendaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDailyAgendaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDailyAgendaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDailyAgendaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDailyAgendaRequestValidationError) ErrorName() string {
+	return "GetDailyAgendaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDailyAgendaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDailyAgendaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDailyAgendaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDailyAgendaRequestValidationError{}
+
+// Validate checks the field values on AgendaGap with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AgendaGap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgendaGap with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AgendaGapMultiError, or nil
+// if none found.
+func (m *AgendaGap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgendaGap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgendaGapValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgendaGapValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgendaGapValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgendaGapValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgendaGapValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgendaGapValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AgendaGapMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgendaGapMultiError is an error wrapping multiple validation errors returned
+// by AgendaGap.ValidateAll() if the designated constraints aren't met.
+type AgendaGapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgendaGapMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgendaGapMultiError) AllErrors() []error { return m }
+
+// AgendaGapValidationError is the validation error returned by
+// AgendaGap.Validate if the designated constraints aren't met.
+type AgendaGapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgendaGapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgendaGapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgendaGapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgendaGapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgendaGapValidationError) ErrorName() string { return "AgendaGapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AgendaGapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgendaGap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgendaGapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgendaGapValidationError{}
+
+// Validate checks the field values on DailyAgenda with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DailyAgenda) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DailyAgenda with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DailyAgendaMultiError, or
+// nil if none found.
+func (m *DailyAgenda) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DailyAgenda) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DailyAgendaValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DailyAgendaValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DailyAgendaValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetBookings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DailyAgendaValidationError{
+						field:  fmt.Sprintf("Bookings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DailyAgendaValidationError{
+						field:  fmt.Sprintf("Bookings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DailyAgendaValidationError{
+					field:  fmt.Sprintf("Bookings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetGaps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DailyAgendaValidationError{
+						field:  fmt.Sprintf("Gaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DailyAgendaValidationError{
+						field:  fmt.Sprintf("Gaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DailyAgendaValidationError{
+					field:  fmt.Sprintf("Gaps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DailyAgendaMultiError(errors)
+	}
+
+	return nil
+}
+
+// DailyAgendaMultiError is an error wrapping multiple validation errors
+// returned by DailyAgenda.ValidateAll() if the designated constraints aren't met.
+type DailyAgendaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DailyAgendaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DailyAgendaMultiError) AllErrors() []error { return m }
+
+// DailyAgendaValidationError is the validation error returned by
+// DailyAgenda.Validate if the designated constraints aren't met.
+type DailyAgendaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DailyAgendaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DailyAgendaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DailyAgendaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DailyAgendaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DailyAgendaValidationError) ErrorName() string { return "DailyAgendaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DailyAgendaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDailyAgenda.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DailyAgendaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DailyAgendaValidationError{}
+
+// Validate checks the field values on GetRevenueReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRevenueReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRevenueReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRevenueReportRequestMultiError, or nil if none found.
+func (m *GetRevenueReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRevenueReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if m.GetStartDay() == nil {
+		err := GetRevenueReportRequestValidationError{
+			field:  "StartDay",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRevenueReportRequestValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRevenueReportRequestValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRevenueReportRequestValidationError{
+				field:  "StartDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEndDay() == nil {
+		err := GetRevenueReportRequestValidationError{
+			field:  "EndDay",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRevenueReportRequestValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRevenueReportRequestValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRevenueReportRequestValidationError{
+				field:  "EndDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := ReportGranularity_name[int32(m.GetGranularity())]; !ok {
+		err := GetRevenueReportRequestValidationError{
+			field:  "Granularity",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRevenueReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRevenueReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRevenueReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRevenueReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRevenueReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRevenueReportRequestMultiError) AllErrors() []error { return m }
+
+// GetRevenueReportRequestValidationError is the validation error returned by
+// GetRevenueReportRequest.Validate if the designated constraints aren't met.
+type GetRevenueReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRevenueReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRevenueReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRevenueReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRevenueReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRevenueReportRequestValidationError) ErrorName() string {
+	return "GetRevenueReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRevenueReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRevenueReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRevenueReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRevenueReportRequestValidationError{}
+
+// Validate checks the field values on RevenueLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevenueLine) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevenueLine with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevenueLineMultiError, or
+// nil if none found.
+func (m *RevenueLine) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevenueLine) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPeriodStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevenueLineValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevenueLineValidationError{
+					field:  "PeriodStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevenueLineValidationError{
+				field:  "PeriodStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BarberId
+
+	// no validation rules for ServiceType
+
+	// no validation rules for Bookings
+
+	// no validation rules for Revenue
+
+	// no validation rules for Tips
+
+	if len(errors) > 0 {
+		return RevenueLineMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevenueLineMultiError is an error wrapping multiple validation errors
+// returned by RevenueLine.ValidateAll() if the designated constraints aren't met.
+type RevenueLineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevenueLineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevenueLineMultiError) AllErrors() []error { return m }
+
+// RevenueLineValidationError is the validation error returned by
+// RevenueLine.Validate if the designated constraints aren't met.
+type RevenueLineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevenueLineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevenueLineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevenueLineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevenueLineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevenueLineValidationError) ErrorName() string { return "RevenueLineValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevenueLineValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevenueLine.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevenueLineValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevenueLineValidationError{}
+
+// Validate checks the field values on RevenueReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevenueReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevenueReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevenueReportMultiError, or
+// nil if none found.
+func (m *RevenueReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevenueReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevenueReportValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevenueReportValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevenueReportValidationError{
+				field:  "StartDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RevenueReportValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RevenueReportValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RevenueReportValidationError{
+				field:  "EndDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Granularity
+
+	// no validation rules for Currency
+
+	for idx, item := range m.GetLines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RevenueReportValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RevenueReportValidationError{
+						field:  fmt.Sprintf("Lines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RevenueReportValidationError{
+					field:  fmt.Sprintf("Lines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Bookings
+
+	// no validation rules for Revenue
+
+	// no validation rules for Tips
+
+	if len(errors) > 0 {
+		return RevenueReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevenueReportMultiError is an error wrapping multiple validation errors
+// returned by RevenueReport.ValidateAll() if the designated constraints
+// aren't met.
+type RevenueReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevenueReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevenueReportMultiError) AllErrors() []error { return m }
+
+// RevenueReportValidationError is the validation error returned by
+// RevenueReport.Validate if the designated constraints aren't met.
+type RevenueReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevenueReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevenueReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevenueReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevenueReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevenueReportValidationError) ErrorName() string { return "RevenueReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevenueReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevenueReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevenueReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevenueReportValidationError{}
+
+// Validate checks the field values on RevenueExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RevenueExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevenueExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RevenueExportMultiError, or
+// nil if none found.
+func (m *RevenueExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevenueExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Filename
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return RevenueExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevenueExportMultiError is an error wrapping multiple validation errors
+// returned by RevenueExport.ValidateAll() if the designated constraints
+// aren't met.
+type RevenueExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevenueExportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevenueExportMultiError) AllErrors() []error { return m }
+
+// RevenueExportValidationError is the validation error returned by
+// RevenueExport.Validate if the designated constraints aren't met.
+type RevenueExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevenueExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevenueExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevenueExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevenueExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevenueExportValidationError) ErrorName() string { return "RevenueExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RevenueExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevenueExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevenueExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevenueExportValidationError{}
+
+// Validate checks the field values on GetRetentionPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRetentionPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRetentionPolicyRequestMultiError, or nil if none found.
+func (m *GetRetentionPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRetentionPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetRetentionPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRetentionPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRetentionPolicyRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetRetentionPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRetentionPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRetentionPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetRetentionPolicyRequestValidationError is the validation error returned by
+// GetRetentionPolicyRequest.Validate if the designated constraints aren't met.
+type GetRetentionPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRetentionPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRetentionPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRetentionPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRetentionPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRetentionPolicyRequestValidationError) ErrorName() string {
+	return "GetRetentionPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRetentionPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRetentionPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRetentionPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRetentionPolicyRequestValidationError{}
+
+// Validate checks the field values on RetentionPolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetentionPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetentionPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetentionPolicyMultiError, or nil if none found.
+func (m *RetentionPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetentionPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCompletedDays() < 0 {
+		err := RetentionPolicyValidationError{
+			field:  "CompletedDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCancelledDays() < 0 {
+		err := RetentionPolicyValidationError{
+			field:  "CancelledDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetNoShowDays() < 0 {
+		err := RetentionPolicyValidationError{
+			field:  "NoShowDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RetentionMode_name[int32(m.GetMode())]; !ok {
+		err := RetentionPolicyValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAuditDays() < 0 {
+		err := RetentionPolicyValidationError{
+			field:  "AuditDays",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RetentionPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetentionPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetentionPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type RetentionPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetentionPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetentionPolicyMultiError) AllErrors() []error { return m }
+
+// RetentionPolicyValidationError is the validation error returned by
+// RetentionPolicy.Validate if the designated constraints aren't met.
+type RetentionPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetentionPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetentionPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetentionPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetentionPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetentionPolicyValidationError) ErrorName() string { return "RetentionPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetentionPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetentionPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetentionPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetentionPolicyValidationError{}
+
+// Validate checks the field values on UpdateRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRetentionPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRetentionPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRetentionPolicyRequestMultiError, or nil if none found.
+func (m *UpdateRetentionPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRetentionPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPolicy() == nil {
+		err := UpdateRetentionPolicyRequestValidationError{
+			field:  "Policy",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRetentionPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRetentionPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRetentionPolicyRequestValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRetentionPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRetentionPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateRetentionPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateRetentionPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRetentionPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRetentionPolicyRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRetentionPolicyRequestValidationError is the validation error returned
+// by UpdateRetentionPolicyRequest.Validate if the designated constraints
+// aren't met.
+type UpdateRetentionPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRetentionPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRetentionPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRetentionPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRetentionPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRetentionPolicyRequestValidationError) ErrorName() string {
+	return "UpdateRetentionPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRetentionPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRetentionPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRetentionPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRetentionPolicyRequestValidationError{}
+
+// Validate checks the field values on ExportUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUserDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUserDataRequestMultiError, or nil if none found.
+func (m *ExportUserDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUserDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := ExportUserDataRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportUserDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUserDataRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportUserDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportUserDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUserDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUserDataRequestMultiError) AllErrors() []error { return m }
+
+// ExportUserDataRequestValidationError is the validation error returned by
+// ExportUserDataRequest.Validate if the designated constraints aren't met.
+type ExportUserDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUserDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUserDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUserDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUserDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUserDataRequestValidationError) ErrorName() string {
+	return "ExportUserDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUserDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUserDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUserDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUserDataRequestValidationError{}
+
+// Validate checks the field values on UserDataExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserDataExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserDataExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserDataExportMultiError,
+// or nil if none found.
+func (m *UserDataExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserDataExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Filename
+
+	// no validation rules for ContentType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return UserDataExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserDataExportMultiError is an error wrapping multiple validation errors
+// returned by UserDataExport.ValidateAll() if the designated constraints
+// aren't met.
+type UserDataExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserDataExportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserDataExportMultiError) AllErrors() []error { return m }
+
+// UserDataExportValidationError is the validation error returned by
+// UserDataExport.Validate if the designated constraints aren't met.
+type UserDataExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserDataExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserDataExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserDataExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserDataExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserDataExportValidationError) ErrorName() string { return "UserDataExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserDataExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserDataExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserDataExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserDataExportValidationError{}
+
+// Validate checks the field values on EraseUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUserDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUserDataRequestMultiError, or nil if none found.
+func (m *EraseUserDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := EraseUserDataRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RetentionMode_name[int32(m.GetMode())]; !ok {
+		err := EraseUserDataRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EraseUserDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUserDataRequestMultiError is an error wrapping multiple validation
+// errors returned by EraseUserDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EraseUserDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserDataRequestMultiError) AllErrors() []error { return m }
+
+// EraseUserDataRequestValidationError is the validation error returned by
+// EraseUserDataRequest.Validate if the designated constraints aren't met.
+type EraseUserDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserDataRequestValidationError) ErrorName() string {
+	return "EraseUserDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUserDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserDataRequestValidationError{}
+
+// Validate checks the field values on EraseUserDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EraseUserDataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EraseUserDataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EraseUserDataResponseMultiError, or nil if none found.
+func (m *EraseUserDataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EraseUserDataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookingsAnonymized
+
+	// no validation rules for BookingsDeleted
+
+	// no validation rules for SurveysErased
+
+	// no validation rules for AuditEntriesErased
+
+	// no validation rules for ReviewsErased
+
+	// no validation rules for FavoritesErased
+
+	if len(errors) > 0 {
+		return EraseUserDataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EraseUserDataResponseMultiError is an error wrapping multiple validation
+// errors returned by EraseUserDataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EraseUserDataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EraseUserDataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EraseUserDataResponseMultiError) AllErrors() []error { return m }
+
+// EraseUserDataResponseValidationError is the validation error returned by
+// EraseUserDataResponse.Validate if the designated constraints aren't met.
+type EraseUserDataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EraseUserDataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EraseUserDataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EraseUserDataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EraseUserDataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EraseUserDataResponseValidationError) ErrorName() string {
+	return "EraseUserDataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EraseUserDataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEraseUserDataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EraseUserDataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EraseUserDataResponseValidationError{}
+
+// Validate checks the field values on GetCancellationPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCancellationPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCancellationPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCancellationPolicyRequestMultiError, or nil if none found.
+func (m *GetCancellationPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCancellationPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCancellationPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCancellationPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by GetCancellationPolicyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetCancellationPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCancellationPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCancellationPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetCancellationPolicyRequestValidationError is the validation error returned
+// by GetCancellationPolicyRequest.Validate if the designated constraints
+// aren't met.
+type GetCancellationPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCancellationPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCancellationPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCancellationPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCancellationPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCancellationPolicyRequestValidationError) ErrorName() string {
+	return "GetCancellationPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCancellationPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCancellationPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCancellationPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCancellationPolicyRequestValidationError{}
+
+// Validate checks the field values on CancellationRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CancellationRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancellationRule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancellationRuleMultiError, or nil if none found.
+func (m *CancellationRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancellationRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWithinMinutes() <= 0 {
+		err := CancellationRuleValidationError{
+			field:  "WithinMinutes",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetFeePercent(); val < 0 || val > 100 {
+		err := CancellationRuleValidationError{
+			field:  "FeePercent",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Forbidden
+
+	if len(errors) > 0 {
+		return CancellationRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancellationRuleMultiError is an error wrapping multiple validation errors
+// returned by CancellationRule.ValidateAll() if the designated constraints
+// aren't met.
+type CancellationRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancellationRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancellationRuleMultiError) AllErrors() []error { return m }
+
+// CancellationRuleValidationError is the validation error returned by
+// CancellationRule.Validate if the designated constraints aren't met.
+type CancellationRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancellationRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancellationRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancellationRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancellationRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancellationRuleValidationError) ErrorName() string { return "CancellationRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CancellationRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancellationRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancellationRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancellationRuleValidationError{}
+
+// Validate checks the field values on CancellationPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancellationPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancellationPolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancellationPolicyMultiError, or nil if none found.
+func (m *CancellationPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancellationPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CancellationPolicyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CancellationPolicyValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CancellationPolicyValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CancellationPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancellationPolicyMultiError is an error wrapping multiple validation errors
+// returned by CancellationPolicy.ValidateAll() if the designated constraints
+// aren't met.
+type CancellationPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancellationPolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancellationPolicyMultiError) AllErrors() []error { return m }
+
+// CancellationPolicyValidationError is the validation error returned by
+// CancellationPolicy.Validate if the designated constraints aren't met.
+type CancellationPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancellationPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancellationPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancellationPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancellationPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancellationPolicyValidationError) ErrorName() string {
+	return "CancellationPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancellationPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancellationPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancellationPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancellationPolicyValidationError{}
+
+// Validate checks the field values on UpdateCancellationPolicyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCancellationPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCancellationPolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateCancellationPolicyRequestMultiError, or nil if none found.
+func (m *UpdateCancellationPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCancellationPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPolicy() == nil {
+		err := UpdateCancellationPolicyRequestValidationError{
+			field:  "Policy",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCancellationPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCancellationPolicyRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCancellationPolicyRequestValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCancellationPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCancellationPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateCancellationPolicyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateCancellationPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCancellationPolicyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCancellationPolicyRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCancellationPolicyRequestValidationError is the validation error
+// returned by UpdateCancellationPolicyRequest.Validate if the designated
+// constraints aren't met.
+type UpdateCancellationPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCancellationPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCancellationPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCancellationPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCancellationPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCancellationPolicyRequestValidationError) ErrorName() string {
+	return "UpdateCancellationPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCancellationPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCancellationPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCancellationPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCancellationPolicyRequestValidationError{}
+
+// Validate checks the field values on CheckInBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckInBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckInBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckInBookingRequestMultiError, or nil if none found.
+func (m *CheckInBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckInBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := CheckInBookingRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckInBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckInBookingRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckInBookingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckInBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckInBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckInBookingRequestMultiError) AllErrors() []error { return m }
+
+// CheckInBookingRequestValidationError is the validation error returned by
+// CheckInBookingRequest.Validate if the designated constraints aren't met.
+type CheckInBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckInBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckInBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckInBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckInBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckInBookingRequestValidationError) ErrorName() string {
+	return "CheckInBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckInBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckInBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckInBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckInBookingRequestValidationError{}
+
+// Validate checks the field values on MarkNoShowRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MarkNoShowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkNoShowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MarkNoShowRequestMultiError, or nil if none found.
+func (m *MarkNoShowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkNoShowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := MarkNoShowRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MarkNoShowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkNoShowRequestMultiError is an error wrapping multiple validation errors
+// returned by MarkNoShowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MarkNoShowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkNoShowRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkNoShowRequestMultiError) AllErrors() []error { return m }
+
+// MarkNoShowRequestValidationError is the validation error returned by
+// MarkNoShowRequest.Validate if the designated constraints aren't met.
+type MarkNoShowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkNoShowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkNoShowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkNoShowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkNoShowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkNoShowRequestValidationError) ErrorName() string {
+	return "MarkNoShowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkNoShowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkNoShowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkNoShowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkNoShowRequestValidationError{}
+
+// Validate checks the field values on GetUserReliabilityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserReliabilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserReliabilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserReliabilityRequestMultiError, or nil if none found.
+func (m *GetUserReliabilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserReliabilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := GetUserReliabilityRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetUserReliabilityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserReliabilityRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserReliabilityRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetUserReliabilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserReliabilityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserReliabilityRequestMultiError) AllErrors() []error { return m }
+
+// GetUserReliabilityRequestValidationError is the validation error returned by
+// GetUserReliabilityRequest.Validate if the designated constraints aren't met.
+type GetUserReliabilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserReliabilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserReliabilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserReliabilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserReliabilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserReliabilityRequestValidationError) ErrorName() string {
+	return "GetUserReliabilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserReliabilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserReliabilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserReliabilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserReliabilityRequestValidationError{}
+
+// Validate checks the field values on UserReliability with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserReliability) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserReliability with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserReliabilityMultiError, or nil if none found.
+func (m *UserReliability) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserReliability) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Bookings
+
+	// no validation rules for Completed
+
+	// no validation rules for Cancelled
+
+	// no validation rules for NoShows
+
+	// no validation rules for NoShowRate
+
+	if len(errors) > 0 {
+		return UserReliabilityMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserReliabilityMultiError is an error wrapping multiple validation errors
+// returned by UserReliability.ValidateAll() if the designated constraints
+// aren't met.
+type UserReliabilityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserReliabilityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserReliabilityMultiError) AllErrors() []error { return m }
+
+// UserReliabilityValidationError is the validation error returned by
+// UserReliability.Validate if the designated constraints aren't met.
+type UserReliabilityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserReliabilityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserReliabilityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserReliabilityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserReliabilityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserReliabilityValidationError) ErrorName() string { return "UserReliabilityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserReliabilityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserReliability.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserReliabilityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserReliabilityValidationError{}
+
+// Validate checks the field values on GetReliabilityReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReliabilityReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReliabilityReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReliabilityReportRequestMultiError, or nil if none found.
+func (m *GetReliabilityReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReliabilityReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := ReliabilityGroup_name[int32(m.GetGroupBy())]; !ok {
+		err := GetReliabilityReportRequestValidationError{
+			field:  "GroupBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartDay() == nil {
+		err := GetReliabilityReportRequestValidationError{
+			field:  "StartDay",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReliabilityReportRequestValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReliabilityReportRequestValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReliabilityReportRequestValidationError{
+				field:  "StartDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetEndDay() == nil {
+		err := GetReliabilityReportRequestValidationError{
+			field:  "EndDay",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReliabilityReportRequestValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReliabilityReportRequestValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReliabilityReportRequestValidationError{
+				field:  "EndDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for BarberId
+
+	if m.GetMinBookings() < 0 {
+		err := GetReliabilityReportRequestValidationError{
+			field:  "MinBookings",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ReliabilitySort_name[int32(m.GetSortBy())]; !ok {
+		err := GetReliabilityReportRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 500 {
+		err := GetReliabilityReportRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReliabilityReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReliabilityReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GetReliabilityReportRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetReliabilityReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReliabilityReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReliabilityReportRequestMultiError) AllErrors() []error { return m }
+
+// GetReliabilityReportRequestValidationError is the validation error returned
+// by GetReliabilityReportRequest.Validate if the designated constraints
+// aren't met.
+type GetReliabilityReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReliabilityReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReliabilityReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReliabilityReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReliabilityReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReliabilityReportRequestValidationError) ErrorName() string {
+	return "GetReliabilityReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReliabilityReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReliabilityReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReliabilityReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReliabilityReportRequestValidationError{}
+
+// Validate checks the field values on ReliabilityRow with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReliabilityRow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReliabilityRow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReliabilityRowMultiError,
+// or nil if none found.
+func (m *ReliabilityRow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReliabilityRow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Bookings
+
+	// no validation rules for Completed
+
+	// no validation rules for Cancelled
+
+	// no validation rules for NoShows
+
+	// no validation rules for NoShowRate
+
+	// no validation rules for CancellationRate
+
+	if len(errors) > 0 {
+		return ReliabilityRowMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReliabilityRowMultiError is an error wrapping multiple validation errors
+// returned by ReliabilityRow.ValidateAll() if the designated constraints
+// aren't met.
+type ReliabilityRowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReliabilityRowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReliabilityRowMultiError) AllErrors() []error { return m }
+
+// ReliabilityRowValidationError is the validation error returned by
+// ReliabilityRow.Validate if the designated constraints aren't met.
+type ReliabilityRowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReliabilityRowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReliabilityRowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReliabilityRowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReliabilityRowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReliabilityRowValidationError) ErrorName() string { return "ReliabilityRowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReliabilityRowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReliabilityRow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReliabilityRowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReliabilityRowValidationError{}
+
+// Validate checks the field values on ReliabilityReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReliabilityReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReliabilityReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReliabilityReportMultiError, or nil if none found.
+func (m *ReliabilityReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReliabilityReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupBy
+
+	if all {
+		switch v := interface{}(m.GetStartDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReliabilityReportValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReliabilityReportValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReliabilityReportValidationError{
+				field:  "StartDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReliabilityReportValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReliabilityReportValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReliabilityReportValidationError{
+				field:  "EndDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReliabilityReportValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReliabilityReportValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReliabilityReportValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReliabilityReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReliabilityReportMultiError is an error wrapping multiple validation errors
+// returned by ReliabilityReport.ValidateAll() if the designated constraints
+// aren't met.
+type ReliabilityReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReliabilityReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReliabilityReportMultiError) AllErrors() []error { return m }
+
+// ReliabilityReportValidationError is the validation error returned by
+// ReliabilityReport.Validate if the designated constraints aren't met.
+type ReliabilityReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReliabilityReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReliabilityReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReliabilityReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReliabilityReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReliabilityReportValidationError) ErrorName() string {
+	return "ReliabilityReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReliabilityReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReliabilityReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReliabilityReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReliabilityReportValidationError{}
+
+// Validate checks the field values on GetCustomerSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCustomerSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCustomerSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCustomerSummaryRequestMultiError, or nil if none found.
+func (m *GetCustomerSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCustomerSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUserId()) < 1 {
+		err := GetCustomerSummaryRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCustomerSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCustomerSummaryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCustomerSummaryRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetCustomerSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCustomerSummaryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCustomerSummaryRequestMultiError) AllErrors() []error { return m }
+
+// GetCustomerSummaryRequestValidationError is the validation error returned by
+// GetCustomerSummaryRequest.Validate if the designated constraints aren't met.
+type GetCustomerSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCustomerSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCustomerSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCustomerSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCustomerSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCustomerSummaryRequestValidationError) ErrorName() string {
+	return "GetCustomerSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCustomerSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCustomerSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCustomerSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCustomerSummaryRequestValidationError{}
+
+// Validate checks the field values on CustomerSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CustomerSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomerSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CustomerSummaryMultiError, or nil if none found.
+func (m *CustomerSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomerSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for TotalVisits
+
+	if all {
+		switch v := interface{}(m.GetFirstVisit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomerSummaryValidationError{
+					field:  "FirstVisit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomerSummaryValidationError{
+					field:  "FirstVisit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstVisit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomerSummaryValidationError{
+				field:  "FirstVisit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastVisit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomerSummaryValidationError{
+					field:  "LastVisit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomerSummaryValidationError{
+					field:  "LastVisit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastVisit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomerSummaryValidationError{
+				field:  "LastVisit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UsualService
+
+	// no validation rules for AverageIntervalDays
+
+	if all {
+		switch v := interface{}(m.GetNextVisitDue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomerSummaryValidationError{
+					field:  "NextVisitDue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomerSummaryValidationError{
+					field:  "NextVisitDue",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextVisitDue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomerSummaryValidationError{
+				field:  "NextVisitDue",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CustomerSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomerSummaryMultiError is an error wrapping multiple validation errors
+// returned by CustomerSummary.ValidateAll() if the designated constraints
+// aren't met.
+type CustomerSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomerSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomerSummaryMultiError) AllErrors() []error { return m }
+
+// CustomerSummaryValidationError is the validation error returned by
+// CustomerSummary.Validate if the designated constraints aren't met.
+type CustomerSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomerSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomerSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomerSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomerSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomerSummaryValidationError) ErrorName() string { return "CustomerSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CustomerSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomerSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomerSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomerSummaryValidationError{}
+
+// Validate checks the field values on ConfirmBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmBookingRequestMultiError, or nil if none found.
+func (m *ConfirmBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ConfirmBookingRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmBookingRequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmBookingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmBookingRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmBookingRequestValidationError is the validation error returned by
+// ConfirmBookingRequest.Validate if the designated constraints aren't met.
+type ConfirmBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmBookingRequestValidationError) ErrorName() string {
+	return "ConfirmBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmBookingRequestValidationError{}
+
+// Validate checks the field values on CompleteBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteBookingRequestMultiError, or nil if none found.
+func (m *CompleteBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := CompleteBookingRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CompleteBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteBookingRequestMultiError is an error wrapping multiple validation
+// errors returned by CompleteBookingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CompleteBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteBookingRequestMultiError) AllErrors() []error { return m }
+
+// CompleteBookingRequestValidationError is the validation error returned by
+// CompleteBookingRequest.Validate if the designated constraints aren't met.
+type CompleteBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteBookingRequestValidationError) ErrorName() string {
+	return "CompleteBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteBookingRequestValidationError{}
+
+// Validate checks the field values on ListBookingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBookingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBookingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBookingsRequestMultiError, or nil if none found.
+func (m *ListBookingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBookingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for BarberId
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if _, ok := BookingStatus_name[int32(item)]; !ok {
+			err := ListBookingsRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetStartDate() != "" {
+
+		if !_ListBookingsRequest_StartDate_Pattern.MatchString(m.GetStartDate()) {
+			err := ListBookingsRequestValidationError{
+				field:  "StartDate",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndDate() != "" {
+
+		if !_ListBookingsRequest_EndDate_Pattern.MatchString(m.GetEndDate()) {
+			err := ListBookingsRequestValidationError{
+				field:  "EndDate",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Timezone
+
+	if _, ok := BookingSortField_name[int32(m.GetSortBy())]; !ok {
+		err := ListBookingsRequestValidationError{
+			field:  "SortBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Descending
+
+	// no validation rules for NeedsReview
+
+	if len(errors) > 0 {
+		return ListBookingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBookingsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBookingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBookingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBookingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBookingsRequestMultiError) AllErrors() []error { return m }
+
+// ListBookingsRequestValidationError is the validation error returned by
+// ListBookingsRequest.Validate if the designated constraints aren't met.
+type ListBookingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBookingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBookingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBookingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBookingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBookingsRequestValidationError) ErrorName() string {
+	return "ListBookingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBookingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBookingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBookingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBookingsRequestValidationError{}
+
+var _ListBookingsRequest_StartDate_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+var _ListBookingsRequest_EndDate_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+// Validate checks the field values on WatchBookingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchBookingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchBookingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchBookingsRequestMultiError, or nil if none found.
+func (m *WatchBookingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchBookingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for BarberId
+
+	if len(errors) > 0 {
+		return WatchBookingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchBookingsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchBookingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchBookingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchBookingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchBookingsRequestMultiError) AllErrors() []error { return m }
+
+// WatchBookingsRequestValidationError is the validation error returned by
+// WatchBookingsRequest.Validate if the designated constraints aren't met.
+type WatchBookingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchBookingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchBookingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchBookingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchBookingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchBookingsRequestValidationError) ErrorName() string {
+	return "WatchBookingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchBookingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchBookingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchBookingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchBookingsRequestValidationError{}
+
+// Validate checks the field values on BookingEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookingEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookingEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookingEventMultiError, or
+// nil if none found.
+func (m *BookingEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookingEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetBooking()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BookingEventValidationError{
+					field:  "Booking",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BookingEventValidationError{
+					field:  "Booking",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBooking()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BookingEventValidationError{
+				field:  "Booking",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BookingEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookingEventMultiError is an error wrapping multiple validation errors
+// returned by BookingEvent.ValidateAll() if the designated constraints aren't met.
+type BookingEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookingEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookingEventMultiError) AllErrors() []error { return m }
+
+// BookingEventValidationError is the validation error returned by
+// BookingEvent.Validate if the designated constraints aren't met.
+type BookingEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookingEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookingEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookingEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookingEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookingEventValidationError) ErrorName() string { return "BookingEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookingEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookingEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookingEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookingEventValidationError{}
+
+// Validate checks the field values on RescheduleBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleBookingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RescheduleBookingRequestMultiError, or nil if none found.
+func (m *RescheduleBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := RescheduleBookingRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StartTime
+
+	if all {
+		switch v := interface{}(m.GetStartTimeTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RescheduleBookingRequestValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RescheduleBookingRequestValidationError{
+					field:  "StartTimeTs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTimeTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RescheduleBookingRequestValidationError{
+				field:  "StartTimeTs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RescheduleBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleBookingRequestMultiError is an error wrapping multiple validation
+// errors returned by RescheduleBookingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RescheduleBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleBookingRequestMultiError) AllErrors() []error { return m }
+
+// RescheduleBookingRequestValidationError is the validation error returned by
+// RescheduleBookingRequest.Validate if the designated constraints aren't met.
+type RescheduleBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleBookingRequestValidationError) ErrorName() string {
+	return "RescheduleBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleBookingRequestValidationError{}
+
+// Validate checks the field values on WorkingHours with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WorkingHours) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkingHours with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WorkingHoursMultiError, or
+// nil if none found.
+func (m *WorkingHours) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkingHours) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := Weekday_name[int32(m.GetWeekday())]; !ok {
+		err := WorkingHoursValidationError{
+			field:  "Weekday",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WorkingHours_Start_Pattern.MatchString(m.GetStart()) {
+		err := WorkingHoursValidationError{
+			field:  "Start",
+			reason: "value does not match regex pattern \"^\\\\d{2}:\\\\d{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WorkingHours_End_Pattern.MatchString(m.GetEnd()) {
+		err := WorkingHoursValidationError{
+			field:  "End",
+			reason: "value does not match regex pattern \"^\\\\d{2}:\\\\d{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WorkingHoursMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkingHoursMultiError is an error wrapping multiple validation errors
+// returned by WorkingHours.ValidateAll() if the designated constraints aren't met.
+type WorkingHoursMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkingHoursMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkingHoursMultiError) AllErrors() []error { return m }
+
+// WorkingHoursValidationError is the validation error returned by
+// WorkingHours.Validate if the designated constraints aren't met.
+type WorkingHoursValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkingHoursValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkingHoursValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkingHoursValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkingHoursValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkingHoursValidationError) ErrorName() string { return "WorkingHoursValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkingHoursValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkingHours.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkingHoursValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkingHoursValidationError{}
+
+var _WorkingHours_Start_Pattern = regexp.MustCompile("^\\d{2}:\\d{2}$")
+
+var _WorkingHours_End_Pattern = regexp.MustCompile("^\\d{2}:\\d{2}$")
+
+// Validate checks the field values on BreakPeriod with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BreakPeriod) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BreakPeriod with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BreakPeriodMultiError, or
+// nil if none found.
+func (m *BreakPeriod) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BreakPeriod) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_BreakPeriod_Start_Pattern.MatchString(m.GetStart()) {
+		err := BreakPeriodValidationError{
+			field:  "Start",
+			reason: "value does not match regex pattern \"^\\\\d{2}:\\\\d{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BreakPeriod_End_Pattern.MatchString(m.GetEnd()) {
+		err := BreakPeriodValidationError{
+			field:  "End",
+			reason: "value does not match regex pattern \"^\\\\d{2}:\\\\d{2}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BreakPeriodMultiError(errors)
+	}
+
+	return nil
+}
+
+// BreakPeriodMultiError is an error wrapping multiple validation errors
+// returned by BreakPeriod.ValidateAll() if the designated constraints aren't met.
+type BreakPeriodMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BreakPeriodMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BreakPeriodMultiError) AllErrors() []error { return m }
+
+// BreakPeriodValidationError is the validation error returned by
+// BreakPeriod.Validate if the designated constraints aren't met.
+type BreakPeriodValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BreakPeriodValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BreakPeriodValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BreakPeriodValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BreakPeriodValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BreakPeriodValidationError) ErrorName() string { return "BreakPeriodValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BreakPeriodValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBreakPeriod.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BreakPeriodValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BreakPeriodValidationError{}
+
+var _BreakPeriod_Start_Pattern = regexp.MustCompile("^\\d{2}:\\d{2}$")
+
+var _BreakPeriod_End_Pattern = regexp.MustCompile("^\\d{2}:\\d{2}$")
+
+// Validate checks the field values on BarberSchedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BarberSchedule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BarberSchedule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BarberScheduleMultiError,
+// or nil if none found.
+func (m *BarberSchedule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BarberSchedule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	for idx, item := range m.GetHours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BarberScheduleValidationError{
+						field:  fmt.Sprintf("Hours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BarberScheduleValidationError{
+						field:  fmt.Sprintf("Hours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BarberScheduleValidationError{
+					field:  fmt.Sprintf("Hours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBreaks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BarberScheduleValidationError{
+						field:  fmt.Sprintf("Breaks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BarberScheduleValidationError{
+						field:  fmt.Sprintf("Breaks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BarberScheduleValidationError{
+					field:  fmt.Sprintf("Breaks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Timezone
+
+	// no validation rules for SlotMinutes
+
+	// no validation rules for MinLeadMinutes
+
+	// no validation rules for MaxAdvanceDays
+
+	if len(errors) > 0 {
+		return BarberScheduleMultiError(errors)
+	}
+
+	return nil
+}
+
+// BarberScheduleMultiError is an error wrapping multiple validation errors
+// returned by BarberSchedule.ValidateAll() if the designated constraints
+// aren't met.
+type BarberScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BarberScheduleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BarberScheduleMultiError) AllErrors() []error { return m }
+
+// BarberScheduleValidationError is the validation error returned by
+// BarberSchedule.Validate if the designated constraints aren't met.
+type BarberScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BarberScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BarberScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BarberScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BarberScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BarberScheduleValidationError) ErrorName() string { return "BarberScheduleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BarberScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBarberSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BarberScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BarberScheduleValidationError{}
+
+// Validate checks the field values on GetWorkingHoursRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkingHoursRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkingHoursRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkingHoursRequestMultiError, or nil if none found.
+func (m *GetWorkingHoursRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkingHoursRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := GetWorkingHoursRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetWorkingHoursRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkingHoursRequestMultiError is an error wrapping multiple validation
+// errors returned by GetWorkingHoursRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkingHoursRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkingHoursRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkingHoursRequestMultiError) AllErrors() []error { return m }
+
+// GetWorkingHoursRequestValidationError is the validation error returned by
+// GetWorkingHoursRequest.Validate if the designated constraints aren't met.
+type GetWorkingHoursRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkingHoursRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkingHoursRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkingHoursRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkingHoursRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkingHoursRequestValidationError) ErrorName() string {
+	return "GetWorkingHoursRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkingHoursRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkingHoursRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkingHoursRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkingHoursRequestValidationError{}
+
+// Validate checks the field values on SetWorkingHoursRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetWorkingHoursRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetWorkingHoursRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetWorkingHoursRequestMultiError, or nil if none found.
+func (m *SetWorkingHoursRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetWorkingHoursRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	for idx, item := range m.GetHours() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetWorkingHoursRequestValidationError{
+						field:  fmt.Sprintf("Hours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetWorkingHoursRequestValidationError{
+						field:  fmt.Sprintf("Hours[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetWorkingHoursRequestValidationError{
+					field:  fmt.Sprintf("Hours[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBreaks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetWorkingHoursRequestValidationError{
+						field:  fmt.Sprintf("Breaks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetWorkingHoursRequestValidationError{
+						field:  fmt.Sprintf("Breaks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetWorkingHoursRequestValidationError{
+					field:  fmt.Sprintf("Breaks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Timezone
+
+	// no validation rules for SlotMinutes
+
+	// no validation rules for MinLeadMinutes
+
+	// no validation rules for MaxAdvanceDays
+
+	if len(errors) > 0 {
+		return SetWorkingHoursRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetWorkingHoursRequestMultiError is an error wrapping multiple validation
+// errors returned by SetWorkingHoursRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetWorkingHoursRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetWorkingHoursRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetWorkingHoursRequestMultiError) AllErrors() []error { return m }
+
+// SetWorkingHoursRequestValidationError is the validation error returned by
+// SetWorkingHoursRequest.Validate if the designated constraints aren't met.
+type SetWorkingHoursRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetWorkingHoursRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetWorkingHoursRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetWorkingHoursRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetWorkingHoursRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetWorkingHoursRequestValidationError) ErrorName() string {
+	return "SetWorkingHoursRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetWorkingHoursRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetWorkingHoursRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetWorkingHoursRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetWorkingHoursRequestValidationError{}
+
+// Validate checks the field values on Holiday with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Holiday) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Holiday with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in HolidayMultiError, or nil if none found.
+func (m *Holiday) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Holiday) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return HolidayMultiError(errors)
+	}
+
+	return nil
+}
+
+// HolidayMultiError is an error wrapping multiple validation errors returned
+// by Holiday.ValidateAll() if the designated constraints aren't met.
+type HolidayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HolidayMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HolidayMultiError) AllErrors() []error { return m }
+
+// HolidayValidationError is the validation error returned by Holiday.Validate
+// if the designated constraints aren't met.
+type HolidayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HolidayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HolidayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HolidayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HolidayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HolidayValidationError) ErrorName() string { return "HolidayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HolidayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHoliday.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HolidayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HolidayValidationError{}
+
+// Validate checks the field values on HolidayList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HolidayList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HolidayList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HolidayListMultiError, or
+// nil if none found.
+func (m *HolidayList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HolidayList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHolidays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HolidayListValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HolidayListValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HolidayListValidationError{
+					field:  fmt.Sprintf("Holidays[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HolidayListMultiError(errors)
+	}
+
+	return nil
+}
+
+// HolidayListMultiError is an error wrapping multiple validation errors
+// returned by HolidayList.ValidateAll() if the designated constraints aren't met.
+type HolidayListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HolidayListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HolidayListMultiError) AllErrors() []error { return m }
+
+// HolidayListValidationError is the validation error returned by
+// HolidayList.Validate if the designated constraints aren't met.
+type HolidayListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HolidayListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HolidayListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HolidayListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HolidayListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HolidayListValidationError) ErrorName() string { return "HolidayListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HolidayListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHolidayList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HolidayListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HolidayListValidationError{}
+
+// Validate checks the field values on ListHolidaysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHolidaysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHolidaysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHolidaysRequestMultiError, or nil if none found.
+func (m *ListHolidaysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHolidaysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStartDate() != "" {
+
+		if !_ListHolidaysRequest_StartDate_Pattern.MatchString(m.GetStartDate()) {
+			err := ListHolidaysRequestValidationError{
+				field:  "StartDate",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndDate() != "" {
+
+		if !_ListHolidaysRequest_EndDate_Pattern.MatchString(m.GetEndDate()) {
+			err := ListHolidaysRequestValidationError{
+				field:  "EndDate",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListHolidaysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHolidaysRequestMultiError is an error wrapping multiple validation
+// errors returned by ListHolidaysRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListHolidaysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHolidaysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHolidaysRequestMultiError) AllErrors() []error { return m }
+
+// ListHolidaysRequestValidationError is the validation error returned by
+// ListHolidaysRequest.Validate if the designated constraints aren't met.
+type ListHolidaysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHolidaysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHolidaysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHolidaysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHolidaysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHolidaysRequestValidationError) ErrorName() string {
+	return "ListHolidaysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHolidaysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHolidaysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHolidaysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHolidaysRequestValidationError{}
+
+var _ListHolidaysRequest_StartDate_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+var _ListHolidaysRequest_EndDate_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+// Validate checks the field values on AddHolidayRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddHolidayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddHolidayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddHolidayRequestMultiError, or nil if none found.
+func (m *AddHolidayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddHolidayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_AddHolidayRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := AddHolidayRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return AddHolidayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddHolidayRequestMultiError is an error wrapping multiple validation errors
+// returned by AddHolidayRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddHolidayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddHolidayRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddHolidayRequestMultiError) AllErrors() []error { return m }
+
+// AddHolidayRequestValidationError is the validation error returned by
+// AddHolidayRequest.Validate if the designated constraints aren't met.
+type AddHolidayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddHolidayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddHolidayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddHolidayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddHolidayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddHolidayRequestValidationError) ErrorName() string {
+	return "AddHolidayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddHolidayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddHolidayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddHolidayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddHolidayRequestValidationError{}
+
+var _AddHolidayRequest_Date_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+// Validate checks the field values on RemoveHolidayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveHolidayRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveHolidayRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveHolidayRequestMultiError, or nil if none found.
+func (m *RemoveHolidayRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveHolidayRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RemoveHolidayRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := RemoveHolidayRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveHolidayRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveHolidayRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveHolidayRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveHolidayRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveHolidayRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveHolidayRequestMultiError) AllErrors() []error { return m }
+
+// RemoveHolidayRequestValidationError is the validation error returned by
+// RemoveHolidayRequest.Validate if the designated constraints aren't met.
+type RemoveHolidayRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveHolidayRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveHolidayRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveHolidayRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveHolidayRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveHolidayRequestValidationError) ErrorName() string {
+	return "RemoveHolidayRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveHolidayRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveHolidayRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveHolidayRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveHolidayRequestValidationError{}
+
+var _RemoveHolidayRequest_Date_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+// Validate checks the field values on RemoveHolidayResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveHolidayResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveHolidayResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveHolidayResponseMultiError, or nil if none found.
+func (m *RemoveHolidayResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveHolidayResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return RemoveHolidayResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveHolidayResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveHolidayResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveHolidayResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveHolidayResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveHolidayResponseMultiError) AllErrors() []error { return m }
+
+// RemoveHolidayResponseValidationError is the validation error returned by
+// RemoveHolidayResponse.Validate if the designated constraints aren't met.
+type RemoveHolidayResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveHolidayResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveHolidayResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveHolidayResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveHolidayResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveHolidayResponseValidationError) ErrorName() string {
+	return "RemoveHolidayResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveHolidayResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveHolidayResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveHolidayResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveHolidayResponseValidationError{}
+
+// Validate checks the field values on GetNextAvailableSlotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNextAvailableSlotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNextAvailableSlotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNextAvailableSlotRequestMultiError, or nil if none found.
+func (m *GetNextAvailableSlotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNextAvailableSlotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := GetNextAvailableSlotRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCount() < 0 {
+		err := GetNextAvailableSlotRequestValidationError{
+			field:  "Count",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Timezone
+
+	if m.ServiceType != nil {
+		// no validation rules for ServiceType
+	}
+
+	if len(errors) > 0 {
+		return GetNextAvailableSlotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNextAvailableSlotRequestMultiError is an error wrapping multiple
+// validation errors returned by GetNextAvailableSlotRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetNextAvailableSlotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNextAvailableSlotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNextAvailableSlotRequestMultiError) AllErrors() []error { return m }
+
+// GetNextAvailableSlotRequestValidationError is the validation error returned
+// by GetNextAvailableSlotRequest.Validate if the designated constraints
+// aren't met.
+type GetNextAvailableSlotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNextAvailableSlotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNextAvailableSlotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNextAvailableSlotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNextAvailableSlotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNextAvailableSlotRequestValidationError) ErrorName() string {
+	return "GetNextAvailableSlotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNextAvailableSlotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNextAvailableSlotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNextAvailableSlotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNextAvailableSlotRequestValidationError{}
+
+// Validate checks the field values on GetAvailableTimeSlotsRangeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetAvailableTimeSlotsRangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAvailableTimeSlotsRangeRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetAvailableTimeSlotsRangeRequestMultiError, or nil if none found.
+func (m *GetAvailableTimeSlotsRangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAvailableTimeSlotsRangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if m.GetStartDate() != "" {
+
+		if !_GetAvailableTimeSlotsRangeRequest_StartDate_Pattern.MatchString(m.GetStartDate()) {
+			err := GetAvailableTimeSlotsRangeRequestValidationError{
+				field:  "StartDate",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndDate() != "" {
+
+		if !_GetAvailableTimeSlotsRangeRequest_EndDate_Pattern.MatchString(m.GetEndDate()) {
+			err := GetAvailableTimeSlotsRangeRequestValidationError{
+				field:  "EndDate",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}(T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2}))?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAvailableTimeSlotsRangeRequestValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAvailableTimeSlotsRangeRequestValidationError{
+					field:  "StartDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAvailableTimeSlotsRangeRequestValidationError{
+				field:  "StartDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetAvailableTimeSlotsRangeRequestValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetAvailableTimeSlotsRangeRequestValidationError{
+					field:  "EndDay",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetAvailableTimeSlotsRangeRequestValidationError{
+				field:  "EndDay",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	if m.ServiceType != nil {
+		// no validation rules for ServiceType
+	}
+
+	if len(errors) > 0 {
+		return GetAvailableTimeSlotsRangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAvailableTimeSlotsRangeRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetAvailableTimeSlotsRangeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetAvailableTimeSlotsRangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAvailableTimeSlotsRangeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAvailableTimeSlotsRangeRequestMultiError) AllErrors() []error { return m }
+
+// GetAvailableTimeSlotsRangeRequestValidationError is the validation error
+// returned by GetAvailableTimeSlotsRangeRequest.Validate if the designated
+// constraints aren't met.
+type GetAvailableTimeSlotsRangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAvailableTimeSlotsRangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAvailableTimeSlotsRangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAvailableTimeSlotsRangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAvailableTimeSlotsRangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAvailableTimeSlotsRangeRequestValidationError) ErrorName() string {
+	return "GetAvailableTimeSlotsRangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAvailableTimeSlotsRangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAvailableTimeSlotsRangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAvailableTimeSlotsRangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAvailableTimeSlotsRangeRequestValidationError{}
+
+var _GetAvailableTimeSlotsRangeRequest_StartDate_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+var _GetAvailableTimeSlotsRangeRequest_EndDate_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}(T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2}))?$")
+
+// Validate checks the field values on DayTimeSlots with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DayTimeSlots) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DayTimeSlots with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DayTimeSlotsMultiError, or
+// nil if none found.
+func (m *DayTimeSlots) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DayTimeSlots) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDay()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DayTimeSlotsValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DayTimeSlotsValidationError{
+					field:  "Day",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDay()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DayTimeSlotsValidationError{
+				field:  "Day",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTimeSlots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DayTimeSlotsValidationError{
+						field:  fmt.Sprintf("TimeSlots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DayTimeSlotsValidationError{
+						field:  fmt.Sprintf("TimeSlots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DayTimeSlotsValidationError{
+					field:  fmt.Sprintf("TimeSlots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DayTimeSlotsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DayTimeSlotsMultiError is an error wrapping multiple validation errors
+// returned by DayTimeSlots.ValidateAll() if the designated constraints aren't met.
+type DayTimeSlotsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DayTimeSlotsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DayTimeSlotsMultiError) AllErrors() []error { return m }
+
+// DayTimeSlotsValidationError is the validation error returned by
+// DayTimeSlots.Validate if the designated constraints aren't met.
+type DayTimeSlotsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DayTimeSlotsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DayTimeSlotsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DayTimeSlotsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DayTimeSlotsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DayTimeSlotsValidationError) ErrorName() string { return "DayTimeSlotsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DayTimeSlotsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDayTimeSlots.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DayTimeSlotsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DayTimeSlotsValidationError{}
+
+// Validate checks the field values on DayTimeSlotsList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DayTimeSlotsList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DayTimeSlotsList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DayTimeSlotsListMultiError, or nil if none found.
+func (m *DayTimeSlotsList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DayTimeSlotsList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DayTimeSlotsListValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DayTimeSlotsListValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DayTimeSlotsListValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DayTimeSlotsListMultiError(errors)
+	}
+
+	return nil
+}
+
+// DayTimeSlotsListMultiError is an error wrapping multiple validation errors
+// returned by DayTimeSlotsList.ValidateAll() if the designated constraints
+// aren't met.
+type DayTimeSlotsListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DayTimeSlotsListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DayTimeSlotsListMultiError) AllErrors() []error { return m }
+
+// DayTimeSlotsListValidationError is the validation error returned by
+// DayTimeSlotsList.Validate if the designated constraints aren't met.
+type DayTimeSlotsListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DayTimeSlotsListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DayTimeSlotsListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DayTimeSlotsListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DayTimeSlotsListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DayTimeSlotsListValidationError) ErrorName() string { return "DayTimeSlotsListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DayTimeSlotsListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDayTimeSlotsList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DayTimeSlotsListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DayTimeSlotsListValidationError{}
+
+// Validate checks the field values on CatalogService with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CatalogService) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CatalogService with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CatalogServiceMultiError,
+// or nil if none found.
+func (m *CatalogService) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CatalogService) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceType
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CatalogServiceValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDurationMinutes() <= 0 {
+		err := CatalogServiceValidationError{
+			field:  "DurationMinutes",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() < 0 {
+		err := CatalogServiceValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Active
+
+	if m.CleanupMinutes != nil {
+
+		if val := m.GetCleanupMinutes(); val < 0 || val > 120 {
+			err := CatalogServiceValidationError{
+				field:  "CleanupMinutes",
+				reason: "value must be inside range [0, 120]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CatalogServiceMultiError(errors)
+	}
+
+	return nil
+}
+
+// CatalogServiceMultiError is an error wrapping multiple validation errors
+// returned by CatalogService.ValidateAll() if the designated constraints
+// aren't met.
+type CatalogServiceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CatalogServiceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CatalogServiceMultiError) AllErrors() []error { return m }
+
+// CatalogServiceValidationError is the validation error returned by
+// CatalogService.Validate if the designated constraints aren't met.
+type CatalogServiceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CatalogServiceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CatalogServiceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CatalogServiceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CatalogServiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CatalogServiceValidationError) ErrorName() string { return "CatalogServiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CatalogServiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCatalogService.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CatalogServiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CatalogServiceValidationError{}
+
+// Validate checks the field values on ListCatalogServicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCatalogServicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCatalogServicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCatalogServicesRequestMultiError, or nil if none found.
+func (m *ListCatalogServicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCatalogServicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeInactive
+
+	if len(errors) > 0 {
+		return ListCatalogServicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCatalogServicesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListCatalogServicesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListCatalogServicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCatalogServicesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCatalogServicesRequestMultiError) AllErrors() []error { return m }
+
+// ListCatalogServicesRequestValidationError is the validation error returned
+// by ListCatalogServicesRequest.Validate if the designated constraints aren't met.
+type ListCatalogServicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCatalogServicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCatalogServicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCatalogServicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCatalogServicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCatalogServicesRequestValidationError) ErrorName() string {
+	return "ListCatalogServicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCatalogServicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCatalogServicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCatalogServicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCatalogServicesRequestValidationError{}
+
+// Validate checks the field values on CatalogServiceList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CatalogServiceList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CatalogServiceList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CatalogServiceListMultiError, or nil if none found.
+func (m *CatalogServiceList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CatalogServiceList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogServiceListValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogServiceListValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogServiceListValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CatalogServiceListMultiError(errors)
+	}
+
+	return nil
+}
+
+// CatalogServiceListMultiError is an error wrapping multiple validation errors
+// returned by CatalogServiceList.ValidateAll() if the designated constraints
+// aren't met.
+type CatalogServiceListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CatalogServiceListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CatalogServiceListMultiError) AllErrors() []error { return m }
+
+// CatalogServiceListValidationError is the validation error returned by
+// CatalogServiceList.Validate if the designated constraints aren't met.
+type CatalogServiceListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CatalogServiceListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CatalogServiceListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CatalogServiceListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CatalogServiceListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CatalogServiceListValidationError) ErrorName() string {
+	return "CatalogServiceListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CatalogServiceListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCatalogServiceList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CatalogServiceListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CatalogServiceListValidationError{}
+
+// Validate checks the field values on CreateCatalogServiceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCatalogServiceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCatalogServiceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCatalogServiceRequestMultiError, or nil if none found.
+func (m *CreateCatalogServiceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCatalogServiceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetService() == nil {
+		err := CreateCatalogServiceRequestValidationError{
+			field:  "Service",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetService()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCatalogServiceRequestValidationError{
+					field:  "Service",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCatalogServiceRequestValidationError{
+					field:  "Service",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCatalogServiceRequestValidationError{
+				field:  "Service",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCatalogServiceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCatalogServiceRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateCatalogServiceRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateCatalogServiceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCatalogServiceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCatalogServiceRequestMultiError) AllErrors() []error { return m }
+
+// CreateCatalogServiceRequestValidationError is the validation error returned
+// by CreateCatalogServiceRequest.Validate if the designated constraints
+// aren't met.
+type CreateCatalogServiceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCatalogServiceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCatalogServiceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCatalogServiceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCatalogServiceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCatalogServiceRequestValidationError) ErrorName() string {
+	return "CreateCatalogServiceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCatalogServiceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCatalogServiceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCatalogServiceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCatalogServiceRequestValidationError{}
+
+// Validate checks the field values on UpdateCatalogServiceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCatalogServiceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCatalogServiceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCatalogServiceRequestMultiError, or nil if none found.
+func (m *UpdateCatalogServiceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCatalogServiceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetService() == nil {
+		err := UpdateCatalogServiceRequestValidationError{
+			field:  "Service",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetService()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCatalogServiceRequestValidationError{
+					field:  "Service",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCatalogServiceRequestValidationError{
+					field:  "Service",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCatalogServiceRequestValidationError{
+				field:  "Service",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateCatalogServiceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCatalogServiceRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateCatalogServiceRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateCatalogServiceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCatalogServiceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCatalogServiceRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCatalogServiceRequestValidationError is the validation error returned
+// by UpdateCatalogServiceRequest.Validate if the designated constraints
+// aren't met.
+type UpdateCatalogServiceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCatalogServiceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCatalogServiceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCatalogServiceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCatalogServiceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCatalogServiceRequestValidationError) ErrorName() string {
+	return "UpdateCatalogServiceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCatalogServiceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCatalogServiceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCatalogServiceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCatalogServiceRequestValidationError{}
+
+// Validate checks the field values on DeleteCatalogServiceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCatalogServiceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCatalogServiceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCatalogServiceRequestMultiError, or nil if none found.
+func (m *DeleteCatalogServiceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCatalogServiceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceType
+
+	if len(errors) > 0 {
+		return DeleteCatalogServiceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCatalogServiceRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteCatalogServiceRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteCatalogServiceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCatalogServiceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCatalogServiceRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCatalogServiceRequestValidationError is the validation error returned
+// by DeleteCatalogServiceRequest.Validate if the designated constraints
+// aren't met.
+type DeleteCatalogServiceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCatalogServiceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCatalogServiceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCatalogServiceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCatalogServiceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCatalogServiceRequestValidationError) ErrorName() string {
+	return "DeleteCatalogServiceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCatalogServiceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCatalogServiceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCatalogServiceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCatalogServiceRequestValidationError{}
+
+// Validate checks the field values on DeleteCatalogServiceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCatalogServiceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCatalogServiceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCatalogServiceResponseMultiError, or nil if none found.
+func (m *DeleteCatalogServiceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCatalogServiceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteCatalogServiceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCatalogServiceResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteCatalogServiceResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteCatalogServiceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCatalogServiceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCatalogServiceResponseMultiError) AllErrors() []error { return m }
+
+// DeleteCatalogServiceResponseValidationError is the validation error returned
+// by DeleteCatalogServiceResponse.Validate if the designated constraints
+// aren't met.
+type DeleteCatalogServiceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCatalogServiceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCatalogServiceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCatalogServiceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCatalogServiceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCatalogServiceResponseValidationError) ErrorName() string {
+	return "DeleteCatalogServiceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCatalogServiceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCatalogServiceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCatalogServiceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCatalogServiceResponseValidationError{}
+
+// Validate checks the field values on PromoCode with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PromoCode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PromoCode with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PromoCodeMultiError, or nil
+// if none found.
+func (m *PromoCode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PromoCode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PromoCode_Code_Pattern.MatchString(m.GetCode()) {
+		err := PromoCodeValidationError{
+			field:  "Code",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]{3,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPercentOff(); val < 0 || val > 100 {
+		err := PromoCodeValidationError{
+			field:  "PercentOff",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmountOff() < 0 {
+		err := PromoCodeValidationError{
+			field:  "AmountOff",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PromoCodeValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PromoCodeValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PromoCodeValidationError{
+				field:  "ValidFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetValidUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PromoCodeValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PromoCodeValidationError{
+					field:  "ValidUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PromoCodeValidationError{
+				field:  "ValidUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMaxUses() < 0 {
+		err := PromoCodeValidationError{
+			field:  "MaxUses",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Uses
+
+	// no validation rules for Active
+
+	if len(errors) > 0 {
+		return PromoCodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PromoCodeMultiError is an error wrapping multiple validation errors returned
+// by PromoCode.ValidateAll() if the designated constraints aren't met.
+type PromoCodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PromoCodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PromoCodeMultiError) AllErrors() []error { return m }
+
+// PromoCodeValidationError is the validation error returned by
+// PromoCode.Validate if the designated constraints aren't met.
+type PromoCodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromoCodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromoCodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromoCodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromoCodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromoCodeValidationError) ErrorName() string { return "PromoCodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PromoCodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromoCode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromoCodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromoCodeValidationError{}
+
+var _PromoCode_Code_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]{3,32}$")
+
+// Validate checks the field values on ListPromoCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPromoCodesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPromoCodesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPromoCodesRequestMultiError, or nil if none found.
+func (m *ListPromoCodesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPromoCodesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPromoCodesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPromoCodesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPromoCodesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPromoCodesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPromoCodesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPromoCodesRequestMultiError) AllErrors() []error { return m }
+
+// ListPromoCodesRequestValidationError is the validation error returned by
+// ListPromoCodesRequest.Validate if the designated constraints aren't met.
+type ListPromoCodesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPromoCodesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPromoCodesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPromoCodesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPromoCodesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPromoCodesRequestValidationError) ErrorName() string {
+	return "ListPromoCodesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPromoCodesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPromoCodesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPromoCodesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPromoCodesRequestValidationError{}
+
+// Validate checks the field values on PromoCodeList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PromoCodeList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PromoCodeList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PromoCodeListMultiError, or
+// nil if none found.
+func (m *PromoCodeList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PromoCodeList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPromoCodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PromoCodeListValidationError{
+						field:  fmt.Sprintf("PromoCodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PromoCodeListValidationError{
+						field:  fmt.Sprintf("PromoCodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PromoCodeListValidationError{
+					field:  fmt.Sprintf("PromoCodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PromoCodeListMultiError(errors)
+	}
+
+	return nil
+}
+
+// PromoCodeListMultiError is an error wrapping multiple validation errors
+// returned by PromoCodeList.ValidateAll() if the designated constraints
+// aren't met.
+type PromoCodeListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PromoCodeListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PromoCodeListMultiError) AllErrors() []error { return m }
+
+// PromoCodeListValidationError is the validation error returned by
+// PromoCodeList.Validate if the designated constraints aren't met.
+type PromoCodeListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromoCodeListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromoCodeListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromoCodeListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromoCodeListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromoCodeListValidationError) ErrorName() string { return "PromoCodeListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PromoCodeListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromoCodeList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromoCodeListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromoCodeListValidationError{}
+
+// Validate checks the field values on GetPromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPromoCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPromoCodeRequestMultiError, or nil if none found.
+func (m *GetPromoCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPromoCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := GetPromoCodeRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPromoCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPromoCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPromoCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPromoCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPromoCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPromoCodeRequestMultiError) AllErrors() []error { return m }
+
+// GetPromoCodeRequestValidationError is the validation error returned by
+// GetPromoCodeRequest.Validate if the designated constraints aren't met.
+type GetPromoCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPromoCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPromoCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPromoCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPromoCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPromoCodeRequestValidationError) ErrorName() string {
+	return "GetPromoCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPromoCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPromoCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPromoCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPromoCodeRequestValidationError{}
+
+// Validate checks the field values on CreatePromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePromoCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePromoCodeRequestMultiError, or nil if none found.
+func (m *CreatePromoCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePromoCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPromoCode() == nil {
+		err := CreatePromoCodeRequestValidationError{
+			field:  "PromoCode",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPromoCode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePromoCodeRequestValidationError{
+					field:  "PromoCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePromoCodeRequestValidationError{
+					field:  "PromoCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPromoCode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePromoCodeRequestValidationError{
+				field:  "PromoCode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePromoCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePromoCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePromoCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePromoCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePromoCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePromoCodeRequestMultiError) AllErrors() []error { return m }
+
+// CreatePromoCodeRequestValidationError is the validation error returned by
+// CreatePromoCodeRequest.Validate if the designated constraints aren't met.
+type CreatePromoCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePromoCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePromoCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePromoCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePromoCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePromoCodeRequestValidationError) ErrorName() string {
+	return "CreatePromoCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePromoCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePromoCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePromoCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePromoCodeRequestValidationError{}
+
+// Validate checks the field values on UpdatePromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePromoCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePromoCodeRequestMultiError, or nil if none found.
+func (m *UpdatePromoCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePromoCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPromoCode() == nil {
+		err := UpdatePromoCodeRequestValidationError{
+			field:  "PromoCode",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPromoCode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePromoCodeRequestValidationError{
+					field:  "PromoCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePromoCodeRequestValidationError{
+					field:  "PromoCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPromoCode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePromoCodeRequestValidationError{
+				field:  "PromoCode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePromoCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePromoCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePromoCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePromoCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePromoCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePromoCodeRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePromoCodeRequestValidationError is the validation error returned by
+// UpdatePromoCodeRequest.Validate if the designated constraints aren't met.
+type UpdatePromoCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePromoCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePromoCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePromoCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePromoCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePromoCodeRequestValidationError) ErrorName() string {
+	return "UpdatePromoCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePromoCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePromoCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePromoCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePromoCodeRequestValidationError{}
+
+// Validate checks the field values on DeletePromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePromoCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePromoCodeRequestMultiError, or nil if none found.
+func (m *DeletePromoCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePromoCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := DeletePromoCodeRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeletePromoCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePromoCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeletePromoCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePromoCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePromoCodeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePromoCodeRequestMultiError) AllErrors() []error { return m }
+
+// DeletePromoCodeRequestValidationError is the validation error returned by
+// DeletePromoCodeRequest.Validate if the designated constraints aren't met.
+type DeletePromoCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePromoCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePromoCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePromoCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePromoCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePromoCodeRequestValidationError) ErrorName() string {
+	return "DeletePromoCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePromoCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePromoCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePromoCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePromoCodeRequestValidationError{}
+
+// Validate checks the field values on DeletePromoCodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeletePromoCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeletePromoCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeletePromoCodeResponseMultiError, or nil if none found.
+func (m *DeletePromoCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeletePromoCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeletePromoCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeletePromoCodeResponseMultiError is an error wrapping multiple validation
+// errors returned by DeletePromoCodeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeletePromoCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeletePromoCodeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeletePromoCodeResponseMultiError) AllErrors() []error { return m }
+
+// DeletePromoCodeResponseValidationError is the validation error returned by
+// DeletePromoCodeResponse.Validate if the designated constraints aren't met.
+type DeletePromoCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeletePromoCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeletePromoCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeletePromoCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeletePromoCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeletePromoCodeResponseValidationError) ErrorName() string {
+	return "DeletePromoCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeletePromoCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeletePromoCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeletePromoCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeletePromoCodeResponseValidationError{}
+
+// Validate checks the field values on Resource with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Resource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Resource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourceMultiError, or nil
+// if none found.
+func (m *Resource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Resource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_Resource_Id_Pattern.MatchString(m.GetId()) {
+		err := ResourceValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[a-z0-9][a-z0-9-]{0,63}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := ResourceValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCapacity(); val < 1 || val > 100 {
+		err := ResourceValidationError{
+			field:  "Capacity",
+			reason: "value must be inside range [1, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceMultiError is an error wrapping multiple validation errors returned
+// by Resource.ValidateAll() if the designated constraints aren't met.
+type ResourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceMultiError) AllErrors() []error { return m }
+
+// ResourceValidationError is the validation error returned by
+// Resource.Validate if the designated constraints aren't met.
+type ResourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceValidationError{}
+
+var _Resource_Id_Pattern = regexp.MustCompile("^[a-z0-9][a-z0-9-]{0,63}$")
+
+// Validate checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListResourcesRequestMultiError, or nil if none found.
+func (m *ListResourcesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListResourcesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListResourcesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListResourcesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
+
+// ListResourcesRequestValidationError is the validation error returned by
+// ListResourcesRequest.Validate if the designated constraints aren't met.
+type ListResourcesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListResourcesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListResourcesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListResourcesRequestValidationError) ErrorName() string {
+	return "ListResourcesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListResourcesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListResourcesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListResourcesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListResourcesRequestValidationError{}
+
+// Validate checks the field values on ResourceList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResourceList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourceListMultiError, or
+// nil if none found.
+func (m *ResourceList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ResourceListValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ResourceListValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ResourceListValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ResourceListMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceListMultiError is an error wrapping multiple validation errors
+// returned by ResourceList.ValidateAll() if the designated constraints aren't met.
+type ResourceListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceListMultiError) AllErrors() []error { return m }
+
+// ResourceListValidationError is the validation error returned by
+// ResourceList.Validate if the designated constraints aren't met.
+type ResourceListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceListValidationError) ErrorName() string { return "ResourceListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceListValidationError{}
+
+// Validate checks the field values on CreateResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateResourceRequestMultiError, or nil if none found.
+func (m *CreateResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetResource() == nil {
+		err := CreateResourceRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateResourceRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateResourceRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateResourceRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateResourceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateResourceRequestMultiError) AllErrors() []error { return m }
+
+// CreateResourceRequestValidationError is the validation error returned by
+// CreateResourceRequest.Validate if the designated constraints aren't met.
+type CreateResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateResourceRequestValidationError) ErrorName() string {
+	return "CreateResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateResourceRequestValidationError{}
+
+// Validate checks the field values on UpdateResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateResourceRequestMultiError, or nil if none found.
+func (m *UpdateResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetResource() == nil {
+		err := UpdateResourceRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateResourceRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateResourceRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateResourceRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateResourceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateResourceRequestMultiError) AllErrors() []error { return m }
+
+// UpdateResourceRequestValidationError is the validation error returned by
+// UpdateResourceRequest.Validate if the designated constraints aren't met.
+type UpdateResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateResourceRequestValidationError) ErrorName() string {
+	return "UpdateResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateResourceRequestValidationError{}
+
+// Validate checks the field values on DeleteResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteResourceRequestMultiError, or nil if none found.
+func (m *DeleteResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DeleteResourceRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteResourceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteResourceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteResourceRequestMultiError) AllErrors() []error { return m }
+
+// DeleteResourceRequestValidationError is the validation error returned by
+// DeleteResourceRequest.Validate if the designated constraints aren't met.
+type DeleteResourceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteResourceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteResourceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteResourceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteResourceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteResourceRequestValidationError) ErrorName() string {
+	return "DeleteResourceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteResourceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteResourceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteResourceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteResourceRequestValidationError{}
+
+// Validate checks the field values on DeleteResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteResourceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteResourceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteResourceResponseMultiError, or nil if none found.
+func (m *DeleteResourceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteResourceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteResourceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteResourceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteResourceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteResourceResponseMultiError) AllErrors() []error { return m }
+
+// DeleteResourceResponseValidationError is the validation error returned by
+// DeleteResourceResponse.Validate if the designated constraints aren't met.
+type DeleteResourceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteResourceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteResourceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteResourceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteResourceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteResourceResponseValidationError) ErrorName() string {
+	return "DeleteResourceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteResourceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteResourceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteResourceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteResourceResponseValidationError{}
+
+// Validate checks the field values on BarberService with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BarberService) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BarberService with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BarberServiceMultiError, or
+// nil if none found.
+func (m *BarberService) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BarberService) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceType
+
+	// no validation rules for Name
+
+	if m.GetDurationMinutes() < 0 {
+		err := BarberServiceValidationError{
+			field:  "DurationMinutes",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() < 0 {
+		err := BarberServiceValidationError{
+			field:  "Price",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BarberServiceMultiError(errors)
+	}
+
+	return nil
+}
+
+// BarberServiceMultiError is an error wrapping multiple validation errors
+// returned by BarberService.ValidateAll() if the designated constraints
+// aren't met.
+type BarberServiceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BarberServiceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BarberServiceMultiError) AllErrors() []error { return m }
+
+// BarberServiceValidationError is the validation error returned by
+// BarberService.Validate if the designated constraints aren't met.
+type BarberServiceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BarberServiceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BarberServiceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BarberServiceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BarberServiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BarberServiceValidationError) ErrorName() string { return "BarberServiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BarberServiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBarberService.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BarberServiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BarberServiceValidationError{}
+
+// Validate checks the field values on GetBarberServicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBarberServicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBarberServicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBarberServicesRequestMultiError, or nil if none found.
+func (m *GetBarberServicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBarberServicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := GetBarberServicesRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBarberServicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBarberServicesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBarberServicesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBarberServicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBarberServicesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBarberServicesRequestMultiError) AllErrors() []error { return m }
+
+// GetBarberServicesRequestValidationError is the validation error returned by
+// GetBarberServicesRequest.Validate if the designated constraints aren't met.
+type GetBarberServicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBarberServicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBarberServicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBarberServicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBarberServicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBarberServicesRequestValidationError) ErrorName() string {
+	return "GetBarberServicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBarberServicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBarberServicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBarberServicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBarberServicesRequestValidationError{}
+
+// Validate checks the field values on BarberServiceList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BarberServiceList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BarberServiceList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BarberServiceListMultiError, or nil if none found.
+func (m *BarberServiceList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BarberServiceList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BarberServiceListValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BarberServiceListValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BarberServiceListValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BarberServiceListMultiError(errors)
+	}
+
+	return nil
+}
+
+// BarberServiceListMultiError is an error wrapping multiple validation errors
+// returned by BarberServiceList.ValidateAll() if the designated constraints
+// aren't met.
+type BarberServiceListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BarberServiceListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BarberServiceListMultiError) AllErrors() []error { return m }
+
+// BarberServiceListValidationError is the validation error returned by
+// BarberServiceList.Validate if the designated constraints aren't met.
+type BarberServiceListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BarberServiceListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BarberServiceListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BarberServiceListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BarberServiceListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BarberServiceListValidationError) ErrorName() string {
+	return "BarberServiceListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BarberServiceListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBarberServiceList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BarberServiceListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BarberServiceListValidationError{}
+
+// Validate checks the field values on SetBarberServicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBarberServicesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBarberServicesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBarberServicesRequestMultiError, or nil if none found.
+func (m *SetBarberServicesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBarberServicesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetBarberServicesRequestValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetBarberServicesRequestValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetBarberServicesRequestValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetBarberServicesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBarberServicesRequestMultiError is an error wrapping multiple validation
+// errors returned by SetBarberServicesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetBarberServicesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBarberServicesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBarberServicesRequestMultiError) AllErrors() []error { return m }
+
+// SetBarberServicesRequestValidationError is the validation error returned by
+// SetBarberServicesRequest.Validate if the designated constraints aren't met.
+type SetBarberServicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBarberServicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBarberServicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBarberServicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBarberServicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBarberServicesRequestValidationError) ErrorName() string {
+	return "SetBarberServicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBarberServicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBarberServicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBarberServicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBarberServicesRequestValidationError{}
+
+// Validate checks the field values on Barber with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Barber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Barber with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in BarberMultiError, or nil if none found.
+func (m *Barber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Barber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := BarberValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetDisplayName()); l < 1 || l > 100 {
+		err := BarberValidationError{
+			field:  "DisplayName",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBio()) > 2000 {
+		err := BarberValidationError{
+			field:  "Bio",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PhotoUrl
+
+	// no validation rules for Active
+
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BarberValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BarberValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BarberValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if val := m.GetCapacity(); val < 0 || val > 20 {
+		err := BarberValidationError{
+			field:  "Capacity",
+			reason: "value must be inside range [0, 20]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRating()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BarberValidationError{
+					field:  "Rating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BarberValidationError{
+					field:  "Rating",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRating()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BarberValidationError{
+				field:  "Rating",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BarberMultiError(errors)
+	}
+
+	return nil
+}
+
+// BarberMultiError is an error wrapping multiple validation errors returned by
+// Barber.ValidateAll() if the designated constraints aren't met.
+type BarberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BarberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BarberMultiError) AllErrors() []error { return m }
+
+// BarberValidationError is the validation error returned by Barber.Validate if
+// the designated constraints aren't met.
+type BarberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BarberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BarberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BarberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BarberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BarberValidationError) ErrorName() string { return "BarberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BarberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBarber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BarberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BarberValidationError{}
+
+// Validate checks the field values on ListBarbersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBarbersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBarbersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBarbersRequestMultiError, or nil if none found.
+func (m *ListBarbersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBarbersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeInactive
+
+	if len(errors) > 0 {
+		return ListBarbersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBarbersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBarbersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBarbersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBarbersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBarbersRequestMultiError) AllErrors() []error { return m }
+
+// ListBarbersRequestValidationError is the validation error returned by
+// ListBarbersRequest.Validate if the designated constraints aren't met.
+type ListBarbersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBarbersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBarbersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBarbersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBarbersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBarbersRequestValidationError) ErrorName() string {
+	return "ListBarbersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBarbersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBarbersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBarbersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBarbersRequestValidationError{}
+
+// Validate checks the field values on BarberList with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BarberList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BarberList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BarberListMultiError, or
+// nil if none found.
+func (m *BarberList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BarberList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBarbers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BarberListValidationError{
+						field:  fmt.Sprintf("Barbers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BarberListValidationError{
+						field:  fmt.Sprintf("Barbers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BarberListValidationError{
+					field:  fmt.Sprintf("Barbers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BarberListMultiError(errors)
+	}
+
+	return nil
+}
+
+// BarberListMultiError is an error wrapping multiple validation errors
+// returned by BarberList.ValidateAll() if the designated constraints aren't met.
+type BarberListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BarberListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BarberListMultiError) AllErrors() []error { return m }
+
+// BarberListValidationError is the validation error returned by
+// BarberList.Validate if the designated constraints aren't met.
+type BarberListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BarberListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BarberListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BarberListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BarberListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BarberListValidationError) ErrorName() string { return "BarberListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BarberListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBarberList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BarberListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BarberListValidationError{}
+
+// Validate checks the field values on GetBarberRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBarberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBarberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBarberRequestMultiError, or nil if none found.
+func (m *GetBarberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBarberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetBarberRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBarberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBarberRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBarberRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBarberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBarberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBarberRequestMultiError) AllErrors() []error { return m }
+
+// GetBarberRequestValidationError is the validation error returned by
+// GetBarberRequest.Validate if the designated constraints aren't met.
+type GetBarberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBarberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBarberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBarberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBarberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBarberRequestValidationError) ErrorName() string { return "GetBarberRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetBarberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBarberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBarberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBarberRequestValidationError{}
+
+// Validate checks the field values on CreateBarberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBarberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBarberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBarberRequestMultiError, or nil if none found.
+func (m *CreateBarberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBarberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBarber() == nil {
+		err := CreateBarberRequestValidationError{
+			field:  "Barber",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBarber()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBarberRequestValidationError{
+					field:  "Barber",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBarberRequestValidationError{
+					field:  "Barber",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBarber()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBarberRequestValidationError{
+				field:  "Barber",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBarberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBarberRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBarberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBarberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBarberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBarberRequestMultiError) AllErrors() []error { return m }
+
+// CreateBarberRequestValidationError is the validation error returned by
+// CreateBarberRequest.Validate if the designated constraints aren't met.
+type CreateBarberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBarberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBarberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBarberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBarberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBarberRequestValidationError) ErrorName() string {
+	return "CreateBarberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBarberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBarberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBarberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBarberRequestValidationError{}
+
+// Validate checks the field values on UpdateBarberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateBarberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBarberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBarberRequestMultiError, or nil if none found.
+func (m *UpdateBarberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBarberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBarber() == nil {
+		err := UpdateBarberRequestValidationError{
+			field:  "Barber",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBarber()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBarberRequestValidationError{
+					field:  "Barber",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBarberRequestValidationError{
+					field:  "Barber",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBarber()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBarberRequestValidationError{
+				field:  "Barber",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBarberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBarberRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBarberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBarberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBarberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBarberRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBarberRequestValidationError is the validation error returned by
+// UpdateBarberRequest.Validate if the designated constraints aren't met.
+type UpdateBarberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBarberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBarberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBarberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBarberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBarberRequestValidationError) ErrorName() string {
+	return "UpdateBarberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBarberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBarberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBarberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBarberRequestValidationError{}
+
+// Validate checks the field values on DeleteBarberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBarberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBarberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBarberRequestMultiError, or nil if none found.
+func (m *DeleteBarberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBarberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DeleteBarberRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteBarberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBarberRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBarberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBarberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBarberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBarberRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBarberRequestValidationError is the validation error returned by
+// DeleteBarberRequest.Validate if the designated constraints aren't met.
+type DeleteBarberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBarberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBarberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBarberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBarberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBarberRequestValidationError) ErrorName() string {
+	return "DeleteBarberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBarberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBarberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBarberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBarberRequestValidationError{}
+
+// Validate checks the field values on DeleteBarberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBarberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBarberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBarberResponseMultiError, or nil if none found.
+func (m *DeleteBarberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBarberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteBarberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBarberResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteBarberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBarberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBarberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBarberResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBarberResponseValidationError is the validation error returned by
+// DeleteBarberResponse.Validate if the designated constraints aren't met.
+type DeleteBarberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBarberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBarberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBarberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBarberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBarberResponseValidationError) ErrorName() string {
+	return "DeleteBarberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBarberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBarberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBarberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBarberResponseValidationError{}
+
+// Validate checks the field values on AddFavoriteBarberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddFavoriteBarberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddFavoriteBarberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddFavoriteBarberRequestMultiError, or nil if none found.
+func (m *AddFavoriteBarberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddFavoriteBarberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := AddFavoriteBarberRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddFavoriteBarberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddFavoriteBarberRequestMultiError is an error wrapping multiple validation
+// errors returned by AddFavoriteBarberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddFavoriteBarberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddFavoriteBarberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddFavoriteBarberRequestMultiError) AllErrors() []error { return m }
+
+// AddFavoriteBarberRequestValidationError is the validation error returned by
+// AddFavoriteBarberRequest.Validate if the designated constraints aren't met.
+type AddFavoriteBarberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddFavoriteBarberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddFavoriteBarberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddFavoriteBarberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddFavoriteBarberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddFavoriteBarberRequestValidationError) ErrorName() string {
+	return "AddFavoriteBarberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddFavoriteBarberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddFavoriteBarberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddFavoriteBarberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddFavoriteBarberRequestValidationError{}
+
+// Validate checks the field values on FavoriteBarber with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FavoriteBarber) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FavoriteBarber with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FavoriteBarberMultiError,
+// or nil if none found.
+func (m *FavoriteBarber) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FavoriteBarber) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if all {
+		switch v := interface{}(m.GetAddedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FavoriteBarberValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FavoriteBarberValidationError{
+					field:  "AddedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FavoriteBarberValidationError{
+				field:  "AddedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBarber()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FavoriteBarberValidationError{
+					field:  "Barber",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FavoriteBarberValidationError{
+					field:  "Barber",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBarber()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FavoriteBarberValidationError{
+				field:  "Barber",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FavoriteBarberMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavoriteBarberMultiError is an error wrapping multiple validation errors
+// returned by FavoriteBarber.ValidateAll() if the designated constraints
+// aren't met.
+type FavoriteBarberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavoriteBarberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavoriteBarberMultiError) AllErrors() []error { return m }
+
+// FavoriteBarberValidationError is the validation error returned by
+// FavoriteBarber.Validate if the designated constraints aren't met.
+type FavoriteBarberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavoriteBarberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavoriteBarberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavoriteBarberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavoriteBarberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavoriteBarberValidationError) ErrorName() string { return "FavoriteBarberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FavoriteBarberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavoriteBarber.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavoriteBarberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavoriteBarberValidationError{}
+
+// Validate checks the field values on RemoveFavoriteBarberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFavoriteBarberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFavoriteBarberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFavoriteBarberRequestMultiError, or nil if none found.
+func (m *RemoveFavoriteBarberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFavoriteBarberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBarberId()) < 1 {
+		err := RemoveFavoriteBarberRequestValidationError{
+			field:  "BarberId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveFavoriteBarberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFavoriteBarberRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveFavoriteBarberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveFavoriteBarberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFavoriteBarberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFavoriteBarberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveFavoriteBarberRequestValidationError is the validation error returned
+// by RemoveFavoriteBarberRequest.Validate if the designated constraints
+// aren't met.
+type RemoveFavoriteBarberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFavoriteBarberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFavoriteBarberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFavoriteBarberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFavoriteBarberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFavoriteBarberRequestValidationError) ErrorName() string {
+	return "RemoveFavoriteBarberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFavoriteBarberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFavoriteBarberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFavoriteBarberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFavoriteBarberRequestValidationError{}
+
+// Validate checks the field values on RemoveFavoriteBarberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveFavoriteBarberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveFavoriteBarberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveFavoriteBarberResponseMultiError, or nil if none found.
+func (m *RemoveFavoriteBarberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveFavoriteBarberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Removed
+
+	if len(errors) > 0 {
+		return RemoveFavoriteBarberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveFavoriteBarberResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveFavoriteBarberResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveFavoriteBarberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveFavoriteBarberResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveFavoriteBarberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveFavoriteBarberResponseValidationError is the validation error returned
+// by RemoveFavoriteBarberResponse.Validate if the designated constraints
+// aren't met.
+type RemoveFavoriteBarberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveFavoriteBarberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveFavoriteBarberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveFavoriteBarberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveFavoriteBarberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveFavoriteBarberResponseValidationError) ErrorName() string {
+	return "RemoveFavoriteBarberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveFavoriteBarberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveFavoriteBarberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveFavoriteBarberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveFavoriteBarberResponseValidationError{}
+
+// Validate checks the field values on ListFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListFavoritesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFavoritesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFavoritesRequestMultiError, or nil if none found.
+func (m *ListFavoritesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFavoritesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListFavoritesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFavoritesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListFavoritesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListFavoritesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFavoritesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFavoritesRequestMultiError) AllErrors() []error { return m }
+
+// ListFavoritesRequestValidationError is the validation error returned by
+// ListFavoritesRequest.Validate if the designated constraints aren't met.
+type ListFavoritesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFavoritesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFavoritesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFavoritesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFavoritesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFavoritesRequestValidationError) ErrorName() string {
+	return "ListFavoritesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFavoritesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFavoritesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFavoritesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFavoritesRequestValidationError{}
+
+// Validate checks the field values on FavoriteBarberList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FavoriteBarberList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FavoriteBarberList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FavoriteBarberListMultiError, or nil if none found.
+func (m *FavoriteBarberList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FavoriteBarberList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetFavorites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FavoriteBarberListValidationError{
+						field:  fmt.Sprintf("Favorites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FavoriteBarberListValidationError{
+						field:  fmt.Sprintf("Favorites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FavoriteBarberListValidationError{
+					field:  fmt.Sprintf("Favorites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FavoriteBarberListMultiError(errors)
+	}
+
+	return nil
+}
+
+// FavoriteBarberListMultiError is an error wrapping multiple validation errors
+// returned by FavoriteBarberList.ValidateAll() if the designated constraints
+// aren't met.
+type FavoriteBarberListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FavoriteBarberListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FavoriteBarberListMultiError) AllErrors() []error { return m }
+
+// FavoriteBarberListValidationError is the validation error returned by
+// FavoriteBarberList.Validate if the designated constraints aren't met.
+type FavoriteBarberListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FavoriteBarberListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FavoriteBarberListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FavoriteBarberListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FavoriteBarberListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FavoriteBarberListValidationError) ErrorName() string {
+	return "FavoriteBarberListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FavoriteBarberListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFavoriteBarberList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FavoriteBarberListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FavoriteBarberListValidationError{}
+
+// Validate checks the field values on GetQuoteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQuoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuoteRequestMultiError, or nil if none found.
+func (m *GetQuoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	if len(errors) > 0 {
+		return GetQuoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuoteRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQuoteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuoteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuoteRequestMultiError) AllErrors() []error { return m }
+
+// GetQuoteRequestValidationError is the validation error returned by
+// GetQuoteRequest.Validate if the designated constraints aren't met.
+type GetQuoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuoteRequestValidationError) ErrorName() string { return "GetQuoteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQuoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuoteRequestValidationError{}
+
+// Validate checks the field values on Quote with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Quote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Quote with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in QuoteMultiError, or nil if none found.
+func (m *Quote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Quote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BarberId
+
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuoteValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuoteValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuoteValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DurationMinutes
+
+	// no validation rules for Price
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return QuoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuoteMultiError is an error wrapping multiple validation errors returned by
+// Quote.ValidateAll() if the designated constraints aren't met.
+type QuoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuoteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuoteMultiError) AllErrors() []error { return m }
+
+// QuoteValidationError is the validation error returned by Quote.Validate if
+// the designated constraints aren't met.
+type QuoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuoteValidationError) ErrorName() string { return "QuoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuoteValidationError{}
+
+// Validate checks the field values on GetBookingHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookingHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookingHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookingHistoryRequestMultiError, or nil if none found.
+func (m *GetBookingHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookingHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBookingId()) < 1 {
+		err := GetBookingHistoryRequestValidationError{
+			field:  "BookingId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBookingHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookingHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBookingHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookingHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookingHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookingHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetBookingHistoryRequestValidationError is the validation error returned by
+// GetBookingHistoryRequest.Validate if the designated constraints aren't met.
+type GetBookingHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookingHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookingHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookingHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookingHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookingHistoryRequestValidationError) ErrorName() string {
+	return "GetBookingHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookingHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookingHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookingHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookingHistoryRequestValidationError{}
+
+// Validate checks the field values on GetBookingICSRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBookingICSRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBookingICSRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBookingICSRequestMultiError, or nil if none found.
+func (m *GetBookingICSRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBookingICSRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := GetBookingICSRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBookingICSRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBookingICSRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBookingICSRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBookingICSRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBookingICSRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBookingICSRequestMultiError) AllErrors() []error { return m }
+
+// GetBookingICSRequestValidationError is the validation error returned by
+// GetBookingICSRequest.Validate if the designated constraints aren't met.
+type GetBookingICSRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBookingICSRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBookingICSRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBookingICSRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBookingICSRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBookingICSRequestValidationError) ErrorName() string {
+	return "GetBookingICSRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBookingICSRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBookingICSRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBookingICSRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBookingICSRequestValidationError{}
+
+// Validate checks the field values on BookingICS with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookingICS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookingICS with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookingICSMultiError, or
+// nil if none found.
+func (m *BookingICS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookingICS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentType
+
+	// no validation rules for Filename
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return BookingICSMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookingICSMultiError is an error wrapping multiple validation errors
+// returned by BookingICS.ValidateAll() if the designated constraints aren't met.
+type BookingICSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookingICSMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookingICSMultiError) AllErrors() []error { return m }
+
+// BookingICSValidationError is the validation error returned by
+// BookingICS.Validate if the designated constraints aren't met.
+type BookingICSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookingICSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookingICSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookingICSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookingICSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookingICSValidationError) ErrorName() string { return "BookingICSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookingICSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookingICS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookingICSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookingICSValidationError{}
+
+// Validate checks the field values on FieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldChangeMultiError, or
+// nil if none found.
+func (m *FieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	if len(errors) > 0 {
+		return FieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldChangeMultiError is an error wrapping multiple validation errors
+// returned by FieldChange.ValidateAll() if the designated constraints aren't met.
+type FieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldChangeMultiError) AllErrors() []error { return m }
+
+// FieldChangeValidationError is the validation error returned by
+// FieldChange.Validate if the designated constraints aren't met.
+type FieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldChangeValidationError) ErrorName() string { return "FieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldChangeValidationError{}
+
+// Validate checks the field values on BookingHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BookingHistoryEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookingHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BookingHistoryEntryMultiError, or nil if none found.
+func (m *BookingHistoryEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookingHistoryEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Action
+
+	// no validation rules for ActorId
+
+	// no validation rules for At
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookingHistoryEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookingHistoryEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookingHistoryEntryValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BookingHistoryEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookingHistoryEntryMultiError is an error wrapping multiple validation
+// errors returned by BookingHistoryEntry.ValidateAll() if the designated
+// constraints aren't met.
+type BookingHistoryEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookingHistoryEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookingHistoryEntryMultiError) AllErrors() []error { return m }
+
+// BookingHistoryEntryValidationError is the validation error returned by
+// BookingHistoryEntry.Validate if the designated constraints aren't met.
+type BookingHistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookingHistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookingHistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookingHistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookingHistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookingHistoryEntryValidationError) ErrorName() string {
+	return "BookingHistoryEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BookingHistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookingHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookingHistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookingHistoryEntryValidationError{}
+
+// Validate checks the field values on BookingHistory with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BookingHistory) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BookingHistory with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BookingHistoryMultiError,
+// or nil if none found.
+func (m *BookingHistory) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BookingHistory) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BookingId
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BookingHistoryValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BookingHistoryValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BookingHistoryValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BookingHistoryMultiError(errors)
+	}
+
+	return nil
+}
+
+// BookingHistoryMultiError is an error wrapping multiple validation errors
+// returned by BookingHistory.ValidateAll() if the designated constraints
+// aren't met.
+type BookingHistoryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BookingHistoryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BookingHistoryMultiError) AllErrors() []error { return m }
+
+// BookingHistoryValidationError is the validation error returned by
+// BookingHistory.Validate if the designated constraints aren't met.
+type BookingHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BookingHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BookingHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BookingHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BookingHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BookingHistoryValidationError) ErrorName() string { return "BookingHistoryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BookingHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBookingHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BookingHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BookingHistoryValidationError{}
+
+// Validate checks the field values on ListAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogRequestMultiError, or nil if none found.
+func (m *ListAuditLogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActorId
+
+	// no validation rules for Method
+
+	if m.GetStartTime() != "" {
+
+		if !_ListAuditLogRequest_StartTime_Pattern.MatchString(m.GetStartTime()) {
+			err := ListAuditLogRequestValidationError{
+				field:  "StartTime",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEndTime() != "" {
+
+		if !_ListAuditLogRequest_EndTime_Pattern.MatchString(m.GetEndTime()) {
+			err := ListAuditLogRequestValidationError{
+				field:  "EndTime",
+				reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}(\\\\.\\\\d+)?(Z|[+-]\\\\d{2}:\\\\d{2})$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetLimit() < 0 {
+		err := ListAuditLogRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAuditLogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditLogRequestValidationError is the validation error returned by
+// ListAuditLogRequest.Validate if the designated constraints aren't met.
+type ListAuditLogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogRequestValidationError) ErrorName() string {
+	return "ListAuditLogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogRequestValidationError{}
+
+var _ListAuditLogRequest_StartTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2})$")
+
+var _ListAuditLogRequest_EndTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}(\\.\\d+)?(Z|[+-]\\d{2}:\\d{2})$")
+
+// Validate checks the field values on AuditEntry with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditEntryMultiError, or
+// nil if none found.
+func (m *AuditEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for ActorId
+
+	// no validation rules for IsBarber
+
+	// no validation rules for IsAdmin
+
+	// no validation rules for Request
+
+	// no validation rules for Code
+
+	// no validation rules for Error
+
+	// no validation rules for DurationMs
+
+	// no validation rules for At
+
+	if len(errors) > 0 {
+		return AuditEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditEntryMultiError is an error wrapping multiple validation errors
+// returned by AuditEntry.ValidateAll() if the designated constraints aren't met.
+type AuditEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditEntryMultiError) AllErrors() []error { return m }
+
+// AuditEntryValidationError is the validation error returned by
+// AuditEntry.Validate if the designated constraints aren't met.
+type AuditEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditEntryValidationError) ErrorName() string { return "AuditEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditEntryValidationError{}
+
+// Validate checks the field values on AuditLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditLogMultiError, or nil
+// if none found.
+func (m *AuditLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditLogValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditLogValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditLogValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuditLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditLogMultiError is an error wrapping multiple validation errors returned
+// by AuditLog.ValidateAll() if the designated constraints aren't met.
+type AuditLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditLogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditLogMultiError) AllErrors() []error { return m }
+
+// AuditLogValidationError is the validation error returned by
+// AuditLog.Validate if the designated constraints aren't met.
+type AuditLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditLogValidationError) ErrorName() string { return "AuditLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditLogValidationError{}
+
+// Validate checks the field values on DeleteBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookingRequestMultiError, or nil if none found.
+func (m *DeleteBookingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteBookingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookingRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBookingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBookingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookingRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBookingRequestValidationError is the validation error returned by
+// DeleteBookingRequest.Validate if the designated constraints aren't met.
+type DeleteBookingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookingRequestValidationError) ErrorName() string {
+	return "DeleteBookingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookingRequestValidationError{}
+
+// Validate checks the field values on DeleteBookingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBookingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBookingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBookingResponseMultiError, or nil if none found.
+func (m *DeleteBookingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBookingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return DeleteBookingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBookingResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteBookingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBookingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBookingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBookingResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBookingResponseValidationError is the validation error returned by
+// DeleteBookingResponse.Validate if the designated constraints aren't met.
+type DeleteBookingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBookingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBookingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBookingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBookingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBookingResponseValidationError) ErrorName() string {
+	return "DeleteBookingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBookingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBookingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBookingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBookingResponseValidationError{}
+
+// Validate checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Webhook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Webhook with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in WebhookMultiError, or nil if none found.
+func (m *Webhook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Webhook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Url
+
+	// no validation rules for Secret
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookMultiError is an error wrapping multiple validation errors returned
+// by Webhook.ValidateAll() if the designated constraints aren't met.
+type WebhookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookMultiError) AllErrors() []error { return m }
+
+// WebhookValidationError is the validation error returned by Webhook.Validate
+// if the designated constraints aren't met.
+type WebhookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookValidationError) ErrorName() string { return "WebhookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookValidationError{}
+
+// Validate checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateWebhookRequestMultiError, or nil if none found.
+func (m *CreateWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateWebhookRequest_Url_Pattern.MatchString(m.GetUrl()) {
+		err := CreateWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value does not match regex pattern \"^https?://[^\\\\s/]+\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEventTypes()) < 1 {
+		err := CreateWebhookRequestValidationError{
+			field:  "EventTypes",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetEventTypes() {
+		_, _ = idx, item
+
+		if _, ok := _CreateWebhookRequest_EventTypes_InLookup[item]; !ok {
+			err := CreateWebhookRequestValidationError{
+				field:  fmt.Sprintf("EventTypes[%v]", idx),
+				reason: "value must be in list [booking.created booking.updated booking.rescheduled booking.confirmed booking.cancelled]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWebhookRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWebhookRequestMultiError) AllErrors() []error { return m }
+
+// CreateWebhookRequestValidationError is the validation error returned by
+// CreateWebhookRequest.Validate if the designated constraints aren't met.
+type CreateWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWebhookRequestValidationError) ErrorName() string {
+	return "CreateWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWebhookRequestValidationError{}
+
+var _CreateWebhookRequest_Url_Pattern = regexp.MustCompile("^https?://[^\\s/]+")
+
+var _CreateWebhookRequest_EventTypes_InLookup = map[string]struct{}{
+	"booking.created":     {},
+	"booking.updated":     {},
+	"booking.rescheduled": {},
+	"booking.confirmed":   {},
+	"booking.cancelled":   {},
+}
+
+// Validate checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhooksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhooksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhooksRequestMultiError, or nil if none found.
+func (m *ListWebhooksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhooksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListWebhooksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhooksRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWebhooksRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWebhooksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhooksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhooksRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhooksRequestValidationError is the validation error returned by
+// ListWebhooksRequest.Validate if the designated constraints aren't met.
+type ListWebhooksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhooksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhooksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhooksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhooksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhooksRequestValidationError) ErrorName() string {
+	return "ListWebhooksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhooksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhooksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhooksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhooksRequestValidationError{}
+
+// Validate checks the field values on WebhookList with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookList with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookListMultiError, or
+// nil if none found.
+func (m *WebhookList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWebhooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WebhookListValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WebhookListValidationError{
+						field:  fmt.Sprintf("Webhooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WebhookListValidationError{
+					field:  fmt.Sprintf("Webhooks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WebhookListMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookListMultiError is an error wrapping multiple validation errors
+// returned by WebhookList.ValidateAll() if the designated constraints aren't met.
+type WebhookListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookListMultiError) AllErrors() []error { return m }
+
+// WebhookListValidationError is the validation error returned by
+// WebhookList.Validate if the designated constraints aren't met.
+type WebhookListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookListValidationError) ErrorName() string { return "WebhookListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookListValidationError{}
+
+// Validate checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookRequestMultiError, or nil if none found.
+func (m *DeleteWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := DeleteWebhookRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookRequestValidationError is the validation error returned by
+// DeleteWebhookRequest.Validate if the designated constraints aren't met.
+type DeleteWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookRequestValidationError) ErrorName() string {
+	return "DeleteWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookRequestValidationError{}
+
+// Validate checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWebhookResponseMultiError, or nil if none found.
+func (m *DeleteWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return DeleteWebhookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWebhookResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWebhookResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWebhookResponseValidationError is the validation error returned by
+// DeleteWebhookResponse.Validate if the designated constraints aren't met.
+type DeleteWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWebhookResponseValidationError) ErrorName() string {
+	return "DeleteWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWebhookResponseValidationError{}
@@ -19,13 +19,84 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBooking_FullMethodName         = "/booking.BookingService/CreateBooking"
-	BookingService_GetBooking_FullMethodName            = "/booking.BookingService/GetBooking"
-	BookingService_UpdateBooking_FullMethodName         = "/booking.BookingService/UpdateBooking"
-	BookingService_CancelBooking_FullMethodName         = "/booking.BookingService/CancelBooking"
-	BookingService_GetUserBookings_FullMethodName       = "/booking.BookingService/GetUserBookings"
-	BookingService_GetBarberBookings_FullMethodName     = "/booking.BookingService/GetBarberBookings"
-	BookingService_GetAvailableTimeSlots_FullMethodName = "/booking.BookingService/GetAvailableTimeSlots"
+	BookingService_CreateBooking_FullMethodName              = "/booking.BookingService/CreateBooking"
+	BookingService_HoldTimeSlot_FullMethodName               = "/booking.BookingService/HoldTimeSlot"
+	BookingService_RebookLast_FullMethodName                 = "/booking.BookingService/RebookLast"
+	BookingService_GetBooking_FullMethodName                 = "/booking.BookingService/GetBooking"
+	BookingService_UpdateBooking_FullMethodName              = "/booking.BookingService/UpdateBooking"
+	BookingService_RescheduleBooking_FullMethodName          = "/booking.BookingService/RescheduleBooking"
+	BookingService_ConfirmBooking_FullMethodName             = "/booking.BookingService/ConfirmBooking"
+	BookingService_CompleteBooking_FullMethodName            = "/booking.BookingService/CompleteBooking"
+	BookingService_CancelBooking_FullMethodName              = "/booking.BookingService/CancelBooking"
+	BookingService_DeleteBooking_FullMethodName              = "/booking.BookingService/DeleteBooking"
+	BookingService_ListBookings_FullMethodName               = "/booking.BookingService/ListBookings"
+	BookingService_WatchBookings_FullMethodName              = "/booking.BookingService/WatchBookings"
+	BookingService_GetUserBookings_FullMethodName            = "/booking.BookingService/GetUserBookings"
+	BookingService_GetBarberBookings_FullMethodName          = "/booking.BookingService/GetBarberBookings"
+	BookingService_GetAvailableTimeSlots_FullMethodName      = "/booking.BookingService/GetAvailableTimeSlots"
+	BookingService_CheckInBooking_FullMethodName             = "/booking.BookingService/CheckInBooking"
+	BookingService_MarkNoShow_FullMethodName                 = "/booking.BookingService/MarkNoShow"
+	BookingService_GetUserReliability_FullMethodName         = "/booking.BookingService/GetUserReliability"
+	BookingService_GetReliabilityReport_FullMethodName       = "/booking.BookingService/GetReliabilityReport"
+	BookingService_GetCustomerSummary_FullMethodName         = "/booking.BookingService/GetCustomerSummary"
+	BookingService_GetBookingHistory_FullMethodName          = "/booking.BookingService/GetBookingHistory"
+	BookingService_GetBookingICS_FullMethodName              = "/booking.BookingService/GetBookingICS"
+	BookingService_ListAuditLog_FullMethodName               = "/booking.BookingService/ListAuditLog"
+	BookingService_AddBookingAttachment_FullMethodName       = "/booking.BookingService/AddBookingAttachment"
+	BookingService_GetBookingByExternalRef_FullMethodName    = "/booking.BookingService/GetBookingByExternalRef"
+	BookingService_RecordPOSCompletion_FullMethodName        = "/booking.BookingService/RecordPOSCompletion"
+	BookingService_SubmitSurveyResponse_FullMethodName       = "/booking.BookingService/SubmitSurveyResponse"
+	BookingService_GetBarberSurveyScores_FullMethodName      = "/booking.BookingService/GetBarberSurveyScores"
+	BookingService_SubmitReview_FullMethodName               = "/booking.BookingService/SubmitReview"
+	BookingService_ListBarberReviews_FullMethodName          = "/booking.BookingService/ListBarberReviews"
+	BookingService_GetBarberRating_FullMethodName            = "/booking.BookingService/GetBarberRating"
+	BookingService_GetBarberStats_FullMethodName             = "/booking.BookingService/GetBarberStats"
+	BookingService_GetDemandHeatmap_FullMethodName           = "/booking.BookingService/GetDemandHeatmap"
+	BookingService_GetDailyAgenda_FullMethodName             = "/booking.BookingService/GetDailyAgenda"
+	BookingService_ExportPayroll_FullMethodName              = "/booking.BookingService/ExportPayroll"
+	BookingService_FinalizePayrollPeriod_FullMethodName      = "/booking.BookingService/FinalizePayrollPeriod"
+	BookingService_GetRevenueReport_FullMethodName           = "/booking.BookingService/GetRevenueReport"
+	BookingService_ExportRevenueReport_FullMethodName        = "/booking.BookingService/ExportRevenueReport"
+	BookingService_GetRetentionPolicy_FullMethodName         = "/booking.BookingService/GetRetentionPolicy"
+	BookingService_UpdateRetentionPolicy_FullMethodName      = "/booking.BookingService/UpdateRetentionPolicy"
+	BookingService_ExportUserData_FullMethodName             = "/booking.BookingService/ExportUserData"
+	BookingService_EraseUserData_FullMethodName              = "/booking.BookingService/EraseUserData"
+	BookingService_GetCancellationPolicy_FullMethodName      = "/booking.BookingService/GetCancellationPolicy"
+	BookingService_UpdateCancellationPolicy_FullMethodName   = "/booking.BookingService/UpdateCancellationPolicy"
+	BookingService_GetWorkingHours_FullMethodName            = "/booking.BookingService/GetWorkingHours"
+	BookingService_SetWorkingHours_FullMethodName            = "/booking.BookingService/SetWorkingHours"
+	BookingService_ListHolidays_FullMethodName               = "/booking.BookingService/ListHolidays"
+	BookingService_AddHoliday_FullMethodName                 = "/booking.BookingService/AddHoliday"
+	BookingService_RemoveHoliday_FullMethodName              = "/booking.BookingService/RemoveHoliday"
+	BookingService_GetNextAvailableSlot_FullMethodName       = "/booking.BookingService/GetNextAvailableSlot"
+	BookingService_GetAvailableTimeSlotsRange_FullMethodName = "/booking.BookingService/GetAvailableTimeSlotsRange"
+	BookingService_ListCatalogServices_FullMethodName        = "/booking.BookingService/ListCatalogServices"
+	BookingService_CreateCatalogService_FullMethodName       = "/booking.BookingService/CreateCatalogService"
+	BookingService_UpdateCatalogService_FullMethodName       = "/booking.BookingService/UpdateCatalogService"
+	BookingService_DeleteCatalogService_FullMethodName       = "/booking.BookingService/DeleteCatalogService"
+	BookingService_ListPromoCodes_FullMethodName             = "/booking.BookingService/ListPromoCodes"
+	BookingService_GetPromoCode_FullMethodName               = "/booking.BookingService/GetPromoCode"
+	BookingService_CreatePromoCode_FullMethodName            = "/booking.BookingService/CreatePromoCode"
+	BookingService_UpdatePromoCode_FullMethodName            = "/booking.BookingService/UpdatePromoCode"
+	BookingService_DeletePromoCode_FullMethodName            = "/booking.BookingService/DeletePromoCode"
+	BookingService_ListResources_FullMethodName              = "/booking.BookingService/ListResources"
+	BookingService_CreateResource_FullMethodName             = "/booking.BookingService/CreateResource"
+	BookingService_UpdateResource_FullMethodName             = "/booking.BookingService/UpdateResource"
+	BookingService_DeleteResource_FullMethodName             = "/booking.BookingService/DeleteResource"
+	BookingService_GetBarberServices_FullMethodName          = "/booking.BookingService/GetBarberServices"
+	BookingService_SetBarberServices_FullMethodName          = "/booking.BookingService/SetBarberServices"
+	BookingService_ListBarbers_FullMethodName                = "/booking.BookingService/ListBarbers"
+	BookingService_GetBarber_FullMethodName                  = "/booking.BookingService/GetBarber"
+	BookingService_CreateBarber_FullMethodName               = "/booking.BookingService/CreateBarber"
+	BookingService_UpdateBarber_FullMethodName               = "/booking.BookingService/UpdateBarber"
+	BookingService_DeleteBarber_FullMethodName               = "/booking.BookingService/DeleteBarber"
+	BookingService_AddFavoriteBarber_FullMethodName          = "/booking.BookingService/AddFavoriteBarber"
+	BookingService_RemoveFavoriteBarber_FullMethodName       = "/booking.BookingService/RemoveFavoriteBarber"
+	BookingService_ListFavorites_FullMethodName              = "/booking.BookingService/ListFavorites"
+	BookingService_GetQuote_FullMethodName                   = "/booking.BookingService/GetQuote"
+	BookingService_CreateWebhook_FullMethodName              = "/booking.BookingService/CreateWebhook"
+	BookingService_ListWebhooks_FullMethodName               = "/booking.BookingService/ListWebhooks"
+	BookingService_DeleteWebhook_FullMethodName              = "/booking.BookingService/DeleteWebhook"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -34,18 +105,162 @@ const (
 type BookingServiceClient interface {
 	// Create a new booking
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Reserve a slot for a few minutes while the customer finishes booking it
+	HoldTimeSlot(ctx context.Context, in *HoldTimeSlotRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Book the customer's most recent completed booking again, at the barber's next free slot
+	RebookLast(ctx context.Context, in *RebookLastRequest, opts ...grpc.CallOption) (*Booking, error)
 	// Get a specific booking by ID
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	// Update an existing booking
 	UpdateBooking(ctx context.Context, in *UpdateBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Move a booking to a new start time, atomically checking the barber's availability
+	RescheduleBooking(ctx context.Context, in *RescheduleBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Confirm a pending booking (the booked barber or admins only)
+	ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Mark a booking as completed (the booked barber or admins only)
+	CompleteBooking(ctx context.Context, in *CompleteBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	// Cancel a booking
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	// Soft-delete a booking, purged with its history after the retention period (admins only)
+	DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*DeleteBookingResponse, error)
+	// List bookings matching filters, sorted
+	ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*BookingList, error)
+	// Stream changes to a user's or a barber's bookings as they happen
+	WatchBookings(ctx context.Context, in *WatchBookingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BookingEvent], error)
 	// Get all bookings for a user
 	GetUserBookings(ctx context.Context, in *GetUserBookingsRequest, opts ...grpc.CallOption) (*BookingList, error)
 	// Get all bookings for a barber
 	GetBarberBookings(ctx context.Context, in *GetBarberBookingsRequest, opts ...grpc.CallOption) (*BookingList, error)
 	// Get available time slots for a barber on a specific date
 	GetAvailableTimeSlots(ctx context.Context, in *GetAvailableTimeSlotsRequest, opts ...grpc.CallOption) (*TimeSlotList, error)
+	// Check in the customer of a booking (barbers only)
+	CheckInBooking(ctx context.Context, in *CheckInBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Record that the customer didn't turn up for a booking (barbers only)
+	MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Get how a customer's bookings turned out (barbers and admins only)
+	GetUserReliability(ctx context.Context, in *GetUserReliabilityRequest, opts ...grpc.CallOption) (*UserReliability, error)
+	// Get no-show and cancellation rates per customer or barber over a period,
+	// highest first (barbers and admins only)
+	GetReliabilityReport(ctx context.Context, in *GetReliabilityReportRequest, opts ...grpc.CallOption) (*ReliabilityReport, error)
+	// Get a customer's visits: the last, the usual service and how often they come (barbers and admins only)
+	GetCustomerSummary(ctx context.Context, in *GetCustomerSummaryRequest, opts ...grpc.CallOption) (*CustomerSummary, error)
+	// Get every change made to a booking, oldest first
+	GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*BookingHistory, error)
+	// Get a booking as an iCalendar file to add to a calendar app. Importing it
+	// again after a reschedule or cancellation updates the same event.
+	GetBookingICS(ctx context.Context, in *GetBookingICSRequest, opts ...grpc.CallOption) (*BookingICS, error)
+	// Query the log of authenticated calls that changed something (admins only)
+	ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error)
+	// Attach a reference image to a booking by URL or through a pre-signed upload
+	AddBookingAttachment(ctx context.Context, in *AddBookingAttachmentRequest, opts ...grpc.CallOption) (*AddBookingAttachmentResponse, error)
+	// Get a booking by the external (e.g. point-of-sale) reference attached at creation
+	GetBookingByExternalRef(ctx context.Context, in *GetBookingByExternalRefRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Record a point-of-sale payment and mark the booking completed
+	RecordPOSCompletion(ctx context.Context, in *RecordPOSCompletionRequest, opts ...grpc.CallOption) (*Booking, error)
+	// Record the response to a post-appointment satisfaction survey (authenticated by the survey token)
+	SubmitSurveyResponse(ctx context.Context, in *SubmitSurveyResponseRequest, opts ...grpc.CallOption) (*SubmitSurveyResponseResponse, error)
+	// Get aggregate satisfaction survey scores for a barber
+	GetBarberSurveyScores(ctx context.Context, in *GetBarberSurveyScoresRequest, opts ...grpc.CallOption) (*SurveyScores, error)
+	// Review a completed booking, once (the booking's customer only)
+	SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*Review, error)
+	// List a barber's reviews, newest first
+	ListBarberReviews(ctx context.Context, in *ListBarberReviewsRequest, opts ...grpc.CallOption) (*ReviewList, error)
+	// Get the number of a barber's reviews and their average rating
+	GetBarberRating(ctx context.Context, in *GetBarberRatingRequest, opts ...grpc.CallOption) (*BarberRating, error)
+	// Get a barber's booking statistics for a week or month, for their dashboard (barbers only)
+	GetBarberStats(ctx context.Context, in *GetBarberStatsRequest, opts ...grpc.CallOption) (*BarberStats, error)
+	// Get past bookings by the weekday and hour they started at, to plan staffing (barbers only)
+	GetDemandHeatmap(ctx context.Context, in *GetDemandHeatmapRequest, opts ...grpc.CallOption) (*DemandHeatmap, error)
+	// Get a barber's bookings for a day in the order they start, with the free time left (barbers only)
+	GetDailyAgenda(ctx context.Context, in *GetDailyAgendaRequest, opts ...grpc.CallOption) (*DailyAgenda, error)
+	// Export a month's per-barber payroll (admins only)
+	ExportPayroll(ctx context.Context, in *ExportPayrollRequest, opts ...grpc.CallOption) (*PayrollExport, error)
+	// Finalize and lock a past month's payroll (admins only)
+	FinalizePayrollPeriod(ctx context.Context, in *FinalizePayrollPeriodRequest, opts ...grpc.CallOption) (*PayrollExport, error)
+	// Get completed-booking revenue by period, barber and service (admins only)
+	GetRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...grpc.CallOption) (*RevenueReport, error)
+	// Export a revenue report as CSV for accounting (admins only)
+	ExportRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...grpc.CallOption) (*RevenueExport, error)
+	// Get the shop's data retention policy (admins only)
+	GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error)
+	// Update the shop's data retention policy (admins only)
+	UpdateRetentionPolicy(ctx context.Context, in *UpdateRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error)
+	// Export everything stored about a customer as JSON (admins only)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error)
+	// Anonymize or delete a customer's personal data (admins only)
+	EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*EraseUserDataResponse, error)
+	// Get the shop's cancellation policy
+	GetCancellationPolicy(ctx context.Context, in *GetCancellationPolicyRequest, opts ...grpc.CallOption) (*CancellationPolicy, error)
+	// Update the shop's cancellation policy (admins only)
+	UpdateCancellationPolicy(ctx context.Context, in *UpdateCancellationPolicyRequest, opts ...grpc.CallOption) (*CancellationPolicy, error)
+	// Get a barber's weekly working hours
+	GetWorkingHours(ctx context.Context, in *GetWorkingHoursRequest, opts ...grpc.CallOption) (*BarberSchedule, error)
+	// Replace a barber's weekly working hours (the barber themselves or admins)
+	SetWorkingHours(ctx context.Context, in *SetWorkingHoursRequest, opts ...grpc.CallOption) (*BarberSchedule, error)
+	// List the days the shop is closed
+	ListHolidays(ctx context.Context, in *ListHolidaysRequest, opts ...grpc.CallOption) (*HolidayList, error)
+	// Close the shop on a day (admins only)
+	AddHoliday(ctx context.Context, in *AddHolidayRequest, opts ...grpc.CallOption) (*Holiday, error)
+	// Reopen the shop on a day (admins only)
+	RemoveHoliday(ctx context.Context, in *RemoveHolidayRequest, opts ...grpc.CallOption) (*RemoveHolidayResponse, error)
+	// Find a barber's earliest available slots, across as many days as needed
+	GetNextAvailableSlot(ctx context.Context, in *GetNextAvailableSlotRequest, opts ...grpc.CallOption) (*TimeSlotList, error)
+	// Get available time slots for a barber on each day of a date range
+	GetAvailableTimeSlotsRange(ctx context.Context, in *GetAvailableTimeSlotsRangeRequest, opts ...grpc.CallOption) (*DayTimeSlotsList, error)
+	// List the services the shop offers
+	ListCatalogServices(ctx context.Context, in *ListCatalogServicesRequest, opts ...grpc.CallOption) (*CatalogServiceList, error)
+	// Add a service to the catalog (admins only)
+	CreateCatalogService(ctx context.Context, in *CreateCatalogServiceRequest, opts ...grpc.CallOption) (*CatalogService, error)
+	// Change a catalog service (admins only)
+	UpdateCatalogService(ctx context.Context, in *UpdateCatalogServiceRequest, opts ...grpc.CallOption) (*CatalogService, error)
+	// Remove a service from the catalog (admins only)
+	DeleteCatalogService(ctx context.Context, in *DeleteCatalogServiceRequest, opts ...grpc.CallOption) (*DeleteCatalogServiceResponse, error)
+	// List the promo codes with how often each was used (admins only)
+	ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*PromoCodeList, error)
+	// Get a promo code with how often it was used (admins only)
+	GetPromoCode(ctx context.Context, in *GetPromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error)
+	// Add a promo code (admins only)
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error)
+	// Change a promo code's discount, validity or limit (admins only)
+	UpdatePromoCode(ctx context.Context, in *UpdatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error)
+	// Remove a promo code (admins only)
+	DeletePromoCode(ctx context.Context, in *DeletePromoCodeRequest, opts ...grpc.CallOption) (*DeletePromoCodeResponse, error)
+	// List the shop's shared equipment, e.g. wash stations
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ResourceList, error)
+	// Add a piece of shared equipment (admins only)
+	CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*Resource, error)
+	// Change a piece of shared equipment (admins only)
+	UpdateResource(ctx context.Context, in *UpdateResourceRequest, opts ...grpc.CallOption) (*Resource, error)
+	// Remove a piece of shared equipment (admins only)
+	DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error)
+	// Get the services a barber offers with their durations and prices
+	GetBarberServices(ctx context.Context, in *GetBarberServicesRequest, opts ...grpc.CallOption) (*BarberServiceList, error)
+	// Replace the services a barber offers (the barber themselves or admins only)
+	SetBarberServices(ctx context.Context, in *SetBarberServicesRequest, opts ...grpc.CallOption) (*BarberServiceList, error)
+	// List the barbers taking bookings, with the services they offer. Needs no sign-in.
+	ListBarbers(ctx context.Context, in *ListBarbersRequest, opts ...grpc.CallOption) (*BarberList, error)
+	// Get a barber's profile
+	GetBarber(ctx context.Context, in *GetBarberRequest, opts ...grpc.CallOption) (*Barber, error)
+	// Add a barber's profile (admins only)
+	CreateBarber(ctx context.Context, in *CreateBarberRequest, opts ...grpc.CallOption) (*Barber, error)
+	// Change a barber's profile (the barber themselves or admins; only admins can change whether they're active)
+	UpdateBarber(ctx context.Context, in *UpdateBarberRequest, opts ...grpc.CallOption) (*Barber, error)
+	// Remove a barber's profile (admins only)
+	DeleteBarber(ctx context.Context, in *DeleteBarberRequest, opts ...grpc.CallOption) (*DeleteBarberResponse, error)
+	// Mark a barber as one of the caller's favorites
+	AddFavoriteBarber(ctx context.Context, in *AddFavoriteBarberRequest, opts ...grpc.CallOption) (*FavoriteBarber, error)
+	// Remove a barber from the caller's favorites
+	RemoveFavoriteBarber(ctx context.Context, in *RemoveFavoriteBarberRequest, opts ...grpc.CallOption) (*RemoveFavoriteBarberResponse, error)
+	// List the caller's favorite barbers, most recently added first
+	ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*FavoriteBarberList, error)
+	// Get the price and duration of a prospective booking
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*Quote, error)
+	// Register a URL to be sent booking events (admins only)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error)
+	// List the registered webhooks, without their secrets (admins only)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*WebhookList, error)
+	// Stop sending booking events to a webhook (admins only)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -66,6 +281,26 @@ func (c *bookingServiceClient) CreateBooking(ctx context.Context, in *CreateBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) HoldTimeSlot(ctx context.Context, in *HoldTimeSlotRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_HoldTimeSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RebookLast(ctx context.Context, in *RebookLastRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_RebookLast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Booking)
@@ -86,6 +321,36 @@ func (c *bookingServiceClient) UpdateBooking(ctx context.Context, in *UpdateBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) RescheduleBooking(ctx context.Context, in *RescheduleBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_RescheduleBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_ConfirmBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CompleteBooking(ctx context.Context, in *CompleteBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_CompleteBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CancelBookingResponse)
@@ -96,6 +361,45 @@ func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*DeleteBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListBookings(ctx context.Context, in *ListBookingsRequest, opts ...grpc.CallOption) (*BookingList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingList)
+	err := c.cc.Invoke(ctx, BookingService_ListBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) WatchBookings(ctx context.Context, in *WatchBookingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BookingEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookingService_ServiceDesc.Streams[0], BookingService_WatchBookings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchBookingsRequest, BookingEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_WatchBookingsClient = grpc.ServerStreamingClient[BookingEvent]
+
 func (c *bookingServiceClient) GetUserBookings(ctx context.Context, in *GetUserBookingsRequest, opts ...grpc.CallOption) (*BookingList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BookingList)
@@ -126,198 +430,2456 @@ func (c *bookingServiceClient) GetAvailableTimeSlots(ctx context.Context, in *Ge
 	return out, nil
 }
 
-// BookingServiceServer is the server API for BookingService service.
-// All implementations must embed UnimplementedBookingServiceServer
-// for forward compatibility.
-type BookingServiceServer interface {
-	// Create a new booking
-	CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error)
-	// Get a specific booking by ID
-	GetBooking(context.Context, *GetBookingRequest) (*Booking, error)
-	// Update an existing booking
-	UpdateBooking(context.Context, *UpdateBookingRequest) (*Booking, error)
-	// Cancel a booking
-	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
-	// Get all bookings for a user
-	GetUserBookings(context.Context, *GetUserBookingsRequest) (*BookingList, error)
-	// Get all bookings for a barber
-	GetBarberBookings(context.Context, *GetBarberBookingsRequest) (*BookingList, error)
-	// Get available time slots for a barber on a specific date
-	GetAvailableTimeSlots(context.Context, *GetAvailableTimeSlotsRequest) (*TimeSlotList, error)
-	mustEmbedUnimplementedBookingServiceServer()
+func (c *bookingServiceClient) CheckInBooking(ctx context.Context, in *CheckInBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_CheckInBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedBookingServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedBookingServiceServer struct{}
+func (c *bookingServiceClient) MarkNoShow(ctx context.Context, in *MarkNoShowRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_MarkNoShow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
+func (c *bookingServiceClient) GetUserReliability(ctx context.Context, in *GetUserReliabilityRequest, opts ...grpc.CallOption) (*UserReliability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserReliability)
+	err := c.cc.Invoke(ctx, BookingService_GetUserReliability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*Booking, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+
+func (c *bookingServiceClient) GetReliabilityReport(ctx context.Context, in *GetReliabilityReportRequest, opts ...grpc.CallOption) (*ReliabilityReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReliabilityReport)
+	err := c.cc.Invoke(ctx, BookingService_GetReliabilityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBookingServiceServer) UpdateBooking(context.Context, *UpdateBookingRequest) (*Booking, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateBooking not implemented")
+
+func (c *bookingServiceClient) GetCustomerSummary(ctx context.Context, in *GetCustomerSummaryRequest, opts ...grpc.CallOption) (*CustomerSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerSummary)
+	err := c.cc.Invoke(ctx, BookingService_GetCustomerSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+
+func (c *bookingServiceClient) GetBookingHistory(ctx context.Context, in *GetBookingHistoryRequest, opts ...grpc.CallOption) (*BookingHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingHistory)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookingICS(ctx context.Context, in *GetBookingICSRequest, opts ...grpc.CallOption) (*BookingICS, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookingICS)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingICS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*AuditLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditLog)
+	err := c.cc.Invoke(ctx, BookingService_ListAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AddBookingAttachment(ctx context.Context, in *AddBookingAttachmentRequest, opts ...grpc.CallOption) (*AddBookingAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBookingAttachmentResponse)
+	err := c.cc.Invoke(ctx, BookingService_AddBookingAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBookingByExternalRef(ctx context.Context, in *GetBookingByExternalRefRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_GetBookingByExternalRef_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RecordPOSCompletion(ctx context.Context, in *RecordPOSCompletionRequest, opts ...grpc.CallOption) (*Booking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, BookingService_RecordPOSCompletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SubmitSurveyResponse(ctx context.Context, in *SubmitSurveyResponseRequest, opts ...grpc.CallOption) (*SubmitSurveyResponseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitSurveyResponseResponse)
+	err := c.cc.Invoke(ctx, BookingService_SubmitSurveyResponse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBarberSurveyScores(ctx context.Context, in *GetBarberSurveyScoresRequest, opts ...grpc.CallOption) (*SurveyScores, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SurveyScores)
+	err := c.cc.Invoke(ctx, BookingService_GetBarberSurveyScores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*Review, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Review)
+	err := c.cc.Invoke(ctx, BookingService_SubmitReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListBarberReviews(ctx context.Context, in *ListBarberReviewsRequest, opts ...grpc.CallOption) (*ReviewList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewList)
+	err := c.cc.Invoke(ctx, BookingService_ListBarberReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBarberRating(ctx context.Context, in *GetBarberRatingRequest, opts ...grpc.CallOption) (*BarberRating, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BarberRating)
+	err := c.cc.Invoke(ctx, BookingService_GetBarberRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBarberStats(ctx context.Context, in *GetBarberStatsRequest, opts ...grpc.CallOption) (*BarberStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BarberStats)
+	err := c.cc.Invoke(ctx, BookingService_GetBarberStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetDemandHeatmap(ctx context.Context, in *GetDemandHeatmapRequest, opts ...grpc.CallOption) (*DemandHeatmap, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DemandHeatmap)
+	err := c.cc.Invoke(ctx, BookingService_GetDemandHeatmap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetDailyAgenda(ctx context.Context, in *GetDailyAgendaRequest, opts ...grpc.CallOption) (*DailyAgenda, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DailyAgenda)
+	err := c.cc.Invoke(ctx, BookingService_GetDailyAgenda_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExportPayroll(ctx context.Context, in *ExportPayrollRequest, opts ...grpc.CallOption) (*PayrollExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayrollExport)
+	err := c.cc.Invoke(ctx, BookingService_ExportPayroll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) FinalizePayrollPeriod(ctx context.Context, in *FinalizePayrollPeriodRequest, opts ...grpc.CallOption) (*PayrollExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayrollExport)
+	err := c.cc.Invoke(ctx, BookingService_FinalizePayrollPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...grpc.CallOption) (*RevenueReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevenueReport)
+	err := c.cc.Invoke(ctx, BookingService_GetRevenueReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExportRevenueReport(ctx context.Context, in *GetRevenueReportRequest, opts ...grpc.CallOption) (*RevenueExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevenueExport)
+	err := c.cc.Invoke(ctx, BookingService_ExportRevenueReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetentionPolicy)
+	err := c.cc.Invoke(ctx, BookingService_GetRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateRetentionPolicy(ctx context.Context, in *UpdateRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetentionPolicy)
+	err := c.cc.Invoke(ctx, BookingService_UpdateRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*UserDataExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDataExport)
+	err := c.cc.Invoke(ctx, BookingService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) EraseUserData(ctx context.Context, in *EraseUserDataRequest, opts ...grpc.CallOption) (*EraseUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseUserDataResponse)
+	err := c.cc.Invoke(ctx, BookingService_EraseUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetCancellationPolicy(ctx context.Context, in *GetCancellationPolicyRequest, opts ...grpc.CallOption) (*CancellationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancellationPolicy)
+	err := c.cc.Invoke(ctx, BookingService_GetCancellationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateCancellationPolicy(ctx context.Context, in *UpdateCancellationPolicyRequest, opts ...grpc.CallOption) (*CancellationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancellationPolicy)
+	err := c.cc.Invoke(ctx, BookingService_UpdateCancellationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetWorkingHours(ctx context.Context, in *GetWorkingHoursRequest, opts ...grpc.CallOption) (*BarberSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BarberSchedule)
+	err := c.cc.Invoke(ctx, BookingService_GetWorkingHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetWorkingHours(ctx context.Context, in *SetWorkingHoursRequest, opts ...grpc.CallOption) (*BarberSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BarberSchedule)
+	err := c.cc.Invoke(ctx, BookingService_SetWorkingHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListHolidays(ctx context.Context, in *ListHolidaysRequest, opts ...grpc.CallOption) (*HolidayList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HolidayList)
+	err := c.cc.Invoke(ctx, BookingService_ListHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AddHoliday(ctx context.Context, in *AddHolidayRequest, opts ...grpc.CallOption) (*Holiday, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holiday)
+	err := c.cc.Invoke(ctx, BookingService_AddHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RemoveHoliday(ctx context.Context, in *RemoveHolidayRequest, opts ...grpc.CallOption) (*RemoveHolidayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveHolidayResponse)
+	err := c.cc.Invoke(ctx, BookingService_RemoveHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetNextAvailableSlot(ctx context.Context, in *GetNextAvailableSlotRequest, opts ...grpc.CallOption) (*TimeSlotList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TimeSlotList)
+	err := c.cc.Invoke(ctx, BookingService_GetNextAvailableSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetAvailableTimeSlotsRange(ctx context.Context, in *GetAvailableTimeSlotsRangeRequest, opts ...grpc.CallOption) (*DayTimeSlotsList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DayTimeSlotsList)
+	err := c.cc.Invoke(ctx, BookingService_GetAvailableTimeSlotsRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListCatalogServices(ctx context.Context, in *ListCatalogServicesRequest, opts ...grpc.CallOption) (*CatalogServiceList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogServiceList)
+	err := c.cc.Invoke(ctx, BookingService_ListCatalogServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateCatalogService(ctx context.Context, in *CreateCatalogServiceRequest, opts ...grpc.CallOption) (*CatalogService, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogService)
+	err := c.cc.Invoke(ctx, BookingService_CreateCatalogService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateCatalogService(ctx context.Context, in *UpdateCatalogServiceRequest, opts ...grpc.CallOption) (*CatalogService, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogService)
+	err := c.cc.Invoke(ctx, BookingService_UpdateCatalogService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteCatalogService(ctx context.Context, in *DeleteCatalogServiceRequest, opts ...grpc.CallOption) (*DeleteCatalogServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCatalogServiceResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteCatalogService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*PromoCodeList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoCodeList)
+	err := c.cc.Invoke(ctx, BookingService_ListPromoCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetPromoCode(ctx context.Context, in *GetPromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, BookingService_GetPromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, BookingService_CreatePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdatePromoCode(ctx context.Context, in *UpdatePromoCodeRequest, opts ...grpc.CallOption) (*PromoCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoCode)
+	err := c.cc.Invoke(ctx, BookingService_UpdatePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeletePromoCode(ctx context.Context, in *DeletePromoCodeRequest, opts ...grpc.CallOption) (*DeletePromoCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePromoCodeResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeletePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ResourceList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceList)
+	err := c.cc.Invoke(ctx, BookingService_ListResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateResource(ctx context.Context, in *CreateResourceRequest, opts ...grpc.CallOption) (*Resource, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resource)
+	err := c.cc.Invoke(ctx, BookingService_CreateResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateResource(ctx context.Context, in *UpdateResourceRequest, opts ...grpc.CallOption) (*Resource, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Resource)
+	err := c.cc.Invoke(ctx, BookingService_UpdateResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResourceResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteResource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBarberServices(ctx context.Context, in *GetBarberServicesRequest, opts ...grpc.CallOption) (*BarberServiceList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BarberServiceList)
+	err := c.cc.Invoke(ctx, BookingService_GetBarberServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetBarberServices(ctx context.Context, in *SetBarberServicesRequest, opts ...grpc.CallOption) (*BarberServiceList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BarberServiceList)
+	err := c.cc.Invoke(ctx, BookingService_SetBarberServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListBarbers(ctx context.Context, in *ListBarbersRequest, opts ...grpc.CallOption) (*BarberList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BarberList)
+	err := c.cc.Invoke(ctx, BookingService_ListBarbers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBarber(ctx context.Context, in *GetBarberRequest, opts ...grpc.CallOption) (*Barber, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Barber)
+	err := c.cc.Invoke(ctx, BookingService_GetBarber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateBarber(ctx context.Context, in *CreateBarberRequest, opts ...grpc.CallOption) (*Barber, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Barber)
+	err := c.cc.Invoke(ctx, BookingService_CreateBarber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) UpdateBarber(ctx context.Context, in *UpdateBarberRequest, opts ...grpc.CallOption) (*Barber, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Barber)
+	err := c.cc.Invoke(ctx, BookingService_UpdateBarber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteBarber(ctx context.Context, in *DeleteBarberRequest, opts ...grpc.CallOption) (*DeleteBarberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBarberResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteBarber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) AddFavoriteBarber(ctx context.Context, in *AddFavoriteBarberRequest, opts ...grpc.CallOption) (*FavoriteBarber, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteBarber)
+	err := c.cc.Invoke(ctx, BookingService_AddFavoriteBarber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RemoveFavoriteBarber(ctx context.Context, in *RemoveFavoriteBarberRequest, opts ...grpc.CallOption) (*RemoveFavoriteBarberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFavoriteBarberResponse)
+	err := c.cc.Invoke(ctx, BookingService_RemoveFavoriteBarber_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListFavorites(ctx context.Context, in *ListFavoritesRequest, opts ...grpc.CallOption) (*FavoriteBarberList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FavoriteBarberList)
+	err := c.cc.Invoke(ctx, BookingService_ListFavorites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*Quote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Quote)
+	err := c.cc.Invoke(ctx, BookingService_GetQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, BookingService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*WebhookList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookList)
+	err := c.cc.Invoke(ctx, BookingService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, BookingService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingServiceServer is the server API for BookingService service.
+// All implementations must embed UnimplementedBookingServiceServer
+// for forward compatibility.
+type BookingServiceServer interface {
+	// Create a new booking
+	CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error)
+	// Reserve a slot for a few minutes while the customer finishes booking it
+	HoldTimeSlot(context.Context, *HoldTimeSlotRequest) (*Booking, error)
+	// Book the customer's most recent completed booking again, at the barber's next free slot
+	RebookLast(context.Context, *RebookLastRequest) (*Booking, error)
+	// Get a specific booking by ID
+	GetBooking(context.Context, *GetBookingRequest) (*Booking, error)
+	// Update an existing booking
+	UpdateBooking(context.Context, *UpdateBookingRequest) (*Booking, error)
+	// Move a booking to a new start time, atomically checking the barber's availability
+	RescheduleBooking(context.Context, *RescheduleBookingRequest) (*Booking, error)
+	// Confirm a pending booking (the booked barber or admins only)
+	ConfirmBooking(context.Context, *ConfirmBookingRequest) (*Booking, error)
+	// Mark a booking as completed (the booked barber or admins only)
+	CompleteBooking(context.Context, *CompleteBookingRequest) (*Booking, error)
+	// Cancel a booking
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	// Soft-delete a booking, purged with its history after the retention period (admins only)
+	DeleteBooking(context.Context, *DeleteBookingRequest) (*DeleteBookingResponse, error)
+	// List bookings matching filters, sorted
+	ListBookings(context.Context, *ListBookingsRequest) (*BookingList, error)
+	// Stream changes to a user's or a barber's bookings as they happen
+	WatchBookings(*WatchBookingsRequest, grpc.ServerStreamingServer[BookingEvent]) error
+	// Get all bookings for a user
+	GetUserBookings(context.Context, *GetUserBookingsRequest) (*BookingList, error)
+	// Get all bookings for a barber
+	GetBarberBookings(context.Context, *GetBarberBookingsRequest) (*BookingList, error)
+	// Get available time slots for a barber on a specific date
+	GetAvailableTimeSlots(context.Context, *GetAvailableTimeSlotsRequest) (*TimeSlotList, error)
+	// Check in the customer of a booking (barbers only)
+	CheckInBooking(context.Context, *CheckInBookingRequest) (*Booking, error)
+	// Record that the customer didn't turn up for a booking (barbers only)
+	MarkNoShow(context.Context, *MarkNoShowRequest) (*Booking, error)
+	// Get how a customer's bookings turned out (barbers and admins only)
+	GetUserReliability(context.Context, *GetUserReliabilityRequest) (*UserReliability, error)
+	// Get no-show and cancellation rates per customer or barber over a period,
+	// highest first (barbers and admins only)
+	GetReliabilityReport(context.Context, *GetReliabilityReportRequest) (*ReliabilityReport, error)
+	// Get a customer's visits: the last, the usual service and how often they come (barbers and admins only)
+	GetCustomerSummary(context.Context, *GetCustomerSummaryRequest) (*CustomerSummary, error)
+	// Get every change made to a booking, oldest first
+	GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*BookingHistory, error)
+	// Get a booking as an iCalendar file to add to a calendar app. Importing it
+	// again after a reschedule or cancellation updates the same event.
+	GetBookingICS(context.Context, *GetBookingICSRequest) (*BookingICS, error)
+	// Query the log of authenticated calls that changed something (admins only)
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*AuditLog, error)
+	// Attach a reference image to a booking by URL or through a pre-signed upload
+	AddBookingAttachment(context.Context, *AddBookingAttachmentRequest) (*AddBookingAttachmentResponse, error)
+	// Get a booking by the external (e.g. point-of-sale) reference attached at creation
+	GetBookingByExternalRef(context.Context, *GetBookingByExternalRefRequest) (*Booking, error)
+	// Record a point-of-sale payment and mark the booking completed
+	RecordPOSCompletion(context.Context, *RecordPOSCompletionRequest) (*Booking, error)
+	// Record the response to a post-appointment satisfaction survey (authenticated by the survey token)
+	SubmitSurveyResponse(context.Context, *SubmitSurveyResponseRequest) (*SubmitSurveyResponseResponse, error)
+	// Get aggregate satisfaction survey scores for a barber
+	GetBarberSurveyScores(context.Context, *GetBarberSurveyScoresRequest) (*SurveyScores, error)
+	// Review a completed booking, once (the booking's customer only)
+	SubmitReview(context.Context, *SubmitReviewRequest) (*Review, error)
+	// List a barber's reviews, newest first
+	ListBarberReviews(context.Context, *ListBarberReviewsRequest) (*ReviewList, error)
+	// Get the number of a barber's reviews and their average rating
+	GetBarberRating(context.Context, *GetBarberRatingRequest) (*BarberRating, error)
+	// Get a barber's booking statistics for a week or month, for their dashboard (barbers only)
+	GetBarberStats(context.Context, *GetBarberStatsRequest) (*BarberStats, error)
+	// Get past bookings by the weekday and hour they started at, to plan staffing (barbers only)
+	GetDemandHeatmap(context.Context, *GetDemandHeatmapRequest) (*DemandHeatmap, error)
+	// Get a barber's bookings for a day in the order they start, with the free time left (barbers only)
+	GetDailyAgenda(context.Context, *GetDailyAgendaRequest) (*DailyAgenda, error)
+	// Export a month's per-barber payroll (admins only)
+	ExportPayroll(context.Context, *ExportPayrollRequest) (*PayrollExport, error)
+	// Finalize and lock a past month's payroll (admins only)
+	FinalizePayrollPeriod(context.Context, *FinalizePayrollPeriodRequest) (*PayrollExport, error)
+	// Get completed-booking revenue by period, barber and service (admins only)
+	GetRevenueReport(context.Context, *GetRevenueReportRequest) (*RevenueReport, error)
+	// Export a revenue report as CSV for accounting (admins only)
+	ExportRevenueReport(context.Context, *GetRevenueReportRequest) (*RevenueExport, error)
+	// Get the shop's data retention policy (admins only)
+	GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*RetentionPolicy, error)
+	// Update the shop's data retention policy (admins only)
+	UpdateRetentionPolicy(context.Context, *UpdateRetentionPolicyRequest) (*RetentionPolicy, error)
+	// Export everything stored about a customer as JSON (admins only)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error)
+	// Anonymize or delete a customer's personal data (admins only)
+	EraseUserData(context.Context, *EraseUserDataRequest) (*EraseUserDataResponse, error)
+	// Get the shop's cancellation policy
+	GetCancellationPolicy(context.Context, *GetCancellationPolicyRequest) (*CancellationPolicy, error)
+	// Update the shop's cancellation policy (admins only)
+	UpdateCancellationPolicy(context.Context, *UpdateCancellationPolicyRequest) (*CancellationPolicy, error)
+	// Get a barber's weekly working hours
+	GetWorkingHours(context.Context, *GetWorkingHoursRequest) (*BarberSchedule, error)
+	// Replace a barber's weekly working hours (the barber themselves or admins)
+	SetWorkingHours(context.Context, *SetWorkingHoursRequest) (*BarberSchedule, error)
+	// List the days the shop is closed
+	ListHolidays(context.Context, *ListHolidaysRequest) (*HolidayList, error)
+	// Close the shop on a day (admins only)
+	AddHoliday(context.Context, *AddHolidayRequest) (*Holiday, error)
+	// Reopen the shop on a day (admins only)
+	RemoveHoliday(context.Context, *RemoveHolidayRequest) (*RemoveHolidayResponse, error)
+	// Find a barber's earliest available slots, across as many days as needed
+	GetNextAvailableSlot(context.Context, *GetNextAvailableSlotRequest) (*TimeSlotList, error)
+	// Get available time slots for a barber on each day of a date range
+	GetAvailableTimeSlotsRange(context.Context, *GetAvailableTimeSlotsRangeRequest) (*DayTimeSlotsList, error)
+	// List the services the shop offers
+	ListCatalogServices(context.Context, *ListCatalogServicesRequest) (*CatalogServiceList, error)
+	// Add a service to the catalog (admins only)
+	CreateCatalogService(context.Context, *CreateCatalogServiceRequest) (*CatalogService, error)
+	// Change a catalog service (admins only)
+	UpdateCatalogService(context.Context, *UpdateCatalogServiceRequest) (*CatalogService, error)
+	// Remove a service from the catalog (admins only)
+	DeleteCatalogService(context.Context, *DeleteCatalogServiceRequest) (*DeleteCatalogServiceResponse, error)
+	// List the promo codes with how often each was used (admins only)
+	ListPromoCodes(context.Context, *ListPromoCodesRequest) (*PromoCodeList, error)
+	// Get a promo code with how often it was used (admins only)
+	GetPromoCode(context.Context, *GetPromoCodeRequest) (*PromoCode, error)
+	// Add a promo code (admins only)
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCode, error)
+	// Change a promo code's discount, validity or limit (admins only)
+	UpdatePromoCode(context.Context, *UpdatePromoCodeRequest) (*PromoCode, error)
+	// Remove a promo code (admins only)
+	DeletePromoCode(context.Context, *DeletePromoCodeRequest) (*DeletePromoCodeResponse, error)
+	// List the shop's shared equipment, e.g. wash stations
+	ListResources(context.Context, *ListResourcesRequest) (*ResourceList, error)
+	// Add a piece of shared equipment (admins only)
+	CreateResource(context.Context, *CreateResourceRequest) (*Resource, error)
+	// Change a piece of shared equipment (admins only)
+	UpdateResource(context.Context, *UpdateResourceRequest) (*Resource, error)
+	// Remove a piece of shared equipment (admins only)
+	DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error)
+	// Get the services a barber offers with their durations and prices
+	GetBarberServices(context.Context, *GetBarberServicesRequest) (*BarberServiceList, error)
+	// Replace the services a barber offers (the barber themselves or admins only)
+	SetBarberServices(context.Context, *SetBarberServicesRequest) (*BarberServiceList, error)
+	// List the barbers taking bookings, with the services they offer. Needs no sign-in.
+	ListBarbers(context.Context, *ListBarbersRequest) (*BarberList, error)
+	// Get a barber's profile
+	GetBarber(context.Context, *GetBarberRequest) (*Barber, error)
+	// Add a barber's profile (admins only)
+	CreateBarber(context.Context, *CreateBarberRequest) (*Barber, error)
+	// Change a barber's profile (the barber themselves or admins; only admins can change whether they're active)
+	UpdateBarber(context.Context, *UpdateBarberRequest) (*Barber, error)
+	// Remove a barber's profile (admins only)
+	DeleteBarber(context.Context, *DeleteBarberRequest) (*DeleteBarberResponse, error)
+	// Mark a barber as one of the caller's favorites
+	AddFavoriteBarber(context.Context, *AddFavoriteBarberRequest) (*FavoriteBarber, error)
+	// Remove a barber from the caller's favorites
+	RemoveFavoriteBarber(context.Context, *RemoveFavoriteBarberRequest) (*RemoveFavoriteBarberResponse, error)
+	// List the caller's favorite barbers, most recently added first
+	ListFavorites(context.Context, *ListFavoritesRequest) (*FavoriteBarberList, error)
+	// Get the price and duration of a prospective booking
+	GetQuote(context.Context, *GetQuoteRequest) (*Quote, error)
+	// Register a URL to be sent booking events (admins only)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error)
+	// List the registered webhooks, without their secrets (admins only)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*WebhookList, error)
+	// Stop sending booking events to a webhook (admins only)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+// UnimplementedBookingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingServiceServer struct{}
+
+func (UnimplementedBookingServiceServer) CreateBooking(context.Context, *CreateBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) HoldTimeSlot(context.Context, *HoldTimeSlotRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldTimeSlot not implemented")
+}
+func (UnimplementedBookingServiceServer) RebookLast(context.Context, *RebookLastRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebookLast not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateBooking(context.Context, *UpdateBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) RescheduleBooking(context.Context, *RescheduleBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ConfirmBooking(context.Context, *ConfirmBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CompleteBooking(context.Context, *CompleteBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteBooking(context.Context, *DeleteBookingRequest) (*DeleteBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ListBookings(context.Context, *ListBookingsRequest) (*BookingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) WatchBookings(*WatchBookingsRequest, grpc.ServerStreamingServer[BookingEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) GetUserBookings(context.Context, *GetUserBookingsRequest) (*BookingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBarberBookings(context.Context, *GetBarberBookingsRequest) (*BookingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBarberBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) GetAvailableTimeSlots(context.Context, *GetAvailableTimeSlotsRequest) (*TimeSlotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableTimeSlots not implemented")
+}
+func (UnimplementedBookingServiceServer) CheckInBooking(context.Context, *CheckInBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) MarkNoShow(context.Context, *MarkNoShowRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNoShow not implemented")
+}
+func (UnimplementedBookingServiceServer) GetUserReliability(context.Context, *GetUserReliabilityRequest) (*UserReliability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserReliability not implemented")
+}
+func (UnimplementedBookingServiceServer) GetReliabilityReport(context.Context, *GetReliabilityReportRequest) (*ReliabilityReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReliabilityReport not implemented")
+}
+func (UnimplementedBookingServiceServer) GetCustomerSummary(context.Context, *GetCustomerSummaryRequest) (*CustomerSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerSummary not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingHistory(context.Context, *GetBookingHistoryRequest) (*BookingHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingHistory not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingICS(context.Context, *GetBookingICSRequest) (*BookingICS, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingICS not implemented")
+}
+func (UnimplementedBookingServiceServer) ListAuditLog(context.Context, *ListAuditLogRequest) (*AuditLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
+func (UnimplementedBookingServiceServer) AddBookingAttachment(context.Context, *AddBookingAttachmentRequest) (*AddBookingAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBookingAttachment not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBookingByExternalRef(context.Context, *GetBookingByExternalRefRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookingByExternalRef not implemented")
+}
+func (UnimplementedBookingServiceServer) RecordPOSCompletion(context.Context, *RecordPOSCompletionRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPOSCompletion not implemented")
+}
+func (UnimplementedBookingServiceServer) SubmitSurveyResponse(context.Context, *SubmitSurveyResponseRequest) (*SubmitSurveyResponseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSurveyResponse not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBarberSurveyScores(context.Context, *GetBarberSurveyScoresRequest) (*SurveyScores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBarberSurveyScores not implemented")
+}
+func (UnimplementedBookingServiceServer) SubmitReview(context.Context, *SubmitReviewRequest) (*Review, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReview not implemented")
+}
+func (UnimplementedBookingServiceServer) ListBarberReviews(context.Context, *ListBarberReviewsRequest) (*ReviewList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBarberReviews not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBarberRating(context.Context, *GetBarberRatingRequest) (*BarberRating, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBarberRating not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBarberStats(context.Context, *GetBarberStatsRequest) (*BarberStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBarberStats not implemented")
+}
+func (UnimplementedBookingServiceServer) GetDemandHeatmap(context.Context, *GetDemandHeatmapRequest) (*DemandHeatmap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDemandHeatmap not implemented")
+}
+func (UnimplementedBookingServiceServer) GetDailyAgenda(context.Context, *GetDailyAgendaRequest) (*DailyAgenda, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDailyAgenda not implemented")
+}
+func (UnimplementedBookingServiceServer) ExportPayroll(context.Context, *ExportPayrollRequest) (*PayrollExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPayroll not implemented")
+}
+func (UnimplementedBookingServiceServer) FinalizePayrollPeriod(context.Context, *FinalizePayrollPeriodRequest) (*PayrollExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizePayrollPeriod not implemented")
+}
+func (UnimplementedBookingServiceServer) GetRevenueReport(context.Context, *GetRevenueReportRequest) (*RevenueReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevenueReport not implemented")
+}
+func (UnimplementedBookingServiceServer) ExportRevenueReport(context.Context, *GetRevenueReportRequest) (*RevenueExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportRevenueReport not implemented")
+}
+func (UnimplementedBookingServiceServer) GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*RetentionPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionPolicy not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateRetentionPolicy(context.Context, *UpdateRetentionPolicyRequest) (*RetentionPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRetentionPolicy not implemented")
+}
+func (UnimplementedBookingServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*UserDataExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedBookingServiceServer) EraseUserData(context.Context, *EraseUserDataRequest) (*EraseUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseUserData not implemented")
+}
+func (UnimplementedBookingServiceServer) GetCancellationPolicy(context.Context, *GetCancellationPolicyRequest) (*CancellationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCancellationPolicy not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateCancellationPolicy(context.Context, *UpdateCancellationPolicyRequest) (*CancellationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCancellationPolicy not implemented")
+}
+func (UnimplementedBookingServiceServer) GetWorkingHours(context.Context, *GetWorkingHoursRequest) (*BarberSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkingHours not implemented")
+}
+func (UnimplementedBookingServiceServer) SetWorkingHours(context.Context, *SetWorkingHoursRequest) (*BarberSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkingHours not implemented")
+}
+func (UnimplementedBookingServiceServer) ListHolidays(context.Context, *ListHolidaysRequest) (*HolidayList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHolidays not implemented")
+}
+func (UnimplementedBookingServiceServer) AddHoliday(context.Context, *AddHolidayRequest) (*Holiday, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHoliday not implemented")
+}
+func (UnimplementedBookingServiceServer) RemoveHoliday(context.Context, *RemoveHolidayRequest) (*RemoveHolidayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHoliday not implemented")
+}
+func (UnimplementedBookingServiceServer) GetNextAvailableSlot(context.Context, *GetNextAvailableSlotRequest) (*TimeSlotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextAvailableSlot not implemented")
+}
+func (UnimplementedBookingServiceServer) GetAvailableTimeSlotsRange(context.Context, *GetAvailableTimeSlotsRangeRequest) (*DayTimeSlotsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableTimeSlotsRange not implemented")
+}
+func (UnimplementedBookingServiceServer) ListCatalogServices(context.Context, *ListCatalogServicesRequest) (*CatalogServiceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCatalogServices not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateCatalogService(context.Context, *CreateCatalogServiceRequest) (*CatalogService, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCatalogService not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateCatalogService(context.Context, *UpdateCatalogServiceRequest) (*CatalogService, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCatalogService not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteCatalogService(context.Context, *DeleteCatalogServiceRequest) (*DeleteCatalogServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCatalogService not implemented")
+}
+func (UnimplementedBookingServiceServer) ListPromoCodes(context.Context, *ListPromoCodesRequest) (*PromoCodeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromoCodes not implemented")
+}
+func (UnimplementedBookingServiceServer) GetPromoCode(context.Context, *GetPromoCodeRequest) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPromoCode not implemented")
+}
+func (UnimplementedBookingServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdatePromoCode(context.Context, *UpdatePromoCodeRequest) (*PromoCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePromoCode not implemented")
+}
+func (UnimplementedBookingServiceServer) DeletePromoCode(context.Context, *DeletePromoCodeRequest) (*DeletePromoCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePromoCode not implemented")
+}
+func (UnimplementedBookingServiceServer) ListResources(context.Context, *ListResourcesRequest) (*ResourceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateResource(context.Context, *CreateResourceRequest) (*Resource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateResource not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateResource(context.Context, *UpdateResourceRequest) (*Resource, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateResource not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResource not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBarberServices(context.Context, *GetBarberServicesRequest) (*BarberServiceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBarberServices not implemented")
+}
+func (UnimplementedBookingServiceServer) SetBarberServices(context.Context, *SetBarberServicesRequest) (*BarberServiceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBarberServices not implemented")
+}
+func (UnimplementedBookingServiceServer) ListBarbers(context.Context, *ListBarbersRequest) (*BarberList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBarbers not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBarber(context.Context, *GetBarberRequest) (*Barber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBarber not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateBarber(context.Context, *CreateBarberRequest) (*Barber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBarber not implemented")
+}
+func (UnimplementedBookingServiceServer) UpdateBarber(context.Context, *UpdateBarberRequest) (*Barber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBarber not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteBarber(context.Context, *DeleteBarberRequest) (*DeleteBarberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBarber not implemented")
+}
+func (UnimplementedBookingServiceServer) AddFavoriteBarber(context.Context, *AddFavoriteBarberRequest) (*FavoriteBarber, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavoriteBarber not implemented")
+}
+func (UnimplementedBookingServiceServer) RemoveFavoriteBarber(context.Context, *RemoveFavoriteBarberRequest) (*RemoveFavoriteBarberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavoriteBarber not implemented")
+}
+func (UnimplementedBookingServiceServer) ListFavorites(context.Context, *ListFavoritesRequest) (*FavoriteBarberList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavorites not implemented")
+}
+func (UnimplementedBookingServiceServer) GetQuote(context.Context, *GetQuoteRequest) (*Quote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedBookingServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*WebhookList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
+func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingServiceServer will
+// result in compilation errors.
+type UnsafeBookingServiceServer interface {
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+func RegisterBookingServiceServer(s grpc.ServiceRegistrar, srv BookingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookingService_ServiceDesc, srv)
+}
+
+func _BookingService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_HoldTimeSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldTimeSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).HoldTimeSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_HoldTimeSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).HoldTimeSlot(ctx, req.(*HoldTimeSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RebookLast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebookLastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RebookLast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RebookLast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RebookLast(ctx, req.(*RebookLastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateBooking(ctx, req.(*UpdateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RescheduleBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RescheduleBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RescheduleBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RescheduleBooking(ctx, req.(*RescheduleBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ConfirmBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ConfirmBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ConfirmBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ConfirmBooking(ctx, req.(*ConfirmBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CompleteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CompleteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CompleteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CompleteBooking(ctx, req.(*CompleteBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteBooking(ctx, req.(*DeleteBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBookings(ctx, req.(*ListBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_WatchBookings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBookingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookingServiceServer).WatchBookings(m, &grpc.GenericServerStream[WatchBookingsRequest, BookingEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_WatchBookingsServer = grpc.ServerStreamingServer[BookingEvent]
+
+func _BookingService_GetUserBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetUserBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetUserBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetUserBookings(ctx, req.(*GetUserBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBarberBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBarberBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBarberBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBarberBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBarberBookings(ctx, req.(*GetBarberBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetAvailableTimeSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableTimeSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetAvailableTimeSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetAvailableTimeSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetAvailableTimeSlots(ctx, req.(*GetAvailableTimeSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CheckInBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckInBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CheckInBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckInBooking(ctx, req.(*CheckInBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_MarkNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNoShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).MarkNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_MarkNoShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).MarkNoShow(ctx, req.(*MarkNoShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetUserReliability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReliabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetUserReliability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetUserReliability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetUserReliability(ctx, req.(*GetUserReliabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetReliabilityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReliabilityReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetReliabilityReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetReliabilityReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetReliabilityReport(ctx, req.(*GetReliabilityReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetCustomerSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetCustomerSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetCustomerSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetCustomerSummary(ctx, req.(*GetCustomerSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingHistory(ctx, req.(*GetBookingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingICS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingICSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingICS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingICS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingICS(ctx, req.(*GetBookingICSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListAuditLog(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AddBookingAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBookingAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AddBookingAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_AddBookingAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AddBookingAttachment(ctx, req.(*AddBookingAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBookingByExternalRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingByExternalRefRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBookingByExternalRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBookingByExternalRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBookingByExternalRef(ctx, req.(*GetBookingByExternalRefRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RecordPOSCompletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPOSCompletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RecordPOSCompletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RecordPOSCompletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RecordPOSCompletion(ctx, req.(*RecordPOSCompletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SubmitSurveyResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSurveyResponseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SubmitSurveyResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SubmitSurveyResponse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SubmitSurveyResponse(ctx, req.(*SubmitSurveyResponseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBarberSurveyScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBarberSurveyScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBarberSurveyScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBarberSurveyScores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBarberSurveyScores(ctx, req.(*GetBarberSurveyScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SubmitReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SubmitReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SubmitReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SubmitReview(ctx, req.(*SubmitReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListBarberReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBarberReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBarberReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListBarberReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBarberReviews(ctx, req.(*ListBarberReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBarberRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBarberRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBarberRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBarberRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBarberRating(ctx, req.(*GetBarberRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBarberStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBarberStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBarberStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBarberStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBarberStats(ctx, req.(*GetBarberStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetDemandHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDemandHeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetDemandHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetDemandHeatmap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetDemandHeatmap(ctx, req.(*GetDemandHeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetDailyAgenda_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyAgendaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetDailyAgenda(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetDailyAgenda_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetDailyAgenda(ctx, req.(*GetDailyAgendaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExportPayroll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPayrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExportPayroll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ExportPayroll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExportPayroll(ctx, req.(*ExportPayrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_FinalizePayrollPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePayrollPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).FinalizePayrollPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_FinalizePayrollPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).FinalizePayrollPeriod(ctx, req.(*FinalizePayrollPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetRevenueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetRevenueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetRevenueReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetRevenueReport(ctx, req.(*GetRevenueReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExportRevenueReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevenueReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExportRevenueReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ExportRevenueReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExportRevenueReport(ctx, req.(*GetRevenueReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetRetentionPolicy(ctx, req.(*GetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateRetentionPolicy(ctx, req.(*UpdateRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_EraseUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).EraseUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_EraseUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).EraseUserData(ctx, req.(*EraseUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetCancellationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCancellationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetCancellationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetCancellationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetCancellationPolicy(ctx, req.(*GetCancellationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateCancellationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCancellationPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateCancellationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateCancellationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateCancellationPolicy(ctx, req.(*UpdateCancellationPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkingHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetWorkingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetWorkingHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetWorkingHours(ctx, req.(*GetWorkingHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SetWorkingHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWorkingHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SetWorkingHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SetWorkingHours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SetWorkingHours(ctx, req.(*SetWorkingHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListHolidays(ctx, req.(*ListHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_AddHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).AddHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_AddHoliday_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).AddHoliday(ctx, req.(*AddHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RemoveHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveHolidayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RemoveHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_RemoveHoliday_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RemoveHoliday(ctx, req.(*RemoveHolidayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetNextAvailableSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextAvailableSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetNextAvailableSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetNextAvailableSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetNextAvailableSlot(ctx, req.(*GetNextAvailableSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetAvailableTimeSlotsRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableTimeSlotsRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetAvailableTimeSlotsRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetAvailableTimeSlotsRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetAvailableTimeSlotsRange(ctx, req.(*GetAvailableTimeSlotsRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListCatalogServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListCatalogServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListCatalogServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListCatalogServices(ctx, req.(*ListCatalogServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateCatalogService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCatalogServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateCatalogService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateCatalogService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateCatalogService(ctx, req.(*CreateCatalogServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateCatalogService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCatalogServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateCatalogService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateCatalogService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateCatalogService(ctx, req.(*UpdateCatalogServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteCatalogService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCatalogServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteCatalogService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteCatalogService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteCatalogService(ctx, req.(*DeleteCatalogServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromoCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListPromoCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListPromoCodes(ctx, req.(*ListPromoCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetPromoCode(ctx, req.(*GetPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdatePromoCode(ctx, req.(*UpdatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeletePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeletePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeletePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeletePromoCode(ctx, req.(*DeletePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateResource(ctx, req.(*CreateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_UpdateResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateResource(ctx, req.(*UpdateResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteResource(ctx, req.(*DeleteResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBarberServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBarberServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBarberServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBarberServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBarberServices(ctx, req.(*GetBarberServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SetBarberServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBarberServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SetBarberServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SetBarberServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SetBarberServices(ctx, req.(*SetBarberServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBookingServiceServer) GetUserBookings(context.Context, *GetUserBookingsRequest) (*BookingList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserBookings not implemented")
+
+func _BookingService_ListBarbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBarbersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBarbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListBarbers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBarbers(ctx, req.(*ListBarbersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBookingServiceServer) GetBarberBookings(context.Context, *GetBarberBookingsRequest) (*BookingList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBarberBookings not implemented")
+
+func _BookingService_GetBarber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBarberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBarber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBarber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBarber(ctx, req.(*GetBarberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBookingServiceServer) GetAvailableTimeSlots(context.Context, *GetAvailableTimeSlotsRequest) (*TimeSlotList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableTimeSlots not implemented")
+
+func _BookingService_CreateBarber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBarberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBarber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateBarber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBarber(ctx, req.(*CreateBarberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
-func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BookingServiceServer will
-// result in compilation errors.
-type UnsafeBookingServiceServer interface {
-	mustEmbedUnimplementedBookingServiceServer()
+func _BookingService_UpdateBarber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBarberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).UpdateBarber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_UpdateBarber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).UpdateBarber(ctx, req.(*UpdateBarberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBookingServiceServer(s grpc.ServiceRegistrar, srv BookingServiceServer) {
-	// If the following call pancis, it indicates UnimplementedBookingServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _BookingService_DeleteBarber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBarberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&BookingService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteBarber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_DeleteBarber_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteBarber(ctx, req.(*DeleteBarberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CreateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBookingRequest)
+func _BookingService_AddFavoriteBarber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFavoriteBarberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CreateBooking(ctx, in)
+		return srv.(BookingServiceServer).AddFavoriteBarber(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CreateBooking_FullMethodName,
+		FullMethod: BookingService_AddFavoriteBarber_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CreateBooking(ctx, req.(*CreateBookingRequest))
+		return srv.(BookingServiceServer).AddFavoriteBarber(ctx, req.(*AddFavoriteBarberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBookingRequest)
+func _BookingService_RemoveFavoriteBarber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteBarberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).GetBooking(ctx, in)
+		return srv.(BookingServiceServer).RemoveFavoriteBarber(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_GetBooking_FullMethodName,
+		FullMethod: BookingService_RemoveFavoriteBarber_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+		return srv.(BookingServiceServer).RemoveFavoriteBarber(ctx, req.(*RemoveFavoriteBarberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_UpdateBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBookingRequest)
+func _BookingService_ListFavorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).UpdateBooking(ctx, in)
+		return srv.(BookingServiceServer).ListFavorites(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_UpdateBooking_FullMethodName,
+		FullMethod: BookingService_ListFavorites_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).UpdateBooking(ctx, req.(*UpdateBookingRequest))
+		return srv.(BookingServiceServer).ListFavorites(ctx, req.(*ListFavoritesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelBookingRequest)
+func _BookingService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+		return srv.(BookingServiceServer).GetQuote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_CancelBooking_FullMethodName,
+		FullMethod: BookingService_GetQuote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+		return srv.(BookingServiceServer).GetQuote(ctx, req.(*GetQuoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_GetUserBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserBookingsRequest)
+func _BookingService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).GetUserBookings(ctx, in)
+		return srv.(BookingServiceServer).CreateWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_GetUserBookings_FullMethodName,
+		FullMethod: BookingService_CreateWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).GetUserBookings(ctx, req.(*GetUserBookingsRequest))
+		return srv.(BookingServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_GetBarberBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBarberBookingsRequest)
+func _BookingService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).GetBarberBookings(ctx, in)
+		return srv.(BookingServiceServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_GetBarberBookings_FullMethodName,
+		FullMethod: BookingService_ListWebhooks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).GetBarberBookings(ctx, req.(*GetBarberBookingsRequest))
+		return srv.(BookingServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookingService_GetAvailableTimeSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAvailableTimeSlotsRequest)
+func _BookingService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookingServiceServer).GetAvailableTimeSlots(ctx, in)
+		return srv.(BookingServiceServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookingService_GetAvailableTimeSlots_FullMethodName,
+		FullMethod: BookingService_DeleteWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookingServiceServer).GetAvailableTimeSlots(ctx, req.(*GetAvailableTimeSlotsRequest))
+		return srv.(BookingServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -333,6 +2895,14 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBooking",
 			Handler:    _BookingService_CreateBooking_Handler,
 		},
+		{
+			MethodName: "HoldTimeSlot",
+			Handler:    _BookingService_HoldTimeSlot_Handler,
+		},
+		{
+			MethodName: "RebookLast",
+			Handler:    _BookingService_RebookLast_Handler,
+		},
 		{
 			MethodName: "GetBooking",
 			Handler:    _BookingService_GetBooking_Handler,
@@ -341,10 +2911,30 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBooking",
 			Handler:    _BookingService_UpdateBooking_Handler,
 		},
+		{
+			MethodName: "RescheduleBooking",
+			Handler:    _BookingService_RescheduleBooking_Handler,
+		},
+		{
+			MethodName: "ConfirmBooking",
+			Handler:    _BookingService_ConfirmBooking_Handler,
+		},
+		{
+			MethodName: "CompleteBooking",
+			Handler:    _BookingService_CompleteBooking_Handler,
+		},
 		{
 			MethodName: "CancelBooking",
 			Handler:    _BookingService_CancelBooking_Handler,
 		},
+		{
+			MethodName: "DeleteBooking",
+			Handler:    _BookingService_DeleteBooking_Handler,
+		},
+		{
+			MethodName: "ListBookings",
+			Handler:    _BookingService_ListBookings_Handler,
+		},
 		{
 			MethodName: "GetUserBookings",
 			Handler:    _BookingService_GetUserBookings_Handler,
@@ -357,7 +2947,265 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailableTimeSlots",
 			Handler:    _BookingService_GetAvailableTimeSlots_Handler,
 		},
+		{
+			MethodName: "CheckInBooking",
+			Handler:    _BookingService_CheckInBooking_Handler,
+		},
+		{
+			MethodName: "MarkNoShow",
+			Handler:    _BookingService_MarkNoShow_Handler,
+		},
+		{
+			MethodName: "GetUserReliability",
+			Handler:    _BookingService_GetUserReliability_Handler,
+		},
+		{
+			MethodName: "GetReliabilityReport",
+			Handler:    _BookingService_GetReliabilityReport_Handler,
+		},
+		{
+			MethodName: "GetCustomerSummary",
+			Handler:    _BookingService_GetCustomerSummary_Handler,
+		},
+		{
+			MethodName: "GetBookingHistory",
+			Handler:    _BookingService_GetBookingHistory_Handler,
+		},
+		{
+			MethodName: "GetBookingICS",
+			Handler:    _BookingService_GetBookingICS_Handler,
+		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _BookingService_ListAuditLog_Handler,
+		},
+		{
+			MethodName: "AddBookingAttachment",
+			Handler:    _BookingService_AddBookingAttachment_Handler,
+		},
+		{
+			MethodName: "GetBookingByExternalRef",
+			Handler:    _BookingService_GetBookingByExternalRef_Handler,
+		},
+		{
+			MethodName: "RecordPOSCompletion",
+			Handler:    _BookingService_RecordPOSCompletion_Handler,
+		},
+		{
+			MethodName: "SubmitSurveyResponse",
+			Handler:    _BookingService_SubmitSurveyResponse_Handler,
+		},
+		{
+			MethodName: "GetBarberSurveyScores",
+			Handler:    _BookingService_GetBarberSurveyScores_Handler,
+		},
+		{
+			MethodName: "SubmitReview",
+			Handler:    _BookingService_SubmitReview_Handler,
+		},
+		{
+			MethodName: "ListBarberReviews",
+			Handler:    _BookingService_ListBarberReviews_Handler,
+		},
+		{
+			MethodName: "GetBarberRating",
+			Handler:    _BookingService_GetBarberRating_Handler,
+		},
+		{
+			MethodName: "GetBarberStats",
+			Handler:    _BookingService_GetBarberStats_Handler,
+		},
+		{
+			MethodName: "GetDemandHeatmap",
+			Handler:    _BookingService_GetDemandHeatmap_Handler,
+		},
+		{
+			MethodName: "GetDailyAgenda",
+			Handler:    _BookingService_GetDailyAgenda_Handler,
+		},
+		{
+			MethodName: "ExportPayroll",
+			Handler:    _BookingService_ExportPayroll_Handler,
+		},
+		{
+			MethodName: "FinalizePayrollPeriod",
+			Handler:    _BookingService_FinalizePayrollPeriod_Handler,
+		},
+		{
+			MethodName: "GetRevenueReport",
+			Handler:    _BookingService_GetRevenueReport_Handler,
+		},
+		{
+			MethodName: "ExportRevenueReport",
+			Handler:    _BookingService_ExportRevenueReport_Handler,
+		},
+		{
+			MethodName: "GetRetentionPolicy",
+			Handler:    _BookingService_GetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateRetentionPolicy",
+			Handler:    _BookingService_UpdateRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _BookingService_ExportUserData_Handler,
+		},
+		{
+			MethodName: "EraseUserData",
+			Handler:    _BookingService_EraseUserData_Handler,
+		},
+		{
+			MethodName: "GetCancellationPolicy",
+			Handler:    _BookingService_GetCancellationPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateCancellationPolicy",
+			Handler:    _BookingService_UpdateCancellationPolicy_Handler,
+		},
+		{
+			MethodName: "GetWorkingHours",
+			Handler:    _BookingService_GetWorkingHours_Handler,
+		},
+		{
+			MethodName: "SetWorkingHours",
+			Handler:    _BookingService_SetWorkingHours_Handler,
+		},
+		{
+			MethodName: "ListHolidays",
+			Handler:    _BookingService_ListHolidays_Handler,
+		},
+		{
+			MethodName: "AddHoliday",
+			Handler:    _BookingService_AddHoliday_Handler,
+		},
+		{
+			MethodName: "RemoveHoliday",
+			Handler:    _BookingService_RemoveHoliday_Handler,
+		},
+		{
+			MethodName: "GetNextAvailableSlot",
+			Handler:    _BookingService_GetNextAvailableSlot_Handler,
+		},
+		{
+			MethodName: "GetAvailableTimeSlotsRange",
+			Handler:    _BookingService_GetAvailableTimeSlotsRange_Handler,
+		},
+		{
+			MethodName: "ListCatalogServices",
+			Handler:    _BookingService_ListCatalogServices_Handler,
+		},
+		{
+			MethodName: "CreateCatalogService",
+			Handler:    _BookingService_CreateCatalogService_Handler,
+		},
+		{
+			MethodName: "UpdateCatalogService",
+			Handler:    _BookingService_UpdateCatalogService_Handler,
+		},
+		{
+			MethodName: "DeleteCatalogService",
+			Handler:    _BookingService_DeleteCatalogService_Handler,
+		},
+		{
+			MethodName: "ListPromoCodes",
+			Handler:    _BookingService_ListPromoCodes_Handler,
+		},
+		{
+			MethodName: "GetPromoCode",
+			Handler:    _BookingService_GetPromoCode_Handler,
+		},
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _BookingService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "UpdatePromoCode",
+			Handler:    _BookingService_UpdatePromoCode_Handler,
+		},
+		{
+			MethodName: "DeletePromoCode",
+			Handler:    _BookingService_DeletePromoCode_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _BookingService_ListResources_Handler,
+		},
+		{
+			MethodName: "CreateResource",
+			Handler:    _BookingService_CreateResource_Handler,
+		},
+		{
+			MethodName: "UpdateResource",
+			Handler:    _BookingService_UpdateResource_Handler,
+		},
+		{
+			MethodName: "DeleteResource",
+			Handler:    _BookingService_DeleteResource_Handler,
+		},
+		{
+			MethodName: "GetBarberServices",
+			Handler:    _BookingService_GetBarberServices_Handler,
+		},
+		{
+			MethodName: "SetBarberServices",
+			Handler:    _BookingService_SetBarberServices_Handler,
+		},
+		{
+			MethodName: "ListBarbers",
+			Handler:    _BookingService_ListBarbers_Handler,
+		},
+		{
+			MethodName: "GetBarber",
+			Handler:    _BookingService_GetBarber_Handler,
+		},
+		{
+			MethodName: "CreateBarber",
+			Handler:    _BookingService_CreateBarber_Handler,
+		},
+		{
+			MethodName: "UpdateBarber",
+			Handler:    _BookingService_UpdateBarber_Handler,
+		},
+		{
+			MethodName: "DeleteBarber",
+			Handler:    _BookingService_DeleteBarber_Handler,
+		},
+		{
+			MethodName: "AddFavoriteBarber",
+			Handler:    _BookingService_AddFavoriteBarber_Handler,
+		},
+		{
+			MethodName: "RemoveFavoriteBarber",
+			Handler:    _BookingService_RemoveFavoriteBarber_Handler,
+		},
+		{
+			MethodName: "ListFavorites",
+			Handler:    _BookingService_ListFavorites_Handler,
+		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _BookingService_GetQuote_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _BookingService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _BookingService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _BookingService_DeleteWebhook_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBookings",
+			Handler:       _BookingService_WatchBookings_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/proto/booking.proto",
 }
@@ -0,0 +1,258 @@
+// Package client is a Go client for the booking service. It wraps the
+// generated gRPC stubs with what every caller otherwise writes itself:
+// sending the caller's token, retrying calls the service refused before
+// acting on them, reading times and returning errors callers can match with
+// errors.Is.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// TokenSource returns the token to call the service with. It's called for
+// every call, so it can refresh tokens before they expire.
+type TokenSource func(ctx context.Context) (string, error)
+
+// StaticToken is a token source always returning token
+func StaticToken(token string) TokenSource {
+	return func(ctx context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+// options holds the settings of a client
+type options struct {
+	tokens      TokenSource
+	tenant      string
+	creds       credentials.TransportCredentials
+	retry       RetryPolicy
+	dialOptions []grpc.DialOption
+}
+
+// Option configures a client
+type Option func(*options)
+
+// WithTokenSource sends a token from tokens with every call
+func WithTokenSource(tokens TokenSource) Option {
+	return func(o *options) {
+		o.tokens = tokens
+	}
+}
+
+// WithToken sends token with every call
+func WithToken(token string) Option {
+	return WithTokenSource(StaticToken(token))
+}
+
+// WithTenant acts on a shop of a multi-tenant deployment, for callers whose
+// tokens don't name one, e.g. admins of every shop
+func WithTenant(tenant string) Option {
+	return func(o *options) {
+		o.tenant = tenant
+	}
+}
+
+// WithTLS connects over TLS with the given config, e.g. to present a client
+// certificate. Clients connect over TLS with the system roots by default.
+func WithTLS(config *tls.Config) Option {
+	return func(o *options) {
+		o.creds = credentials.NewTLS(config)
+	}
+}
+
+// WithInsecure connects without TLS, for local development only: tokens are
+// sent in the clear
+func WithInsecure() Option {
+	return func(o *options) {
+		o.creds = insecure.NewCredentials()
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+// WithDialOptions passes extra options to grpc.NewClient
+func WithDialOptions(dialOptions ...grpc.DialOption) Option {
+	return func(o *options) {
+		o.dialOptions = append(o.dialOptions, dialOptions...)
+	}
+}
+
+// Client calls the booking service. It's safe for concurrent use.
+type Client struct {
+	conn *grpc.ClientConn
+	stub pb.BookingServiceClient
+}
+
+// New creates a client for the service at target, e.g.
+// "booking-service:50051". Connecting happens on the first call.
+func New(target string, opts ...Option) (*Client, error) {
+	o := options{
+		creds: credentials.NewTLS(nil),
+		retry: DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	dialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(o.creds),
+		grpc.WithChainUnaryInterceptor(tokenUnaryInterceptor(o.tokens), o.retry.unaryRetry),
+		grpc.WithChainStreamInterceptor(tokenStreamInterceptor(o.tokens)),
+	}
+	if o.tenant != "" {
+		dialOptions = append(dialOptions,
+			grpc.WithChainUnaryInterceptor(tenantUnaryInterceptor(o.tenant)),
+			grpc.WithChainStreamInterceptor(tenantStreamInterceptor(o.tenant)))
+	}
+	conn, err := grpc.NewClient(target, append(dialOptions, o.dialOptions...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{conn: conn, stub: pb.NewBookingServiceClient(conn)}, nil
+}
+
+// Close closes the connection to the service
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Stub returns the generated client, for calls without a helper here. Its
+// calls send the token and are retried too, and fail with an *Error.
+func (c *Client) Stub() pb.BookingServiceClient {
+	return c.stub
+}
+
+// withToken adds the caller's token to the outgoing metadata
+func withToken(ctx context.Context, tokens TokenSource) (context.Context, error) {
+	if tokens == nil {
+		return ctx, nil
+	}
+	token, err := tokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token), nil
+}
+
+// tokenUnaryInterceptor sends the token with unary calls
+func tokenUnaryInterceptor(tokens TokenSource) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := withToken(ctx, tokens)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// tokenStreamInterceptor sends the token when opening streams
+func tokenStreamInterceptor(tokens TokenSource) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := withToken(ctx, tokens)
+		if err != nil {
+			return nil, err
+		}
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		return stream, FromError(err)
+	}
+}
+
+// tenantUnaryInterceptor sends the shop to act on with unary calls
+func tenantUnaryInterceptor(tenant string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-tenant-id", tenant)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// tenantStreamInterceptor sends the shop to act on when opening streams
+func tenantStreamInterceptor(tenant string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-tenant-id", tenant)
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// NewBooking describes a booking to create
+type NewBooking struct {
+	UserID   string
+	BarberID string
+	Start    time.Time
+	Services []pb.ServiceType // Done back to back, in order
+	Notes    string
+
+	// ExternalRef is an optional reference from another system, e.g. a POS
+	ExternalRef string
+	// IdempotencyKey makes retrying the create safe across processes. The
+	// client's own retries use a generated key when it's empty.
+	IdempotencyKey string
+}
+
+// CreateBooking books an appointment. A taken slot fails with an *Error
+// matching ErrSlotUnavailable, whose Alternatives suggest free slots.
+func (c *Client) CreateBooking(ctx context.Context, booking NewBooking) (*pb.Booking, error) {
+	return c.stub.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:         booking.UserID,
+		BarberId:       booking.BarberID,
+		StartTimeTs:    timestamppb.New(booking.Start),
+		ServiceTypes:   booking.Services,
+		Notes:          booking.Notes,
+		ExternalRef:    booking.ExternalRef,
+		IdempotencyKey: booking.IdempotencyKey,
+	})
+}
+
+// GetBooking gets a booking by ID
+func (c *Client) GetBooking(ctx context.Context, id string) (*pb.Booking, error) {
+	return c.stub.GetBooking(ctx, &pb.GetBookingRequest{Id: id})
+}
+
+// CancelBooking cancels a booking, returning the fee charged under the
+// cancellation policy, if any
+func (c *Client) CancelBooking(ctx context.Context, id, reason string) (*pb.CancelBookingResponse, error) {
+	return c.stub.CancelBooking(ctx, &pb.CancelBookingRequest{Id: id, Reason: reason})
+}
+
+// AvailableSlots lists a barber's free slots on the calendar date of day,
+// taken in the barber's time zone, with room for the services
+func (c *Client) AvailableSlots(ctx context.Context, barberID string, day time.Time, services ...pb.ServiceType) ([]TimeSlot, error) {
+	resp, err := c.stub.GetAvailableTimeSlots(ctx, &pb.GetAvailableTimeSlotsRequest{
+		BarberId:     barberID,
+		Day:          &pb.CalendarDate{Year: int32(day.Year()), Month: int32(day.Month()), Day: int32(day.Day())},
+		ServiceTypes: services,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return TimeSlots(resp.TimeSlots), nil
+}
+
+// NextAvailableSlots finds a barber's earliest free slots with room for the
+// services. The service caps count.
+func (c *Client) NextAvailableSlots(ctx context.Context, barberID string, count int, services ...pb.ServiceType) ([]TimeSlot, error) {
+	resp, err := c.stub.GetNextAvailableSlot(ctx, &pb.GetNextAvailableSlotRequest{
+		BarberId:     barberID,
+		Count:        int32(count),
+		ServiceTypes: services,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return TimeSlots(resp.TimeSlots), nil
+}
@@ -0,0 +1,277 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// fakeServer fails calls with the queued errors before answering them, and
+// records what it receives
+type fakeServer struct {
+	pb.UnimplementedBookingServiceServer
+
+	mu              sync.Mutex
+	failures        []error
+	calls           int
+	authorization   []string
+	tenants         []string
+	idempotencyKeys []string
+}
+
+// fail pops the next queued error, recording the caller's token
+func (s *fakeServer) fail(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls++
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.authorization = append(s.authorization, md.Get("authorization")...)
+	s.tenants = append(s.tenants, md.Get("x-tenant-id")...)
+	if len(s.failures) == 0 {
+		return nil
+	}
+	err := s.failures[0]
+	s.failures = s.failures[1:]
+	return err
+}
+
+func (s *fakeServer) GetBooking(ctx context.Context, req *pb.GetBookingRequest) (*pb.Booking, error) {
+	if err := s.fail(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.Booking{
+		Id:          req.Id,
+		StartTimeTs: timestamppb.New(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+		EndTime:     "2024-03-01T10:30:00Z",
+	}, nil
+}
+
+func (s *fakeServer) CreateBooking(ctx context.Context, req *pb.CreateBookingRequest) (*pb.Booking, error) {
+	s.mu.Lock()
+	s.idempotencyKeys = append(s.idempotencyKeys, req.IdempotencyKey)
+	s.mu.Unlock()
+	if err := s.fail(ctx); err != nil {
+		return nil, err
+	}
+	return &pb.Booking{Id: "booking1", UserId: req.UserId, StartTimeTs: req.StartTimeTs}, nil
+}
+
+func (s *fakeServer) GetAvailableTimeSlots(ctx context.Context, req *pb.GetAvailableTimeSlotsRequest) (*pb.TimeSlotList, error) {
+	if err := s.fail(ctx); err != nil {
+		return nil, err
+	}
+	start := time.Date(int(req.Day.Year), time.Month(req.Day.Month), int(req.Day.Day), 9, 0, 0, 0, time.UTC)
+	return &pb.TimeSlotList{TimeSlots: []*pb.TimeSlot{
+		{StartTimeTs: timestamppb.New(start), EndTimeTs: timestamppb.New(start.Add(30 * time.Minute))},
+	}}, nil
+}
+
+// newTestClient starts a fake server and connects a client to it
+func newTestClient(t *testing.T, srv *fakeServer, opts ...Option) *Client {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	pb.RegisterBookingServiceServer(s, srv)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}
+	opts = append([]Option{
+		WithInsecure(),
+		WithDialOptions(grpc.WithContextDialer(dialer)),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}),
+	}, opts...)
+
+	c, err := New("passthrough:///bufnet", opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestClient_SendsToken(t *testing.T) {
+	srv := &fakeServer{}
+	calls := 0
+	c := newTestClient(t, srv, WithTokenSource(func(ctx context.Context) (string, error) {
+		calls++
+		return "token" + string(rune('0'+calls)), nil
+	}))
+
+	_, err := c.GetBooking(context.Background(), "booking1")
+	require.NoError(t, err)
+	_, err = c.Stub().GetBooking(context.Background(), &pb.GetBookingRequest{Id: "booking1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Bearer token1", "Bearer token2"}, srv.authorization)
+}
+
+func TestClient_TokenSourceError(t *testing.T) {
+	srv := &fakeServer{}
+	tokenErr := errors.New("token expired")
+	c := newTestClient(t, srv, WithTokenSource(func(ctx context.Context) (string, error) {
+		return "", tokenErr
+	}))
+
+	_, err := c.GetBooking(context.Background(), "booking1")
+	assert.ErrorIs(t, err, tokenErr)
+	assert.Empty(t, srv.authorization)
+}
+
+func TestClient_SendsTenant(t *testing.T) {
+	srv := &fakeServer{}
+	c := newTestClient(t, srv, WithToken("token1"), WithTenant("shop1"))
+
+	_, err := c.GetBooking(context.Background(), "booking1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"shop1"}, srv.tenants)
+	assert.Equal(t, []string{"Bearer token1"}, srv.authorization)
+}
+
+func TestClient_Retries(t *testing.T) {
+	t.Run("unavailable calls are retried", func(t *testing.T) {
+		srv := &fakeServer{failures: []error{
+			status.Error(codes.Unavailable, "restarting"),
+			status.Error(codes.Unavailable, "restarting"),
+		}}
+		c := newTestClient(t, srv)
+
+		booking, err := c.GetBooking(context.Background(), "booking1")
+		require.NoError(t, err)
+		assert.Equal(t, "booking1", booking.Id)
+		assert.Equal(t, 3, srv.calls)
+	})
+
+	t.Run("gives up after the last attempt", func(t *testing.T) {
+		srv := &fakeServer{failures: []error{
+			status.Error(codes.Unavailable, "down"),
+			status.Error(codes.Unavailable, "down"),
+			status.Error(codes.Unavailable, "down"),
+		}}
+		c := newTestClient(t, srv)
+
+		_, err := c.GetBooking(context.Background(), "booking1")
+		assert.ErrorIs(t, err, ErrUnavailable)
+		assert.Equal(t, 3, srv.calls)
+	})
+
+	t.Run("other errors aren't retried", func(t *testing.T) {
+		srv := &fakeServer{failures: []error{status.Error(codes.NotFound, "booking not found")}}
+		c := newTestClient(t, srv)
+
+		_, err := c.GetBooking(context.Background(), "booking1")
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Equal(t, 1, srv.calls)
+	})
+
+	t.Run("rate-limited calls wait as asked", func(t *testing.T) {
+		st, err := status.New(codes.ResourceExhausted, "rate limit exceeded").
+			WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(20 * time.Millisecond)})
+		require.NoError(t, err)
+		srv := &fakeServer{failures: []error{st.Err()}}
+		c := newTestClient(t, srv)
+
+		started := time.Now()
+		_, err = c.GetBooking(context.Background(), "booking1")
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(started), 20*time.Millisecond)
+	})
+
+	t.Run("retried creates reuse one idempotency key", func(t *testing.T) {
+		srv := &fakeServer{failures: []error{status.Error(codes.Unavailable, "restarting")}}
+		c := newTestClient(t, srv)
+
+		_, err := c.CreateBooking(context.Background(), NewBooking{UserID: "alice", BarberID: "barber1", Start: time.Now()})
+		require.NoError(t, err)
+		require.Len(t, srv.idempotencyKeys, 2)
+		assert.NotEmpty(t, srv.idempotencyKeys[0])
+		assert.Equal(t, srv.idempotencyKeys[0], srv.idempotencyKeys[1])
+
+		_, err = c.CreateBooking(context.Background(), NewBooking{UserID: "alice", BarberID: "barber1", Start: time.Now(), IdempotencyKey: "mine"})
+		require.NoError(t, err)
+		assert.Equal(t, "mine", srv.idempotencyKeys[2])
+	})
+}
+
+func TestClient_SlotUnavailable(t *testing.T) {
+	conflict := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	st, err := status.New(codes.FailedPrecondition, "barber is not available at the requested time").WithDetails(
+		&errdetails.ErrorInfo{Reason: "SLOT_UNAVAILABLE", Domain: errorDomain},
+		&pb.SlotUnavailableDetail{
+			Conflicts:    []*pb.TimeSlot{{StartTimeTs: timestamppb.New(conflict), EndTimeTs: timestamppb.New(conflict.Add(30 * time.Minute))}},
+			Alternatives: []*pb.TimeSlot{{StartTimeTs: timestamppb.New(conflict.Add(time.Hour)), EndTimeTs: timestamppb.New(conflict.Add(90 * time.Minute))}},
+		},
+	)
+	require.NoError(t, err)
+	c := newTestClient(t, &fakeServer{failures: []error{st.Err()}})
+
+	_, err = c.CreateBooking(context.Background(), NewBooking{UserID: "alice", BarberID: "barber1", Start: conflict})
+	assert.ErrorIs(t, err, ErrSlotUnavailable)
+	assert.ErrorIs(t, err, ErrFailedPrecondition)
+	assert.NotErrorIs(t, err, ErrShopClosed)
+
+	var clientErr *Error
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, "SLOT_UNAVAILABLE", clientErr.Reason)
+	assert.Equal(t, []TimeSlot{{Start: conflict, End: conflict.Add(30 * time.Minute)}}, clientErr.Conflicts)
+	assert.Equal(t, []TimeSlot{{Start: conflict.Add(time.Hour), End: conflict.Add(90 * time.Minute)}}, clientErr.Alternatives)
+	assert.Equal(t, "booking service: barber is not available at the requested time (FailedPrecondition)", err.Error())
+}
+
+func TestClient_AvailableSlots(t *testing.T) {
+	c := newTestClient(t, &fakeServer{})
+
+	slots, err := c.AvailableSlots(context.Background(), "barber1", time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC), pb.ServiceType_HAIRCUT)
+	require.NoError(t, err)
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, []TimeSlot{{Start: start, End: start.Add(30 * time.Minute)}}, slots)
+}
+
+func TestFromError_Violations(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid request: id is required").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "id", Description: "is required"}},
+	})
+	require.NoError(t, err)
+
+	converted := FromError(st.Err())
+	assert.ErrorIs(t, converted, ErrInvalidArgument)
+	var clientErr *Error
+	require.ErrorAs(t, converted, &clientErr)
+	assert.Equal(t, []FieldViolation{{Field: "id", Description: "is required"}}, clientErr.Violations)
+
+	// Errors without a status are left alone
+	assert.Equal(t, context.Canceled, FromError(context.Canceled))
+	assert.Nil(t, FromError(nil))
+}
+
+func TestBookingTimes(t *testing.T) {
+	start, end, err := BookingTimes(&pb.Booking{
+		StartTimeTs: timestamppb.New(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+		StartTime:   "ignored",
+		EndTime:     "2024-03-01T11:30:00+01:00",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC), start)
+	assert.True(t, end.Equal(time.Date(2024, 3, 1, 10, 30, 0, 0, time.UTC)))
+
+	_, _, err = BookingTimes(&pb.Booking{StartTime: "yesterday"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// errorDomain is the ErrorInfo domain of the booking service's errors
+const errorDomain = "booking.ita-av"
+
+// Errors by status code. Calls fail with an *Error, which matches these with
+// errors.Is.
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrConflict           = errors.New("modified concurrently")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrUnavailable        = errors.New("service unavailable")
+)
+
+// Errors by the reason the service gives for refusing a booking time. An
+// *Error matches both these and its status code's error.
+var (
+	ErrSlotUnavailable    = errors.New("slot unavailable")
+	ErrBarberOnBreak      = errors.New("barber on break")
+	ErrShopClosed         = errors.New("shop closed")
+	ErrStartTimeInPast    = errors.New("start time in the past")
+	ErrBookingTooSoon     = errors.New("booking too soon")
+	ErrBookingTooFarAhead = errors.New("booking too far ahead")
+	ErrServiceNotOffered  = errors.New("service not offered")
+)
+
+// codeErrors maps status codes to their errors
+var codeErrors = map[codes.Code]error{
+	codes.InvalidArgument:    ErrInvalidArgument,
+	codes.NotFound:           ErrNotFound,
+	codes.AlreadyExists:      ErrAlreadyExists,
+	codes.PermissionDenied:   ErrPermissionDenied,
+	codes.Unauthenticated:    ErrUnauthenticated,
+	codes.FailedPrecondition: ErrFailedPrecondition,
+	codes.Aborted:            ErrConflict,
+	codes.ResourceExhausted:  ErrRateLimited,
+	codes.Unavailable:        ErrUnavailable,
+}
+
+// reasonErrors maps ErrorInfo reasons to their errors
+var reasonErrors = map[string]error{
+	"SLOT_UNAVAILABLE":      ErrSlotUnavailable,
+	"BARBER_ON_BREAK":       ErrBarberOnBreak,
+	"SHOP_CLOSED":           ErrShopClosed,
+	"START_TIME_IN_PAST":    ErrStartTimeInPast,
+	"BOOKING_TOO_SOON":      ErrBookingTooSoon,
+	"BOOKING_TOO_FAR_AHEAD": ErrBookingTooFarAhead,
+	"SERVICE_NOT_OFFERED":   ErrServiceNotOffered,
+}
+
+// FieldViolation is a request field that broke the service's validation rules
+type FieldViolation struct {
+	Field       string // e.g. "policy.rules[1].fee_percent"
+	Description string
+}
+
+// Error is a failed call, with the details the service attached
+type Error struct {
+	Code    codes.Code
+	Message string
+
+	// Reason is the ErrorInfo reason, e.g. SLOT_UNAVAILABLE, if there is one
+	Reason string
+	// Violations lists the invalid fields of a rejected request
+	Violations []FieldViolation
+	// RetryAfter is how long to wait before retrying a rate-limited call
+	RetryAfter time.Duration
+	// Conflicts are the times of the bookings a requested slot overlaps, and
+	// Alternatives free slots to offer instead
+	Conflicts    []TimeSlot
+	Alternatives []TimeSlot
+
+	status *status.Status
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("booking service: %s (%s)", e.Message, e.Code)
+}
+
+// Is matches the errors for the status code and reason
+func (e *Error) Is(target error) bool {
+	return target == codeErrors[e.Code] || (e.Reason != "" && target == reasonErrors[e.Reason])
+}
+
+// GRPCStatus returns the status the call failed with, so status.Code and
+// status.FromError still work on the error
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// FromError converts the error of a call to an *Error, reading the details
+// the service attached. Errors without a status, such as the caller's
+// context being cancelled, are returned unchanged.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var clientErr *Error
+	if errors.As(err, &clientErr) {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	e := &Error{Code: st.Code(), Message: st.Message(), status: st}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.Domain == errorDomain {
+				e.Reason = d.Reason
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				e.Violations = append(e.Violations, FieldViolation{Field: v.Field, Description: v.Description})
+			}
+		case *errdetails.RetryInfo:
+			e.RetryAfter = d.RetryDelay.AsDuration()
+		case *pb.SlotUnavailableDetail:
+			e.Conflicts = TimeSlots(d.Conflicts)
+			e.Alternatives = TimeSlots(d.Alternatives)
+		}
+	}
+	return e
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// RetryPolicy says how calls that failed before the service acted on them
+// are retried: calls refused as Unavailable or ResourceExhausted
+type RetryPolicy struct {
+	// MaxAttempts is how many times a call is made in total; 1 disables retries
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry, doubled after each
+	// one up to MaxBackoff. Rate-limited calls wait as long as the service asks.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy makes up to three attempts, starting 100ms apart
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+// retryable reports whether a failed call can be made again safely
+func retryable(code codes.Code) bool {
+	return code == codes.Unavailable || code == codes.ResourceExhausted
+}
+
+// unaryRetry retries failed calls under the policy, and converts the final
+// error to an *Error. Bookings are created with an idempotency key, so a
+// retried create can't book twice.
+func (p RetryPolicy) unaryRetry(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if create, ok := req.(*pb.CreateBookingRequest); ok && create.IdempotencyKey == "" && p.MaxAttempts > 1 {
+		create = proto.Clone(create).(*pb.CreateBookingRequest)
+		create.IdempotencyKey = newIdempotencyKey()
+		req = create
+	}
+
+	backoff := p.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			return nil
+		}
+		err = FromError(err)
+		if attempt >= p.MaxAttempts || !retryable(status.Code(err)) {
+			return err
+		}
+
+		wait := backoff
+		if clientErr, ok := err.(*Error); ok && clientErr.RetryAfter > 0 {
+			wait = clientErr.RetryAfter
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, p.MaxBackoff)
+	}
+}
+
+// newIdempotencyKey returns a random key for a create request
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
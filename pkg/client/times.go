@@ -0,0 +1,57 @@
+package client
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ita-av/booking-service/pkg/api/proto"
+)
+
+// TimeSlot is a free or taken period in a barber's day
+type TimeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ParseTime reads a time the service sends both as a timestamp and as a
+// deprecated ISO string, preferring the timestamp. The zero time means
+// neither was set.
+func ParseTime(ts *timestamppb.Timestamp, legacy string) (time.Time, error) {
+	if ts != nil {
+		return ts.AsTime(), nil
+	}
+	if legacy == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, legacy)
+}
+
+// BookingTimes returns when a booking starts and ends
+func BookingTimes(booking *pb.Booking) (start, end time.Time, err error) {
+	if start, err = ParseTime(booking.GetStartTimeTs(), booking.GetStartTime()); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if end, err = ParseTime(booking.GetEndTimeTs(), booking.GetEndTime()); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, end, nil
+}
+
+// TimeSlots converts time slots from the service, skipping any whose times
+// can't be read
+func TimeSlots(slots []*pb.TimeSlot) []TimeSlot {
+	converted := make([]TimeSlot, 0, len(slots))
+	for _, slot := range slots {
+		start, err := ParseTime(slot.GetStartTimeTs(), slot.GetStartTime())
+		if err != nil {
+			continue
+		}
+		end, err := ParseTime(slot.GetEndTimeTs(), slot.GetEndTime())
+		if err != nil {
+			continue
+		}
+		converted = append(converted, TimeSlot{Start: start, End: end})
+	}
+	return converted
+}